@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"runtime"
+	"slices"
 	"sync"
 
 	"github.com/opencontainers/go-digest"
@@ -529,6 +530,9 @@ func (c *DefaultConstructor) processSourceWithInput(ctx context.Context, targetR
 	var processedSource *descriptor.Source
 
 	if result.ProcessedBlobData != nil {
+		if result.ProcessedSource != nil {
+			src.Labels = mergeProcessedLabels(src.Labels, result.ProcessedSource.Labels)
+		}
 		processedSource, err = addColocatedSourceLocalBlob(ctx, targetRepo, component, version, src, result.ProcessedBlobData)
 	} else if result.ProcessedSource != nil {
 		processedSource = result.ProcessedSource
@@ -570,6 +574,9 @@ func (c *DefaultConstructor) processResourceWithInput(ctx context.Context, targe
 	var processedResource *descriptor.Resource
 
 	if result.ProcessedBlobData != nil {
+		if result.ProcessedResource != nil {
+			resource.Labels = mergeProcessedLabels(resource.Labels, result.ProcessedResource.Labels)
+		}
 		processedResource, err = addColocatedResourceLocalBlob(ctx, targetRepo, component, version, resource, result.ProcessedBlobData)
 	} else if result.ProcessedResource != nil {
 		processedResource = result.ProcessedResource
@@ -673,6 +680,21 @@ func calculateDigest(component *descriptor.Descriptor) (*descriptor.Digest, erro
 //  2. If the media type is available it is used for the local blob specification.
 //
 // The resource is expected to be a local resource so the access that is created is always a local blob.
+// mergeProcessedLabels returns the labels extended by the labels of the processed resource or source an input method
+// returned along with its blob data, e.g. a label recording the origin of the data. Processed labels replace labels
+// of the same name. The given labels are not modified.
+func mergeProcessedLabels(labels []constructor.Label, processed []descriptor.Label) []constructor.Label {
+	merged := slices.Clone(labels)
+	for _, label := range constructor.ConvertFromDescriptorLabels(processed) {
+		if idx := slices.IndexFunc(merged, func(l constructor.Label) bool { return l.Name == label.Name }); idx >= 0 {
+			merged[idx] = label
+		} else {
+			merged = append(merged, label)
+		}
+	}
+	return merged
+}
+
 func addColocatedResourceLocalBlob(
 	ctx context.Context,
 	repo TargetRepository,
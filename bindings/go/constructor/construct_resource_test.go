@@ -38,17 +38,13 @@ func (m *mockInputMethod) GetResourceCredentialConsumerIdentity(ctx context.Cont
 
 func (m *mockInputMethod) ProcessResource(ctx context.Context, resource *constructorruntime.Resource, creds runtime.Typed) (*ResourceInputMethodResult, error) {
 	m.capturedCreds = creds
-	if m.processedResource != nil {
-		return &ResourceInputMethodResult{
-			ProcessedResource: m.processedResource,
-		}, nil
-	}
-	if m.processedBlob != nil {
-		return &ResourceInputMethodResult{
-			ProcessedBlobData: m.processedBlob,
-		}, nil
+	if m.processedResource == nil && m.processedBlob == nil {
+		return nil, nil
 	}
-	return nil, nil
+	return &ResourceInputMethodResult{
+		ProcessedResource: m.processedResource,
+		ProcessedBlobData: m.processedBlob,
+	}, nil
 }
 
 // mockInputMethodProvider implements ResourceInputMethodProvider for testing
@@ -270,6 +266,57 @@ func TestConstructWithMockInputMethod(t *testing.T) {
 	assert.Len(t, mockRepo.addedVersions, 1)
 }
 
+func TestConstructWithInputMethodLabels(t *testing.T) {
+	// the input method returns blob data together with a processed resource carrying labels about the data
+	mockInput := &mockInputMethod{
+		processedResource: &descriptor.Resource{
+			ElementMeta: descriptor.ElementMeta{
+				ObjectMeta: descriptor.ObjectMeta{
+					Labels: []descriptor.Label{
+						{Name: "origin", Value: []byte(`"processed"`)},
+						{Name: "commit", Value: []byte(`"abc"`)},
+					},
+				},
+			},
+		},
+		processedBlob: &mockBlob{mediaType: "application/octet-stream", data: []byte("test data")},
+	}
+	mockProvider := &mockInputMethodProvider{
+		methods: map[runtime.Type]ResourceInputMethod{
+			runtime.NewVersionedType("mock", "v1"): mockInput,
+		},
+	}
+
+	constructor := setupTestComponent(t, `
+      - name: test-resource
+        version: v1.0.0
+        type: blob
+        labels:
+          - name: origin
+            value: declared
+          - name: team
+            value: ocm
+        input:
+          type: mock/v1
+`)
+
+	mockRepo := newMockTargetRepository()
+	constructorInstance := NewDefaultConstructor(constructor, Options{
+		ResourceInputMethodProvider: mockProvider,
+		TargetRepositoryProvider:    &mockTargetRepositoryProvider{repo: mockRepo},
+	})
+	require.NoError(t, constructorInstance.Construct(t.Context()))
+
+	require.Len(t, mockRepo.addedLocalResources, 1)
+	labels := mockRepo.addedLocalResources[0].Labels
+	require.Len(t, labels, 3)
+	assert.Equal(t, "origin", labels[0].Name)
+	assert.JSONEq(t, `"processed"`, string(labels[0].Value))
+	assert.Equal(t, "team", labels[1].Name)
+	assert.Equal(t, "commit", labels[2].Name)
+	assert.JSONEq(t, `"abc"`, string(labels[2].Value))
+}
+
 func TestConstructWithResourceAccess(t *testing.T) {
 	constructor := setupTestComponent(t, `
        - name: test-resource
@@ -34,17 +34,13 @@ func (m *mockSourceInputMethod) GetSourceCredentialConsumerIdentity(ctx context.
 }
 
 func (m *mockSourceInputMethod) ProcessSource(ctx context.Context, source *constructorruntime.Source, creds runtime.Typed) (*SourceInputMethodResult, error) {
-	if m.processedSource != nil {
-		return &SourceInputMethodResult{
-			ProcessedSource: m.processedSource,
-		}, nil
+	if m.processedSource == nil && m.processedBlob == nil {
+		return nil, nil
 	}
-	if m.processedBlob != nil {
-		return &SourceInputMethodResult{
-			ProcessedBlobData: m.processedBlob,
-		}, nil
-	}
-	return nil, nil
+	return &SourceInputMethodResult{
+		ProcessedSource:   m.processedSource,
+		ProcessedBlobData: m.processedBlob,
+	}, nil
 }
 
 // mockSourceInputMethodProvider implements SourceInputMethodProvider for testing
@@ -347,6 +343,46 @@ func TestConstructWithSourceBlob(t *testing.T) {
 	assert.Len(t, mockRepo.addedVersions, 1)
 }
 
+func TestConstructWithSourceInputMethodLabels(t *testing.T) {
+	// the input method returns blob data together with a processed source carrying labels about the data
+	mockInput := &mockSourceInputMethod{
+		processedSource: &descriptor.Source{
+			ElementMeta: descriptor.ElementMeta{
+				ObjectMeta: descriptor.ObjectMeta{
+					Labels: []descriptor.Label{{Name: "commit", Value: []byte(`"abc"`)}},
+				},
+			},
+		},
+		processedBlob: &mockBlob{mediaType: "application/octet-stream", data: []byte("test source data")},
+	}
+	mockProvider := &mockSourceInputMethodProvider{
+		methods: map[runtime.Type]SourceInputMethod{
+			runtime.NewVersionedType("mock", "v1"): mockInput,
+		},
+	}
+
+	constructor := setupTestComponentWithSource(t, `
+      - name: test-source
+        version: v1.0.0
+        type: git
+        input:
+          type: mock/v1
+`)
+
+	mockRepo := newMockTargetRepository()
+	constructorInstance := NewDefaultConstructor(constructor, Options{
+		SourceInputMethodProvider: mockProvider,
+		TargetRepositoryProvider:  &mockTargetRepositoryProvider{repo: mockRepo},
+	})
+	require.NoError(t, constructorInstance.Construct(t.Context()))
+
+	require.Len(t, mockRepo.addedSources, 1)
+	labels := mockRepo.addedSources[0].Labels
+	require.Len(t, labels, 1)
+	assert.Equal(t, "commit", labels[0].Name)
+	assert.JSONEq(t, `"abc"`, string(labels[0].Value))
+}
+
 func TestConstructWithInvalidSourceInputMethodType(t *testing.T) {
 	constructor := setupTestComponentWithSource(t, `
       - name: test-source
@@ -22,6 +22,8 @@ import (
 // If the ResourceInputMethodResult.ProcessedBlobData is set, the access type of the blob must be uploaded as a local resource
 // with the relation `local`, the media type derived from blob.MediaTypeAware, and the resource version defaulted
 // to the component version.
+// If both are set, the blob is uploaded and the labels of the ProcessedResource are added to the uploaded resource,
+// replacing labels of the same name. This is how input methods record information about the processed data.
 type ResourceInputMethodResult struct {
 	ProcessedResource *descriptor.Resource
 	ProcessedBlobData blob.ReadOnlyBlob
@@ -62,6 +64,8 @@ type ResourceInputMethod interface {
 // If the ProcessedSource.ProcessedBlobData is set, the access type of the blob must be uploaded as a local resource
 // with the relation `local`, the media type derived from blob.MediaTypeAware, and the resource version defaulted
 // to the component version.
+// If both are set, the blob is uploaded and the labels of the ProcessedSource are added to the uploaded source,
+// replacing labels of the same name. This is how input methods record information about the processed data.
 type SourceInputMethodResult struct {
 	ProcessedSource   *descriptor.Source
 	ProcessedBlobData blob.ReadOnlyBlob
@@ -76,6 +76,25 @@
 // [ocm.software/open-component-model/bindings/go/credentials/spec/config/v1.DirectCredentials]
 // behavior when no provider is configured.
 //
+// # Credential References
+//
+// Credentials of type [ocm.software/open-component-model/bindings/go/credentials/spec/reference/v1alpha1.CredentialReference]
+// do not contain secrets. Each property references a literal value, an environment variable, a file or
+// the output of a command, so that the configuration can be committed:
+//
+//	credentials:
+//	- type: CredentialReference/v1alpha1
+//	  credentialType: OCICredentials/v1
+//	  properties:
+//	    username:
+//	      value: ci-bot
+//	    password:
+//	      env: REGISTRY_PASSWORD
+//
+// The references are read on the first [Graph.Resolve] of a matching identity and the result replaces the
+// reference in the graph. The properties are converted into credentialType if it is known to the credential type
+// scheme, otherwise into DirectCredentials. A reference counts as typed credential during ingestion.
+//
 // # Multi-Identity and Credential Mappings
 //
 // The credential system supports complex credential resolution through a graph-based approach.
@@ -56,6 +56,9 @@ type Graph struct {
 	repositoryPluginProvider     RepositoryPluginProvider     // injection for resolving custom repository types
 	credentialPluginProvider     CredentialPluginProvider     // injection for resolving custom credential types
 	credentialTypeSchemeProvider CredentialTypeSchemeProvider // optional: enables typed credential ingestion
+
+	referencesMu sync.Mutex                      // Mutex to protect access to references
+	references   map[string]*referenceResolution // Credential reference resolutions in progress by node
 }
 
 // credentialTypeScheme returns the underlying scheme from the credential type
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -686,3 +687,45 @@ func TestResolutionErrors(t *testing.T) {
 	r.ErrorContains(err, fmt.Sprintf("failed to resolve credentials for identity %q: credentials not found", id.String()))
 	r.ErrorContains(err, "no indirect credentials found in graph")
 }
+
+func TestResolveFromRepositoryIgnoresRepositoriesWithoutCredentials(t *testing.T) {
+	r := require.New(t)
+	id := runtime.Identity{
+		runtime.IdentityAttributeType:     "OCIRegistry",
+		runtime.IdentityAttributeHostname: "quay.io",
+	}
+	credsType := runtime.NewVersionedType(v1.CredentialsType, v1.Version)
+
+	g, err := credentials.ToGraph(t.Context(), &credentialruntime.Config{
+		Repositories: []credentialruntime.RepositoryConfigEntry{
+			{Repository: &runtime.Raw{Type: runtime.NewUnversionedType("Empty"), Data: []byte(`{"type":"Empty"}`)}},
+			{Repository: &runtime.Raw{Type: runtime.NewUnversionedType("Slow"), Data: []byte(`{"type":"Slow"}`)}},
+		},
+	}, credentials.Options{
+		RepositoryPluginProvider: credentials.GetRepositoryPluginFn(func(ctx context.Context, _ runtime.Typed) (credentials.RepositoryPlugin, error) {
+			return RepositoryPlugin{
+				RepositoryIdentityFunc: func(config runtime.Typed) (runtime.Identity, error) {
+					return runtime.Identity{}, nil
+				},
+				ResolveFunc: func(ctx context.Context, cfg runtime.Typed, identity runtime.Identity, _ runtime.Typed) (runtime.Typed, error) {
+					if cfg.GetType().Name == "Empty" {
+						// the repository does not know the identity and returns immediately
+						return nil, nil
+					}
+					select {
+					case <-ctx.Done():
+						return nil, ctx.Err()
+					case <-time.After(50 * time.Millisecond):
+					}
+					return &v1.DirectCredentials{Type: credsType, Properties: map[string]string{"username": "slow"}}, nil
+				},
+			}, nil
+		}),
+		CredentialRepositoryTypeScheme: runtime.NewScheme(runtime.WithAllowUnknown()),
+	})
+	r.NoError(err)
+
+	creds, err := g.Resolve(t.Context(), id)
+	r.NoError(err)
+	r.Equal("slow", creds.(*v1.DirectCredentials).Properties["username"])
+}
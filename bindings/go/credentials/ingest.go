@@ -185,6 +185,20 @@ func extractResolvable(ctx context.Context, g *Graph, creds []runtime.Typed) (ru
 			return nil, nil, fmt.Errorf("credential type is empty")
 		}
 
+		// Credential references count as typed credentials. Their values are only
+		// read when the credentials are resolved.
+		if ref, ok, err := asCredentialReference(cred); ok {
+			if err != nil {
+				return nil, nil, err
+			}
+			if resolved == nil {
+				resolved = ref
+			} else {
+				remaining = append(remaining, cred)
+			}
+			continue
+		}
+
 		// Try the credential type scheme first (e.g. HelmCredentials/v1, OCICredentials/v1).
 		// Only attempt deserialization for types explicitly registered in the scheme.
 		// Schemes configured with WithAllowUnknown would otherwise round-trip any type
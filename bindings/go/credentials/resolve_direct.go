@@ -25,10 +25,10 @@ func (g *Graph) resolveFromGraph(ctx context.Context, identity runtime.Identity)
 		return nil, err
 	}
 
-	// Leaf node: return the credentials directly.
+	// Leaf node: return the credentials directly, reading referenced values.
 	creds, cached := g.getCredentials(vertex.ID)
 	if cached {
-		return creds, nil
+		return g.dereference(ctx, vertex.ID, creds)
 	}
 
 	node := identity.String()
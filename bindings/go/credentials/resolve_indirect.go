@@ -48,7 +48,7 @@ func (g *Graph) resolveFromRepository(ctx context.Context, identity runtime.Iden
 		errs     []error
 	)
 
-	// Create a cancellable context so that once one repository succeeds, the other goroutines can be cancelled.
+	// Create a cancellable context so that once one repository yields credentials, the other goroutines can be cancelled.
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -76,6 +76,9 @@ func (g *Graph) resolveFromRepository(ctx context.Context, identity runtime.Iden
 		case err != nil:
 			slog.DebugContext(ctx, "repository plugin failed to resolve credentials", slog.Any("identity", identity), slog.Any("config", cfg.GetType()), slog.Any("error", err))
 			errs = append(errs, err)
+		case credentials == nil:
+			// the repository does not know the identity, another repository might still resolve it.
+			slog.DebugContext(ctx, "repository plugin did not resolve credentials", slog.Any("identity", identity), slog.Any("config", cfg.GetType()))
 		case resolved == nil:
 			resolved = credentials
 			cancel()
@@ -0,0 +1,173 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"strings"
+
+	v1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	reference "ocm.software/open-component-model/bindings/go/credentials/spec/reference/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// asCredentialReference converts cred into a CredentialReference if it is of that type.
+// The returned bool reports whether cred is a CredentialReference, independent of its validity.
+func asCredentialReference(cred runtime.Typed) (*reference.CredentialReference, bool, error) {
+	if !reference.Scheme.IsRegistered(cred.GetType()) {
+		return nil, false, nil
+	}
+	var ref reference.CredentialReference
+	if err := reference.Scheme.Convert(cred, &ref); err != nil {
+		return nil, true, fmt.Errorf("could not convert credential reference: %w", err)
+	}
+	if err := ref.Validate(); err != nil {
+		return nil, true, fmt.Errorf("invalid credential reference: %w", err)
+	}
+	return &ref, true, nil
+}
+
+// referenceResolution is a resolution of the credential reference of a node in progress.
+// Resolutions of the same node that start while it is in progress wait for and share its result,
+// so that a command is not run several times at once for concurrent requests.
+type referenceResolution struct {
+	done  chan struct{}
+	creds runtime.Typed
+	err   error
+}
+
+// dereference reads the values of a CredentialReference stored on node and returns the resolved credentials.
+// The values are read on every resolution and not stored in the graph, so that rotated secrets and
+// short-lived tokens printed by commands are picked up. Credentials of any other type are returned as is.
+func (g *Graph) dereference(ctx context.Context, node string, creds runtime.Typed) (runtime.Typed, error) {
+	ref, ok := creds.(*reference.CredentialReference)
+	if !ok {
+		return creds, nil
+	}
+
+	g.referencesMu.Lock()
+	if inProgress, ok := g.references[node]; ok {
+		g.referencesMu.Unlock()
+		select {
+		case <-inProgress.done:
+			return inProgress.creds, inProgress.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	resolution := &referenceResolution{done: make(chan struct{})}
+	if g.references == nil {
+		g.references = make(map[string]*referenceResolution)
+	}
+	g.references[node] = resolution
+	g.referencesMu.Unlock()
+
+	resolution.creds, resolution.err = g.resolveReference(ctx, node, ref)
+
+	g.referencesMu.Lock()
+	delete(g.references, node)
+	g.referencesMu.Unlock()
+	close(resolution.done)
+
+	return resolution.creds, resolution.err
+}
+
+// resolveReference reads the referenced values and converts them into the credential type of ref.
+func (g *Graph) resolveReference(ctx context.Context, node string, ref *reference.CredentialReference) (runtime.Typed, error) {
+	properties := make(map[string]string, len(ref.Properties))
+	for name, value := range ref.Properties {
+		resolved, err := resolveValueReference(ctx, value)
+		if err != nil {
+			return nil, fmt.Errorf("resolving property %q of credential reference for node %q failed: %w", name, node, err)
+		}
+		properties[name] = resolved
+	}
+
+	resolved, err := g.toCredentialType(ctx, ref.CredentialType, properties)
+	if err != nil {
+		return nil, fmt.Errorf("converting credential reference for node %q failed: %w", node, err)
+	}
+	return resolved, nil
+}
+
+// toCredentialType builds credentials of the given type from the resolved properties.
+// Types unknown to the credential type scheme fall back to DirectCredentials.
+func (g *Graph) toCredentialType(ctx context.Context, typ runtime.Type, properties map[string]string) (runtime.Typed, error) {
+	direct := &v1.DirectCredentials{
+		Type:       runtime.NewVersionedType(v1.CredentialsType, v1.Version),
+		Properties: properties,
+	}
+	if typ.IsEmpty() || scheme.IsRegistered(typ) {
+		return direct, nil
+	}
+
+	credScheme := g.credentialTypeScheme()
+	if credScheme == nil || !credScheme.IsRegistered(typ) {
+		slog.WarnContext(ctx, "credential type of credential reference is unknown, falling back to DirectCredentials",
+			"type", typ.String())
+		return direct, nil
+	}
+
+	fields := make(map[string]string, len(properties)+1)
+	for k, v := range properties {
+		fields[k] = v
+	}
+	fields["type"] = typ.String()
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling properties: %w", err)
+	}
+
+	typed, err := credScheme.NewObject(typ)
+	if err != nil {
+		return nil, fmt.Errorf("could not create credentials of type %q: %w", typ, err)
+	}
+	if err := credScheme.Convert(&runtime.Raw{Type: typ, Data: data}, typed); err != nil {
+		return nil, fmt.Errorf("could not convert properties to credentials of type %q: %w", typ, err)
+	}
+	return typed, nil
+}
+
+// resolveValueReference reads the value referenced by ref.
+func resolveValueReference(ctx context.Context, ref reference.ValueReference) (string, error) {
+	switch {
+	case ref.Value != "":
+		return ref.Value, nil
+	case ref.Env != "":
+		value, ok := os.LookupEnv(ref.Env)
+		if !ok {
+			return "", fmt.Errorf("environment variable %q is not set", ref.Env)
+		}
+		return value, nil
+	case ref.File != "":
+		data, err := os.ReadFile(ref.File)
+		if err != nil {
+			return "", fmt.Errorf("reading file failed: %w", err)
+		}
+		return trimLineBreak(string(data)), nil
+	case len(ref.Command) > 0:
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, ref.Command[0], ref.Command[1:]...)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return "", fmt.Errorf("running command %q failed: %w: %s", ref.Command[0], err, msg)
+			}
+			return "", fmt.Errorf("running command %q failed: %w", ref.Command[0], err)
+		}
+		return trimLineBreak(stdout.String()), nil
+	default:
+		return "", fmt.Errorf("no value referenced")
+	}
+}
+
+// trimLineBreak removes a single trailing line break as written by editors and most commands.
+func trimLineBreak(s string) string {
+	s = strings.TrimSuffix(s, "\n")
+	return strings.TrimSuffix(s, "\r")
+}
@@ -0,0 +1,207 @@
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfgRuntime "ocm.software/open-component-model/bindings/go/credentials/spec/config/runtime"
+	v1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	ocmruntime "ocm.software/open-component-model/bindings/go/runtime"
+)
+
+type staticCredentialTypeScheme struct {
+	scheme *ocmruntime.Scheme
+}
+
+func (s staticCredentialTypeScheme) GetCredentialTypeScheme() *ocmruntime.Scheme {
+	return s.scheme
+}
+
+func referenceGraph(t *testing.T, config string) *Graph {
+	t.Helper()
+	r := require.New(t)
+
+	var configv1 v1.Config
+	r.NoError(scheme.Decode(strings.NewReader(config), &configv1))
+
+	g, err := ToGraph(t.Context(), cfgRuntime.ConvertFromV1(&configv1), Options{
+		CredentialTypeSchemeProvider: staticCredentialTypeScheme{
+			scheme: newSchemeWith(t, &helmHTTPCredentials{Type: helmHTTPCredentialsType}),
+		},
+		CredentialRepositoryTypeScheme: ocmruntime.NewScheme(ocmruntime.WithAllowUnknown()),
+	})
+	r.NoError(err)
+	return g
+}
+
+func TestResolve_CredentialReference(t *testing.T) {
+	r := require.New(t)
+
+	t.Setenv("TEST_REGISTRY_PASSWORD", "from-env")
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert")
+	r.NoError(os.WriteFile(certFile, []byte("from-file\n"), 0o600))
+
+	g := referenceGraph(t, `
+type: credentials.config.ocm.software
+consumers:
+- identity:
+    type: OCIRegistry
+    hostname: ghcr.io
+  credentials:
+  - type: CredentialReference/v1alpha1
+    properties:
+      username:
+        value: ci-bot
+      password:
+        env: TEST_REGISTRY_PASSWORD
+- identity:
+    type: HelmChartRepository
+    hostname: charts.example.com
+  credentials:
+  - type: CredentialReference/v1alpha1
+    credentialType: HelmHTTPCredentials/v1
+    properties:
+      certFile:
+        file: `+certFile+`
+`)
+
+	t.Run("environment variable resolves to DirectCredentials", func(t *testing.T) {
+		creds, err := g.Resolve(t.Context(), ocmruntime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"})
+		require.NoError(t, err)
+		direct, ok := creds.(*v1.DirectCredentials)
+		require.True(t, ok, "expected *v1.DirectCredentials, got %T", creds)
+		assert.Equal(t, map[string]string{"username": "ci-bot", "password": "from-env"}, direct.Properties)
+	})
+
+	t.Run("file resolves to the configured credential type", func(t *testing.T) {
+		creds, err := g.Resolve(t.Context(), ocmruntime.Identity{"type": "HelmChartRepository", "hostname": "charts.example.com"})
+		require.NoError(t, err)
+		helm, ok := creds.(*helmHTTPCredentials)
+		require.True(t, ok, "expected *helmHTTPCredentials, got %T", creds)
+		assert.Equal(t, "from-file", helm.CertFile)
+	})
+
+	t.Run("values are read on every resolution", func(t *testing.T) {
+		t.Setenv("TEST_REGISTRY_PASSWORD", "changed")
+		creds, err := g.Resolve(t.Context(), ocmruntime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"})
+		require.NoError(t, err)
+		assert.Equal(t, "changed", creds.(*v1.DirectCredentials).Properties["password"])
+	})
+}
+
+func TestResolve_CredentialReferenceIsLazy(t *testing.T) {
+	g := referenceGraph(t, `
+type: credentials.config.ocm.software
+consumers:
+- identity:
+    type: OCIRegistry
+    hostname: ghcr.io
+  credentials:
+  - type: CredentialReference
+    properties:
+      password:
+        env: TEST_UNSET_REGISTRY_PASSWORD
+`)
+
+	_, err := g.Resolve(t.Context(), ocmruntime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"})
+	require.ErrorContains(t, err, `environment variable "TEST_UNSET_REGISTRY_PASSWORD" is not set`)
+}
+
+func TestResolve_CredentialReferenceCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	g := referenceGraph(t, `
+type: credentials.config.ocm.software
+consumers:
+- identity:
+    type: OCIRegistry
+    hostname: ghcr.io
+  credentials:
+  - type: CredentialReference/v1alpha1
+    properties:
+      token:
+        command: ["sh", "-c", "echo from-command"]
+- identity:
+    type: OCIRegistry
+    hostname: quay.io
+  credentials:
+  - type: CredentialReference/v1alpha1
+    properties:
+      token:
+        command: ["sh", "-c", "echo denied >&2; exit 1"]
+`)
+
+	creds, err := g.Resolve(t.Context(), ocmruntime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"})
+	require.NoError(t, err)
+	assert.Equal(t, "from-command", creds.(*v1.DirectCredentials).Properties["token"])
+
+	_, err = g.Resolve(t.Context(), ocmruntime.Identity{"type": "OCIRegistry", "hostname": "quay.io"})
+	require.ErrorContains(t, err, "denied")
+}
+
+func TestToGraph_InvalidCredentialReference(t *testing.T) {
+	var configv1 v1.Config
+	require.NoError(t, scheme.Decode(strings.NewReader(`
+type: credentials.config.ocm.software
+consumers:
+- identity:
+    type: OCIRegistry
+    hostname: ghcr.io
+  credentials:
+  - type: CredentialReference/v1alpha1
+    properties:
+      password:
+        env: A
+        file: /b
+`), &configv1))
+
+	_, err := ToGraph(t.Context(), cfgRuntime.ConvertFromV1(&configv1), Options{})
+	require.ErrorContains(t, err, "exactly one of value, env, file or command must be set")
+}
+
+func TestResolve_CredentialReferenceCommandConcurrent(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	// the command counts its runs and is slow enough for the resolutions to overlap.
+	counter := filepath.Join(t.TempDir(), "runs")
+	g := referenceGraph(t, `
+type: credentials.config.ocm.software
+consumers:
+- identity:
+    type: OCIRegistry
+    hostname: ghcr.io
+  credentials:
+  - type: CredentialReference/v1alpha1
+    properties:
+      token:
+        command: ["sh", "-c", "echo run >> `+counter+`; sleep 0.5; echo from-command"]
+`)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Go(func() {
+			creds, err := g.Resolve(t.Context(), ocmruntime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"})
+			assert.NoError(t, err)
+			if assert.IsType(t, &v1.DirectCredentials{}, creds) {
+				assert.Equal(t, "from-command", creds.(*v1.DirectCredentials).Properties["token"])
+			}
+		})
+	}
+	wg.Wait()
+
+	runs, err := os.ReadFile(counter)
+	require.NoError(t, err)
+	assert.Less(t, strings.Count(string(runs), "run"), 5, "concurrent resolutions share a running command")
+}
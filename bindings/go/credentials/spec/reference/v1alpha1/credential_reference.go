@@ -0,0 +1,97 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	// CredentialReferenceType is the type of credentials whose values are read from
+	// environment variables, files or command output when they are resolved.
+	CredentialReferenceType = "CredentialReference"
+)
+
+// CredentialReferenceVersionedType is the versioned type of CredentialReference.
+var CredentialReferenceVersionedType = runtime.NewVersionedType(CredentialReferenceType, Version)
+
+// Scheme contains the CredentialReference type.
+var Scheme = runtime.NewScheme()
+
+func init() {
+	Scheme.MustRegisterWithAlias(&CredentialReference{}, CredentialReferenceVersionedType, runtime.NewUnversionedType(CredentialReferenceType))
+}
+
+// CredentialReference describes credentials whose values are not stored in the configuration.
+// Each property references the place its value is read from when the credentials are resolved,
+// so that the configuration can be committed while secrets are injected by the environment.
+//
+//	credentials:
+//	- type: CredentialReference/v1alpha1
+//	  credentialType: OCICredentials/v1
+//	  properties:
+//	    username:
+//	      value: ci-bot
+//	    password:
+//	      env: REGISTRY_PASSWORD
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type CredentialReference struct {
+	// +ocm:jsonschema-gen:enum=CredentialReference/v1alpha1
+	// +ocm:jsonschema-gen:enum:deprecated=CredentialReference
+	Type runtime.Type `json:"type"`
+	// CredentialType is the type of the resolved credentials, for example OCICredentials/v1,
+	// HelmHTTPCredentials/v1 or RSACredentials/v1. The property names are the fields of that type.
+	// If not set, the properties are resolved as Credentials/v1.
+	CredentialType runtime.Type `json:"credentialType,omitempty"`
+	// Properties maps each credential property to the place its value is read from.
+	Properties map[string]ValueReference `json:"properties"`
+}
+
+// ValueReference references the value of a single credential property.
+// Exactly one of its fields must be set.
+//
+// +k8s:deepcopy-gen=true
+// +ocm:jsonschema-gen=true
+type ValueReference struct {
+	// Value is a literal value, for values that are not secret.
+	Value string `json:"value,omitempty"`
+	// Env is the name of an environment variable holding the value.
+	Env string `json:"env,omitempty"`
+	// File is the path of a file holding the value, for example a mounted secret.
+	// A trailing line break is removed.
+	File string `json:"file,omitempty"`
+	// Command is a command whose standard output is the value, for example ["gh", "auth", "token"].
+	// A trailing line break is removed.
+	Command []string `json:"command,omitempty"`
+}
+
+// Validate checks that every property references exactly one value.
+func (c *CredentialReference) Validate() error {
+	if len(c.Properties) == 0 {
+		return fmt.Errorf("at least one property is required")
+	}
+	for name, ref := range c.Properties {
+		if err := ref.Validate(); err != nil {
+			return fmt.Errorf("property %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that exactly one value is referenced.
+func (r ValueReference) Validate() error {
+	set := 0
+	for _, ok := range []bool{r.Value != "", r.Env != "", r.File != "", len(r.Command) > 0} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("exactly one of value, env, file or command must be set")
+	}
+	return nil
+}
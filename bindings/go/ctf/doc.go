@@ -33,6 +33,9 @@
 // a TAR archive (unzipped - FormatTAR or zipped - FormatTGZ).
 // The descriptor SHOULD be the first file if stored in an archive.
 //
+// Besides file systems, a CTF can be stored below a prefix of an object storage such as an S3 bucket
+// with the same layout (see ObjectStoreCTF). This allows object storages to be used as transfer medium.
+//
 // This package also offers a legacy compatibility layer access for the ArtifactSet, a now no longer recommended
 // artifact format that was used in the past by OCM CLI to package local blobs. We now instead recommend packaging
 // in the format of OCI Image Layouts as they are almost identical. See ArtifactSet for more details.
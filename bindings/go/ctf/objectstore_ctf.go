@@ -0,0 +1,235 @@
+package ctf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	"ocm.software/open-component-model/bindings/go/ctf/index/v1"
+)
+
+// ObjectStore is a flat key-value store for objects, such as a bucket of an S3-compatible object storage.
+// Errors for missing objects MUST match fs.ErrNotExist.
+type ObjectStore interface {
+	// GetObject returns the content and the size of the object with the given key.
+	// The size is negative if unknown.
+	GetObject(ctx context.Context, key string) (io.ReadCloser, int64, error)
+	// StatObject returns the size of the object with the given key.
+	StatObject(ctx context.Context, key string) (int64, error)
+	// PutObject stores the content under the given key. The size is negative if unknown.
+	PutObject(ctx context.Context, key string, data io.Reader, size int64) error
+	// DeleteObject deletes the object with the given key.
+	DeleteObject(ctx context.Context, key string) error
+	// ListObjects returns the keys of all objects starting with the given prefix.
+	ListObjects(ctx context.Context, prefix string) ([]string, error)
+}
+
+// ObjectStoreCTF is a CTF implementation that uses an ObjectStore as the underlying storage.
+// It is used to exchange CTFs through object storages instead of filesystems, e.g. as transfer
+// medium into air-gapped environments.
+// The layout below the prefix is the same as for the FileSystemCTF:
+//   - the index object at <prefix>/v1.ArtifactIndexFileName
+//   - the blobs at <prefix>/BlobsDirectoryName/<blob file name>
+//
+// The CTF offered will always be of type FormatDirectory, so the content of the prefix can be
+// downloaded into a directory and opened with OpenCTFFromOSPath.
+//
+// Concurrency: Object stores replace objects atomically, so readers never see partially written objects.
+type ObjectStoreCTF struct {
+	store  ObjectStore
+	prefix string
+}
+
+var _ CTF = (*ObjectStoreCTF)(nil)
+
+// NewObjectStoreCTF opens a CTF stored below the prefix of the object store.
+// An empty prefix stores the CTF at the root of the object store.
+func NewObjectStoreCTF(store ObjectStore, prefix string) *ObjectStoreCTF {
+	return &ObjectStoreCTF{store: store, prefix: strings.Trim(prefix, "/")}
+}
+
+// Format always returns FormatDirectory for ObjectStoreCTF.
+func (c *ObjectStoreCTF) Format() FileFormat {
+	return FormatDirectory
+}
+
+// GetIndex returns the v1.ArtifactIndexFileName object parsed as v1.Index of the CTF.
+// If the CTF is empty, an empty index is returned so it can be set with SetIndex.
+func (c *ObjectStoreCTF) GetIndex(ctx context.Context) (index v1.Index, err error) {
+	data, size, err := c.store.GetObject(ctx, c.key(v1.ArtifactIndexFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return v1.NewIndex(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to get artifact index: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, data.Close())
+	}()
+
+	raw, err := io.ReadAll(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read artifact index: %w", err)
+	}
+	if size == 0 || len(raw) == 0 {
+		return v1.NewIndex(), nil
+	}
+
+	if index, err = v1.DecodeIndex(bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("unable to decode artifact index: %w", err)
+	}
+	return index, nil
+}
+
+// SetIndex sets the v1.ArtifactIndexFileName object of the CTF to the given index.
+func (c *ObjectStoreCTF) SetIndex(ctx context.Context, index v1.Index) error {
+	data, err := v1.Encode(index)
+	if err != nil {
+		return fmt.Errorf("unable to encode artifact index: %w", err)
+	}
+	if err := c.store.PutObject(ctx, c.key(v1.ArtifactIndexFileName), bytes.NewReader(data), int64(len(data))); err != nil {
+		return fmt.Errorf("unable to store artifact index: %w", err)
+	}
+	return nil
+}
+
+// GetBlob returns the blob with the given digest from the CTF.
+// The content of the blob is only fetched from the object store when it is read.
+func (c *ObjectStoreCTF) GetBlob(ctx context.Context, digest string) (blob.ReadOnlyBlob, error) {
+	key, err := c.blobKey(digest)
+	if err != nil {
+		return nil, err
+	}
+	size, err := c.store.StatObject(ctx, key)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("blob %s not found: %w", digest, err)
+		}
+		return nil, fmt.Errorf("unable to stat blob: %w", err)
+	}
+	return &objectBlob{ctx: ctx, store: c.store, key: key, digest: digest, size: size}, nil
+}
+
+// ListBlobs returns a list of all blobs in the CTF by listing the objects below BlobsDirectoryName.
+func (c *ObjectStoreCTF) ListBlobs(ctx context.Context) ([]string, error) {
+	prefix := c.key(BlobsDirectoryName) + "/"
+	keys, err := c.store.ListObjects(ctx, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list blobs: %w", err)
+	}
+
+	digests := make([]string, 0, len(keys))
+	for _, key := range keys {
+		name := strings.TrimPrefix(key, prefix)
+		// objects in nested "directories" are not part of the CTF
+		if name == "" || strings.Contains(name, "/") {
+			continue
+		}
+		digests = append(digests, ToDigest(name))
+	}
+	return digests, nil
+}
+
+// SaveBlob stores the blob in the CTF under its digest.
+func (c *ObjectStoreCTF) SaveBlob(ctx context.Context, b blob.ReadOnlyBlob) (err error) {
+	digestable, ok := b.(blob.DigestAware)
+	if !ok {
+		return errors.New("blob does not have a digest that can be used to save it")
+	}
+	dig, known := digestable.Digest()
+	if !known {
+		return errors.New("blob does not have a digest that can be used to save it")
+	}
+	key, err := c.blobKey(dig)
+	if err != nil {
+		return err
+	}
+
+	size := blob.SizeUnknown
+	if sizeable, ok := b.(blob.SizeAware); ok {
+		size = sizeable.Size()
+	}
+
+	data, err := b.ReadCloser()
+	if err != nil {
+		return fmt.Errorf("unable to read blob: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, data.Close())
+	}()
+
+	ctxRead, err := newCtxReader(ctx, data)
+	if err != nil {
+		return fmt.Errorf("unable to create context reader: %w", err)
+	}
+	if err := c.store.PutObject(ctx, key, ctxRead, size); err != nil {
+		return fmt.Errorf("unable to store blob: %w", err)
+	}
+	return nil
+}
+
+// DeleteBlob deletes the blob with the given digest from the CTF.
+func (c *ObjectStoreCTF) DeleteBlob(ctx context.Context, digest string) error {
+	key, err := c.blobKey(digest)
+	if err != nil {
+		return err
+	}
+	if err := c.store.DeleteObject(ctx, key); err != nil {
+		return fmt.Errorf("unable to delete blob: %w", err)
+	}
+	return nil
+}
+
+func (c *ObjectStoreCTF) blobKey(digest string) (string, error) {
+	file, err := ToBlobFileName(digest)
+	if err != nil {
+		return "", err
+	}
+	return c.key(path.Join(BlobsDirectoryName, file)), nil
+}
+
+func (c *ObjectStoreCTF) key(name string) string {
+	if c.prefix == "" {
+		return name
+	}
+	return c.prefix + "/" + name
+}
+
+// objectBlob is a blob in an ObjectStore that is fetched on every read.
+type objectBlob struct {
+	// ctx is the context of the GetBlob call, used for reading the content
+	// because blob.ReadOnlyBlob does not pass a context.
+	ctx    context.Context
+	store  ObjectStore
+	key    string
+	digest string
+	size   int64
+}
+
+var (
+	_ blob.ReadOnlyBlob = (*objectBlob)(nil)
+	_ blob.DigestAware  = (*objectBlob)(nil)
+	_ blob.SizeAware    = (*objectBlob)(nil)
+)
+
+func (b *objectBlob) ReadCloser() (io.ReadCloser, error) {
+	data, _, err := b.store.GetObject(b.ctx, b.key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get blob %s: %w", b.digest, err)
+	}
+	return data, nil
+}
+
+func (b *objectBlob) Digest() (string, bool) {
+	return b.digest, true
+}
+
+func (b *objectBlob) Size() int64 {
+	return b.size
+}
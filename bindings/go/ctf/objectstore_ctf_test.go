@@ -0,0 +1,143 @@
+package ctf_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/blob/inmemory"
+	"ocm.software/open-component-model/bindings/go/ctf"
+	v1 "ocm.software/open-component-model/bindings/go/ctf/index/v1"
+)
+
+// memoryStore is an in-memory ctf.ObjectStore.
+type memoryStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	sizes   map[string]int64
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{objects: map[string][]byte{}, sizes: map[string]int64{}}
+}
+
+func (m *memoryStore) GetObject(_ context.Context, key string) (io.ReadCloser, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, 0, fmt.Errorf("object %q: %w", key, fs.ErrNotExist)
+	}
+	return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+func (m *memoryStore) StatObject(_ context.Context, key string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return 0, fmt.Errorf("object %q: %w", key, fs.ErrNotExist)
+	}
+	return int64(len(data)), nil
+}
+
+func (m *memoryStore) PutObject(_ context.Context, key string, data io.Reader, size int64) error {
+	raw, err := io.ReadAll(data)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = raw
+	m.sizes[key] = size
+	return nil
+}
+
+func (m *memoryStore) DeleteObject(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objects, key)
+	return nil
+}
+
+func (m *memoryStore) ListObjects(_ context.Context, prefix string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for key := range m.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func Test_ObjectStoreCTF_BasicOperations(t *testing.T) {
+	ctx := t.Context()
+	r := require.New(t)
+	store := newMemoryStore()
+
+	archive := ctf.NewObjectStoreCTF(store, "/transfers/2026-10/")
+	r.Equal(ctf.FormatDirectory, archive.Format())
+
+	idx, err := archive.GetIndex(ctx)
+	r.NoError(err)
+	r.Empty(idx.GetArtifacts())
+
+	testData := []byte("test blob data")
+	testBlob := inmemory.New(bytes.NewReader(testData))
+	r.NoError(archive.SaveBlob(ctx, testBlob))
+	dig, _ := testBlob.Digest()
+
+	r.Contains(store.objects, "transfers/2026-10/blobs/"+strings.ReplaceAll(dig, ":", "."))
+	// the known size is passed on, so that the store does not have to buffer the blob.
+	r.Equal(int64(len(testData)), store.sizes["transfers/2026-10/blobs/"+strings.ReplaceAll(dig, ":", ".")])
+
+	blobs, err := archive.ListBlobs(ctx)
+	r.NoError(err)
+	r.Equal([]string{dig}, blobs)
+
+	b, err := archive.GetBlob(ctx, dig)
+	r.NoError(err)
+	r.Equal(int64(len(testData)), b.(interface{ Size() int64 }).Size())
+	rc, err := b.ReadCloser()
+	r.NoError(err)
+	data, err := io.ReadAll(rc)
+	r.NoError(err)
+	r.NoError(rc.Close())
+	r.Equal(testData, data)
+
+	newIdx := v1.NewIndex()
+	newIdx.AddArtifact(v1.ArtifactMetadata{Repository: "test/repo", Tag: "v1", Digest: dig})
+	r.NoError(archive.SetIndex(ctx, newIdx))
+	r.Contains(store.objects, "transfers/2026-10/"+v1.ArtifactIndexFileName)
+
+	idx, err = archive.GetIndex(ctx)
+	r.NoError(err)
+	r.Len(idx.GetArtifacts(), 1)
+
+	r.NoError(archive.DeleteBlob(ctx, dig))
+	_, err = archive.GetBlob(ctx, dig)
+	r.ErrorIs(err, fs.ErrNotExist)
+
+	blobs, err = archive.ListBlobs(ctx)
+	r.NoError(err)
+	r.Empty(blobs)
+}
+
+func Test_ObjectStoreCTF_InvalidDigest(t *testing.T) {
+	archive := ctf.NewObjectStoreCTF(newMemoryStore(), "")
+
+	_, err := archive.GetBlob(t.Context(), "invalid")
+	require.Error(t, err)
+	require.Error(t, archive.DeleteBlob(t.Context(), "invalid"))
+}
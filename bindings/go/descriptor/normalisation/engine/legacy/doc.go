@@ -0,0 +1,17 @@
+// Package legacy implements the normalisation engine of OCM v1 that is used by the legacy
+// jsonNormalisation/v1, jsonNormalisation/v2 and jsonNormalisation/v3 algorithms.
+//
+// The engine reuses the transformation rules of the jcs package, but differs from jcs.Prepare
+// in how null values are treated: fields with a null value are kept as null instead of being
+// dropped, like OCM v1 did. It is intended to verify signatures created with OCM v1.
+//
+// Two output formats are supported:
+//   - jcs.Type renders the prepared structure with the JSON Canonicalization Scheme (RFC 8785),
+//     as used by jsonNormalisation/v3.
+//   - Entries renders every map as a list of single-entry maps sorted by key, as used by
+//     jsonNormalisation/v1 and jsonNormalisation/v2.
+//
+// For example, the entry list format of {"b": 1, "a": {"c": true}} is
+//
+//	[{"a":[{"c":true}]},{"b":1}]
+package legacy
@@ -0,0 +1,103 @@
+package legacy
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/engine/jcs"
+)
+
+// Entries is the entry list normalisation of OCM v1.
+// Maps are represented as lists of single-entry maps sorted by key, arrays are kept.
+var Entries jcs.Normalisation = entries{}
+
+type entries struct{}
+
+func (entries) NewArray() jcs.Normalised {
+	return &normalised{value: make([]interface{}, 0)}
+}
+
+func (entries) NewMap() jcs.Normalised {
+	return &normalised{value: make(entryList, 0)}
+}
+
+func (entries) NewValue(v interface{}) jcs.Normalised {
+	return &normalised{value: v}
+}
+
+func (entries) String() string {
+	return "entry list normalisation"
+}
+
+// entryList is a map represented as list of single-entry maps, sorted by key.
+type entryList []entry
+
+// entry is a single field of a map. It is marshalled as {"<key>":<value>}.
+type entry struct {
+	key   string
+	value interface{}
+}
+
+func (e entry) MarshalJSON() ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(map[string]interface{}{e.key: e.value}); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+type normalised struct {
+	value interface{}
+}
+
+func (n *normalised) Value() interface{} {
+	return n.value
+}
+
+func (n *normalised) IsEmpty() bool {
+	switch v := n.value.(type) {
+	case entryList:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// Append adds an element to a normalised array.
+// Panics if called on a non-array value.
+func (n *normalised) Append(elem jcs.Normalised) {
+	n.value = append(n.value.([]interface{}), elem.Value())
+}
+
+// SetField sets a field of a normalised map, keeping the entries sorted by key.
+// Panics if called on a non-map value.
+func (n *normalised) SetField(name string, value jcs.Normalised) {
+	list := n.value.(entryList)
+	i := sort.Search(len(list), func(i int) bool { return list[i].key >= name })
+	if i < len(list) && list[i].key == name {
+		list[i].value = value.Value()
+		return
+	}
+	list = append(list, entry{})
+	copy(list[i+1:], list[i:])
+	list[i] = entry{key: name, value: value.Value()}
+	n.value = list
+}
+
+// Marshal encodes the normalised value to JSON without escaping HTML characters.
+// Like in OCM v1, the encoding is terminated by a newline, which is part of the digested content.
+func (n *normalised) Marshal(gap string) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", gap)
+	if err := encoder.Encode(n.Value()); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,103 @@
+package legacy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/engine/jcs"
+)
+
+// Normalise prepares the value with the given rules and marshals it in the format of the normalisation.
+func Normalise(n jcs.Normalisation, v interface{}, rules jcs.TransformationRules) ([]byte, error) {
+	entries, err := PrepareNormalisation(n, v, rules)
+	if err != nil {
+		return nil, err
+	}
+	return entries.Marshal("")
+}
+
+// PrepareNormalisation converts the value into its generic JSON structure and prepares it
+// for the normalisation by applying the rules.
+func PrepareNormalisation(n jcs.Normalisation, v interface{}, rules jcs.TransformationRules) (jcs.Normalised, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	prepared, err := Prepare(n, raw, rules)
+	if err != nil {
+		return nil, err
+	}
+	if prepared == nil {
+		return jcs.Null, nil
+	}
+	return prepared, nil
+}
+
+// Prepare recursively converts a generic JSON value into a normalised structure, applying the rules.
+// Null values are kept as jcs.Null. A nil result means that the value was removed by a filter.
+func Prepare(n jcs.Normalisation, v interface{}, rules jcs.TransformationRules) (jcs.Normalised, error) {
+	if v == nil {
+		return jcs.Null, nil
+	}
+	if rules == nil {
+		rules = jcs.NoExcludes{}
+	}
+	if mapper, ok := rules.(jcs.ValueMappingRule); ok {
+		v = mapper.MapValue(v)
+	}
+
+	var result jcs.Normalised
+	var err error
+	switch typed := v.(type) {
+	case map[string]interface{}:
+		result, err = prepareStruct(n, typed, rules)
+	case []interface{}:
+		result, err = prepareArray(n, typed, rules)
+	default:
+		return n.NewValue(v), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rules.Filter(result)
+}
+
+func prepareStruct(n jcs.Normalisation, v map[string]interface{}, rules jcs.TransformationRules) (jcs.Normalised, error) {
+	entries := n.NewMap()
+	for key, value := range v {
+		name, mapped, prop := rules.Field(key, value)
+		if name == "" {
+			continue
+		}
+		nested, err := Prepare(n, mapped, prop)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", key, err)
+		}
+		if nested != nil {
+			entries.SetField(name, nested)
+		}
+	}
+	return entries, nil
+}
+
+func prepareArray(n jcs.Normalisation, v []interface{}, rules jcs.TransformationRules) (jcs.Normalised, error) {
+	entries := n.NewArray()
+	for index, value := range v {
+		exclude, mapped, prop := rules.Element(value)
+		if exclude {
+			continue
+		}
+		nested, err := Prepare(n, mapped, prop)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", index, err)
+		}
+		if nested != nil {
+			entries.Append(nested)
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,71 @@
+package legacy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/engine/jcs"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/engine/legacy"
+)
+
+func TestNormalise(t *testing.T) {
+	input := map[string]any{
+		"b": "<b>",
+		"a": map[string]any{
+			"z": nil,
+			"y": []any{map[string]any{"x": 1}, "w"},
+		},
+		"excluded": "value",
+		"empty":    map[string]any{},
+	}
+	rules := jcs.MapExcludes{
+		"excluded": nil,
+		"empty":    jcs.ExcludeEmpty{},
+	}
+
+	tests := []struct {
+		name     string
+		n        jcs.Normalisation
+		expected string
+	}{
+		{
+			name:     "entries",
+			n:        legacy.Entries,
+			expected: `[{"a":[{"y":[[{"x":1}],"w"]},{"z":null}]},{"b":"<b>"}]` + "\n",
+		},
+		{
+			name:     "jcs",
+			n:        jcs.Type,
+			expected: `{"a":{"y":[{"x":1},"w"],"z":null},"b":"<b>"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := legacy.Normalise(tt.n, input, rules)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(data))
+		})
+	}
+}
+
+func TestPrepare_Filter(t *testing.T) {
+	rules := jcs.MapExcludes{
+		"labels": jcs.ExcludeEmpty{TransformationRules: jcs.DynamicArrayExcludes{
+			ValueChecker: func(v any) bool { return v.(map[string]any)["signing"] != true },
+		}},
+	}
+
+	data, err := legacy.Normalise(legacy.Entries, map[string]any{
+		"labels": []any{map[string]any{"name": "unsigned"}},
+	}, rules)
+	require.NoError(t, err)
+	assert.Equal(t, "[]\n", string(data))
+
+	data, err = legacy.Normalise(legacy.Entries, map[string]any{
+		"labels": []any{map[string]any{"name": "signed", "signing": true}},
+	}, rules)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"labels":[[{"name":"signed"},{"signing":true}]]}]`+"\n", string(data))
+}
@@ -0,0 +1,124 @@
+// Package rules contains the descriptor specific rules shared by the legacy jsonNormalisation
+// algorithms of OCM v1.
+package rules
+
+import (
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/engine/jcs"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v4alpha1"
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	v2 "ocm.software/open-component-model/bindings/go/descriptor/v2"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// ConvertToV2 converts the descriptor into its v2 serialization and sets the defaults
+// that OCM v1 always serialized.
+func ConvertToV2(cd *descruntime.Descriptor) (*v2.Descriptor, error) {
+	scheme := runtime.NewScheme(runtime.WithAllowUnknown())
+	desc, err := descruntime.ConvertToV2(scheme, cd)
+	if err != nil {
+		return nil, err
+	}
+	if desc.Meta.Version == "" {
+		desc.Meta.Version = "v2"
+	}
+	v4alpha1.DefaultComponent(desc)
+	return desc, nil
+}
+
+// IgnoreResourcesWithNoneAccess checks if a resource has the "none" access type.
+// Returns true if the resource should be excluded from normalisation.
+func IgnoreResourcesWithNoneAccess(v interface{}) bool {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	access, ok := m["access"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	typ, ok := access["type"].(string)
+	return ok && v4alpha1.IsNoneAccessKind(typ)
+}
+
+// DefaultExtraIdentity sets a missing extraIdentity of an element to null.
+// The v2 serialization of OCM v1 did not omit empty extra identities.
+func DefaultExtraIdentity(v interface{}) interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		if _, ok := m["extraIdentity"]; !ok {
+			m["extraIdentity"] = nil
+		}
+	}
+	return v
+}
+
+// OmitEmptyDigest removes the digest of an element if none of its fields is set.
+// OCM v1 omitted unset digests of component references.
+func OmitEmptyDigest(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	digest, ok := m["digest"].(map[string]interface{})
+	if !ok {
+		return v
+	}
+	for _, field := range digest {
+		if field != nil && field != "" {
+			return v
+		}
+	}
+	delete(m, "digest")
+	return v
+}
+
+// Chain returns a mapper applying the given mappers in order.
+func Chain(mappers ...jcs.ValueMapper) jcs.ValueMapper {
+	return func(v interface{}) interface{} {
+		for _, mapper := range mappers {
+			v = mapper(v)
+		}
+		return v
+	}
+}
+
+// AgnosticExclusionRules are the exclusion rules of the serialization agnostic algorithms
+// jsonNormalisation/v2 and jsonNormalisation/v3.
+//
+// The v2 serialization is mapped to the version agnostic structure of OCM v1:
+// the meta section is excluded, the provider is always a map and unset reference digests are omitted.
+// Resources with "none" access are kept without their digest.
+var AgnosticExclusionRules = jcs.MapExcludes{
+	"meta": nil,
+	"component": jcs.MapExcludes{
+		"repositoryContexts": nil,
+		"provider": jcs.MapValue{
+			Mapping: v4alpha1.ProviderAsMap,
+			Continue: jcs.MapExcludes{
+				"labels": v4alpha1.LabelExcludes,
+			},
+		},
+		"labels": v4alpha1.LabelExcludes,
+		"resources": jcs.DynamicArrayExcludes{
+			ValueMapper: v4alpha1.MapResourcesWithNoneAccess,
+			Continue: jcs.MapExcludes{
+				"access":  nil,
+				"srcRefs": nil,
+				"labels":  v4alpha1.LabelExcludes,
+			},
+		},
+		"sources": jcs.ArrayExcludes{
+			Continue: jcs.MapExcludes{
+				"access": nil,
+				"labels": v4alpha1.LabelExcludes,
+			},
+		},
+		"componentReferences": jcs.DynamicArrayExcludes{
+			ValueMapper: OmitEmptyDigest,
+			Continue: jcs.MapExcludes{
+				"labels": v4alpha1.LabelExcludes,
+			},
+		},
+	},
+	"signatures":    nil,
+	"nestedDigests": nil,
+}
@@ -0,0 +1,11 @@
+// Package v1 provides the legacy jsonNormalisation/v1 algorithm of OCM v1.
+//
+// The algorithm normalises the v2 serialization of a component descriptor, including its meta
+// section, into the entry list format of the legacy engine, where every map is represented as
+// a list of single-entry maps sorted by key. Because it depends on the serialization format of
+// the descriptor, it is only provided to verify existing signatures. New signatures should use
+// jsonNormalisation/v4alpha1.
+//
+// The algorithm follows the normalisation rules of OCM v1, but is not yet checked against output
+// of OCM v1. The vectors in testdata/regression were produced by this implementation.
+package v1
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	norms "ocm.software/open-component-model/bindings/go/descriptor/normalisation"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/engine/jcs"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/engine/legacy"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/internal/rules"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v4alpha1"
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+)
+
+// Algorithm is the registered name for this normalisation algorithm.
+const Algorithm = "jsonNormalisation/v1"
+
+// ExclusionRules defines which fields of the v2 serialization are excluded from the normalised output.
+//
+// Other than in later algorithms, the meta section is part of the output, resources with
+// "none" access are removed completely, and elements always carry an extraIdentity field,
+// which is null if not set.
+var ExclusionRules = jcs.MapExcludes{
+	"component": jcs.MapExcludes{
+		"repositoryContexts": nil,
+		"labels":             v4alpha1.LabelExcludes,
+		"resources": jcs.DynamicArrayExcludes{
+			ValueChecker: rules.IgnoreResourcesWithNoneAccess,
+			ValueMapper:  rules.DefaultExtraIdentity,
+			Continue: jcs.MapExcludes{
+				"access":  nil,
+				"srcRefs": nil,
+				"labels":  v4alpha1.LabelExcludes,
+			},
+		},
+		"sources": jcs.DynamicArrayExcludes{
+			ValueMapper: rules.DefaultExtraIdentity,
+			Continue: jcs.MapExcludes{
+				"access": nil,
+				"labels": v4alpha1.LabelExcludes,
+			},
+		},
+		"componentReferences": jcs.DynamicArrayExcludes{
+			ValueMapper: rules.Chain(rules.DefaultExtraIdentity, rules.OmitEmptyDigest),
+			Continue: jcs.MapExcludes{
+				"labels": v4alpha1.LabelExcludes,
+			},
+		},
+	},
+	"signatures":    nil,
+	"nestedDigests": nil,
+}
+
+func init() {
+	norms.Normalisations.Register(Algorithm, algo{})
+}
+
+type algo struct{}
+
+// Normalise converts the descriptor to its v2 serialization and normalises it into the entry list format.
+func (algo) Normalise(cd *descruntime.Descriptor) ([]byte, error) {
+	desc, err := rules.ConvertToV2(cd)
+	if err != nil {
+		return nil, err
+	}
+	return legacy.Normalise(legacy.Entries, desc, ExclusionRules)
+}
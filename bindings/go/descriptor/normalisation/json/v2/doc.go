@@ -0,0 +1,10 @@
+// Package v2 provides the legacy jsonNormalisation/v2 algorithm of OCM v1.
+//
+// The algorithm normalises the version agnostic structure of a component descriptor into the
+// entry list format of the legacy engine, where every map is represented as
+// a list of single-entry maps sorted by key. It is only provided to verify existing
+// signatures. New signatures should use jsonNormalisation/v4alpha1.
+//
+// The algorithm follows the normalisation rules of OCM v1, but is not yet checked against output
+// of OCM v1. The vectors in testdata/regression were produced by this implementation.
+package v2
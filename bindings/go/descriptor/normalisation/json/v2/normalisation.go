@@ -0,0 +1,33 @@
+package v2
+
+import (
+	norms "ocm.software/open-component-model/bindings/go/descriptor/normalisation"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/engine/jcs"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/engine/legacy"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/internal/rules"
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+)
+
+// Algorithm is the registered name for this normalisation algorithm.
+const Algorithm = "jsonNormalisation/v2"
+
+// ExclusionRules defines which fields are excluded from the normalised output.
+// The meta section, repository contexts, accesses, source references, signatures, nested digests
+// and labels without signing flag are excluded. The provider is always normalised as map and
+// resources with "none" access are kept without their digest.
+var ExclusionRules jcs.TransformationRules = rules.AgnosticExclusionRules
+
+func init() {
+	norms.Normalisations.Register(Algorithm, algo{})
+}
+
+type algo struct{}
+
+// Normalise converts the descriptor to its version agnostic structure and normalises it into the entry list format.
+func (algo) Normalise(cd *descruntime.Descriptor) ([]byte, error) {
+	desc, err := rules.ConvertToV2(cd)
+	if err != nil {
+		return nil, err
+	}
+	return legacy.Normalise(legacy.Entries, desc, ExclusionRules)
+}
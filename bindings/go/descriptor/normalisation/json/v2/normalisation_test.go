@@ -0,0 +1,53 @@
+package v2_test
+
+import (
+	"embed"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v2"
+	"ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	descriptorv2 "ocm.software/open-component-model/bindings/go/descriptor/v2"
+)
+
+//go:embed testdata
+var testdata embed.FS
+
+// TestRegression checks the normalisation against output of this implementation,
+// see the README of each vector for its provenance.
+func TestRegression(t *testing.T) {
+	testVectors(t, "regression")
+}
+
+func testVectors(t *testing.T, dir string) {
+	prefix := path.Join("testdata", dir)
+	tests, err := testdata.ReadDir(prefix)
+	require.NoError(t, err, "failed to read %s test directory", dir)
+
+	for _, folder := range tests {
+		t.Run(folder.Name(), func(t *testing.T) {
+			r := require.New(t)
+			desc, err := testdata.ReadFile(path.Join(prefix, folder.Name(), "README.md"))
+			r.NoError(err, "failed to read test README")
+			t.Log(string(desc))
+
+			input, err := testdata.ReadFile(path.Join(prefix, folder.Name(), "input.yaml"))
+			r.NoError(err, "failed to read test input")
+			expected, err := testdata.ReadFile(path.Join(prefix, folder.Name(), "expected.json"))
+			r.NoError(err, "failed to read test expected output")
+
+			var descriptor descriptorv2.Descriptor
+			r.NoError(yaml.Unmarshal(input, &descriptor), "failed to unmarshal YAML")
+			runtimeDescriptor, err := runtime.ConvertFromV2(&descriptor)
+			r.NoError(err, "failed to convert descriptor")
+
+			normalised, err := normalisation.Normalise(runtimeDescriptor, v2.Algorithm)
+			r.NoError(err, "failed to normalise descriptor")
+			r.Equal(string(expected), string(normalised), "normalised output does not match expected output from testcase")
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Package v3 provides the legacy jsonNormalisation/v3 algorithm of OCM v1.
+//
+// The algorithm normalises the version agnostic structure of a component descriptor into the
+// JSON Canonicalization Scheme (RFC 8785). It is only provided to verify existing
+// signatures. New signatures should use jsonNormalisation/v4alpha1.
+//
+// The algorithm follows the normalisation rules of OCM v1, but is not yet checked against output
+// of OCM v1. The vectors in testdata/regression pin the output of this implementation.
+package v3
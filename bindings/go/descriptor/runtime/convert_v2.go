@@ -275,9 +275,23 @@ func ConvertFromV2Signature(signature *v2.Signature) *Signature {
 		Name:      signature.Name,
 		Digest:    *ConvertFromV2Digest(&signature.Digest),
 		Signature: *ConvertFromV2SignatureInfo(&signature.Signature),
+		Timestamp: ConvertFromV2Timestamp(signature.Timestamp),
 	}
 }
 
+// ConvertFromV2Timestamp converts a v2 signature timestamp to internal format.
+func ConvertFromV2Timestamp(ts *v2.TimestampSpec) *TimestampSpec {
+	if ts == nil {
+		return nil
+	}
+	out := &TimestampSpec{Value: ts.Value}
+	if ts.Time != nil {
+		t := ts.Time.Time.Time
+		out.Time = &t
+	}
+	return out
+}
+
 func ConvertFromV2SignatureInfo(signature *v2.SignatureInfo) *SignatureInfo {
 	if signature == nil {
 		return nil
@@ -487,7 +501,20 @@ func ConvertToV2Signature(sig *Signature) *v2.Signature {
 		Name:      sig.Name,
 		Digest:    *ConvertToV2Digest(&sig.Digest),
 		Signature: *ConvertToV2SignatureInfo(&sig.Signature),
+		Timestamp: ConvertToV2Timestamp(sig.Timestamp),
+	}
+}
+
+// ConvertToV2Timestamp converts an internal signature timestamp to v2 format.
+func ConvertToV2Timestamp(ts *TimestampSpec) *v2.TimestampSpec {
+	if ts == nil {
+		return nil
+	}
+	out := &v2.TimestampSpec{Value: ts.Value}
+	if ts.Time != nil {
+		out.Time = &v2.Timestamp{Time: v2.NewTime(*ts.Time)}
 	}
+	return out
 }
 
 func ConvertToV2SignatureInfo(sig *SignatureInfo) *v2.SignatureInfo {
@@ -331,6 +331,26 @@ type Signature struct {
 	// Signature is the metadata and cryptographic payload proving the authenticity
 	// of the digest. It includes details on the algorithm, encoding, and issuer.
 	Signature SignatureInfo `json:"-"`
+
+	// Timestamp optionally carries an RFC 3161 timestamp token over the signature value.
+	// It proves that the signature existed at the time asserted by a timestamp authority,
+	// which allows verifying signatures after the signing certificate has expired.
+	Timestamp *TimestampSpec `json:"-"`
+}
+
+// TimestampSpec describes an RFC 3161 timestamp issued for a signature.
+//
+// See specification reference:
+//   - https://datatracker.ietf.org/doc/html/rfc3161
+//
+// +k8s:deepcopy-gen=true
+type TimestampSpec struct {
+	// Value is the base64 encoded DER timestamp token (a CMS SignedData structure).
+	Value string `json:"-"`
+
+	// Time is the generation time asserted by the timestamp authority.
+	// It is informational, verifiers must take the time from the verified token.
+	Time *time.Time `json:"-"`
 }
 
 // SignatureInfo provides the metadata and cryptographic material for a signature.
@@ -3,6 +3,7 @@ package runtime_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -456,6 +457,7 @@ func TestConvertFromV2References(t *testing.T) {
 }
 
 func TestConvertFromV2Signatures(t *testing.T) {
+	signingTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
 	tests := []struct {
 		name       string
 		signatures []v2.Signature
@@ -488,6 +490,18 @@ func TestConvertFromV2Signatures(t *testing.T) {
 						Issuer:    "test-issuer",
 					},
 				},
+				{
+					Name: "timestamped",
+					Signature: v2.SignatureInfo{
+						Algorithm: "test-algo",
+						Value:     "test-value",
+						MediaType: "test-media",
+					},
+					Timestamp: &v2.TimestampSpec{
+						Value: "dG9rZW4=",
+						Time:  &v2.Timestamp{Time: v2.NewTime(signingTime)},
+					},
+				},
 			},
 			want: []descriptorRuntime.Signature{
 				{
@@ -504,6 +518,18 @@ func TestConvertFromV2Signatures(t *testing.T) {
 						Issuer:    "test-issuer",
 					},
 				},
+				{
+					Name: "timestamped",
+					Signature: descriptorRuntime.SignatureInfo{
+						Algorithm: "test-algo",
+						Value:     "test-value",
+						MediaType: "test-media",
+					},
+					Timestamp: &descriptorRuntime.TimestampSpec{
+						Value: "dG9rZW4=",
+						Time:  &signingTime,
+					},
+				},
 			},
 		},
 	}
@@ -743,6 +769,7 @@ func TestConvertToV2References(t *testing.T) {
 }
 
 func TestConvertToV2Signatures(t *testing.T) {
+	signingTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
 	tests := []struct {
 		name       string
 		signatures []descriptorRuntime.Signature
@@ -775,6 +802,18 @@ func TestConvertToV2Signatures(t *testing.T) {
 						Issuer:    "test-issuer",
 					},
 				},
+				{
+					Name: "timestamped",
+					Signature: descriptorRuntime.SignatureInfo{
+						Algorithm: "test-algo",
+						Value:     "test-value",
+						MediaType: "test-media",
+					},
+					Timestamp: &descriptorRuntime.TimestampSpec{
+						Value: "dG9rZW4=",
+						Time:  &signingTime,
+					},
+				},
 			},
 			want: []v2.Signature{
 				{
@@ -791,6 +830,18 @@ func TestConvertToV2Signatures(t *testing.T) {
 						Issuer:    "test-issuer",
 					},
 				},
+				{
+					Name: "timestamped",
+					Signature: v2.SignatureInfo{
+						Algorithm: "test-algo",
+						Value:     "test-value",
+						MediaType: "test-media",
+					},
+					Timestamp: &v2.TimestampSpec{
+						Value: "dG9rZW4=",
+						Time:  &v2.Timestamp{Time: v2.NewTime(signingTime)},
+					},
+				},
 			},
 		},
 	}
@@ -7,6 +7,7 @@ package runtime
 
 import (
 	json "encoding/json"
+	time "time"
 
 	goruntime "ocm.software/open-component-model/bindings/go/runtime"
 )
@@ -212,6 +213,11 @@ func (in *Signature) DeepCopyInto(out *Signature) {
 	*out = *in
 	out.Digest = in.Digest
 	out.Signature = in.Signature
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = new(TimestampSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -290,3 +296,24 @@ func (in *SourceRef) DeepCopy() *SourceRef {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampSpec) DeepCopyInto(out *TimestampSpec) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(time.Time)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampSpec.
+func (in *TimestampSpec) DeepCopy() *TimestampSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -92,6 +92,10 @@ const jsonData = `
         "algorithm": "RSASSA-PSS",
         "mediaType": "application/vnd.ocm.signature.rsa",
         "value": "26468587671bdbd2166cf5f69829f090c10768511b15e804294fcb26e552654316c8f4851ed396f279ec99335e5f4b11cb043feb97f1f9a42115f4fda2d31ae8b481b7303b9a913d3a4b92d446fbee9ed487c93b09e513f3f68355040ec08454675e1f407422062abbd2681f70dd5488ad29020b30cfa7e001455c550458da96166bc3243c8426977d73352aface5323fb2b5a374e9c31b272a59c160b85631231c9fc2f23c032401b80fef937029a39111cee34470c61ae86cd4942553466411a5a116159fdcc10e50fe9360c5184028e72d1fe9c7315f26e15d7b4849f62d197501b8cc6b6f1b1391ecc2fc2fc0c1290d2554594505b25fa8f9bfb28c8df24"
+      },
+      "timestamp": {
+        "value": "MIIDjgYJKoZIhvcNAQcCoIIDfzCCA3sCAQMxDTALBglghkgBZQMEAgEw",
+        "time": "2026-01-02T03:04:05Z"
       }
     }
   ],
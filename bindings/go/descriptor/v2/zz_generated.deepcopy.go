@@ -85,7 +85,9 @@ func (in *Descriptor) DeepCopyInto(out *Descriptor) {
 	if in.Signatures != nil {
 		in, out := &in.Signatures, &out.Signatures
 		*out = make([]Signature, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.NestedDigests != nil {
 		in, out := &in.NestedDigests, &out.NestedDigests
@@ -350,6 +352,11 @@ func (in *Signature) DeepCopyInto(out *Signature) {
 	*out = *in
 	out.Digest = in.Digest
 	out.Signature = in.Signature
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = new(TimestampSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -447,3 +454,24 @@ func (in *Timestamp) DeepCopy() *Timestamp {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampSpec) DeepCopyInto(out *TimestampSpec) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(Timestamp)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampSpec.
+func (in *TimestampSpec) DeepCopy() *TimestampSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,320 @@
+// Package handler implements ECDSA signing and verification for OCM.
+// It supports the NIST curves P-256 and P-384, and two encodings:
+//  1. Plain: hex ASN.1 DER signature bytes without certificates.
+//  2. PEM: a SIGNATURE PEM block with an embedded X.509 chain.
+//
+// Private keys are used through crypto.Signer only, so keys that never leave
+// a hardware security module can be used through a SignerProvider.
+//
+// For PEM verification, the leaf public key is taken from the chain after
+// the chain validates against system roots and/or an optional trust anchor
+// provided via credentials.
+package handler
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/ec/signing/ecdsa/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/ec/signing/internal/chain"
+	eccredentials "ocm.software/open-component-model/bindings/go/ec/signing/internal/credentials"
+	"ocm.software/open-component-model/bindings/go/ec/signing/internal/digest"
+	eccredentialsv1 "ocm.software/open-component-model/bindings/go/ec/spec/credentials/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/ec/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	signingpem "ocm.software/open-component-model/bindings/go/signing/pem"
+)
+
+// Common errors for callers to test.
+var (
+	ErrInvalidAlgorithm  = errors.New("invalid algorithm")
+	ErrInvalidKey        = errors.New("key does not match the signature algorithm")
+	ErrMissingPrivateKey = errors.New("private key not found")
+	ErrMissingPublicKey  = errors.New("missing public key, required for plain ECDSA signatures")
+	ErrInvalidSignature  = errors.New("ecdsa signature verification failed")
+)
+
+// Handler holds trust anchors and time source for X.509 validation.
+type Handler struct {
+	roots           *x509.CertPool
+	now             func() time.Time
+	signerProviders []SignerProvider
+}
+
+// New returns a Handler. If useSystemRoots is true, system trust roots are loaded, otherwise an empty pool is used.
+func New(useSystemRoots bool, opts ...HandlerOption) (*Handler, error) {
+	var (
+		roots *x509.CertPool
+		err   error
+	)
+	if useSystemRoots {
+		roots, err = x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("load system roots: %w", err)
+		}
+	}
+	h := &Handler{
+		roots: roots,
+		now:   time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h, nil
+}
+
+func (h *Handler) GetSigningHandlerScheme() *runtime.Scheme {
+	return v1alpha1.Scheme
+}
+
+// ---- SPI ----
+
+// Sign produces a signature for the given digest, using ECDSA with the
+// configured curve and encoding policy. For PEM encoding, the certificate
+// chain is read from credentials and embedded into the SIGNATURE block.
+func (h *Handler) Sign(
+	ctx context.Context,
+	unsigned descruntime.Digest,
+	rawCfg runtime.Typed,
+	creds runtime.Typed,
+) (descruntime.SignatureInfo, error) {
+	var supported v1alpha1.Config
+	if err := h.GetSigningHandlerScheme().Convert(rawCfg, &supported); err != nil {
+		return descruntime.SignatureInfo{}, fmt.Errorf("convert config: %w", err)
+	}
+	algorithm := supported.GetSignatureAlgorithm()
+
+	pemEncoded := supported.GetSignatureEncodingPolicy() == v1alpha1.SignatureEncodingPolicyPEM
+	signer, certs, release, err := eccredentials.SignerFromCredentials(ctx, h.signerProviders, creds, pemEncoded)
+	if err != nil {
+		return descruntime.SignatureInfo{}, err
+	}
+	if release != nil {
+		defer func() {
+			if err := release(); err != nil {
+				slog.WarnContext(ctx, "failed to release signer", "error", err)
+			}
+		}()
+	}
+	if signer == nil {
+		return descruntime.SignatureInfo{}, ErrMissingPrivateKey
+	}
+
+	hash, dig, err := digest.Parse(unsigned)
+	if err != nil {
+		return descruntime.SignatureInfo{}, err
+	}
+
+	rawSig, err := signECDSA(algorithm, signer, hash, dig)
+	if err != nil {
+		return descruntime.SignatureInfo{}, fmt.Errorf("ecdsa sign: %w", err)
+	}
+
+	switch supported.GetSignatureEncodingPolicy() {
+	case v1alpha1.SignatureEncodingPolicyPEM:
+		slog.WarnContext(ctx, "signing with PEM encoding is experimental")
+		pem := signingpem.SignatureBytesToPem(string(algorithm), rawSig, certs...)
+		return descruntime.SignatureInfo{
+			Algorithm: string(algorithm),
+			MediaType: v1alpha1.MediaTypePEM,
+			Value:     string(pem),
+		}, nil
+	case v1alpha1.SignatureEncodingPolicyPlain:
+		fallthrough
+	default:
+		return descruntime.SignatureInfo{
+			Algorithm: string(algorithm),
+			MediaType: supported.GetDefaultMediaType(),
+			Value:     hex.EncodeToString(rawSig),
+		}, nil
+	}
+}
+
+// Verify validates an OCM signature. For plain signatures, a public key must be
+// present in credentials. For PEM signatures, the embedded chain must be valid
+// against system roots and/or the optional trust anchor in credentials.
+func (h *Handler) Verify(
+	ctx context.Context,
+	signed descruntime.Signature,
+	// we use hints from the signature to determine the correct settings, so no additional config is needed
+	_ runtime.Typed,
+	creds runtime.Typed,
+) error {
+	ecCreds, err := convertCredentials(creds)
+	if err != nil {
+		return err
+	}
+
+	_, dig, err := digest.Parse(signed.Digest)
+	if err != nil {
+		return err
+	}
+
+	switch signed.Signature.MediaType {
+	case v1alpha1.MediaTypePlainECDSAP256, v1alpha1.MediaTypePlainECDSAP384:
+		pubFromCreds, err := eccredentials.PublicKeyFromCredentials(ecCreds)
+		if err != nil {
+			return fmt.Errorf("cannot load public key from credentials for verification: %w", err)
+		}
+		if pubFromCreds == nil {
+			return ErrMissingPublicKey
+		}
+		sig, err := hex.DecodeString(signed.Signature.Value)
+		if err != nil {
+			return fmt.Errorf("decode hex signature: %w", err)
+		}
+		alg, err := algorithmFromPlainMedia(signed.Signature.MediaType)
+		if err != nil {
+			return err
+		}
+		return verifyECDSA(alg, pubFromCreds.PublicKey, dig, sig)
+
+	case v1alpha1.MediaTypePEM:
+		slog.WarnContext(ctx, "verifying signatures with PEM encoding is experimental")
+		credChain, err := eccredentials.CertificateChainFromCredentials(ecCreds)
+		if err != nil {
+			return fmt.Errorf("cannot load certificate chain from credentials: %w", err)
+		}
+		sig, alg, leaf, err := chain.VerifyPEMSignature(signed, credChain, h.roots, h.now())
+		if err != nil {
+			return err
+		}
+		return verifyECDSA(v1alpha1.SignatureAlgorithm(alg), leaf.PublicKey, dig, sig)
+
+	default:
+		return fmt.Errorf("unsupported media type %q", signed.Signature.MediaType)
+	}
+}
+
+// GetSigningCredentialConsumerIdentity requests credentials for signing.
+// It encodes the algorithm and the logical signature name.
+func (*Handler) GetSigningCredentialConsumerIdentity(
+	_ context.Context,
+	name string,
+	_ descruntime.Digest,
+	rawCfg runtime.Typed,
+) (runtime.Identity, error) {
+	var supported v1alpha1.Config
+	if err := v1alpha1.Scheme.Convert(rawCfg, &supported); err != nil {
+		return nil, fmt.Errorf("convert config: %w", err)
+	}
+	return identity(supported.GetSignatureAlgorithm(), name), nil
+}
+
+// GetVerifyingCredentialConsumerIdentity requests credentials for verification.
+// For plain signatures, infer algorithm from media type if empty.
+// For PEM signatures, parse the PEM and ensure its algorithm matches the declared one.
+// If declared is empty, use the algorithm parsed from the PEM.
+func (*Handler) GetVerifyingCredentialConsumerIdentity(
+	_ context.Context,
+	signature descruntime.Signature,
+	_ runtime.Typed,
+) (runtime.Identity, error) {
+	alg := signature.Signature.Algorithm
+
+	if signature.Signature.MediaType == v1alpha1.MediaTypePEM {
+		_, pemAlg, _, err := signingpem.GetSignatureFromPem([]byte(signature.Signature.Value))
+		if err != nil {
+			return nil, fmt.Errorf("parse pem signature: %w", err)
+		}
+		if alg != "" && alg != pemAlg {
+			return nil, fmt.Errorf("algorithm mismatch: declared %q, pem %q", alg, pemAlg)
+		}
+		if alg == "" {
+			alg = pemAlg
+		}
+	} else if alg == "" {
+		if inferred, err := algorithmFromPlainMedia(signature.Signature.MediaType); err == nil {
+			alg = string(inferred)
+		}
+	}
+
+	return identity(v1alpha1.SignatureAlgorithm(alg), signature.Name), nil
+}
+
+// ---- internal helpers ----
+
+func convertCredentials(creds runtime.Typed) (*eccredentialsv1.ECCredentials, error) {
+	if creds == nil {
+		return nil, nil
+	}
+	c, err := eccredentialsv1.ConvertToECCredentials(creds)
+	if err != nil {
+		return nil, fmt.Errorf("parse ec credentials: %w", err)
+	}
+	return c, nil
+}
+
+// algorithmFromPlainMedia infers the ECDSA algorithm from a plain media type.
+func algorithmFromPlainMedia(mt string) (v1alpha1.SignatureAlgorithm, error) {
+	switch mt {
+	case v1alpha1.MediaTypePlainECDSAP256:
+		return v1alpha1.AlgorithmECDSAP256, nil
+	case v1alpha1.MediaTypePlainECDSAP384:
+		return v1alpha1.AlgorithmECDSAP384, nil
+	default:
+		return "", fmt.Errorf("unsupported media type %q", mt)
+	}
+}
+
+// curveForAlgorithm returns the curve a key must use for the given algorithm.
+func curveForAlgorithm(algorithm v1alpha1.SignatureAlgorithm) (elliptic.Curve, error) {
+	switch algorithm {
+	case v1alpha1.AlgorithmECDSAP256:
+		return elliptic.P256(), nil
+	case v1alpha1.AlgorithmECDSAP384:
+		return elliptic.P384(), nil
+	default:
+		return nil, ErrInvalidAlgorithm
+	}
+}
+
+// signECDSA signs dig with signer after checking that its public key is on
+// the curve required by algorithm. The result is an ASN.1 DER signature.
+func signECDSA(algorithm v1alpha1.SignatureAlgorithm, signer crypto.Signer, h crypto.Hash, dig []byte) ([]byte, error) {
+	curve, err := curveForAlgorithm(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	pub, ok := signer.Public().(*ecdsa.PublicKey)
+	if !ok || pub.Curve != curve {
+		return nil, fmt.Errorf("%w: expected ECDSA key on curve %s", ErrInvalidKey, curve.Params().Name)
+	}
+	return signer.Sign(rand.Reader, dig, h)
+}
+
+// verifyECDSA verifies the ASN.1 DER signature sig over dig.
+func verifyECDSA(algorithm v1alpha1.SignatureAlgorithm, pub crypto.PublicKey, dig, sig []byte) error {
+	curve, err := curveForAlgorithm(algorithm)
+	if err != nil {
+		return err
+	}
+	ecPub, ok := pub.(*ecdsa.PublicKey)
+	if !ok || ecPub.Curve != curve {
+		return fmt.Errorf("%w: expected ECDSA key on curve %s", ErrInvalidKey, curve.Params().Name)
+	}
+	if !ecdsa.VerifyASN1(ecPub, dig, sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// identity builds an ECDSA credential consumer identity.
+func identity(algorithm v1alpha1.SignatureAlgorithm, signature string) runtime.Identity {
+	m := runtime.Identity{
+		identityv1.IdentityAttributeAlgorithm: string(algorithm),
+		identityv1.IdentityAttributeSignature: signature,
+	}
+	m.SetType(identityv1.ECDSAVersionedType)
+	return m
+}
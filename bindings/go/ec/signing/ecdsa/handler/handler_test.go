@@ -0,0 +1,317 @@
+package handler
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/ec/signing/ecdsa/v1alpha1"
+	eccredentialsv1 "ocm.software/open-component-model/bindings/go/ec/spec/credentials/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/ec/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func Test_ECDSA_Handler_Plain(t *testing.T) {
+	h, err := New(false)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		alg   v1alpha1.SignatureAlgorithm
+		curve elliptic.Curve
+		media string
+	}{
+		{v1alpha1.AlgorithmECDSAP256, elliptic.P256(), v1alpha1.MediaTypePlainECDSAP256},
+		{v1alpha1.AlgorithmECDSAP384, elliptic.P384(), v1alpha1.MediaTypePlainECDSAP384},
+	} {
+		t.Run(string(tc.alg), func(t *testing.T) {
+			key := mustKey(t, tc.curve)
+			other := mustKey(t, tc.curve)
+
+			for _, hash := range []crypto.Hash{crypto.SHA256, crypto.SHA512} {
+				t.Run(hash.String(), func(t *testing.T) {
+					d := digestHex(hash, []byte("hello world"))
+					cfg := &v1alpha1.Config{SignatureAlgorithm: tc.alg}
+
+					si, err := h.Sign(t.Context(), d, cfg, privateKeyCredentials(t, key))
+					require.NoError(t, err)
+					require.Equal(t, string(tc.alg), si.Algorithm)
+					require.Equal(t, tc.media, si.MediaType)
+
+					signed := descruntime.Signature{Digest: d, Signature: si}
+					require.NoError(t, h.Verify(t.Context(), signed, nil, publicKeyCredentials(t, &key.PublicKey)))
+					// the public key is derived from the private key if not given
+					require.NoError(t, h.Verify(t.Context(), signed, nil, privateKeyCredentials(t, key)))
+
+					require.ErrorIs(t, h.Verify(t.Context(), signed, nil, publicKeyCredentials(t, &other.PublicKey)), ErrInvalidSignature)
+					require.ErrorIs(t, h.Verify(t.Context(), signed, nil, nil), ErrMissingPublicKey)
+
+					tampered := signed
+					tampered.Digest = digestHex(hash, []byte("tampered"))
+					require.ErrorIs(t, h.Verify(t.Context(), tampered, nil, publicKeyCredentials(t, &key.PublicKey)), ErrInvalidSignature)
+				})
+			}
+		})
+	}
+}
+
+func Test_ECDSA_Handler_CurveMismatch(t *testing.T) {
+	h, err := New(false)
+	require.NoError(t, err)
+	d := digestHex(crypto.SHA256, []byte("hello world"))
+
+	p256 := mustKey(t, elliptic.P256())
+	_, err = h.Sign(t.Context(), d, &v1alpha1.Config{SignatureAlgorithm: v1alpha1.AlgorithmECDSAP384}, privateKeyCredentials(t, p256))
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	si, err := h.Sign(t.Context(), d, &v1alpha1.Config{}, privateKeyCredentials(t, p256))
+	require.NoError(t, err)
+	require.Equal(t, string(v1alpha1.AlgorithmECDSAP256), si.Algorithm)
+
+	p384 := mustKey(t, elliptic.P384())
+	err = h.Verify(t.Context(), descruntime.Signature{Digest: d, Signature: si}, nil, publicKeyCredentials(t, &p384.PublicKey))
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = h.Sign(t.Context(), d, &v1alpha1.Config{}, nil)
+	require.ErrorIs(t, err, ErrMissingPrivateKey)
+}
+
+func Test_ECDSA_Handler_PEM(t *testing.T) {
+	rootKey := mustKey(t, elliptic.P384())
+	root := mustCA(t, "root", rootKey)
+	leafKey := mustKey(t, elliptic.P256())
+	leaf := mustLeaf(t, "signer", leafKey, root, rootKey)
+
+	otherRootKey := mustKey(t, elliptic.P256())
+	otherRoot := mustCA(t, "other-root", otherRootKey)
+
+	h, err := New(false)
+	require.NoError(t, err)
+	d := digestHex(crypto.SHA256, []byte("hello world"))
+
+	signCreds := &eccredentialsv1.ECCredentials{
+		Type:          eccredentialsv1.VersionedType,
+		PrivateKeyPEM: string(privateKeyPEM(t, leafKey)),
+		PublicKeyPEM:  string(certPEM(leaf)),
+	}
+	si, err := h.Sign(t.Context(), d, &v1alpha1.Config{SignatureEncodingPolicy: v1alpha1.SignatureEncodingPolicyPEM}, signCreds)
+	require.NoError(t, err)
+	require.Equal(t, v1alpha1.MediaTypePEM, si.MediaType)
+	require.Contains(t, si.Value, "Signature Algorithm: ECDSA-P256")
+
+	signed := descruntime.Signature{Name: "signer", Digest: d, Signature: si}
+	rootCreds := &eccredentialsv1.ECCredentials{
+		Type:         eccredentialsv1.VersionedType,
+		PublicKeyPEM: string(certPEM(root)),
+	}
+
+	t.Run("valid chain against credential root", func(t *testing.T) {
+		require.NoError(t, h.Verify(t.Context(), signed, nil, rootCreds))
+	})
+
+	t.Run("no trust anchor", func(t *testing.T) {
+		require.ErrorContains(t, h.Verify(t.Context(), signed, nil, nil), "certificate verification failed")
+	})
+
+	t.Run("untrusted root", func(t *testing.T) {
+		require.ErrorContains(t, h.Verify(t.Context(), signed, nil, &eccredentialsv1.ECCredentials{
+			Type:         eccredentialsv1.VersionedType,
+			PublicKeyPEM: string(certPEM(otherRoot)),
+		}), "certificate verification failed")
+	})
+
+	t.Run("issuer matches", func(t *testing.T) {
+		withIssuer := signed
+		withIssuer.Signature.Issuer = "CN=root"
+		require.NoError(t, h.Verify(t.Context(), withIssuer, nil, rootCreds))
+	})
+
+	t.Run("issuer mismatch", func(t *testing.T) {
+		withIssuer := signed
+		withIssuer.Signature.Issuer = "CN=someone-else"
+		require.ErrorContains(t, h.Verify(t.Context(), withIssuer, nil, rootCreds), "issuer mismatch")
+	})
+
+	t.Run("embedded self-signed root is rejected", func(t *testing.T) {
+		si, err := h.Sign(t.Context(), d, &v1alpha1.Config{SignatureEncodingPolicy: v1alpha1.SignatureEncodingPolicyPEM}, &eccredentialsv1.ECCredentials{
+			Type:          eccredentialsv1.VersionedType,
+			PrivateKeyPEM: string(privateKeyPEM(t, leafKey)),
+			PublicKeyPEM:  string(append(certPEM(leaf), certPEM(root)...)),
+		})
+		require.NoError(t, err)
+		err = h.Verify(t.Context(), descruntime.Signature{Digest: d, Signature: si}, nil, rootCreds)
+		require.ErrorContains(t, err, "must not be embedded in the signature")
+	})
+
+	t.Run("expired leaf", func(t *testing.T) {
+		expired := *h
+		expired.now = func() time.Time { return time.Now().Add(48 * time.Hour) }
+		require.ErrorContains(t, expired.Verify(t.Context(), signed, nil, rootCreds), "certificate verification failed")
+	})
+}
+
+func Test_ECDSA_Handler_SignerProvider(t *testing.T) {
+	rootKey := mustKey(t, elliptic.P256())
+	root := mustCA(t, "root", rootKey)
+	leafKey := mustKey(t, elliptic.P256())
+	leaf := mustLeaf(t, "signer", leafKey, root, rootKey)
+	hsmType := runtime.NewUnversionedType("HSM")
+
+	var released bool
+	h, err := New(false, WithSignerProvider(func(_ context.Context, creds runtime.Typed) (crypto.Signer, []*x509.Certificate, func() error, error) {
+		if creds == nil || creds.GetType() != hsmType {
+			return nil, nil, nil, nil
+		}
+		return opaqueSigner{leafKey}, []*x509.Certificate{leaf}, func() error {
+			released = true
+			return nil
+		}, nil
+	}))
+	require.NoError(t, err)
+	d := digestHex(crypto.SHA256, []byte("hello world"))
+
+	si, err := h.Sign(t.Context(), d, &v1alpha1.Config{SignatureEncodingPolicy: v1alpha1.SignatureEncodingPolicyPEM}, &runtime.Raw{Type: hsmType})
+	require.NoError(t, err)
+	require.True(t, released)
+
+	signed := descruntime.Signature{Name: "signer", Digest: d, Signature: si}
+	require.NoError(t, h.Verify(t.Context(), signed, nil, &eccredentialsv1.ECCredentials{
+		Type:         eccredentialsv1.VersionedType,
+		PublicKeyPEM: string(certPEM(root)),
+	}))
+
+	// credentials not handled by the provider fall back to EC credentials
+	_, err = h.Sign(t.Context(), d, &v1alpha1.Config{}, nil)
+	require.ErrorIs(t, err, ErrMissingPrivateKey)
+}
+
+// opaqueSigner hides the concrete key type, like a signer backed by an HSM.
+type opaqueSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s opaqueSigner) Public() crypto.PublicKey { return &s.key.PublicKey }
+
+func (s opaqueSigner) Sign(r io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.key.Sign(r, digest, opts)
+}
+
+func Test_ECDSA_Handler_Identities(t *testing.T) {
+	h, err := New(false)
+	require.NoError(t, err)
+
+	id, err := h.GetSigningCredentialConsumerIdentity(t.Context(), "release", descruntime.Digest{}, &v1alpha1.Config{
+		SignatureAlgorithm: v1alpha1.AlgorithmECDSAP384,
+	})
+	require.NoError(t, err)
+	require.Equal(t, identityv1.ECDSAVersionedType.String(), id[runtime.IdentityAttributeType])
+	require.Equal(t, string(v1alpha1.AlgorithmECDSAP384), id[identityv1.IdentityAttributeAlgorithm])
+	require.Equal(t, "release", id[identityv1.IdentityAttributeSignature])
+
+	id, err = h.GetVerifyingCredentialConsumerIdentity(t.Context(), descruntime.Signature{
+		Name:      "release",
+		Signature: descruntime.SignatureInfo{MediaType: v1alpha1.MediaTypePlainECDSAP384},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, string(v1alpha1.AlgorithmECDSAP384), id[identityv1.IdentityAttributeAlgorithm])
+}
+
+// ---- helpers ----
+
+func mustKey(t *testing.T, curve elliptic.Curve) *ecdsa.PrivateKey {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+	return k
+}
+
+func mustCA(t *testing.T, cn string, key *ecdsa.PrivateKey) *x509.Certificate {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func mustLeaf(t *testing.T, cn string, key *ecdsa.PrivateKey, parent *x509.Certificate, parentKey crypto.Signer) *x509.Certificate {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func privateKeyPEM(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func certPEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+// privateKeyCredentials writes the key to a file to exercise the file based credential fields.
+func privateKeyCredentials(t *testing.T, key *ecdsa.PrivateKey) runtime.Typed {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, privateKeyPEM(t, key), 0o600))
+	return &eccredentialsv1.ECCredentials{
+		Type:              eccredentialsv1.VersionedType,
+		PrivateKeyPEMFile: path,
+	}
+}
+
+func publicKeyCredentials(t *testing.T, pub *ecdsa.PublicKey) runtime.Typed {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return &eccredentialsv1.ECCredentials{
+		Type:         eccredentialsv1.VersionedType,
+		PublicKeyPEM: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}
+}
+
+func digestHex(h crypto.Hash, data []byte) descruntime.Digest {
+	hasher := h.New()
+	hasher.Write(data)
+	return descruntime.Digest{
+		HashAlgorithm:          h.String(),
+		NormalisationAlgorithm: "jsonNormalisation/v4alpha1",
+		Value:                  hex.EncodeToString(hasher.Sum(nil)),
+	}
+}
@@ -0,0 +1,39 @@
+package v1alpha1
+
+// SignatureAlgorithm is the signature algorithm to use when creating new signatures.
+// This field is optional and defaults to AlgorithmECDSAP256. For verification, this field is ignored
+// and the signature algorithm is inferred from the signature specification.
+// +ocm:jsonschema-gen:enum=ECDSA-P256,ECDSA-P384
+type SignatureAlgorithm string
+
+const (
+	// MediaTypePlainECDSAP256 is the media type for a plain signature based on AlgorithmECDSAP256 encoded as a hex string.
+	MediaTypePlainECDSAP256 = "application/vnd.ocm.signature.ecdsa.p256"
+	// AlgorithmECDSAP256 is the identifier for ECDSA over the NIST P-256 curve (secp256r1).
+	//
+	// ECDSA is defined in:
+	//   - NIST FIPS 186-5: https://csrc.nist.gov/pubs/fips/186-5/final
+	//   - SEC 1 v2.0: https://www.secg.org/sec1-v2.pdf
+	//
+	// Key properties:
+	//   - Non-deterministic: the same digest produces different signatures when signed multiple times.
+	//   - Signatures are encoded as an ASN.1 DER SEQUENCE of the two integers r and s.
+	//   - The private key may live outside the process (e.g. in an HSM) as long as it is
+	//     reachable through crypto.Signer.
+	//
+	// Parameters used in OCM:
+	//   - Curve: P-256, the signing key must be a P-256 key.
+	//   - Hash function: the digest of the component descriptor is signed as-is (SHA-256, SHA-384
+	//     or SHA-512 based on the digest specification); no additional hashing is applied.
+	//
+	// This is the default algorithm for ECDSA signing.
+	AlgorithmECDSAP256 SignatureAlgorithm = "ECDSA-P256"
+
+	// MediaTypePlainECDSAP384 is the media type for a plain signature based on AlgorithmECDSAP384 encoded as a hex string.
+	MediaTypePlainECDSAP384 = "application/vnd.ocm.signature.ecdsa.p384"
+	// AlgorithmECDSAP384 is the identifier for ECDSA over the NIST P-384 curve (secp384r1).
+	//
+	// Apart from the curve, it behaves exactly like AlgorithmECDSAP256.
+	// The signing key must be a P-384 key.
+	AlgorithmECDSAP384 SignatureAlgorithm = "ECDSA-P384"
+)
@@ -0,0 +1,58 @@
+package v1alpha1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const ConfigType = "ECDSASigningConfiguration"
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	Scheme.MustRegisterWithAlias(&Config{},
+		runtime.NewUnversionedType(ConfigType),
+		runtime.NewVersionedType(ConfigType, Version),
+	)
+}
+
+// Config defines configuration for signing based on AlgorithmECDSAP256 or AlgorithmECDSAP384.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type Config struct {
+	// Type identifies this configuration object’s runtime type.
+	// +ocm:jsonschema-gen:enum=ECDSASigningConfiguration/v1alpha1
+	// +ocm:jsonschema-gen:enum:deprecated=ECDSASigningConfiguration
+	Type runtime.Type `json:"type"`
+
+	SignatureEncodingPolicy SignatureEncodingPolicy `json:"signatureEncodingPolicy,omitempty"`
+
+	SignatureAlgorithm SignatureAlgorithm `json:"signatureAlgorithm,omitempty"`
+}
+
+func (cfg *Config) GetSignatureEncodingPolicy() SignatureEncodingPolicy {
+	if cfg == nil || cfg.SignatureEncodingPolicy == "" {
+		return SignatureEncodingPolicyDefault
+	}
+	return cfg.SignatureEncodingPolicy
+}
+
+func (cfg *Config) GetSignatureAlgorithm() SignatureAlgorithm {
+	if cfg == nil || cfg.SignatureAlgorithm == "" {
+		return AlgorithmECDSAP256
+	}
+	return cfg.SignatureAlgorithm
+}
+
+func (cfg *Config) GetDefaultMediaType() string {
+	switch cfg.GetSignatureAlgorithm() {
+	case AlgorithmECDSAP256:
+		return MediaTypePlainECDSAP256
+	case AlgorithmECDSAP384:
+		return MediaTypePlainECDSAP384
+	default:
+		return ""
+	}
+}
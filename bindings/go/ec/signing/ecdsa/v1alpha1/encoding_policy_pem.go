@@ -0,0 +1,28 @@
+package v1alpha1
+
+const (
+	// MediaTypePEM is the media type for a PEM-encoded ECDSA signature.
+	// It represents a signature encoded via SignatureEncodingPolicyPEM.
+	MediaTypePEM = "application/x-pem-file"
+
+	// SignatureEncodingPolicyPEM encodes the signature in a PEM block, optionally
+	// followed by the signer’s certificate chain.
+	//
+	// The layout is identical to the PEM encoding of the RSA signing handler:
+	//   1. Create a PEM block with type "SIGNATURE".
+	//   2. Insert the ASN.1 DER signature bytes into the block.
+	//   3. Add the signing algorithm (e.g. "ECDSA-P256") as the "Signature Algorithm" header.
+	//   4. Encode the block into PEM format.
+	//   5. Optionally append the signer’s certificate chain in PEM format
+	//      (only possible if the signature was created with a certificate).
+	//
+	// Verification rules:
+	//   1. The public key is extracted from the appended and validated certificate chain.
+	//   2. The signature’s issuer, if set, must match the Distinguished Name (DN) of the
+	//      CA that issued the leaf certificate.
+	//   3. The chain is validated against the host system’s trust store or against a
+	//      root certificate distributed via credentials.
+	//
+	// Experimental: This encoding policy is experimental and may change or be deprecated in the future.
+	SignatureEncodingPolicyPEM SignatureEncodingPolicy = "PEM"
+)
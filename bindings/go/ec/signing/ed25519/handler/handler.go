@@ -0,0 +1,273 @@
+// Package handler implements Ed25519 signing and verification for OCM.
+// The raw digest bytes are signed with pure Ed25519, in two encodings:
+//  1. Plain: hex signature bytes without certificates.
+//  2. PEM: a SIGNATURE PEM block with an embedded X.509 chain.
+//
+// Private keys are used through crypto.Signer only, so keys that never leave
+// a hardware security module can be used through a SignerProvider.
+//
+// For PEM verification, the leaf public key is taken from the chain after
+// the chain validates against system roots and/or an optional trust anchor
+// provided via credentials.
+package handler
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/ec/signing/ed25519/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/ec/signing/internal/chain"
+	eccredentials "ocm.software/open-component-model/bindings/go/ec/signing/internal/credentials"
+	"ocm.software/open-component-model/bindings/go/ec/signing/internal/digest"
+	eccredentialsv1 "ocm.software/open-component-model/bindings/go/ec/spec/credentials/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/ec/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	signingpem "ocm.software/open-component-model/bindings/go/signing/pem"
+)
+
+// Common errors for callers to test.
+var (
+	ErrInvalidAlgorithm  = errors.New("invalid algorithm")
+	ErrInvalidKey        = errors.New("key is not an Ed25519 key")
+	ErrMissingPrivateKey = errors.New("private key not found")
+	ErrMissingPublicKey  = errors.New("missing public key, required for plain Ed25519 signatures")
+	ErrInvalidSignature  = errors.New("ed25519 signature verification failed")
+)
+
+// Handler holds trust anchors and time source for X.509 validation.
+type Handler struct {
+	roots           *x509.CertPool
+	now             func() time.Time
+	signerProviders []SignerProvider
+}
+
+// New returns a Handler. If useSystemRoots is true, system trust roots are loaded, otherwise an empty pool is used.
+func New(useSystemRoots bool, opts ...HandlerOption) (*Handler, error) {
+	var (
+		roots *x509.CertPool
+		err   error
+	)
+	if useSystemRoots {
+		roots, err = x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("load system roots: %w", err)
+		}
+	}
+	h := &Handler{
+		roots: roots,
+		now:   time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h, nil
+}
+
+func (h *Handler) GetSigningHandlerScheme() *runtime.Scheme {
+	return v1alpha1.Scheme
+}
+
+// ---- SPI ----
+
+// Sign produces a signature for the given digest, using Ed25519 and the
+// configured encoding policy. For PEM encoding, the certificate
+// chain is read from credentials and embedded into the SIGNATURE block.
+func (h *Handler) Sign(
+	ctx context.Context,
+	unsigned descruntime.Digest,
+	rawCfg runtime.Typed,
+	creds runtime.Typed,
+) (descruntime.SignatureInfo, error) {
+	var supported v1alpha1.Config
+	if err := h.GetSigningHandlerScheme().Convert(rawCfg, &supported); err != nil {
+		return descruntime.SignatureInfo{}, fmt.Errorf("convert config: %w", err)
+	}
+	algorithm := v1alpha1.AlgorithmEd25519
+
+	pemEncoded := supported.GetSignatureEncodingPolicy() == v1alpha1.SignatureEncodingPolicyPEM
+	signer, certs, release, err := eccredentials.SignerFromCredentials(ctx, h.signerProviders, creds, pemEncoded)
+	if err != nil {
+		return descruntime.SignatureInfo{}, err
+	}
+	if release != nil {
+		defer func() {
+			if err := release(); err != nil {
+				slog.WarnContext(ctx, "failed to release signer", "error", err)
+			}
+		}()
+	}
+	if signer == nil {
+		return descruntime.SignatureInfo{}, ErrMissingPrivateKey
+	}
+
+	_, dig, err := digest.Parse(unsigned)
+	if err != nil {
+		return descruntime.SignatureInfo{}, err
+	}
+
+	rawSig, err := signEd25519(signer, dig)
+	if err != nil {
+		return descruntime.SignatureInfo{}, fmt.Errorf("ed25519 sign: %w", err)
+	}
+
+	switch supported.GetSignatureEncodingPolicy() {
+	case v1alpha1.SignatureEncodingPolicyPEM:
+		slog.WarnContext(ctx, "signing with PEM encoding is experimental")
+		pem := signingpem.SignatureBytesToPem(string(algorithm), rawSig, certs...)
+		return descruntime.SignatureInfo{
+			Algorithm: string(algorithm),
+			MediaType: v1alpha1.MediaTypePEM,
+			Value:     string(pem),
+		}, nil
+	case v1alpha1.SignatureEncodingPolicyPlain:
+		fallthrough
+	default:
+		return descruntime.SignatureInfo{
+			Algorithm: string(algorithm),
+			MediaType: v1alpha1.MediaTypePlainEd25519,
+			Value:     hex.EncodeToString(rawSig),
+		}, nil
+	}
+}
+
+// Verify validates an OCM signature. For plain signatures, a public key must be
+// present in credentials. For PEM signatures, the embedded chain must be valid
+// against system roots and/or the optional trust anchor in credentials.
+func (h *Handler) Verify(
+	ctx context.Context,
+	signed descruntime.Signature,
+	// we use hints from the signature to determine the correct settings, so no additional config is needed
+	_ runtime.Typed,
+	creds runtime.Typed,
+) error {
+	ecCreds, err := convertCredentials(creds)
+	if err != nil {
+		return err
+	}
+
+	_, dig, err := digest.Parse(signed.Digest)
+	if err != nil {
+		return err
+	}
+
+	switch signed.Signature.MediaType {
+	case v1alpha1.MediaTypePlainEd25519:
+		pubFromCreds, err := eccredentials.PublicKeyFromCredentials(ecCreds)
+		if err != nil {
+			return fmt.Errorf("cannot load public key from credentials for verification: %w", err)
+		}
+		if pubFromCreds == nil {
+			return ErrMissingPublicKey
+		}
+		sig, err := hex.DecodeString(signed.Signature.Value)
+		if err != nil {
+			return fmt.Errorf("decode hex signature: %w", err)
+		}
+		return verifyEd25519(pubFromCreds.PublicKey, dig, sig)
+
+	case v1alpha1.MediaTypePEM:
+		slog.WarnContext(ctx, "verifying signatures with PEM encoding is experimental")
+		credChain, err := eccredentials.CertificateChainFromCredentials(ecCreds)
+		if err != nil {
+			return fmt.Errorf("cannot load certificate chain from credentials: %w", err)
+		}
+		sig, alg, leaf, err := chain.VerifyPEMSignature(signed, credChain, h.roots, h.now())
+		if err != nil {
+			return err
+		}
+		if v1alpha1.SignatureAlgorithm(alg) != v1alpha1.AlgorithmEd25519 {
+			return fmt.Errorf("%w: %q", ErrInvalidAlgorithm, alg)
+		}
+		return verifyEd25519(leaf.PublicKey, dig, sig)
+
+	default:
+		return fmt.Errorf("unsupported media type %q", signed.Signature.MediaType)
+	}
+}
+
+// GetSigningCredentialConsumerIdentity requests credentials for signing.
+// It encodes the algorithm and the logical signature name.
+func (*Handler) GetSigningCredentialConsumerIdentity(
+	_ context.Context,
+	name string,
+	_ descruntime.Digest,
+	rawCfg runtime.Typed,
+) (runtime.Identity, error) {
+	var supported v1alpha1.Config
+	if err := v1alpha1.Scheme.Convert(rawCfg, &supported); err != nil {
+		return nil, fmt.Errorf("convert config: %w", err)
+	}
+	return identity(name), nil
+}
+
+// GetVerifyingCredentialConsumerIdentity requests credentials for verification.
+// For PEM signatures, the algorithm in the PEM header must be Ed25519.
+func (*Handler) GetVerifyingCredentialConsumerIdentity(
+	_ context.Context,
+	signature descruntime.Signature,
+	_ runtime.Typed,
+) (runtime.Identity, error) {
+	if signature.Signature.MediaType == v1alpha1.MediaTypePEM {
+		_, pemAlg, _, err := signingpem.GetSignatureFromPem([]byte(signature.Signature.Value))
+		if err != nil {
+			return nil, fmt.Errorf("parse pem signature: %w", err)
+		}
+		if pemAlg != "" && v1alpha1.SignatureAlgorithm(pemAlg) != v1alpha1.AlgorithmEd25519 {
+			return nil, fmt.Errorf("algorithm mismatch: expected %q, pem %q", v1alpha1.AlgorithmEd25519, pemAlg)
+		}
+	}
+	return identity(signature.Name), nil
+}
+
+// ---- internal helpers ----
+
+func convertCredentials(creds runtime.Typed) (*eccredentialsv1.ECCredentials, error) {
+	if creds == nil {
+		return nil, nil
+	}
+	c, err := eccredentialsv1.ConvertToECCredentials(creds)
+	if err != nil {
+		return nil, fmt.Errorf("parse ec credentials: %w", err)
+	}
+	return c, nil
+}
+
+// signEd25519 signs dig as the message of a pure Ed25519 signature.
+func signEd25519(signer crypto.Signer, dig []byte) ([]byte, error) {
+	if _, ok := signer.Public().(ed25519.PublicKey); !ok {
+		return nil, ErrInvalidKey
+	}
+	// crypto.Hash(0) selects pure Ed25519, the message is not pre-hashed.
+	return signer.Sign(rand.Reader, dig, crypto.Hash(0))
+}
+
+// verifyEd25519 verifies the Ed25519 signature sig over dig.
+func verifyEd25519(pub crypto.PublicKey, dig, sig []byte) error {
+	edPub, ok := pub.(ed25519.PublicKey)
+	if !ok {
+		return ErrInvalidKey
+	}
+	if !ed25519.Verify(edPub, dig, sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// identity builds an Ed25519 credential consumer identity.
+func identity(signature string) runtime.Identity {
+	m := runtime.Identity{
+		identityv1.IdentityAttributeAlgorithm: string(v1alpha1.AlgorithmEd25519),
+		identityv1.IdentityAttributeSignature: signature,
+	}
+	m.SetType(identityv1.Ed25519VersionedType)
+	return m
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	eccredentials "ocm.software/open-component-model/bindings/go/ec/signing/internal/credentials"
+)
+
+// SignerProvider resolves credentials that reference a private key held outside
+// the process, such as in a hardware security module, into a crypto.Signer and
+// the certificate chain to embed for PEM signatures.
+// It returns a nil signer if it does not handle the given credentials. The
+// returned release function is called once signing is done.
+type SignerProvider = eccredentials.SignerProvider
+
+// HandlerOption configures a Handler.
+type HandlerOption func(*Handler)
+
+// WithSignerProvider adds a SignerProvider that is asked for a signer before
+// the private key is read from EC credentials. Providers are asked in the
+// order they were added.
+func WithSignerProvider(p SignerProvider) HandlerOption {
+	return func(h *Handler) {
+		h.signerProviders = append(h.signerProviders, p)
+	}
+}
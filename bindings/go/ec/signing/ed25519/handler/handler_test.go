@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/ec/signing/ed25519/v1alpha1"
+	eccredentialsv1 "ocm.software/open-component-model/bindings/go/ec/spec/credentials/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/ec/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func Test_Ed25519_Handler_Plain(t *testing.T) {
+	h, err := New(false)
+	require.NoError(t, err)
+
+	pub, priv := mustKey(t)
+	otherPub, _ := mustKey(t)
+
+	for _, hash := range []crypto.Hash{crypto.SHA256, crypto.SHA512} {
+		t.Run(hash.String(), func(t *testing.T) {
+			d := digestHex(hash, []byte("hello world"))
+
+			si, err := h.Sign(t.Context(), d, &v1alpha1.Config{}, privateKeyCredentials(t, priv))
+			require.NoError(t, err)
+			require.Equal(t, string(v1alpha1.AlgorithmEd25519), si.Algorithm)
+			require.Equal(t, v1alpha1.MediaTypePlainEd25519, si.MediaType)
+
+			// Ed25519 is deterministic over the digest bytes.
+			raw, err := hex.DecodeString(si.Value)
+			require.NoError(t, err)
+			dig, err := hex.DecodeString(d.Value)
+			require.NoError(t, err)
+			require.Equal(t, ed25519.Sign(priv, dig), raw)
+
+			signed := descruntime.Signature{Digest: d, Signature: si}
+			require.NoError(t, h.Verify(t.Context(), signed, nil, publicKeyCredentials(t, pub)))
+			require.NoError(t, h.Verify(t.Context(), signed, nil, privateKeyCredentials(t, priv)))
+			require.ErrorIs(t, h.Verify(t.Context(), signed, nil, publicKeyCredentials(t, otherPub)), ErrInvalidSignature)
+			require.ErrorIs(t, h.Verify(t.Context(), signed, nil, nil), ErrMissingPublicKey)
+		})
+	}
+
+	_, err = h.Sign(t.Context(), digestHex(crypto.SHA256, nil), &v1alpha1.Config{}, nil)
+	require.ErrorIs(t, err, ErrMissingPrivateKey)
+}
+
+func Test_Ed25519_Handler_PEM(t *testing.T) {
+	rootPub, rootPriv := mustKey(t)
+	root := mustCert(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "root"},
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, nil, rootPub, rootPriv)
+	leafPub, leafPriv := mustKey(t)
+	leaf := mustCert(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "signer"},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}, root, leafPub, rootPriv)
+
+	h, err := New(false)
+	require.NoError(t, err)
+	d := digestHex(crypto.SHA256, []byte("hello world"))
+
+	si, err := h.Sign(t.Context(), d, &v1alpha1.Config{SignatureEncodingPolicy: v1alpha1.SignatureEncodingPolicyPEM}, &eccredentialsv1.ECCredentials{
+		Type:          eccredentialsv1.VersionedType,
+		PrivateKeyPEM: string(privateKeyPEM(t, leafPriv)),
+		PublicKeyPEM:  string(certPEM(leaf)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1alpha1.MediaTypePEM, si.MediaType)
+
+	signed := descruntime.Signature{Name: "signer", Digest: d, Signature: si}
+	signed.Signature.Issuer = "CN=root"
+	rootCreds := &eccredentialsv1.ECCredentials{
+		Type:         eccredentialsv1.VersionedType,
+		PublicKeyPEM: string(certPEM(root)),
+	}
+	require.NoError(t, h.Verify(t.Context(), signed, nil, rootCreds))
+	require.ErrorContains(t, h.Verify(t.Context(), signed, nil, nil), "certificate verification failed")
+
+	id, err := h.GetVerifyingCredentialConsumerIdentity(t.Context(), signed, nil)
+	require.NoError(t, err)
+	require.Equal(t, identityv1.Ed25519VersionedType.String(), id[runtime.IdentityAttributeType])
+	require.Equal(t, string(v1alpha1.AlgorithmEd25519), id[identityv1.IdentityAttributeAlgorithm])
+	require.Equal(t, "signer", id[identityv1.IdentityAttributeSignature])
+}
+
+// ---- helpers ----
+
+func mustKey(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return pub, priv
+}
+
+func mustCert(t *testing.T, tmpl, parent *x509.Certificate, pub ed25519.PublicKey, parentKey ed25519.PrivateKey) *x509.Certificate {
+	t.Helper()
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent = tmpl
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, pub, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func privateKeyPEM(t *testing.T, key ed25519.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func certPEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+func privateKeyCredentials(t *testing.T, key ed25519.PrivateKey) runtime.Typed {
+	t.Helper()
+	return &eccredentialsv1.ECCredentials{
+		Type:          eccredentialsv1.VersionedType,
+		PrivateKeyPEM: string(privateKeyPEM(t, key)),
+	}
+}
+
+func publicKeyCredentials(t *testing.T, pub ed25519.PublicKey) runtime.Typed {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return &eccredentialsv1.ECCredentials{
+		Type:         eccredentialsv1.VersionedType,
+		PublicKeyPEM: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}
+}
+
+func digestHex(h crypto.Hash, data []byte) descruntime.Digest {
+	hasher := h.New()
+	hasher.Write(data)
+	return descruntime.Digest{
+		HashAlgorithm:          h.String(),
+		NormalisationAlgorithm: "jsonNormalisation/v4alpha1",
+		Value:                  hex.EncodeToString(hasher.Sum(nil)),
+	}
+}
@@ -0,0 +1,27 @@
+package v1alpha1
+
+// SignatureAlgorithm is the signature algorithm used for Ed25519 signatures.
+// There is only one algorithm, so it is not configurable, but it is recorded
+// in the signature specification and in the consumer identity.
+type SignatureAlgorithm string
+
+const (
+	// MediaTypePlainEd25519 is the media type for a plain signature based on AlgorithmEd25519 encoded as a hex string.
+	MediaTypePlainEd25519 = "application/vnd.ocm.signature.ed25519"
+	// AlgorithmEd25519 is the identifier for the Edwards-curve Digital Signature Algorithm over Curve25519.
+	//
+	// Ed25519 is defined in:
+	//   - RFC 8032: https://datatracker.ietf.org/doc/html/rfc8032#section-5.1
+	//   - NIST FIPS 186-5: https://csrc.nist.gov/pubs/fips/186-5/final
+	//
+	// Key properties:
+	//   - Deterministic: the same digest always produces the same signature with the same key.
+	//   - Fixed signature size of 64 bytes.
+	//   - No curve or hash parameters to choose, which rules out a whole class of misconfiguration.
+	//
+	// Parameters used in OCM:
+	//   - Variant: pure Ed25519 (not Ed25519ph or Ed25519ctx).
+	//   - Message: the raw bytes of the component descriptor digest (SHA-256, SHA-384 or SHA-512
+	//     based on the digest specification). Ed25519 hashes the message internally with SHA-512.
+	AlgorithmEd25519 SignatureAlgorithm = "Ed25519"
+)
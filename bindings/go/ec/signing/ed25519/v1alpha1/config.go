@@ -0,0 +1,38 @@
+package v1alpha1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const ConfigType = "Ed25519SigningConfiguration"
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	Scheme.MustRegisterWithAlias(&Config{},
+		runtime.NewUnversionedType(ConfigType),
+		runtime.NewVersionedType(ConfigType, Version),
+	)
+}
+
+// Config defines configuration for signing based on AlgorithmEd25519.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type Config struct {
+	// Type identifies this configuration object’s runtime type.
+	// +ocm:jsonschema-gen:enum=Ed25519SigningConfiguration/v1alpha1
+	// +ocm:jsonschema-gen:enum:deprecated=Ed25519SigningConfiguration
+	Type runtime.Type `json:"type"`
+
+	SignatureEncodingPolicy SignatureEncodingPolicy `json:"signatureEncodingPolicy,omitempty"`
+}
+
+func (cfg *Config) GetSignatureEncodingPolicy() SignatureEncodingPolicy {
+	if cfg == nil || cfg.SignatureEncodingPolicy == "" {
+		return SignatureEncodingPolicyDefault
+	}
+	return cfg.SignatureEncodingPolicy
+}
@@ -0,0 +1,138 @@
+// Package chain validates the X.509 certificate chains embedded in PEM
+// encoded signatures. The rules are the same as for the RSA signing handler:
+//   - the signer must not embed self-signed certificates,
+//   - a self-signed certificate from the verifier's credentials replaces the
+//     system roots as the only trust anchor,
+//   - the leaf must be valid for code signing,
+//   - an issuer declared on the signature must match the issuer of the leaf.
+package chain
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	signingpem "ocm.software/open-component-model/bindings/go/signing/pem"
+	"ocm.software/open-component-model/bindings/go/signing/rfc2253"
+)
+
+// VerifyPEMSignature parses the PEM encoded signature value, validates the
+// embedded chain together with the verifier-controlled credential chain and
+// checks the declared issuer. It returns the raw signature bytes, the
+// algorithm from the PEM header and the validated leaf certificate.
+func VerifyPEMSignature(
+	signed descruntime.Signature,
+	credChain []*x509.Certificate,
+	roots *x509.CertPool,
+	now time.Time,
+) (sig []byte, algorithm string, leaf *x509.Certificate, err error) {
+	sig, algorithm, embedded, err := signingpem.GetSignatureFromPem([]byte(signed.Signature.Value))
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("parse pem signature: %w", err)
+	}
+	if len(embedded) == 0 {
+		return nil, "", nil, errors.New("pem signature missing certificate chain")
+	}
+	leaf = embedded[0]
+
+	credIntermediates, credAnchor, err := classifyCredentialChain(credChain)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	// Merge embedded chain intermediates with credential intermediates.
+	intermediates := make([]*x509.Certificate, 0, len(embedded)-1+len(credIntermediates))
+	intermediates = append(intermediates, embedded[1:]...)
+	intermediates = append(intermediates, credIntermediates...)
+
+	if err := verifyChainWithOptionalAnchor(leaf, intermediates, credAnchor, roots, now); err != nil {
+		return nil, "", nil, fmt.Errorf("certificate verification failed: %w", err)
+	}
+
+	if err := verifyIssuerForLeafCert(signed, leaf); err != nil {
+		return nil, "", nil, fmt.Errorf("issuer verification based on leaf certificate failed: %w", err)
+	}
+
+	return sig, algorithm, leaf, nil
+}
+
+// isSelfSigned reports whether cert is self-signed, i.e. its signature can be
+// verified with its own public key.
+func isSelfSigned(cert *x509.Certificate) bool {
+	return cert.CheckSignatureFrom(cert) == nil
+}
+
+// classifyCredentialChain splits the verifier-controlled credential chain into
+// intermediates (non-self-signed) and an optional root anchor (the single
+// self-signed cert, which must appear last if present).
+func classifyCredentialChain(chain []*x509.Certificate) (intermediates []*x509.Certificate, anchor *x509.Certificate, err error) {
+	for i, c := range chain {
+		if isSelfSigned(c) {
+			if i != len(chain)-1 {
+				return nil, nil, fmt.Errorf("self-signed certificate %q at position %d must be the last certificate in the credential chain", c.Subject.String(), i)
+			}
+			anchor = c
+		} else {
+			intermediates = append(intermediates, c)
+		}
+	}
+	return intermediates, anchor, nil
+}
+
+// verifyChainWithOptionalAnchor validates leaf against a root pool.
+// If anchor is set, it is the only trust anchor and roots are ignored.
+// Self-signed certificates in intermediates are rejected, because a signer
+// must not be able to assert its own trust anchor.
+func verifyChainWithOptionalAnchor(
+	leaf *x509.Certificate,
+	intermediates []*x509.Certificate,
+	anchor *x509.Certificate,
+	roots *x509.CertPool,
+	now time.Time,
+) error {
+	if anchor != nil {
+		roots = x509.NewCertPool()
+		roots.AddCert(anchor)
+	} else if roots == nil {
+		roots = x509.NewCertPool()
+	}
+
+	ip := x509.NewCertPool()
+	for _, c := range intermediates {
+		if isSelfSigned(c) {
+			return fmt.Errorf("invalid certificate chain: self-signed certificate %q must not be embedded in the signature; supply root CAs via credentials instead", c.Subject.String())
+		}
+		ip.AddCert(c)
+	}
+
+	_, err := leaf.Verify(x509.VerifyOptions{
+		Intermediates: ip,
+		Roots:         roots,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		CurrentTime:   now,
+	})
+	return err
+}
+
+// verifyIssuerForLeafCert checks that the Issuer field declared in the signature
+// matches the X.509 Issuer of the leaf certificate. The check is skipped when
+// the Issuer field is empty.
+func verifyIssuerForLeafCert(signed descruntime.Signature, leaf *x509.Certificate) error {
+	iss := strings.TrimSpace(signed.Signature.Issuer)
+	if iss == "" {
+		return nil
+	}
+
+	want, err := rfc2253.Parse(iss)
+	if err != nil {
+		return fmt.Errorf("parsing issuer %q failed: %w", iss, err)
+	}
+
+	if err := rfc2253.Equal(want, leaf.Issuer); err != nil {
+		return fmt.Errorf("issuer mismatch between %q and %q: %w", want.String(), leaf.Issuer.String(), err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package credentials
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	eccredentialsv1 "ocm.software/open-component-model/bindings/go/ec/spec/credentials/v1"
+	signingpem "ocm.software/open-component-model/bindings/go/signing/pem"
+)
+
+// PrivateKeyFromCredentials returns the private key from the credentials as a
+// crypto.Signer, or nil if the credentials contain no private key.
+func PrivateKeyFromCredentials(creds *eccredentialsv1.ECCredentials) (crypto.Signer, error) {
+	if creds == nil {
+		return nil, nil
+	}
+	b, err := loadBytes(creds.PrivateKeyPEM, creds.PrivateKeyPEMFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading private key PEM: %w", err)
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+	return signingpem.ParsePrivateKeyPEM(b, func(k crypto.Signer) bool { return isSupportedPublicKey(k.Public()) }), nil
+}
+
+// PublicKeyFromCredentials returns the public key from the credentials. If no
+// public key is configured, it is derived from the private key.
+func PublicKeyFromCredentials(creds *eccredentialsv1.ECCredentials) (*signingpem.PublicKeyPEM, error) {
+	if creds == nil {
+		return nil, nil
+	}
+	b, err := loadBytes(creds.PublicKeyPEM, creds.PublicKeyPEMFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading public key PEM: %w", err)
+	}
+	if len(b) == 0 {
+		// fallback: derive from private
+		pk, err := PrivateKeyFromCredentials(creds)
+		if err != nil {
+			return nil, err
+		}
+		if pk == nil {
+			return nil, nil
+		}
+		return &signingpem.PublicKeyPEM{
+			PublicKey: pk.Public(),
+		}, nil
+	}
+	return signingpem.ParsePublicKeyPEM(b, isSupportedPublicKey), nil
+}
+
+// CertificateChainFromCredentials returns the certificate chain from the
+// public key fields of the credentials, if any.
+func CertificateChainFromCredentials(creds *eccredentialsv1.ECCredentials) ([]*x509.Certificate, error) {
+	if creds == nil {
+		return nil, nil
+	}
+	b, err := loadBytes(creds.PublicKeyPEM, creds.PublicKeyPEMFile)
+	if err != nil || len(b) == 0 {
+		return nil, nil
+	}
+	return signingpem.ParseCertificateChain(b)
+}
+
+// isSupportedPublicKey reports whether k is an ECDSA or Ed25519 public key.
+func isSupportedPublicKey(k crypto.PublicKey) bool {
+	switch k.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey:
+		return true
+	default:
+		return false
+	}
+}
+
+func loadBytes(inline, file string) ([]byte, error) {
+	if inline != "" {
+		// treat as literal bytes
+		return []byte(inline), nil
+	}
+	if file != "" {
+		return os.ReadFile(file)
+	}
+	return nil, nil
+}
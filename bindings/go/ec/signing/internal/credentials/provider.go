@@ -0,0 +1,59 @@
+package credentials
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"fmt"
+
+	eccredentialsv1 "ocm.software/open-component-model/bindings/go/ec/spec/credentials/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// SignerProvider resolves credentials that reference a private key held outside
+// the process, such as in a hardware security module, into a crypto.Signer and
+// the certificate chain to embed for PEM signatures.
+// It returns a nil signer if it does not handle the given credentials. The
+// returned release function is called once signing is done.
+type SignerProvider func(ctx context.Context, creds runtime.Typed) (signer crypto.Signer, chain []*x509.Certificate, release func() error, err error)
+
+// SignerFromCredentials returns the signer to use for creds and, if withChain
+// is set, the certificate chain to embed. The providers are asked first, then
+// the private key is read from EC credentials. A nil signer is returned if
+// there is no private key. The release function is nil if there is nothing to release.
+func SignerFromCredentials(
+	ctx context.Context,
+	providers []SignerProvider,
+	creds runtime.Typed,
+	withChain bool,
+) (crypto.Signer, []*x509.Certificate, func() error, error) {
+	for _, provide := range providers {
+		signer, chain, release, err := provide(ctx, creds)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("cannot load signer from credentials: %w", err)
+		}
+		if signer != nil {
+			return signer, chain, release, nil
+		}
+	}
+
+	if creds == nil {
+		return nil, nil, nil, nil
+	}
+	ecCreds, err := eccredentialsv1.ConvertToECCredentials(creds)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("parse ec credentials: %w", err)
+	}
+	signer, err := PrivateKeyFromCredentials(ecCreds)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot load private key from credentials for signing: %w", err)
+	}
+	if signer == nil || !withChain {
+		return signer, nil, nil, nil
+	}
+	chain, err := CertificateChainFromCredentials(ecCreds)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("read certificate chain: %w", err)
+	}
+	return signer, chain, nil, nil
+}
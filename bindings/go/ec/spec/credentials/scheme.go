@@ -0,0 +1,20 @@
+package credentials
+
+import (
+	"ocm.software/open-component-model/bindings/go/ec/spec/credentials/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	MustRegisterCredentialType(Scheme)
+}
+
+// MustRegisterCredentialType registers ECCredentials/v1 in the given scheme.
+func MustRegisterCredentialType(scheme *runtime.Scheme) {
+	scheme.MustRegisterWithAlias(&v1.ECCredentials{},
+		v1.VersionedType,
+		runtime.NewUnversionedType(v1.ECCredentialsType),
+	)
+}
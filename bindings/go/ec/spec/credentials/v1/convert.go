@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"fmt"
+
+	v1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+//nolint:gosec // G101: These are key names, not credentials.
+const (
+	credentialKeyPublicKeyPEM      = "publicKeyPEM"
+	credentialKeyPublicKeyPEMFile  = "publicKeyPEMFile"
+	credentialKeyPrivateKeyPEM     = "privateKeyPEM"
+	credentialKeyPrivateKeyPEMFile = "privateKeyPEMFile"
+)
+
+var convertScheme = runtime.NewScheme()
+
+func init() {
+	convertScheme.MustRegisterWithAlias(&ECCredentials{},
+		VersionedType,
+		runtime.NewUnversionedType(ECCredentialsType),
+	)
+	v1.MustRegister(convertScheme)
+}
+
+// ConvertToECCredentials converts [runtime.Typed] into [ECCredentials].
+// Direct conversion as well as converting from [v1.DirectCredentials] is supported.
+// Other supported [runtime.Typed] implementations are [runtime.Raw].
+// For unsupported [runtime.Typed] implementations, an error will be returned.
+func ConvertToECCredentials(creds runtime.Typed) (*ECCredentials, error) {
+	typed, err := convertScheme.NewObject(creds.GetType())
+	if err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+
+	if err = convertScheme.Convert(creds, typed); err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+
+	switch t := typed.(type) {
+	case *v1.DirectCredentials:
+		return fromDirectCredentials(t.Properties), nil
+	case *ECCredentials:
+		return t, nil
+	}
+
+	return nil, fmt.Errorf("unsupported credential type %v", typed.GetType())
+}
+
+// fromDirectCredentials converts a DirectCredentials properties map into typed ECCredentials.
+// A nil map is safe and returns an ECCredentials with only the type set.
+func fromDirectCredentials(properties map[string]string) *ECCredentials {
+	return &ECCredentials{
+		Type:              runtime.NewVersionedType(ECCredentialsType, Version),
+		PublicKeyPEM:      properties[credentialKeyPublicKeyPEM],
+		PublicKeyPEMFile:  properties[credentialKeyPublicKeyPEMFile],
+		PrivateKeyPEM:     properties[credentialKeyPrivateKeyPEM],
+		PrivateKeyPEMFile: properties[credentialKeyPrivateKeyPEMFile],
+	}
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+type fakeTyped struct{}
+
+func (f *fakeTyped) GetType() runtime.Type        { return runtime.NewUnversionedType("Unknown") }
+func (f *fakeTyped) SetType(_ runtime.Type)       {}
+func (f *fakeTyped) DeepCopyTyped() runtime.Typed { return &fakeTyped{} }
+
+func TestConvertToECCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   runtime.Typed
+		want    *ECCredentials
+		wantErr bool
+	}{
+		{
+			name: "ECCredentials passthrough",
+			input: &ECCredentials{
+				Type:             VersionedType,
+				PrivateKeyPEM:    "my-key",
+				PublicKeyPEMFile: "/path/pub.pem",
+			},
+			want: &ECCredentials{
+				Type:             VersionedType,
+				PrivateKeyPEM:    "my-key",
+				PublicKeyPEMFile: "/path/pub.pem",
+			},
+		},
+		{
+			name: "DirectCredentials",
+			input: &credv1.DirectCredentials{
+				Type: runtime.NewVersionedType(credv1.DirectCredentialsType, Version),
+				Properties: map[string]string{
+					credentialKeyPrivateKeyPEMFile: "/path/key.pem",
+					credentialKeyPublicKeyPEM:      "my-chain",
+				},
+			},
+			want: &ECCredentials{
+				Type:              VersionedType,
+				PrivateKeyPEMFile: "/path/key.pem",
+				PublicKeyPEM:      "my-chain",
+			},
+		},
+		{
+			name: "DirectCredentials with nil properties",
+			input: &credv1.DirectCredentials{
+				Type: runtime.NewVersionedType(credv1.DirectCredentialsType, Version),
+			},
+			want: &ECCredentials{
+				Type: VersionedType,
+			},
+		},
+		{
+			name: "raw ECCredentials",
+			input: &runtime.Raw{
+				Type: VersionedType,
+				Data: []byte(`{"type":"ECCredentials/v1","privateKeyPEMFile":"/path/key.pem"}`),
+			},
+			want: &ECCredentials{
+				Type:              VersionedType,
+				PrivateKeyPEMFile: "/path/key.pem",
+			},
+		},
+		{
+			name:    "unknown type returns error",
+			input:   &fakeTyped{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertToECCredentials(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package v1
+
+import "ocm.software/open-component-model/bindings/go/runtime"
+
+// MustRegisterIdentityType registers ECDSA/v1 and Ed25519/v1 (with unversioned aliases) in the given scheme.
+func MustRegisterIdentityType(scheme *runtime.Scheme) {
+	scheme.MustRegisterWithAlias(&ECDSAIdentity{},
+		ECDSAVersionedType,
+		ECDSAType, // unversioned alias
+	)
+	scheme.MustRegisterWithAlias(&Ed25519Identity{},
+		Ed25519VersionedType,
+		Ed25519Type, // unversioned alias
+	)
+}
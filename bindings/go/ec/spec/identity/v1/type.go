@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	ECDSAIdentityType   = "ECDSA"
+	Ed25519IdentityType = "Ed25519"
+	Version             = "v1"
+)
+
+// ECDSAType is the unversioned consumer identity type for ECDSA signing.
+var ECDSAType = runtime.NewUnversionedType(ECDSAIdentityType)
+
+// ECDSAVersionedType is the versioned consumer identity type for ECDSA signing.
+var ECDSAVersionedType = runtime.NewVersionedType(ECDSAIdentityType, Version)
+
+// Ed25519Type is the unversioned consumer identity type for Ed25519 signing.
+var Ed25519Type = runtime.NewUnversionedType(Ed25519IdentityType)
+
+// Ed25519VersionedType is the versioned consumer identity type for Ed25519 signing.
+var Ed25519VersionedType = runtime.NewVersionedType(Ed25519IdentityType, Version)
+
+// Identity attribute keys for elliptic-curve signing credentials.
+const (
+	IdentityAttributeAlgorithm = "algorithm"
+	IdentityAttributeSignature = "signature"
+)
+
+// ECDSAIdentity is the typed consumer identity for ECDSA signing handlers.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type ECDSAIdentity struct {
+	// +ocm:jsonschema-gen:enum=ECDSA/v1
+	// +ocm:jsonschema-gen:enum:deprecated=ECDSA
+	Type      runtime.Type `json:"type"`
+	Algorithm string       `json:"algorithm,omitempty"`
+	Signature string       `json:"signature,omitempty"`
+}
+
+// Ed25519Identity is the typed consumer identity for Ed25519 signing handlers.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type Ed25519Identity struct {
+	// +ocm:jsonschema-gen:enum=Ed25519/v1
+	// +ocm:jsonschema-gen:enum:deprecated=Ed25519
+	Type      runtime.Type `json:"type"`
+	Algorithm string       `json:"algorithm,omitempty"`
+	Signature string       `json:"signature,omitempty"`
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func TestMustRegisterIdentityType(t *testing.T) {
+	scheme := runtime.NewScheme()
+	MustRegisterIdentityType(scheme)
+
+	for _, typ := range []runtime.Type{ECDSAVersionedType, ECDSAType} {
+		assert.True(t, scheme.IsRegistered(typ))
+		obj, err := scheme.NewObject(typ)
+		require.NoError(t, err)
+		_, ok := obj.(*ECDSAIdentity)
+		assert.True(t, ok, "expected *ECDSAIdentity, got %T", obj)
+	}
+
+	for _, typ := range []runtime.Type{Ed25519VersionedType, Ed25519Type} {
+		assert.True(t, scheme.IsRegistered(typ))
+		obj, err := scheme.NewObject(typ)
+		require.NoError(t, err)
+		_, ok := obj.(*Ed25519Identity)
+		assert.True(t, ok, "expected *Ed25519Identity, got %T", obj)
+	}
+}
@@ -0,0 +1,56 @@
+package input
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	"ocm.software/open-component-model/bindings/go/blob/compression"
+	"ocm.software/open-component-model/bindings/go/blob/filesystem"
+	"ocm.software/open-component-model/bindings/go/blob/inmemory"
+	"ocm.software/open-component-model/bindings/go/git/internal"
+	"ocm.software/open-component-model/bindings/go/git/internal/gitcli"
+	v1 "ocm.software/open-component-model/bindings/go/git/spec/input/v1"
+)
+
+// Options configure how a git input is processed.
+type Options struct {
+	// WorkingDirectory is used to resolve relative local repository paths.
+	WorkingDirectory string
+	// TempFolder is the base directory for temporary repositories.
+	TempFolder string
+	// Credentials are used for repositories served via HTTP/S.
+	Credentials *gitcli.Credentials
+}
+
+// GetV1GitBlob creates a ReadOnlyBlob from a v1.Git specification.
+// It fetches the requested commit from the repository, archives its tree (or the configured
+// subdirectory) as tar and applies compression if requested.
+// Besides the blob, the resolved commit hash is returned.
+//
+// The archive is held in memory, as the temporary repository is removed before returning.
+func GetV1GitBlob(ctx context.Context, git v1.Git, opts Options) (blob.ReadOnlyBlob, string, error) {
+	if git.Repository == "" {
+		return nil, "", fmt.Errorf("git repository must not be empty")
+	}
+
+	var buf bytes.Buffer
+	commit, err := gitcli.Archive(ctx, &buf, gitcli.Options{
+		Repository:  internal.ResolveRepository(git.Repository, opts.WorkingDirectory),
+		Ref:         git.Ref,
+		Commit:      git.Commit,
+		Path:        git.Path,
+		TempFolder:  opts.TempFolder,
+		Credentials: opts.Credentials,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	var b blob.ReadOnlyBlob = inmemory.New(&buf, inmemory.WithMediaType(filesystem.DefaultTarMediaType))
+	if git.Compress {
+		b = compression.Compress(b)
+	}
+	return b, commit, nil
+}
@@ -0,0 +1,160 @@
+package input
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	"ocm.software/open-component-model/bindings/go/constructor"
+	constructorruntime "ocm.software/open-component-model/bindings/go/constructor/runtime"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/git/internal"
+	"ocm.software/open-component-model/bindings/go/git/spec/input"
+	v1 "ocm.software/open-component-model/bindings/go/git/spec/input/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// ErrLocalRepositoriesDoNotRequireCredentials is returned when credential-related operations are attempted
+// on repositories on the local filesystem, since these are accessed directly and do not require authentication.
+var ErrLocalRepositoriesDoNotRequireCredentials = errors.New("local git repositories do not require credentials")
+
+// CommitLabel is the label that records the archived commit on processed resources and sources.
+const CommitLabel = internal.CommitLabel
+
+var _ interface {
+	constructor.ResourceInputMethod
+	constructor.SourceInputMethod
+} = (*InputMethod)(nil)
+
+// InputMethod implements the ResourceInputMethod and SourceInputMethod interfaces for git inputs.
+// It archives the tree of a commit of a git repository as local blob. The commit is recorded in the
+// CommitLabel of the processed resource or source returned along with the blob, so that the content
+// can be traced back to its origin.
+//
+// Credentials are only requested for repositories served via HTTP/S, using the Git consumer identity
+// of the repository URL.
+type InputMethod struct {
+	// WorkingDirectory is the base directory used to resolve relative repository paths.
+	WorkingDirectory string
+	// TempFolder is used for the temporary repositories the trees are fetched into.
+	// If empty, the system's default temporary directory is used.
+	TempFolder string
+}
+
+// NewInputMethod creates a new InputMethod instance with the specified working directory.
+// If the working directory is empty, it defaults to the current working directory of the process.
+func NewInputMethod(workingDir string) (*InputMethod, error) {
+	if workingDir == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, fmt.Errorf("error getting current working directory: %w", err)
+		}
+		workingDir = wd
+	}
+	return &InputMethod{WorkingDirectory: workingDir}, nil
+}
+
+func (i *InputMethod) GetInputMethodScheme() *runtime.Scheme {
+	return input.Scheme
+}
+
+// GetResourceCredentialConsumerIdentity returns the Git consumer identity for remote repositories
+// or ErrLocalRepositoriesDoNotRequireCredentials for local ones.
+func (i *InputMethod) GetResourceCredentialConsumerIdentity(_ context.Context, resource *constructorruntime.Resource) (identity runtime.Identity, err error) {
+	return i.identity(resource.Input)
+}
+
+// ProcessResource archives the git tree referenced by the resource input and returns it together with
+// the processed resource recording the archived commit in its CommitLabel. The given resource is not modified.
+func (i *InputMethod) ProcessResource(ctx context.Context, resource *constructorruntime.Resource, credentials runtime.Typed) (result *constructor.ResourceInputMethodResult, err error) {
+	git := v1.Git{}
+	if err := i.GetInputMethodScheme().Convert(resource.Input, &git); err != nil {
+		return nil, fmt.Errorf("error converting resource input spec: %w", err)
+	}
+
+	gitBlob, commit, err := i.process(ctx, git, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("error getting git blob based on resource input specification: %w", err)
+	}
+	processed := constructorruntime.ConvertToDescriptorResource(resource)
+	if processed.Labels, err = withCommitLabel(processed.Labels, commit); err != nil {
+		return nil, err
+	}
+
+	return &constructor.ResourceInputMethodResult{
+		ProcessedResource: processed,
+		ProcessedBlobData: gitBlob,
+	}, nil
+}
+
+// GetSourceCredentialConsumerIdentity returns the Git consumer identity for remote repositories
+// or ErrLocalRepositoriesDoNotRequireCredentials for local ones.
+func (i *InputMethod) GetSourceCredentialConsumerIdentity(_ context.Context, src *constructorruntime.Source) (identity runtime.Identity, err error) {
+	return i.identity(src.Input)
+}
+
+// ProcessSource archives the git tree referenced by the source input and returns it together with
+// the processed source recording the archived commit in its CommitLabel. The given source is not modified.
+func (i *InputMethod) ProcessSource(ctx context.Context, src *constructorruntime.Source, credentials runtime.Typed) (result *constructor.SourceInputMethodResult, err error) {
+	git := v1.Git{}
+	if err := i.GetInputMethodScheme().Convert(src.Input, &git); err != nil {
+		return nil, fmt.Errorf("error converting source input spec: %w", err)
+	}
+
+	gitBlob, commit, err := i.process(ctx, git, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("error getting git blob based on source input specification: %w", err)
+	}
+	processed := constructorruntime.ConvertToDescriptorSource(src)
+	if processed.Labels, err = withCommitLabel(processed.Labels, commit); err != nil {
+		return nil, err
+	}
+
+	return &constructor.SourceInputMethodResult{
+		ProcessedSource:   processed,
+		ProcessedBlobData: gitBlob,
+	}, nil
+}
+
+func (i *InputMethod) identity(typed runtime.Typed) (runtime.Identity, error) {
+	git := v1.Git{}
+	if err := i.GetInputMethodScheme().Convert(typed, &git); err != nil {
+		return nil, fmt.Errorf("error converting input spec: %w", err)
+	}
+	if internal.IsLocal(git.Repository) {
+		return nil, ErrLocalRepositoriesDoNotRequireCredentials
+	}
+	return internal.CredentialConsumerIdentity(git.Repository)
+}
+
+func (i *InputMethod) process(ctx context.Context, git v1.Git, credentials runtime.Typed) (blob.ReadOnlyBlob, string, error) {
+	gitCreds, err := internal.ConvertCredentials(credentials)
+	if err != nil {
+		return nil, "", err
+	}
+	return GetV1GitBlob(ctx, git, Options{
+		WorkingDirectory: i.WorkingDirectory,
+		TempFolder:       i.TempFolder,
+		Credentials:      gitCreds,
+	})
+}
+
+// withCommitLabel returns a copy of the labels with the CommitLabel set to the given commit,
+// replacing an existing one.
+func withCommitLabel(labels []descriptor.Label, commit string) ([]descriptor.Label, error) {
+	value, err := json.Marshal(commit)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling commit label: %w", err)
+	}
+	label := descriptor.Label{Name: CommitLabel, Value: value}
+	labels = slices.Clone(labels)
+	if idx := slices.IndexFunc(labels, func(l descriptor.Label) bool { return l.Name == CommitLabel }); idx >= 0 {
+		labels[idx] = label
+		return labels, nil
+	}
+	return append(labels, label), nil
+}
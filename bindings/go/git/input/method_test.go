@@ -0,0 +1,316 @@
+package input_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	constructorruntime "ocm.software/open-component-model/bindings/go/constructor/runtime"
+	"ocm.software/open-component-model/bindings/go/git/input"
+	identityv1 "ocm.software/open-component-model/bindings/go/git/spec/identity/v1"
+	v1 "ocm.software/open-component-model/bindings/go/git/spec/input/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// newRepository creates a repository with two commits on branch main and returns its path
+// together with the hashes of the first and second commit.
+func newRepository(t *testing.T) (string, string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@ocm.software",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@ocm.software",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	git("init", "--quiet", "--initial-branch=main")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("v1"), 0o600))
+	git("add", ".")
+	git("commit", "--quiet", "-m", "first")
+	first := git("rev-parse", "HEAD")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "docs"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("v2"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "guide.md"), []byte("guide"), 0o600))
+	git("add", ".")
+	git("commit", "--quiet", "-m", "second")
+	second := git("rev-parse", "HEAD")
+	git("tag", "v1.0.0", first)
+
+	return dir, first, second
+}
+
+// readTar returns the regular files of the tar archive in b.
+func readTar(t *testing.T, b blob.ReadOnlyBlob, compressed bool) map[string]string {
+	t.Helper()
+	rc, err := b.ReadCloser()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = rc.Close() })
+
+	var r io.Reader = rc
+	if compressed {
+		gz, err := gzip.NewReader(rc)
+		require.NoError(t, err)
+		r = gz
+	}
+
+	files := map[string]string{}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(data)
+	}
+	return files
+}
+
+func TestGetV1GitBlob(t *testing.T) {
+	repo, first, second := newRepository(t)
+
+	tests := []struct {
+		name           string
+		spec           v1.Git
+		expectedCommit string
+		expectedFiles  map[string]string
+		expectedErr    string
+	}{
+		{
+			name:           "default branch",
+			spec:           v1.Git{Repository: repo},
+			expectedCommit: second,
+			expectedFiles:  map[string]string{"README.md": "v2", "docs/guide.md": "guide"},
+		},
+		{
+			name:           "branch",
+			spec:           v1.Git{Repository: repo, Ref: "refs/heads/main"},
+			expectedCommit: second,
+			expectedFiles:  map[string]string{"README.md": "v2", "docs/guide.md": "guide"},
+		},
+		{
+			name:           "tag",
+			spec:           v1.Git{Repository: repo, Ref: "refs/tags/v1.0.0"},
+			expectedCommit: first,
+			expectedFiles:  map[string]string{"README.md": "v1"},
+		},
+		{
+			name:           "commit takes precedence over ref",
+			spec:           v1.Git{Repository: "file://" + repo, Ref: "refs/heads/main", Commit: first},
+			expectedCommit: first,
+			expectedFiles:  map[string]string{"README.md": "v1"},
+		},
+		{
+			name:           "subdirectory",
+			spec:           v1.Git{Repository: repo, Path: "docs"},
+			expectedCommit: second,
+			expectedFiles:  map[string]string{"guide.md": "guide"},
+		},
+		{
+			name:        "unknown ref",
+			spec:        v1.Git{Repository: repo, Ref: "refs/heads/unknown"},
+			expectedErr: "error fetching",
+		},
+		{
+			name:        "unknown path",
+			spec:        v1.Git{Repository: repo, Path: "unknown"},
+			expectedErr: "error archiving",
+		},
+		{
+			name:        "empty repository",
+			spec:        v1.Git{},
+			expectedErr: "git repository must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, commit, err := input.GetV1GitBlob(t.Context(), tt.spec, input.Options{TempFolder: t.TempDir()})
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCommit, commit)
+			assert.Equal(t, tt.expectedFiles, readTar(t, b, false))
+
+			mediaType, known := b.(blob.MediaTypeAware).MediaType()
+			assert.True(t, known)
+			assert.Equal(t, "application/x-tar", mediaType)
+		})
+	}
+}
+
+func TestGetV1GitBlob_Reproducible(t *testing.T) {
+	repo, _, _ := newRepository(t)
+	opts := input.Options{TempFolder: t.TempDir()}
+
+	first, _, err := input.GetV1GitBlob(t.Context(), v1.Git{Repository: repo}, opts)
+	require.NoError(t, err)
+	second, _, err := input.GetV1GitBlob(t.Context(), v1.Git{Repository: repo}, opts)
+	require.NoError(t, err)
+
+	firstDigest, ok := first.(blob.DigestAware).Digest()
+	require.True(t, ok)
+	secondDigest, ok := second.(blob.DigestAware).Digest()
+	require.True(t, ok)
+	assert.Equal(t, firstDigest, secondDigest)
+}
+
+func TestInputMethod_ProcessResource(t *testing.T) {
+	repo, first, _ := newRepository(t)
+	method, err := input.NewInputMethod(filepath.Dir(repo))
+	require.NoError(t, err)
+	method.TempFolder = t.TempDir()
+
+	resource := &constructorruntime.Resource{
+		ElementMeta: constructorruntime.ElementMeta{
+			ObjectMeta: constructorruntime.ObjectMeta{
+				Name:   "sources",
+				Labels: []constructorruntime.Label{{Name: input.CommitLabel, Value: json.RawMessage(`"outdated"`)}},
+			},
+		},
+		Type: "blob",
+		AccessOrInput: constructorruntime.AccessOrInput{
+			Input: &v1.Git{
+				Type:       runtime.NewVersionedType(v1.Type, v1.Version),
+				Repository: filepath.Base(repo),
+				Ref:        "refs/tags/v1.0.0",
+				Compress:   true,
+			},
+		},
+	}
+
+	_, err = method.GetResourceCredentialConsumerIdentity(t.Context(), resource)
+	require.ErrorIs(t, err, input.ErrLocalRepositoriesDoNotRequireCredentials)
+
+	result, err := method.ProcessResource(t.Context(), resource, nil)
+	require.NoError(t, err)
+	require.NotNil(t, result.ProcessedBlobData)
+	assert.Equal(t, map[string]string{"README.md": "v1"}, readTar(t, result.ProcessedBlobData, true))
+
+	mediaType, _ := result.ProcessedBlobData.(blob.MediaTypeAware).MediaType()
+	assert.Equal(t, "application/x-tar+gzip", mediaType)
+
+	require.NotNil(t, result.ProcessedResource)
+	require.Len(t, result.ProcessedResource.Labels, 1)
+	assert.Equal(t, input.CommitLabel, result.ProcessedResource.Labels[0].Name)
+	assert.JSONEq(t, `"`+first+`"`, string(result.ProcessedResource.Labels[0].Value))
+
+	// the input resource is left untouched
+	require.Len(t, resource.Labels, 1)
+	assert.JSONEq(t, `"outdated"`, string(resource.Labels[0].Value))
+}
+
+func TestInputMethod_ProcessSource(t *testing.T) {
+	repo, _, second := newRepository(t)
+	method := &input.InputMethod{TempFolder: t.TempDir()}
+
+	src := &constructorruntime.Source{
+		ElementMeta: constructorruntime.ElementMeta{
+			ObjectMeta: constructorruntime.ObjectMeta{Name: "sources"},
+		},
+		Type: "git",
+		AccessOrInput: constructorruntime.AccessOrInput{
+			Input: &v1.Git{
+				Type:       runtime.NewUnversionedType(v1.LegacyType),
+				Repository: repo,
+				Path:       "docs",
+			},
+		},
+	}
+
+	result, err := method.ProcessSource(t.Context(), src, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"guide.md": "guide"}, readTar(t, result.ProcessedBlobData, false))
+
+	require.NotNil(t, result.ProcessedSource)
+	require.Len(t, result.ProcessedSource.Labels, 1)
+	assert.Equal(t, input.CommitLabel, result.ProcessedSource.Labels[0].Name)
+	assert.JSONEq(t, `"`+second+`"`, string(result.ProcessedSource.Labels[0].Value))
+	assert.Empty(t, src.Labels)
+}
+
+func TestInputMethod_CredentialConsumerIdentity(t *testing.T) {
+	method := &input.InputMethod{}
+
+	tests := []struct {
+		name        string
+		repository  string
+		expected    runtime.Identity
+		expectedErr error
+	}{
+		{
+			name:       "https repository",
+			repository: "https://github.com/open-component-model/open-component-model.git",
+			expected: runtime.Identity{
+				runtime.IdentityAttributeType:     identityv1.Type.String(),
+				runtime.IdentityAttributeScheme:   "https",
+				runtime.IdentityAttributeHostname: "github.com",
+				runtime.IdentityAttributePath:     "open-component-model/open-component-model.git",
+			},
+		},
+		{
+			name:        "local path",
+			repository:  "./repo",
+			expectedErr: input.ErrLocalRepositoriesDoNotRequireCredentials,
+		},
+		{
+			name:        "file url",
+			repository:  "file:///tmp/repo",
+			expectedErr: input.ErrLocalRepositoriesDoNotRequireCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := &constructorruntime.Source{
+				AccessOrInput: constructorruntime.AccessOrInput{
+					Input: &v1.Git{Type: runtime.NewVersionedType(v1.Type, v1.Version), Repository: tt.repository},
+				},
+			}
+			identity, err := method.GetSourceCredentialConsumerIdentity(t.Context(), src)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, identity)
+		})
+	}
+}
+
+func TestScheme_ResolvesAllGitInputAliases(t *testing.T) {
+	scheme := (&input.InputMethod{}).GetInputMethodScheme()
+	for _, typ := range []runtime.Type{
+		runtime.NewVersionedType(v1.Type, v1.Version),
+		runtime.NewUnversionedType(v1.Type),
+		runtime.NewVersionedType(v1.LegacyType, v1.Version),
+		runtime.NewUnversionedType(v1.LegacyType),
+	} {
+		obj, err := scheme.NewObject(typ)
+		require.NoError(t, err, typ.String())
+		assert.IsType(t, &v1.Git{}, obj)
+	}
+}
@@ -0,0 +1,158 @@
+// Package gitcli fetches and archives trees of git repositories with the git command line client.
+//
+// Every operation works on a fresh bare repository in a temporary directory, so neither the
+// user's working copies nor any global state (other than the git configuration) are touched.
+// Only the objects of the requested commit are fetched (shallow fetch).
+package gitcli
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// DefaultRef is fetched if neither a ref nor a commit is requested.
+const DefaultRef = "HEAD"
+
+// Credentials authenticate requests against git repositories served via HTTP/S.
+type Credentials struct {
+	Username string
+	Password string
+	Token    string
+}
+
+// Options describe the tree to fetch and archive.
+type Options struct {
+	// Repository is the URL or local path of the repository.
+	Repository string
+	// Ref is the branch or tag to fetch. Ignored if Commit is set.
+	Ref string
+	// Commit is the commit to fetch.
+	Commit string
+	// Path is an optional subdirectory of the tree to archive.
+	Path string
+	// TempFolder is the base directory for the temporary bare repository.
+	// If empty, the system's default temporary directory is used.
+	TempFolder string
+	// Credentials are used for HTTP/S repositories.
+	Credentials *Credentials
+}
+
+// Archive fetches the requested commit and writes its tree (or the subdirectory Path of it)
+// as a tar archive into w. The resolved commit hash is returned.
+//
+// The archive is created by `git archive`, which only depends on the tree and the commit time,
+// so archiving the same commit always results in the same bytes.
+func Archive(ctx context.Context, w io.Writer, opts Options) (commit string, err error) {
+	if opts.Repository == "" {
+		return "", fmt.Errorf("git repository is required")
+	}
+
+	dir, err := os.MkdirTemp(opts.TempFolder, "git-archive-*")
+	if err != nil {
+		return "", fmt.Errorf("error creating temporary directory for git repository: %w", err)
+	}
+	defer func() {
+		if rerr := os.RemoveAll(dir); rerr != nil {
+			slog.WarnContext(ctx, "failed to remove temporary git directory", "path", dir, "err", rerr)
+		}
+	}()
+
+	g := &client{dir: dir, credentials: opts.Credentials}
+
+	if _, err := g.run(ctx, nil, "init", "--quiet", "--bare"); err != nil {
+		return "", err
+	}
+
+	revision := opts.Commit
+	if revision == "" {
+		revision = opts.Ref
+	}
+	if revision == "" {
+		revision = DefaultRef
+	}
+	if _, err := g.run(ctx, nil, "fetch", "--quiet", "--no-tags", "--depth=1", "--", opts.Repository, revision); err != nil {
+		return "", fmt.Errorf("error fetching %q from git repository %q: %w", revision, opts.Repository, err)
+	}
+
+	out, err := g.run(ctx, nil, "rev-parse", "--verify", "FETCH_HEAD^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("error resolving fetched commit: %w", err)
+	}
+	commit = strings.TrimSpace(out)
+	if opts.Commit != "" && !strings.HasPrefix(commit, opts.Commit) {
+		return "", fmt.Errorf("fetched commit %q does not match requested commit %q", commit, opts.Commit)
+	}
+
+	treeish := commit
+	if path := strings.Trim(opts.Path, "/"); path != "" && path != "." {
+		treeish = commit + ":" + path
+	}
+	if _, err := g.run(ctx, w, "archive", "--format=tar", treeish); err != nil {
+		return "", fmt.Errorf("error archiving %q: %w", treeish, err)
+	}
+
+	return commit, nil
+}
+
+type client struct {
+	dir         string
+	credentials *Credentials
+}
+
+// run executes git in the bare repository. If stdout is nil, the output is returned as string.
+func (c *client) run(ctx context.Context, stdout io.Writer, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"--git-dir", c.dir}, args...)...)
+	cmd.Env = append(os.Environ(), c.env()...)
+
+	var out, stderr bytes.Buffer
+	if stdout == nil {
+		stdout = &out
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", fmt.Errorf("git %s failed: %s", args[0], strings.TrimSpace(stderr.String()))
+		}
+		return "", fmt.Errorf("git %s failed: %w", args[0], err)
+	}
+	return out.String(), nil
+}
+
+// env never prompts for credentials and passes the authorization header through
+// the environment, so that it does not show up in the process list.
+func (c *client) env() []string {
+	env := []string{"GIT_TERMINAL_PROMPT=0"}
+	if header := c.authorizationHeader(); header != "" {
+		env = append(env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0="+header,
+		)
+	}
+	return env
+}
+
+func (c *client) authorizationHeader() string {
+	switch {
+	case c.credentials == nil:
+		return ""
+	case c.credentials.Token != "":
+		return "Authorization: Bearer " + c.credentials.Token
+	case c.credentials.Username != "" || c.credentials.Password != "":
+		auth := base64.StdEncoding.EncodeToString([]byte(c.credentials.Username + ":" + c.credentials.Password))
+		return "Authorization: Basic " + auth
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"ocm.software/open-component-model/bindings/go/git/internal/gitcli"
+	credsv1 "ocm.software/open-component-model/bindings/go/git/spec/credentials/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/git/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// CommitLabel is the label recording the commit of the archived git tree.
+const CommitLabel = "git.ocm.software/commit"
+
+// scpLikeURL matches the scp-like syntax of ssh repositories such as git@github.com:org/repo.git.
+var scpLikeURL = regexp.MustCompile(`^[\w.-]+@[\w.-]+:`)
+
+// IsLocal reports whether the repository is a path on the local filesystem.
+func IsLocal(repository string) bool {
+	if strings.HasPrefix(repository, "file://") {
+		return true
+	}
+	return !strings.Contains(repository, "://") && !scpLikeURL.MatchString(repository)
+}
+
+// ResolveRepository resolves relative local repository paths against the working directory.
+// Remote repositories are returned unchanged.
+func ResolveRepository(repository, workingDirectory string) string {
+	if !IsLocal(repository) || strings.HasPrefix(repository, "file://") || filepath.IsAbs(repository) || workingDirectory == "" {
+		return repository
+	}
+	return filepath.Join(workingDirectory, repository)
+}
+
+// CredentialConsumerIdentity resolves the credential consumer identity for the
+// given git repository URL. Only repositories served via HTTP/S accept credentials,
+// for all others an error is returned.
+func CredentialConsumerIdentity(repository string) (runtime.Identity, error) {
+	if repository == "" {
+		return nil, fmt.Errorf("no git repository specified")
+	}
+	if !strings.HasPrefix(repository, "https://") && !strings.HasPrefix(repository, "http://") {
+		return nil, fmt.Errorf("credentials are only supported for git repositories served via HTTP/S, got %q", repository)
+	}
+
+	identity, err := runtime.ParseURLToIdentity(repository)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing git repository URL to identity: %w", err)
+	}
+	identity.SetType(identityv1.Type)
+	return identity, nil
+}
+
+// ConvertCredentials converts typed credentials into credentials of the git client.
+// Returns nil, nil if no credentials are given.
+func ConvertCredentials(credentials runtime.Typed) (*gitcli.Credentials, error) {
+	gitCreds, err := credsv1.ConvertToGitCredentials(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("error converting credentials: %w", err)
+	}
+	if gitCreds == nil {
+		return nil, nil
+	}
+	return &gitcli.Credentials{
+		Username: gitCreds.Username,
+		Password: gitCreds.Password,
+		Token:    gitCreds.Token,
+	}, nil
+}
@@ -0,0 +1,27 @@
+// Package resource implements [repository.ResourceRepository] for trees of git repositories.
+//
+// The [ResourceRepository] handles the Git/v1 access type. Downloading a resource fetches
+// the referenced commit (shallow) into a temporary bare repository and returns its tree,
+// or one of its subdirectories, as tar archive.
+//
+// # Credentials
+//
+// Repositories served via HTTP/S are resolved to a consumer identity of type Git carrying the
+// hostname, scheme, port and path of the repository URL. Credentials of type GitCredentials/v1
+// or DirectCredentials with username/password or token are supported.
+//
+// # Usage
+//
+//	repo := resource.NewResourceRepository(filesystemConfig)
+//
+//	identity, err := repo.GetResourceCredentialConsumerIdentity(ctx, res)
+//	tree, err := repo.DownloadResource(ctx, res, creds)
+//
+// # Registration
+//
+// In the CLI the repository is registered as a builtin plugin:
+//
+//	manager.ResourcePluginRegistry.RegisterInternalResourcePlugin(
+//	    resource.NewResourceRepository(filesystemConfig),
+//	)
+package resource
@@ -0,0 +1,116 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	"ocm.software/open-component-model/bindings/go/blob/filesystem"
+	"ocm.software/open-component-model/bindings/go/blob/inmemory"
+	filesystemv1alpha1 "ocm.software/open-component-model/bindings/go/configuration/filesystem/v1alpha1/spec"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/git/internal"
+	"ocm.software/open-component-model/bindings/go/git/internal/gitcli"
+	gitaccess "ocm.software/open-component-model/bindings/go/git/spec/access"
+	v1 "ocm.software/open-component-model/bindings/go/git/spec/access/v1"
+	"ocm.software/open-component-model/bindings/go/repository"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// ResourceRepository implements a resource repository for trees of git repositories.
+// It supports downloading the tree of a commit as tar archive and resolving
+// credential consumer identities for repositories served via HTTP/S.
+type ResourceRepository struct {
+	filesystemConfig *filesystemv1alpha1.Config
+}
+
+var _ repository.ResourceRepository = (*ResourceRepository)(nil)
+
+// NewResourceRepository creates a ResourceRepository. If filesystemConfig is non-nil,
+// its TempFolder is used for the temporary repositories trees are fetched into;
+// otherwise os.TempDir is used.
+func NewResourceRepository(filesystemConfig *filesystemv1alpha1.Config) *ResourceRepository {
+	if filesystemConfig == nil {
+		filesystemConfig = &filesystemv1alpha1.Config{}
+	}
+	return &ResourceRepository{
+		filesystemConfig: filesystemConfig,
+	}
+}
+
+// GetResourceRepositoryScheme returns the git access scheme containing the
+// Git/v1 type and its aliases.
+func (r *ResourceRepository) GetResourceRepositoryScheme() *runtime.Scheme {
+	return gitaccess.Scheme
+}
+
+// GetResourceCredentialConsumerIdentity resolves the Git credential consumer identity
+// for the repository of the given resource. Returns nil for local repositories.
+func (r *ResourceRepository) GetResourceCredentialConsumerIdentity(ctx context.Context, resource *descriptor.Resource) (runtime.Identity, error) {
+	git, err := r.convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if internal.IsLocal(git.Repository) {
+		slog.DebugContext(ctx, "local git repositories do not require credentials")
+		return nil, nil
+	}
+
+	return internal.CredentialConsumerIdentity(git.Repository)
+}
+
+// DownloadResource fetches the commit referenced by the git access and returns
+// its tree (or the configured subdirectory) as tar archive.
+// The archive is byte-identical to the one created by the git input method for the
+// same commit and path, so digests calculated during construction stay valid.
+func (r *ResourceRepository) DownloadResource(ctx context.Context, resource *descriptor.Resource, credentials runtime.Typed) (blob.ReadOnlyBlob, error) {
+	git, err := r.convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	gitCreds, err := internal.ConvertCredentials(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	commit, err := gitcli.Archive(ctx, &buf, gitcli.Options{
+		Repository:  git.Repository,
+		Ref:         git.Ref,
+		Commit:      git.Commit,
+		Path:        git.Path,
+		TempFolder:  r.filesystemConfig.TempFolder,
+		Credentials: gitCreds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error downloading git tree %q: %w", git.String(), err)
+	}
+
+	slog.DebugContext(ctx, "downloaded git tree", "repository", git.Repository, "commit", commit)
+
+	return inmemory.New(&buf, inmemory.WithMediaType(filesystem.DefaultTarMediaType)), nil
+}
+
+// UploadResource is not supported for git repositories and always returns an error.
+// Pushing commits is out of scope for a resource repository.
+func (r *ResourceRepository) UploadResource(_ context.Context, _ *descriptor.Resource, _ blob.ReadOnlyBlob, _ runtime.Typed) (*descriptor.Resource, error) {
+	return nil, fmt.Errorf("git repositories do not support upload operations")
+}
+
+func (r *ResourceRepository) convertAccess(resource *descriptor.Resource) (*v1.Git, error) {
+	if resource == nil || resource.Access == nil {
+		return nil, fmt.Errorf("resource access is required")
+	}
+	var git v1.Git
+	if err := gitaccess.Scheme.Convert(resource.Access, &git); err != nil {
+		return nil, fmt.Errorf("error converting access to git spec: %w", err)
+	}
+	if git.Repository == "" {
+		return nil, fmt.Errorf("git repository is required")
+	}
+	return &git, nil
+}
@@ -0,0 +1,114 @@
+package resource_test
+
+import (
+	"archive/tar"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	filesystemv1alpha1 "ocm.software/open-component-model/bindings/go/configuration/filesystem/v1alpha1/spec"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/git/repository/resource"
+	v1 "ocm.software/open-component-model/bindings/go/git/spec/access/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/git/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func newRepository(t *testing.T) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@ocm.software",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@ocm.software",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	git("init", "--quiet", "--initial-branch=main")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main"), 0o600))
+	git("add", ".")
+	git("commit", "--quiet", "-m", "initial")
+	return dir, git("rev-parse", "HEAD")
+}
+
+func newResource(access *v1.Git) *descriptor.Resource {
+	access.Type = runtime.NewVersionedType(v1.Type, v1.Version)
+	return &descriptor.Resource{
+		ElementMeta: descriptor.ElementMeta{
+			ObjectMeta: descriptor.ObjectMeta{Name: "sources", Version: "1.0.0"},
+		},
+		Type:     "blob",
+		Relation: descriptor.ExternalRelation,
+		Access:   access,
+	}
+}
+
+func TestResourceRepository_DownloadResource(t *testing.T) {
+	dir, commit := newRepository(t)
+	repo := resource.NewResourceRepository(&filesystemv1alpha1.Config{TempFolder: t.TempDir()})
+
+	b, err := repo.DownloadResource(t.Context(), newResource(&v1.Git{Repository: dir, Commit: commit, Path: "src"}), nil)
+	require.NoError(t, err)
+
+	rc, err := b.ReadCloser()
+	require.NoError(t, err)
+	defer func() { _ = rc.Close() }()
+
+	var names []string
+	tr := tar.NewReader(rc)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+	assert.Equal(t, []string{"main.go"}, names)
+}
+
+func TestResourceRepository_DownloadResource_Errors(t *testing.T) {
+	dir, _ := newRepository(t)
+	repo := resource.NewResourceRepository(nil)
+
+	_, err := repo.DownloadResource(t.Context(), newResource(&v1.Git{}), nil)
+	require.ErrorContains(t, err, "git repository is required")
+
+	_, err = repo.DownloadResource(t.Context(), newResource(&v1.Git{Repository: dir, Commit: strings.Repeat("0", 40)}), nil)
+	require.ErrorContains(t, err, "error downloading git tree")
+}
+
+func TestResourceRepository_GetResourceCredentialConsumerIdentity(t *testing.T) {
+	repo := resource.NewResourceRepository(nil)
+
+	identity, err := repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource(&v1.Git{Repository: "https://git.example.com:8443/org/repo.git"}))
+	require.NoError(t, err)
+	assert.Equal(t, runtime.Identity{
+		runtime.IdentityAttributeType:     identityv1.Type.String(),
+		runtime.IdentityAttributeScheme:   "https",
+		runtime.IdentityAttributeHostname: "git.example.com",
+		runtime.IdentityAttributePort:     "8443",
+		runtime.IdentityAttributePath:     "org/repo.git",
+	}, identity)
+
+	identity, err = repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource(&v1.Git{Repository: "/tmp/repo"}))
+	require.NoError(t, err)
+	assert.Nil(t, identity)
+}
+
+func TestResourceRepository_UploadResource(t *testing.T) {
+	repo := resource.NewResourceRepository(nil)
+	_, err := repo.UploadResource(t.Context(), newResource(&v1.Git{Repository: "/tmp/repo"}), nil, nil)
+	require.ErrorContains(t, err, "do not support upload")
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	Version    = "v1"
+	Type       = "Git"
+	LegacyType = "git"
+)
+
+// Git describes the access for a tree of a git repository at a given commit.
+// This spec is aligned with ocm v1 https://github.com/open-component-model/ocm/blob/main/api/ocm/extensions/accessmethods/git/method.go
+//
+// Exactly one commit is referenced by the access. If Commit is set, it is used as is.
+// Otherwise, Ref is resolved to a commit during download. Access specifications created
+// by the constructor always contain the commit so that the content is immutable.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type Git struct {
+	// +ocm:jsonschema-gen:enum=Git/v1,git/v1
+	// +ocm:jsonschema-gen:enum:deprecated=Git,git
+	Type runtime.Type `json:"type"`
+
+	// Repository is the URL of the git repository (https://, ssh://, file:// or a local path).
+	Repository string `json:"repository"`
+
+	// Ref is a branch or tag of the repository, such as refs/heads/main or refs/tags/v1.0.0.
+	// If neither Ref nor Commit is set, the default branch (HEAD) of the repository is used.
+	Ref string `json:"ref,omitempty"`
+
+	// Commit is the full commit hash of the tree to access.
+	Commit string `json:"commit,omitempty"`
+
+	// Path is an optional subdirectory of the repository that is accessed instead of the whole tree.
+	Path string `json:"path,omitempty"`
+}
+
+func (g *Git) String() string {
+	revision := g.Commit
+	if revision == "" {
+		revision = g.Ref
+	}
+	if revision == "" {
+		return g.Repository
+	}
+	return g.Repository + "@" + revision
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"fmt"
+
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	credentialKeyUsername = "username"
+	credentialKeyPassword = "password"
+	credentialKeyToken    = "token"
+)
+
+var convertScheme = runtime.NewScheme()
+
+func init() {
+	MustRegisterCredentialType(convertScheme)
+	credv1.MustRegister(convertScheme)
+}
+
+func directToGitCredentials(properties map[string]string) *GitCredentials {
+	return &GitCredentials{
+		Type:     runtime.NewVersionedType(GitCredentialsType, Version),
+		Username: properties[credentialKeyUsername],
+		Password: properties[credentialKeyPassword],
+		Token:    properties[credentialKeyToken],
+	}
+}
+
+// ConvertToGitCredentials converts runtime.Typed credentials into *GitCredentials.
+// DirectCredentials are mapped using the git-relevant fields (username, password, token).
+// Returns nil, nil for nil input or input with an empty type.
+func ConvertToGitCredentials(creds runtime.Typed) (*GitCredentials, error) {
+	if creds == nil || creds.GetType().String() == "" {
+		return nil, nil
+	}
+	typed, err := convertScheme.NewObject(creds.GetType())
+	if err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+	if err = convertScheme.Convert(creds, typed); err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+	switch t := typed.(type) {
+	case *credv1.DirectCredentials:
+		return directToGitCredentials(t.Properties), nil
+	case *GitCredentials:
+		return t, nil
+	}
+	return nil, fmt.Errorf("unsupported credential type for git: %v", typed.GetType())
+}
@@ -0,0 +1,67 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	v1 "ocm.software/open-component-model/bindings/go/git/spec/credentials/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func TestConvertToGitCredentials(t *testing.T) {
+	tests := []struct {
+		name        string
+		creds       runtime.Typed
+		expected    *v1.GitCredentials
+		expectedErr string
+	}{
+		{
+			name:  "nil credentials",
+			creds: nil,
+		},
+		{
+			name: "direct credentials",
+			creds: &credv1.DirectCredentials{
+				Type:       runtime.NewVersionedType(credv1.CredentialsType, credv1.Version),
+				Properties: map[string]string{"username": "user", "password": "pass", "token": "tok", "other": "ignored"},
+			},
+			expected: &v1.GitCredentials{
+				Type:     runtime.NewVersionedType(v1.GitCredentialsType, v1.Version),
+				Username: "user",
+				Password: "pass",
+				Token:    "tok",
+			},
+		},
+		{
+			name: "typed credentials",
+			creds: &v1.GitCredentials{
+				Type:  runtime.NewUnversionedType(v1.GitCredentialsType),
+				Token: "tok",
+			},
+			expected: &v1.GitCredentials{
+				Type:  runtime.NewUnversionedType(v1.GitCredentialsType),
+				Token: "tok",
+			},
+		},
+		{
+			name:        "unsupported credentials",
+			creds:       &runtime.Raw{Type: runtime.NewVersionedType("OCICredentials", "v1"), Data: []byte(`{"type":"OCICredentials/v1"}`)},
+			expectedErr: "error converting credential type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := v1.ConvertToGitCredentials(tt.creds)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, creds)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	//nolint:gosec // G101: This is a type name, not a credential.
+	GitCredentialsType = "GitCredentials"
+	Version            = "v1"
+)
+
+// GitCredentials represents typed credentials for git repositories accessed via HTTP/S.
+// Either Username and Password, or Token can be set. A token is sent as bearer token,
+// username and password are sent with basic authentication.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type GitCredentials struct {
+	// +ocm:jsonschema-gen:enum=GitCredentials/v1
+	// +ocm:jsonschema-gen:enum:deprecated=GitCredentials
+	Type     runtime.Type `json:"type"`
+	Username string       `json:"username,omitempty"`
+	Password string       `json:"password,omitempty"`
+	Token    string       `json:"token,omitempty"`
+}
+
+// MustRegisterCredentialType registers GitCredentials/v1 in the given scheme.
+func MustRegisterCredentialType(scheme *runtime.Scheme) {
+	scheme.MustRegisterWithAlias(&GitCredentials{},
+		runtime.NewVersionedType(GitCredentialsType, Version),
+		runtime.NewUnversionedType(GitCredentialsType),
+	)
+}
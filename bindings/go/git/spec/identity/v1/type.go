@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	GitRepositoryIdentityType = "Git"
+	Version                   = "v1"
+)
+
+// Type is the unversioned consumer identity type for git repositories (backward compat).
+var Type = runtime.NewUnversionedType(GitRepositoryIdentityType)
+
+// VersionedType is the versioned consumer identity type for git repositories.
+// Identities of this type carry the hostname, scheme, port and path of the repository URL.
+var VersionedType = runtime.NewVersionedType(GitRepositoryIdentityType, Version)
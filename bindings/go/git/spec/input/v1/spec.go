@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	Version    = "v1"
+	Type       = "Git"
+	LegacyType = "git"
+)
+
+// Git describes an input sourced from a git repository.
+// The tree of the resolved commit (or one of its subdirectories) is archived as a tar
+// and the commit is recorded in the label git.ocm.software/commit of the processed element.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type Git struct {
+	// +ocm:jsonschema-gen:enum=Git/v1,git/v1
+	// +ocm:jsonschema-gen:enum:deprecated=Git,git
+	Type runtime.Type `json:"type"`
+
+	// Repository is the URL of the git repository (https://, ssh://, file:// or a local path).
+	// Relative local paths are resolved against the working directory of the constructor.
+	Repository string `json:"repository"`
+
+	// Ref is a branch or tag of the repository, such as refs/heads/main or refs/tags/v1.0.0.
+	// If neither Ref nor Commit is set, the default branch (HEAD) of the repository is used.
+	Ref string `json:"ref,omitempty"`
+
+	// Commit is the full commit hash to archive. If set, Ref is ignored.
+	Commit string `json:"commit,omitempty"`
+
+	// Path is an optional subdirectory of the repository. Only its content is added to the archive.
+	Path string `json:"path,omitempty"`
+
+	// Compress indicates whether the resulting tar should be compressed with gzip.
+	// If set to true, adds a +gzip suffix to the media type.
+	Compress bool `json:"compress,omitempty"`
+}
+
+func (g *Git) String() string {
+	return g.Repository
+}
@@ -0,0 +1,199 @@
+package ociimage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ociImageSpecV1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	v1 "ocm.software/open-component-model/bindings/go/input/ociimage/spec/v1"
+	"ocm.software/open-component-model/bindings/go/oci/looseref"
+	ocicredsv1 "ocm.software/open-component-model/bindings/go/oci/spec/credentials/v1"
+	"ocm.software/open-component-model/bindings/go/oci/tar"
+)
+
+// Options configures how GetV1OCIImageBlob reads an OCI image.
+type Options struct {
+	// WorkingDirectory is used to resolve relative paths of OCI layouts and docker archives.
+	// Paths are not allowed to escape the working directory if it is set.
+	WorkingDirectory string
+	// TempFolder is used to buffer the resulting OCI layout while it is being written.
+	TempFolder string
+	// Credentials are used to authenticate against remote registries.
+	Credentials *ocicredsv1.OCICredentials
+	// HTTPConfig configures the client used to access remote registries.
+	HTTPConfig *httpv1alpha1.Config
+	// UserAgent is sent with all requests against remote registries.
+	UserAgent string
+}
+
+// Option is a function that modifies Options.
+type Option func(options *Options)
+
+// WithWorkingDirectory sets the directory relative paths are resolved against.
+func WithWorkingDirectory(dir string) Option {
+	return func(options *Options) {
+		options.WorkingDirectory = dir
+	}
+}
+
+// WithTempFolder sets the folder used for temporary data while building the OCI layout.
+func WithTempFolder(dir string) Option {
+	return func(options *Options) {
+		options.TempFolder = dir
+	}
+}
+
+// WithCredentials sets the credentials to use for a remote registry.
+func WithCredentials(credentials *ocicredsv1.OCICredentials) Option {
+	return func(options *Options) {
+		options.Credentials = credentials
+	}
+}
+
+// WithHTTPConfig sets the HTTP client configuration used for remote registry access.
+func WithHTTPConfig(cfg *httpv1alpha1.Config) Option {
+	return func(options *Options) {
+		options.HTTPConfig = cfg
+	}
+}
+
+// WithUserAgent sets the user agent used for remote registry access.
+func WithUserAgent(userAgent string) Option {
+	return func(options *Options) {
+		options.UserAgent = userAgent
+	}
+}
+
+// GetV1OCIImageBlob creates a ReadOnlyBlob from a v1.OCIImage specification.
+// The referenced image or index is copied with all of its content into an OCI layout archive,
+// so the resulting blob carries the media type [layout.MediaTypeOCIImageLayoutTarGzipV1]
+// and can later be uploaded to any OCI based repository as a regular artifact.
+//
+// The function performs the following steps:
+//  1. Determines the source of the image (OCI layout, docker-archive or registry)
+//  2. Opens a read-only store on top of that source and resolves the top-level descriptor
+//  3. Narrows down image indexes to the requested platforms, if any
+//  4. Copies the resulting graph into an OCI layout tarball, tagged with the resolved tag (if any)
+//
+// [layout.MediaTypeOCIImageLayoutTarGzipV1]: https://pkg.go.dev/ocm.software/open-component-model/bindings/go/oci/spec/layout
+func GetV1OCIImageBlob(ctx context.Context, image v1.OCIImage, opts ...Option) (_ blob.ReadOnlyBlob, err error) {
+	options := &Options{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if image.Path == "" {
+		return nil, fmt.Errorf("path must not be empty")
+	}
+
+	src, err := openSource(ctx, image, options)
+	if err != nil {
+		return nil, err
+	}
+
+	root, store, err := selectPlatforms(ctx, src.store, src.root, image.Platforms)
+	if err != nil {
+		_ = src.Close()
+		return nil, err
+	}
+
+	var tags []string
+	if src.tag != "" {
+		tags = append(tags, src.tag)
+	}
+
+	b, err := tar.CopyToOCILayoutInMemory(ctx, store, root, tar.CopyToOCILayoutOptions{
+		Tags:    tags,
+		TempDir: options.TempFolder,
+	})
+	if err != nil {
+		_ = src.Close()
+		return nil, fmt.Errorf("failed to copy image into oci layout: %w", err)
+	}
+
+	// the copy runs asynchronously, so the source can only be released once the layout is fully written.
+	if err := b.Load(); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to create oci layout from %q: %w", image.Path, err), src.Close())
+	}
+	if err := src.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close image source %q: %w", image.Path, err)
+	}
+
+	return b, nil
+}
+
+// source is an opened location of an OCI image with its resolved top-level descriptor.
+type source struct {
+	store content.ReadOnlyStorage
+	root  ociImageSpecV1.Descriptor
+	// tag is the tag the artifact was resolved with, if any.
+	tag   string
+	close func() error
+}
+
+func (s *source) Close() error {
+	if s.close != nil {
+		return s.close()
+	}
+	return nil
+}
+
+// detectSource determines the source of the image if it is not explicitly specified.
+// Existing directories are interpreted as OCI layouts and existing files as docker archives.
+// Paths that don't exist locally are only interpreted as registry reference if they name a registry and a tag or digest,
+// so a mistyped local path fails with a clear error instead of a network error against a registry.
+func detectSource(image v1.OCIImage, workingDirectory string) (v1.Source, error) {
+	if image.Source != "" {
+		return image.Source, nil
+	}
+	path, err := resolveLocalPath(image.Path, workingDirectory)
+	if err == nil {
+		var fi os.FileInfo
+		if fi, err = os.Stat(path); err == nil {
+			if fi.IsDir() {
+				return v1.SourceOCILayout, nil
+			}
+			return v1.SourceDockerArchive, nil
+		}
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("failed to access oci image path %q: %w", image.Path, err)
+	}
+	if filepath.IsAbs(image.Path) || strings.HasPrefix(image.Path, ".") || !isRegistryReference(image.Path) {
+		return "", fmt.Errorf("oci image path %q does not exist and is not a registry reference with tag or digest, "+
+			"set the source to %q explicitly to pull it from a registry", image.Path, v1.SourceRegistry)
+	}
+	return v1.SourceRegistry, nil
+}
+
+// isRegistryReference reports whether the path is an image reference naming a registry and a tag or digest.
+func isRegistryReference(path string) bool {
+	ref, err := looseref.ParseReference(path)
+	return err == nil && ref.Registry != "" && ref.ReferenceOrTag() != ""
+}
+
+func openSource(ctx context.Context, image v1.OCIImage, options *Options) (*source, error) {
+	src, err := detectSource(image, options.WorkingDirectory)
+	if err != nil {
+		return nil, err
+	}
+	switch src {
+	case v1.SourceOCILayout:
+		return openOCILayoutDirectory(ctx, image, options.WorkingDirectory)
+	case v1.SourceDockerArchive:
+		return openDockerArchive(ctx, image, options.WorkingDirectory)
+	case v1.SourceRegistry:
+		return openRegistry(ctx, image, options)
+	default:
+		return nil, fmt.Errorf("unsupported oci image source %q", src)
+	}
+}
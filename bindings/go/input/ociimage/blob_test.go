@@ -0,0 +1,551 @@
+package ociimage_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ociImageSpecV1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/oci"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	constructorruntime "ocm.software/open-component-model/bindings/go/constructor/runtime"
+	"ocm.software/open-component-model/bindings/go/input/ociimage"
+	v1 "ocm.software/open-component-model/bindings/go/input/ociimage/spec/v1"
+	"ocm.software/open-component-model/bindings/go/oci/spec/layout"
+	ocitar "ocm.software/open-component-model/bindings/go/oci/tar"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func TestGetV1OCIImageBlob_OCILayout(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	manifest := pushImage(t, ctx, dir, "1.0.0", nil)
+
+	b, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: dir}, ociimage.WithTempFolder(t.TempDir()))
+	require.NoError(t, err)
+
+	index := requireLayout(t, ctx, b)
+	require.Len(t, index.Manifests, 1)
+	assert.Equal(t, manifest.Digest, index.Manifests[0].Digest)
+	assert.Equal(t, ociImageSpecV1.MediaTypeImageManifest, index.Manifests[0].MediaType)
+	assert.Equal(t, "1.0.0", index.Manifests[0].Annotations[ociImageSpecV1.AnnotationRefName])
+}
+
+func TestGetV1OCIImageBlob_OCILayout_Reference(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	first := pushImage(t, ctx, dir, "1.0.0", nil)
+	second := pushImage(t, ctx, dir, "2.0.0", nil)
+
+	_, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: dir})
+	require.ErrorContains(t, err, "a reference is required")
+
+	for _, tc := range []struct {
+		reference string
+		expected  ociImageSpecV1.Descriptor
+	}{
+		{reference: "1.0.0", expected: first},
+		{reference: "2.0.0", expected: second},
+		{reference: first.Digest.String(), expected: first},
+	} {
+		t.Run(tc.reference, func(t *testing.T) {
+			b, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: dir, Source: v1.SourceOCILayout, Reference: tc.reference})
+			require.NoError(t, err)
+			index := requireLayout(t, ctx, b)
+			require.Len(t, index.Manifests, 1)
+			assert.Equal(t, tc.expected.Digest, index.Manifests[0].Digest)
+		})
+	}
+}
+
+func TestGetV1OCIImageBlob_Platforms(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	amd64 := pushImage(t, ctx, dir, "", &ociImageSpecV1.Platform{OS: "linux", Architecture: "amd64"})
+	arm64 := pushImage(t, ctx, dir, "", &ociImageSpecV1.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"})
+	s390x := pushImage(t, ctx, dir, "", &ociImageSpecV1.Platform{OS: "linux", Architecture: "s390x"})
+
+	store, err := oci.New(dir)
+	require.NoError(t, err)
+	index := pushJSON(t, ctx, store, ociImageSpecV1.MediaTypeImageIndex, ociImageSpecV1.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ociImageSpecV1.MediaTypeImageIndex,
+		Manifests: []ociImageSpecV1.Descriptor{amd64, arm64, s390x},
+	})
+	require.NoError(t, store.Tag(ctx, index, "multi"))
+
+	tests := []struct {
+		name      string
+		platforms []string
+		check     func(t *testing.T, root ociImageSpecV1.Descriptor, store content.Fetcher)
+		wantErr   string
+	}{
+		{
+			name: "no selection keeps index",
+			check: func(t *testing.T, root ociImageSpecV1.Descriptor, _ content.Fetcher) {
+				assert.Equal(t, index.Digest, root.Digest)
+			},
+		},
+		{
+			name:      "single platform selects manifest",
+			platforms: []string{"linux/arm64"},
+			check: func(t *testing.T, root ociImageSpecV1.Descriptor, _ content.Fetcher) {
+				assert.Equal(t, arm64.Digest, root.Digest)
+				assert.Equal(t, ociImageSpecV1.MediaTypeImageManifest, root.MediaType)
+			},
+		},
+		{
+			name:      "multiple platforms create filtered index",
+			platforms: []string{"linux/amd64", "linux/arm64/v8"},
+			check: func(t *testing.T, root ociImageSpecV1.Descriptor, fetcher content.Fetcher) {
+				assert.Equal(t, ociImageSpecV1.MediaTypeImageIndex, root.MediaType)
+				assert.NotEqual(t, index.Digest, root.Digest)
+
+				raw, err := content.FetchAll(t.Context(), fetcher, root)
+				require.NoError(t, err)
+				var filtered ociImageSpecV1.Index
+				require.NoError(t, json.Unmarshal(raw, &filtered))
+				require.Len(t, filtered.Manifests, 2)
+				assert.Equal(t, amd64.Digest, filtered.Manifests[0].Digest)
+				assert.Equal(t, arm64.Digest, filtered.Manifests[1].Digest)
+			},
+		},
+		{
+			name:      "variant mismatch",
+			platforms: []string{"linux/arm64/v7"},
+			wantErr:   "no manifest in image index matches platforms",
+		},
+		{
+			name:      "invalid platform",
+			platforms: []string{"linux"},
+			wantErr:   "invalid platform",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: dir, Reference: "multi", Platforms: tt.platforms})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			layoutStore, err := ocitar.ReadOCILayout(ctx, b)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, layoutStore.Close())
+			})
+			tt.check(t, requireTaggedRoot(t, layoutStore.Index, "multi"), layoutStore)
+		})
+	}
+}
+
+func TestGetV1OCIImageBlob_PlatformsDockerManifestList(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	amd64 := pushImage(t, ctx, dir, "", &ociImageSpecV1.Platform{OS: "linux", Architecture: "amd64"})
+	arm64 := pushImage(t, ctx, dir, "", &ociImageSpecV1.Platform{OS: "linux", Architecture: "arm64"})
+	s390x := pushImage(t, ctx, dir, "", &ociImageSpecV1.Platform{OS: "linux", Architecture: "s390x"})
+
+	store, err := oci.New(dir)
+	require.NoError(t, err)
+	list := pushJSON(t, ctx, store, mediaTypeDockerManifestList, ociImageSpecV1.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: mediaTypeDockerManifestList,
+		Manifests: []ociImageSpecV1.Descriptor{amd64, arm64, s390x},
+	})
+	require.NoError(t, store.Tag(ctx, list, "list"))
+
+	t.Run("single platform selects manifest", func(t *testing.T) {
+		b, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: dir, Reference: "list", Platforms: []string{"linux/arm64"}})
+		require.NoError(t, err)
+		layoutStore, err := ocitar.ReadOCILayout(ctx, b)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, layoutStore.Close())
+		})
+		assert.Equal(t, arm64.Digest, requireTaggedRoot(t, layoutStore.Index, "list").Digest)
+	})
+
+	t.Run("multiple platforms keep the manifest list media type", func(t *testing.T) {
+		b, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: dir, Reference: "list", Platforms: []string{"linux/amd64", "linux/s390x"}})
+		require.NoError(t, err)
+		layoutStore, err := ocitar.ReadOCILayout(ctx, b)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, layoutStore.Close())
+		})
+		root := requireTaggedRoot(t, layoutStore.Index, "list")
+		assert.Equal(t, mediaTypeDockerManifestList, root.MediaType)
+
+		raw, err := content.FetchAll(ctx, layoutStore, root)
+		require.NoError(t, err)
+		var filtered ociImageSpecV1.Index
+		require.NoError(t, json.Unmarshal(raw, &filtered))
+		assert.Equal(t, mediaTypeDockerManifestList, filtered.MediaType)
+		require.Len(t, filtered.Manifests, 2)
+		assert.Equal(t, amd64.Digest, filtered.Manifests[0].Digest)
+		assert.Equal(t, s390x.Digest, filtered.Manifests[1].Digest)
+	})
+}
+
+func TestGetV1OCIImageBlob_PlatformsRequireIndex(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+	pushImage(t, ctx, dir, "1.0.0", nil)
+
+	_, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: dir, Platforms: []string{"linux/amd64"}})
+	require.ErrorContains(t, err, "platform selection requires an image index")
+}
+
+func TestGetV1OCIImageBlob_DockerArchive(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+
+	config := []byte(`{"architecture":"amd64","os":"linux","rootfs":{"type":"layers","diff_ids":[]}}`)
+	layer := []byte("not really a tar, but good enough for a test")
+	archive := filepath.Join(dir, "image.tar")
+	writeTar(t, archive, map[string][]byte{
+		"manifest.json": mustJSON(t, []map[string]any{
+			{
+				"Config":   "config.json",
+				"RepoTags": []string{"ghcr.io/acme/app:1.0.0"},
+				"Layers":   []string{"layer/layer.tar"},
+			},
+			{
+				"Config":   "config.json",
+				"RepoTags": []string{"ghcr.io/acme/app:2.0.0"},
+				"Layers":   []string{},
+			},
+		}),
+		"config.json":     config,
+		"layer/layer.tar": layer,
+	})
+
+	_, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: archive})
+	require.ErrorContains(t, err, "a reference is required")
+
+	for _, reference := range []string{"1.0.0", "ghcr.io/acme/app:1.0.0"} {
+		t.Run(reference, func(t *testing.T) {
+			b, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: archive, Reference: reference})
+			require.NoError(t, err)
+
+			layoutStore, err := ocitar.ReadOCILayout(ctx, b)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, layoutStore.Close())
+			})
+			require.Len(t, layoutStore.Index.Manifests, 1)
+			root := layoutStore.Index.Manifests[0]
+			assert.Equal(t, "1.0.0", root.Annotations[ociImageSpecV1.AnnotationRefName])
+
+			raw, err := content.FetchAll(ctx, layoutStore, root)
+			require.NoError(t, err)
+			var manifest ociImageSpecV1.Manifest
+			require.NoError(t, json.Unmarshal(raw, &manifest))
+			assert.Equal(t, ociImageSpecV1.MediaTypeImageConfig, manifest.Config.MediaType)
+			require.Len(t, manifest.Layers, 1)
+			assert.Equal(t, ociImageSpecV1.MediaTypeImageLayer, manifest.Layers[0].MediaType)
+
+			data, err := content.FetchAll(ctx, layoutStore, manifest.Layers[0])
+			require.NoError(t, err)
+			assert.Equal(t, layer, data)
+		})
+	}
+
+	_, err = ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: archive, Reference: "3.0.0"})
+	require.ErrorContains(t, err, "no image with reference")
+}
+
+func TestGetV1OCIImageBlob_WorkingDirectory(t *testing.T) {
+	ctx := t.Context()
+	wd := t.TempDir()
+	layoutDir := filepath.Join(wd, "layout")
+	pushImage(t, ctx, layoutDir, "1.0.0", nil)
+
+	b, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: "layout"}, ociimage.WithWorkingDirectory(wd))
+	require.NoError(t, err)
+	requireLayout(t, ctx, b)
+
+	_, err = ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: "../outside", Source: v1.SourceOCILayout}, ociimage.WithWorkingDirectory(wd))
+	require.Error(t, err)
+}
+
+func TestGetV1OCIImageBlob_Registry(t *testing.T) {
+	ctx := t.Context()
+	registry := newTestRegistry(t, "acme/app")
+	layer := registry.push(ociImageSpecV1.MediaTypeImageLayer, []byte("layer"))
+	config := registry.push(ociImageSpecV1.MediaTypeImageConfig, []byte(`{"architecture":"amd64","os":"linux"}`))
+	manifest := registry.push(ociImageSpecV1.MediaTypeImageManifest, mustJSON(t, ociImageSpecV1.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ociImageSpecV1.MediaTypeImageManifest,
+		Config:    config,
+		Layers:    []ociImageSpecV1.Descriptor{layer},
+	}))
+	registry.tags["1.0.0"] = manifest.Digest
+
+	for _, reference := range []string{"1.0.0", manifest.Digest.String()} {
+		t.Run(reference, func(t *testing.T) {
+			separator := ":"
+			if reference == manifest.Digest.String() {
+				separator = "@"
+			}
+			b, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: registry.url + "/acme/app" + separator + reference},
+				ociimage.WithUserAgent("ociimage-test"))
+			require.NoError(t, err)
+
+			index := requireLayout(t, ctx, b)
+			require.Len(t, index.Manifests, 1)
+			assert.Equal(t, manifest.Digest, index.Manifests[0].Digest)
+			assert.Equal(t, "ociimage-test", registry.userAgent)
+		})
+	}
+
+	t.Run("unknown tag", func(t *testing.T) {
+		_, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: registry.url + "/acme/app:2.0.0"})
+		require.ErrorContains(t, err, "failed to resolve")
+	})
+
+	t.Run("reference without tag or digest", func(t *testing.T) {
+		_, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: registry.url + "/acme/app", Source: v1.SourceRegistry})
+		require.ErrorContains(t, err, "must contain a tag or digest")
+	})
+}
+
+func TestGetV1OCIImageBlob_MissingLocalPath(t *testing.T) {
+	ctx := t.Context()
+	wd := t.TempDir()
+
+	for _, path := range []string{
+		filepath.Join(wd, "missing.tar"),
+		"./missing",
+		"missing.tar",
+		"build/image",
+	} {
+		t.Run(path, func(t *testing.T) {
+			_, err := ociimage.GetV1OCIImageBlob(ctx, v1.OCIImage{Path: path}, ociimage.WithWorkingDirectory(wd))
+			require.ErrorContains(t, err, "does not exist and is not a registry reference")
+		})
+	}
+}
+
+func TestInputMethod_ProcessResource(t *testing.T) {
+	ctx := t.Context()
+	wd := t.TempDir()
+	pushImage(t, ctx, filepath.Join(wd, "layout"), "1.0.0", nil)
+
+	method, err := ociimage.NewInputMethod(wd)
+	require.NoError(t, err)
+
+	resource := &constructorruntime.Resource{
+		AccessOrInput: constructorruntime.AccessOrInput{
+			Input: &runtime.Raw{
+				Type: runtime.NewVersionedType(v1.Type, v1.Version),
+				Data: []byte(`{"type":"OCIImage/v1","path":"layout"}`),
+			},
+		},
+	}
+
+	_, err = method.GetResourceCredentialConsumerIdentity(ctx, resource)
+	require.ErrorIs(t, err, ociimage.ErrLocalImagesDoNotRequireCredentials)
+
+	result, err := method.ProcessResource(ctx, resource, nil)
+	require.NoError(t, err)
+	requireLayout(t, ctx, result.ProcessedBlobData)
+}
+
+func TestInputMethod_RegistryIdentity(t *testing.T) {
+	method, err := ociimage.NewInputMethod(t.TempDir())
+	require.NoError(t, err)
+
+	identity, err := method.GetResourceCredentialConsumerIdentity(t.Context(), &constructorruntime.Resource{
+		AccessOrInput: constructorruntime.AccessOrInput{
+			Input: &v1.OCIImage{
+				Type: runtime.NewVersionedType(v1.Type, v1.Version),
+				Path: "ghcr.io/open-component-model/ocm:1.0.0",
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ghcr.io", identity[runtime.IdentityAttributeHostname])
+	assert.Equal(t, "OCIRegistry", identity.GetType().Name)
+}
+
+func TestScheme_ResolvesAllOCIImageInputAliases(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  runtime.Type
+	}{
+		{"versioned", runtime.NewVersionedType(v1.Type, v1.Version)},
+		{"unversioned", runtime.NewUnversionedType(v1.Type)},
+		{"legacy versioned", runtime.NewVersionedType(v1.LegacyType, v1.Version)},
+		{"legacy unversioned", runtime.NewUnversionedType(v1.LegacyType)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj, err := ociimage.Scheme.NewObject(tt.typ)
+			require.NoError(t, err)
+			require.IsType(t, &v1.OCIImage{}, obj)
+		})
+	}
+}
+
+// mediaTypeDockerManifestList is the media type of docker manifest lists as produced by most registries and `docker save`.
+const mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+// testRegistry is a minimal read-only OCI distribution API serving a single repository from memory.
+type testRegistry struct {
+	url       string
+	blobs     map[digest.Digest]ociImageSpecV1.Descriptor
+	data      map[digest.Digest][]byte
+	tags      map[string]digest.Digest
+	userAgent string
+}
+
+func newTestRegistry(t *testing.T, repository string) *testRegistry {
+	t.Helper()
+	registry := &testRegistry{
+		blobs: map[digest.Digest]ociImageSpecV1.Descriptor{},
+		data:  map[digest.Digest][]byte{},
+		tags:  map[string]digest.Digest{},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		registry.userAgent = r.UserAgent()
+		if r.URL.Path == "/v2/" {
+			return
+		}
+		rest, ok := strings.CutPrefix(r.URL.Path, "/v2/"+repository+"/")
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		kind, reference, _ := strings.Cut(rest, "/")
+		dgst := digest.Digest(reference)
+		if tagged, ok := registry.tags[reference]; ok && kind == "manifests" {
+			dgst = tagged
+		}
+		desc, ok := registry.blobs[dgst]
+		if !ok || (kind != "manifests" && kind != "blobs") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", desc.MediaType)
+		w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+		w.Header().Set("Content-Length", strconv.FormatInt(desc.Size, 10))
+		if r.Method != http.MethodHead {
+			_, _ = w.Write(registry.data[dgst])
+		}
+	}))
+	t.Cleanup(server.Close)
+	registry.url = server.URL
+	return registry
+}
+
+func (r *testRegistry) push(mediaType string, data []byte) ociImageSpecV1.Descriptor {
+	desc := content.NewDescriptorFromBytes(mediaType, data)
+	r.blobs[desc.Digest] = desc
+	r.data[desc.Digest] = data
+	return desc
+}
+
+// pushImage pushes a minimal image into the OCI layout at dir and optionally tags it.
+func pushImage(t *testing.T, ctx context.Context, dir, tag string, platform *ociImageSpecV1.Platform) ociImageSpecV1.Descriptor {
+	t.Helper()
+	store, err := oci.New(dir)
+	require.NoError(t, err)
+
+	name := tag
+	if platform != nil {
+		name = platform.Architecture + platform.Variant
+	}
+	layer := pushBlob(t, ctx, store, ociImageSpecV1.MediaTypeImageLayer, []byte("layer "+name))
+	config := pushBlob(t, ctx, store, ociImageSpecV1.MediaTypeImageConfig, []byte(`{"architecture":"`+name+`"}`))
+	manifest := pushJSON(t, ctx, store, ociImageSpecV1.MediaTypeImageManifest, ociImageSpecV1.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ociImageSpecV1.MediaTypeImageManifest,
+		Config:    config,
+		Layers:    []ociImageSpecV1.Descriptor{layer},
+	})
+	manifest.Platform = platform
+	if tag != "" {
+		require.NoError(t, store.Tag(ctx, manifest, tag))
+	}
+	return manifest
+}
+
+func pushBlob(t *testing.T, ctx context.Context, store content.Pusher, mediaType string, data []byte) ociImageSpecV1.Descriptor {
+	t.Helper()
+	desc := content.NewDescriptorFromBytes(mediaType, data)
+	require.NoError(t, store.Push(ctx, desc, bytes.NewReader(data)))
+	return desc
+}
+
+func pushJSON(t *testing.T, ctx context.Context, store content.Pusher, mediaType string, v any) ociImageSpecV1.Descriptor {
+	t.Helper()
+	return pushBlob(t, ctx, store, mediaType, mustJSON(t, v))
+}
+
+func mustJSON(t *testing.T, v any) []byte {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
+
+func writeTar(t *testing.T, path string, files map[string][]byte) {
+	t.Helper()
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	tw := tar.NewWriter(f)
+	for name, data := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, f.Close())
+}
+
+// requireTaggedRoot returns the descriptor in the layout index that is tagged with the given tag.
+// Besides the tagged root, the index of a written layout also lists all nested manifests.
+func requireTaggedRoot(t *testing.T, index ociImageSpecV1.Index, tag string) ociImageSpecV1.Descriptor {
+	t.Helper()
+	for _, desc := range index.Manifests {
+		if desc.Annotations[ociImageSpecV1.AnnotationRefName] == tag {
+			return desc
+		}
+	}
+	require.Failf(t, "no tagged root", "no descriptor tagged with %q in layout index", tag)
+	return ociImageSpecV1.Descriptor{}
+}
+
+// requireLayout checks that the blob is an OCI layout with the expected media type and returns its index.
+func requireLayout(t *testing.T, ctx context.Context, b blob.ReadOnlyBlob) ociImageSpecV1.Index {
+	t.Helper()
+	require.Implements(t, (*blob.MediaTypeAware)(nil), b)
+	mediaType, ok := b.(blob.MediaTypeAware).MediaType()
+	require.True(t, ok)
+	assert.Equal(t, layout.MediaTypeOCIImageLayoutTarGzipV1, mediaType)
+
+	store, err := ocitar.ReadOCILayout(ctx, b)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, store.Close())
+	})
+	return store.Index
+}
@@ -0,0 +1,23 @@
+// Package ociimage provides functionality for embedding OCI images in the Open Component Model (OCM) constructor.
+//
+// This package implements input methods for both resources and sources that are backed by OCI images
+// or image indexes. Instead of referencing the image in its registry, the complete artifact graph is
+// copied into an OCI layout archive that is added to the component version as local blob.
+//
+// Key Features:
+//   - Images from local OCI layout directories
+//   - Images from docker-archive tarballs (as written by `docker save`), including archives in OCI layout format
+//   - Images from remote OCI registries, with credentials resolved for the registry hosting the image
+//   - Selection of platforms from multi-arch image indexes
+//   - The resulting blob always uses the OCI layout media type, so it can be uploaded as a regular OCI artifact later on
+//
+// Example:
+//
+//	result, err := (&ociimage.InputMethod{}).ProcessResource(ctx, resource, nil)
+//
+// The package uses the v1.OCIImage specification which includes:
+//   - Path: The image reference or the filesystem path of the OCI layout or docker-archive
+//   - Source: Optional explicit source (ociLayout, dockerArchive or registry), auto-detected if not provided
+//   - Reference: Optional tag or digest selecting an artifact within an OCI layout or docker-archive
+//   - Platforms: Optional list of platforms to select from an image index
+package ociimage
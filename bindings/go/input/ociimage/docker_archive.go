@@ -0,0 +1,228 @@
+package ociimage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/nlepage/go-tarfs"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ociImageSpecV1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+
+	v1 "ocm.software/open-component-model/bindings/go/input/ociimage/spec/v1"
+)
+
+// dockerArchiveManifestFile is the file name of the manifest in a docker-archive as written by `docker save`.
+const dockerArchiveManifestFile = "manifest.json"
+
+// dockerArchiveManifest is a single entry of the manifest.json of a docker-archive.
+type dockerArchiveManifest struct {
+	Config   string   `json:"Config"`
+	RepoTags []string `json:"RepoTags"`
+	Layers   []string `json:"Layers"`
+}
+
+// openDockerArchive opens a tarball as produced by `docker save`.
+// Archives written by recent docker versions already contain an OCI layout and are read as such.
+// Older archives only contain a docker manifest.json, which is converted into an OCI image manifest
+// on the fly. The layer and config blobs are served directly from the archive.
+func openDockerArchive(ctx context.Context, image v1.OCIImage, workingDirectory string) (_ *source, err error) {
+	path, err := resolveLocalPath(image.Path, workingDirectory)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open docker archive %q: %w", image.Path, err)
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, file.Close())
+		}
+	}()
+
+	fsys, err := tarfs.New(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read docker archive %q: %w", image.Path, err)
+	}
+
+	var src *source
+	if _, statErr := fs.Stat(fsys, ociImageSpecV1.ImageLayoutFile); statErr == nil {
+		src, err = openOCILayoutFS(ctx, fsys, image.Reference)
+	} else {
+		src, err = openLegacyDockerArchive(ctx, fsys, image.Reference)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open docker archive %q: %w", image.Path, err)
+	}
+	src.close = file.Close
+	return src, nil
+}
+
+// openLegacyDockerArchive converts the manifest.json of a docker-archive into an OCI image manifest.
+func openLegacyDockerArchive(ctx context.Context, fsys fs.FS, reference string) (*source, error) {
+	raw, err := fs.ReadFile(fsys, dockerArchiveManifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dockerArchiveManifestFile, err)
+	}
+	var manifests []dockerArchiveManifest
+	if err := json.Unmarshal(raw, &manifests); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", dockerArchiveManifestFile, err)
+	}
+
+	entry, tag, err := selectDockerArchiveManifest(manifests, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	store := &archiveStore{fsys: fsys, files: make(map[digest.Digest]string), raw: make(map[digest.Digest][]byte)}
+
+	config, err := store.addFile(entry.Config, ociImageSpecV1.MediaTypeImageConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add config: %w", err)
+	}
+	layers := make([]ociImageSpecV1.Descriptor, 0, len(entry.Layers))
+	for _, layer := range entry.Layers {
+		mediaType, err := detectLayerMediaType(fsys, layer)
+		if err != nil {
+			return nil, err
+		}
+		desc, err := store.addFile(layer, mediaType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add layer: %w", err)
+		}
+		layers = append(layers, desc)
+	}
+
+	manifest := ociImageSpecV1.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ociImageSpecV1.MediaTypeImageManifest,
+		Config:    config,
+		Layers:    layers,
+	}
+	manifestRaw, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal image manifest: %w", err)
+	}
+	root := content.NewDescriptorFromBytes(ociImageSpecV1.MediaTypeImageManifest, manifestRaw)
+	store.raw[root.Digest] = manifestRaw
+
+	return &source{store: store, root: root, tag: tag}, nil
+}
+
+// selectDockerArchiveManifest picks the manifest entry matching the given reference.
+// The reference may either be a full repository tag (e.g. ghcr.io/acme/app:1.0.0) or only its tag (e.g. 1.0.0).
+// Without reference, the archive must contain exactly one image.
+func selectDockerArchiveManifest(manifests []dockerArchiveManifest, reference string) (dockerArchiveManifest, string, error) {
+	if reference == "" {
+		if len(manifests) != 1 {
+			return dockerArchiveManifest{}, "", fmt.Errorf("docker archive contains %d images, a reference is required to select one of them", len(manifests))
+		}
+		var tag string
+		if len(manifests[0].RepoTags) > 0 {
+			tag = tagOf(manifests[0].RepoTags[0])
+		}
+		return manifests[0], tag, nil
+	}
+	for _, manifest := range manifests {
+		if slices.ContainsFunc(manifest.RepoTags, func(repoTag string) bool {
+			return repoTag == reference || tagOf(repoTag) == reference
+		}) {
+			return manifest, tagOf(reference), nil
+		}
+	}
+	return dockerArchiveManifest{}, "", fmt.Errorf("no image with reference %q found in docker archive", reference)
+}
+
+// tagOf returns the tag part of a repository tag such as registry:5000/repo:tag.
+func tagOf(repoTag string) string {
+	idx := strings.LastIndex(repoTag, ":")
+	if idx < 0 || strings.Contains(repoTag[idx:], "/") {
+		return repoTag
+	}
+	return repoTag[idx+1:]
+}
+
+// detectLayerMediaType checks whether a layer in a docker-archive is gzip compressed.
+func detectLayerMediaType(fsys fs.FS, name string) (_ string, err error) {
+	f, err := fsys.Open(path.Clean(name))
+	if err != nil {
+		return "", fmt.Errorf("failed to open layer %q: %w", name, err)
+	}
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+	header, err := bufio.NewReader(f).Peek(2)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read layer %q: %w", name, err)
+	}
+	if bytes.Equal(header, []byte{0x1f, 0x8b}) {
+		return ociImageSpecV1.MediaTypeImageLayerGzip, nil
+	}
+	return ociImageSpecV1.MediaTypeImageLayer, nil
+}
+
+// archiveStore is a read-only store serving blobs from files of a docker-archive
+// as well as generated content (such as the converted manifest) from memory.
+type archiveStore struct {
+	fsys  fs.FS
+	files map[digest.Digest]string
+	raw   map[digest.Digest][]byte
+}
+
+var _ content.ReadOnlyStorage = (*archiveStore)(nil)
+
+// addFile registers a file of the archive under its digest and returns its descriptor.
+func (s *archiveStore) addFile(name, mediaType string) (_ ociImageSpecV1.Descriptor, err error) {
+	name = path.Clean(name)
+	f, err := s.fsys.Open(name)
+	if err != nil {
+		return ociImageSpecV1.Descriptor{}, fmt.Errorf("failed to open %q: %w", name, err)
+	}
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+	digester := digest.Canonical.Digester()
+	size, err := io.Copy(digester.Hash(), f)
+	if err != nil {
+		return ociImageSpecV1.Descriptor{}, fmt.Errorf("failed to digest %q: %w", name, err)
+	}
+	dgst := digester.Digest()
+	s.files[dgst] = name
+	return ociImageSpecV1.Descriptor{
+		MediaType: mediaType,
+		Digest:    dgst,
+		Size:      size,
+	}, nil
+}
+
+func (s *archiveStore) Exists(_ context.Context, target ociImageSpecV1.Descriptor) (bool, error) {
+	if _, ok := s.raw[target.Digest]; ok {
+		return true, nil
+	}
+	_, ok := s.files[target.Digest]
+	return ok, nil
+}
+
+func (s *archiveStore) Fetch(_ context.Context, target ociImageSpecV1.Descriptor) (io.ReadCloser, error) {
+	if raw, ok := s.raw[target.Digest]; ok {
+		return io.NopCloser(bytes.NewReader(raw)), nil
+	}
+	name, ok := s.files[target.Digest]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", target.Digest, errdef.ErrNotFound)
+	}
+	return s.fsys.Open(name)
+}
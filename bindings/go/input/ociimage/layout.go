@@ -0,0 +1,103 @@
+package ociimage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/opencontainers/go-digest"
+	ociImageSpecV1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content/oci"
+
+	v1 "ocm.software/open-component-model/bindings/go/input/ociimage/spec/v1"
+	"ocm.software/open-component-model/bindings/go/oci/tar"
+)
+
+// openOCILayoutDirectory opens a directory following the OCI image layout specification.
+func openOCILayoutDirectory(ctx context.Context, image v1.OCIImage, workingDirectory string) (*source, error) {
+	path, err := resolveLocalPath(image.Path, workingDirectory)
+	if err != nil {
+		return nil, err
+	}
+	src, err := openOCILayoutFS(ctx, os.DirFS(path), image.Reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open oci layout %q: %w", image.Path, err)
+	}
+	return src, nil
+}
+
+// openOCILayoutFS opens an OCI layout on top of the given filesystem and resolves its top-level artifact.
+// If reference is set, it is resolved as tag or digest within the layout.
+// Otherwise, the layout is expected to contain exactly one top-level artifact.
+func openOCILayoutFS(ctx context.Context, fsys fs.FS, reference string) (*source, error) {
+	store, err := oci.NewFromFS(ctx, fsys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read oci layout: %w", err)
+	}
+
+	if reference != "" {
+		root, err := store.Resolve(ctx, reference)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve reference %q in oci layout: %w", reference, err)
+		}
+		src := &source{store: store, root: root}
+		if _, err := digest.Parse(reference); err != nil {
+			src.tag = reference
+		}
+		return src, nil
+	}
+
+	raw, err := fs.ReadFile(fsys, ociImageSpecV1.ImageIndexFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read oci layout index: %w", err)
+	}
+	var index ociImageSpecV1.Index
+	if err := json.Unmarshal(raw, &index); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal oci layout index: %w", err)
+	}
+
+	topLevel := tar.TopLevelArtifacts(ctx, store, index.Manifests)
+	switch len(topLevel) {
+	case 0:
+		return nil, fmt.Errorf("oci layout does not contain any artifact")
+	case 1:
+	default:
+		return nil, fmt.Errorf("oci layout contains %d top-level artifacts, a reference is required to select one of them", len(topLevel))
+	}
+
+	root := topLevel[0]
+	src := &source{store: store, root: root, tag: root.Annotations[ociImageSpecV1.AnnotationRefName]}
+	// the ref name annotation is only meaningful inside the index of the layout and must not be part of the copied descriptor.
+	delete(src.root.Annotations, ociImageSpecV1.AnnotationRefName)
+	if len(src.root.Annotations) == 0 {
+		src.root.Annotations = nil
+	}
+	return src, nil
+}
+
+// resolveLocalPath resolves the given path against the working directory.
+// If a working directory is set, the path is not allowed to escape it.
+func resolveLocalPath(path, workingDirectory string) (string, error) {
+	if workingDirectory == "" {
+		return path, nil
+	}
+	rel := path
+	if filepath.IsAbs(path) {
+		var err error
+		if rel, err = filepath.Rel(workingDirectory, path); err != nil {
+			return "", fmt.Errorf("failed to create relative path for %q based on working directory %q: %w", path, workingDirectory, err)
+		}
+	}
+	root, err := os.OpenRoot(workingDirectory)
+	if err != nil {
+		return "", fmt.Errorf("failed to open working directory %q: %w", workingDirectory, err)
+	}
+	defer root.Close()
+	if _, err := root.Stat(rel); err != nil {
+		return "", fmt.Errorf("failed to access path %q in root %q: %w", path, workingDirectory, err)
+	}
+	return filepath.Join(workingDirectory, rel), nil
+}
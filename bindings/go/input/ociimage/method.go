@@ -0,0 +1,175 @@
+package ociimage
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"ocm.software/open-component-model/bindings/go/constructor"
+	constructorruntime "ocm.software/open-component-model/bindings/go/constructor/runtime"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	v1 "ocm.software/open-component-model/bindings/go/input/ociimage/spec/v1"
+	"ocm.software/open-component-model/bindings/go/oci/looseref"
+	ocicredsv1 "ocm.software/open-component-model/bindings/go/oci/spec/credentials/v1"
+	credidentityv1 "ocm.software/open-component-model/bindings/go/oci/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// ErrLocalImagesDoNotRequireCredentials is returned when credential-related operations are attempted
+// on images read from an OCI layout or docker-archive, since these are accessed directly from the local
+// filesystem and do not require authentication or authorization.
+var ErrLocalImagesDoNotRequireCredentials = fmt.Errorf("local oci images do not require credentials")
+
+var _ interface {
+	constructor.ResourceInputMethod
+	constructor.SourceInputMethod
+} = (*InputMethod)(nil)
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	Scheme.MustRegisterWithAlias(&v1.OCIImage{},
+		runtime.NewVersionedType(v1.Type, v1.Version),
+		runtime.NewUnversionedType(v1.Type),
+		runtime.NewVersionedType(v1.LegacyType, v1.Version),
+		runtime.NewUnversionedType(v1.LegacyType),
+	)
+}
+
+// InputMethod implements the ResourceInputMethod and SourceInputMethod interfaces
+// for OCI image inputs. It embeds images and image indexes from OCI layouts, docker-archives
+// or remote registries as local blobs in OCI layout format.
+//
+// Credentials are only required for images pulled from remote registries.
+// They are requested with the OCI registry consumer identity of the registry hosting the image.
+type InputMethod struct {
+	// WorkingDirectory is the base directory used to resolve relative paths in input specifications.
+	WorkingDirectory string
+	// TempFolder is used for temporary data while creating the OCI layout.
+	// If empty, the system's default temporary directory is used.
+	TempFolder string
+	// HTTPConfig configures the client used to access remote registries.
+	HTTPConfig *httpv1alpha1.Config
+	// UserAgent is sent with all requests against remote registries.
+	UserAgent string
+}
+
+// NewInputMethod creates a new InputMethod instance with the specified working directory.
+// If the working directory is empty, it defaults to the current working directory of the process.
+func NewInputMethod(workingDir string) (*InputMethod, error) {
+	if workingDir == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, fmt.Errorf("error getting current working directory: %w", err)
+		}
+		workingDir = wd
+	}
+
+	return &InputMethod{
+		WorkingDirectory: workingDir,
+	}, nil
+}
+
+func (i *InputMethod) GetInputMethodScheme() *runtime.Scheme {
+	return Scheme
+}
+
+// GetResourceCredentialConsumerIdentity returns the OCI registry identity for images pulled from
+// a remote registry, or ErrLocalImagesDoNotRequireCredentials for images read from the filesystem.
+func (i *InputMethod) GetResourceCredentialConsumerIdentity(_ context.Context, resource *constructorruntime.Resource) (identity runtime.Identity, err error) {
+	return i.identity(resource.Input)
+}
+
+// ProcessResource processes an OCI image resource input by converting the input specification
+// to a v1.OCIImage format, reading the image from its source and returning it as OCI layout blob.
+func (i *InputMethod) ProcessResource(ctx context.Context, resource *constructorruntime.Resource, credentials runtime.Typed) (result *constructor.ResourceInputMethodResult, err error) {
+	image := v1.OCIImage{}
+	if err := i.GetInputMethodScheme().Convert(resource.Input, &image); err != nil {
+		return nil, fmt.Errorf("error converting resource input spec: %w", err)
+	}
+
+	opts, err := i.options(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	imageBlob, err := GetV1OCIImageBlob(ctx, image, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting oci image blob based on resource input specification: %w", err)
+	}
+
+	return &constructor.ResourceInputMethodResult{
+		ProcessedBlobData: imageBlob,
+	}, nil
+}
+
+// GetSourceCredentialConsumerIdentity returns the OCI registry identity for images pulled from
+// a remote registry, or ErrLocalImagesDoNotRequireCredentials for images read from the filesystem.
+func (i *InputMethod) GetSourceCredentialConsumerIdentity(_ context.Context, src *constructorruntime.Source) (identity runtime.Identity, err error) {
+	return i.identity(src.Input)
+}
+
+// ProcessSource processes an OCI image source input by converting the input specification
+// to a v1.OCIImage format, reading the image from its source and returning it as OCI layout blob.
+func (i *InputMethod) ProcessSource(ctx context.Context, src *constructorruntime.Source, credentials runtime.Typed) (result *constructor.SourceInputMethodResult, err error) {
+	image := v1.OCIImage{}
+	if err := i.GetInputMethodScheme().Convert(src.Input, &image); err != nil {
+		return nil, fmt.Errorf("error converting source input spec: %w", err)
+	}
+
+	opts, err := i.options(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	imageBlob, err := GetV1OCIImageBlob(ctx, image, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting oci image blob based on source input specification: %w", err)
+	}
+
+	return &constructor.SourceInputMethodResult{
+		ProcessedBlobData: imageBlob,
+	}, nil
+}
+
+func (i *InputMethod) identity(input runtime.Typed) (runtime.Identity, error) {
+	image := v1.OCIImage{}
+	if err := i.GetInputMethodScheme().Convert(input, &image); err != nil {
+		return nil, fmt.Errorf("error converting input spec: %w", err)
+	}
+	src, err := detectSource(image, i.WorkingDirectory)
+	if err != nil {
+		return nil, err
+	}
+	if src != v1.SourceRegistry {
+		return nil, ErrLocalImagesDoNotRequireCredentials
+	}
+
+	ref, err := looseref.ParseReference(image.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing image reference %q: %w", image.Path, err)
+	}
+	identity, err := runtime.ParseURLToIdentity(ref.RegistryWithScheme())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing URL to identity: %w", err)
+	}
+	identity.SetType(credidentityv1.Type)
+	return identity, nil
+}
+
+func (i *InputMethod) options(credentials runtime.Typed) ([]Option, error) {
+	opts := []Option{
+		WithWorkingDirectory(i.WorkingDirectory),
+		WithTempFolder(i.TempFolder),
+		WithHTTPConfig(i.HTTPConfig),
+		WithUserAgent(i.UserAgent),
+	}
+	if credentials != nil {
+		ociCredentials, err := ocicredsv1.ConvertToOCICredentials(credentials)
+		if err != nil {
+			return nil, fmt.Errorf("error converting credentials: %w", err)
+		}
+		opts = append(opts, WithCredentials(ociCredentials))
+	}
+	return opts, nil
+}
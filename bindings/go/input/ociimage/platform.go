@@ -0,0 +1,123 @@
+package ociimage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	ociImageSpecV1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+)
+
+// mediaTypeDockerManifestList is the docker predecessor of the OCI image index.
+// It is what most registries and `docker save` produce for multi-arch images and shares the index structure.
+const mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+// selectPlatforms narrows down an image index or docker manifest list to the manifests matching the requested platforms.
+// If no platforms are requested, root and store are returned as is.
+// If exactly one manifest matches, it becomes the new root. Otherwise, a new index containing only
+// the matching manifests is created and served from memory on top of the given store.
+func selectPlatforms(ctx context.Context, store content.ReadOnlyStorage, root ociImageSpecV1.Descriptor, platforms []string) (ociImageSpecV1.Descriptor, content.ReadOnlyStorage, error) {
+	if len(platforms) == 0 {
+		return root, store, nil
+	}
+	if root.MediaType != ociImageSpecV1.MediaTypeImageIndex && root.MediaType != mediaTypeDockerManifestList {
+		return ociImageSpecV1.Descriptor{}, nil, fmt.Errorf("platform selection requires an image index or docker manifest list, but got %q", root.MediaType)
+	}
+
+	wanted := make([]ociImageSpecV1.Platform, 0, len(platforms))
+	for _, p := range platforms {
+		platform, err := parsePlatform(p)
+		if err != nil {
+			return ociImageSpecV1.Descriptor{}, nil, err
+		}
+		wanted = append(wanted, platform)
+	}
+
+	raw, err := content.FetchAll(ctx, store, root)
+	if err != nil {
+		return ociImageSpecV1.Descriptor{}, nil, fmt.Errorf("failed to fetch image index: %w", err)
+	}
+	var index ociImageSpecV1.Index
+	if err := json.Unmarshal(raw, &index); err != nil {
+		return ociImageSpecV1.Descriptor{}, nil, fmt.Errorf("failed to unmarshal image index: %w", err)
+	}
+
+	var selected []ociImageSpecV1.Descriptor
+	for _, manifest := range index.Manifests {
+		if manifest.Platform == nil {
+			continue
+		}
+		for _, platform := range wanted {
+			if matchPlatform(platform, *manifest.Platform) {
+				selected = append(selected, manifest)
+				break
+			}
+		}
+	}
+
+	switch len(selected) {
+	case 0:
+		return ociImageSpecV1.Descriptor{}, nil, fmt.Errorf("no manifest in image index matches platforms %v", platforms)
+	case 1:
+		return selected[0], store, nil
+	}
+
+	// the filtered index keeps the media type of the root, so docker manifest lists stay docker manifest lists.
+	index.Manifests = selected
+	indexRaw, err := json.Marshal(index)
+	if err != nil {
+		return ociImageSpecV1.Descriptor{}, nil, fmt.Errorf("failed to marshal filtered image index: %w", err)
+	}
+	filtered := content.NewDescriptorFromBytes(root.MediaType, indexRaw)
+	filtered.ArtifactType = root.ArtifactType
+	filtered.Annotations = root.Annotations
+
+	return filtered, &indexProxy{ReadOnlyStorage: store, desc: filtered, raw: indexRaw}, nil
+}
+
+// parsePlatform parses a platform in the form os/architecture[/variant].
+func parsePlatform(platform string) (ociImageSpecV1.Platform, error) {
+	parts := strings.Split(platform, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return ociImageSpecV1.Platform{}, fmt.Errorf("invalid platform %q, expected os/architecture[/variant]", platform)
+	}
+	p := ociImageSpecV1.Platform{OS: parts[0], Architecture: parts[1]}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
+
+// matchPlatform reports whether the candidate satisfies the wanted platform.
+// The variant is only compared if it is requested.
+func matchPlatform(wanted, candidate ociImageSpecV1.Platform) bool {
+	if wanted.OS != candidate.OS || wanted.Architecture != candidate.Architecture {
+		return false
+	}
+	return wanted.Variant == "" || wanted.Variant == candidate.Variant
+}
+
+// indexProxy serves a generated image index from memory and delegates everything else to the underlying store.
+type indexProxy struct {
+	content.ReadOnlyStorage
+	desc ociImageSpecV1.Descriptor
+	raw  []byte
+}
+
+func (p *indexProxy) Exists(ctx context.Context, target ociImageSpecV1.Descriptor) (bool, error) {
+	if target.Digest == p.desc.Digest {
+		return true, nil
+	}
+	return p.ReadOnlyStorage.Exists(ctx, target)
+}
+
+func (p *indexProxy) Fetch(ctx context.Context, target ociImageSpecV1.Descriptor) (io.ReadCloser, error) {
+	if target.Digest == p.desc.Digest {
+		return io.NopCloser(bytes.NewReader(p.raw)), nil
+	}
+	return p.ReadOnlyStorage.Fetch(ctx, target)
+}
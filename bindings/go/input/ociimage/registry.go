@@ -0,0 +1,66 @@
+package ociimage
+
+import (
+	"context"
+	"fmt"
+
+	"oras.land/oras-go/v2/registry/remote/auth"
+
+	ocmhttp "ocm.software/open-component-model/bindings/go/http"
+	v1 "ocm.software/open-component-model/bindings/go/input/ociimage/spec/v1"
+	ocicredentials "ocm.software/open-component-model/bindings/go/oci/credentials"
+	"ocm.software/open-component-model/bindings/go/oci/looseref"
+	urlresolver "ocm.software/open-component-model/bindings/go/oci/resolver/url"
+)
+
+// DefaultUserAgent is used for registry requests if no user agent is configured.
+const DefaultUserAgent = "ocm.software/open-component-model/bindings/go/input/ociimage"
+
+// openRegistry resolves the image reference in Path against its remote registry.
+func openRegistry(ctx context.Context, image v1.OCIImage, options *Options) (*source, error) {
+	ref, err := looseref.ParseReference(image.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse image reference %q: %w", image.Path, err)
+	}
+	reference := ref.ReferenceOrTag()
+	if reference == "" {
+		return nil, fmt.Errorf("image reference %q must contain a tag or digest", image.Path)
+	}
+
+	userAgent := options.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	client := &auth.Client{
+		Client: ocmhttp.New(
+			ocmhttp.WithConfig(options.HTTPConfig),
+			ocmhttp.WithUserAgent(userAgent),
+		),
+	}
+	if options.Credentials != nil {
+		client.Credential = auth.StaticCredential(ref.Registry, ocicredentials.MapCredentials(options.Credentials))
+	}
+
+	resolver, err := urlresolver.New(
+		urlresolver.WithBaseURL(ref.Registry),
+		urlresolver.WithBaseClient(client),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resolver for %q: %w", image.Path, err)
+	}
+	store, err := resolver.StoreForReference(ctx, image.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store for %q: %w", image.Path, err)
+	}
+	root, err := store.Resolve(ctx, reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %q: %w", image.Path, err)
+	}
+
+	src := &source{store: store, root: root}
+	// mirror the oci repository which tags downloaded artifacts with their full image reference.
+	if ref.Tag != "" {
+		src.tag = image.Path
+	}
+	return src, nil
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// OCIImage describes an input sourced by an OCI image or image index.
+// The artifact can be read from a local OCI layout directory, a docker-archive tarball
+// (as produced by `docker save`) or a remote OCI registry.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type OCIImage struct {
+	// +ocm:jsonschema-gen:enum=ociImage/v1,OCIImage/v1
+	// +ocm:jsonschema-gen:enum:deprecated=ociImage,OCIImage
+	Type runtime.Type `json:"type"`
+
+	// Path is either the image reference of an artifact in a remote registry
+	// (e.g. ghcr.io/open-component-model/ocm:1.0.0) or the filesystem path to an
+	// OCI layout directory or docker-archive tarball.
+	Path string `json:"path"`
+
+	// Source defines how Path is interpreted.
+	// If not set, the source is detected automatically: an existing directory is read as OCI layout,
+	// an existing file is read as docker-archive, and a path that doesn't exist locally is read from a registry
+	// if it is a reference with registry and tag or digest (e.g. ghcr.io/acme/app:1.0.0). Any other path fails.
+	Source Source `json:"source,omitempty"`
+
+	// Reference selects the artifact within an OCI layout or docker-archive by tag or digest.
+	// It is only required if the layout or archive contains more than one top-level artifact.
+	// For registry sources the reference is part of Path and this field is ignored.
+	Reference string `json:"reference,omitempty"`
+
+	// Platforms restricts a multi-arch image index to the given platforms, each in the
+	// form os/architecture[/variant] (e.g. linux/amd64 or linux/arm64/v8).
+	// If exactly one platform is selected, the matching image manifest is embedded on its own,
+	// otherwise a new index only containing the selected manifests is created.
+	// If not set, the artifact is embedded as is.
+	Platforms []string `json:"platforms,omitempty"`
+}
+
+// Source defines the kind of location an OCIImage input is read from.
+// +ocm:jsonschema-gen:enum=ociLayout,dockerArchive,registry
+type Source string
+
+const (
+	// SourceOCILayout reads the artifact from a local directory following the OCI image layout specification.
+	SourceOCILayout Source = "ociLayout"
+	// SourceDockerArchive reads the artifact from a tarball as produced by `docker save`.
+	SourceDockerArchive Source = "dockerArchive"
+	// SourceRegistry pulls the artifact from a remote OCI registry.
+	SourceRegistry Source = "registry"
+)
+
+func (t *OCIImage) String() string {
+	return t.Path
+}
+
+const (
+	Version    = "v1"
+	Type       = "OCIImage"
+	LegacyType = "ociImage"
+)
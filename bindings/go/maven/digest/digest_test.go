@@ -0,0 +1,91 @@
+package digest_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	godigest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/maven/digest"
+	v1 "ocm.software/open-component-model/bindings/go/maven/spec/access/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const content = "pom content"
+
+func newRepository(t *testing.T, checksum bool) string {
+	t.Helper()
+	root := t.TempDir()
+	file := filepath.Join(root, "org", "example", "tool", "1.0.0", "tool-1.0.0.pom")
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o755))
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+	if checksum {
+		sum := sha256.Sum256([]byte(content))
+		require.NoError(t, os.WriteFile(file+".sha256", []byte(hex.EncodeToString(sum[:])), 0o644))
+	}
+	return "file://" + filepath.ToSlash(root)
+}
+
+func newResource(repoURL string, dig *descriptor.Digest) *descriptor.Resource {
+	return &descriptor.Resource{
+		ElementMeta: descriptor.ElementMeta{
+			ObjectMeta: descriptor.ObjectMeta{Name: "pom", Version: "1.0.0"},
+		},
+		Type:     "pom",
+		Relation: descriptor.ExternalRelation,
+		Access: &v1.Maven{
+			Type:       runtime.NewUnversionedType(v1.LegacyType),
+			RepoURL:    repoURL,
+			GroupID:    "org.example",
+			ArtifactID: "tool",
+			Version:    "1.0.0",
+			Extension:  "pom",
+		},
+		Digest: dig,
+	}
+}
+
+func TestDigestProcessor_ProcessResourceDigest(t *testing.T) {
+	processor := digest.NewDigestProcessor()
+	contentDigest := godigest.FromString(content)
+
+	t.Run("sets digest", func(t *testing.T) {
+		original := newResource(newRepository(t, true), nil)
+		processed, err := processor.ProcessResourceDigest(t.Context(), original, nil)
+		require.NoError(t, err)
+
+		assert.Nil(t, original.Digest, "input resource must not be modified")
+		assert.Equal(t, &descriptor.Digest{
+			HashAlgorithm:          "SHA-256",
+			NormalisationAlgorithm: "genericBlobDigest/v1",
+			Value:                  contentDigest.Encoded(),
+		}, processed.Digest)
+	})
+
+	t.Run("verifies existing digest", func(t *testing.T) {
+		_, err := processor.ProcessResourceDigest(t.Context(), newResource(newRepository(t, true), &descriptor.Digest{
+			HashAlgorithm:          "SHA-256",
+			NormalisationAlgorithm: "genericBlobDigest/v1",
+			Value:                  godigest.FromString("other").Encoded(),
+		}), nil)
+		require.ErrorContains(t, err, "digest mismatch")
+	})
+
+	t.Run("requires registry checksum", func(t *testing.T) {
+		_, err := processor.ProcessResourceDigest(t.Context(), newResource(newRepository(t, false), nil), nil)
+		require.ErrorContains(t, err, "no checksum published")
+	})
+
+	t.Run("consumer identity", func(t *testing.T) {
+		identity, err := processor.GetResourceDigestProcessorCredentialConsumerIdentity(t.Context(), newResource("https://repo.example.com/maven2", nil))
+		require.NoError(t, err)
+		assert.Equal(t, "repo.example.com", identity[runtime.IdentityAttributeHostname])
+		assert.Equal(t, "maven2", identity[runtime.IdentityAttributePath])
+	})
+}
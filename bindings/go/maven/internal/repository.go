@@ -0,0 +1,417 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec // G505: sha1 checksums are published by Maven repositories and only used for verification.
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	nethttp "net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+
+	"ocm.software/open-component-model/bindings/go/blob/filesystem"
+	ocmhttp "ocm.software/open-component-model/bindings/go/http"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	v1 "ocm.software/open-component-model/bindings/go/maven/spec/access/v1"
+	credsv1 "ocm.software/open-component-model/bindings/go/maven/spec/credentials/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/maven/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// DefaultUserAgent is used for requests if no user agent is configured.
+const DefaultUserAgent = "ocm.software/open-component-model/bindings/go/maven"
+
+var (
+	// ErrChecksumMismatch is returned if a file does not match the checksum published by the repository.
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	// ErrNoChecksum is returned if a checksum is required but the repository does not publish one.
+	ErrNoChecksum = errors.New("no checksum published")
+)
+
+// checksum is a checksum file published next to the files of a Maven repository.
+type checksum struct {
+	extension string
+	hash      func() hash.Hash
+}
+
+// checksums are verified in order of preference, only the first published checksum is verified.
+var checksums = []checksum{
+	{extension: "sha512", hash: sha512.New},
+	{extension: "sha256", hash: sha256.New},
+	{extension: "sha1", hash: sha1.New},
+}
+
+var mediaTypes = map[string]string{
+	"jar":    "application/java-archive",
+	"war":    "application/java-archive",
+	"ear":    "application/java-archive",
+	"pom":    "application/xml",
+	"xml":    "application/xml",
+	"json":   "application/json",
+	"zip":    "application/zip",
+	"tar":    "application/x-tar",
+	"tgz":    "application/gzip",
+	"tar.gz": "application/gzip",
+}
+
+// MediaType returns the media type of files with the given extension.
+func MediaType(extension string) string {
+	if mt, ok := mediaTypes[extension]; ok {
+		return mt
+	}
+	return "application/octet-stream"
+}
+
+// Validate checks that the access references a file.
+func Validate(access *v1.Maven) error {
+	switch {
+	case access.RepoURL == "":
+		return fmt.Errorf("repoUrl is required for maven access")
+	case access.GroupID == "":
+		return fmt.Errorf("groupId is required for maven access")
+	case access.ArtifactID == "":
+		return fmt.Errorf("artifactId is required for maven access")
+	case access.Version == "":
+		return fmt.Errorf("version is required for maven access")
+	}
+	return nil
+}
+
+// FilePath returns the path of the file of the access relative to the repository root
+// according to the Maven repository layout.
+func FilePath(access *v1.Maven) string {
+	name := access.ArtifactID + "-" + access.Version
+	if access.Classifier != "" {
+		name += "-" + access.Classifier
+	}
+	name += "." + access.FileExtension()
+	return path.Join(strings.ReplaceAll(access.GroupID, ".", "/"), access.ArtifactID, access.Version, name)
+}
+
+// IsLocal reports whether the repository is on the local filesystem.
+func IsLocal(repoURL string) bool {
+	return strings.HasPrefix(repoURL, "file://")
+}
+
+// CredentialConsumerIdentity resolves the credential consumer identity for the given repository URL.
+// The identity contains the scheme, hostname, port and path of the URL.
+// Local repositories do not require credentials, for them nil is returned.
+func CredentialConsumerIdentity(repoURL string) (runtime.Identity, error) {
+	if IsLocal(repoURL) {
+		return nil, nil
+	}
+	if !strings.HasPrefix(repoURL, "https://") && !strings.HasPrefix(repoURL, "http://") {
+		return nil, fmt.Errorf("maven repository %q must use the http, https or file scheme", repoURL)
+	}
+	identity, err := runtime.ParseURLToIdentity(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing maven repository url to identity: %w", err)
+	}
+	identity.SetType(identityv1.Type)
+	return identity, nil
+}
+
+// Client reads and writes files of Maven repositories served via HTTP/S or on the local filesystem.
+type Client struct {
+	HTTPConfig *httpv1alpha1.Config
+	UserAgent  string
+	// TempDir is the directory downloaded files are buffered in.
+	// If empty, the default directory for temporary files is used.
+	TempDir string
+}
+
+// Download fetches the file of the access into a temporary file and verifies it against the checksum
+// published by the repository and against the expected digest, if set.
+// Besides the blob, the digest of the file is returned. It is calculated with the algorithm of the
+// expected digest, or with the canonical algorithm if no digest is expected.
+// If requireChecksum is set, ErrNoChecksum is returned for files without published checksum.
+func (c *Client) Download(ctx context.Context, access *v1.Maven, credentials runtime.Typed, expected digest.Digest, requireChecksum bool) (*filesystem.Blob, digest.Digest, error) {
+	content, err := c.open(ctx, access, credentials, expected, requireChecksum)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = content.Close()
+	}()
+	b, err := copyToTempBlob(c.TempDir, "maven-download-*", content, content.verify)
+	if err != nil {
+		return nil, "", fmt.Errorf("error downloading %s: %w", access.GAV(), err)
+	}
+	b.SetMediaType(MediaType(access.FileExtension()))
+	return b, content.digester.Digest(), nil
+}
+
+// Digest fetches the file of the access without storing it and verifies it like Download.
+// The digest of the file is returned.
+func (c *Client) Digest(ctx context.Context, access *v1.Maven, credentials runtime.Typed, expected digest.Digest, requireChecksum bool) (digest.Digest, error) {
+	content, err := c.open(ctx, access, credentials, expected, requireChecksum)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = content.Close()
+	}()
+	if _, err := io.Copy(io.Discard, content); err != nil {
+		return "", fmt.Errorf("error downloading %s: %w", access.GAV(), err)
+	}
+	if err := content.verify(); err != nil {
+		return "", fmt.Errorf("error downloading %s: %w", access.GAV(), err)
+	}
+	return content.digester.Digest(), nil
+}
+
+func (c *Client) open(ctx context.Context, access *v1.Maven, credentials runtime.Typed, expected digest.Digest, requireChecksum bool) (_ *fileReader, err error) {
+	repo, err := c.repository(access.RepoURL, credentials)
+	if err != nil {
+		return nil, err
+	}
+	filePath := FilePath(access)
+
+	rc, err := repo.open(ctx, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading %s: %w", access.GAV(), err)
+	}
+	defer func() {
+		if err != nil {
+			_ = rc.Close()
+		}
+	}()
+	cs, published, err := publishedChecksum(ctx, repo, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error verifying %s: %w", access.GAV(), err)
+	}
+	if cs == nil && requireChecksum {
+		return nil, fmt.Errorf("error verifying %s: %w", access.GAV(), ErrNoChecksum)
+	}
+
+	algorithm := digest.Canonical
+	if expected != "" {
+		algorithm = expected.Algorithm()
+	}
+	r := &fileReader{
+		ReadCloser: rc,
+		digester:   algorithm.Digester(),
+		expected:   expected,
+		checksum:   cs,
+		published:  published,
+	}
+	writers := []io.Writer{r.digester.Hash()}
+	if cs != nil {
+		r.hash = cs.hash()
+		writers = append(writers, r.hash)
+	}
+	r.tee = io.TeeReader(rc, io.MultiWriter(writers...))
+	return r, nil
+}
+
+// fileReader hashes a file of a repository while it is read,
+// so that it can be verified without holding it in memory.
+type fileReader struct {
+	io.ReadCloser
+	tee       io.Reader
+	digester  digest.Digester
+	expected  digest.Digest
+	checksum  *checksum
+	hash      hash.Hash
+	published string
+}
+
+func (r *fileReader) Read(p []byte) (int, error) {
+	return r.tee.Read(p)
+}
+
+// verify checks the content read so far against the published checksum and the expected digest.
+func (r *fileReader) verify() error {
+	if r.checksum != nil {
+		if actual := hex.EncodeToString(r.hash.Sum(nil)); !strings.EqualFold(r.published, actual) {
+			return fmt.Errorf("%w: %s checksum is %s, repository published %s", ErrChecksumMismatch, r.checksum.extension, actual, r.published)
+		}
+	}
+	if actual := r.digester.Digest(); r.expected != "" && actual != r.expected {
+		return fmt.Errorf("%w: file has digest %s, expected %s", ErrDigestMismatch, actual, r.expected)
+	}
+	return nil
+}
+
+// Upload stores the file of the access together with its checksums in the repository.
+// Repository metadata (maven-metadata.xml) is not maintained.
+func (c *Client) Upload(ctx context.Context, access *v1.Maven, credentials runtime.Typed, data []byte) error {
+	repo, err := c.repository(access.RepoURL, credentials)
+	if err != nil {
+		return err
+	}
+	filePath := FilePath(access)
+
+	if err := repo.put(ctx, filePath, data); err != nil {
+		return fmt.Errorf("error uploading %s: %w", access.GAV(), err)
+	}
+	for _, cs := range checksums {
+		h := cs.hash()
+		h.Write(data)
+		if err := repo.put(ctx, filePath+"."+cs.extension, []byte(hex.EncodeToString(h.Sum(nil)))); err != nil {
+			return fmt.Errorf("error uploading %s checksum of %s: %w", cs.extension, access.GAV(), err)
+		}
+	}
+	return nil
+}
+
+// publishedChecksum returns the preferred checksum published for the file, or nil if none is published.
+func publishedChecksum(ctx context.Context, repo repository, filePath string) (*checksum, string, error) {
+	for _, cs := range checksums {
+		published, err := get(ctx, repo, filePath+"."+cs.extension)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("error downloading %s checksum: %w", cs.extension, err)
+		}
+		// checksum files may contain the file name after the checksum.
+		fields := strings.Fields(string(published))
+		if len(fields) == 0 {
+			return nil, "", fmt.Errorf("%w: empty %s checksum", ErrChecksumMismatch, cs.extension)
+		}
+		return &cs, fields[0], nil
+	}
+	return nil, "", nil
+}
+
+func get(ctx context.Context, repo repository, filePath string) ([]byte, error) {
+	rc, err := repo.open(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rc.Close()
+	}()
+	return io.ReadAll(rc)
+}
+
+type repository interface {
+	open(ctx context.Context, filePath string) (io.ReadCloser, error)
+	put(ctx context.Context, filePath string, data []byte) error
+}
+
+func (c *Client) repository(repoURL string, credentials runtime.Typed) (repository, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maven repository url %q: %w", repoURL, err)
+	}
+	switch u.Scheme {
+	case "file":
+		return &localRepository{root: filepath.FromSlash(u.Path)}, nil
+	case "http", "https":
+		creds, err := credsv1.ConvertToMavenCredentials(credentials)
+		if err != nil {
+			return nil, fmt.Errorf("error converting credentials: %w", err)
+		}
+		userAgent := c.UserAgent
+		if userAgent == "" {
+			userAgent = DefaultUserAgent
+		}
+		return &httpRepository{
+			base:        strings.TrimSuffix(repoURL, "/"),
+			credentials: creds,
+			client:      ocmhttp.New(ocmhttp.WithConfig(c.HTTPConfig), ocmhttp.WithUserAgent(userAgent)),
+		}, nil
+	default:
+		return nil, fmt.Errorf("maven repository %q must use the http, https or file scheme", repoURL)
+	}
+}
+
+type localRepository struct {
+	root string
+}
+
+func (r *localRepository) open(_ context.Context, filePath string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(r.root, filepath.FromSlash(filePath)))
+}
+
+func (r *localRepository) put(_ context.Context, filePath string, data []byte) (err error) {
+	target := filepath.Join(r.root, filepath.FromSlash(filePath))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	// write to a temporary file first, so that readers never see partially written files.
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, os.Remove(tmp.Name()))
+		}
+	}()
+	if _, err = tmp.Write(data); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), target)
+}
+
+type httpRepository struct {
+	base        string
+	credentials *credsv1.MavenCredentials
+	client      *nethttp.Client
+}
+
+func (r *httpRepository) open(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	resp, err := r.do(ctx, nethttp.MethodGet, filePath, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case nethttp.StatusOK:
+		return resp.Body, nil
+	case nethttp.StatusNotFound:
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%s: %w", resp.Request.URL, fs.ErrNotExist)
+	default:
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s for %s", resp.Status, resp.Request.URL)
+	}
+}
+
+func (r *httpRepository) put(ctx context.Context, filePath string, data []byte) error {
+	resp, err := r.do(ctx, nethttp.MethodPut, filePath, data)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	switch resp.StatusCode {
+	case nethttp.StatusOK, nethttp.StatusCreated, nethttp.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("unexpected status %s for %s", resp.Status, resp.Request.URL)
+	}
+}
+
+func (r *httpRepository) do(ctx context.Context, method, filePath string, data []byte) (*nethttp.Response, error) {
+	var body io.Reader
+	if data != nil {
+		body = bytes.NewReader(data)
+	}
+	req, err := nethttp.NewRequestWithContext(ctx, method, r.base+"/"+filePath, body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	if r.credentials != nil && (r.credentials.Username != "" || r.credentials.Password != "") {
+		req.SetBasicAuth(r.credentials.Username, r.credentials.Password)
+	}
+	return r.client.Do(req)
+}
@@ -0,0 +1,23 @@
+// Package resource implements [repository.ResourceRepository] for files of artifacts in Maven repositories.
+//
+// The [ResourceRepository] handles the Maven/v1 access type. A file is addressed by its Maven coordinates
+// (groupId, artifactId, version, classifier and extension) in a repository served via HTTP/S or on the
+// local filesystem (file:// URLs). Downloads are verified against the checksum the repository publishes
+// next to the file (.sha512, .sha256 or .sha1). Uploads store the file together with these checksums.
+// Downloaded files are hashed while they are streamed into a temporary file (see [WithTempDir]),
+// so that large artifacts are never held in memory.
+//
+// # Credentials
+//
+// The consumer identity of type MavenRepository carries the scheme, hostname, port and path of the
+// repository URL. Credentials of type MavenCredentials/v1 or DirectCredentials with username and password
+// (basic authentication) are supported. Local repositories do not have a consumer identity.
+//
+// # Usage
+//
+//	repo := resource.NewResourceRepository(resource.WithHTTPConfig(httpConfig))
+//
+//	identity, err := repo.GetResourceCredentialConsumerIdentity(ctx, res)
+//	content, err := repo.DownloadResource(ctx, res, creds)
+//	uploaded, err := repo.UploadResource(ctx, target, content, creds)
+package resource
@@ -0,0 +1,135 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/maven/internal"
+	mavenaccess "ocm.software/open-component-model/bindings/go/maven/spec/access"
+	v1 "ocm.software/open-component-model/bindings/go/maven/spec/access/v1"
+	"ocm.software/open-component-model/bindings/go/repository"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// ResourceRepository implements a resource repository for files of artifacts in Maven repositories.
+// Repositories served via HTTP/S use the retrying client of the http bindings, so per-host timeouts
+// and retry policies of the HTTP configuration apply.
+type ResourceRepository struct {
+	client internal.Client
+}
+
+// Option configures a ResourceRepository.
+type Option func(*ResourceRepository)
+
+// WithHTTPConfig sets the HTTP client configuration used for requests.
+func WithHTTPConfig(cfg *httpv1alpha1.Config) Option {
+	return func(r *ResourceRepository) {
+		r.client.HTTPConfig = cfg
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with all requests.
+func WithUserAgent(userAgent string) Option {
+	return func(r *ResourceRepository) {
+		r.client.UserAgent = userAgent
+	}
+}
+
+// WithTempDir sets the directory downloaded files are buffered in.
+func WithTempDir(dir string) Option {
+	return func(r *ResourceRepository) {
+		r.client.TempDir = dir
+	}
+}
+
+var _ repository.ResourceRepository = (*ResourceRepository)(nil)
+
+// NewResourceRepository creates a ResourceRepository.
+func NewResourceRepository(opts ...Option) *ResourceRepository {
+	r := &ResourceRepository{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// GetResourceRepositoryScheme returns the maven access scheme containing the
+// Maven/v1 type and its aliases.
+func (r *ResourceRepository) GetResourceRepositoryScheme() *runtime.Scheme {
+	return mavenaccess.Scheme
+}
+
+// GetResourceCredentialConsumerIdentity resolves the MavenRepository credential consumer identity
+// from the repository URL of the resource. Returns nil for local repositories.
+func (r *ResourceRepository) GetResourceCredentialConsumerIdentity(_ context.Context, resource *descriptor.Resource) (runtime.Identity, error) {
+	maven, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	return internal.CredentialConsumerIdentity(maven.RepoURL)
+}
+
+// DownloadResource fetches the file referenced by the maven access of the resource.
+// The file is verified against the checksum published by the repository and against the
+// generic blob digest of the resource, if present.
+// The file is buffered in a temporary file, which is not removed by the repository.
+func (r *ResourceRepository) DownloadResource(ctx context.Context, resource *descriptor.Resource, credentials runtime.Typed) (blob.ReadOnlyBlob, error) {
+	maven, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := r.client.Download(ctx, maven, credentials, internal.ExpectedDigest(resource.Digest), false)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading resource %s: %w", resource.ToIdentity(), err)
+	}
+	return data, nil
+}
+
+// UploadResource stores the content as file of the artifact referenced by the maven access of the
+// resource, together with its checksums. Existing files are overwritten if the repository permits it.
+func (r *ResourceRepository) UploadResource(ctx context.Context, resource *descriptor.Resource, content blob.ReadOnlyBlob, credentials runtime.Typed) (_ *descriptor.Resource, err error) {
+	maven, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := content.ReadCloser()
+	if err != nil {
+		return nil, fmt.Errorf("error reading content for %s: %w", maven.GAV(), err)
+	}
+	defer func() {
+		err = errors.Join(err, rc.Close())
+	}()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("error reading content for %s: %w", maven.GAV(), err)
+	}
+
+	if err := r.client.Upload(ctx, maven, credentials, data); err != nil {
+		return nil, err
+	}
+
+	resource = resource.DeepCopy()
+	maven.Type = runtime.NewVersionedType(v1.Type, v1.Version)
+	resource.Access = maven
+	return resource, nil
+}
+
+func convertAccess(resource *descriptor.Resource) (*v1.Maven, error) {
+	if resource == nil || resource.Access == nil {
+		return nil, fmt.Errorf("resource access is required")
+	}
+	var maven v1.Maven
+	if err := mavenaccess.Scheme.Convert(resource.Access, &maven); err != nil {
+		return nil, fmt.Errorf("error converting access to maven spec: %w", err)
+	}
+	if err := internal.Validate(&maven); err != nil {
+		return nil, err
+	}
+	return &maven, nil
+}
@@ -0,0 +1,231 @@
+package resource_test
+
+import (
+	"crypto/sha1" //nolint:gosec // G505: sha1 checksums are published by Maven repositories.
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	"ocm.software/open-component-model/bindings/go/blob/inmemory"
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/maven/repository/resource"
+	v1 "ocm.software/open-component-model/bindings/go/maven/spec/access/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/maven/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	content  = "jar content"
+	filePath = "org/example/tool/1.0.0/tool-1.0.0.jar"
+)
+
+func sha1Hex(data string) string {
+	sum := sha1.Sum([]byte(data)) //nolint:gosec // G401: test checksum.
+	return hex.EncodeToString(sum[:])
+}
+
+// newLocalRepository creates a file-based Maven repository containing the tool artifact.
+func newLocalRepository(t *testing.T, checksum string) string {
+	t.Helper()
+	root := t.TempDir()
+	file := filepath.Join(root, filepath.FromSlash(filePath))
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o755))
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+	if checksum != "" {
+		require.NoError(t, os.WriteFile(file+".sha1", []byte(checksum+"  tool-1.0.0.jar\n"), 0o644))
+	}
+	return root
+}
+
+// newServer serves the repository root via HTTP, requiring basic authentication.
+func newServer(t *testing.T, root string) *httptest.Server {
+	t.Helper()
+	files := http.FileServer(http.Dir(root))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Method == http.MethodPut {
+			file := filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(r.URL.Path, "/maven2/")))
+			data, err := io.ReadAll(r.Body)
+			if err == nil {
+				err = os.MkdirAll(filepath.Dir(file), 0o755)
+			}
+			if err == nil {
+				err = os.WriteFile(file, data, 0o644)
+			}
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		http.StripPrefix("/maven2", files).ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newResource(repoURL string) *descriptor.Resource {
+	return &descriptor.Resource{
+		ElementMeta: descriptor.ElementMeta{
+			ObjectMeta: descriptor.ObjectMeta{Name: "tool", Version: "1.0.0"},
+		},
+		Type:     "jar",
+		Relation: descriptor.ExternalRelation,
+		Access: &v1.Maven{
+			Type:       runtime.NewVersionedType(v1.Type, v1.Version),
+			RepoURL:    repoURL,
+			GroupID:    "org.example",
+			ArtifactID: "tool",
+			Version:    "1.0.0",
+		},
+	}
+}
+
+func credentials() runtime.Typed {
+	return &credv1.DirectCredentials{
+		Type:       runtime.NewVersionedType(credv1.CredentialsType, credv1.Version),
+		Properties: map[string]string{"username": "user", "password": "pass"},
+	}
+}
+
+func readAll(t *testing.T, b blob.ReadOnlyBlob) string {
+	t.Helper()
+	rc, err := b.ReadCloser()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, rc.Close())
+	}()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestResourceRepository_GetResourceCredentialConsumerIdentity(t *testing.T) {
+	repo := resource.NewResourceRepository()
+
+	identity, err := repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource("https://repo.example.com/maven2"))
+	require.NoError(t, err)
+	assert.Equal(t, identityv1.Type.String(), identity[runtime.IdentityAttributeType])
+	assert.Equal(t, "repo.example.com", identity[runtime.IdentityAttributeHostname])
+	assert.Equal(t, "maven2", identity[runtime.IdentityAttributePath])
+
+	identity, err = repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource("file:///srv/maven"))
+	require.NoError(t, err)
+	assert.Nil(t, identity)
+
+	_, err = repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource("ftp://repo.example.com"))
+	assert.ErrorContains(t, err, "must use the http, https or file scheme")
+}
+
+func TestResourceRepository_DownloadResource(t *testing.T) {
+	repo := resource.NewResourceRepository(resource.WithTempDir(t.TempDir()))
+
+	t.Run("local repository", func(t *testing.T) {
+		root := newLocalRepository(t, sha1Hex(content))
+		b, err := repo.DownloadResource(t.Context(), newResource("file://"+filepath.ToSlash(root)), nil)
+		require.NoError(t, err)
+		assert.Equal(t, content, readAll(t, b))
+
+		mediaType, _ := b.(blob.MediaTypeAware).MediaType()
+		assert.Equal(t, "application/java-archive", mediaType)
+		dig, _ := b.(blob.DigestAware).Digest()
+		assert.Equal(t, digest.FromString(content).String(), dig)
+	})
+
+	t.Run("http repository with credentials", func(t *testing.T) {
+		server := newServer(t, newLocalRepository(t, sha1Hex(content)))
+		b, err := repo.DownloadResource(t.Context(), newResource(server.URL+"/maven2"), credentials())
+		require.NoError(t, err)
+		assert.Equal(t, content, readAll(t, b))
+
+		_, err = repo.DownloadResource(t.Context(), newResource(server.URL+"/maven2"), nil)
+		assert.ErrorContains(t, err, "401")
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		tempDir := t.TempDir()
+		repo := resource.NewResourceRepository(resource.WithTempDir(tempDir))
+		root := newLocalRepository(t, sha1Hex("other"))
+		_, err := repo.DownloadResource(t.Context(), newResource("file://"+filepath.ToSlash(root)), nil)
+		assert.ErrorContains(t, err, "checksum mismatch")
+		entries, err := os.ReadDir(tempDir)
+		require.NoError(t, err)
+		assert.Empty(t, entries, "content not matching the checksum must not be kept")
+	})
+
+	t.Run("resource digest mismatch", func(t *testing.T) {
+		root := newLocalRepository(t, "")
+		res := newResource("file://" + filepath.ToSlash(root))
+		res.Digest = &descriptor.Digest{
+			HashAlgorithm:          "SHA-256",
+			NormalisationAlgorithm: "genericBlobDigest/v1",
+			Value:                  digest.FromString("other").Encoded(),
+		}
+		tempDir := t.TempDir()
+		_, err := resource.NewResourceRepository(resource.WithTempDir(tempDir)).DownloadResource(t.Context(), res, nil)
+		assert.ErrorContains(t, err, "digest mismatch")
+		entries, err := os.ReadDir(tempDir)
+		require.NoError(t, err)
+		assert.Empty(t, entries, "content not matching the digest must not be kept")
+	})
+
+	t.Run("missing artifact", func(t *testing.T) {
+		res := newResource("file://" + filepath.ToSlash(t.TempDir()))
+		_, err := repo.DownloadResource(t.Context(), res, nil)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestResourceRepository_UploadResource(t *testing.T) {
+	repo := resource.NewResourceRepository()
+
+	for name, setup := range map[string]func(t *testing.T, root string) (string, runtime.Typed){
+		"local repository": func(t *testing.T, root string) (string, runtime.Typed) {
+			return "file://" + filepath.ToSlash(root), nil
+		},
+		"http repository": func(t *testing.T, root string) (string, runtime.Typed) {
+			return newServer(t, root).URL + "/maven2", credentials()
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			root := t.TempDir()
+			repoURL, creds := setup(t, root)
+
+			target := newResource(repoURL)
+			target.Access.(*v1.Maven).Classifier = "linux"
+			uploaded, err := repo.UploadResource(t.Context(), target, inmemory.New(strings.NewReader(content)), creds)
+			require.NoError(t, err)
+			assert.Equal(t, "linux", uploaded.Access.(*v1.Maven).Classifier)
+
+			file := filepath.Join(root, "org", "example", "tool", "1.0.0", "tool-1.0.0-linux.jar")
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+			assert.Equal(t, content, string(data))
+			checksum, err := os.ReadFile(file + ".sha1")
+			require.NoError(t, err)
+			assert.Equal(t, sha1Hex(content), string(checksum))
+			for _, ext := range []string{".sha256", ".sha512"} {
+				assert.FileExists(t, file+ext)
+			}
+
+			b, err := repo.DownloadResource(t.Context(), uploaded, creds)
+			require.NoError(t, err)
+			assert.Equal(t, content, readAll(t, b))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"strings"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	Version    = "v1"
+	Type       = "Maven"
+	LegacyType = "maven"
+)
+
+// DefaultExtension is used if no extension is specified.
+const DefaultExtension = "jar"
+
+// Maven describes the access for a single file of an artifact in a Maven repository.
+// This spec is aligned with ocm v1 https://github.com/open-component-model/ocm/blob/main/api/ocm/extensions/accessmethods/maven/method.go
+//
+// In contrast to ocm v1, a single file is always addressed: if no extension is given, the jar of the artifact is accessed.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type Maven struct {
+	// +ocm:jsonschema-gen:enum=Maven/v1,maven/v1
+	// +ocm:jsonschema-gen:enum:deprecated=Maven,maven
+	Type runtime.Type `json:"type"`
+
+	// RepoURL is the URL of the Maven repository, e.g. https://repo1.maven.org/maven2.
+	// Repositories on the local filesystem are referenced with file:// URLs.
+	RepoURL string `json:"repoUrl"`
+
+	// GroupID is the group of the artifact, e.g. org.apache.commons.
+	GroupID string `json:"groupId"`
+
+	// ArtifactID is the name of the artifact, e.g. commons-lang3.
+	ArtifactID string `json:"artifactId"`
+
+	// Version is the version of the artifact.
+	Version string `json:"version"`
+
+	// Classifier distinguishes files of the same artifact, e.g. sources.
+	Classifier string `json:"classifier,omitempty"`
+
+	// Extension is the file extension, e.g. jar or pom. Defaults to jar.
+	Extension string `json:"extension,omitempty"`
+}
+
+// GAV returns the Maven coordinates of the file in the form groupId:artifactId:version[:classifier]:extension.
+func (m *Maven) GAV() string {
+	parts := []string{m.GroupID, m.ArtifactID, m.Version}
+	if m.Classifier != "" {
+		parts = append(parts, m.Classifier)
+	}
+	return strings.Join(append(parts, m.FileExtension()), ":")
+}
+
+// FileExtension returns the extension of the file, defaulting to DefaultExtension.
+func (m *Maven) FileExtension() string {
+	if m.Extension == "" {
+		return DefaultExtension
+	}
+	return m.Extension
+}
+
+func (m *Maven) String() string {
+	return m.RepoURL + " " + m.GAV()
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"fmt"
+
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	credentialKeyUsername = "username"
+	credentialKeyPassword = "password"
+)
+
+var convertScheme = runtime.NewScheme()
+
+func init() {
+	MustRegisterCredentialType(convertScheme)
+	credv1.MustRegister(convertScheme)
+}
+
+func directToMavenCredentials(properties map[string]string) *MavenCredentials {
+	return &MavenCredentials{
+		Type:     runtime.NewVersionedType(MavenCredentialsType, Version),
+		Username: properties[credentialKeyUsername],
+		Password: properties[credentialKeyPassword],
+	}
+}
+
+// ConvertToMavenCredentials converts runtime.Typed credentials into *MavenCredentials.
+// DirectCredentials are mapped using the Maven-relevant fields (username, password).
+// Returns nil, nil for nil input or input with an empty type.
+func ConvertToMavenCredentials(creds runtime.Typed) (*MavenCredentials, error) {
+	if creds == nil || creds.GetType().String() == "" {
+		return nil, nil
+	}
+	typed, err := convertScheme.NewObject(creds.GetType())
+	if err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+	if err = convertScheme.Convert(creds, typed); err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+	switch t := typed.(type) {
+	case *credv1.DirectCredentials:
+		return directToMavenCredentials(t.Properties), nil
+	case *MavenCredentials:
+		return t, nil
+	}
+	return nil, fmt.Errorf("unsupported credential type for Maven: %v", typed.GetType())
+}
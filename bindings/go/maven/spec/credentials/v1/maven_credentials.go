@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	//nolint:gosec // G101: This is a type name, not a credential.
+	MavenCredentialsType = "MavenCredentials"
+	Version              = "v1"
+)
+
+// MavenCredentials represents typed credentials for Maven repositories.
+// Username and password are sent with basic authentication.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type MavenCredentials struct {
+	// +ocm:jsonschema-gen:enum=MavenCredentials/v1
+	// +ocm:jsonschema-gen:enum:deprecated=MavenCredentials
+	Type     runtime.Type `json:"type"`
+	Username string       `json:"username,omitempty"`
+	Password string       `json:"password,omitempty"`
+}
+
+// MustRegisterCredentialType registers MavenCredentials/v1 in the given scheme.
+func MustRegisterCredentialType(scheme *runtime.Scheme) {
+	scheme.MustRegisterWithAlias(&MavenCredentials{},
+		runtime.NewVersionedType(MavenCredentialsType, Version),
+		runtime.NewUnversionedType(MavenCredentialsType),
+	)
+}
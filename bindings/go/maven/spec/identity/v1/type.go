@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	MavenRepositoryIdentityType = "MavenRepository"
+	Version                     = "v1"
+)
+
+// Type is the unversioned consumer identity type for Maven repositories (backward compat).
+var Type = runtime.NewUnversionedType(MavenRepositoryIdentityType)
+
+// VersionedType is the versioned consumer identity type.
+// Identities of this type carry the hostname, scheme, port and path of the repository URL.
+var VersionedType = runtime.NewVersionedType(MavenRepositoryIdentityType, Version)
@@ -0,0 +1,110 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+
+	godigest "github.com/opencontainers/go-digest"
+
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/npm/internal"
+	npmaccess "ocm.software/open-component-model/bindings/go/npm/spec/access"
+	v1 "ocm.software/open-component-model/bindings/go/npm/spec/access/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/digestprocessor"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+var _ digestprocessor.BuiltinDigestProcessorPlugin = (*DigestProcessor)(nil)
+
+// DigestProcessor resolves digests for npm accesses.
+// The tarball is downloaded and verified against the checksum published by the registry
+// (the integrity, or the shasum of old packages), so that a digest is only pinned for content
+// the registry vouches for. Versions without published checksum are rejected.
+type DigestProcessor struct {
+	client internal.Client
+}
+
+// Option configures a DigestProcessor.
+type Option func(*DigestProcessor)
+
+// WithHTTPConfig sets the HTTP client configuration used for downloads.
+func WithHTTPConfig(cfg *httpv1alpha1.Config) Option {
+	return func(p *DigestProcessor) {
+		p.client.HTTPConfig = cfg
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with all downloads.
+func WithUserAgent(userAgent string) Option {
+	return func(p *DigestProcessor) {
+		p.client.UserAgent = userAgent
+	}
+}
+
+// NewDigestProcessor creates a new npm digest processor.
+func NewDigestProcessor(opts ...Option) *DigestProcessor {
+	p := &DigestProcessor{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (p *DigestProcessor) GetResourceRepositoryScheme() *runtime.Scheme {
+	return npmaccess.Scheme
+}
+
+// GetResourceDigestProcessorCredentialConsumerIdentity resolves the NpmRegistry credential consumer
+// identity from the registry URL of the resource access.
+func (p *DigestProcessor) GetResourceDigestProcessorCredentialConsumerIdentity(_ context.Context, resource *descriptor.Resource) (runtime.Identity, error) {
+	npm, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	return internal.CredentialConsumerIdentity(npm.Registry)
+}
+
+// ProcessResourceDigest downloads the tarball of the resource, verifies the registry checksum and sets
+// the SHA-256 digest of the tarball as generic blob digest of the resource.
+// An existing resource digest is verified instead of overwritten.
+func (p *DigestProcessor) ProcessResourceDigest(ctx context.Context, resource *descriptor.Resource, credentials runtime.Typed) (*descriptor.Resource, error) {
+	npm, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	// an existing digest is verified while downloading.
+	var expected godigest.Digest
+	if resource.Digest != nil {
+		if expected = internal.ExpectedDigest(resource.Digest); expected == "" {
+			return nil, fmt.Errorf("failed to verify digest of resource: unsupported digest %s/%s", resource.Digest.NormalisationAlgorithm, resource.Digest.HashAlgorithm)
+		}
+	}
+	dig, err := p.client.Digest(ctx, npm, credentials, expected, true)
+	if err != nil {
+		return nil, err
+	}
+
+	resource = resource.DeepCopy()
+	if resource.Digest == nil {
+		resource.Digest = &descriptor.Digest{}
+		if err := internal.ApplyDigest(resource.Digest, dig); err != nil {
+			return nil, fmt.Errorf("failed to apply digest to resource: %w", err)
+		}
+	}
+	return resource, nil
+}
+
+func convertAccess(resource *descriptor.Resource) (*v1.Npm, error) {
+	if resource == nil || resource.Access == nil {
+		return nil, fmt.Errorf("resource access is required")
+	}
+	var npm v1.Npm
+	if err := npmaccess.Scheme.Convert(resource.Access, &npm); err != nil {
+		return nil, fmt.Errorf("error converting resource access spec: %w", err)
+	}
+	if err := internal.Validate(&npm); err != nil {
+		return nil, err
+	}
+	return &npm, nil
+}
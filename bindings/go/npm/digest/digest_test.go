@@ -0,0 +1,74 @@
+package digest_test
+
+import (
+	"testing"
+
+	godigest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/npm/digest"
+	"ocm.software/open-component-model/bindings/go/npm/internal/stubregistry"
+	v1 "ocm.software/open-component-model/bindings/go/npm/spec/access/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const content = "package tarball"
+
+func newResource(registry, version string, dig *descriptor.Digest) *descriptor.Resource {
+	return &descriptor.Resource{
+		ElementMeta: descriptor.ElementMeta{
+			ObjectMeta: descriptor.ObjectMeta{Name: "package", Version: version},
+		},
+		Type:     "npmPackage",
+		Relation: descriptor.ExternalRelation,
+		Access: &v1.Npm{
+			Type:     runtime.NewUnversionedType(v1.LegacyType),
+			Registry: registry,
+			Package:  "left-pad",
+			Version:  version,
+		},
+		Digest: dig,
+	}
+}
+
+func TestDigestProcessor_ProcessResourceDigest(t *testing.T) {
+	registry := stubregistry.New(t)
+	registry.Publish("left-pad", "1.3.0", []byte(content), map[string]any{
+		"tarball": registry.URL + "/left-pad/-/left-pad-1.3.0.tgz",
+		"shasum":  "70328599cc7b96e3542d6fbcd5baaebb2251b73d",
+	})
+	registry.Publish("left-pad", "1.2.0", []byte(content), map[string]any{
+		"tarball": registry.URL + "/left-pad/-/left-pad-1.2.0.tgz",
+	})
+	processor := digest.NewDigestProcessor()
+	contentDigest := godigest.FromString(content)
+
+	t.Run("sets digest", func(t *testing.T) {
+		original := newResource(registry.URL, "1.3.0", nil)
+		processed, err := processor.ProcessResourceDigest(t.Context(), original, nil)
+		require.NoError(t, err)
+
+		assert.Nil(t, original.Digest, "input resource must not be modified")
+		assert.Equal(t, &descriptor.Digest{
+			HashAlgorithm:          "SHA-256",
+			NormalisationAlgorithm: "genericBlobDigest/v1",
+			Value:                  contentDigest.Encoded(),
+		}, processed.Digest)
+	})
+
+	t.Run("verifies existing digest", func(t *testing.T) {
+		_, err := processor.ProcessResourceDigest(t.Context(), newResource(registry.URL, "1.3.0", &descriptor.Digest{
+			HashAlgorithm:          "SHA-256",
+			NormalisationAlgorithm: "genericBlobDigest/v1",
+			Value:                  godigest.FromString("other").Encoded(),
+		}), nil)
+		require.ErrorContains(t, err, "digest mismatch")
+	})
+
+	t.Run("requires registry checksum", func(t *testing.T) {
+		_, err := processor.ProcessResourceDigest(t.Context(), newResource(registry.URL, "1.2.0", nil), nil)
+		require.ErrorContains(t, err, "no checksum published")
+	})
+}
@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/opencontainers/go-digest"
+
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+)
+
+const (
+	HashAlgorithmSHA256        = "SHA-256"
+	HashAlgorithmSHA512        = "SHA-512"
+	GenericBlobDigestAlgorithm = "genericBlobDigest/v1"
+)
+
+// ErrDigestMismatch is returned if content does not match the digest of the resource.
+var ErrDigestMismatch = errors.New("digest mismatch")
+
+var shaMapping = map[digest.Algorithm]string{
+	digest.SHA256: HashAlgorithmSHA256,
+	digest.SHA512: HashAlgorithmSHA512,
+}
+
+// ApplyDigest sets the given digest as generic blob digest of the target.
+func ApplyDigest(target *descriptor.Digest, d digest.Digest) error {
+	algo, ok := shaMapping[d.Algorithm()]
+	if !ok {
+		return fmt.Errorf("unknown digest algorithm: %s", d.Algorithm())
+	}
+	target.HashAlgorithm = algo
+	target.NormalisationAlgorithm = GenericBlobDigestAlgorithm
+	target.Value = d.Encoded()
+	return nil
+}
+
+// ExpectedDigest returns the digest described by a generic blob digest of a resource.
+// Returns an empty digest if the resource has no digest or it uses another normalisation.
+func ExpectedDigest(d *descriptor.Digest) digest.Digest {
+	if d == nil || d.NormalisationAlgorithm != GenericBlobDigestAlgorithm {
+		return ""
+	}
+	for algorithm, name := range shaMapping {
+		if name == d.HashAlgorithm {
+			return digest.NewDigestFromEncoded(algorithm, d.Value)
+		}
+	}
+	return ""
+}
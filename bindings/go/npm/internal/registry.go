@@ -0,0 +1,432 @@
+package internal
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1" //nolint:gosec // G505: sha1 shasums are published by npm registries and only used for verification.
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	nethttp "net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+
+	"ocm.software/open-component-model/bindings/go/blob/filesystem"
+	ocmhttp "ocm.software/open-component-model/bindings/go/http"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	v1 "ocm.software/open-component-model/bindings/go/npm/spec/access/v1"
+	credsv1 "ocm.software/open-component-model/bindings/go/npm/spec/credentials/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/npm/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	// DefaultUserAgent is used for requests if no user agent is configured.
+	DefaultUserAgent = "ocm.software/open-component-model/bindings/go/npm"
+	// MediaType is the media type of package tarballs.
+	MediaType = "application/x-tgz"
+	// DistTag is the dist-tag set for published versions, as done by npm publish.
+	DistTag = "latest"
+)
+
+var (
+	// ErrChecksumMismatch is returned if a tarball does not match the checksum published by the registry.
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	// ErrNoChecksum is returned if a checksum is required but the registry does not publish one.
+	ErrNoChecksum = errors.New("no checksum published")
+)
+
+// Dist is the distribution metadata of a package version.
+type Dist struct {
+	Tarball   string `json:"tarball"`
+	Shasum    string `json:"shasum,omitempty"`
+	Integrity string `json:"integrity,omitempty"`
+}
+
+// Validate checks that the access references a package version.
+func Validate(access *v1.Npm) error {
+	switch {
+	case access.Registry == "":
+		return fmt.Errorf("registry is required for npm access")
+	case access.Package == "":
+		return fmt.Errorf("package is required for npm access")
+	case access.Version == "":
+		return fmt.Errorf("version is required for npm access")
+	}
+	return nil
+}
+
+// CredentialConsumerIdentity resolves the credential consumer identity for the given registry URL.
+// The identity contains the scheme, hostname, port and path of the URL.
+func CredentialConsumerIdentity(registry string) (runtime.Identity, error) {
+	if !strings.HasPrefix(registry, "https://") && !strings.HasPrefix(registry, "http://") {
+		return nil, fmt.Errorf("npm registry %q must use the http or https scheme", registry)
+	}
+	identity, err := runtime.ParseURLToIdentity(registry)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing npm registry url to identity: %w", err)
+	}
+	identity.SetType(identityv1.Type)
+	return identity, nil
+}
+
+// TarballName returns the file name of the tarball of a package version, e.g. node-22.0.0.tgz for @types/node.
+func TarballName(pkg, version string) string {
+	return path.Base(pkg) + "-" + version + ".tgz"
+}
+
+// Client downloads and publishes package versions of npm registries.
+type Client struct {
+	HTTPConfig *httpv1alpha1.Config
+	UserAgent  string
+	// TempDir is the directory downloaded tarballs are buffered in.
+	// If empty, the default directory for temporary files is used.
+	TempDir string
+}
+
+// Download fetches the tarball of the package version into a temporary file and verifies it against the
+// checksum published by the registry (the integrity, or the shasum for old packages) and against the
+// expected digest, if set.
+// Besides the blob, the digest of the tarball is returned. It is calculated with the algorithm of the
+// expected digest, or with the canonical algorithm if no digest is expected.
+// If requireChecksum is set, ErrNoChecksum is returned for versions without published checksum.
+func (c *Client) Download(ctx context.Context, access *v1.Npm, credentials runtime.Typed, expected digest.Digest, requireChecksum bool) (*filesystem.Blob, digest.Digest, error) {
+	tarball, err := c.open(ctx, access, credentials, expected, requireChecksum)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = tarball.Close()
+	}()
+	b, err := copyToTempBlob(c.TempDir, "npm-download-*", tarball, tarball.verify)
+	if err != nil {
+		return nil, "", fmt.Errorf("error downloading tarball of %s: %w", access, err)
+	}
+	b.SetMediaType(MediaType)
+	return b, tarball.digester.Digest(), nil
+}
+
+// Digest fetches the tarball of the package version without storing it and verifies it like Download.
+// The digest of the tarball is returned.
+func (c *Client) Digest(ctx context.Context, access *v1.Npm, credentials runtime.Typed, expected digest.Digest, requireChecksum bool) (digest.Digest, error) {
+	tarball, err := c.open(ctx, access, credentials, expected, requireChecksum)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = tarball.Close()
+	}()
+	if _, err := io.Copy(io.Discard, tarball); err != nil {
+		return "", fmt.Errorf("error downloading tarball of %s: %w", access, err)
+	}
+	if err := tarball.verify(); err != nil {
+		return "", fmt.Errorf("error downloading tarball of %s: %w", access, err)
+	}
+	return tarball.digester.Digest(), nil
+}
+
+func (c *Client) open(ctx context.Context, access *v1.Npm, credentials runtime.Typed, expected digest.Digest, requireChecksum bool) (*tarballReader, error) {
+	reg, err := c.registry(access.Registry, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata struct {
+		Dist Dist `json:"dist"`
+	}
+	if err := reg.getJSON(ctx, reg.packageURL(access.Package)+"/"+url.PathEscape(access.Version), &metadata); err != nil {
+		return nil, fmt.Errorf("error getting metadata of %s: %w", access, err)
+	}
+	if metadata.Dist.Tarball == "" {
+		return nil, fmt.Errorf("no tarball published for %s", access)
+	}
+	cs := publishedChecksum(metadata.Dist)
+	if cs == nil && requireChecksum {
+		return nil, fmt.Errorf("error verifying tarball of %s: %w", access, ErrNoChecksum)
+	}
+
+	rc, err := reg.open(ctx, metadata.Dist.Tarball)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading tarball of %s: %w", access, err)
+	}
+	algorithm := digest.Canonical
+	if expected != "" {
+		algorithm = expected.Algorithm()
+	}
+	r := &tarballReader{
+		ReadCloser: rc,
+		digester:   algorithm.Digester(),
+		expected:   expected,
+		checksum:   cs,
+	}
+	writers := []io.Writer{r.digester.Hash()}
+	if cs != nil {
+		writers = append(writers, cs.hash)
+	}
+	r.tee = io.TeeReader(rc, io.MultiWriter(writers...))
+	return r, nil
+}
+
+// tarballReader hashes a tarball while it is read,
+// so that it can be verified without holding it in memory.
+type tarballReader struct {
+	io.ReadCloser
+	tee      io.Reader
+	digester digest.Digester
+	expected digest.Digest
+	checksum *checksum
+}
+
+func (r *tarballReader) Read(p []byte) (int, error) {
+	return r.tee.Read(p)
+}
+
+// verify checks the content read so far against the published checksum and the expected digest.
+func (r *tarballReader) verify() error {
+	if r.checksum != nil {
+		if actual := r.checksum.encode(r.checksum.hash.Sum(nil)); actual != r.checksum.published {
+			return fmt.Errorf("%w: %s is %s, registry published %s", ErrChecksumMismatch, r.checksum.name, actual, r.checksum.published)
+		}
+	}
+	if actual := r.digester.Digest(); r.expected != "" && actual != r.expected {
+		return fmt.Errorf("%w: tarball has digest %s, expected %s", ErrDigestMismatch, actual, r.expected)
+	}
+	return nil
+}
+
+// Upload publishes the tarball as the package version of the access.
+// The package manifest is taken from the package.json in the tarball, whose name and version
+// must match the access.
+func (c *Client) Upload(ctx context.Context, access *v1.Npm, credentials runtime.Typed, data []byte) error {
+	reg, err := c.registry(access.Registry, credentials)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := packageManifest(data)
+	if err != nil {
+		return fmt.Errorf("error reading package.json of %s: %w", access, err)
+	}
+	if name, _ := manifest["name"].(string); name != access.Package {
+		return fmt.Errorf("package.json of tarball has name %q, expected %q", name, access.Package)
+	}
+	if version, _ := manifest["version"].(string); version != access.Version {
+		return fmt.Errorf("package.json of tarball has version %q, expected %q", version, access.Version)
+	}
+
+	tarballName := TarballName(access.Package, access.Version)
+	manifest["_id"] = access.Package + "@" + access.Version
+	manifest["dist"] = Dist{
+		Tarball:   reg.packageURL(access.Package) + "/-/" + tarballName,
+		Shasum:    sha1Hex(data),
+		Integrity: sha512Integrity(data),
+	}
+	document := map[string]any{
+		"_id":       access.Package,
+		"name":      access.Package,
+		"dist-tags": map[string]string{DistTag: access.Version},
+		"versions":  map[string]any{access.Version: manifest},
+		"_attachments": map[string]any{
+			tarballName: map[string]any{
+				"content_type": "application/octet-stream",
+				"data":         base64.StdEncoding.EncodeToString(data),
+				"length":       len(data),
+			},
+		},
+	}
+	body, err := json.Marshal(document)
+	if err != nil {
+		return fmt.Errorf("error encoding publish document of %s: %w", access, err)
+	}
+	if err := reg.put(ctx, reg.packageURL(access.Package), body); err != nil {
+		return fmt.Errorf("error publishing %s: %w", access, err)
+	}
+	return nil
+}
+
+// checksum is a checksum published by the registry for a tarball.
+type checksum struct {
+	name      string
+	published string
+	hash      hash.Hash
+	encode    func(sum []byte) string
+}
+
+// publishedChecksum returns the integrity of the dist, falling back to the shasum for packages published
+// before integrities were introduced. Returns nil if the dist has no supported checksum.
+func publishedChecksum(dist Dist) *checksum {
+	// an integrity may list several hashes separated by whitespace, only sha512 is supported.
+	for _, integrity := range strings.Fields(dist.Integrity) {
+		if strings.HasPrefix(integrity, "sha512-") {
+			return &checksum{name: "integrity", published: integrity, hash: sha512.New(), encode: func(sum []byte) string {
+				return "sha512-" + base64.StdEncoding.EncodeToString(sum)
+			}}
+		}
+	}
+	if dist.Shasum != "" {
+		return &checksum{name: "shasum", published: strings.ToLower(dist.Shasum), hash: sha1.New(), encode: hex.EncodeToString} //nolint:gosec // G401: the shasum format of npm is sha1.
+	}
+	return nil
+}
+
+func sha512Integrity(data []byte) string {
+	sum := sha512.Sum512(data)
+	return "sha512-" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func sha1Hex(data []byte) string {
+	sum := sha1.Sum(data) //nolint:gosec // G401: the shasum format of npm is sha1.
+	return hex.EncodeToString(sum[:])
+}
+
+// packageManifest reads package/package.json from the gzipped tarball.
+func packageManifest(data []byte) (map[string]any, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("tarball is not gzip compressed: %w", err)
+	}
+	defer func() {
+		_ = gz.Close()
+	}()
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("package/package.json not found in tarball")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading tarball: %w", err)
+		}
+		if path.Clean(hdr.Name) != "package/package.json" {
+			continue
+		}
+		var manifest map[string]any
+		if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+			return nil, fmt.Errorf("error decoding package.json: %w", err)
+		}
+		return manifest, nil
+	}
+}
+
+type registry struct {
+	base        *url.URL
+	credentials *credsv1.NpmCredentials
+	client      *nethttp.Client
+}
+
+func (c *Client) registry(registryURL string, credentials runtime.Typed) (*registry, error) {
+	if _, err := CredentialConsumerIdentity(registryURL); err != nil {
+		return nil, err
+	}
+	base, err := url.Parse(strings.TrimSuffix(registryURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid npm registry url %q: %w", registryURL, err)
+	}
+	creds, err := credsv1.ConvertToNpmCredentials(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("error converting credentials: %w", err)
+	}
+	userAgent := c.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	return &registry{
+		base:        base,
+		credentials: creds,
+		client:      ocmhttp.New(ocmhttp.WithConfig(c.HTTPConfig), ocmhttp.WithUserAgent(userAgent)),
+	}, nil
+}
+
+// packageURL returns the URL of the package document. The slash of scoped packages is escaped.
+func (r *registry) packageURL(pkg string) string {
+	return r.base.String() + "/" + url.PathEscape(pkg)
+}
+
+func (r *registry) getJSON(ctx context.Context, u string, v any) error {
+	data, err := r.get(ctx, u)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error decoding response of %s: %w", u, err)
+	}
+	return nil
+}
+
+func (r *registry) get(ctx context.Context, u string) ([]byte, error) {
+	rc, err := r.open(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rc.Close()
+	}()
+	return io.ReadAll(rc)
+}
+
+func (r *registry) open(ctx context.Context, u string) (io.ReadCloser, error) {
+	resp, err := r.do(ctx, nethttp.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case nethttp.StatusOK:
+		return resp.Body, nil
+	case nethttp.StatusNotFound:
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%s: %w", u, fs.ErrNotExist)
+	default:
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s for %s", resp.Status, u)
+	}
+}
+
+func (r *registry) put(ctx context.Context, u string, body []byte) error {
+	resp, err := r.do(ctx, nethttp.MethodPut, u, body)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != nethttp.StatusOK && resp.StatusCode != nethttp.StatusCreated {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("unexpected status %s for %s: %s", resp.Status, u, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func (r *registry) do(ctx context.Context, method, u string, body []byte) (*nethttp.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := nethttp.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	// tarballs may be served from other hosts, credentials are only sent to the registry.
+	if r.credentials != nil && req.URL.Host == r.base.Host {
+		switch {
+		case r.credentials.Token != "":
+			req.Header.Set("Authorization", "Bearer "+r.credentials.Token)
+		case r.credentials.Username != "" || r.credentials.Password != "":
+			req.SetBasicAuth(r.credentials.Username, r.credentials.Password)
+		}
+	}
+	return r.client.Do(req)
+}
@@ -0,0 +1,149 @@
+// Package stubregistry provides an in-process stub of an npm registry for tests.
+//
+// The stub serves version documents (GET /<package>/<version>) and tarballs, and accepts
+// publish documents (PUT /<package>) as sent by npm publish.
+// If a token is configured, all requests must carry it as bearer token.
+package stubregistry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// Version is a published package version.
+type Version struct {
+	Manifest map[string]any
+	Tarball  []byte
+}
+
+// Registry is a stub npm registry.
+type Registry struct {
+	*httptest.Server
+
+	// Token is the bearer token requests must carry. If empty, anonymous requests are accepted.
+	Token string
+
+	mu       sync.Mutex
+	packages map[string]map[string]*Version
+}
+
+// New starts a stub npm registry. It is closed with the cleanup of the test.
+func New(t interface{ Cleanup(func()) }) *Registry {
+	r := &Registry{packages: map[string]map[string]*Version{}}
+	r.Server = httptest.NewServer(nethttp.HandlerFunc(r.serve))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// Publish adds a package version with the given dist metadata. The tarball is served at dist.tarball.
+func (r *Registry) Publish(pkg, version string, tarball []byte, dist map[string]any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.packages[pkg] == nil {
+		r.packages[pkg] = map[string]*Version{}
+	}
+	r.packages[pkg][version] = &Version{
+		Manifest: map[string]any{"name": pkg, "version": version, "dist": dist},
+		Tarball:  tarball,
+	}
+}
+
+// Get returns the published package version.
+func (r *Registry) Get(pkg, version string) (*Version, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v, ok := r.packages[pkg][version]
+	return v, ok
+}
+
+func (r *Registry) serve(w nethttp.ResponseWriter, req *nethttp.Request) {
+	if r.Token != "" && req.Header.Get("Authorization") != "Bearer "+r.Token {
+		nethttp.Error(w, "unauthorized", nethttp.StatusUnauthorized)
+		return
+	}
+
+	// scoped packages are requested with an escaped slash.
+	p := strings.TrimPrefix(req.URL.EscapedPath(), "/")
+	p = strings.ReplaceAll(strings.ReplaceAll(p, "%2F", "/"), "%2f", "/")
+
+	switch req.Method {
+	case nethttp.MethodPut:
+		r.publish(w, req, p)
+	case nethttp.MethodGet:
+		if pkg, file, ok := strings.Cut(p, "/-/"); ok {
+			r.serveTarball(w, pkg, file)
+			return
+		}
+		idx := strings.LastIndex(p, "/")
+		if idx <= 0 {
+			nethttp.NotFound(w, req)
+			return
+		}
+		v, ok := r.Get(p[:idx], p[idx+1:])
+		if !ok {
+			nethttp.NotFound(w, req)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v.Manifest)
+	default:
+		nethttp.Error(w, "method not allowed", nethttp.StatusMethodNotAllowed)
+	}
+}
+
+func (r *Registry) serveTarball(w nethttp.ResponseWriter, pkg, file string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, v := range r.packages[pkg] {
+		if dist, ok := v.Manifest["dist"].(map[string]any); ok && strings.HasSuffix(dist["tarball"].(string), "/"+file) {
+			_, _ = w.Write(v.Tarball)
+			return
+		}
+	}
+	nethttp.Error(w, "not found", nethttp.StatusNotFound)
+}
+
+func (r *Registry) publish(w nethttp.ResponseWriter, req *nethttp.Request, pkg string) {
+	var doc struct {
+		Name        string                    `json:"name"`
+		Versions    map[string]map[string]any `json:"versions"`
+		Attachments map[string]struct {
+			Data   string `json:"data"`
+			Length int    `json:"length"`
+		} `json:"_attachments"`
+	}
+	data, err := io.ReadAll(req.Body)
+	if err == nil {
+		err = json.Unmarshal(data, &doc)
+	}
+	if err != nil || doc.Name != pkg || len(doc.Versions) != 1 || len(doc.Attachments) != 1 {
+		nethttp.Error(w, "invalid publish document", nethttp.StatusBadRequest)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for version, manifest := range doc.Versions {
+		if _, exists := r.packages[pkg][version]; exists {
+			nethttp.Error(w, "cannot publish over existing version", nethttp.StatusForbidden)
+			return
+		}
+		for _, attachment := range doc.Attachments {
+			tarball, err := base64.StdEncoding.DecodeString(attachment.Data)
+			if err != nil || len(tarball) != attachment.Length {
+				nethttp.Error(w, "invalid attachment", nethttp.StatusBadRequest)
+				return
+			}
+			if r.packages[pkg] == nil {
+				r.packages[pkg] = map[string]*Version{}
+			}
+			r.packages[pkg][version] = &Version{Manifest: manifest, Tarball: tarball}
+		}
+	}
+	w.WriteHeader(nethttp.StatusCreated)
+}
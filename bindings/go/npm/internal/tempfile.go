@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"ocm.software/open-component-model/bindings/go/blob/filesystem"
+)
+
+// copyToTempBlob copies the content of r into a new temporary file in dir and returns a read-only blob of the file.
+// If dir is empty, the default directory for temporary files is used.
+// If verify is not nil, it is called once all content has been copied, e.g. to check a digest calculated while reading r.
+// The file is removed if copying or verification fails, otherwise the caller is responsible for removing it.
+func copyToTempBlob(dir, pattern string, r io.Reader, verify func() error) (_ *filesystem.Blob, err error) {
+	file, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, os.Remove(file.Name()))
+		}
+	}()
+
+	if _, err := io.Copy(file, r); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to copy data to temporary file: %w", err), file.Close())
+	}
+	if err := file.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close temporary file: %w", err)
+	}
+	if verify != nil {
+		if err := verify(); err != nil {
+			return nil, err
+		}
+	}
+	return filesystem.GetBlobFromOSPath(file.Name())
+}
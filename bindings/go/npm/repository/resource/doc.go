@@ -0,0 +1,25 @@
+// Package resource implements [repository.ResourceRepository] for package tarballs in npm registries.
+//
+// The [ResourceRepository] handles the Npm/v1 access type. A package version is addressed by the
+// registry URL, the package name (including the scope of scoped packages) and the version.
+// Downloads are verified against the checksum the registry publishes for the version (the sha512
+// integrity, or the sha1 shasum of old packages). Uploads publish the tarball like npm publish,
+// using the package.json of the tarball as manifest of the version.
+// Downloaded tarballs are hashed while they are streamed into a temporary file (see [WithTempDir]),
+// so that large packages are never held in memory.
+//
+// # Credentials
+//
+// The consumer identity of type NpmRegistry carries the scheme, hostname, port and path of the
+// registry URL. Credentials of type NpmCredentials/v1 or DirectCredentials with username/password (basic
+// authentication) or token (bearer authentication) are supported. Credentials are only sent to the
+// registry, not to other hosts serving tarballs.
+//
+// # Usage
+//
+//	repo := resource.NewResourceRepository(resource.WithHTTPConfig(httpConfig))
+//
+//	identity, err := repo.GetResourceCredentialConsumerIdentity(ctx, res)
+//	content, err := repo.DownloadResource(ctx, res, creds)
+//	uploaded, err := repo.UploadResource(ctx, target, content, creds)
+package resource
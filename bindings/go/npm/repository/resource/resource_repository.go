@@ -0,0 +1,136 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/npm/internal"
+	npmaccess "ocm.software/open-component-model/bindings/go/npm/spec/access"
+	v1 "ocm.software/open-component-model/bindings/go/npm/spec/access/v1"
+	"ocm.software/open-component-model/bindings/go/repository"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// ResourceRepository implements a resource repository for package tarballs in npm registries.
+// Requests use the retrying client of the http bindings, so per-host timeouts and retry
+// policies of the HTTP configuration apply.
+type ResourceRepository struct {
+	client internal.Client
+}
+
+// Option configures a ResourceRepository.
+type Option func(*ResourceRepository)
+
+// WithHTTPConfig sets the HTTP client configuration used for requests.
+func WithHTTPConfig(cfg *httpv1alpha1.Config) Option {
+	return func(r *ResourceRepository) {
+		r.client.HTTPConfig = cfg
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with all requests.
+func WithUserAgent(userAgent string) Option {
+	return func(r *ResourceRepository) {
+		r.client.UserAgent = userAgent
+	}
+}
+
+// WithTempDir sets the directory downloaded tarballs are buffered in.
+func WithTempDir(dir string) Option {
+	return func(r *ResourceRepository) {
+		r.client.TempDir = dir
+	}
+}
+
+var _ repository.ResourceRepository = (*ResourceRepository)(nil)
+
+// NewResourceRepository creates a ResourceRepository.
+func NewResourceRepository(opts ...Option) *ResourceRepository {
+	r := &ResourceRepository{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// GetResourceRepositoryScheme returns the npm access scheme containing the
+// Npm/v1 type and its aliases.
+func (r *ResourceRepository) GetResourceRepositoryScheme() *runtime.Scheme {
+	return npmaccess.Scheme
+}
+
+// GetResourceCredentialConsumerIdentity resolves the NpmRegistry credential consumer identity
+// from the registry URL of the resource.
+func (r *ResourceRepository) GetResourceCredentialConsumerIdentity(_ context.Context, resource *descriptor.Resource) (runtime.Identity, error) {
+	npm, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	return internal.CredentialConsumerIdentity(npm.Registry)
+}
+
+// DownloadResource fetches the package tarball referenced by the npm access of the resource.
+// The tarball is verified against the checksum published by the registry and against the
+// generic blob digest of the resource, if present.
+// The tarball is buffered in a temporary file, which is not removed by the repository.
+func (r *ResourceRepository) DownloadResource(ctx context.Context, resource *descriptor.Resource, credentials runtime.Typed) (blob.ReadOnlyBlob, error) {
+	npm, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := r.client.Download(ctx, npm, credentials, internal.ExpectedDigest(resource.Digest), false)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading resource %s: %w", resource.ToIdentity(), err)
+	}
+	return data, nil
+}
+
+// UploadResource publishes the content as the package version referenced by the npm access of the resource.
+// The content must be a package tarball whose package.json matches the package and version of the access.
+// Registries reject publishing over existing versions.
+func (r *ResourceRepository) UploadResource(ctx context.Context, resource *descriptor.Resource, content blob.ReadOnlyBlob, credentials runtime.Typed) (_ *descriptor.Resource, err error) {
+	npm, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := content.ReadCloser()
+	if err != nil {
+		return nil, fmt.Errorf("error reading content for %s: %w", npm, err)
+	}
+	defer func() {
+		err = errors.Join(err, rc.Close())
+	}()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("error reading content for %s: %w", npm, err)
+	}
+
+	if err := r.client.Upload(ctx, npm, credentials, data); err != nil {
+		return nil, err
+	}
+
+	resource = resource.DeepCopy()
+	npm.Type = runtime.NewVersionedType(v1.Type, v1.Version)
+	resource.Access = npm
+	return resource, nil
+}
+
+func convertAccess(resource *descriptor.Resource) (*v1.Npm, error) {
+	if resource == nil || resource.Access == nil {
+		return nil, fmt.Errorf("resource access is required")
+	}
+	var npm v1.Npm
+	if err := npmaccess.Scheme.Convert(resource.Access, &npm); err != nil {
+		return nil, fmt.Errorf("error converting access to npm spec: %w", err)
+	}
+	if err := internal.Validate(&npm); err != nil {
+		return nil, err
+	}
+	return &npm, nil
+}
@@ -0,0 +1,164 @@
+package resource_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha512"
+	"encoding/base64"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	"ocm.software/open-component-model/bindings/go/blob/inmemory"
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/npm/internal/stubregistry"
+	"ocm.software/open-component-model/bindings/go/npm/repository/resource"
+	v1 "ocm.software/open-component-model/bindings/go/npm/spec/access/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/npm/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// tarball creates a package tarball with the given package.json.
+func tarball(t *testing.T, packageJSON string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "package/package.json", Mode: 0o644, Size: int64(len(packageJSON))}))
+	_, err := tw.Write([]byte(packageJSON))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func integrity(data []byte) string {
+	sum := sha512.Sum512(data)
+	return "sha512-" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func newResource(registry, pkg, version string) *descriptor.Resource {
+	return &descriptor.Resource{
+		ElementMeta: descriptor.ElementMeta{
+			ObjectMeta: descriptor.ObjectMeta{Name: "package", Version: version},
+		},
+		Type:     "npmPackage",
+		Relation: descriptor.ExternalRelation,
+		Access: &v1.Npm{
+			Type:     runtime.NewVersionedType(v1.Type, v1.Version),
+			Registry: registry,
+			Package:  pkg,
+			Version:  version,
+		},
+	}
+}
+
+func credentials() runtime.Typed {
+	return &credv1.DirectCredentials{
+		Type:       runtime.NewVersionedType(credv1.CredentialsType, credv1.Version),
+		Properties: map[string]string{"token": "secret"},
+	}
+}
+
+func readAll(t *testing.T, b blob.ReadOnlyBlob) []byte {
+	t.Helper()
+	rc, err := b.ReadCloser()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, rc.Close())
+	}()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return data
+}
+
+func TestResourceRepository_GetResourceCredentialConsumerIdentity(t *testing.T) {
+	repo := resource.NewResourceRepository()
+
+	identity, err := repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource("https://npm.example.com/registry", "left-pad", "1.3.0"))
+	require.NoError(t, err)
+	assert.Equal(t, identityv1.Type.String(), identity[runtime.IdentityAttributeType])
+	assert.Equal(t, "npm.example.com", identity[runtime.IdentityAttributeHostname])
+	assert.Equal(t, "registry", identity[runtime.IdentityAttributePath])
+
+	_, err = repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource("https://npm.example.com", "", "1.3.0"))
+	assert.ErrorContains(t, err, "package is required")
+}
+
+func TestResourceRepository_DownloadResource(t *testing.T) {
+	registry := stubregistry.New(t)
+	registry.Token = "secret"
+	data := tarball(t, `{"name":"@acme/ui","version":"1.0.0"}`)
+	registry.Publish("@acme/ui", "1.0.0", data, map[string]any{
+		"tarball":   registry.URL + "/@acme/ui/-/ui-1.0.0.tgz",
+		"integrity": integrity(data),
+	})
+	registry.Publish("@acme/ui", "0.9.0", data, map[string]any{
+		"tarball": registry.URL + "/@acme/ui/-/ui-0.9.0.tgz",
+		"shasum":  "0000000000000000000000000000000000000000",
+	})
+	repo := resource.NewResourceRepository(resource.WithTempDir(t.TempDir()))
+
+	t.Run("scoped package", func(t *testing.T) {
+		b, err := repo.DownloadResource(t.Context(), newResource(registry.URL, "@acme/ui", "1.0.0"), credentials())
+		require.NoError(t, err)
+		assert.Equal(t, data, readAll(t, b))
+
+		mediaType, _ := b.(blob.MediaTypeAware).MediaType()
+		assert.Equal(t, "application/x-tgz", mediaType)
+		dig, _ := b.(blob.DigestAware).Digest()
+		assert.Equal(t, digest.FromBytes(data).String(), dig)
+	})
+
+	t.Run("shasum mismatch", func(t *testing.T) {
+		tempDir := t.TempDir()
+		repo := resource.NewResourceRepository(resource.WithTempDir(tempDir))
+		_, err := repo.DownloadResource(t.Context(), newResource(registry.URL, "@acme/ui", "0.9.0"), credentials())
+		assert.ErrorContains(t, err, "checksum mismatch")
+		entries, err := os.ReadDir(tempDir)
+		require.NoError(t, err)
+		assert.Empty(t, entries, "tarballs not matching the checksum must not be kept")
+	})
+
+	t.Run("missing credentials", func(t *testing.T) {
+		_, err := repo.DownloadResource(t.Context(), newResource(registry.URL, "@acme/ui", "1.0.0"), nil)
+		assert.ErrorContains(t, err, "401")
+	})
+
+	t.Run("missing version", func(t *testing.T) {
+		_, err := repo.DownloadResource(t.Context(), newResource(registry.URL, "@acme/ui", "2.0.0"), credentials())
+		assert.ErrorContains(t, err, "not exist")
+	})
+}
+
+func TestResourceRepository_UploadResource(t *testing.T) {
+	registry := stubregistry.New(t)
+	repo := resource.NewResourceRepository()
+	data := tarball(t, `{"name":"left-pad","version":"1.3.0","main":"index.js"}`)
+
+	uploaded, err := repo.UploadResource(t.Context(), newResource(registry.URL, "left-pad", "1.3.0"), inmemory.New(bytes.NewReader(data)), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "left-pad", uploaded.Access.(*v1.Npm).Package)
+
+	published, ok := registry.Get("left-pad", "1.3.0")
+	require.True(t, ok)
+	assert.Equal(t, data, published.Tarball)
+	assert.Equal(t, "index.js", published.Manifest["main"])
+
+	b, err := repo.DownloadResource(t.Context(), uploaded, nil)
+	require.NoError(t, err)
+	assert.Equal(t, data, readAll(t, b))
+
+	_, err = repo.UploadResource(t.Context(), newResource(registry.URL, "left-pad", "1.3.0"), inmemory.New(bytes.NewReader(data)), nil)
+	assert.ErrorContains(t, err, "403")
+
+	_, err = repo.UploadResource(t.Context(), newResource(registry.URL, "left-pad", "1.4.0"), inmemory.New(bytes.NewReader(data)), nil)
+	assert.ErrorContains(t, err, `has version "1.3.0", expected "1.4.0"`)
+}
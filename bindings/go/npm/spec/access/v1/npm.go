@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	Version    = "v1"
+	Type       = "Npm"
+	LegacyType = "npm"
+)
+
+// Npm describes the access for the tarball of a package version in an npm registry.
+// This spec is aligned with ocm v1 https://github.com/open-component-model/ocm/blob/main/api/ocm/extensions/accessmethods/npm/method.go
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type Npm struct {
+	// +ocm:jsonschema-gen:enum=Npm/v1,npm/v1
+	// +ocm:jsonschema-gen:enum:deprecated=Npm,npm
+	Type runtime.Type `json:"type"`
+
+	// Registry is the URL of the npm registry, e.g. https://registry.npmjs.org.
+	Registry string `json:"registry"`
+
+	// Package is the name of the package, including the scope for scoped packages (e.g. @types/node).
+	Package string `json:"package"`
+
+	// Version is the version of the package.
+	Version string `json:"version"`
+}
+
+func (n *Npm) String() string {
+	return n.Package + "@" + n.Version
+}
@@ -0,0 +1,12 @@
+package credentials
+
+import (
+	v1 "ocm.software/open-component-model/bindings/go/npm/spec/credentials/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	v1.MustRegisterCredentialType(Scheme)
+}
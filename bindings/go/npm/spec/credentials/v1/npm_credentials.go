@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	//nolint:gosec // G101: This is a type name, not a credential.
+	NpmCredentialsType = "NpmCredentials"
+	Version            = "v1"
+)
+
+// NpmCredentials represents typed credentials for npm registries.
+// Either Username and Password, or Token can be set. A token is sent as bearer token,
+// username and password are sent with basic authentication.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type NpmCredentials struct {
+	// +ocm:jsonschema-gen:enum=NpmCredentials/v1
+	// +ocm:jsonschema-gen:enum:deprecated=NpmCredentials
+	Type     runtime.Type `json:"type"`
+	Username string       `json:"username,omitempty"`
+	Password string       `json:"password,omitempty"`
+	Token    string       `json:"token,omitempty"`
+}
+
+// MustRegisterCredentialType registers NpmCredentials/v1 in the given scheme.
+func MustRegisterCredentialType(scheme *runtime.Scheme) {
+	scheme.MustRegisterWithAlias(&NpmCredentials{},
+		runtime.NewVersionedType(NpmCredentialsType, Version),
+		runtime.NewUnversionedType(NpmCredentialsType),
+	)
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	NpmRegistryIdentityType = "NpmRegistry"
+	Version                 = "v1"
+)
+
+// Type is the unversioned consumer identity type for npm registries (backward compat).
+var Type = runtime.NewUnversionedType(NpmRegistryIdentityType)
+
+// VersionedType is the versioned consumer identity type.
+// Identities of this type carry the hostname, scheme, port and path of the registry URL.
+var VersionedType = runtime.NewVersionedType(NpmRegistryIdentityType, Version)
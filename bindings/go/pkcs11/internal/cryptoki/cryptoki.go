@@ -0,0 +1,94 @@
+// Package cryptoki is a minimal binding to the PKCS#11 (Cryptoki) C API.
+// It covers exactly what is needed to sign with a key held by a token:
+// loading a module, selecting a slot, logging in, finding objects, reading
+// attributes and signing. Everything else is deliberately left out.
+//
+// The binding loads the module with dlopen and therefore requires cgo on a
+// unix platform. On other builds every entry point returns ErrUnsupported.
+package cryptoki
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupported is returned when the binary was built without PKCS#11 support.
+var ErrUnsupported = errors.New("pkcs11 is not supported by this build, it requires a binary built with cgo (CGO_ENABLED=1) on a unix platform")
+
+// ErrNotFound is returned when a slot, token or object could not be found.
+var ErrNotFound = errors.New("not found")
+
+// Object classes, attribute types, key types and mechanisms used by this package.
+// The values are defined by the PKCS#11 specification.
+const (
+	ClassCertificate uint = 0x1
+	ClassPublicKey   uint = 0x2
+	ClassPrivateKey  uint = 0x3
+
+	AttributeClass          uint = 0x0
+	AttributeLabel          uint = 0x3
+	AttributeValue          uint = 0x11
+	AttributeKeyType        uint = 0x100
+	AttributeID             uint = 0x102
+	AttributeModulus        uint = 0x120
+	AttributePublicExponent uint = 0x122
+	AttributeECParams       uint = 0x180
+	AttributeECPoint        uint = 0x181
+
+	KeyTypeRSA       uint = 0x0
+	KeyTypeEC        uint = 0x3
+	KeyTypeECEdwards uint = 0x40
+
+	MechanismRSAPKCS    uint = 0x1
+	MechanismRSAPKCSPSS uint = 0xd
+	MechanismSHA256     uint = 0x250
+	MechanismSHA384     uint = 0x260
+	MechanismSHA512     uint = 0x270
+	MechanismECDSA      uint = 0x1041
+	MechanismEdDSA      uint = 0x1057
+
+	MGF1SHA256 uint = 0x2
+	MGF1SHA384 uint = 0x3
+	MGF1SHA512 uint = 0x4
+)
+
+// Return values that are handled explicitly.
+const (
+	rvOK                         uint = 0x0
+	rvUserAlreadyLoggedIn        uint = 0x100
+	rvCryptokiAlreadyInitialized uint = 0x191
+)
+
+// Object is a handle to an object on a token, valid for the session it was found in.
+type Object uint
+
+// Mechanism selects the signing mechanism and its parameters.
+type Mechanism struct {
+	Type uint
+	// PSS holds the parameters for MechanismRSAPKCSPSS and must be nil for all other mechanisms.
+	PSS *PSSParams
+}
+
+// PSSParams are the CK_RSA_PKCS_PSS_PARAMS of a MechanismRSAPKCSPSS signature.
+type PSSParams struct {
+	Hash       uint
+	MGF        uint
+	SaltLength uint
+}
+
+// Error is a PKCS#11 return value other than CKR_OK.
+type Error struct {
+	Function string
+	RV       uint
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("pkcs11: %s failed with CKR 0x%x", e.Function, e.RV)
+}
+
+func check(function string, rv uint) error {
+	if rv == rvOK {
+		return nil
+	}
+	return &Error{Function: function, RV: rv}
+}
@@ -0,0 +1,406 @@
+//go:build cgo && unix
+
+package cryptoki
+
+/*
+#cgo linux LDFLAGS: -ldl
+
+#include <dlfcn.h>
+#include <stdlib.h>
+#include <string.h>
+
+typedef unsigned long ck_ulong;
+
+typedef struct {
+	unsigned char major;
+	unsigned char minor;
+} ck_version;
+
+typedef struct {
+	ck_ulong type;
+	void *value;
+	ck_ulong value_len;
+} ck_attribute;
+
+typedef struct {
+	ck_ulong mechanism;
+	void *parameter;
+	ck_ulong parameter_len;
+} ck_mechanism;
+
+typedef struct {
+	ck_ulong hash_alg;
+	ck_ulong mgf;
+	ck_ulong s_len;
+} ck_rsa_pkcs_pss_params;
+
+typedef struct {
+	void *create_mutex;
+	void *destroy_mutex;
+	void *lock_mutex;
+	void *unlock_mutex;
+	ck_ulong flags;
+	void *reserved;
+} ck_c_initialize_args;
+
+typedef struct {
+	unsigned char label[32];
+	unsigned char manufacturer_id[32];
+	unsigned char model[16];
+	unsigned char serial_number[16];
+	ck_ulong flags;
+	ck_ulong counters[10];
+	ck_version hardware_version;
+	ck_version firmware_version;
+	unsigned char utc_time[16];
+} ck_token_info;
+
+// ck_function_list mirrors CK_FUNCTION_LIST up to C_Sign. Entries that are
+// not used by this package are kept as opaque pointers to preserve the layout.
+typedef struct {
+	ck_version version;
+	ck_ulong (*C_Initialize)(void *);
+	ck_ulong (*C_Finalize)(void *);
+	void *C_GetInfo;
+	void *C_GetFunctionList;
+	ck_ulong (*C_GetSlotList)(unsigned char, ck_ulong *, ck_ulong *);
+	void *C_GetSlotInfo;
+	ck_ulong (*C_GetTokenInfo)(ck_ulong, ck_token_info *);
+	void *C_GetMechanismList;
+	void *C_GetMechanismInfo;
+	void *C_InitToken;
+	void *C_InitPIN;
+	void *C_SetPIN;
+	ck_ulong (*C_OpenSession)(ck_ulong, ck_ulong, void *, void *, ck_ulong *);
+	ck_ulong (*C_CloseSession)(ck_ulong);
+	void *C_CloseAllSessions;
+	void *C_GetSessionInfo;
+	void *C_GetOperationState;
+	void *C_SetOperationState;
+	ck_ulong (*C_Login)(ck_ulong, ck_ulong, unsigned char *, ck_ulong);
+	void *C_Logout;
+	void *C_CreateObject;
+	void *C_CopyObject;
+	void *C_DestroyObject;
+	void *C_GetObjectSize;
+	ck_ulong (*C_GetAttributeValue)(ck_ulong, ck_ulong, ck_attribute *, ck_ulong);
+	void *C_SetAttributeValue;
+	ck_ulong (*C_FindObjectsInit)(ck_ulong, ck_attribute *, ck_ulong);
+	ck_ulong (*C_FindObjects)(ck_ulong, ck_ulong *, ck_ulong, ck_ulong *);
+	ck_ulong (*C_FindObjectsFinal)(ck_ulong);
+	void *C_EncryptInit;
+	void *C_Encrypt;
+	void *C_EncryptUpdate;
+	void *C_EncryptFinal;
+	void *C_DecryptInit;
+	void *C_Decrypt;
+	void *C_DecryptUpdate;
+	void *C_DecryptFinal;
+	void *C_DigestInit;
+	void *C_Digest;
+	void *C_DigestUpdate;
+	void *C_DigestKey;
+	void *C_DigestFinal;
+	ck_ulong (*C_SignInit)(ck_ulong, ck_mechanism *, ck_ulong);
+	ck_ulong (*C_Sign)(ck_ulong, unsigned char *, ck_ulong, unsigned char *, ck_ulong *);
+} ck_function_list;
+
+#define CKF_OS_LOCKING_OK 0x2
+#define CKF_RW_SESSION 0x2
+#define CKF_SERIAL_SESSION 0x4
+#define CKU_USER 0x1
+
+static ck_ulong load(const char *path, void **handle, ck_function_list **fl, char **err) {
+	*handle = dlopen(path, RTLD_NOW | RTLD_LOCAL);
+	if (*handle == NULL) {
+		*err = strdup(dlerror());
+		return 1;
+	}
+	ck_ulong (*get)(ck_function_list **) = (ck_ulong (*)(ck_function_list **))dlsym(*handle, "C_GetFunctionList");
+	if (get == NULL) {
+		*err = strdup("module does not export C_GetFunctionList");
+		dlclose(*handle);
+		return 1;
+	}
+	return get(fl);
+}
+
+static ck_ulong initialize(ck_function_list *fl) {
+	ck_c_initialize_args args;
+	memset(&args, 0, sizeof(args));
+	args.flags = CKF_OS_LOCKING_OK;
+	return fl->C_Initialize(&args);
+}
+
+static ck_ulong get_slot_list(ck_function_list *fl, ck_ulong *slots, ck_ulong *count) {
+	return fl->C_GetSlotList(1, slots, count);
+}
+
+static ck_ulong get_token_info(ck_function_list *fl, ck_ulong slot, ck_token_info *info) {
+	return fl->C_GetTokenInfo(slot, info);
+}
+
+static ck_ulong open_session(ck_function_list *fl, ck_ulong slot, ck_ulong *session) {
+	return fl->C_OpenSession(slot, CKF_SERIAL_SESSION, NULL, NULL, session);
+}
+
+static ck_ulong close_session(ck_function_list *fl, ck_ulong session) {
+	return fl->C_CloseSession(session);
+}
+
+static ck_ulong login(ck_function_list *fl, ck_ulong session, unsigned char *pin, ck_ulong pin_len) {
+	return fl->C_Login(session, CKU_USER, pin, pin_len);
+}
+
+static ck_ulong get_attribute_value(ck_function_list *fl, ck_ulong session, ck_ulong object, ck_attribute *attr) {
+	return fl->C_GetAttributeValue(session, object, attr, 1);
+}
+
+static ck_ulong find_objects_init(ck_function_list *fl, ck_ulong session, ck_attribute *tmpl, ck_ulong count) {
+	return fl->C_FindObjectsInit(session, tmpl, count);
+}
+
+static ck_ulong find_objects(ck_function_list *fl, ck_ulong session, ck_ulong *objects, ck_ulong max, ck_ulong *count) {
+	return fl->C_FindObjects(session, objects, max, count);
+}
+
+static ck_ulong find_objects_final(ck_function_list *fl, ck_ulong session) {
+	return fl->C_FindObjectsFinal(session);
+}
+
+static ck_ulong sign_init(ck_function_list *fl, ck_ulong session, ck_mechanism *mech, ck_ulong key) {
+	return fl->C_SignInit(session, mech, key);
+}
+
+static ck_ulong sign(ck_function_list *fl, ck_ulong session, unsigned char *data, ck_ulong data_len, unsigned char *sig, ck_ulong *sig_len) {
+	return fl->C_Sign(session, data, data_len, sig, sig_len);
+}
+*/
+import "C"
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+// Module is a loaded and initialized PKCS#11 module.
+type Module struct {
+	path string
+	fl   *C.ck_function_list
+}
+
+var (
+	modulesMu sync.Mutex
+	modules   = map[string]*Module{}
+)
+
+// Load loads and initializes the PKCS#11 module at path. Modules are loaded
+// once per process and stay loaded, so that sessions of concurrent signers
+// share the same initialized library.
+func Load(path string) (*Module, error) {
+	modulesMu.Lock()
+	defer modulesMu.Unlock()
+	if m, ok := modules[path]; ok {
+		return m, nil
+	}
+
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+
+	var (
+		handle unsafe.Pointer
+		fl     *C.ck_function_list
+		cerr   *C.char
+	)
+	if rv := uint(C.load(cpath, &handle, &fl, &cerr)); rv != rvOK {
+		if cerr != nil {
+			defer C.free(unsafe.Pointer(cerr))
+			return nil, fmt.Errorf("pkcs11: loading module %q failed: %s", path, C.GoString(cerr))
+		}
+		return nil, check("C_GetFunctionList", rv)
+	}
+	if rv := uint(C.initialize(fl)); rv != rvOK && rv != rvCryptokiAlreadyInitialized {
+		return nil, check("C_Initialize", rv)
+	}
+
+	m := &Module{path: path, fl: fl}
+	modules[path] = m
+	return m, nil
+}
+
+// FindSlot returns the ID of the slot with the given ID or token label.
+// Only slots with a token present are considered.
+func (m *Module) FindSlot(slot *uint, tokenLabel string) (uint, error) {
+	var count C.ck_ulong
+	if err := check("C_GetSlotList", uint(C.get_slot_list(m.fl, nil, &count))); err != nil {
+		return 0, err
+	}
+	slots := make([]C.ck_ulong, count)
+	if count > 0 {
+		if err := check("C_GetSlotList", uint(C.get_slot_list(m.fl, &slots[0], &count))); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, id := range slots[:count] {
+		if slot != nil {
+			if uint(id) == *slot {
+				return uint(id), nil
+			}
+			continue
+		}
+		var info C.ck_token_info
+		if err := check("C_GetTokenInfo", uint(C.get_token_info(m.fl, id, &info))); err != nil {
+			return 0, err
+		}
+		// token labels are padded with blanks to 32 bytes
+		label := C.GoBytes(unsafe.Pointer(&info.label[0]), C.int(len(info.label)))
+		if string(bytes.TrimRight(label, " \x00")) == tokenLabel {
+			return uint(id), nil
+		}
+	}
+
+	if slot != nil {
+		return 0, fmt.Errorf("slot %d with token present in module %q: %w", *slot, m.path, ErrNotFound)
+	}
+	return 0, fmt.Errorf("token with label %q in module %q: %w", tokenLabel, m.path, ErrNotFound)
+}
+
+// Session is an open read-only session with a token.
+type Session struct {
+	m      *Module
+	handle C.ck_ulong
+}
+
+// OpenSession opens a read-only session with the token in slot.
+func (m *Module) OpenSession(slot uint) (*Session, error) {
+	var handle C.ck_ulong
+	if err := check("C_OpenSession", uint(C.open_session(m.fl, C.ck_ulong(slot), &handle))); err != nil {
+		return nil, err
+	}
+	return &Session{m: m, handle: handle}, nil
+}
+
+// Close closes the session. Closing the last session of a token also logs the user out.
+func (s *Session) Close() error {
+	return check("C_CloseSession", uint(C.close_session(s.m.fl, s.handle)))
+}
+
+// Login logs the normal user in. Being logged in already is not an error,
+// as the login state is shared by all sessions of the process.
+func (s *Session) Login(pin string) error {
+	cpin := C.CBytes([]byte(pin))
+	defer C.free(cpin)
+	rv := uint(C.login(s.m.fl, s.handle, (*C.uchar)(cpin), C.ck_ulong(len(pin))))
+	if rv == rvUserAlreadyLoggedIn {
+		return nil
+	}
+	return check("C_Login", rv)
+}
+
+// FindObjects returns all objects of the given class that match label and id.
+// Empty label or id are not used for matching.
+func (s *Session) FindObjects(class uint, label string, id []byte) ([]Object, error) {
+	class64 := C.ck_ulong(class)
+	values := [][]byte{unsafe.Slice((*byte)(unsafe.Pointer(&class64)), unsafe.Sizeof(class64))}
+	types := []uint{AttributeClass}
+	if label != "" {
+		types = append(types, AttributeLabel)
+		values = append(values, []byte(label))
+	}
+	if len(id) > 0 {
+		types = append(types, AttributeID)
+		values = append(values, id)
+	}
+
+	// the template contains pointers, so it has to live in C memory
+	tmplPtr := (*C.ck_attribute)(C.calloc(C.size_t(len(types)), C.size_t(unsafe.Sizeof(C.ck_attribute{}))))
+	defer C.free(unsafe.Pointer(tmplPtr))
+	tmpl := unsafe.Slice(tmplPtr, len(types))
+	for i := range types {
+		v := C.CBytes(values[i])
+		defer C.free(v)
+		tmpl[i] = C.ck_attribute{_type: C.ck_ulong(types[i]), value: v, value_len: C.ck_ulong(len(values[i]))}
+	}
+
+	if err := check("C_FindObjectsInit", uint(C.find_objects_init(s.m.fl, s.handle, tmplPtr, C.ck_ulong(len(types))))); err != nil {
+		return nil, err
+	}
+
+	var (
+		objects []Object
+		found   [16]C.ck_ulong
+		count   C.ck_ulong
+		err     error
+	)
+	for {
+		if err = check("C_FindObjects", uint(C.find_objects(s.m.fl, s.handle, &found[0], C.ck_ulong(len(found)), &count))); err != nil || count == 0 {
+			break
+		}
+		for _, o := range found[:count] {
+			objects = append(objects, Object(o))
+		}
+	}
+	if finalErr := check("C_FindObjectsFinal", uint(C.find_objects_final(s.m.fl, s.handle))); err == nil {
+		err = finalErr
+	}
+	return objects, err
+}
+
+// Attribute reads a single attribute of object.
+func (s *Session) Attribute(object Object, typ uint) ([]byte, error) {
+	attr := (*C.ck_attribute)(C.calloc(1, C.size_t(unsafe.Sizeof(C.ck_attribute{}))))
+	defer C.free(unsafe.Pointer(attr))
+	attr._type = C.ck_ulong(typ)
+
+	// first call determines the length, second call reads the value
+	if err := check("C_GetAttributeValue", uint(C.get_attribute_value(s.m.fl, s.handle, C.ck_ulong(object), attr))); err != nil {
+		return nil, err
+	}
+	if attr.value_len == 0 {
+		return nil, nil
+	}
+	attr.value = C.malloc(C.size_t(attr.value_len))
+	defer C.free(attr.value)
+	if err := check("C_GetAttributeValue", uint(C.get_attribute_value(s.m.fl, s.handle, C.ck_ulong(object), attr))); err != nil {
+		return nil, err
+	}
+	return C.GoBytes(attr.value, C.int(attr.value_len)), nil
+}
+
+// Sign signs data with key using mechanism in a single-part operation.
+func (s *Session) Sign(key Object, mechanism Mechanism, data []byte) ([]byte, error) {
+	mech := (*C.ck_mechanism)(C.calloc(1, C.size_t(unsafe.Sizeof(C.ck_mechanism{}))))
+	defer C.free(unsafe.Pointer(mech))
+	mech.mechanism = C.ck_ulong(mechanism.Type)
+	if p := mechanism.PSS; p != nil {
+		params := (*C.ck_rsa_pkcs_pss_params)(C.calloc(1, C.size_t(unsafe.Sizeof(C.ck_rsa_pkcs_pss_params{}))))
+		defer C.free(unsafe.Pointer(params))
+		params.hash_alg = C.ck_ulong(p.Hash)
+		params.mgf = C.ck_ulong(p.MGF)
+		params.s_len = C.ck_ulong(p.SaltLength)
+		mech.parameter = unsafe.Pointer(params)
+		mech.parameter_len = C.ck_ulong(unsafe.Sizeof(*params))
+	}
+
+	if err := check("C_SignInit", uint(C.sign_init(s.m.fl, s.handle, mech, C.ck_ulong(key)))); err != nil {
+		return nil, err
+	}
+
+	cdata := C.CBytes(data)
+	defer C.free(cdata)
+
+	// first call determines the length, second call signs
+	var sigLen C.ck_ulong
+	if err := check("C_Sign", uint(C.sign(s.m.fl, s.handle, (*C.uchar)(cdata), C.ck_ulong(len(data)), nil, &sigLen))); err != nil {
+		return nil, err
+	}
+	sig := C.malloc(C.size_t(sigLen))
+	defer C.free(sig)
+	if err := check("C_Sign", uint(C.sign(s.m.fl, s.handle, (*C.uchar)(cdata), C.ck_ulong(len(data)), (*C.uchar)(sig), &sigLen))); err != nil {
+		return nil, err
+	}
+	return C.GoBytes(sig, C.int(sigLen)), nil
+}
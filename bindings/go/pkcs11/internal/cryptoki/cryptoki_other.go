@@ -0,0 +1,49 @@
+//go:build !cgo || !unix
+
+package cryptoki
+
+// Module is a loaded and initialized PKCS#11 module.
+type Module struct{}
+
+// Load always returns ErrUnsupported, as this build has no PKCS#11 support.
+func Load(string) (*Module, error) {
+	return nil, ErrUnsupported
+}
+
+// FindSlot always returns ErrUnsupported.
+func (*Module) FindSlot(*uint, string) (uint, error) {
+	return 0, ErrUnsupported
+}
+
+// Session is an open read-only session with a token.
+type Session struct{}
+
+// OpenSession always returns ErrUnsupported.
+func (*Module) OpenSession(uint) (*Session, error) {
+	return nil, ErrUnsupported
+}
+
+// Close always returns ErrUnsupported.
+func (*Session) Close() error {
+	return ErrUnsupported
+}
+
+// Login always returns ErrUnsupported.
+func (*Session) Login(string) error {
+	return ErrUnsupported
+}
+
+// FindObjects always returns ErrUnsupported.
+func (*Session) FindObjects(uint, string, []byte) ([]Object, error) {
+	return nil, ErrUnsupported
+}
+
+// Attribute always returns ErrUnsupported.
+func (*Session) Attribute(Object, uint) ([]byte, error) {
+	return nil, ErrUnsupported
+}
+
+// Sign always returns ErrUnsupported.
+func (*Session) Sign(Object, Mechanism, []byte) ([]byte, error) {
+	return nil, ErrUnsupported
+}
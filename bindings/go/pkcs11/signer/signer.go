@@ -0,0 +1,401 @@
+// Package signer provides a crypto.Signer for private keys held by a PKCS#11
+// token. Signing handlers that accept a crypto.Signer (RSA, ECDSA, Ed25519)
+// can use it to sign without the key material ever leaving the token.
+//
+// The digest passed to Sign is handed to the token as is; the signer only
+// selects the PKCS#11 mechanism that matches the key type and signer options:
+//
+//   - RSA with *rsa.PSSOptions: CKM_RSA_PKCS_PSS with MGF1 over the same hash.
+//   - RSA otherwise: CKM_RSA_PKCS over the DER encoded DigestInfo (PKCS#1 v1.5).
+//   - ECDSA: CKM_ECDSA, the raw r||s result is converted to ASN.1 DER like crypto/ecdsa.
+//   - Ed25519: CKM_EDDSA over the message (pure Ed25519).
+package signer
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sync"
+
+	"ocm.software/open-component-model/bindings/go/pkcs11/internal/cryptoki"
+	pkcs11credentialsv1 "ocm.software/open-component-model/bindings/go/pkcs11/spec/credentials/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// ErrUnsupported is returned when the binary was built without PKCS#11 support.
+var ErrUnsupported = cryptoki.ErrUnsupported
+
+// Signer is a crypto.Signer backed by a private key on a PKCS#11 token.
+// It holds an open session until Close is called.
+type Signer struct {
+	mu      sync.Mutex
+	session *cryptoki.Session
+	key     cryptoki.Object
+	public  crypto.PublicKey
+}
+
+var _ crypto.Signer = (*Signer)(nil)
+
+// New loads the module referenced by creds, logs in to the selected token and
+// looks up the private key and its public counterpart.
+// The returned Signer must be closed after use.
+func New(creds *pkcs11credentialsv1.PKCS11Credentials) (_ *Signer, err error) {
+	if err := creds.Validate(); err != nil {
+		return nil, err
+	}
+	var id []byte
+	if creds.KeyID != "" {
+		if id, err = hex.DecodeString(creds.KeyID); err != nil {
+			return nil, fmt.Errorf("invalid key ID %q: %w", creds.KeyID, err)
+		}
+	}
+
+	module, err := cryptoki.Load(creds.Module)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := module.FindSlot(creds.Slot, creds.TokenLabel)
+	if err != nil {
+		return nil, err
+	}
+	session, err := module.OpenSession(slot)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, session.Close())
+		}
+	}()
+
+	if err := session.Login(creds.PIN); err != nil {
+		return nil, fmt.Errorf("login to token failed: %w", err)
+	}
+
+	key, err := findOne(session, cryptoki.ClassPrivateKey, creds.KeyLabel, id)
+	if err != nil {
+		return nil, fmt.Errorf("private key: %w", err)
+	}
+	public, err := publicKey(session, creds.KeyLabel, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		session: session,
+		key:     key,
+		public:  public,
+	}, nil
+}
+
+// Public returns the public key of the token key.
+func (s *Signer) Public() crypto.PublicKey {
+	return s.public
+}
+
+// Sign signs digest with the token key, see the package documentation for
+// the mechanism used per key type.
+func (s *Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch pub := s.public.(type) {
+	case *rsa.PublicKey:
+		return s.signRSA(pub, digest, opts)
+	case *ecdsa.PublicKey:
+		return s.signECDSA(pub, digest)
+	case ed25519.PublicKey:
+		if opts.HashFunc() != 0 {
+			return nil, errors.New("pkcs11: only pure Ed25519 is supported")
+		}
+		return s.session.Sign(s.key, cryptoki.Mechanism{Type: cryptoki.MechanismEdDSA}, digest)
+	default:
+		return nil, fmt.Errorf("pkcs11: unsupported key type %T", s.public)
+	}
+}
+
+// Close closes the session with the token.
+func (s *Signer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.session.Close()
+}
+
+// CertificateChain returns the certificate chain configured in creds,
+// or nil if there is none.
+func CertificateChain(creds *pkcs11credentialsv1.PKCS11Credentials) ([]*x509.Certificate, error) {
+	data := []byte(creds.CertificateChainPEM)
+	if len(data) == 0 && creds.CertificateChainPEMFile != "" {
+		var err error
+		if data, err = os.ReadFile(creds.CertificateChainPEMFile); err != nil {
+			return nil, fmt.Errorf("read certificate chain: %w", err)
+		}
+	}
+	var chain []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse certificate chain: %w", err)
+		}
+		chain = append(chain, cert)
+	}
+	return chain, nil
+}
+
+// FromCredentials opens a Signer for creds if they are PKCS#11 credentials,
+// together with the configured certificate chain and a function releasing the
+// token session. For other credentials it returns a nil signer and no error.
+// It can be passed to the RSA and EC signing handlers as their signer provider.
+func FromCredentials(_ context.Context, creds runtime.Typed) (crypto.Signer, []*x509.Certificate, func() error, error) {
+	if !pkcs11credentialsv1.IsPKCS11Credentials(creds) {
+		return nil, nil, nil, nil
+	}
+	c, err := pkcs11credentialsv1.ConvertToPKCS11Credentials(creds)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	chain, err := CertificateChain(c)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	s, err := New(c)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return s, chain, s.Close, nil
+}
+
+func (s *Signer) signRSA(pub *rsa.PublicKey, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	hash := opts.HashFunc()
+	if pss, ok := opts.(*rsa.PSSOptions); ok {
+		mech, mgf, err := pssHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		return s.session.Sign(s.key, cryptoki.Mechanism{
+			Type: cryptoki.MechanismRSAPKCSPSS,
+			PSS: &cryptoki.PSSParams{
+				Hash:       mech,
+				MGF:        mgf,
+				SaltLength: uint(pssSaltLength(pss, pub, hash)),
+			},
+		}, digest)
+	}
+
+	prefix, ok := digestInfoPrefixes[hash]
+	if !ok {
+		return nil, fmt.Errorf("pkcs11: unsupported hash %s for RSA PKCS#1 v1.5", hash)
+	}
+	return s.session.Sign(s.key, cryptoki.Mechanism{Type: cryptoki.MechanismRSAPKCS}, append(prefix, digest...))
+}
+
+func (s *Signer) signECDSA(pub *ecdsa.PublicKey, digest []byte) ([]byte, error) {
+	// Truncate like crypto/ecdsa does, tokens are not required to do it.
+	size := (pub.Curve.Params().BitSize + 7) / 8
+	if len(digest) > size {
+		digest = digest[:size]
+	}
+	raw, err := s.session.Sign(s.key, cryptoki.Mechanism{Type: cryptoki.MechanismECDSA}, digest)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw)%2 != 0 {
+		return nil, fmt.Errorf("pkcs11: invalid ECDSA signature length %d", len(raw))
+	}
+	half := len(raw) / 2
+	return asn1.Marshal(struct{ R, S *big.Int }{
+		R: new(big.Int).SetBytes(raw[:half]),
+		S: new(big.Int).SetBytes(raw[half:]),
+	})
+}
+
+// pssSaltLength resolves the symbolic salt lengths of crypto/rsa, which tokens do not understand.
+func pssSaltLength(opts *rsa.PSSOptions, pub *rsa.PublicKey, hash crypto.Hash) int {
+	switch opts.SaltLength {
+	case rsa.PSSSaltLengthAuto:
+		// maximum salt length, as chosen by crypto/rsa
+		return (pub.N.BitLen()-1+7)/8 - 2 - hash.Size()
+	case rsa.PSSSaltLengthEqualsHash:
+		return hash.Size()
+	default:
+		return opts.SaltLength
+	}
+}
+
+func pssHash(hash crypto.Hash) (mechanism, mgf uint, err error) {
+	switch hash {
+	case crypto.SHA256:
+		return cryptoki.MechanismSHA256, cryptoki.MGF1SHA256, nil
+	case crypto.SHA384:
+		return cryptoki.MechanismSHA384, cryptoki.MGF1SHA384, nil
+	case crypto.SHA512:
+		return cryptoki.MechanismSHA512, cryptoki.MGF1SHA512, nil
+	default:
+		return 0, 0, fmt.Errorf("pkcs11: unsupported hash %s for RSA PSS", hash)
+	}
+}
+
+// digestInfoPrefixes are the DER encoded DigestInfo headers of RFC 8017, section 9.2.
+var digestInfoPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+func findOne(session *cryptoki.Session, class uint, label string, id []byte) (cryptoki.Object, error) {
+	objects, err := session.FindObjects(class, label, id)
+	if err != nil {
+		return 0, err
+	}
+	switch len(objects) {
+	case 0:
+		return 0, fmt.Errorf("no object with label %q and id %x: %w", label, id, cryptoki.ErrNotFound)
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, fmt.Errorf("%d objects with label %q and id %x, the key reference is ambiguous", len(objects), label, id)
+	}
+}
+
+// publicKey reads the public key matching label and id, either from a public
+// key object or, if the token stores none, from a certificate.
+func publicKey(session *cryptoki.Session, label string, id []byte) (crypto.PublicKey, error) {
+	obj, err := findOne(session, cryptoki.ClassPublicKey, label, id)
+	if errors.Is(err, cryptoki.ErrNotFound) {
+		cert, certErr := findOne(session, cryptoki.ClassCertificate, label, id)
+		if certErr != nil {
+			return nil, fmt.Errorf("public key: %w", errors.Join(err, certErr))
+		}
+		der, err := session.Attribute(cert, cryptoki.AttributeValue)
+		if err != nil {
+			return nil, err
+		}
+		c, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("parse certificate from token: %w", err)
+		}
+		return c.PublicKey, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("public key: %w", err)
+	}
+
+	keyType, err := session.Attribute(obj, cryptoki.AttributeKeyType)
+	if err != nil {
+		return nil, err
+	}
+	switch decodeULong(keyType) {
+	case cryptoki.KeyTypeRSA:
+		return rsaPublicKey(session, obj)
+	case cryptoki.KeyTypeEC:
+		return ecdsaPublicKey(session, obj)
+	case cryptoki.KeyTypeECEdwards:
+		point, err := ecPoint(session, obj)
+		if err != nil {
+			return nil, err
+		}
+		if len(point) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("unsupported Edwards curve public key of %d bytes", len(point))
+		}
+		return ed25519.PublicKey(point), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type 0x%x", decodeULong(keyType))
+	}
+}
+
+func rsaPublicKey(session *cryptoki.Session, obj cryptoki.Object) (*rsa.PublicKey, error) {
+	n, err := session.Attribute(obj, cryptoki.AttributeModulus)
+	if err != nil {
+		return nil, err
+	}
+	e, err := session.Attribute(obj, cryptoki.AttributePublicExponent)
+	if err != nil {
+		return nil, err
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+var namedCurves = []struct {
+	oid   asn1.ObjectIdentifier
+	curve elliptic.Curve
+}{
+	{asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}, elliptic.P256()},
+	{asn1.ObjectIdentifier{1, 3, 132, 0, 34}, elliptic.P384()},
+	{asn1.ObjectIdentifier{1, 3, 132, 0, 35}, elliptic.P521()},
+}
+
+func ecdsaPublicKey(session *cryptoki.Session, obj cryptoki.Object) (*ecdsa.PublicKey, error) {
+	params, err := session.Attribute(obj, cryptoki.AttributeECParams)
+	if err != nil {
+		return nil, err
+	}
+	var oid asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(params, &oid); err != nil {
+		return nil, fmt.Errorf("only named curves are supported: %w", err)
+	}
+	var curve elliptic.Curve
+	for _, c := range namedCurves {
+		if c.oid.Equal(oid) {
+			curve = c.curve
+		}
+	}
+	if curve == nil {
+		return nil, fmt.Errorf("unsupported curve %s", oid)
+	}
+
+	point, err := ecPoint(session, obj)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:staticcheck // elliptic.Unmarshal is the only way to build a key from an arbitrary named curve point
+	x, y := elliptic.Unmarshal(curve, point)
+	if x == nil {
+		return nil, errors.New("invalid EC point on token")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// ecPoint reads CKA_EC_POINT, which the specification defines as a DER
+// OCTET STRING. Some modules return the bare point, which is accepted as well.
+func ecPoint(session *cryptoki.Session, obj cryptoki.Object) ([]byte, error) {
+	raw, err := session.Attribute(obj, cryptoki.AttributeECPoint)
+	if err != nil {
+		return nil, err
+	}
+	var point []byte
+	if rest, err := asn1.Unmarshal(raw, &point); err == nil && len(rest) == 0 {
+		return point, nil
+	}
+	return raw, nil
+}
+
+// decodeULong decodes a CK_ULONG attribute value in host byte order.
+func decodeULong(b []byte) uint {
+	switch len(b) {
+	case 4:
+		return uint(binary.NativeEndian.Uint32(b))
+	case 8:
+		return uint(binary.NativeEndian.Uint64(b))
+	default:
+		return ^uint(0)
+	}
+}
@@ -0,0 +1,38 @@
+//go:build !cgo || !unix
+
+package signer_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/pkcs11/signer"
+	pkcs11credentialsv1 "ocm.software/open-component-model/bindings/go/pkcs11/spec/credentials/v1"
+)
+
+// Test_Signer_Unsupported runs for builds without cgo, such as the released CLI binaries,
+// and checks that PKCS#11 credentials fail with an error that names the missing build requirement.
+func Test_Signer_Unsupported(t *testing.T) {
+	creds := &pkcs11credentialsv1.PKCS11Credentials{
+		Module:     filepath.Join(t.TempDir(), "libsofthsm2.so"),
+		TokenLabel: tokenLabel,
+		KeyLabel:   "rsa",
+		PIN:        pin,
+	}
+
+	t.Run("new", func(t *testing.T) {
+		_, err := signer.New(creds)
+		require.ErrorIs(t, err, signer.ErrUnsupported)
+		require.ErrorContains(t, err, "CGO_ENABLED=1")
+	})
+
+	t.Run("from credentials", func(t *testing.T) {
+		typed := creds.DeepCopy()
+		typed.Type = pkcs11credentialsv1.VersionedType
+		s, _, _, err := signer.FromCredentials(t.Context(), typed)
+		require.ErrorIs(t, err, signer.ErrUnsupported)
+		require.Nil(t, s)
+	})
+}
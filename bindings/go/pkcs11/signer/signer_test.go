@@ -0,0 +1,222 @@
+package signer_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/pkcs11/signer"
+	pkcs11credentialsv1 "ocm.software/open-component-model/bindings/go/pkcs11/spec/credentials/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	tokenLabel = "ocm-test"
+	pin        = "1234"
+)
+
+// softHSMModulePaths are the usual install locations of the SoftHSM v2 module.
+var softHSMModulePaths = []string{
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/lib/aarch64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+	"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+}
+
+func Test_Signer_SoftHSM(t *testing.T) {
+	module := setupSoftHSM(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	importKey(t, "rsa", "01", rsaKey)
+	importKey(t, "ec", "02", ecKey)
+
+	dig := sha256.Sum256([]byte("hello world"))
+
+	t.Run("rsa pkcs1v15", func(t *testing.T) {
+		s := newSigner(t, module, "rsa")
+		require.Equal(t, &rsaKey.PublicKey, s.Public())
+		sig, err := s.Sign(rand.Reader, dig[:], crypto.SHA256)
+		require.NoError(t, err)
+		require.NoError(t, rsa.VerifyPKCS1v15(&rsaKey.PublicKey, crypto.SHA256, dig[:], sig))
+	})
+
+	t.Run("rsa pss", func(t *testing.T) {
+		s := newSigner(t, module, "rsa")
+		opts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto, Hash: crypto.SHA256}
+		sig, err := s.Sign(rand.Reader, dig[:], opts)
+		require.NoError(t, err)
+		require.NoError(t, rsa.VerifyPSS(&rsaKey.PublicKey, crypto.SHA256, dig[:], sig, opts))
+	})
+
+	t.Run("ecdsa", func(t *testing.T) {
+		s := newSigner(t, module, "ec")
+		pub, ok := s.Public().(*ecdsa.PublicKey)
+		require.True(t, ok)
+		require.True(t, ecKey.PublicKey.Equal(pub))
+		sig, err := s.Sign(rand.Reader, dig[:], crypto.SHA256)
+		require.NoError(t, err)
+		require.True(t, ecdsa.VerifyASN1(&ecKey.PublicKey, dig[:], sig))
+	})
+
+	t.Run("key id", func(t *testing.T) {
+		s, err := signer.New(&pkcs11credentialsv1.PKCS11Credentials{
+			Module:     module,
+			TokenLabel: tokenLabel,
+			KeyID:      "02",
+			PIN:        pin,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, s.Close()) })
+		require.True(t, ecKey.PublicKey.Equal(s.Public()))
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := signer.New(&pkcs11credentialsv1.PKCS11Credentials{
+			Module:     module,
+			TokenLabel: tokenLabel,
+			KeyLabel:   "missing",
+			PIN:        pin,
+		})
+		require.ErrorContains(t, err, "no object with label")
+	})
+
+	t.Run("wrong pin", func(t *testing.T) {
+		_, err := signer.New(&pkcs11credentialsv1.PKCS11Credentials{
+			Module:     module,
+			TokenLabel: tokenLabel,
+			KeyLabel:   "rsa",
+			PIN:        "0000",
+		})
+		require.ErrorContains(t, err, "login to token failed")
+	})
+}
+
+func Test_Signer_InvalidModule(t *testing.T) {
+	_, err := signer.New(&pkcs11credentialsv1.PKCS11Credentials{
+		Module:     filepath.Join(t.TempDir(), "missing.so"),
+		TokenLabel: tokenLabel,
+		KeyLabel:   "rsa",
+	})
+	require.Error(t, err)
+}
+
+func Test_CertificateChain(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "signer"}}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	chain, err := signer.CertificateChain(&pkcs11credentialsv1.PKCS11Credentials{CertificateChainPEM: string(certPEM)})
+	require.NoError(t, err)
+	require.Len(t, chain, 1)
+	require.Equal(t, "signer", chain[0].Subject.CommonName)
+
+	file := filepath.Join(t.TempDir(), "chain.pem")
+	require.NoError(t, os.WriteFile(file, append(certPEM, certPEM...), 0o600))
+	chain, err = signer.CertificateChain(&pkcs11credentialsv1.PKCS11Credentials{CertificateChainPEMFile: file})
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+
+	chain, err = signer.CertificateChain(&pkcs11credentialsv1.PKCS11Credentials{})
+	require.NoError(t, err)
+	require.Empty(t, chain)
+}
+
+func Test_FromCredentials_IgnoresOtherCredentials(t *testing.T) {
+	s, chain, release, err := signer.FromCredentials(t.Context(), &credv1.DirectCredentials{
+		Type: runtime.NewVersionedType(credv1.DirectCredentialsType, "v1"),
+	})
+	require.NoError(t, err)
+	require.Nil(t, s)
+	require.Nil(t, chain)
+	require.Nil(t, release)
+}
+
+// ---- helpers ----
+
+// setupSoftHSM initialises a fresh SoftHSM token in a temporary directory and
+// returns the module path. The test is skipped if SoftHSM is not installed.
+// SOFTHSM2_MODULE can be used to point to a module in a non-standard location.
+func setupSoftHSM(t *testing.T) string {
+	t.Helper()
+	// if the module is configured explicitly, as in CI, the tests must not be skipped.
+	module := os.Getenv("SOFTHSM2_MODULE")
+	if module != "" {
+		_, err := os.Stat(module)
+		require.NoError(t, err, "SOFTHSM2_MODULE is set, but the module is not available")
+		_, err = exec.LookPath("softhsm2-util")
+		require.NoError(t, err, "SOFTHSM2_MODULE is set, but softhsm2-util is not available")
+	} else {
+		for _, p := range softHSMModulePaths {
+			if _, err := os.Stat(p); err == nil {
+				module = p
+				break
+			}
+		}
+		if module == "" {
+			t.Skip("SoftHSM module not found, set SOFTHSM2_MODULE to run PKCS#11 tests")
+		}
+		if _, err := exec.LookPath("softhsm2-util"); err != nil {
+			t.Skip("softhsm2-util not found")
+		}
+	}
+
+	dir := t.TempDir()
+	tokens := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokens, 0o700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte("directories.tokendir = "+tokens+"\nobjectstore.backend = file\n"), 0o600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	softHSMUtil(t, "--init-token", "--free", "--label", tokenLabel, "--pin", pin, "--so-pin", pin)
+	return module
+}
+
+// importKey imports key as a key pair with the given label and hex id.
+func importKey(t *testing.T, label, id string, key crypto.Signer) {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	file := filepath.Join(t.TempDir(), label+".pem")
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+	softHSMUtil(t, "--import", file, "--token", tokenLabel, "--label", label, "--id", id, "--pin", pin)
+}
+
+func softHSMUtil(t *testing.T, args ...string) {
+	t.Helper()
+	out, err := exec.Command("softhsm2-util", args...).CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func newSigner(t *testing.T, module, label string) *signer.Signer {
+	t.Helper()
+	s, err := signer.New(&pkcs11credentialsv1.PKCS11Credentials{
+		Module:     module,
+		TokenLabel: tokenLabel,
+		KeyLabel:   label,
+		PIN:        pin,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+	return s
+}
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"fmt"
+	"strconv"
+
+	v1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+//nolint:gosec // G101: These are key names, not credentials.
+const (
+	credentialKeyModule                  = "module"
+	credentialKeySlot                    = "slot"
+	credentialKeyTokenLabel              = "tokenLabel"
+	credentialKeyKeyLabel                = "keyLabel"
+	credentialKeyKeyID                   = "keyID"
+	credentialKeyPIN                     = "pin"
+	credentialKeyCertificateChainPEM     = "certificateChainPEM"
+	credentialKeyCertificateChainPEMFile = "certificateChainPEMFile"
+)
+
+var convertScheme = runtime.NewScheme()
+
+func init() {
+	convertScheme.MustRegisterWithAlias(&PKCS11Credentials{},
+		VersionedType,
+		runtime.NewUnversionedType(PKCS11CredentialsType),
+	)
+	v1.MustRegister(convertScheme)
+}
+
+// IsPKCS11Credentials reports whether creds are typed PKCS#11 credentials.
+// Signing handlers use this to decide whether to sign with a key on a token
+// instead of a key from their own credential type.
+func IsPKCS11Credentials(creds runtime.Typed) bool {
+	return creds != nil && creds.GetType().Name == PKCS11CredentialsType
+}
+
+// ConvertToPKCS11Credentials converts [runtime.Typed] into [PKCS11Credentials].
+// Direct conversion as well as converting from [v1.DirectCredentials] is supported.
+// Other supported [runtime.Typed] implementations are [runtime.Raw].
+// For unsupported [runtime.Typed] implementations, an error will be returned.
+func ConvertToPKCS11Credentials(creds runtime.Typed) (*PKCS11Credentials, error) {
+	typed, err := convertScheme.NewObject(creds.GetType())
+	if err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+
+	if err = convertScheme.Convert(creds, typed); err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+
+	switch t := typed.(type) {
+	case *v1.DirectCredentials:
+		return fromDirectCredentials(t.Properties)
+	case *PKCS11Credentials:
+		return t, nil
+	}
+
+	return nil, fmt.Errorf("unsupported credential type %v", typed.GetType())
+}
+
+// Validate checks that the credentials identify a module, a token and a key.
+func (c *PKCS11Credentials) Validate() error {
+	if c.Module == "" {
+		return fmt.Errorf("pkcs11 credentials: %s is required", credentialKeyModule)
+	}
+	if (c.Slot == nil) == (c.TokenLabel == "") {
+		return fmt.Errorf("pkcs11 credentials: exactly one of %s or %s is required", credentialKeySlot, credentialKeyTokenLabel)
+	}
+	if c.KeyLabel == "" && c.KeyID == "" {
+		return fmt.Errorf("pkcs11 credentials: at least one of %s or %s is required", credentialKeyKeyLabel, credentialKeyKeyID)
+	}
+	return nil
+}
+
+// fromDirectCredentials converts a DirectCredentials properties map into typed PKCS11Credentials.
+func fromDirectCredentials(properties map[string]string) (*PKCS11Credentials, error) {
+	creds := &PKCS11Credentials{
+		Type:                    runtime.NewVersionedType(PKCS11CredentialsType, Version),
+		Module:                  properties[credentialKeyModule],
+		TokenLabel:              properties[credentialKeyTokenLabel],
+		KeyLabel:                properties[credentialKeyKeyLabel],
+		KeyID:                   properties[credentialKeyKeyID],
+		PIN:                     properties[credentialKeyPIN],
+		CertificateChainPEM:     properties[credentialKeyCertificateChainPEM],
+		CertificateChainPEMFile: properties[credentialKeyCertificateChainPEMFile],
+	}
+	if s := properties[credentialKeySlot]; s != "" {
+		slot, err := strconv.ParseUint(s, 10, 0)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", credentialKeySlot, s, err)
+		}
+		v := uint(slot)
+		creds.Slot = &v
+	}
+	return creds, nil
+}
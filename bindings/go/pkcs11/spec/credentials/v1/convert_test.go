@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func TestConvertToPKCS11Credentials(t *testing.T) {
+	slot := uint(3)
+	tests := []struct {
+		name    string
+		input   runtime.Typed
+		want    *PKCS11Credentials
+		wantErr bool
+	}{
+		{
+			name: "PKCS11Credentials passthrough",
+			input: &PKCS11Credentials{
+				Type:       VersionedType,
+				Module:     "/usr/lib/softhsm/libsofthsm2.so",
+				TokenLabel: "release",
+				KeyLabel:   "signing-key",
+				PIN:        "1234",
+			},
+			want: &PKCS11Credentials{
+				Type:       VersionedType,
+				Module:     "/usr/lib/softhsm/libsofthsm2.so",
+				TokenLabel: "release",
+				KeyLabel:   "signing-key",
+				PIN:        "1234",
+			},
+		},
+		{
+			name: "DirectCredentials",
+			input: &credv1.DirectCredentials{
+				Type: runtime.NewVersionedType(credv1.DirectCredentialsType, Version),
+				Properties: map[string]string{
+					credentialKeyModule: "/usr/lib/softhsm/libsofthsm2.so",
+					credentialKeySlot:   "3",
+					credentialKeyKeyID:  "01",
+					credentialKeyPIN:    "1234",
+				},
+			},
+			want: &PKCS11Credentials{
+				Type:   VersionedType,
+				Module: "/usr/lib/softhsm/libsofthsm2.so",
+				Slot:   &slot,
+				KeyID:  "01",
+				PIN:    "1234",
+			},
+		},
+		{
+			name: "DirectCredentials with invalid slot",
+			input: &credv1.DirectCredentials{
+				Type:       runtime.NewVersionedType(credv1.DirectCredentialsType, Version),
+				Properties: map[string]string{credentialKeySlot: "first"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "raw PKCS11Credentials",
+			input: &runtime.Raw{
+				Type: VersionedType,
+				Data: []byte(`{"type":"PKCS11Credentials/v1","module":"/lib/p11.so","slot":3,"keyLabel":"k"}`),
+			},
+			want: &PKCS11Credentials{
+				Type:     VersionedType,
+				Module:   "/lib/p11.so",
+				Slot:     &slot,
+				KeyLabel: "k",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertToPKCS11Credentials(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPKCS11Credentials_Validate(t *testing.T) {
+	slot := uint(0)
+	valid := func() *PKCS11Credentials {
+		return &PKCS11Credentials{Type: VersionedType, Module: "/lib/p11.so", TokenLabel: "token", KeyLabel: "key"}
+	}
+	require.NoError(t, valid().Validate())
+
+	c := valid()
+	c.Module = ""
+	require.ErrorContains(t, c.Validate(), "module")
+
+	c = valid()
+	c.Slot = &slot
+	require.ErrorContains(t, c.Validate(), "exactly one of slot or tokenLabel")
+
+	c = valid()
+	c.TokenLabel = ""
+	require.ErrorContains(t, c.Validate(), "exactly one of slot or tokenLabel")
+
+	c = valid()
+	c.KeyLabel = ""
+	require.ErrorContains(t, c.Validate(), "keyLabel or keyID")
+
+	assert.True(t, IsPKCS11Credentials(valid().DeepCopyTyped()))
+	assert.False(t, IsPKCS11Credentials(&credv1.DirectCredentials{Type: runtime.NewVersionedType(credv1.DirectCredentialsType, Version)}))
+	assert.False(t, IsPKCS11Credentials(nil))
+}
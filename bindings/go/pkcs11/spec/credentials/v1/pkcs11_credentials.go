@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	// PKCS11CredentialsType is the type name for PKCS#11 credentials.
+	PKCS11CredentialsType = "PKCS11Credentials"
+	// Version is the version of the PKCS#11 credentials type.
+	Version = "v1"
+)
+
+var VersionedType = runtime.NewVersionedType(PKCS11CredentialsType, Version)
+
+// PKCS11Credentials reference a private key held by a PKCS#11 token, such as a
+// hardware security module or SoftHSM. The key material never leaves the token,
+// signing handlers use it through crypto.Signer.
+//
+// The token is selected by Slot or TokenLabel (exactly one of them must be set),
+// the key on the token by KeyLabel and/or KeyID.
+//
+// For PEM-encoded signing, CertificateChainPEM or CertificateChainPEMFile should
+// contain the certificate chain (leaf + intermediates) to embed in the signature.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type PKCS11Credentials struct {
+	// +ocm:jsonschema-gen:enum=PKCS11Credentials/v1
+	// +ocm:jsonschema-gen:enum:deprecated=PKCS11Credentials
+	Type runtime.Type `json:"type"`
+	// Module is the path to the PKCS#11 module (shared library) of the token vendor,
+	// e.g. /usr/lib/softhsm/libsofthsm2.so.
+	Module string `json:"module"`
+	// Slot is the numeric ID of the slot holding the token.
+	// Mutually exclusive with TokenLabel.
+	Slot *uint `json:"slot,omitempty"`
+	// TokenLabel is the label of the token. Slot IDs are not stable across
+	// restarts for many modules, so this is the preferred way to select a token.
+	// Mutually exclusive with Slot.
+	TokenLabel string `json:"tokenLabel,omitempty"`
+	// KeyLabel is the CKA_LABEL of the private key on the token.
+	KeyLabel string `json:"keyLabel,omitempty"`
+	// KeyID is the hex-encoded CKA_ID of the private key on the token.
+	// If both KeyLabel and KeyID are set, the key must match both.
+	KeyID string `json:"keyID,omitempty"`
+	// PIN is the user PIN used to log in to the token.
+	PIN string `json:"pin,omitempty"`
+	// CertificateChainPEM is an inline PEM-encoded X.509 certificate chain (leaf + intermediates)
+	// of the key. Only used for PEM-encoded signing.
+	// Takes precedence over CertificateChainPEMFile when both are set.
+	CertificateChainPEM string `json:"certificateChainPEM,omitempty"`
+	// CertificateChainPEMFile is a path to a PEM file containing the certificate chain of the key.
+	// Same semantics as CertificateChainPEM, but loaded from disk. Ignored when CertificateChainPEM is also set.
+	CertificateChainPEMFile string `json:"certificateChainPEMFile,omitempty"`
+}
@@ -5,9 +5,34 @@
 //   - registering handlers
 //   - idle check ( after a configured amount of times being without a task will automatically shut down the plugin to prevent resource usage )
 //   - determine listening address ( for tcp: get a free port and listen on it; unix: create a name of the socket )
+//   - serving the handlers over the transport negotiated with the manager ( JSON over HTTP, or the gRPC services of the contracts served by
+//     the same handlers if declared with capabilities.AddSupportedTransports(types.TransportGRPC) )
 //
 // GracefulShutdown will handle interrupts and will clean up any created unix domain sockets if any were created.
-// The following code is an example on how to use this package:
+//
+// The easiest way to write a plugin is to implement one of the interfaces of the OCM library, e.g.
+// repository.ResourceRepository or signing.Handler, and to register it with the typed helpers of this package.
+// They set up the endpoints, capabilities and JSON schemas of the plugin type. Run then takes care of the
+// invocation by the plugin manager, including streaming the logs of the default logger to the manager:
+//
+//	func main() {
+//		scheme := runtime.NewScheme()
+//		v1.MustAddToScheme(scheme)
+//		capabilities := endpoints.NewEndpoints(scheme)
+//
+//		if err := sdk.RegisterResourceRepository(&v1.OCIImage{}, &OCIRepository{}, capabilities); err != nil {
+//			log.Fatal(err)
+//		}
+//
+//		if err := sdk.Run(context.Background(), capabilities); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+// Plugins written this way can be tested in-process against the plugin manager with the sdktest package.
+//
+// For plugin types without typed helpers, the handlers are registered with the registries of the plugin manager
+// and the plugin is set up by hand. The following code is an example on how to do that:
 // First, call the appropriate endpoint builder to get the right handlers and config that needs to be sent back to
 // the manager:
 //
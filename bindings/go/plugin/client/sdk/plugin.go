@@ -15,8 +15,11 @@ import (
 	"syscall"
 	"time"
 
+	"google.golang.org/grpc"
+
 	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/transport"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
 )
 
@@ -30,6 +33,7 @@ type Plugin struct {
 
 	handlers      []endpoints.Handler
 	server        *http.Server
+	grpcServer    *grpc.Server // serves the calls instead of server if the gRPC transport was negotiated.
 	interrupt     chan bool
 	workerCounter atomic.Int64
 	location      string
@@ -115,12 +119,20 @@ func (p *Plugin) Start(ctx context.Context) error {
 		if err := p.GracefulShutdown(ctx); err != nil {
 			p.logger.ErrorContext(ctx, "Error shutting down plugin", "error", err)
 		}
-		if err := p.server.Close(); err != nil {
+		if p.grpcServer != nil {
+			p.grpcServer.Stop()
+		} else if err := p.server.Close(); err != nil {
 			p.logger.ErrorContext(ctx, "failed to close server", "error", err)
 		}
 		p.logger.InfoContext(ctx, "Plugin shutdown complete", "id", p.Config.ID)
 	}()
 
+	return p.Serve(ctx)
+}
+
+// Serve serves the plugin until it's shut down. Unlike Start, it doesn't handle interrupts,
+// so it can be used to serve a plugin in a process that isn't dedicated to it, e.g. in tests.
+func (p *Plugin) Serve(ctx context.Context) error {
 	err := p.listen(ctx)
 
 	if errors.Is(err, http.ErrServerClosed) {
@@ -168,6 +180,14 @@ func (p *Plugin) listen(ctx context.Context) error {
 	m.HandleFunc("/shutdown", p.Shutdown)
 	m.HandleFunc("/healthz", p.Healthz)
 
+	if p.Config.Transport == types.TransportGRPC {
+		// the rpcs of the contracts are served by the same handlers.
+		p.grpcServer = grpc.NewServer()
+		if err := transport.RegisterServer(p.grpcServer, m); err != nil {
+			return fmt.Errorf("failed to register grpc services: %w", err)
+		}
+	}
+
 	server := &http.Server{
 		Handler:           m,
 		ReadHeaderTimeout: 5 * time.Second,
@@ -195,6 +215,10 @@ func (p *Plugin) listen(ctx context.Context) error {
 		return fmt.Errorf("failed to write location to output writer: %w", err)
 	}
 
+	if p.grpcServer != nil {
+		return p.grpcServer.Serve(conn)
+	}
+
 	return server.Serve(conn)
 }
 
@@ -254,8 +278,12 @@ func (p *Plugin) determineLocation(ctx context.Context) (_ string, err error) {
 // In the case of sockets, it will remove the created socket.
 func (p *Plugin) GracefulShutdown(ctx context.Context) error {
 	p.logger.InfoContext(ctx, "gracefully shutting down plugin", "id", p.Config.ID)
-	// We ignore server closed errors because server closing might race with the listener.
-	if err := p.server.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	if p.grpcServer != nil {
+		if err := p.stopGRPCServer(ctx); err != nil {
+			return fmt.Errorf("failed to shutdown server: %w", err)
+		}
+	} else if err := p.server.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		// We ignore server closed errors because server closing might race with the listener.
 		return fmt.Errorf("failed to shutdown server: %w", err)
 	}
 
@@ -277,6 +305,24 @@ func (p *Plugin) GracefulShutdown(ctx context.Context) error {
 	return nil
 }
 
+// stopGRPCServer waits for running calls to finish like http.Server.Shutdown.
+// If ctx is done first, the remaining calls are cancelled.
+func (p *Plugin) stopGRPCServer(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		p.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		p.grpcServer.Stop()
+		return ctx.Err()
+	}
+}
+
 func (p *Plugin) RegisterHandlers(handlers ...endpoints.Handler) error {
 	for _, h := range handlers {
 		if h.Handler == nil {
@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/transport"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
 )
 
@@ -68,6 +69,59 @@ func TestPluginSDK(t *testing.T) {
 	r.True(os.IsNotExist(err))
 }
 
+func TestPluginSDKGRPCTransport(t *testing.T) {
+	r := require.New(t)
+
+	output := bytes.NewBuffer(nil)
+	location := "/tmp/test-plugin-grpc-plugin.socket"
+	ctx := context.Background()
+	p := NewPlugin(ctx, slog.Default(), types.Config{
+		ID:         "test-plugin-grpc",
+		Type:       types.Socket,
+		PluginType: testPluginType,
+		Transport:  types.TransportGRPC,
+	}, output)
+
+	t.Cleanup(func() {
+		r.NoError(os.RemoveAll(location))
+	})
+
+	r.NoError(p.RegisterHandlers(endpoints.Handler{
+		Handler: func(writer http.ResponseWriter, request *http.Request) {
+			_, _ = io.Copy(writer, request.Body)
+		},
+		Location: "/resource/digest/process",
+	}))
+
+	go func() {
+		_ = p.Start(ctx)
+	}()
+
+	client, err := transport.NewClient("unix", location)
+	r.NoError(err)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	httpClient := &http.Client{Transport: client}
+
+	// The handlers are served over the grpc transport, including the health check.
+	waitForPlugin(r, httpClient)
+
+	// the handlers of the contracts serve their rpcs.
+	resp, err := httpClient.Post("http://unix/resource/digest/process", "application/json", bytes.NewBufferString(`{"resource":{"name":"hello"}}`))
+	r.NoError(err)
+	r.Equal(http.StatusOK, resp.StatusCode)
+	content, err := io.ReadAll(resp.Body)
+	r.NoError(err)
+	r.JSONEq(`{"resource":{"name":"hello"}}`, string(content))
+
+	r.NoError(p.GracefulShutdown(ctx))
+
+	// GracefulShutdown should remove the socket.
+	_, err = os.Stat(location)
+	r.True(os.IsNotExist(err))
+}
+
 func TestPluginSDKForceShutdownContext(t *testing.T) {
 	r := require.New(t)
 
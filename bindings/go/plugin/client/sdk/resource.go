@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"ocm.software/open-component-model/bindings/go/blob/filesystem"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/resource/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/blobs"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/resource"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/repository"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// RegisterResourceRepository registers repo as resource repository plugin for the access type of proto.
+// The access type must be registered with the scheme of capabilities.
+func RegisterResourceRepository[T runtime.Typed](proto T, repo repository.ResourceRepository, capabilities *endpoints.EndpointBuilder) error {
+	return resource.RegisterResourcePlugin(proto, &resourceRepositoryPlugin{
+		repo:   repo,
+		scheme: capabilities.Scheme,
+	}, capabilities)
+}
+
+// resourceRepositoryPlugin serves a repository.ResourceRepository as resource repository plugin.
+type resourceRepositoryPlugin struct {
+	repo   repository.ResourceRepository
+	scheme *runtime.Scheme
+}
+
+var _ v1.ReadWriteResourcePluginContract = (*resourceRepositoryPlugin)(nil)
+
+func (p *resourceRepositoryPlugin) Ping(_ context.Context) error {
+	return nil
+}
+
+func (p *resourceRepositoryPlugin) GetIdentity(ctx context.Context, request *v1.GetIdentityRequest[runtime.Typed]) (*v1.GetIdentityResponse, error) {
+	identity, err := p.repo.GetResourceCredentialConsumerIdentity(ctx, &descriptor.Resource{Access: request.Typ})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetIdentityResponse{Identity: identity}, nil
+}
+
+func (p *resourceRepositoryPlugin) GetGlobalResource(ctx context.Context, request *v1.GetGlobalResourceRequest, credentials runtime.Typed) (*v1.GetGlobalResourceResponse, error) {
+	content, err := p.repo.DownloadResource(ctx, descriptor.ConvertFromV2Resource(request.Resource), credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.CreateTemp("", "resource")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	if err := errors.Join(tmp.Close(), filesystem.CopyBlobToOSPath(content, tmp.Name())); err != nil {
+		return nil, fmt.Errorf("failed to copy resource to temp file: %w", err)
+	}
+
+	return &v1.GetGlobalResourceResponse{
+		Location: types.Location{
+			LocationType: types.LocationTypeLocalFile,
+			Value:        tmp.Name(),
+		},
+	}, nil
+}
+
+func (p *resourceRepositoryPlugin) AddGlobalResource(ctx context.Context, request *v1.AddGlobalResourceRequest, credentials runtime.Typed) (*v1.AddGlobalResourceResponse, error) {
+	content, err := blobs.CreateBlobData(request.ResourceLocation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob data: %w", err)
+	}
+
+	res, err := p.repo.UploadResource(ctx, descriptor.ConvertFromV2Resource(request.Resource), content, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	converted, err := descriptor.ConvertToV2Resource(p.scheme, res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert resource: %w", err)
+	}
+
+	return &v1.AddGlobalResourceResponse{Resource: converted}, nil
+}
@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+// Run runs the plugin with the handlers registered with capabilities, e.g. with RegisterResourceRepository.
+// It takes care of the invocation by the plugin manager, so it should be the last thing done by main:
+//   - with the `capabilities` argument, the capabilities are printed and Run returns.
+//   - otherwise, the config passed with `--config` is parsed and the plugin is started until it's shut down.
+//
+// Logs are streamed to the plugin manager, so the default logger is replaced by a JSON logger writing to stderr.
+func Run(ctx context.Context, capabilities *endpoints.EndpointBuilder) error {
+	// log messages are shared over stderr by convention established by the plugin manager.
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelDebug, // the plugin manager filters messages by its own level.
+	}))
+	slog.SetDefault(logger)
+
+	return run(ctx, logger, capabilities, os.Args[1:], os.Stdout)
+}
+
+func run(ctx context.Context, logger *slog.Logger, capabilities *endpoints.EndpointBuilder, args []string, stdout io.Writer) error {
+	if len(args) > 0 && args[0] == "capabilities" {
+		content, err := capabilities.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal capabilities: %w", err)
+		}
+		if _, err := fmt.Fprintln(stdout, string(content)); err != nil {
+			return fmt.Errorf("failed to print capabilities: %w", err)
+		}
+		return nil
+	}
+
+	conf, err := parseConfig(args)
+	if err != nil {
+		return err
+	}
+
+	plugin := NewPlugin(ctx, logger, conf, stdout)
+	if err := plugin.RegisterHandlers(capabilities.GetHandlers()...); err != nil {
+		return fmt.Errorf("failed to register handlers: %w", err)
+	}
+
+	logger.InfoContext(ctx, "starting up plugin", "plugin", conf.ID)
+
+	return plugin.Start(ctx)
+}
+
+// parseConfig parses the config the plugin manager passes to the plugin with the --config argument.
+func parseConfig(args []string) (types.Config, error) {
+	flags := flag.NewFlagSet("plugin", flag.ContinueOnError)
+	configData := flags.String("config", "", "Plugin config.")
+	if err := flags.Parse(args); err != nil {
+		return types.Config{}, err
+	}
+	if *configData == "" {
+		return types.Config{}, errors.New("missing required flag --config")
+	}
+
+	conf := types.Config{}
+	if err := json.Unmarshal([]byte(*configData), &conf); err != nil {
+		return types.Config{}, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	if conf.ID == "" {
+		return types.Config{}, errors.New("plugin ID is required")
+	}
+
+	return conf, nil
+}
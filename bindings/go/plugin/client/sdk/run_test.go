@@ -0,0 +1,40 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func TestRun(t *testing.T) {
+	capabilities := endpoints.NewEndpoints(runtime.NewScheme())
+	capabilities.AddConfigType(runtime.NewVersionedType("TestConfig", "v1"))
+
+	t.Run("capabilities", func(t *testing.T) {
+		r := require.New(t)
+		output := bytes.NewBuffer(nil)
+		r.NoError(run(t.Context(), slog.Default(), capabilities, []string{"capabilities"}, output))
+
+		expected, err := capabilities.MarshalJSON()
+		r.NoError(err)
+		r.JSONEq(string(expected), output.String())
+	})
+
+	t.Run("missing config", func(t *testing.T) {
+		err := run(t.Context(), slog.Default(), capabilities, nil, bytes.NewBuffer(nil))
+		require.ErrorContains(t, err, "missing required flag --config")
+	})
+
+	t.Run("missing ID", func(t *testing.T) {
+		config, err := json.Marshal(map[string]string{"type": "unix"})
+		require.NoError(t, err)
+		err = run(t.Context(), slog.Default(), capabilities, []string{"--config", string(config)}, bytes.NewBuffer(nil))
+		require.ErrorContains(t, err, "plugin ID is required")
+	})
+}
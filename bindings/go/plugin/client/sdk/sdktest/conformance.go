@@ -0,0 +1,95 @@
+package sdktest
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v4alpha1"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/plugin/manager"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// TestResourceRepository checks that the resource repository plugin for the access of res conforms to its contract:
+// content uploaded with res can be downloaded again with the resource returned by the upload.
+func TestResourceRepository(t *testing.T, pm *manager.PluginManager, res *descriptor.Resource, content []byte, credentials runtime.Typed) {
+	t.Helper()
+	ctx := t.Context()
+
+	repo, err := pm.ResourcePluginRegistry.GetResourcePlugin(ctx, res.Access)
+	require.NoError(t, err, "no resource repository plugin for access type %s", res.Access.GetType())
+
+	var uploaded *descriptor.Resource
+	t.Run("upload", func(t *testing.T) {
+		uploaded, err = repo.UploadResource(ctx, res, blob.NewDirectReadOnlyBlob(bytes.NewReader(content)), credentials)
+		require.NoError(t, err, "failed to upload resource")
+		require.NotNil(t, uploaded, "upload must return the uploaded resource")
+		require.NotNil(t, uploaded.Access, "uploaded resource must have an access")
+	})
+	if uploaded == nil {
+		return
+	}
+
+	t.Run("download", func(t *testing.T) {
+		downloaded, err := repo.DownloadResource(ctx, uploaded, credentials)
+		require.NoError(t, err, "failed to download uploaded resource")
+		reader, err := downloaded.ReadCloser()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = reader.Close()
+		})
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, content, data, "downloaded content must equal uploaded content")
+	})
+}
+
+// TestSigningHandler checks that the signing handler plugin for config conforms to its contract:
+// signatures created for a digest are verified, and verifying them for another digest fails.
+func TestSigningHandler(t *testing.T, pm *manager.PluginManager, config runtime.Typed, credentials runtime.Typed) {
+	t.Helper()
+	ctx := t.Context()
+
+	handler, err := pm.SigningRegistry.GetPlugin(ctx, config)
+	require.NoError(t, err, "no signing handler plugin for config type %s", config.GetType())
+
+	digest := testDigest("signed")
+
+	var signature descriptor.SignatureInfo
+	t.Run("sign", func(t *testing.T) {
+		signature, err = handler.Sign(ctx, digest, config, credentials)
+		require.NoError(t, err, "failed to sign digest")
+		require.NotEmpty(t, signature.Algorithm, "signature must have an algorithm")
+		require.NotEmpty(t, signature.Value, "signature must have a value")
+	})
+	if signature.Value == "" {
+		return
+	}
+
+	t.Run("verify", func(t *testing.T) {
+		signed := descriptor.Signature{Name: "conformance", Digest: digest, Signature: signature}
+		require.NoError(t, handler.Verify(ctx, signed, config, credentials), "failed to verify signature")
+	})
+
+	t.Run("verify other digest", func(t *testing.T) {
+		signed := descriptor.Signature{Name: "conformance", Digest: testDigest("unsigned"), Signature: signature}
+		require.Error(t, handler.Verify(ctx, signed, config, credentials), "signature must not be valid for another digest")
+	})
+}
+
+// testDigest returns the digest of content as it is calculated for component descriptors.
+func testDigest(content string) descriptor.Digest {
+	sum := sha256.Sum256([]byte(content))
+	return descriptor.Digest{
+		HashAlgorithm:          crypto.SHA256.String(),
+		NormalisationAlgorithm: v4alpha1.Algorithm,
+		Value:                  hex.EncodeToString(sum[:]),
+	}
+}
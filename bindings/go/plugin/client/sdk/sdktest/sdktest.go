@@ -0,0 +1,133 @@
+// Package sdktest provides a harness to test plugins written with the sdk package in the current process.
+//
+// NewManager serves the handlers of the plugin with the sdk and registers the plugin with a plugin manager,
+// so the plugin is driven through the same registries, converters and transports as a plugin binary:
+//
+//	func TestPlugin(t *testing.T) {
+//		scheme := runtime.NewScheme()
+//		v1.MustAddToScheme(scheme)
+//		capabilities := endpoints.NewEndpoints(scheme)
+//		require.NoError(t, sdk.RegisterResourceRepository(&v1.Access{}, &Repository{}, capabilities))
+//
+//		pm := sdktest.NewManager(t, "my-plugin", capabilities)
+//		sdktest.TestResourceRepository(t, pm, resource, blob.NewDirectReadOnlyBlob(strings.NewReader("content")))
+//	}
+//
+// The Test functions check that a plugin conforms to the contract of its capability.
+package sdktest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/plugin/client/sdk"
+	"ocm.software/open-component-model/bindings/go/plugin/manager"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+// NewManager returns a plugin manager with the plugin registered under id. The plugin is served in the current
+// process with the handlers of capabilities once it's called. The manager and the plugin are shut down with the test.
+func NewManager(t testing.TB, id string, capabilities *endpoints.EndpointBuilder, opts ...manager.RegistrationOptionFn) *manager.PluginManager {
+	t.Helper()
+
+	content, err := capabilities.MarshalJSON()
+	require.NoError(t, err, "failed to marshal capabilities")
+
+	pm := manager.NewPluginManager(t.Context())
+	supervisor := &supervisor{
+		ctx:          t.Context(),
+		logger:       slog.Default().With("plugin", id),
+		capabilities: capabilities,
+	}
+	require.NoError(t, pm.RegisterSupervisedPlugin(t.Context(), id, supervisor, content, opts...), "failed to register plugin")
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.NoError(t, errors.Join(pm.Shutdown(ctx), supervisor.shutdown(ctx)), "failed to shut down plugin")
+	})
+
+	return pm
+}
+
+// supervisor serves the plugin in the current process.
+type supervisor struct {
+	ctx          context.Context
+	logger       *slog.Logger
+	capabilities *endpoints.EndpointBuilder
+	config       types.Config
+
+	once     sync.Once
+	plugin   *sdk.Plugin
+	served   chan error
+	client   *http.Client
+	location string
+	err      error
+}
+
+var _ types.Supervisor = (*supervisor)(nil)
+
+// Configure sets the config the plugin is served with.
+func (s *supervisor) Configure(config types.Config) {
+	s.config = config
+}
+
+// Start serves the plugin on first use and returns a client connected to it.
+func (s *supervisor) Start(ctx context.Context) (*http.Client, string, error) {
+	s.once.Do(func() {
+		s.client, s.location, s.err = s.start(ctx)
+	})
+	return s.client, s.location, s.err
+}
+
+func (s *supervisor) start(ctx context.Context) (*http.Client, string, error) {
+	stdout, stdoutWriter := io.Pipe()
+
+	s.plugin = sdk.NewPlugin(s.ctx, s.logger, s.config, stdoutWriter)
+	if err := s.plugin.RegisterHandlers(s.capabilities.GetHandlers()...); err != nil {
+		return nil, "", fmt.Errorf("failed to register handlers: %w", err)
+	}
+
+	s.served = make(chan error, 1)
+	go func() {
+		err := s.plugin.Serve(s.ctx)
+		stdoutWriter.CloseWithError(err)
+		s.served <- err
+	}()
+
+	return plugins.WaitForPlugin(ctx, &types.Plugin{
+		ID:     s.config.ID,
+		Config: s.config,
+		Stdout: stdout,
+	})
+}
+
+// shutdown shuts the plugin down if it was started and returns the error it was served with.
+func (s *supervisor) shutdown(ctx context.Context) error {
+	if s.served == nil {
+		return nil
+	}
+	if s.client != nil {
+		if closer, ok := s.client.Transport.(io.Closer); ok {
+			_ = closer.Close()
+		}
+		s.client.CloseIdleConnections()
+	}
+	if s.err != nil {
+		// the plugin may not be listening, so there is nothing to shut down.
+		return s.err
+	}
+
+	return errors.Join(s.plugin.GracefulShutdown(ctx), <-s.served)
+}
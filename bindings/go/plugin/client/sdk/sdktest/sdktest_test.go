@@ -0,0 +1,166 @@
+package sdktest_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	"ocm.software/open-component-model/bindings/go/blob/inmemory"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/plugin/client/sdk"
+	"ocm.software/open-component-model/bindings/go/plugin/client/sdk/sdktest"
+	"ocm.software/open-component-model/bindings/go/plugin/internal/dummytype"
+	dummyv1 "ocm.software/open-component-model/bindings/go/plugin/internal/dummytype/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/repository"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/signing"
+)
+
+var dummyType = runtime.NewVersionedType(dummyv1.Type, dummyv1.Version)
+
+func TestResourceRepository(t *testing.T) {
+	for _, transport := range []types.Transport{types.TransportHTTP, types.TransportGRPC} {
+		t.Run(string(transport), func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			dummytype.MustAddToScheme(scheme)
+			capabilities := endpoints.NewEndpoints(scheme)
+			capabilities.AddSupportedTransports(types.TransportGRPC)
+			require.NoError(t, sdk.RegisterResourceRepository(&dummyv1.Repository{}, &memoryRepository{
+				scheme:    scheme,
+				resources: map[string][]byte{},
+			}, capabilities))
+
+			pm := sdktest.NewManager(t, "sdktest-resource-"+string(transport), capabilities, manager.WithTransports(transport))
+
+			sdktest.TestResourceRepository(t, pm, &descriptor.Resource{
+				ElementMeta: descriptor.ElementMeta{
+					ObjectMeta: descriptor.ObjectMeta{
+						Name:    "resource",
+						Version: "v1.0.0",
+					},
+				},
+				Type:     "plainText",
+				Relation: descriptor.LocalRelation,
+				Access: &runtime.Raw{
+					Type: dummyType,
+					Data: []byte(`{"type":"DummyRepository/v1","baseUrl":"memory://"}`),
+				},
+			}, []byte("content"), nil)
+		})
+	}
+}
+
+func TestSigningHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	dummytype.MustAddToScheme(scheme)
+	capabilities := endpoints.NewEndpoints(scheme)
+	require.NoError(t, sdk.RegisterSigningHandler(&dummyv1.Repository{}, &hmacHandler{}, capabilities))
+
+	pm := sdktest.NewManager(t, "sdktest-signing", capabilities)
+
+	sdktest.TestSigningHandler(t, pm, &dummyv1.Repository{Type: dummyType, BaseUrl: "key"}, nil)
+
+	t.Run("identity", func(t *testing.T) {
+		handler, err := pm.SigningRegistry.GetPlugin(t.Context(), &dummyv1.Repository{Type: dummyType, BaseUrl: "key"})
+		require.NoError(t, err)
+		identity, err := handler.GetSigningCredentialConsumerIdentity(t.Context(), "signature", descriptor.Digest{Value: "abcd"}, &dummyv1.Repository{Type: dummyType, BaseUrl: "key"})
+		require.NoError(t, err)
+		require.Equal(t, runtime.Identity{"key": "key"}, identity)
+	})
+}
+
+// memoryRepository stores resources in memory by their name.
+type memoryRepository struct {
+	scheme *runtime.Scheme
+
+	mu        sync.Mutex
+	resources map[string][]byte
+}
+
+var _ repository.ResourceRepository = (*memoryRepository)(nil)
+
+func (m *memoryRepository) GetResourceCredentialConsumerIdentity(_ context.Context, res *descriptor.Resource) (runtime.Identity, error) {
+	return runtime.Identity{"type": res.Access.GetType().String()}, nil
+}
+
+func (m *memoryRepository) UploadResource(_ context.Context, res *descriptor.Resource, content blob.ReadOnlyBlob, _ runtime.Typed) (*descriptor.Resource, error) {
+	reader, err := content.ReadCloser()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.resources[res.Name] = data
+
+	uploaded := res.DeepCopy()
+	uploaded.Access = &dummyv1.Repository{Type: dummyType, BaseUrl: "memory://" + res.Name}
+	return uploaded, nil
+}
+
+func (m *memoryRepository) DownloadResource(_ context.Context, res *descriptor.Resource, _ runtime.Typed) (blob.ReadOnlyBlob, error) {
+	var access dummyv1.Repository
+	if err := m.scheme.Convert(res.Access, &access); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.resources[strings.TrimPrefix(access.BaseUrl, "memory://")]
+	if !ok {
+		return nil, fmt.Errorf("resource %s not found", access.BaseUrl)
+	}
+	return inmemory.New(strings.NewReader(string(data))), nil
+}
+
+// hmacHandler signs digests with an HMAC keyed with the base URL of the config.
+type hmacHandler struct{}
+
+var _ signing.Handler = (*hmacHandler)(nil)
+
+func (h *hmacHandler) GetSigningCredentialConsumerIdentity(_ context.Context, _ string, _ descriptor.Digest, config runtime.Typed) (runtime.Identity, error) {
+	return runtime.Identity{"key": config.(*dummyv1.Repository).BaseUrl}, nil
+}
+
+func (h *hmacHandler) Sign(_ context.Context, unsigned descriptor.Digest, config runtime.Typed, _ runtime.Typed) (descriptor.SignatureInfo, error) {
+	return descriptor.SignatureInfo{
+		Algorithm: "HMAC-SHA256",
+		Value:     h.mac(unsigned, config),
+		MediaType: "text/plain",
+	}, nil
+}
+
+func (h *hmacHandler) GetVerifyingCredentialConsumerIdentity(_ context.Context, _ descriptor.Signature, config runtime.Typed) (runtime.Identity, error) {
+	return runtime.Identity{"key": config.(*dummyv1.Repository).BaseUrl}, nil
+}
+
+func (h *hmacHandler) Verify(_ context.Context, signed descriptor.Signature, config runtime.Typed, _ runtime.Typed) error {
+	if !hmac.Equal([]byte(signed.Signature.Value), []byte(h.mac(signed.Digest, config))) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+func (h *hmacHandler) mac(digest descriptor.Digest, config runtime.Typed) string {
+	mac := hmac.New(sha256.New, []byte(config.(*dummyv1.Repository).BaseUrl))
+	mac.Write([]byte(digest.Value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
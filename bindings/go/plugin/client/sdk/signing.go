@@ -0,0 +1,81 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/signing/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/signinghandler"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/signing"
+)
+
+// RegisterSigningHandler registers handler as signing handler plugin for the config type of proto.
+// The handler is called with configs of type T. The config type must be registered with the scheme of capabilities.
+func RegisterSigningHandler[T runtime.Typed](proto T, handler signing.Handler, capabilities *endpoints.EndpointBuilder) error {
+	return signinghandler.RegisterPlugin(proto, &signingHandlerPlugin[T]{handler: handler}, capabilities)
+}
+
+// signingHandlerPlugin serves a signing.Handler as signing handler plugin.
+type signingHandlerPlugin[T runtime.Typed] struct {
+	handler signing.Handler
+}
+
+var _ v1.SignatureHandlerContract[runtime.Typed] = (*signingHandlerPlugin[runtime.Typed])(nil)
+
+func (p *signingHandlerPlugin[T]) Ping(_ context.Context) error {
+	return nil
+}
+
+func (p *signingHandlerPlugin[T]) GetSignerIdentity(ctx context.Context, request *v1.GetSignerIdentityRequest[T]) (*v1.IdentityResponse, error) {
+	if request.Digest == nil {
+		return nil, errors.New("digest is required")
+	}
+
+	identity, err := p.handler.GetSigningCredentialConsumerIdentity(ctx, request.Name, *descriptor.ConvertFromV2Digest(request.Digest), request.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.IdentityResponse{Identity: identity}, nil
+}
+
+func (p *signingHandlerPlugin[T]) Sign(ctx context.Context, request *v1.SignRequest[T], credentials runtime.Typed) (*v1.SignResponse, error) {
+	if request.Digest == nil {
+		return nil, errors.New("digest is required")
+	}
+
+	signature, err := p.handler.Sign(ctx, *descriptor.ConvertFromV2Digest(request.Digest), request.Config, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.SignResponse{Signature: descriptor.ConvertToV2SignatureInfo(&signature)}, nil
+}
+
+func (p *signingHandlerPlugin[T]) GetVerifierIdentity(ctx context.Context, request *v1.GetVerifierIdentityRequest[T]) (*v1.IdentityResponse, error) {
+	if request.Signature == nil {
+		return nil, errors.New("signature is required")
+	}
+
+	identity, err := p.handler.GetVerifyingCredentialConsumerIdentity(ctx, *descriptor.ConvertFromV2Signature(request.Signature), request.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.IdentityResponse{Identity: identity}, nil
+}
+
+func (p *signingHandlerPlugin[T]) Verify(ctx context.Context, request *v1.VerifyRequest[T], credentials runtime.Typed) (*v1.VerifyResponse, error) {
+	if request.Signature == nil {
+		return nil, errors.New("signature is required")
+	}
+
+	if err := p.handler.Verify(ctx, *descriptor.ConvertFromV2Signature(request.Signature), request.Config, credentials); err != nil {
+		return nil, err
+	}
+
+	return &v1.VerifyResponse{}, nil
+}
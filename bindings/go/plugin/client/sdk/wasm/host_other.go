@@ -0,0 +1,7 @@
+//go:build !wasip1
+
+package wasm
+
+func hostDo([]byte) ([]byte, error) {
+	return nil, ErrNotSupported
+}
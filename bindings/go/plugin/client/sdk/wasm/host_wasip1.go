@@ -0,0 +1,37 @@
+//go:build wasip1
+
+package wasm
+
+import (
+	"errors"
+	"unsafe"
+)
+
+//go:wasmimport ocm_http do
+func do(reqPtr unsafe.Pointer, reqLen uint32, handlePtr unsafe.Pointer) int64
+
+//go:wasmimport ocm_http read
+func read(handle uint32, ptr unsafe.Pointer, length uint32) uint32
+
+// hostDo performs the serialized request with the host module of the plugin manager and returns the serialized response.
+func hostDo(req []byte) ([]byte, error) {
+	var handle uint32
+	n := do(unsafe.Pointer(unsafe.SliceData(req)), uint32(len(req)), unsafe.Pointer(&handle))
+
+	size := n
+	if n < 0 {
+		size = -n
+	}
+	result := make([]byte, size)
+	// the result is always read to release it, even if it's empty.
+	copied := read(handle, unsafe.Pointer(unsafe.SliceData(result)), uint32(size))
+	result = result[:copied]
+
+	if n < 0 {
+		if len(result) == 0 {
+			return nil, errors.New("request failed in the plugin manager")
+		}
+		return nil, errors.New(string(result))
+	}
+	return result, nil
+}
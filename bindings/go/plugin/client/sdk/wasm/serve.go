@@ -0,0 +1,154 @@
+package wasm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+// Serve serves the invocation of the plugin by the plugin manager with the handlers of the capabilities.
+// The plugin is either asked for its capabilities or to serve a single call read from stdin.
+// Serve returns once the call is served, so it should be the last thing done by main.
+func Serve(ctx context.Context, capabilities *endpoints.EndpointBuilder) error {
+	return serve(ctx, capabilities, os.Args[1:], os.Stdin, os.Stdout)
+}
+
+func serve(ctx context.Context, capabilities *endpoints.EndpointBuilder, args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) > 0 && args[0] == "capabilities" {
+		content, err := capabilities.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal capabilities: %w", err)
+		}
+		if _, err := fmt.Fprintln(stdout, string(content)); err != nil {
+			return fmt.Errorf("failed to print capabilities: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := ParseConfig(args); err != nil {
+		return err
+	}
+
+	req, err := http.ReadRequest(bufio.NewReader(stdin))
+	if err != nil {
+		return fmt.Errorf("failed to read request: %w", err)
+	}
+
+	m := http.NewServeMux()
+	for _, h := range capabilities.GetHandlers() {
+		m.HandleFunc(h.Location, panicRecovery(h.Handler))
+	}
+	m.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	w := newResponseWriter(stdout)
+	m.ServeHTTP(w, req.WithContext(ctx))
+
+	return w.close()
+}
+
+// ParseConfig parses the config the plugin manager passes to the plugin with the --config argument.
+func ParseConfig(args []string) (types.Config, error) {
+	flags := flag.NewFlagSet("plugin", flag.ContinueOnError)
+	configData := flags.String("config", "", "Plugin config.")
+	if err := flags.Parse(args); err != nil {
+		return types.Config{}, err
+	}
+	if *configData == "" {
+		return types.Config{}, errors.New("missing required flag --config")
+	}
+
+	conf := types.Config{}
+	if err := json.Unmarshal([]byte(*configData), &conf); err != nil {
+		return types.Config{}, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	if conf.ID == "" {
+		return types.Config{}, errors.New("plugin ID is required")
+	}
+
+	return conf, nil
+}
+
+func panicRecovery(f http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if err := recover(); err != nil {
+				plugins.NewError(fmt.Errorf("panic recovered: %v", err), http.StatusInternalServerError).Write(w)
+			}
+		}()
+
+		f(w, r)
+	}
+}
+
+// responseWriter writes the response as HTTP/1.1 with a chunked body, so it's streamed to the manager while it's written.
+type responseWriter struct {
+	out     *bufio.Writer
+	header  http.Header
+	body    io.WriteCloser
+	written bool
+}
+
+var (
+	_ http.ResponseWriter = (*responseWriter)(nil)
+	_ http.Flusher        = (*responseWriter)(nil)
+)
+
+func newResponseWriter(out io.Writer) *responseWriter {
+	return &responseWriter{
+		out:    bufio.NewWriter(out),
+		header: make(http.Header),
+	}
+}
+
+func (w *responseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *responseWriter) WriteHeader(statusCode int) {
+	if w.written {
+		return
+	}
+	w.written = true
+
+	w.header.Del("Content-Length")
+	w.header.Set("Transfer-Encoding", "chunked")
+	_, _ = fmt.Fprintf(w.out, "HTTP/1.1 %d %s\r\n", statusCode, http.StatusText(statusCode))
+	_ = w.header.Write(w.out)
+	_, _ = w.out.WriteString("\r\n")
+	w.body = httputil.NewChunkedWriter(w.out)
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(p)
+}
+
+func (w *responseWriter) Flush() {
+	_ = w.out.Flush()
+}
+
+// close terminates the body and flushes the response.
+func (w *responseWriter) close() error {
+	w.WriteHeader(http.StatusOK)
+	if err := w.body.Close(); err != nil {
+		return err
+	}
+	// the chunked writer doesn't terminate the (empty) trailer.
+	if _, err := w.out.WriteString("\r\n"); err != nil {
+		return err
+	}
+	return w.out.Flush()
+}
@@ -0,0 +1,61 @@
+package wasm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func TestServe(t *testing.T) {
+	capabilities := endpoints.NewEndpoints(runtime.NewScheme())
+	capabilities.AddConfigType(runtime.NewVersionedType("custom.config", "v1"))
+	capabilities.Handlers = append(capabilities.Handlers, endpoints.Handler{
+		Location: "/echo",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Type", r.URL.Query().Get("type"))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.Copy(w, r.Body)
+		},
+	})
+
+	t.Run("capabilities", func(t *testing.T) {
+		var stdout bytes.Buffer
+		require.NoError(t, serve(t.Context(), capabilities, []string{"capabilities"}, nil, &stdout))
+		assert.Contains(t, stdout.String(), "custom.config/v1")
+	})
+
+	t.Run("call", func(t *testing.T) {
+		config, err := json.Marshal(types.Config{ID: "test-plugin"})
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPost, "http://unix/echo?type=Dummy/v1", strings.NewReader(`{"name":"test"}`))
+		require.NoError(t, err)
+		var stdin, stdout bytes.Buffer
+		require.NoError(t, req.Write(&stdin))
+
+		require.NoError(t, serve(t.Context(), capabilities, []string{"--config", string(config)}, &stdin, &stdout))
+
+		resp, err := http.ReadResponse(bufio.NewReader(&stdout), req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "Dummy/v1", resp.Header.Get("X-Type"))
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"test"}`, string(body))
+	})
+
+	t.Run("missing config", func(t *testing.T) {
+		require.ErrorContains(t, serve(t.Context(), capabilities, nil, nil, io.Discard), "missing required flag --config")
+	})
+}
@@ -0,0 +1,39 @@
+package wasm
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotSupported is returned by Transport if the plugin isn't run by the WebAssembly runtime of the plugin manager.
+var ErrNotSupported = errors.New("the host transport is only supported in WebAssembly plugins")
+
+// Transport sends HTTP requests through the plugin manager, as WebAssembly plugins can't open connections
+// themselves. The manager only performs requests to hosts the plugin is allowed to access. Responses
+// are read into memory as a whole.
+type Transport struct{}
+
+var _ http.RoundTripper = Transport{}
+
+// NewClient returns a client sending requests through the plugin manager.
+func NewClient() *http.Client {
+	return &http.Client{Transport: Transport{}}
+}
+
+func (Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var buf bytes.Buffer
+	// the absolute URL is written, so the scheme of the request is kept.
+	if err := req.WriteProxy(&buf); err != nil {
+		return nil, fmt.Errorf("failed to write request: %w", err)
+	}
+
+	result, err := hostDo(buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return http.ReadResponse(bufio.NewReader(bytes.NewReader(result)), req)
+}
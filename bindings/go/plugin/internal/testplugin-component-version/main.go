@@ -215,6 +215,9 @@ func main() {
 		Version: "v1",
 	})
 
+	// the manager tests call this plugin over the grpc transport.
+	capabilities.AddSupportedTransports(types.TransportGRPC)
+
 	// TODO(Skarlso): ConsumerIdentityTypesForConfig endpoint
 
 	if len(args) > 0 && args[0] == "capabilities" {
@@ -2,14 +2,12 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"flag"
 	"fmt"
 	"log/slog"
 	"os"
 
 	v2 "ocm.software/open-component-model/bindings/go/descriptor/v2"
-	plugin "ocm.software/open-component-model/bindings/go/plugin/client/sdk"
+	"ocm.software/open-component-model/bindings/go/plugin/client/sdk"
 	"ocm.software/open-component-model/bindings/go/plugin/internal/dummytype"
 	dummyv1 "ocm.software/open-component-model/bindings/go/plugin/internal/dummytype/v1"
 	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/input/v1"
@@ -21,8 +19,6 @@ import (
 
 type TestPlugin struct{}
 
-var logger *slog.Logger
-
 func (m *TestPlugin) GetIdentity(ctx context.Context, typ *v1.GetIdentityRequest[runtime.Typed]) (*v1.GetIdentityResponse, error) {
 	_, _ = fmt.Fprintf(os.Stdout, "GetIdentity: %+v\n", typ.Typ)
 	return nil, nil
@@ -82,71 +78,17 @@ func (m *TestPlugin) Ping(_ context.Context) error {
 var _ v1.ResourceInputPluginContract = &TestPlugin{}
 
 func main() {
-	args := os.Args[1:]
-	// log messages are shared over stderr by convention established by the plugin manager.
-	logger = slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
-		Level: slog.LevelDebug, // debug level here is respected when sending this message.
-	}))
-
 	scheme := runtime.NewScheme()
 	dummytype.MustAddToScheme(scheme)
 	capabilities := endpoints.NewEndpoints(scheme)
 
 	if err := input.RegisterInputProcessor(&dummyv1.Repository{}, &TestPlugin{}, capabilities); err != nil {
-		logger.Error("failed to register test plugin", "error", err.Error())
-		os.Exit(1)
-	}
-
-	logger.Info("registered test plugin")
-
-	if len(args) > 0 && args[0] == "capabilities" {
-		content, err := capabilities.MarshalJSON()
-		if err != nil {
-			logger.Error("failed to marshal capabilities", "error", err)
-			os.Exit(1)
-		}
-
-		if _, err := fmt.Fprintln(os.Stdout, string(content)); err != nil {
-			logger.Error("failed print capabilities", "error", err)
-			os.Exit(1)
-		}
-
-		logger.Info("capabilities sent")
-
-		os.Exit(0)
-	}
-
-	// Parse command-line arguments
-	configData := flag.String("config", "", "Plugin config.")
-	flag.Parse()
-	if configData == nil || *configData == "" {
-		logger.Error("missing required flag --config")
+		slog.Error("failed to register test plugin", "error", err.Error())
 		os.Exit(1)
 	}
 
-	conf := types.Config{}
-	if err := json.Unmarshal([]byte(*configData), &conf); err != nil {
-		logger.Error("failed to unmarshal config", "error", err)
-		os.Exit(1)
-	}
-	logger.Debug("config data", "config", conf)
-
-	if conf.ID == "" {
-		logger.Error("plugin config has no ID")
-		os.Exit(1)
-	}
-
-	separateContext := context.Background()
-	ocmPlugin := plugin.NewPlugin(separateContext, logger, conf, os.Stdout)
-	if err := ocmPlugin.RegisterHandlers(capabilities.GetHandlers()...); err != nil {
-		logger.Error("failed to register handlers", "error", err)
-		os.Exit(1)
-	}
-
-	logger.Info("starting up plugin", "plugin", conf.ID)
-
-	if err := ocmPlugin.Start(context.Background()); err != nil {
-		logger.Error("failed to start plugin", "error", err)
+	if err := sdk.Run(context.Background(), capabilities); err != nil {
+		slog.Error("failed to run test plugin", "error", err.Error())
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	plugin "ocm.software/open-component-model/bindings/go/plugin/client/sdk"
+	"ocm.software/open-component-model/bindings/go/plugin/internal/dummytype"
+	dummyv1 "ocm.software/open-component-model/bindings/go/plugin/internal/dummytype/v1"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/transformation"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+type TestPlugin struct{}
+
+var logger *slog.Logger
+
+// Transform appends a suffix to the base url, so callers can see that the plugin executed the transformation.
+func (m *TestPlugin) Transform(ctx context.Context, request *v1.TransformRequest[*dummyv1.Repository], credentials runtime.Typed) (*v1.TransformResponse[*dummyv1.Repository], error) {
+	logger.Debug("Transform", "baseUrl", request.Transformation.BaseUrl)
+	transformed := request.Transformation.DeepCopy()
+	transformed.BaseUrl += "-transformed"
+	return &v1.TransformResponse[*dummyv1.Repository]{Transformation: transformed}, nil
+}
+
+func (m *TestPlugin) GetIdentity(ctx context.Context, typ *v1.GetIdentityRequest[*dummyv1.Repository]) (*v1.GetIdentityResponse, error) {
+	return &v1.GetIdentityResponse{Identity: map[string]string{"baseUrl": typ.Typ.BaseUrl}}, nil
+}
+
+func (m *TestPlugin) Ping(_ context.Context) error {
+	return nil
+}
+
+var _ v1.TransformationPluginContract[*dummyv1.Repository] = &TestPlugin{}
+
+func main() {
+	args := os.Args[1:]
+	// log messages are shared over stderr by convention established by the plugin manager.
+	logger = slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelDebug, // debug level here is respected when sending this message.
+	}))
+
+	scheme := runtime.NewScheme()
+	dummytype.MustAddToScheme(scheme)
+	capabilities := endpoints.NewEndpoints(scheme)
+
+	if err := transformation.RegisterTransformation(&dummyv1.Repository{}, &TestPlugin{}, capabilities); err != nil {
+		logger.Error("failed to register test plugin", "error", err.Error())
+		os.Exit(1)
+	}
+
+	logger.Info("registered test plugin")
+
+	if len(args) > 0 && args[0] == "capabilities" {
+		content, err := capabilities.MarshalJSON()
+		if err != nil {
+			logger.Error("failed to marshal capabilities", "error", err)
+			os.Exit(1)
+		}
+
+		if _, err := fmt.Fprintln(os.Stdout, string(content)); err != nil {
+			logger.Error("failed print capabilities", "error", err)
+			os.Exit(1)
+		}
+
+		logger.Info("capabilities sent")
+
+		os.Exit(0)
+	}
+
+	// Parse command-line arguments
+	configData := flag.String("config", "", "Plugin config.")
+	flag.Parse()
+	if configData == nil || *configData == "" {
+		logger.Error("missing required flag --config")
+		os.Exit(1)
+	}
+
+	conf := types.Config{}
+	if err := json.Unmarshal([]byte(*configData), &conf); err != nil {
+		logger.Error("failed to unmarshal config", "error", err)
+		os.Exit(1)
+	}
+	logger.Debug("config data", "config", conf)
+
+	if conf.ID == "" {
+		logger.Error("plugin config has no ID")
+		os.Exit(1)
+	}
+
+	separateContext := context.Background()
+	ocmPlugin := plugin.NewPlugin(separateContext, logger, conf, os.Stdout)
+	if err := ocmPlugin.RegisterHandlers(capabilities.GetHandlers()...); err != nil {
+		logger.Error("failed to register handlers", "error", err)
+		os.Exit(1)
+	}
+
+	logger.Info("starting up plugin", "plugin", conf.ID)
+
+	if err := ocmPlugin.Start(context.Background()); err != nil {
+		logger.Error("failed to start plugin", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,101 @@
+// This test plugin is compiled to WebAssembly with GOOS=wasip1 GOARCH=wasm.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	v2 "ocm.software/open-component-model/bindings/go/descriptor/v2"
+	"ocm.software/open-component-model/bindings/go/plugin/client/sdk/wasm"
+	"ocm.software/open-component-model/bindings/go/plugin/internal/dummytype"
+	dummyv1 "ocm.software/open-component-model/bindings/go/plugin/internal/dummytype/v1"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/input/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/input"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+type TestPlugin struct{}
+
+var logger *slog.Logger
+
+func (m *TestPlugin) GetIdentity(ctx context.Context, typ *v1.GetIdentityRequest[runtime.Typed]) (*v1.GetIdentityResponse, error) {
+	return nil, nil
+}
+
+// ProcessResource downloads the content of the resource from the base URL of its input through the plugin manager.
+func (m *TestPlugin) ProcessResource(ctx context.Context, request *v1.ProcessResourceInputRequest, credentials runtime.Typed) (*v1.ProcessResourceInputResponse, error) {
+	var spec dummyv1.Repository
+	if err := json.Unmarshal(request.Resource.Input.Data, &spec); err != nil {
+		return nil, fmt.Errorf("error unmarshalling input: %w", err)
+	}
+
+	logger.Info("downloading resource", "url", spec.BaseUrl)
+	resp, err := wasm.NewClient().Get(spec.BaseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading resource: %w", err)
+	}
+	defer resp.Body.Close()
+
+	tmp, err := os.CreateTemp("", "test-resource-file")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temp file: %w", err)
+	}
+	defer tmp.Close()
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		return nil, fmt.Errorf("error writing temp file: %w", err)
+	}
+
+	return &v1.ProcessResourceInputResponse{
+		Resource: &v2.Resource{
+			ElementMeta: v2.ElementMeta{
+				ObjectMeta: v2.ObjectMeta{
+					Name:    request.Resource.Name,
+					Version: request.Resource.Version,
+				},
+			},
+			Type:     request.Resource.Type,
+			Relation: "local",
+		},
+		Location: &types.Location{
+			LocationType: types.LocationTypeLocalFile,
+			Value:        tmp.Name(),
+		},
+	}, nil
+}
+
+func (m *TestPlugin) ProcessSource(ctx context.Context, request *v1.ProcessSourceInputRequest, credentials runtime.Typed) (*v1.ProcessSourceInputResponse, error) {
+	return nil, fmt.Errorf("sources are not supported")
+}
+
+func (m *TestPlugin) Ping(_ context.Context) error {
+	return nil
+}
+
+var _ v1.ResourceInputPluginContract = &TestPlugin{}
+
+func main() {
+	// log messages are shared over stderr by convention established by the plugin manager.
+	logger = slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelDebug, // debug level here is respected when sending this message.
+	}))
+
+	scheme := runtime.NewScheme()
+	dummytype.MustAddToScheme(scheme)
+	capabilities := endpoints.NewEndpoints(scheme)
+
+	if err := input.RegisterInputProcessor(&dummyv1.Repository{}, &TestPlugin{}, capabilities); err != nil {
+		logger.Error("failed to register test plugin", "error", err.Error())
+		os.Exit(1)
+	}
+
+	if err := wasm.Serve(context.Background(), capabilities); err != nil {
+		logger.Error("failed to serve test plugin", "error", err.Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const TransformationPluginType types.PluginType = "transformation"
+
+var Scheme *runtime.Scheme
+
+func init() {
+	Scheme = runtime.NewScheme()
+	Scheme.MustRegisterWithAlias(&CapabilitySpec{}, runtime.NewUnversionedType(string(TransformationPluginType)))
+}
+
+// CapabilitySpec specifies the transformation types a plugin can execute as nodes of a transformation graph.
+// The JSON schema of each type describes the complete transformation including its spec and output,
+// it is used by the graph builder to type check the expressions referring to the transformation.
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+type CapabilitySpec struct {
+	Type                         runtime.Type `json:"type"`
+	SupportedTransformationTypes []types.Type `json:"supportedTransformationTypes"`
+}
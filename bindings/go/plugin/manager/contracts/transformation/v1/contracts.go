@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"context"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/contracts"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// IdentityProvider provides a way to retrieve the identity of a plugin. This identity can then further be used to resolve
+// credentials for a specific plugin.
+type IdentityProvider[T runtime.Typed] interface {
+	contracts.PluginBase
+	GetIdentity(ctx context.Context, typ *GetIdentityRequest[T]) (*GetIdentityResponse, error)
+}
+
+// TransformationPluginContract is the contract of plugins that execute transformations of a transformation graph.
+type TransformationPluginContract[T runtime.Typed] interface {
+	contracts.PluginBase
+	IdentityProvider[T]
+	// Transform executes the transformation and returns it with its output set.
+	Transform(ctx context.Context, request *TransformRequest[T], credentials runtime.Typed) (*TransformResponse[T], error)
+}
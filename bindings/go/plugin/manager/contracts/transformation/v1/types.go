@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+type TransformRequest[T runtime.Typed] struct {
+	// Transformation to execute with all expressions of its spec resolved.
+	Transformation T `json:"transformation"`
+}
+
+type TransformResponse[T runtime.Typed] struct {
+	// Transformation that was executed with its output set.
+	Transformation T `json:"transformation"`
+}
+
+type GetIdentityRequest[T runtime.Typed] struct {
+	Typ T `json:"type"`
+}
+
+type GetIdentityResponse struct {
+	Identity map[string]string `json:"identity"`
+}
@@ -6,6 +6,8 @@
 // The Plugin Manager facilitates the use of plugins by:
 //   - Discovering plugins in a given location.
 //   - Registering component version repositories.
+//   - Running plugins compiled to WebAssembly (files with the extension .wasm) in a sandbox, see package wasm.
+//   - Registering plugins started by a custom supervisor, e.g. plugins tested in-process with package sdktest.
 //
 // Plugin Management flow:
 //
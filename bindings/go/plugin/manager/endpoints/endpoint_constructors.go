@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
 	pluginruntime "ocm.software/open-component-model/bindings/go/plugin/manager/types/runtime"
 	"ocm.software/open-component-model/bindings/go/runtime"
 )
@@ -56,3 +57,9 @@ func (c *EndpointBuilder) GetHandlers() []Handler {
 func (c *EndpointBuilder) AddConfigType(typ ...runtime.Type) {
 	c.PluginSpec.SupportedConfigTypes = append(c.PluginSpec.SupportedConfigTypes, typ...)
 }
+
+// AddSupportedTransports declares transports the plugin supports in addition to HTTP, e.g. types.TransportGRPC.
+// The plugin manager selects the transport during the capabilities handshake and passes it with the plugin config.
+func (c *EndpointBuilder) AddSupportedTransports(transports ...types.Transport) {
+	c.PluginSpec.SupportedTransports = append(c.PluginSpec.SupportedTransports, transports...)
+}
@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -24,6 +25,7 @@ import (
 	ocmrepositoryv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1"
 	resourcev1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/resource/v1"
 	signinghandlerv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/signing/v1"
+	transformationv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/blobtransformer"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/componentlister"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/componentversionrepository"
@@ -31,11 +33,14 @@ import (
 	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/credentialrepository"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/digestprocessor"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/input"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/resource"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/signinghandler"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/transformation"
 	mtypes "ocm.software/open-component-model/bindings/go/plugin/manager/types"
 	pluginruntime "ocm.software/open-component-model/bindings/go/plugin/manager/types/runtime"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/types/spec"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/wasm"
 	"ocm.software/open-component-model/bindings/go/runtime"
 )
 
@@ -55,9 +60,15 @@ type PluginManager struct {
 	ResourcePluginRegistry             *resource.ResourceRegistry
 	BlobTransformerRegistry            *blobtransformer.Registry
 	SigningRegistry                    *signinghandler.SigningRegistry
+	TransformationRegistry             *transformation.Registry
 
 	mu sync.Mutex
 
+	// supervisors supervise the processes of the registered plugins by plugin ID.
+	supervisors map[string]*plugins.Supervisor
+	// wasmPlugins are the registered plugins compiled to WebAssembly by plugin ID.
+	wasmPlugins map[string]*wasm.Plugin
+
 	// baseCtx is the context that is used for all plugins.
 	// This is a different context than the one used for fetching plugins because
 	// that context is done once fetching is done. The plugin context, however, must not
@@ -78,6 +89,9 @@ func NewPluginManager(ctx context.Context) *PluginManager {
 		ResourcePluginRegistry:             resource.NewResourceRegistry(ctx),
 		BlobTransformerRegistry:            blobtransformer.NewBlobTransformerRegistry(ctx),
 		SigningRegistry:                    signinghandler.NewSigningRegistry(ctx),
+		TransformationRegistry:             transformation.NewTransformationRegistry(ctx),
+		supervisors:                        make(map[string]*plugins.Supervisor),
+		wasmPlugins:                        make(map[string]*wasm.Plugin),
 		baseCtx:                            ctx,
 	}
 }
@@ -85,8 +99,17 @@ func NewPluginManager(ctx context.Context) *PluginManager {
 type RegistrationOptions struct {
 	IdleTimeout time.Duration
 	Config      *genericv1.Config
+	Process     mtypes.ProcessOptions
+	// Transports are the transports used for plugins in order of preference.
+	// The first one a plugin supports is selected.
+	Transports []mtypes.Transport
+	// WASM configures the sandbox of plugins compiled to WebAssembly.
+	WASM mtypes.WASMOptions
 }
 
+// DefaultTransports prefers the gRPC transport for plugins supporting it and falls back to HTTP.
+var DefaultTransports = []mtypes.Transport{mtypes.TransportGRPC, mtypes.TransportHTTP}
+
 type RegistrationOptionFn func(*RegistrationOptions)
 
 // WithIdleTimeout configures the maximum amount of time for a plugin to quit if it's idle.
@@ -103,8 +126,33 @@ func WithConfiguration(c *genericv1.Config) RegistrationOptionFn {
 	}
 }
 
+// WithProcessOptions configures how the processes of the plugins are supervised,
+// e.g. the number of instances per plugin, health checks and resource limits.
+func WithProcessOptions(opts mtypes.ProcessOptions) RegistrationOptionFn {
+	return func(o *RegistrationOptions) {
+		o.Process = opts
+	}
+}
+
+// WithTransports configures the transports used for plugins in order of preference.
+// Plugins supporting none of them fail to register.
+func WithTransports(transports ...mtypes.Transport) RegistrationOptionFn {
+	return func(o *RegistrationOptions) {
+		o.Transports = transports
+	}
+}
+
+// WithWASMOptions configures the sandbox of plugins compiled to WebAssembly,
+// e.g. the directories and hosts they are allowed to access.
+func WithWASMOptions(opts mtypes.WASMOptions) RegistrationOptionFn {
+	return func(o *RegistrationOptions) {
+		o.WASM = opts
+	}
+}
+
 // RegisterPlugins walks through files in a folder and registers them
-// as plugins if connection points can be established. This function doesn't support
+// as plugins if connection points can be established. Files with the extension .wasm are
+// registered as plugins compiled to WebAssembly and run in a sandbox. This function doesn't support
 // concurrent access.
 func (pm *PluginManager) RegisterPlugins(ctx context.Context, dir string, opts ...RegistrationOptionFn) error {
 	pm.mu.Lock()
@@ -112,6 +160,7 @@ func (pm *PluginManager) RegisterPlugins(ctx context.Context, dir string, opts .
 
 	defaultOpts := &RegistrationOptions{
 		IdleTimeout: time.Hour,
+		Transports:  DefaultTransports,
 	}
 
 	for _, opt := range opts {
@@ -141,6 +190,13 @@ func (pm *PluginManager) RegisterPlugins(ctx context.Context, dir string, opts .
 		conf.ID = plugin.ID
 		plugin.Config = *conf
 
+		if filepath.Ext(plugin.Path) == mtypes.WASMExtension {
+			if err := pm.addWASMPlugin(ctx, defaultOpts, *plugin); err != nil {
+				return fmt.Errorf("failed to add plugin %s: %w", plugin.ID, err)
+			}
+			continue
+		}
+
 		output := bytes.NewBuffer(nil)
 		// TODO(fabianburth): provide developer documentation on how to debug
 		//   plugins.
@@ -157,7 +213,7 @@ func (pm *PluginManager) RegisterPlugins(ctx context.Context, dir string, opts .
 			return fmt.Errorf("failed to start plugin %s: %w", plugin.ID, err)
 		}
 
-		if err := pm.addPlugin(pm.baseCtx, defaultOpts.Config, *plugin, output); err != nil {
+		if err := pm.addPlugin(pm.baseCtx, defaultOpts, *plugin, output); err != nil {
 			return fmt.Errorf("failed to add plugin %s: %w", plugin.ID, err)
 		}
 	}
@@ -165,16 +221,102 @@ func (pm *PluginManager) RegisterPlugins(ctx context.Context, dir string, opts .
 	return nil
 }
 
+// addWASMPlugin compiles the plugin and registers it with the capabilities it reports.
+func (pm *PluginManager) addWASMPlugin(ctx context.Context, opts *RegistrationOptions, plugin mtypes.Plugin) error {
+	wasmPlugin, err := wasm.NewPlugin(pm.baseCtx, plugin, opts.WASM)
+	if err != nil {
+		return err
+	}
+
+	output, err := wasmPlugin.Capabilities(ctx)
+	if err != nil {
+		return errors.Join(err, wasmPlugin.Close(ctx))
+	}
+
+	plugin.Supervisor = wasmPlugin
+	if err := pm.addPlugin(pm.baseCtx, opts, plugin, output); err != nil {
+		return errors.Join(err, wasmPlugin.Close(ctx))
+	}
+	pm.wasmPlugins[plugin.ID] = wasmPlugin
+
+	return nil
+}
+
+// configurable is implemented by supervisors passing the config to the plugin themselves.
+type configurable interface {
+	Configure(config mtypes.Config)
+}
+
+// RegisterSupervisedPlugin registers a plugin started by supervisor instead of a plugin binary,
+// e.g. a plugin served in the current process by tests. The capabilities are the output of the
+// capabilities command of the plugin. If supervisor has a Configure(types.Config) method, it is
+// called with the config of the plugin before the plugin is started.
+func (pm *PluginManager) RegisterSupervisedPlugin(ctx context.Context, id string, supervisor mtypes.Supervisor, capabilities []byte, opts ...RegistrationOptionFn) error {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	defaultOpts := &RegistrationOptions{
+		IdleTimeout: time.Hour,
+		Transports:  DefaultTransports,
+	}
+
+	for _, opt := range opts {
+		opt(defaultOpts)
+	}
+
+	t, err := determineConnectionType(ctx)
+	if err != nil {
+		return fmt.Errorf("could not determine connection type: %w", err)
+	}
+
+	plugin := mtypes.Plugin{
+		ID: id,
+		Config: mtypes.Config{
+			ID:          id,
+			Type:        t,
+			IdleTimeout: &defaultOpts.IdleTimeout,
+		},
+		Supervisor: supervisor,
+	}
+
+	if err := pm.addPlugin(pm.baseCtx, defaultOpts, plugin, bytes.NewBuffer(capabilities)); err != nil {
+		return fmt.Errorf("failed to add plugin %s: %w", id, err)
+	}
+
+	return nil
+}
+
 func cleanPath(path string) string {
 	return strings.Trim(path, `,;:'"|&*!@#$`)
 }
 
+// Metrics returns a snapshot of the call metrics of all registered plugins by plugin ID.
+func (pm *PluginManager) Metrics() map[string]plugins.Metrics {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	metrics := make(map[string]plugins.Metrics, len(pm.supervisors))
+	for id, supervisor := range pm.supervisors {
+		metrics[id] = supervisor.Metrics()
+	}
+
+	return metrics
+}
+
 // Shutdown is called to terminate all plugins.
 func (pm *PluginManager) Shutdown(ctx context.Context) error {
 	pm.mu.Lock()
 	defer pm.mu.Unlock()
 	var errs error
 
+	for _, supervisor := range pm.supervisors {
+		errs = errors.Join(errs, supervisor.Shutdown(ctx))
+	}
+
+	for _, wasmPlugin := range pm.wasmPlugins {
+		errs = errors.Join(errs, wasmPlugin.Close(ctx))
+	}
+
 	errs = errors.Join(errs,
 		pm.ComponentVersionRepositoryRegistry.Shutdown(ctx),
 		pm.ComponentListerRegistry.Shutdown(ctx),
@@ -184,6 +326,7 @@ func (pm *PluginManager) Shutdown(ctx context.Context) error {
 		pm.ResourcePluginRegistry.Shutdown(ctx),
 		pm.BlobTransformerRegistry.Shutdown(ctx),
 		pm.SigningRegistry.Shutdown(ctx),
+		pm.TransformationRegistry.Shutdown(ctx),
 	)
 
 	return errs
@@ -206,11 +349,11 @@ func (pm *PluginManager) fetchPlugins(ctx context.Context, conf *mtypes.Config,
 
 		// TODO(Skarlso): Determine plugin extension.
 		ext := filepath.Ext(info.Name())
-		if ext != "" {
+		if ext != "" && ext != mtypes.WASMExtension {
 			return nil
 		}
 
-		id := filepath.Base(path)
+		id := strings.TrimSuffix(filepath.Base(path), mtypes.WASMExtension)
 
 		p := &mtypes.Plugin{
 			ID:     id,
@@ -245,9 +388,10 @@ func init() {
 	scheme.MustRegisterScheme(inputv1.Scheme)
 	scheme.MustRegisterScheme(resourcev1.Scheme)
 	scheme.MustRegisterScheme(signinghandlerv1.Scheme)
+	scheme.MustRegisterScheme(transformationv1.Scheme)
 }
 
-func (pm *PluginManager) addPlugin(ctx context.Context, ocmConfig *genericv1.Config, plugin mtypes.Plugin, capabilitiesCommandOutput *bytes.Buffer) error {
+func (pm *PluginManager) addPlugin(ctx context.Context, opts *RegistrationOptions, plugin mtypes.Plugin, capabilitiesCommandOutput *bytes.Buffer) error {
 	// Determine Configuration requirements.
 	rawPluginSpec := spec.PluginSpec{}
 	if err := json.Unmarshal(capabilitiesCommandOutput.Bytes(), &rawPluginSpec); err != nil {
@@ -258,7 +402,7 @@ func (pm *PluginManager) addPlugin(ctx context.Context, ocmConfig *genericv1.Con
 		return fmt.Errorf("failed to convert plugin spec: %w", err)
 	}
 
-	if ocmConfig != nil {
+	if ocmConfig := opts.Config; ocmConfig != nil {
 		filtered, _ := genericv1.Filter(ocmConfig, &genericv1.FilterOptions{ConfigTypes: pluginSpec.SupportedConfigTypes})
 		if len(pluginSpec.SupportedConfigTypes) > 0 && len(filtered.Configurations) == 0 {
 			return fmt.Errorf("no configuration found for plugin %s; requested configuration types: %s", plugin.ID, pluginSpec.SupportedConfigTypes)
@@ -267,30 +411,42 @@ func (pm *PluginManager) addPlugin(ctx context.Context, ocmConfig *genericv1.Con
 		plugin.Config.ConfigTypes = append(plugin.Config.ConfigTypes, filtered.Configurations...)
 	}
 
-	serialized, err := json.Marshal(plugin.Config)
-	if err != nil {
-		return err
+	if _, ok := pm.supervisors[plugin.ID]; ok {
+		return fmt.Errorf("plugin with ID %s already registered", plugin.ID)
 	}
-
-	// Create a command that can then be managed.
-	pluginCmd := exec.CommandContext(ctx, cleanPath(plugin.Path), "--config", string(serialized)) //nolint:gosec // G204 does not apply
-	pluginCmd.Cancel = func() error {
-		slog.InfoContext(ctx, "killing plugin process because the parent context is cancelled", "id", plugin.ID)
-		return pluginCmd.Process.Kill()
+	if _, ok := pm.wasmPlugins[plugin.ID]; ok {
+		return fmt.Errorf("plugin with ID %s already registered", plugin.ID)
 	}
 
-	// Set up communication pipes.
-	plugin.Cmd = pluginCmd
-	sdtErr, err := pluginCmd.StderrPipe()
-	if err != nil {
-		return fmt.Errorf("failed to create stderr pipe: %w", err)
+	// Plugins compiled to WebAssembly come with their own supervisor running them in the sandbox,
+	// so there is no transport to negotiate with them.
+	if _, ok := plugin.Supervisor.(*wasm.Plugin); !ok {
+		if plugin.Config.Transport, err = negotiateTransport(opts.Transports, pluginSpec.SupportedTransports); err != nil {
+			return fmt.Errorf("failed to negotiate transport with plugin %s: %w", plugin.ID, err)
+		}
 	}
-	plugin.Stderr = sdtErr
-	sdtOut, err := pluginCmd.StdoutPipe()
-	if err != nil {
-		return fmt.Errorf("failed to create stdout pipe: %w", err)
+
+	var supervisor *plugins.Supervisor
+	if plugin.Supervisor == nil {
+		// The supervisor creates a command for every process of the plugin, so crashed plugins can be restarted.
+		supervisor = plugins.NewSupervisor(ctx, plugin, opts.Process, func(ctx context.Context, config mtypes.Config) (*exec.Cmd, error) {
+			serialized, err := json.Marshal(config)
+			if err != nil {
+				return nil, err
+			}
+
+			pluginCmd := exec.CommandContext(ctx, cleanPath(plugin.Path), "--config", string(serialized)) //nolint:gosec // G204 does not apply
+			pluginCmd.Cancel = func() error {
+				slog.InfoContext(ctx, "killing plugin process because the parent context is cancelled", "id", config.ID)
+				return pluginCmd.Process.Kill()
+			}
+
+			return pluginCmd, nil
+		})
+		plugin.Supervisor = supervisor
+	} else if c, ok := plugin.Supervisor.(configurable); ok {
+		c.Configure(plugin.Config)
 	}
-	plugin.Stdout = sdtOut
 
 	// TODO(fabianburth): all registries have a common interface now
 	//  we could refactor this to get rid of the switch case statement.
@@ -336,14 +492,38 @@ func (pm *PluginManager) addPlugin(ctx context.Context, ocmConfig *genericv1.Con
 			if err := pm.SigningRegistry.AddPlugin(plugin, capability); err != nil {
 				return fmt.Errorf("failed to register plugin %s: %w", plugin.ID, err)
 			}
+		case *transformationv1.CapabilitySpec:
+			slog.DebugContext(ctx, "adding transformation plugin", "id", plugin.ID)
+			if err := pm.TransformationRegistry.AddPlugin(plugin, capability); err != nil {
+				return fmt.Errorf("failed to register plugin %s: %w", plugin.ID, err)
+			}
 		default:
 			return fmt.Errorf("unknown capability type %T for plugin %s", capability, plugin.ID)
 		}
 	}
 
+	if supervisor != nil {
+		pm.supervisors[plugin.ID] = supervisor
+	}
+
 	return nil
 }
 
+// negotiateTransport selects the first of the preferred transports supported by the plugin.
+// Every plugin supports HTTP, so the plugin only needs to declare additional transports.
+// Without preferences, plugins are called over HTTP.
+func negotiateTransport(preferred, supported []mtypes.Transport) (mtypes.Transport, error) {
+	if len(preferred) == 0 {
+		return mtypes.TransportHTTP, nil
+	}
+	for _, transport := range preferred {
+		if transport == mtypes.TransportHTTP || slices.Contains(supported, transport) {
+			return transport, nil
+		}
+	}
+	return "", fmt.Errorf("plugin supports none of the transports %v, supported transports: %v", preferred, append([]mtypes.Transport{mtypes.TransportHTTP}, supported...))
+}
+
 func determineConnectionType(ctx context.Context) (mtypes.ConnectionType, error) {
 	// if we can't create a temp folder ( for example we are in a scratch container ) we default to TCP
 	tmp, err := os.MkdirTemp("", "")
@@ -7,15 +7,20 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
 	genericv1 "ocm.software/open-component-model/bindings/go/configuration/generic/v1/spec"
+	constructor2 "ocm.software/open-component-model/bindings/go/constructor"
+	constructor "ocm.software/open-component-model/bindings/go/constructor/runtime"
 	"ocm.software/open-component-model/bindings/go/plugin/internal/dummytype"
 	dummyv1 "ocm.software/open-component-model/bindings/go/plugin/internal/dummytype/v1"
 	ocmrepositoryv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1"
@@ -102,7 +107,7 @@ func TestConfigurationPassedToPlugin(t *testing.T) {
 			},
 		},
 	}
-	writer := bytes.NewBuffer(nil)
+	writer := &syncBuffer{}
 	slog.SetDefault(slog.New(slog.NewTextHandler(writer, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
 	})))
@@ -279,7 +284,7 @@ func TestPluginManagerShutdownWithoutWait(t *testing.T) {
 			},
 		},
 	}
-	writer := bytes.NewBuffer(nil)
+	writer := &syncBuffer{}
 	slog.SetDefault(slog.New(slog.NewTextHandler(writer, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
 	})))
@@ -320,11 +325,7 @@ func TestPluginManagerShutdownWithoutWait(t *testing.T) {
 
 	// we need some time for the logs to be streamed back
 	require.Eventually(t, func() bool {
-		content, err := io.ReadAll(writer)
-		if err != nil {
-			return false
-		}
-		return strings.Contains(string(content), "gracefully shutting down plugin")
+		return strings.Contains(writer.String(), "gracefully shutting down plugin")
 	}, 1*time.Second, 100*time.Millisecond)
 }
 
@@ -370,7 +371,7 @@ func TestPluginManagerMultiplePluginsForSameType(t *testing.T) {
 			Version: "v1",
 		},
 	}
-	require.NoError(t, pm.addPlugin(ctx, config, testPlugin, bytes.NewBuffer(serialized)))
+	require.NoError(t, pm.addPlugin(ctx, &RegistrationOptions{Config: config}, testPlugin, bytes.NewBuffer(serialized)))
 	// trying to add the same plugin again for the same type but with different id
 	// this way of testing actually showed a horrible flaw. We were passing around a pointer
 	// which meant if we weren't very careful and overwrote the plugin AFTER we added it,
@@ -379,7 +380,56 @@ func TestPluginManagerMultiplePluginsForSameType(t *testing.T) {
 	testPlugin.Path = "/tmp/test-other-plugin-plugin.socket"
 	testPlugin.Config.ID = "test-other"
 	testPlugin.Config.Type = "tcp"
-	require.ErrorContains(t, pm.addPlugin(ctx, config, testPlugin, bytes.NewBuffer(serialized)), "failed to register plugin test-other: plugin for type OCIRepository/v1 already registered with ID: test-id")
+	require.ErrorContains(t, pm.addPlugin(ctx, &RegistrationOptions{Config: config}, testPlugin, bytes.NewBuffer(serialized)), "failed to register plugin test-other: plugin for type OCIRepository/v1 already registered with ID: test-id")
+}
+
+func TestNegotiateTransport(t *testing.T) {
+	tests := []struct {
+		name      string
+		preferred []types.Transport
+		supported []types.Transport
+		expected  types.Transport
+		err       string
+	}{
+		{
+			name:      "grpc plugin",
+			preferred: DefaultTransports,
+			supported: []types.Transport{types.TransportGRPC},
+			expected:  types.TransportGRPC,
+		},
+		{
+			name:      "http plugin",
+			preferred: DefaultTransports,
+			expected:  types.TransportHTTP,
+		},
+		{
+			name:      "http preferred",
+			preferred: []types.Transport{types.TransportHTTP, types.TransportGRPC},
+			supported: []types.Transport{types.TransportGRPC},
+			expected:  types.TransportHTTP,
+		},
+		{
+			name:      "no preference",
+			supported: []types.Transport{types.TransportGRPC},
+			expected:  types.TransportHTTP,
+		},
+		{
+			name:      "grpc required",
+			preferred: []types.Transport{types.TransportGRPC},
+			err:       "plugin supports none of the transports [grpc]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, err := negotiateTransport(tt.preferred, tt.supported)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, transport)
+		})
+	}
 }
 
 func TestPluginManagerWithNoPlugins(t *testing.T) {
@@ -412,3 +462,96 @@ func TestCredentialTypeRegistryPopulatedFromPlugin(t *testing.T) {
 	scheme := pm.CredentialRepositoryRegistry.GetCredentialTypeScheme()
 	require.True(t, scheme.IsRegistered(runtime.NewVersionedType("DummyToken", "v1")))
 }
+
+func TestWASMPlugin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("wasm-resource"))
+	}))
+	t.Cleanup(server.Close)
+
+	scheme := runtime.NewScheme()
+	dummytype.MustAddToScheme(scheme)
+	typ, err := scheme.TypeForPrototype(&dummyv1.Repository{})
+	require.NoError(t, err)
+	resource := &constructor.Resource{
+		ElementMeta: constructor.ElementMeta{
+			ObjectMeta: constructor.ObjectMeta{
+				Name:    "test-resource",
+				Version: "v0.0.1",
+			},
+		},
+		Type:     "type",
+		Relation: "local",
+		AccessOrInput: constructor.AccessOrInput{
+			Input: &runtime.Raw{
+				Type: typ,
+				Data: []byte(fmt.Sprintf(`{"type":%q,"baseUrl":%q}`, typ, server.URL)),
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		permissions map[string]types.WASMPermissions
+		assert      func(t *testing.T, result *constructor2.ResourceInputMethodResult, err error)
+	}{
+		{
+			name: "allowed host",
+			permissions: map[string]types.WASMPermissions{
+				"test-plugin-wasm": {AllowedHosts: []string{"127.0.0.1"}},
+			},
+			assert: func(t *testing.T, result *constructor2.ResourceInputMethodResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "test-resource", result.ProcessedResource.Name)
+				reader, err := result.ProcessedBlobData.ReadCloser()
+				require.NoError(t, err)
+				defer reader.Close()
+				content, err := io.ReadAll(reader)
+				require.NoError(t, err)
+				require.Equal(t, "wasm-resource", string(content))
+			},
+		},
+		{
+			name: "host not allowed",
+			assert: func(t *testing.T, _ *constructor2.ResourceInputMethodResult, err error) {
+				require.ErrorContains(t, err, "host not allowed: 127.0.0.1")
+			},
+		},
+	}
+	// the compilation cache is shared, so the plugin is only compiled once.
+	cacheDir := t.TempDir()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pm := NewPluginManager(context.Background())
+			require.NoError(t, pm.RegisterPlugins(t.Context(), filepath.Join("..", "tmp", "testdata-wasm"),
+				WithWASMOptions(types.WASMOptions{Permissions: tc.permissions, CompilationCacheDir: cacheDir}),
+			))
+			t.Cleanup(func() {
+				require.NoError(t, pm.Shutdown(context.Background()))
+			})
+
+			plugin, err := pm.InputRegistry.GetResourceInputPlugin(t.Context(), &runtime.Raw{Type: typ})
+			require.NoError(t, err)
+			result, err := plugin.ProcessResource(t.Context(), resource, nil)
+			tc.assert(t, result, err)
+		})
+	}
+}
+
+// syncBuffer is a buffer that can be written by the log streamers of plugins while the test reads it.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
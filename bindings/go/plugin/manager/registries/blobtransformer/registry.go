@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"os/exec"
 	"sync"
 
@@ -68,9 +67,8 @@ func (r *Registry) Shutdown(ctx context.Context) error {
 	defer r.mu.Unlock()
 	var errs error
 	for _, p := range r.constructedPlugins {
-		// The plugins should handle the Interrupt signal for shutdowns.
 		// TODO(Skarlso): Use context to wait for the plugin to actually shut down.
-		if perr := p.cmd.Process.Signal(os.Interrupt); perr != nil && !errors.Is(perr, os.ErrProcessDone) {
+		if perr := plugins.Interrupt(p.cmd); perr != nil {
 			errs = errors.Join(errs, perr)
 		}
 	}
@@ -106,19 +104,11 @@ func (r *Registry) AddPlugin(plugin mtypes.Plugin, spec runtime.Typed) error {
 }
 
 func startAndReturnPlugin(ctx context.Context, r *Registry, plugin *mtypes.Plugin) (blobtransformerv1.BlobTransformerPluginContract[runtime.Typed], error) {
-	if err := plugin.Cmd.Start(); err != nil {
-		return nil, fmt.Errorf("failed to start plugin: %s, %w", plugin.ID, err)
-	}
-
-	client, loc, err := plugins.WaitForPlugin(ctx, plugin)
+	client, loc, err := plugins.StartPlugin(ctx, r.ctx, plugin)
 	if err != nil {
-		return nil, fmt.Errorf("failed to wait for plugin to start: %w", err)
+		return nil, err
 	}
 
-	// start log streaming once the plugin is up and running.
-	// use the baseCtx here from the manager here so the streaming isn't stopped when the request is stopped.
-	go plugins.StartLogStreamer(r.ctx, plugin)
-
 	repoPlugin := NewPlugin(client, plugin.ID, plugin.Path, plugin.Config, loc, r.capabilities[plugin.ID])
 	r.constructedPlugins[plugin.ID] = &constructedPlugin{
 		Plugin: repoPlugin,
@@ -2,9 +2,7 @@ package componentlister
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"os"
 	"os/exec"
 	"sync"
 
@@ -68,26 +66,7 @@ func (r *ComponentListerRegistry) Shutdown(ctx context.Context) error {
 	eg, ctx := errgroup.WithContext(ctx)
 	for _, p := range r.constructedPlugins {
 		eg.Go(func() error {
-			// The plugins should handle the Interrupt signal for shutdowns.
-			if err := p.cmd.Process.Signal(os.Interrupt); err != nil && !errors.Is(err, os.ErrProcessDone) {
-				return fmt.Errorf("failed to send interrupt signal to plugin: %w", errors.Join(err, p.cmd.Process.Kill()))
-			}
-
-			shutdownSig := make(chan error, 1)
-			defer func() {
-				close(shutdownSig)
-			}()
-			go func() {
-				_, err := p.cmd.Process.Wait()
-				shutdownSig <- err
-			}()
-
-			select {
-			case err := <-shutdownSig:
-				return err
-			case <-ctx.Done():
-				return errors.Join(ctx.Err(), p.cmd.Process.Kill())
-			}
+			return plugins.InterruptAndWait(ctx, p.cmd)
 		})
 	}
 
@@ -122,19 +101,11 @@ func (r *ComponentListerRegistry) AddPlugin(plugin types.Plugin, spec runtime.Ty
 }
 
 func startAndReturnPlugin(ctx context.Context, r *ComponentListerRegistry, plugin *types.Plugin) (componentlisterv1.ComponentListerPluginContract[runtime.Typed], error) {
-	if err := plugin.Cmd.Start(); err != nil {
-		return nil, fmt.Errorf("failed to start plugin: %s, %w", plugin.ID, err)
-	}
-
-	client, loc, err := plugins.WaitForPlugin(ctx, plugin)
+	client, loc, err := plugins.StartPlugin(ctx, r.ctx, plugin)
 	if err != nil {
-		return nil, fmt.Errorf("failed to wait for plugin to start: %w", err)
+		return nil, err
 	}
 
-	// start log streaming once the plugin is up and running.
-	// use the baseCtx here from the manager here so the streaming isn't stopped when the request is stopped.
-	go plugins.StartLogStreamer(r.ctx, plugin)
-
 	listerPlugin := NewComponentListerPlugin(client, plugin.ID, plugin.Path, plugin.Config, loc, r.capabilities[plugin.ID])
 	r.constructedPlugins[plugin.ID] = &constructedPlugin{
 		Plugin: listerPlugin,
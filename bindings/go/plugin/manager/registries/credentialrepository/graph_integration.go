@@ -3,6 +3,8 @@ package credentialrepository
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"ocm.software/open-component-model/bindings/go/credentials"
 	"ocm.software/open-component-model/bindings/go/runtime"
@@ -10,12 +12,27 @@ import (
 
 var _ credentials.RepositoryPluginProvider = &RepositoryRegistry{}
 
+// GetRepositoryPlugin returns a plugin that resolves the consumer through all repository types
+// registered for its identity type. Repository configurations of other types are skipped.
 func (r *RepositoryRegistry) GetRepositoryPlugin(ctx context.Context, consumer runtime.Typed) (credentials.RepositoryPlugin, error) {
-	typ, ok := r.consumerTypeRegistrations[consumer.GetType()]
-	if !ok {
+	providerTypes, ok := r.consumerTypeRegistrations[consumer.GetType()]
+	if !ok || len(providerTypes) == 0 {
 		return nil, fmt.Errorf("no plugin registered for consumer identity type %q", consumer.GetType())
 	}
 
+	plugins := make(map[runtime.Type]credentials.RepositoryPlugin, len(providerTypes))
+	for _, typ := range providerTypes {
+		plugin, err := r.getRepositoryPluginForType(ctx, typ)
+		if err != nil {
+			return nil, err
+		}
+		plugins[typ] = plugin
+	}
+
+	return &repositoryPluginDispatcher{scheme: r.scheme, plugins: plugins}, nil
+}
+
+func (r *RepositoryRegistry) getRepositoryPluginForType(ctx context.Context, typ runtime.Type) (credentials.RepositoryPlugin, error) {
 	base, ok := r.internalCredentialRepositoryPlugins[typ]
 	if ok {
 		return base, nil
@@ -37,3 +54,46 @@ func (r *RepositoryRegistry) GetRepositoryPlugin(ctx context.Context, consumer r
 
 	return NewCredentialRepositoryPluginConverter(started), nil
 }
+
+// repositoryPluginDispatcher forwards each repository configuration to the plugin registered for its type.
+type repositoryPluginDispatcher struct {
+	scheme  *runtime.Scheme
+	plugins map[runtime.Type]credentials.RepositoryPlugin
+}
+
+var _ credentials.RepositoryPlugin = (*repositoryPluginDispatcher)(nil)
+
+func (d *repositoryPluginDispatcher) pluginFor(cfg runtime.Typed) (credentials.RepositoryPlugin, bool) {
+	typ, _ := d.scheme.ResolveCanonicalType(cfg.GetType())
+	plugin, ok := d.plugins[typ]
+	return plugin, ok
+}
+
+func (d *repositoryPluginDispatcher) ConsumerIdentityForConfig(ctx context.Context, cfg runtime.Typed) (runtime.Identity, error) {
+	plugin, ok := d.pluginFor(cfg)
+	if !ok {
+		return nil, fmt.Errorf("repository type %q cannot serve this consumer, expected one of %v", cfg.GetType(), d.types())
+	}
+	return plugin.ConsumerIdentityForConfig(ctx, cfg)
+}
+
+// Resolve returns no credentials and no error for repository configurations that cannot serve the consumer,
+// so that other configured repositories can still resolve it.
+func (d *repositoryPluginDispatcher) Resolve(ctx context.Context, cfg runtime.Typed, identity runtime.Identity, credentials runtime.Typed) (runtime.Typed, error) {
+	plugin, ok := d.pluginFor(cfg)
+	if !ok {
+		return nil, nil
+	}
+	return plugin.Resolve(ctx, cfg, identity, credentials)
+}
+
+func (d *repositoryPluginDispatcher) types() []runtime.Type {
+	types := make([]runtime.Type, 0, len(d.plugins))
+	for typ := range d.plugins {
+		types = append(types, typ)
+	}
+	slices.SortFunc(types, func(a, b runtime.Type) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return types
+}
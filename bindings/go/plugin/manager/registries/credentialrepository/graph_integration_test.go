@@ -0,0 +1,100 @@
+package credentialrepository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/credentialrepository"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+type staticRepositoryConfig struct {
+	Type runtime.Type `json:"type"`
+}
+
+func (c *staticRepositoryConfig) GetType() runtime.Type    { return c.Type }
+func (c *staticRepositoryConfig) SetType(typ runtime.Type) { c.Type = typ }
+func (c *staticRepositoryConfig) DeepCopyTyped() runtime.Typed {
+	return &staticRepositoryConfig{Type: c.Type}
+}
+
+// otherRepositoryConfig is a distinct go type, as a scheme maps each go type to a single default type.
+type otherRepositoryConfig struct {
+	staticRepositoryConfig
+}
+
+func (c *otherRepositoryConfig) DeepCopyTyped() runtime.Typed {
+	return &otherRepositoryConfig{staticRepositoryConfig{Type: c.Type}}
+}
+
+// staticRepositoryPlugin serves a single repository type and always resolves to the same credentials.
+type staticRepositoryPlugin struct {
+	prototype runtime.Typed
+	typ       runtime.Type
+	alias     runtime.Type
+}
+
+func (p *staticRepositoryPlugin) GetCredentialRepositoryScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	scheme.MustRegisterWithAlias(p.prototype, p.typ, p.alias)
+	return scheme
+}
+
+func (p *staticRepositoryPlugin) ConsumerIdentityForConfig(_ context.Context, _ runtime.Typed) (runtime.Identity, error) {
+	return runtime.Identity{runtime.IdentityAttributeType: p.typ.String()}, nil
+}
+
+func (p *staticRepositoryPlugin) Resolve(_ context.Context, _ runtime.Typed, _ runtime.Identity, _ runtime.Typed) (runtime.Typed, error) {
+	return &runtime.Raw{Type: p.typ, Data: []byte(`{"type":"` + p.typ.String() + `"}`)}, nil
+}
+
+func TestGetRepositoryPlugin_MultipleRepositoryTypesPerConsumer(t *testing.T) {
+	r := require.New(t)
+	reg := credentialrepository.NewCredentialRepositoryRegistry(t.Context())
+
+	consumerType := runtime.NewVersionedType("Consumer", "v1")
+	onlyA := runtime.NewVersionedType("OnlyA", "v1")
+	typeA := runtime.NewVersionedType("RepoA", "v1")
+	typeB := runtime.NewVersionedType("RepoB", "v1")
+
+	r.NoError(reg.RegisterInternalCredentialRepositoryPlugin(
+		&staticRepositoryPlugin{prototype: &staticRepositoryConfig{}, typ: typeA, alias: runtime.NewUnversionedType("RepoA")},
+		[]runtime.Type{consumerType, onlyA},
+	))
+	r.NoError(reg.RegisterInternalCredentialRepositoryPlugin(
+		&staticRepositoryPlugin{prototype: &otherRepositoryConfig{}, typ: typeB, alias: runtime.NewUnversionedType("RepoB")},
+		[]runtime.Type{consumerType},
+	))
+
+	consumer := runtime.Identity{runtime.IdentityAttributeType: consumerType.String()}
+	plugin, err := reg.GetRepositoryPlugin(t.Context(), consumer)
+	r.NoError(err)
+
+	for _, cfgType := range []runtime.Type{typeA, runtime.NewUnversionedType("RepoA"), typeB} {
+		resolved, err := plugin.Resolve(t.Context(), &runtime.Raw{Type: cfgType}, consumer, nil)
+		r.NoError(err)
+		r.NotNil(resolved, "config of type %s should be resolved", cfgType)
+	}
+	id, err := plugin.ConsumerIdentityForConfig(t.Context(), &runtime.Raw{Type: typeB})
+	r.NoError(err)
+	r.Equal(typeB.String(), id[runtime.IdentityAttributeType])
+
+	t.Run("repository types that do not serve the consumer are skipped", func(t *testing.T) {
+		r := require.New(t)
+		plugin, err := reg.GetRepositoryPlugin(t.Context(), runtime.Identity{runtime.IdentityAttributeType: onlyA.String()})
+		r.NoError(err)
+
+		resolved, err := plugin.Resolve(t.Context(), &runtime.Raw{Type: typeB}, consumer, nil)
+		r.NoError(err)
+		r.Nil(resolved)
+		_, err = plugin.ConsumerIdentityForConfig(t.Context(), &runtime.Raw{Type: typeB})
+		r.ErrorContains(err, "cannot serve this consumer")
+	})
+
+	t.Run("unknown consumer types are rejected", func(t *testing.T) {
+		_, err := reg.GetRepositoryPlugin(t.Context(), runtime.Identity{runtime.IdentityAttributeType: "Unknown/v1"})
+		require.ErrorContains(t, err, "no plugin registered for consumer identity type")
+	})
+}
@@ -5,8 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"os"
 	"os/exec"
+	"slices"
 	"sync"
 
 	"ocm.software/open-component-model/bindings/go/credentials"
@@ -23,7 +23,7 @@ func NewCredentialRepositoryRegistry(ctx context.Context) *RepositoryRegistry {
 		capabilities:                        make(map[string]credentialsv1.CapabilitySpec),
 		registry:                            make(map[runtime.Type]mtypes.Plugin),
 		constructedPlugins:                  make(map[string]*constructedPlugin), // running plugins
-		consumerTypeRegistrations:           make(map[runtime.Type]runtime.Type),
+		consumerTypeRegistrations:           make(map[runtime.Type][]runtime.Type),
 		internalCredentialRepositoryPlugins: make(map[runtime.Type]credentials.RepositoryPlugin),
 		scheme:                              runtime.NewScheme(),
 		credentialTypeScheme:                runtime.NewScheme(),
@@ -39,8 +39,9 @@ type RepositoryRegistry struct {
 	scheme               *runtime.Scheme
 	credentialTypeScheme *runtime.Scheme
 
-	constructedPlugins        map[string]*constructedPlugin // running plugins
-	consumerTypeRegistrations map[runtime.Type]runtime.Type
+	constructedPlugins map[string]*constructedPlugin // running plugins
+	// consumerTypeRegistrations contains all repository provider types that can serve a consumer identity type.
+	consumerTypeRegistrations map[runtime.Type][]runtime.Type
 	// internalCredentialRepositoryPlugins contains all plugins that have been registered using internally import statement.
 	internalCredentialRepositoryPlugins map[runtime.Type]credentials.RepositoryPlugin
 }
@@ -160,6 +161,8 @@ func (r *RepositoryRegistry) GetPlugin(ctx context.Context, spec runtime.Typed)
 
 // RegisterInternalCredentialRepositoryPlugin can be called by actual implementations in the source.
 // It will register any implementations directly for a given type and capability.
+// Multiple repository types can serve the same consumer identity type, in which case
+// each configured repository is resolved by the plugin registered for its type.
 func (r *RepositoryRegistry) RegisterInternalCredentialRepositoryPlugin(
 	plugin BuiltinCredentialRepositoryPlugin,
 	consumerTypes []runtime.Type,
@@ -178,7 +181,9 @@ func (r *RepositoryRegistry) RegisterInternalCredentialRepositoryPlugin(
 		}
 
 		for _, consumerType := range consumerTypes {
-			r.consumerTypeRegistrations[consumerType] = providerType
+			if !slices.Contains(r.consumerTypeRegistrations[consumerType], providerType) {
+				r.consumerTypeRegistrations[consumerType] = append(r.consumerTypeRegistrations[consumerType], providerType)
+			}
 		}
 	}
 
@@ -198,9 +203,8 @@ func (r *RepositoryRegistry) Shutdown(ctx context.Context) error {
 	defer r.mu.Unlock()
 	var errs error
 	for _, p := range r.constructedPlugins {
-		// The plugins should handle the Interrupt signal for shutdowns.
 		// TODO(Skarlso): Use context to wait for the plugin to actually shut down.
-		if perr := p.cmd.Process.Signal(os.Interrupt); perr != nil && !errors.Is(perr, os.ErrProcessDone) {
+		if perr := plugins.Interrupt(p.cmd); perr != nil {
 			errs = errors.Join(errs, perr)
 		}
 	}
@@ -209,19 +213,11 @@ func (r *RepositoryRegistry) Shutdown(ctx context.Context) error {
 }
 
 func startAndReturnPlugin(ctx context.Context, r *RepositoryRegistry, plugin *mtypes.Plugin) (credentialsv1.CredentialRepositoryPluginContract[runtime.Typed], error) {
-	if err := plugin.Cmd.Start(); err != nil {
-		return nil, fmt.Errorf("failed to start plugin: %s, %w", plugin.ID, err)
-	}
-
-	client, loc, err := plugins.WaitForPlugin(ctx, plugin)
+	client, loc, err := plugins.StartPlugin(ctx, r.ctx, plugin)
 	if err != nil {
-		return nil, fmt.Errorf("failed to wait for plugin to start: %w", err)
+		return nil, err
 	}
 
-	// start log streaming once the plugin is up and running.
-	// use the baseCtx here from the manager here so the streaming isn't stopped when the request is stopped.
-	go plugins.StartLogStreamer(r.ctx, plugin)
-
 	repoPlugin := NewCredentialRepositoryPlugin(client, plugin.ID, plugin.Path, plugin.Config, loc, r.capabilities[plugin.ID])
 	r.constructedPlugins[plugin.ID] = &constructedPlugin{
 		Plugin: repoPlugin,
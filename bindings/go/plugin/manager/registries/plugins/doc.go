@@ -11,4 +11,6 @@
 //   - **ValidatePlugin**: Validates an incoming raw type against a given JSON schema.
 //   - **WaitForPlugin**: Waits for a plugin to become ready by making periodic health checks. Once the plugin is ready
 //     it sets up a client which can then be used to interact with said plugin.
+//   - **Supervisor**: Starts the processes of a plugin on demand, restarts crashed or unhealthy processes, runs
+//     several instances per plugin, applies resource limits and records call metrics.
 package plugins
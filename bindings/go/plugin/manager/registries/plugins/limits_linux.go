@@ -0,0 +1,82 @@
+//go:build linux
+
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+// cpuPeriod is the cgroup cpu.max period in microseconds the CPU quota is applied to.
+const cpuPeriod = 100000
+
+// prepareLimits sets up a cgroup for the command if the limits configure a cgroup parent.
+// The returned function removes the cgroup again once the process exited.
+func prepareLimits(cmd *exec.Cmd, id string, limits types.ResourceLimits) (func(), error) {
+	if limits.CgroupParent == "" {
+		if limits.CPUQuota > 0 {
+			return nil, errors.New("a cpu quota requires a cgroup parent")
+		}
+		return func() {}, nil
+	}
+
+	dir := filepath.Join(limits.CgroupParent, "ocm-plugin-"+id)
+	if err := os.Mkdir(dir, 0o755); err != nil && !errors.Is(err, fs.ErrExist) {
+		return nil, fmt.Errorf("failed to create cgroup %s: %w", dir, err)
+	}
+	if limits.MemoryBytes > 0 {
+		if err := os.WriteFile(filepath.Join(dir, "memory.max"), []byte(strconv.FormatInt(limits.MemoryBytes, 10)), 0o644); err != nil {
+			return nil, fmt.Errorf("failed to set memory limit of cgroup %s: %w", dir, err)
+		}
+	}
+	if limits.CPUQuota > 0 {
+		quota := fmt.Sprintf("%d %d", int64(math.Ceil(limits.CPUQuota*cpuPeriod)), cpuPeriod)
+		if err := os.WriteFile(filepath.Join(dir, "cpu.max"), []byte(quota), 0o644); err != nil {
+			return nil, fmt.Errorf("failed to set cpu quota of cgroup %s: %w", dir, err)
+		}
+	}
+
+	fd, err := unix.Open(dir, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cgroup %s: %w", dir, err)
+	}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = fd
+
+	return func() {
+		_ = unix.Close(fd)
+		_ = os.Remove(dir)
+	}, nil
+}
+
+// applyLimits applies the rlimits to the started process.
+// Memory is only limited with an rlimit if it isn't limited by a cgroup.
+func applyLimits(pid int, limits types.ResourceLimits) error {
+	if limits.MemoryBytes > 0 && limits.CgroupParent == "" {
+		limit := &unix.Rlimit{Cur: uint64(limits.MemoryBytes), Max: uint64(limits.MemoryBytes)}
+		if err := unix.Prlimit(pid, unix.RLIMIT_AS, limit, nil); err != nil {
+			return fmt.Errorf("failed to set memory limit: %w", err)
+		}
+	}
+	if limits.CPUTime > 0 {
+		seconds := uint64(math.Ceil(limits.CPUTime.Seconds()))
+		if err := unix.Prlimit(pid, unix.RLIMIT_CPU, &unix.Rlimit{Cur: seconds, Max: seconds}, nil); err != nil {
+			return fmt.Errorf("failed to set cpu time limit: %w", err)
+		}
+	}
+	return nil
+}
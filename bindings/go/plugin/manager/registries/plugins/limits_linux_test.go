@@ -0,0 +1,48 @@
+//go:build linux
+
+package plugins
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+func TestApplyLimits(t *testing.T) {
+	cmd := exec.CommandContext(t.Context(), "sleep", "10")
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	require.NoError(t, applyLimits(cmd.Process.Pid, types.ResourceLimits{
+		MemoryBytes: 512 << 20,
+		CPUTime:     90 * time.Second,
+	}))
+
+	limits, err := os.ReadFile("/proc/" + strconv.Itoa(cmd.Process.Pid) + "/limits")
+	require.NoError(t, err)
+	for _, line := range strings.Split(string(limits), "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case strings.HasPrefix(line, "Max address space"):
+			assert.Equal(t, strconv.Itoa(512<<20), fields[3])
+		case strings.HasPrefix(line, "Max cpu time"):
+			assert.Equal(t, "90", fields[3])
+		}
+	}
+}
+
+func TestPrepareLimitsRequiresCgroupForCPUQuota(t *testing.T) {
+	_, err := prepareLimits(exec.Command("true"), "test", types.ResourceLimits{CPUQuota: 0.5})
+	require.ErrorContains(t, err, "a cpu quota requires a cgroup parent")
+}
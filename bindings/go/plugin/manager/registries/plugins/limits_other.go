@@ -0,0 +1,22 @@
+//go:build !linux
+
+package plugins
+
+import (
+	"errors"
+	"os/exec"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+// prepareLimits rejects resource limits as they are only supported on Linux.
+func prepareLimits(_ *exec.Cmd, _ string, limits types.ResourceLimits) (func(), error) {
+	if !limits.IsZero() {
+		return nil, errors.New("resource limits for plugins are only supported on linux")
+	}
+	return func() {}, nil
+}
+
+func applyLimits(int, types.ResourceLimits) error {
+	return nil
+}
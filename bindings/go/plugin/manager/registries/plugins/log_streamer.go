@@ -4,8 +4,10 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"os"
 	"sort"
 
 	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
@@ -58,7 +60,14 @@ func StartLogStreamer(ctx context.Context, plugin *types.Plugin) {
 	// start streaming log messages to the debug context log
 	for {
 		select {
-		case line := <-lineChan:
+		case line, ok := <-lineChan:
+			if !ok {
+				// the plugin closed its output, e.g. because it exited.
+				if err, ok := <-errChan; ok {
+					logStreamError(ctx, err)
+				}
+				return
+			}
 			parsed, err := parseLine(line)
 			if err != nil {
 				// we don't log this one, otherwise the output gets very crowded during shutdown
@@ -85,7 +94,7 @@ func StartLogStreamer(ctx context.Context, plugin *types.Plugin) {
 			log(ctx, parsed.msg, parsed.args...)
 		case err, ok := <-errChan:
 			if ok {
-				slog.ErrorContext(ctx, "streaming logs from plugin failed", "error", err)
+				logStreamError(ctx, err)
 			}
 		case <-ctx.Done():
 			// context is done, we stop streaming logs
@@ -95,6 +104,15 @@ func StartLogStreamer(ctx context.Context, plugin *types.Plugin) {
 	}
 }
 
+// logStreamError logs errors reading the plugin output. The output is closed once the
+// plugin process is waited for, which is not an error worth reporting.
+func logStreamError(ctx context.Context, err error) {
+	if errors.Is(err, os.ErrClosed) {
+		return
+	}
+	slog.ErrorContext(ctx, "streaming logs from plugin failed", "error", err)
+}
+
 // record represents a single log line
 type record struct {
 	msg   string
@@ -0,0 +1,48 @@
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// Interrupt sends an Interrupt signal to the process of a plugin that was added to a registry with its command.
+// All plugins should handle interrupt signals gracefully. For Go, this is done automatically by the plugin SDK.
+//
+// Plugins registered with the plugin manager are supervised instead. They have no command in the registries,
+// their processes are shut down with their supervisor by the plugin manager, so nothing is done for them here.
+func Interrupt(cmd *exec.Cmd) error {
+	if cmd == nil || cmd.Process == nil {
+		return nil
+	}
+	if err := cmd.Process.Signal(os.Interrupt); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+	return nil
+}
+
+// InterruptAndWait interrupts the process of the plugin like Interrupt and waits for it to exit.
+// The process is killed if the interrupt fails or if it doesn't exit before ctx is done.
+func InterruptAndWait(ctx context.Context, cmd *exec.Cmd) error {
+	if cmd == nil || cmd.Process == nil {
+		return nil
+	}
+	if err := Interrupt(cmd); err != nil {
+		return fmt.Errorf("failed to send interrupt signal to plugin: %w", errors.Join(err, cmd.Process.Kill()))
+	}
+
+	shutdownSig := make(chan error, 1)
+	go func() {
+		_, err := cmd.Process.Wait()
+		shutdownSig <- err
+	}()
+
+	select {
+	case err := <-shutdownSig:
+		return err
+	case <-ctx.Done():
+		return errors.Join(ctx.Err(), cmd.Process.Kill())
+	}
+}
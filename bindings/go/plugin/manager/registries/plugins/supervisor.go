@@ -0,0 +1,620 @@
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"slices"
+	"sync"
+	"time"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/transport"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+const (
+	defaultHealthCheckInterval = 30 * time.Second
+	defaultMaxRestarts         = 5
+	healthCheckTimeout         = 5 * time.Second
+	// crashDetectionTimeout is how long a failed call waits for the plugin process to exit.
+	crashDetectionTimeout = 100 * time.Millisecond
+)
+
+// ErrTooManyRestarts is returned by calls to a plugin whose instance was restarted
+// more often than allowed without a successful call in between.
+var ErrTooManyRestarts = errors.New("plugin exceeded the maximum number of restarts")
+
+// CommandFunc creates the command starting an instance of a plugin with the given configuration.
+type CommandFunc func(ctx context.Context, config types.Config) (*exec.Cmd, error)
+
+// Metrics are the call metrics of a supervised plugin.
+type Metrics struct {
+	// Calls is the number of calls made to the plugin.
+	Calls uint64
+	// Errors is the number of calls that failed or returned a server error.
+	Errors uint64
+	// InFlight is the number of calls currently in progress.
+	InFlight int
+	// Restarts is the number of times an instance of the plugin was restarted.
+	Restarts uint64
+	// Instances is the number of running instances of the plugin.
+	Instances int
+	// Duration is the accumulated duration of all finished calls.
+	Duration time.Duration
+}
+
+// Supervisor starts the processes of a plugin and dispatches calls to them.
+// It implements http.RoundTripper, so the client returned by Start can be used like the one of WaitForPlugin.
+//
+//   - Instances are started lazily. A new instance is only started if all running instances are busy,
+//     otherwise calls go to the instance with the least calls in flight.
+//   - Instances that exited, e.g. because they crashed or shut down after being idle, are restarted
+//     on the next call. A call that couldn't connect to its instance is retried once on a restarted instance.
+//   - Running instances are periodically checked with /healthz. Instances failing the check are stopped
+//     and restarted on the next call.
+type Supervisor struct {
+	plugin  types.Plugin
+	opts    types.ProcessOptions
+	command CommandFunc
+	// ctx is the base context of the manager. It is used for the processes so they outlive single calls.
+	ctx context.Context
+
+	mu         sync.Mutex
+	instances  []*instance
+	client     *http.Client
+	location   string
+	stopHealth context.CancelFunc
+	metrics    Metrics
+	isShutdown bool
+}
+
+type instance struct {
+	id string
+	// restarts is the number of consecutive restarts without a successful call.
+	restarts int
+	inFlight int
+	proc     *process
+	// starting is closed once the process being started for the instance is published.
+	// It is nil while no process is being started.
+	starting chan struct{}
+	// startErr is the error of the last start of a process for the instance.
+	startErr error
+}
+
+type process struct {
+	cmd       *exec.Cmd
+	location  string
+	transport http.RoundTripper
+	// exited is closed once the process exited.
+	exited chan struct{}
+}
+
+var _ types.Supervisor = (*Supervisor)(nil)
+
+// NewSupervisor creates a supervisor for the plugin. The processes are created with command and
+// live until ctx is done or Shutdown is called.
+func NewSupervisor(ctx context.Context, plugin types.Plugin, opts types.ProcessOptions, command CommandFunc) *Supervisor {
+	if opts.Instances <= 0 {
+		opts.Instances = 1
+	}
+	if opts.HealthCheckInterval == 0 {
+		opts.HealthCheckInterval = defaultHealthCheckInterval
+	}
+	if opts.MaxRestarts == 0 {
+		opts.MaxRestarts = defaultMaxRestarts
+	}
+
+	return &Supervisor{
+		plugin:  plugin,
+		opts:    opts,
+		command: command,
+		ctx:     ctx,
+	}
+}
+
+// Start starts the first instance of the plugin if it isn't running yet.
+// It returns a client dispatching calls to the instances of the plugin and the location of the first instance.
+func (s *Supervisor) Start(ctx context.Context) (*http.Client, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isShutdown {
+		return nil, "", fmt.Errorf("plugin %s is shut down", s.plugin.ID)
+	}
+	if s.client != nil {
+		return s.client, s.location, nil
+	}
+
+	if len(s.instances) == 0 {
+		inst := &instance{id: s.instanceID(0)}
+		s.instances = append(s.instances, inst)
+		if err := s.startInstance(ctx, inst, nil); err != nil {
+			s.removeInstance(inst)
+			return nil, "", err
+		}
+	}
+	// a concurrent Start may be starting the first instance.
+	proc, err := s.awaitStart(ctx, s.instances[0])
+	if err != nil {
+		return nil, "", err
+	}
+	if s.client != nil {
+		return s.client, s.location, nil
+	}
+	s.location = proc.location
+	s.client = &http.Client{Transport: s}
+
+	if s.opts.HealthCheckInterval > 0 {
+		healthCtx, cancel := context.WithCancel(s.ctx)
+		s.stopHealth = cancel
+		go s.checkHealthPeriodically(healthCtx, s.opts.HealthCheckInterval)
+	}
+
+	return s.client, s.location, nil
+}
+
+// RoundTrip sends the request to an instance of the plugin, (re)starting instances as needed.
+func (s *Supervisor) RoundTrip(req *http.Request) (*http.Response, error) {
+	inst, proc, err := s.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+
+	resp, err := proc.transport.RoundTrip(req)
+	if err != nil && isDialError(err) && (req.Body == nil || req.GetBody != nil) {
+		// the process is gone without us noticing yet, restart it and retry the call once.
+		slog.DebugContext(req.Context(), "plugin is not reachable, restarting it", "id", inst.id, "error", err)
+		var restarted *process
+		if restarted, err = s.restart(req.Context(), inst, proc); err == nil {
+			proc = restarted
+			if req, err = rewind(req); err == nil {
+				resp, err = proc.transport.RoundTrip(req)
+			}
+		}
+	}
+	if err != nil {
+		if req.Context().Err() == nil {
+			// the connection broke, possibly because the plugin crashed. Give the process the chance to
+			// exit, so the next call restarts it, and don't reuse kept alive connections to it.
+			proc.awaitExit(crashDetectionTimeout)
+			proc.closeIdleConnections()
+		}
+		s.release(inst, start, false)
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{
+		ReadCloser: resp.Body,
+		release: func() {
+			s.release(inst, start, resp.StatusCode < http.StatusInternalServerError)
+		},
+	}
+
+	return resp, nil
+}
+
+// Metrics returns a snapshot of the call metrics of the plugin.
+func (s *Supervisor) Metrics() Metrics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metrics := s.metrics
+	for _, inst := range s.instances {
+		if inst.proc != nil && inst.proc.running() {
+			metrics.Instances++
+		}
+	}
+
+	return metrics
+}
+
+// Shutdown interrupts all instances of the plugin and waits for them to exit.
+// Instances that don't exit before ctx is done are killed.
+func (s *Supervisor) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	s.isShutdown = true
+	if s.stopHealth != nil {
+		s.stopHealth()
+	}
+	procs := make([]*process, 0, len(s.instances))
+	for _, inst := range s.instances {
+		// processes that are still being started are killed once they are published.
+		if inst.proc != nil {
+			procs = append(procs, inst.proc)
+		}
+	}
+	s.mu.Unlock()
+
+	var errs error
+	for _, proc := range procs {
+		// The plugins should handle the Interrupt signal for shutdowns.
+		if err := proc.cmd.Process.Signal(os.Interrupt); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			errs = errors.Join(errs, fmt.Errorf("failed to send interrupt signal to plugin: %w", err))
+		}
+	}
+	for _, proc := range procs {
+		select {
+		case <-proc.exited:
+		case <-ctx.Done():
+			proc.kill()
+		}
+	}
+
+	return errs
+}
+
+// instanceID returns the ID of the instance with the index.
+// Plugins derive their socket location from their ID, so every instance needs a distinct one.
+func (s *Supervisor) instanceID(index int) string {
+	if index == 0 {
+		return s.plugin.ID
+	}
+	return fmt.Sprintf("%s-%d", s.plugin.ID, index)
+}
+
+// nextInstanceID returns the ID of the first instance index that is not in use.
+func (s *Supervisor) nextInstanceID() string {
+	for index := 0; ; index++ {
+		id := s.instanceID(index)
+		if !slices.ContainsFunc(s.instances, func(inst *instance) bool { return inst.id == id }) {
+			return id
+		}
+	}
+}
+
+func (s *Supervisor) removeInstance(inst *instance) {
+	s.instances = slices.DeleteFunc(s.instances, func(other *instance) bool { return other == inst })
+}
+
+// acquire selects the instance for a call and marks the call as in flight.
+// Processes are started without holding the lock, calls selecting an instance whose process is
+// being started wait for it.
+func (s *Supervisor) acquire(ctx context.Context) (*instance, *process, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isShutdown {
+		return nil, nil, fmt.Errorf("plugin %s is shut down", s.plugin.ID)
+	}
+	s.metrics.Calls++
+
+	var selected *instance
+	for _, inst := range s.instances {
+		if selected == nil || inst.inFlight < selected.inFlight {
+			selected = inst
+		}
+	}
+
+	if selected == nil || selected.inFlight > 0 && len(s.instances) < s.opts.Instances {
+		// the slot of the new instance is reserved before its process is started,
+		// so concurrent calls don't start more instances than allowed.
+		inst := &instance{id: s.nextInstanceID()}
+		s.instances = append(s.instances, inst)
+		err := s.startInstance(ctx, inst, nil)
+		switch {
+		case err == nil:
+			selected = inst
+		case selected == nil:
+			s.removeInstance(inst)
+			s.metrics.Errors++
+			return nil, nil, err
+		default:
+			s.removeInstance(inst)
+			slog.WarnContext(ctx, "failed to start additional plugin instance", "id", inst.id, "error", err)
+		}
+	}
+
+	for {
+		proc, err := s.awaitStart(ctx, selected)
+		if err != nil {
+			s.metrics.Errors++
+			return nil, nil, err
+		}
+		if proc.running() {
+			break
+		}
+		slog.InfoContext(ctx, "plugin exited, restarting it", "id", selected.id)
+		if err := s.restartLocked(ctx, selected); err != nil {
+			s.metrics.Errors++
+			return nil, nil, err
+		}
+	}
+
+	selected.inFlight++
+	s.metrics.InFlight++
+
+	return selected, selected.proc, nil
+}
+
+// release marks a call of the instance as finished.
+func (s *Supervisor) release(inst *instance, start time.Time, success bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst.inFlight--
+	s.metrics.InFlight--
+	s.metrics.Duration += time.Since(start)
+	if success {
+		inst.restarts = 0
+	} else {
+		s.metrics.Errors++
+	}
+}
+
+// restart restarts the process of the instance unless another call already replaced it.
+func (s *Supervisor) restart(ctx context.Context, inst *instance, proc *process) (*process, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if inst.proc == proc && inst.starting == nil {
+		if err := s.restartLocked(ctx, inst); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.awaitStart(ctx, inst)
+}
+
+func (s *Supervisor) restartLocked(ctx context.Context, inst *instance) error {
+	if s.opts.MaxRestarts > 0 && inst.restarts >= s.opts.MaxRestarts {
+		return fmt.Errorf("%w: plugin %s was restarted %d times without a successful call", ErrTooManyRestarts, inst.id, inst.restarts)
+	}
+
+	inst.restarts++
+	s.metrics.Restarts++
+
+	return s.startInstance(ctx, inst, inst.proc)
+}
+
+// startInstance replaces the process old of the instance with a new one. It must be called with s.mu held.
+// The lock is released while the process is started, so calls to other instances and Metrics aren't
+// blocked by a plugin that is slow to start.
+func (s *Supervisor) startInstance(ctx context.Context, inst *instance, old *process) error {
+	starting := make(chan struct{})
+	inst.starting = starting
+	s.mu.Unlock()
+
+	if old != nil {
+		old.kill()
+	}
+	proc, err := s.start(ctx, inst.id)
+
+	s.mu.Lock()
+	if err == nil && s.isShutdown {
+		proc.kill()
+		err = fmt.Errorf("plugin %s is shut down", s.plugin.ID)
+	}
+	if err == nil {
+		inst.proc = proc
+	}
+	inst.startErr = err
+	inst.starting = nil
+	close(starting)
+
+	return err
+}
+
+// awaitStart waits until no process is being started for the instance and returns its process.
+// It must be called with s.mu held, the lock is released while waiting.
+func (s *Supervisor) awaitStart(ctx context.Context, inst *instance) (*process, error) {
+	for inst.starting != nil {
+		starting := inst.starting
+		s.mu.Unlock()
+		select {
+		case <-starting:
+		case <-ctx.Done():
+		}
+		s.mu.Lock()
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if inst.startErr != nil {
+			return nil, inst.startErr
+		}
+	}
+
+	return inst.proc, nil
+}
+
+// start starts a new process for the instance with the ID and waits for it to become available.
+func (s *Supervisor) start(ctx context.Context, id string) (*process, error) {
+	config := s.plugin.Config
+	config.ID = id
+
+	cmd, err := s.command(s.ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create command for plugin %s: %w", id, err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stdout pipe: %w", err)
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stderr pipe: %w", err)
+	}
+
+	cleanup, err := prepareLimits(cmd, id, s.opts.Limits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare resource limits for plugin %s: %w", id, err)
+	}
+	if err := cmd.Start(); err != nil {
+		cleanup()
+		return nil, fmt.Errorf("failed to start plugin: %s, %w", id, err)
+	}
+
+	procCtx, cancel := context.WithCancel(s.ctx)
+	proc := &process{cmd: cmd, exited: make(chan struct{})}
+	go func() {
+		err := cmd.Wait()
+		slog.DebugContext(procCtx, "plugin exited", "id", id, "error", err)
+		cancel()
+		cleanup()
+		close(proc.exited)
+	}()
+
+	if err := applyLimits(cmd.Process.Pid, s.opts.Limits); err != nil {
+		proc.kill()
+		return nil, fmt.Errorf("failed to apply resource limits to plugin %s: %w", id, err)
+	}
+
+	plugin := &types.Plugin{
+		ID:     id,
+		Path:   s.plugin.Path,
+		Config: config,
+		Cmd:    cmd,
+		Stdout: stdout,
+		Stderr: stderr,
+	}
+	client, location, err := WaitForPlugin(ctx, plugin)
+	if err != nil {
+		proc.kill()
+		return nil, fmt.Errorf("failed to wait for plugin to start: %w", err)
+	}
+
+	// start log streaming once the plugin is up and running.
+	// the streaming is bound to the process, so it isn't stopped when the request is stopped.
+	go StartLogStreamer(procCtx, plugin)
+
+	proc.location = location
+	proc.transport = client.Transport
+
+	if closer, ok := proc.transport.(io.Closer); ok {
+		// transports keeping a connection to the process, like the gRPC transport, are closed once it exited.
+		go func() {
+			<-proc.exited
+			_ = closer.Close()
+		}()
+	}
+
+	return proc, nil
+}
+
+func (s *Supervisor) checkHealthPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkHealth(ctx)
+		}
+	}
+}
+
+// checkHealth stops all running instances that fail their health check.
+// They are restarted on the next call.
+func (s *Supervisor) checkHealth(ctx context.Context) {
+	s.mu.Lock()
+	procs := make(map[string]*process, len(s.instances))
+	for _, inst := range s.instances {
+		if inst.starting == nil && inst.proc != nil && inst.proc.running() {
+			procs[inst.id] = inst.proc
+		}
+	}
+	s.mu.Unlock()
+
+	for id, proc := range procs {
+		if err := proc.healthy(ctx, s.plugin.Config.Type); err != nil {
+			slog.WarnContext(ctx, "plugin failed health check, stopping it", "id", id, "error", err)
+			proc.kill()
+		}
+	}
+}
+
+func (p *process) running() bool {
+	select {
+	case <-p.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+// kill kills the process if it is still running and waits for it to exit.
+func (p *process) kill() {
+	if p.running() {
+		_ = p.cmd.Process.Kill()
+	}
+	<-p.exited
+}
+
+// awaitExit waits until the process exited or the timeout passed.
+func (p *process) awaitExit(timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-p.exited:
+	case <-timer.C:
+	}
+}
+
+func (p *process) closeIdleConnections() {
+	if t, ok := p.transport.(interface{ CloseIdleConnections() }); ok {
+		t.CloseIdleConnections()
+	}
+}
+
+func (p *process) healthy(ctx context.Context, typ types.ConnectionType) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	base := "http://unix"
+	if typ == types.TCP {
+		base = p.location
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base+"/healthz", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.transport.RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// releasingBody releases the call once the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial" || errors.Is(err, transport.ErrUnavailable)
+}
+
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("failed to rewind request body: %w", err)
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
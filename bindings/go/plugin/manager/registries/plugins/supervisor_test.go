@@ -0,0 +1,255 @@
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+// TestHelperPlugin is not a real test. It is started as plugin process by the supervisor tests.
+// It serves /healthz, /pid returning its process ID, /block blocking until /unblock is called and
+// /crash exiting the process.
+func TestHelperPlugin(t *testing.T) {
+	if os.Getenv("OCM_HELPER_PLUGIN") != "1" {
+		t.Skip("helper process for the supervisor tests")
+	}
+
+	var lc net.ListenConfig
+	listener, err := lc.Listen(context.Background(), "tcp", "127.0.0.1:0")
+	if err != nil {
+		os.Exit(2)
+	}
+
+	unblock := make(chan struct{})
+	var once sync.Once
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		if os.Getenv("OCM_HELPER_PLUGIN_UNHEALTHY") == "1" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	mux.HandleFunc("/pid", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, strconv.Itoa(os.Getpid()))
+	})
+	mux.HandleFunc("/block", func(w http.ResponseWriter, _ *http.Request) {
+		<-unblock
+		_, _ = io.WriteString(w, strconv.Itoa(os.Getpid()))
+	})
+	mux.HandleFunc("/unblock", func(_ http.ResponseWriter, _ *http.Request) {
+		once.Do(func() { close(unblock) })
+	})
+	mux.HandleFunc("/crash", func(_ http.ResponseWriter, _ *http.Request) {
+		os.Exit(1)
+	})
+
+	fmt.Println("http://" + listener.Addr().String())
+	_ = http.Serve(listener, mux) //nolint:gosec // test helper
+	os.Exit(0)
+}
+
+func helperCommand(env ...string) CommandFunc {
+	return func(ctx context.Context, config types.Config) (*exec.Cmd, error) {
+		serialized, err := json.Marshal(config)
+		if err != nil {
+			return nil, err
+		}
+		cmd := exec.CommandContext(ctx, os.Args[0], "-test.run=^TestHelperPlugin$", "--", "--config", string(serialized))
+		cmd.Env = append(os.Environ(), append(env, "OCM_HELPER_PLUGIN=1")...)
+		return cmd, nil
+	}
+}
+
+func newTestSupervisor(t *testing.T, opts types.ProcessOptions, command CommandFunc) (*Supervisor, *http.Client, string) {
+	t.Helper()
+	plugin := types.Plugin{
+		ID:     "test-supervised-plugin",
+		Config: types.Config{Type: types.TCP},
+	}
+	s := NewSupervisor(t.Context(), plugin, opts, command)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.NoError(t, s.Shutdown(ctx))
+	})
+
+	client, location, err := s.Start(t.Context())
+	require.NoError(t, err)
+
+	return s, client, location
+}
+
+func get(t *testing.T, client *http.Client, url string) (string, error) {
+	t.Helper()
+	return call(t, client, http.MethodGet, url)
+}
+
+// crash uses a POST request, so the http transport doesn't retry it once the plugin crashed.
+func crash(t *testing.T, client *http.Client, location string) error {
+	t.Helper()
+	_, err := call(t, client, http.MethodPost, location+"/crash")
+	return err
+}
+
+func call(t *testing.T, client *http.Client, method, url string) (string, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), method, url, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
+
+func TestSupervisorRestartsCrashedPlugin(t *testing.T) {
+	s, client, location := newTestSupervisor(t, types.ProcessOptions{HealthCheckInterval: -1}, helperCommand())
+
+	pid, err := get(t, client, location+"/pid")
+	require.NoError(t, err)
+
+	require.Error(t, crash(t, client, location))
+
+	restartedPID, err := get(t, client, location+"/pid")
+	require.NoError(t, err)
+	assert.NotEqual(t, pid, restartedPID)
+
+	metrics := s.Metrics()
+	assert.Equal(t, uint64(3), metrics.Calls)
+	assert.Equal(t, uint64(1), metrics.Errors)
+	assert.Equal(t, uint64(1), metrics.Restarts)
+	assert.Equal(t, 1, metrics.Instances)
+	assert.Equal(t, 0, metrics.InFlight)
+}
+
+func TestSupervisorStartsInstancesForBusyPlugin(t *testing.T) {
+	s, client, location := newTestSupervisor(t, types.ProcessOptions{Instances: 2, HealthCheckInterval: -1}, helperCommand())
+
+	blocked := make(chan string, 1)
+	go func() {
+		pid, _ := get(t, client, location+"/block")
+		blocked <- pid
+	}()
+	require.Eventually(t, func() bool {
+		return s.Metrics().InFlight == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the first instance is busy, so the call is dispatched to a second instance.
+	pid, err := get(t, client, location+"/pid")
+	require.NoError(t, err)
+	assert.Equal(t, 2, s.Metrics().Instances)
+
+	// unblock the first instance, the second one never blocked.
+	s.mu.Lock()
+	first := s.instances[0].proc
+	s.mu.Unlock()
+	_, err = (&http.Client{Transport: first.transport}).Get(first.location + "/unblock")
+	require.NoError(t, err)
+	assert.NotEqual(t, pid, <-blocked)
+}
+
+func TestSupervisorStopsUnhealthyPlugin(t *testing.T) {
+	s, _, _ := newTestSupervisor(t, types.ProcessOptions{HealthCheckInterval: 50 * time.Millisecond}, helperCommand("OCM_HELPER_PLUGIN_UNHEALTHY=1"))
+
+	require.Eventually(t, func() bool {
+		return s.Metrics().Instances == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSupervisorMaxRestarts(t *testing.T) {
+	starts := 0
+	command := func(ctx context.Context, config types.Config) (*exec.Cmd, error) {
+		starts++
+		if starts == 1 {
+			return helperCommand()(ctx, config)
+		}
+		// every restart exits before announcing its location.
+		return exec.CommandContext(ctx, "false"), nil
+	}
+	_, client, location := newTestSupervisor(t, types.ProcessOptions{HealthCheckInterval: -1, MaxRestarts: 2}, command)
+
+	require.Error(t, crash(t, client, location))
+
+	for range 2 {
+		_, err := get(t, client, location+"/pid")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrTooManyRestarts)
+	}
+	_, err := get(t, client, location+"/pid")
+	assert.ErrorIs(t, err, ErrTooManyRestarts)
+}
+
+func TestSupervisorStartsInstancesWithoutBlocking(t *testing.T) {
+	hold := make(chan struct{})
+	var starts atomic.Int32
+	command := func(ctx context.Context, config types.Config) (*exec.Cmd, error) {
+		if starts.Add(1) > 1 {
+			// additional instances are slow to start.
+			<-hold
+		}
+		return helperCommand()(ctx, config)
+	}
+	s, client, location := newTestSupervisor(t, types.ProcessOptions{Instances: 2, HealthCheckInterval: -1}, command)
+	t.Cleanup(func() {
+		select {
+		case <-hold:
+		default:
+			close(hold)
+		}
+	})
+
+	blocked := make(chan error, 1)
+	go func() {
+		_, err := get(t, client, location+"/block")
+		blocked <- err
+	}()
+	require.Eventually(t, func() bool {
+		return s.Metrics().InFlight == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the call starts a second instance, which must not hold up the supervisor in the meantime.
+	second := make(chan error, 1)
+	go func() {
+		_, err := get(t, client, location+"/pid")
+		second <- err
+	}()
+	require.Eventually(t, func() bool {
+		return starts.Load() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	metrics := make(chan Metrics, 1)
+	go func() { metrics <- s.Metrics() }()
+	select {
+	case m := <-metrics:
+		assert.Equal(t, 1, m.Instances)
+	case <-time.After(time.Second):
+		t.Fatal("metrics are blocked by a starting instance")
+	}
+
+	close(hold)
+	require.NoError(t, <-second)
+	assert.Equal(t, 2, s.Metrics().Instances)
+
+	s.mu.Lock()
+	first := s.instances[0].proc
+	s.mu.Unlock()
+	_, err := (&http.Client{Transport: first.transport}).Get(first.location + "/unblock")
+	require.NoError(t, err)
+	require.NoError(t, <-blocked)
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"ocm.software/open-component-model/bindings/go/plugin/manager/transport"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
 )
 
@@ -36,7 +37,7 @@ func WaitForPlugin(ctx context.Context, plugin *types.Plugin) (*http.Client, str
 
 	slog.DebugContext(ctx, "got plugin location", "location", location)
 
-	client, err := connect(ctx, plugin.ID, location, plugin.Config.Type)
+	client, err := connect(ctx, plugin.ID, location, plugin.Config.Type, plugin.Config.Transport)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to connect to plugin %s: %w", plugin.ID, err)
 	}
@@ -66,8 +67,10 @@ func WaitForPlugin(ctx context.Context, plugin *types.Plugin) (*http.Client, str
 		case <-timer.C:
 			// tick the loop and repeat the main loop body every set interval
 		case <-time.After(timeout):
+			closeTransport(client)
 			return nil, "", fmt.Errorf("timed out waiting for plugin %s", plugin.ID)
 		case <-ctx.Done():
+			closeTransport(client)
 			return nil, "", fmt.Errorf("context was cancelled %s", plugin.ID)
 		}
 	}
@@ -119,7 +122,11 @@ func getPluginLocation(ctx context.Context, plugin *types.Plugin) (string, error
 
 		if err := scanner.Err(); err != nil {
 			errChan <- fmt.Errorf("error reading server output: %w", err)
+			return
 		}
+
+		// the output was closed, e.g. because the plugin exited, so there is no location to wait for.
+		errChan <- errors.New("plugin output closed before the plugin reported its location")
 	}()
 
 	// Wait for either the location, an error, or timeout
@@ -135,8 +142,9 @@ func getPluginLocation(ctx context.Context, plugin *types.Plugin) (string, error
 
 // connect will create a client that sets up connection based on the plugin's connection type.
 // That is either a Unix socket or a TCP based connection. It does this by setting the `DialContext` using
-// the right network location.
-func connect(_ context.Context, id, location string, typ types.ConnectionType) (*http.Client, error) {
+// the right network location. If the gRPC transport was negotiated with the plugin, calls are sent
+// as gRPC calls of the RPCs of the contracts instead.
+func connect(_ context.Context, id, location string, typ types.ConnectionType, tp types.Transport) (*http.Client, error) {
 	var network string
 	switch typ {
 	case types.Socket:
@@ -148,6 +156,18 @@ func connect(_ context.Context, id, location string, typ types.ConnectionType) (
 		return nil, fmt.Errorf("invalid connection type: %s", typ)
 	}
 
+	switch tp {
+	case "", types.TransportHTTP:
+	case types.TransportGRPC:
+		grpcClient, err := transport.NewClient(network, location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to plugin %s: %w", id, err)
+		}
+		return &http.Client{Transport: grpcClient}, nil
+	default:
+		return nil, fmt.Errorf("invalid transport: %s", tp)
+	}
+
 	dialer := net.Dialer{
 		Timeout: 30 * time.Second,
 	}
@@ -170,3 +190,37 @@ func connect(_ context.Context, id, location string, typ types.ConnectionType) (
 
 	return client, nil
 }
+
+// closeTransport closes the connection of transports keeping one, like the gRPC transport.
+func closeTransport(client *http.Client) {
+	if closer, ok := client.Transport.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
+
+// StartPlugin starts the plugin and returns the HTTP client and location to call it.
+// Supervised plugins are started by their supervisor. Otherwise, the plugin command is started,
+// and logs are streamed with baseCtx, so the streaming isn't stopped when the request is stopped.
+func StartPlugin(ctx, baseCtx context.Context, plugin *types.Plugin) (*http.Client, string, error) {
+	if plugin.Supervisor != nil {
+		client, loc, err := plugin.Supervisor.Start(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to start plugin %s: %w", plugin.ID, err)
+		}
+		return client, loc, nil
+	}
+
+	if err := plugin.Cmd.Start(); err != nil {
+		return nil, "", fmt.Errorf("failed to start plugin: %s, %w", plugin.ID, err)
+	}
+
+	client, loc, err := WaitForPlugin(ctx, plugin)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to wait for plugin to start: %w", err)
+	}
+
+	// start log streaming once the plugin is up and running.
+	go StartLogStreamer(baseCtx, plugin)
+
+	return client, loc, nil
+}
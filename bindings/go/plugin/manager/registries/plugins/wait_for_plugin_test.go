@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	plugintransport "ocm.software/open-component-model/bindings/go/plugin/manager/transport"
 	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
 )
 
@@ -140,7 +141,7 @@ func TestWaitForPlugin(t *testing.T) {
 func TestConnect(t *testing.T) {
 	t.Run("socket connection", func(t *testing.T) {
 		ctx := context.Background()
-		client, err := connect(ctx, "test-socket", "/path/to/socket", types.Socket)
+		client, err := connect(ctx, "test-socket", "/path/to/socket", types.Socket, types.TransportHTTP)
 
 		require.NoError(t, err)
 		require.NotNil(t, client)
@@ -155,7 +156,7 @@ func TestConnect(t *testing.T) {
 
 	t.Run("TCP connection", func(t *testing.T) {
 		ctx := context.Background()
-		client, err := connect(ctx, "test-tcp", "localhost:8080", types.TCP)
+		client, err := connect(ctx, "test-tcp", "localhost:8080", types.TCP, types.TransportHTTP)
 
 		require.NoError(t, err)
 		require.NotNil(t, client)
@@ -171,13 +172,13 @@ func TestConnect(t *testing.T) {
 	t.Run("connection attempt with socket type", func(t *testing.T) {
 		// Don't expect this to succeed, but verify it attempts to connect with unix network
 		ctx := context.Background()
-		client, err := connect(ctx, "test-socket", "/non/existent/socket", types.Socket)
+		client, err := connect(ctx, "test-socket", "/non/existent/socket", types.Socket, types.TransportHTTP)
 
 		require.NoError(t, err)
 		require.NotNil(t, client)
 
 		// Create a test request
-		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://unix/test", nil)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://unix/healthz", nil)
 		require.NoError(t, err)
 
 		// This should fail because the socket doesn't exist, but we're testing the connection attempt
@@ -190,7 +191,7 @@ func TestConnect(t *testing.T) {
 	t.Run("connection attempt with TCP type", func(t *testing.T) {
 		// Don't expect this to succeed, but verify it attempts to connect with tcp network
 		ctx := context.Background()
-		client, err := connect(ctx, "test-tcp", "localhost:12345", types.TCP)
+		client, err := connect(ctx, "test-tcp", "localhost:12345", types.TCP, types.TransportHTTP)
 
 		require.NoError(t, err)
 		require.NotNil(t, client)
@@ -204,4 +205,24 @@ func TestConnect(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "localhost:12345")
 	})
+
+	t.Run("connection attempt with grpc transport", func(t *testing.T) {
+		ctx := context.Background()
+		client, err := connect(ctx, "test-grpc", "/non/existent/socket", types.Socket, types.TransportGRPC)
+
+		require.NoError(t, err)
+		require.IsType(t, &plugintransport.Client{}, client.Transport)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://unix/healthz", nil)
+		require.NoError(t, err)
+
+		// The call never reaches a plugin, so it is reported as unavailable and can be retried.
+		_, err = client.Do(req)
+		assert.ErrorIs(t, err, plugintransport.ErrUnavailable)
+	})
+
+	t.Run("unknown transport", func(t *testing.T) {
+		_, err := connect(context.Background(), "test-socket", "/path/to/socket", types.Socket, "carrier-pigeon")
+		assert.ErrorContains(t, err, "invalid transport")
+	})
 }
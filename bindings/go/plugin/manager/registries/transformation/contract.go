@@ -0,0 +1,16 @@
+package transformation
+
+import (
+	"context"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// Transformer executes transformations of a transformation graph that are provided by plugins.
+type Transformer interface {
+	// Transform executes the given transformation and returns it with its output set.
+	Transform(ctx context.Context, transformation runtime.Typed, credentials runtime.Typed) (runtime.Typed, error)
+	// GetTransformationCredentialConsumerIdentity retrieves an identity for the given transformation that
+	// can be used to lookup credentials for the transformation.
+	GetTransformationCredentialConsumerIdentity(ctx context.Context, transformation runtime.Typed) (runtime.Identity, error)
+}
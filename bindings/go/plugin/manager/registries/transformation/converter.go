@@ -0,0 +1,47 @@
+package transformation
+
+import (
+	"context"
+	"fmt"
+
+	transformationv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+type converter struct {
+	externalPlugin transformationv1.TransformationPluginContract[runtime.Typed]
+}
+
+func (c *converter) Transform(ctx context.Context, transformation runtime.Typed, credentials runtime.Typed) (runtime.Typed, error) {
+	request := &transformationv1.TransformRequest[runtime.Typed]{
+		Transformation: transformation,
+	}
+
+	response, err := c.externalPlugin.Transform(ctx, request, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform: %w", err)
+	}
+
+	return response.Transformation, nil
+}
+
+func (c *converter) GetTransformationCredentialConsumerIdentity(ctx context.Context, transformation runtime.Typed) (runtime.Identity, error) {
+	request := &transformationv1.GetIdentityRequest[runtime.Typed]{
+		Typ: transformation,
+	}
+
+	result, err := c.externalPlugin.GetIdentity(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity: %w", err)
+	}
+
+	return result.Identity, nil
+}
+
+var _ Transformer = (*converter)(nil)
+
+func (r *Registry) externalToTransformerConverter(plugin transformationv1.TransformationPluginContract[runtime.Typed]) *converter {
+	return &converter{
+		externalPlugin: plugin,
+	}
+}
@@ -0,0 +1,59 @@
+package transformation
+
+import (
+	"fmt"
+
+	transformationv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// RegisterTransformation takes a builder and a handler and based on the handler's contract type
+// will construct a list of endpoint handlers that they will need. Once completed, MarshalJSON can be
+// used to construct the supported endpoint list to give back to the plugin manager.
+//
+// The prototype is the complete transformation with its spec and output. If it provides its own JSON schema
+// through runtime.JSONSchemaIntrospectable, that schema is announced, otherwise it is generated from the go type.
+// The graph builder uses the schema to type check expressions referring to the transformation.
+func RegisterTransformation[T runtime.Typed](
+	proto T,
+	handler transformationv1.TransformationPluginContract[T],
+	c *endpoints.EndpointBuilder,
+) error {
+	typ, err := c.Scheme.TypeForPrototype(proto)
+	if err != nil {
+		return fmt.Errorf("failed to get type for prototype %T: %w", proto, err)
+	}
+
+	c.Handlers = append(c.Handlers,
+		endpoints.Handler{
+			Handler:  TransformHandlerFunc(handler.Transform),
+			Location: Transform,
+		},
+		endpoints.Handler{
+			Handler:  GetIdentityHandlerFunc(handler.GetIdentity),
+			Location: Identity,
+		},
+	)
+
+	var schema []byte
+	if introspectable, ok := any(proto).(runtime.JSONSchemaIntrospectable); ok {
+		schema = introspectable.JSONSchema()
+	} else if schema, err = plugins.GenerateJSONSchemaForType(proto); err != nil {
+		return fmt.Errorf("failed to generate jsonschema for prototype %T: %w", proto, err)
+	}
+
+	c.PluginSpec.CapabilitySpecs = append(c.PluginSpec.CapabilitySpecs, &transformationv1.CapabilitySpec{
+		Type: runtime.NewUnversionedType(string(transformationv1.TransformationPluginType)),
+		SupportedTransformationTypes: []types.Type{
+			{
+				Type:       typ,
+				JSONSchema: schema,
+			},
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package transformation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/plugin/internal/dummytype"
+	dummyv1 "ocm.software/open-component-model/bindings/go/plugin/internal/dummytype/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/contracts"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/endpoints"
+	pluginruntime "ocm.software/open-component-model/bindings/go/plugin/manager/types/runtime"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+type mockPlugin struct {
+	contracts.EmptyBasePlugin
+}
+
+func (m *mockPlugin) Transform(_ context.Context, request *v1.TransformRequest[*dummyv1.Repository], _ runtime.Typed) (*v1.TransformResponse[*dummyv1.Repository], error) {
+	return &v1.TransformResponse[*dummyv1.Repository]{Transformation: request.Transformation}, nil
+}
+
+func (m *mockPlugin) GetIdentity(_ context.Context, _ *v1.GetIdentityRequest[*dummyv1.Repository]) (*v1.GetIdentityResponse, error) {
+	return &v1.GetIdentityResponse{}, nil
+}
+
+var _ v1.TransformationPluginContract[*dummyv1.Repository] = &mockPlugin{}
+
+func TestRegisterTransformation(t *testing.T) {
+	r := require.New(t)
+
+	scheme := runtime.NewScheme()
+	dummytype.MustAddToScheme(scheme)
+	builder := endpoints.NewEndpoints(scheme)
+	proto := &dummyv1.Repository{}
+	plugin := &mockPlugin{}
+	r.NoError(RegisterTransformation(proto, plugin, builder))
+	rawPluginSpec, err := pluginruntime.ConvertToSpec(&builder.PluginSpec)
+	r.NoError(err)
+	content, err := json.Marshal(rawPluginSpec)
+	r.NoError(err)
+	r.Contains(string(content), "transformation")
+
+	handlers := builder.GetHandlers()
+	r.Len(handlers, 2)
+	r.Equal(Transform, handlers[0].Location)
+	r.Equal(Identity, handlers[1].Location)
+
+	capabilityList := builder.PluginSpec.CapabilitySpecs
+	r.Len(capabilityList, 1)
+	capability := v1.CapabilitySpec{}
+	r.NoError(v1.Scheme.Convert(capabilityList[0], &capability))
+	typeInfo := capability.SupportedTransformationTypes[0]
+	r.Equal("DummyRepository/v1", typeInfo.Type.String())
+	r.JSONEq(string(proto.JSONSchema()), string(typeInfo.JSONSchema), "the schema of the type is announced as is")
+}
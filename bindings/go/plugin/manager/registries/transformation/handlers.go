@@ -0,0 +1,65 @@
+package transformation
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// TransformHandlerFunc is a wrapper around calling the interface method Transform for the plugin.
+// This is a convenience wrapper containing header and query parameter parsing logic that is not important to know for
+// the plugin implementor.
+func TransformHandlerFunc[T runtime.Typed](f func(ctx context.Context, request *v1.TransformRequest[T], credentials runtime.Typed) (*v1.TransformResponse[T], error)) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		credentials, ok := plugins.CredentialsFromHeader(writer, request.Header)
+		if !ok {
+			return
+		}
+
+		body, err := plugins.DecodeJSONRequestBody[v1.TransformRequest[T]](writer, request)
+		if err != nil {
+			slog.Error("failed to decode request body", "error", err)
+			plugins.NewError(err, http.StatusBadRequest).Write(writer)
+			return
+		}
+		response, err := f(request.Context(), body, credentials)
+		if err != nil {
+			plugins.NewError(err, http.StatusInternalServerError).Write(writer)
+			return
+		}
+
+		if err := json.NewEncoder(writer).Encode(response); err != nil {
+			plugins.NewError(err, http.StatusInternalServerError).Write(writer)
+			return
+		}
+	}
+}
+
+// GetIdentityHandlerFunc creates an HTTP handler for retrieving identity information.
+// Unlike other capabilities, the identity of a transformation usually depends on its spec,
+// so the transformation is decoded from the request and passed on.
+func GetIdentityHandlerFunc[T runtime.Typed](f func(ctx context.Context, typ *v1.GetIdentityRequest[T]) (*v1.GetIdentityResponse, error)) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		body, err := plugins.DecodeJSONRequestBody[v1.GetIdentityRequest[T]](writer, request)
+		if err != nil {
+			slog.Error("failed to decode request body", "error", err)
+			plugins.NewError(err, http.StatusBadRequest).Write(writer)
+			return
+		}
+		response, err := f(request.Context(), body)
+		if err != nil {
+			plugins.NewError(err, http.StatusInternalServerError).Write(writer)
+			return
+		}
+
+		if err := json.NewEncoder(writer).Encode(response); err != nil {
+			plugins.NewError(err, http.StatusInternalServerError).Write(writer)
+			return
+		}
+	}
+}
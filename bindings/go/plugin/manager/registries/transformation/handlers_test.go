@@ -0,0 +1,91 @@
+package transformation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dummyv1 "ocm.software/open-component-model/bindings/go/plugin/internal/dummytype/v1"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func TestTransformHandlerFunc(t *testing.T) {
+	handler := TransformHandlerFunc(func(ctx context.Context, request *v1.TransformRequest[*dummyv1.Repository], credentials runtime.Typed) (*v1.TransformResponse[*dummyv1.Repository], error) {
+		transformed := request.Transformation.DeepCopy()
+		transformed.BaseUrl += "-transformed"
+		if credentials != nil {
+			transformed.BaseUrl += "-authenticated"
+		}
+		return &v1.TransformResponse[*dummyv1.Repository]{Transformation: transformed}, nil
+	})
+
+	tests := []struct {
+		name          string
+		authorization string
+		status        int
+		baseURL       string
+	}{
+		{
+			name:    "without credentials",
+			status:  http.StatusOK,
+			baseURL: "ocm.software-transformed",
+		},
+		{
+			name:          "with credentials",
+			authorization: `{"type":"Credentials/v1","username":"test"}`,
+			status:        http.StatusOK,
+			baseURL:       "ocm.software-transformed-authenticated",
+		},
+		{
+			name:          "malformed credentials",
+			authorization: "not-json",
+			status:        http.StatusUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(&v1.TransformRequest[*dummyv1.Repository]{
+				Transformation: &dummyv1.Repository{Type: dummyType, BaseUrl: "ocm.software"},
+			})
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, Transform, bytes.NewReader(body))
+			if tc.authorization != "" {
+				request.Header.Set("Authorization", tc.authorization)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			require.Equal(t, tc.status, recorder.Code)
+			if tc.status != http.StatusOK {
+				return
+			}
+			response := &v1.TransformResponse[*dummyv1.Repository]{}
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(response))
+			require.Equal(t, tc.baseURL, response.Transformation.BaseUrl)
+		})
+	}
+}
+
+func TestGetIdentityHandlerFunc(t *testing.T) {
+	handler := GetIdentityHandlerFunc(func(ctx context.Context, request *v1.GetIdentityRequest[*dummyv1.Repository]) (*v1.GetIdentityResponse, error) {
+		return &v1.GetIdentityResponse{Identity: map[string]string{"baseUrl": request.Typ.BaseUrl}}, nil
+	})
+
+	body, err := json.Marshal(&v1.GetIdentityRequest[*dummyv1.Repository]{
+		Typ: &dummyv1.Repository{Type: dummyType, BaseUrl: "ocm.software"},
+	})
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, Identity, bytes.NewReader(body)))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	response := &v1.GetIdentityResponse{}
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(response))
+	require.Equal(t, map[string]string{"baseUrl": "ocm.software"}, response.Identity, "the identity depends on the decoded transformation")
+}
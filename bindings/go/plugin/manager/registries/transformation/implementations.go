@@ -0,0 +1,140 @@
+package transformation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	transformationv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// Endpoints
+const (
+	// Transform defines the endpoint to execute a transformation.
+	Transform = "/transformation/transform"
+	// Identity defines the endpoint to retrieve credential consumer identity.
+	Identity = "/identity"
+)
+
+// TransformationPlugin implements the TransformationPluginContract for external plugin communication.
+// It handles REST-based communication with external transformation plugins, including request validation,
+// credential management, and data format conversion.
+type TransformationPlugin struct {
+	ID string
+
+	// config is used to start the plugin during a later phase.
+	config types.Config
+	path   string
+	client *http.Client
+
+	capability transformationv1.CapabilitySpec
+	// location is where the plugin started listening.
+	location string
+}
+
+// This plugin implements all the given contracts.
+var (
+	_ transformationv1.TransformationPluginContract[runtime.Typed] = &TransformationPlugin{}
+)
+
+// NewPlugin creates a new plugin instance with the provided configuration.
+// It initializes the plugin with an HTTP client, unique ID, path, configuration, location, and capability.
+func NewPlugin(client *http.Client, id string, path string, config types.Config, loc string, capability transformationv1.CapabilitySpec) *TransformationPlugin {
+	return &TransformationPlugin{
+		ID:         id,
+		path:       path,
+		config:     config,
+		client:     client,
+		capability: capability,
+		location:   loc,
+	}
+}
+
+func (r *TransformationPlugin) Transform(ctx context.Context, request *transformationv1.TransformRequest[runtime.Typed], credentials runtime.Typed) (*transformationv1.TransformResponse[runtime.Typed], error) {
+	credHeader, err := toCredentials(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.validateEndpoint(request.Transformation); err != nil {
+		return nil, err
+	}
+
+	// the transformation type is not known to the caller, so the response is decoded as raw transformation.
+	response := &transformationv1.TransformResponse[*runtime.Raw]{}
+	if err := plugins.Call(ctx, r.client, r.config.Type, r.location, Transform, http.MethodPost, plugins.WithPayload(request), plugins.WithResult(response), plugins.WithHeader(credHeader)); err != nil {
+		return nil, fmt.Errorf("failed to transform via %s: %w", r.ID, err)
+	}
+	if response.Transformation == nil {
+		return nil, fmt.Errorf("plugin %s returned no transformation", r.ID)
+	}
+
+	return &transformationv1.TransformResponse[runtime.Typed]{Transformation: response.Transformation}, nil
+}
+
+func (r *TransformationPlugin) Ping(ctx context.Context) error {
+	slog.InfoContext(ctx, "Pinging plugin", "id", r.ID)
+
+	if err := plugins.Call(ctx, r.client, r.config.Type, r.location, "healthz", http.MethodGet); err != nil {
+		return fmt.Errorf("failed to ping plugin %s: %w", r.ID, err)
+	}
+
+	return nil
+}
+
+func (r *TransformationPlugin) GetIdentity(ctx context.Context, request *transformationv1.GetIdentityRequest[runtime.Typed]) (*transformationv1.GetIdentityResponse, error) {
+	if err := r.validateEndpoint(request.Typ); err != nil {
+		return nil, fmt.Errorf("failed to validate type %q: %w", r.ID, err)
+	}
+
+	identity := transformationv1.GetIdentityResponse{}
+	if err := plugins.Call(ctx, r.client, r.config.Type, r.location, Identity, http.MethodPost, plugins.WithPayload(request), plugins.WithResult(&identity)); err != nil {
+		return nil, fmt.Errorf("failed to get identity from plugin %q: %w", r.ID, err)
+	}
+
+	return &identity, nil
+}
+
+// validateEndpoint uses the JSON schema the plugin announced for the type of the transformation
+// and validates that the transformation conforms to it.
+func (r *TransformationPlugin) validateEndpoint(obj runtime.Typed) error {
+	var schema []byte
+	for _, t := range r.capability.SupportedTransformationTypes {
+		if t.Type != obj.GetType() {
+			continue
+		}
+		schema = t.JSONSchema
+	}
+	if schema == nil {
+		return fmt.Errorf("plugin %q does not support transformation type %q", r.ID, obj.GetType())
+	}
+
+	valid, err := plugins.ValidatePlugin(obj, schema)
+	if err != nil {
+		return fmt.Errorf("failed to validate plugin %q: %w", r.ID, err)
+	}
+	if !valid {
+		return fmt.Errorf("validation of plugin %q failed for transformation", r.ID)
+	}
+
+	return nil
+}
+
+func toCredentials(credentials runtime.Typed) (plugins.KV, error) {
+	if credentials == nil {
+		return plugins.KV{}, nil
+	}
+	rawCreds, err := json.Marshal(credentials)
+	if err != nil {
+		return plugins.KV{}, err
+	}
+	return plugins.KV{
+		Key:   "Authorization",
+		Value: string(rawCreds),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package transformation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dummyv1 "ocm.software/open-component-model/bindings/go/plugin/internal/dummytype/v1"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func newTestPlugin(client *http.Client, location string) *TransformationPlugin {
+	return NewPlugin(client, "test-plugin", location, types.Config{
+		ID:         "test-plugin",
+		Type:       types.TCP,
+		PluginType: v1.TransformationPluginType,
+	}, location, dummyCapability((&dummyv1.Repository{}).JSONSchema()))
+}
+
+func TestPing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" && r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	plugin := newTestPlugin(server.Client(), server.URL)
+
+	err := plugin.Ping(context.Background())
+	assert.NoError(t, err)
+
+	server.Close()
+	err = plugin.Ping(context.Background())
+	assert.Error(t, err)
+}
+
+func TestTransform(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == Transform && r.Method == http.MethodPost {
+			_ = json.NewEncoder(w).Encode(&v1.TransformResponse[*dummyv1.Repository]{
+				Transformation: &dummyv1.Repository{Type: dummyType, BaseUrl: "transformed"},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	plugin := newTestPlugin(server.Client(), server.URL)
+
+	req := &v1.TransformRequest[runtime.Typed]{
+		Transformation: &dummyv1.Repository{
+			Type:    dummyType,
+			BaseUrl: "ocm.software",
+		},
+	}
+	resp, err := plugin.Transform(context.Background(), req, &runtime.Raw{Type: dummyType, Data: []byte(`{}`)})
+	require.NoError(t, err)
+
+	transformed := &dummyv1.Repository{}
+	require.NoError(t, runtime.NewScheme(runtime.WithAllowUnknown()).Convert(resp.Transformation, transformed))
+	require.Equal(t, "transformed", transformed.BaseUrl)
+}
+
+func TestTransformValidationFail(t *testing.T) {
+	plugin := newTestPlugin(http.DefaultClient, "")
+
+	req := &v1.TransformRequest[runtime.Typed]{
+		Transformation: &runtime.Raw{
+			Type: dummyType,
+			Data: []byte(`{"type":"DummyRepository/v1"}`),
+		}, // missing required fields
+	}
+	_, err := plugin.Transform(context.Background(), req, nil)
+	assert.ErrorContains(t, err, "validation")
+}
+
+func TestTransformUnsupportedType(t *testing.T) {
+	plugin := newTestPlugin(http.DefaultClient, "")
+
+	req := &v1.TransformRequest[runtime.Typed]{
+		Transformation: &runtime.Raw{
+			Type: runtime.NewVersionedType("Unknown", "v1"),
+			Data: []byte(`{"type":"Unknown/v1"}`),
+		},
+	}
+	_, err := plugin.Transform(context.Background(), req, nil)
+	assert.ErrorContains(t, err, "does not support transformation type \"Unknown/v1\"")
+}
+
+func TestGetIdentity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == Identity && r.Method == http.MethodPost {
+			_ = json.NewEncoder(w).Encode(&v1.GetIdentityResponse{Identity: map[string]string{"id": "mock"}})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	plugin := newTestPlugin(server.Client(), server.URL)
+
+	req := &v1.GetIdentityRequest[runtime.Typed]{
+		Typ: &dummyv1.Repository{
+			Type:    dummyType,
+			BaseUrl: "ocm.software",
+		},
+	}
+	resp, err := plugin.GetIdentity(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"id": "mock"}, resp.Identity)
+}
@@ -0,0 +1,145 @@
+package transformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sync"
+
+	transformationv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+	mtypes "ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+type constructedPlugin struct {
+	Plugin transformationv1.TransformationPluginContract[runtime.Typed]
+
+	cmd *exec.Cmd
+}
+
+// Registry holds all plugins that execute transformations of a transformation graph.
+// Transformations that are compiled in are registered with the graph builder directly,
+// so unlike other registries this one only knows external plugins.
+type Registry struct {
+	ctx                context.Context
+	mu                 sync.Mutex
+	capabilities       map[string]transformationv1.CapabilitySpec
+	registry           map[runtime.Type]mtypes.Plugin
+	schemas            map[runtime.Type][]byte
+	constructedPlugins map[string]*constructedPlugin // running plugins
+}
+
+// NewTransformationRegistry creates a new registry and initializes maps.
+func NewTransformationRegistry(ctx context.Context) *Registry {
+	return &Registry{
+		ctx:                ctx,
+		capabilities:       make(map[string]transformationv1.CapabilitySpec),
+		registry:           make(map[runtime.Type]mtypes.Plugin),
+		schemas:            make(map[runtime.Type][]byte),
+		constructedPlugins: make(map[string]*constructedPlugin),
+	}
+}
+
+// AddPlugin takes a plugin discovered by the manager and adds it to the stored plugin registry.
+// This function will return an error if the given transformation type already has a registered plugin.
+func (r *Registry) AddPlugin(plugin mtypes.Plugin, spec runtime.Typed) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	capability := transformationv1.CapabilitySpec{}
+	if err := transformationv1.Scheme.Convert(spec, &capability); err != nil {
+		return fmt.Errorf("failed to convert object: %w", err)
+	}
+	if _, ok := r.capabilities[plugin.ID]; ok {
+		return fmt.Errorf("plugin with ID %s already registered", plugin.ID)
+	}
+	r.capabilities[plugin.ID] = capability
+
+	for _, typ := range capability.SupportedTransformationTypes {
+		for _, t := range append([]runtime.Type{typ.Type}, typ.Aliases...) {
+			if v, ok := r.registry[t]; ok {
+				return fmt.Errorf("plugin for type %v already registered with ID: %s", t, v.ID)
+			}
+			r.registry[t] = plugin
+			r.schemas[t] = typ.JSONSchema
+		}
+	}
+
+	return nil
+}
+
+// GetJSONSchema returns the JSON schema of a transformation type as announced by its plugin.
+// It does not start the plugin.
+func (r *Registry) GetJSONSchema(typ runtime.Type) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	schema, ok := r.schemas[typ]
+	if !ok {
+		return nil, fmt.Errorf("failed to get plugin for typ %q", typ)
+	}
+	return schema, nil
+}
+
+// GetPlugin retrieves the plugin for the given transformation type and starts it if it is not running yet.
+func (r *Registry) GetPlugin(ctx context.Context, typ runtime.Type) (Transformer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if typ.IsEmpty() {
+		return nil, fmt.Errorf("transformation type is empty")
+	}
+
+	plugin, err := r.getPlugin(ctx, typ)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plugin: %w", err)
+	}
+
+	return r.externalToTransformerConverter(plugin), nil
+}
+
+func (r *Registry) getPlugin(ctx context.Context, typ runtime.Type) (transformationv1.TransformationPluginContract[runtime.Typed], error) {
+	plugin, ok := r.registry[typ]
+	if !ok {
+		return nil, fmt.Errorf("failed to get plugin for typ %q", typ)
+	}
+
+	if existingPlugin, ok := r.constructedPlugins[plugin.ID]; ok {
+		return existingPlugin.Plugin, nil
+	}
+
+	return startAndReturnPlugin(ctx, r, &plugin)
+}
+
+func startAndReturnPlugin(ctx context.Context, r *Registry, plugin *mtypes.Plugin) (transformationv1.TransformationPluginContract[runtime.Typed], error) {
+	client, loc, err := plugins.StartPlugin(ctx, r.ctx, plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	transformationPlugin := NewPlugin(client, plugin.ID, plugin.Path, plugin.Config, loc, r.capabilities[plugin.ID])
+	r.constructedPlugins[plugin.ID] = &constructedPlugin{
+		Plugin: transformationPlugin,
+		cmd:    plugin.Cmd,
+	}
+
+	return transformationPlugin, nil
+}
+
+// Shutdown will loop through all _STARTED_ plugins and will send an Interrupt signal to them.
+// All plugins should handle interrupt signals gracefully. For Go, this is done automatically by
+// the plugin SDK.
+func (r *Registry) Shutdown(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var errs error
+	for _, p := range r.constructedPlugins {
+		if perr := plugins.Interrupt(p.cmd); perr != nil {
+			errs = errors.Join(errs, perr)
+		}
+	}
+
+	return errs
+}
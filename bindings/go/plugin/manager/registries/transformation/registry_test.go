@@ -0,0 +1,147 @@
+package transformation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dummyv1 "ocm.software/open-component-model/bindings/go/plugin/internal/dummytype/v1"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	mtypes "ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+var (
+	dummyType = runtime.NewVersionedType(dummyv1.Type, dummyv1.Version)
+)
+
+func dummyCapability(schema []byte) v1.CapabilitySpec {
+	return v1.CapabilitySpec{
+		Type: runtime.NewUnversionedType(string(v1.TransformationPluginType)),
+		SupportedTransformationTypes: []mtypes.Type{{
+			Type:       dummyType,
+			Aliases:    []runtime.Type{runtime.NewVersionedType(dummyv1.ShortType, dummyv1.Version)},
+			JSONSchema: schema,
+		}},
+	}
+}
+
+func TestPluginFlow(t *testing.T) {
+	path := filepath.Join("..", "..", "..", "tmp", "testdata", "test-plugin-transformation")
+	_, err := os.Stat(path)
+	require.NoError(t, err, "test plugin not found, please build the plugin under tmp/testdata/test-plugin-transformation first")
+	slog.SetLogLoggerLevel(slog.LevelDebug)
+
+	ctx := t.Context()
+
+	id := "test-plugin-transformation" + time.Now().Format(time.RFC3339)
+
+	registry := NewTransformationRegistry(ctx)
+	config := mtypes.Config{
+		ID:         id,
+		Type:       mtypes.Socket,
+		PluginType: v1.TransformationPluginType,
+	}
+	serialized, err := json.Marshal(config)
+	require.NoError(t, err)
+
+	pluginCmd := exec.CommandContext(ctx, path, "--config", string(serialized))
+	t.Cleanup(func() {
+		assert.NoError(t, pluginCmd.Process.Kill())
+		err := os.Remove(fmt.Sprintf("/tmp/%s-plugin.socket", id))
+		assert.True(t, err == nil || errors.Is(err, os.ErrNotExist))
+	})
+	pipe, err := pluginCmd.StdoutPipe()
+	require.NoError(t, err)
+	stderr, err := pluginCmd.StderrPipe()
+	require.NoError(t, err)
+	plugin := mtypes.Plugin{
+		ID:     "test-plugin-transformation",
+		Path:   path,
+		Config: config,
+		Cmd:    pluginCmd,
+		Stdout: pipe,
+		Stderr: stderr,
+	}
+	capability := dummyCapability((&dummyv1.Repository{}).JSONSchema())
+	require.NoError(t, registry.AddPlugin(plugin, &capability))
+	retrievedPlugin, err := registry.GetPlugin(ctx, dummyType)
+	require.NoError(t, err)
+
+	transformation := &dummyv1.Repository{
+		Type:    dummyType,
+		BaseUrl: "test-base-url",
+	}
+	identity, err := retrievedPlugin.GetTransformationCredentialConsumerIdentity(ctx, transformation)
+	require.NoError(t, err)
+	require.Equal(t, runtime.Identity{"baseUrl": "test-base-url"}, identity)
+
+	transformed, err := retrievedPlugin.Transform(ctx, transformation, nil)
+	require.NoError(t, err)
+	raw, err := json.Marshal(transformed)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"DummyRepository/v1","baseUrl":"test-base-url-transformed"}`, string(raw))
+}
+
+func TestPluginNotFound(t *testing.T) {
+	ctx := context.Background()
+	registry := NewTransformationRegistry(ctx)
+	_, err := registry.GetPlugin(ctx, dummyType)
+	require.ErrorContains(t, err, "failed to get plugin for typ \"DummyRepository/v1\"")
+	_, err = registry.GetJSONSchema(dummyType)
+	require.ErrorContains(t, err, "failed to get plugin for typ \"DummyRepository/v1\"")
+}
+
+func TestGetJSONSchema(t *testing.T) {
+	registry := NewTransformationRegistry(context.Background())
+	capability := dummyCapability([]byte(`{"type":"object"}`))
+	require.NoError(t, registry.AddPlugin(mtypes.Plugin{ID: "test-plugin"}, &capability))
+
+	for _, typ := range []runtime.Type{dummyType, runtime.NewVersionedType(dummyv1.ShortType, dummyv1.Version)} {
+		schema, err := registry.GetJSONSchema(typ)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"type":"object"}`, string(schema))
+	}
+}
+
+func TestAddPluginDuplicate(t *testing.T) {
+	registry := NewTransformationRegistry(context.Background())
+
+	plugin := mtypes.Plugin{
+		ID:   "test-plugin-duplicate",
+		Path: "/path/to/plugin",
+		Config: mtypes.Config{
+			ID:         "test-plugin-duplicate",
+			Type:       mtypes.Socket,
+			PluginType: v1.TransformationPluginType,
+		},
+	}
+	capability := dummyCapability([]byte(`{}`))
+
+	require.NoError(t, registry.AddPlugin(plugin, &capability))
+
+	err := registry.AddPlugin(plugin, &capability)
+	require.ErrorContains(t, err, "plugin with ID test-plugin-duplicate already registered")
+
+	plugin.ID = "test-plugin-other"
+	err = registry.AddPlugin(plugin, &capability)
+	require.ErrorContains(t, err, "plugin for type DummyRepository/v1 already registered with ID: test-plugin-duplicate")
+}
+
+func TestGetPluginWithEmptyType(t *testing.T) {
+	ctx := context.Background()
+	registry := NewTransformationRegistry(ctx)
+
+	_, err := registry.GetPlugin(ctx, runtime.Type{})
+	require.Error(t, err)
+}
@@ -0,0 +1,269 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+var (
+	// ErrUnavailable is returned by calls if no stream could be opened to the plugin, e.g. because it isn't running.
+	// Such calls never reached the plugin, so they can be retried.
+	ErrUnavailable = errors.New("plugin is unavailable")
+
+	// ErrUnsupportedEndpoint is returned by calls of endpoints that are not part of a plugin contract.
+	ErrUnsupportedEndpoint = errors.New("endpoint is not supported by the grpc transport")
+)
+
+// Client calls a plugin over the gRPC transport. It implements http.RoundTripper, so it can be used as the
+// transport of the HTTP client passed to the plugin contracts.
+type Client struct {
+	conn *grpc.ClientConn
+}
+
+var (
+	_ http.RoundTripper = (*Client)(nil)
+	_ io.Closer         = (*Client)(nil)
+)
+
+// NewClient creates a client for the plugin listening on the address of the network, i.e. the path of a unix socket
+// or the host and port of a tcp listener. The connection is established on the first call.
+func NewClient(network, address string) (*Client, error) {
+	dialer := net.Dialer{
+		Timeout: 30 * time.Second,
+	}
+
+	// the target is only used as authority, the dialer always connects to the plugin.
+	conn, err := grpc.NewClient("passthrough:///plugin",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, network, address)
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc client: %w", err)
+	}
+
+	return &Client{conn: conn}, nil
+}
+
+// RoundTrip performs the call of the request with the RPC of its endpoint. The JSON request is converted into the
+// request message of the RPC and the response message back into the JSON response of the endpoint.
+//
+// The content of a local file in the location of the request is streamed to the plugin. The content streamed from
+// the plugin for the location of the response is written to a temporary file, which is returned as local file
+// location and is owned by the caller.
+func (c *Client) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	r, err := lookup(req.Method, req.URL.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := r.requestMessage(req, body)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := req.Context()
+	var out protoreflect.Message
+	var trailer metadata.MD
+	if r.method.IsStreamingClient() || r.method.IsStreamingServer() {
+		out, trailer, err = c.stream(ctx, r, in)
+	} else {
+		out = r.output.New()
+		err = c.conn.Invoke(ctx, r.FullMethod(), in.Interface(), out.Interface(), grpc.Trailer(&trailer))
+	}
+	if err != nil {
+		return errorResponse(ctx, req, err, trailer)
+	}
+
+	data := []byte("null")
+	if r.responseField != nil {
+		if value, ok, err := fieldToJSON(out, r.responseField); err != nil {
+			return nil, fmt.Errorf("failed to encode response of %s: %w", r.Path, err)
+		} else if ok {
+			data = value
+		}
+	}
+	return response(req, http.StatusOK, data), nil
+}
+
+// Close closes the connection to the plugin.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// requestMessage converts the request of the endpoint into the request message of the RPC.
+func (r *rpc) requestMessage(req *http.Request, body []byte) (protoreflect.Message, error) {
+	in := r.input.New()
+	fields := in.Descriptor().Fields()
+
+	query := req.URL.Query()
+	for _, name := range r.Query {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		fd := fields.ByJSONName(name)
+		if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+			in.Set(fd, protoreflect.ValueOfString(value))
+			continue
+		}
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode query parameter %s: %w", name, err)
+		}
+		if err := fieldFromJSON(in, fd, data); err != nil {
+			return nil, fmt.Errorf("failed to decode query parameter %s: %w", name, err)
+		}
+	}
+
+	if r.requestField != nil {
+		if err := fieldFromJSON(in, r.requestField, body); err != nil {
+			return nil, fmt.Errorf("failed to decode request of %s: %w", r.Path, err)
+		}
+	}
+
+	if r.credentials != nil {
+		if credentials := req.Header.Get("Authorization"); credentials != "" {
+			if err := messageFromJSON(in.Mutable(r.credentials).Message(), []byte(credentials)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return in, nil
+}
+
+// stream performs the call of a streaming RPC. A local file of the request location is streamed to the plugin
+// and a blob streamed from the plugin is written to a temporary file.
+func (c *Client) stream(ctx context.Context, r *rpc, in protoreflect.Message) (_ protoreflect.Message, _ metadata.MD, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.conn.NewStream(ctx, &grpc.StreamDesc{
+		StreamName:    r.RPC,
+		ClientStreams: r.method.IsStreamingClient(),
+		ServerStreams: r.method.IsStreamingServer(),
+	}, r.FullMethod())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var blob string
+	if loc, ok := locationOf(in, r.requestField, r.requestBlobAt); ok && loc.typ() == types.LocationTypeLocalFile {
+		blob = loc.value()
+		loc.set(locationTypeInline, "")
+	}
+	// if sending fails, the stream is broken and the error is returned by RecvMsg.
+	if err := stream.SendMsg(in.Interface()); err == nil && blob != "" {
+		if err := sendFile(blob, r.input, r.inputBlob, stream.SendMsg); err != nil && !errors.Is(err, io.EOF) {
+			return nil, nil, err
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, nil, err
+	}
+
+	out := r.output.New()
+	if err := stream.RecvMsg(out.Interface()); err != nil {
+		return nil, stream.Trailer(), err
+	}
+
+	if !r.method.IsStreamingServer() {
+		return out, nil, nil
+	}
+	loc, ok := locationOf(out, r.responseField, r.responseBlobAt)
+	if !ok || loc.typ() != locationTypeInline {
+		if err := receiveFile(nil, r.output, r.outputBlob, stream.RecvMsg); err != nil {
+			return nil, stream.Trailer(), err
+		}
+		return out, nil, nil
+	}
+
+	file, err := os.CreateTemp("", "ocm-plugin-blob-*")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create blob file: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, file.Close())
+		if err != nil {
+			_ = os.Remove(file.Name())
+		}
+	}()
+	if err := receiveFile(file, r.output, r.outputBlob, stream.RecvMsg); err != nil {
+		return nil, stream.Trailer(), err
+	}
+	loc.set(types.LocationTypeLocalFile, file.Name())
+
+	return out, nil, nil
+}
+
+// errorResponse converts the error of a call into the response of the endpoint. Errors returned by the endpoint
+// carry its status code in the trailer, all other errors fail the call.
+func errorResponse(ctx context.Context, req *http.Request, err error, trailer metadata.MD) (*http.Response, error) {
+	if cause := context.Cause(ctx); cause != nil {
+		return nil, cause
+	}
+
+	st := status.Convert(err)
+	if values := trailer.Get(statusCodeTrailer); len(values) > 0 {
+		if code, err := strconv.Atoi(values[0]); err == nil {
+			return response(req, code, []byte(st.Message())), nil
+		}
+	}
+	if st.Code() == codes.Unavailable {
+		return nil, fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+	return nil, fmt.Errorf("plugin call failed: %w", err)
+}
+
+func response(req *http.Request, code int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// readBody reads and closes the body of the request.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer func() {
+		_ = req.Body.Close()
+	}()
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	return body, nil
+}
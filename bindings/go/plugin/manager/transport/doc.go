@@ -0,0 +1,22 @@
+// Package transport implements the gRPC transport of plugins.
+//
+// The protobuf definitions in transport/v1 are generated from the Go types of the plugin contracts by
+// internal/protogen: every endpoint of a contract, listed in routes.Routes, is an RPC of the service of its contract,
+// and every Go type of a request or response is a message with the JSON names of the Go type. Go values without
+// protobuf representation, e.g. runtime.Typed, are carried as JSON. Run task generate/proto after changing the
+// contracts.
+//
+//   - **Client**: An http.RoundTripper used by the plugin manager. It converts the JSON calls of the contracts into
+//     calls of their RPCs, so the registries work unchanged with both transports.
+//   - **RegisterServer**: Serves the RPCs with the http.Handler of a plugin, so the same handlers serve both
+//     transports.
+//
+// Blobs are streamed inline: the content of a local file in the location of a request is streamed to the plugin in
+// blob chunks and written to a temporary file of the plugin, and the content of a local file in the location of a
+// response is streamed to the manager and written to a temporary file owned by the caller. Other locations, e.g.
+// remote URLs, are passed unchanged. Cancelling the context of a request cancels the call in the plugin.
+//
+// The transport is negotiated during the capabilities handshake: plugins declare it with
+// endpoints.EndpointBuilder.AddSupportedTransports and the manager passes the selected transport as
+// types.Config.Transport. Plugins that don't declare it keep being called with JSON over HTTP.
+package transport
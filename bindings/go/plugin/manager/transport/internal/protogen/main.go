@@ -0,0 +1,561 @@
+// Command protogen generates the protobuf definitions of the gRPC transport from the Go types of the plugin contracts.
+//
+// Every route of the transport becomes an RPC of the service of its contract in v1/plugin.proto. The Go types of the
+// requests and responses become messages in a file per Go package, e.g. v1/contracts/ocmrepository/v1/types.proto for
+// the types of the ocmrepository contract, with JSON names matching the JSON encoding of the Go types. Go types
+// without a protobuf representation, e.g. runtime.Typed or types with custom JSON encoding, are carried as JSON.
+//
+// Run it from the transport directory:
+//
+//	go run ./internal/protogen
+package main
+
+import (
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/transport/routes"
+)
+
+const (
+	// modulePrefix is the common prefix of the Go packages of the contract types.
+	modulePrefix = "ocm.software/open-component-model/bindings/go/"
+	// typesPackage is the Go package of the types of the plugin manager. Its file also defines the JSON message.
+	typesPackage = modulePrefix + "plugin/manager/types"
+	// goPackagePrefix is the Go package of the generated code.
+	goPackagePrefix = modulePrefix + "plugin/manager/transport/v1"
+	// jsonMessage is the message carrying values without protobuf representation as JSON.
+	jsonMessage = "." + routes.Package + ".types.JSON"
+)
+
+func main() {
+	out := flag.String("out", "v1", "directory to write the protobuf definitions to")
+	flag.Parse()
+
+	files, err := generate()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to generate protobuf definitions: %v\n", err)
+		os.Exit(1)
+	}
+	for name, content := range files {
+		path := filepath.Join(*out, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to create directory: %v\n", err)
+			os.Exit(1)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write %s: %v\n", path, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// generate returns the protobuf definitions by their path relative to the v1 directory.
+func generate() (map[string]string, error) {
+	g := &generator{files: map[string]*file{}}
+	g.file(typesPackage)
+	plugin, err := g.plugin()
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]string{"plugin.proto": plugin}
+	for _, f := range g.files {
+		out[f.path] = f.render()
+	}
+	return out, nil
+}
+
+var (
+	jsonMarshaler   = reflect.TypeFor[json.Marshaler]()
+	jsonUnmarshaler = reflect.TypeFor[json.Unmarshaler]()
+	textMarshaler   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshaler = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// generator collects the messages of the Go types by the file of their Go package.
+type generator struct {
+	files map[string]*file
+}
+
+// file is the protobuf definition of the types of a Go package.
+type file struct {
+	goPackage string
+	path      string
+	pkg       string
+	imports   map[string]bool
+	messages  []*message
+}
+
+// message is the protobuf message of a Go struct.
+type message struct {
+	name   string
+	goType reflect.Type
+	fields []field
+}
+
+type field struct {
+	label    string
+	typ      string
+	name     string
+	jsonName string
+}
+
+// plugin renders plugin.proto with the services of all routes.
+func (g *generator) plugin() (string, error) {
+	imports := map[string]bool{}
+	var services []string
+	rpcs := map[string][]string{}
+	var messages []string
+
+	for _, route := range routes.Routes {
+		if !slices.Contains(services, route.Service) {
+			services = append(services, route.Service)
+		}
+
+		_, clientStreaming := route.RequestLocation()
+		_, serverStreaming := route.ResponseLocation()
+		var rpc strings.Builder
+		fmt.Fprintf(&rpc, "  // %s serves %s %s.\n", route.RPC, route.Method, route.Path)
+		if clientStreaming {
+			rpc.WriteString("  // The content of a local file in the request location is streamed to the plugin as blob chunks.\n")
+		}
+		if serverStreaming {
+			rpc.WriteString("  // The content of a local file in the response location is streamed from the plugin as blob chunks.\n")
+		}
+		fmt.Fprintf(&rpc, "  rpc %s(%s%sRequest) returns (%s%sResponse);\n",
+			route.RPC, streamPrefix(clientStreaming), route.RPC, streamPrefix(serverStreaming), route.RPC)
+		rpcs[route.Service] = append(rpcs[route.Service], rpc.String())
+
+		request, err := g.requestMessage(route, clientStreaming, imports)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate request of %s.%s: %w", route.Service, route.RPC, err)
+		}
+		response, err := g.responseMessage(route, serverStreaming, imports)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate response of %s.%s: %w", route.Service, route.RPC, err)
+		}
+		messages = append(messages, request, response)
+	}
+
+	var b strings.Builder
+	writeHeader(&b, routes.Package, imports, goPackagePrefix+";v1")
+	b.WriteString(`
+// The services of the gRPC transport of plugins, generated from the routes of the plugin contracts.
+//
+// Every RPC serves an endpoint of a contract. Its request carries the request of the endpoint and the credentials
+// of the call, its response the response of the endpoint. Errors of the plugin are returned as gRPC status with the
+// HTTP status code of the endpoint in the ocm-status-code trailer.
+//
+// RPCs exchanging blobs stream them inline: if the location of a request or response refers to a local file, the
+// first message carries the location with the type "inline" and the following messages carry the content of the
+// file in blob chunks.
+`)
+	for _, service := range services {
+		fmt.Fprintf(&b, "\n// %s serves the endpoints of the %s contract.\nservice %s {\n", service, service, service)
+		b.WriteString(strings.Join(rpcs[service], "\n"))
+		b.WriteString("}\n")
+	}
+	for _, m := range messages {
+		b.WriteString("\n")
+		b.WriteString(m)
+	}
+	return b.String(), nil
+}
+
+func streamPrefix(streaming bool) string {
+	if streaming {
+		return "stream "
+	}
+	return ""
+}
+
+// requestMessage renders the request message of the RPC of the route.
+func (g *generator) requestMessage(route routes.Route, streaming bool, imports map[string]bool) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %sRequest is the request of %s.%s.\nmessage %sRequest {\n", route.RPC, route.Service, route.RPC, route.RPC)
+	number := 1
+
+	switch {
+	case route.Request == nil:
+	case len(route.Query) > 0:
+		fields, err := jsonFields(route.Request)
+		if err != nil {
+			return "", err
+		}
+		for _, name := range route.Query {
+			i := slices.IndexFunc(fields, func(f reflect.StructField) bool { return fieldName(f) == name })
+			if i < 0 {
+				return "", fmt.Errorf("query parameter %q is no field of %s", name, route.Request)
+			}
+			label, typ, err := g.fieldType(fields[i].Type, imports)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&b, "  // %s is the query parameter %s of the endpoint.\n", snakeCase(name), name)
+			fmt.Fprintf(&b, "  %s = %d [json_name = %q];\n", join(label, typ, snakeCase(name)), number, name)
+			number++
+		}
+	default:
+		label, typ, err := g.fieldType(route.Request, imports)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString("  // request is the request of the endpoint.\n")
+		fmt.Fprintf(&b, "  %s = %d;\n", join(label, typ, "request"), number)
+		number++
+	}
+
+	if route.Credentials {
+		imports[filePath(typesPackage)] = true
+		b.WriteString("  // credentials are the credentials of the call.\n")
+		fmt.Fprintf(&b, "  %s credentials = %d;\n", jsonMessage, number)
+		number++
+	}
+	if streaming {
+		b.WriteString("  // blob is a chunk of the content of the request location. It is only set in the messages following the first one.\n")
+		fmt.Fprintf(&b, "  bytes blob = %d;\n", number)
+	}
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+// responseMessage renders the response message of the RPC of the route.
+func (g *generator) responseMessage(route routes.Route, streaming bool, imports map[string]bool) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %sResponse is the response of %s.%s.\nmessage %sResponse {\n", route.RPC, route.Service, route.RPC, route.RPC)
+	number := 1
+
+	if route.Response != nil {
+		label, typ, err := g.fieldType(route.Response, imports)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString("  // response is the response of the endpoint.\n")
+		fmt.Fprintf(&b, "  %s = %d;\n", join(label, typ, "response"), number)
+		number++
+	}
+	if streaming {
+		b.WriteString("  // blob is a chunk of the content of the response location. It is only set in the messages following the first one.\n")
+		fmt.Fprintf(&b, "  bytes blob = %d;\n", number)
+	}
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+// fieldType returns the label and the protobuf type of a field of the Go type t.
+// The imports of the file referencing the type are updated.
+func (g *generator) fieldType(t reflect.Type, imports map[string]bool) (string, string, error) {
+	if opaque(t) {
+		imports[filePath(typesPackage)] = true
+		return "", jsonMessage, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		elem := t.Elem()
+		switch {
+		case opaque(elem):
+			return g.fieldType(elem, imports)
+		case elem.Kind() == reflect.Struct:
+			return g.fieldType(elem, imports)
+		case scalar(elem) != "":
+			return "optional", scalar(elem), nil
+		}
+	case reflect.Struct:
+		if t.Name() == "" {
+			break
+		}
+		name, err := g.message(t)
+		if err != nil {
+			return "", "", err
+		}
+		imports[filePath(t.PkgPath())] = true
+		return "", name, nil
+	case reflect.Slice:
+		elem := t.Elem()
+		if elem.Kind() == reflect.Uint8 && !opaque(elem) {
+			return "", "bytes", nil
+		}
+		label, typ, err := g.fieldType(elem, imports)
+		if err != nil {
+			return "", "", err
+		}
+		if label == "" && !strings.HasPrefix(typ, "map<") {
+			return "repeated", typ, nil
+		}
+	case reflect.Map:
+		if t.Key().Kind() == reflect.String && !opaque(t.Key()) && scalar(t.Elem()) == "string" {
+			return "", "map<string, string>", nil
+		}
+	default:
+		if typ := scalar(t); typ != "" {
+			return "", typ, nil
+		}
+		return "", "", fmt.Errorf("unsupported type %s", t)
+	}
+
+	// everything without a protobuf representation is carried as JSON.
+	imports[filePath(typesPackage)] = true
+	return "", jsonMessage, nil
+}
+
+// message registers the message of the Go struct t and returns its fully qualified name.
+func (g *generator) message(t reflect.Type) (string, error) {
+	f := g.file(t.PkgPath())
+	name, _, _ := strings.Cut(t.Name(), "[")
+	qualified := "." + f.pkg + "." + name
+
+	for _, m := range f.messages {
+		if m.name != name {
+			continue
+		}
+		if m.goType == t {
+			return qualified, nil
+		}
+		// instances of a generic type share the message if their fields are the same.
+		fields, err := g.fields(t, f.imports)
+		if err != nil {
+			return "", err
+		}
+		if !slices.Equal(fields, m.fields) {
+			return "", fmt.Errorf("%s and %s have the same message name %s but different fields", m.goType, t, name)
+		}
+		return qualified, nil
+	}
+
+	m := &message{name: name, goType: t}
+	// register the message before its fields, so recursive types terminate.
+	f.messages = append(f.messages, m)
+	fields, err := g.fields(t, f.imports)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate message of %s: %w", t, err)
+	}
+	m.fields = fields
+	return qualified, nil
+}
+
+// fields returns the fields of the message of the Go struct t.
+func (g *generator) fields(t reflect.Type, imports map[string]bool) ([]field, error) {
+	structFields, err := jsonFields(t)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]field, 0, len(structFields))
+	names := map[string]bool{}
+	for _, structField := range structFields {
+		jsonName := fieldName(structField)
+		label, typ, err := g.fieldType(structField.Type, imports)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", structField.Name, err)
+		}
+		name := snakeCase(jsonName)
+		if names[name] {
+			return nil, fmt.Errorf("field %s: duplicate field name %s", structField.Name, name)
+		}
+		names[name] = true
+		fields = append(fields, field{label: label, typ: typ, name: name, jsonName: jsonName})
+	}
+	return fields, nil
+}
+
+// file returns the file of the types of the Go package.
+func (g *generator) file(goPackage string) *file {
+	path := filePath(goPackage)
+	if f, ok := g.files[path]; ok {
+		return f
+	}
+
+	rel := relativePackage(goPackage)
+	f := &file{
+		goPackage: goPackage,
+		path:      path,
+		pkg:       routes.Package + "." + strings.ReplaceAll(rel, "/", "."),
+		imports:   map[string]bool{},
+	}
+	g.files[path] = f
+	return f
+}
+
+// relativePackage returns the path of the generated package of the Go package relative to the v1 directory,
+// e.g. contracts/ocmrepository/v1 for the types of the ocmrepository contract.
+func relativePackage(goPackage string) string {
+	if goPackage == typesPackage {
+		return "types"
+	}
+	rel := strings.TrimPrefix(goPackage, modulePrefix)
+	return strings.TrimPrefix(rel, "plugin/manager/")
+}
+
+// filePath returns the path of the file of the types of the Go package relative to the v1 directory.
+func filePath(goPackage string) string {
+	return relativePackage(goPackage) + "/types.proto"
+}
+
+// goPackageName returns the name of the generated Go package, e.g. ocmrepositoryv1.
+func goPackageName(rel string) string {
+	var name strings.Builder
+	for _, segment := range strings.Split(rel, "/") {
+		if segment != "contracts" && segment != "spec" {
+			name.WriteString(segment)
+		}
+	}
+	return name.String()
+}
+
+func (f *file) render() string {
+	// the JSON message is part of the types of the plugin manager.
+	delete(f.imports, f.path)
+
+	var b strings.Builder
+	rel := relativePackage(f.goPackage)
+	writeHeader(&b, f.pkg, f.imports, goPackagePrefix+"/"+rel+";"+goPackageName(rel))
+	if rel == "types" {
+		b.WriteString(`
+// JSON is the JSON encoding of a Go value without protobuf representation,
+// e.g. a runtime.Typed or a type with custom JSON encoding.
+message JSON {
+  bytes json = 1;
+}
+`)
+	}
+	for _, m := range f.messages {
+		fmt.Fprintf(&b, "\n// %s is generated from %s.%s.\nmessage %s {\n", m.name, m.goType.PkgPath(), m.name, m.name)
+		for i, fd := range m.fields {
+			fmt.Fprintf(&b, "  %s = %d [json_name = %q];\n", join(fd.label, fd.typ, fd.name), i+1, fd.jsonName)
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+func writeHeader(b *strings.Builder, pkg string, imports map[string]bool, goPackage string) {
+	b.WriteString("// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.\n\n")
+	b.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(b, "package %s;\n\n", pkg)
+	paths := make([]string, 0, len(imports))
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		fmt.Fprintf(b, "import %q;\n", "v1/"+path)
+	}
+	if len(paths) > 0 {
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(b, "option go_package = %q;\n", goPackage)
+}
+
+// jsonFields returns the fields of the Go struct t in the order of its JSON encoding.
+// Embedded structs without JSON name are flattened like by encoding/json.
+func jsonFields(t reflect.Type) ([]reflect.StructField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is no struct", t)
+	}
+
+	var fields []reflect.StructField
+	for i := range t.NumField() {
+		structField := t.Field(i)
+		tag, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+		if structField.Anonymous && tag == "" {
+			embedded := structField.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct && !opaque(embedded) {
+				inner, err := jsonFields(embedded)
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, inner...)
+				continue
+			}
+		}
+		if _, ok := routes.JSONName(structField); ok {
+			fields = append(fields, structField)
+		}
+	}
+	return fields, nil
+}
+
+func fieldName(structField reflect.StructField) string {
+	name, _ := routes.JSONName(structField)
+	return name
+}
+
+// opaque reports whether values of the Go type t have no protobuf representation.
+func opaque(t reflect.Type) bool {
+	if t.Kind() == reflect.Interface {
+		return true
+	}
+	for _, c := range []reflect.Type{t, reflect.PointerTo(t)} {
+		if c.Implements(jsonMarshaler) || c.Implements(jsonUnmarshaler) ||
+			c.Implements(textMarshaler) || c.Implements(textUnmarshaler) {
+			return true
+		}
+	}
+	return false
+}
+
+// scalar returns the protobuf scalar type of the Go type t, or "" if t is no scalar.
+func scalar(t reflect.Type) string {
+	if opaque(t) {
+		return ""
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int64:
+		return "int64"
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return "int32"
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return "uint64"
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return "uint32"
+	case reflect.Float32:
+		return "float"
+	case reflect.Float64:
+		return "double"
+	default:
+		return ""
+	}
+}
+
+func join(label, typ, name string) string {
+	if label == "" {
+		return typ + " " + name
+	}
+	return label + " " + typ + " " + name
+}
+
+// snakeCase converts a JSON name into a protobuf field name, e.g. mediaType into media_type.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case unicode.IsUpper(r):
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedUpToDate checks that the protobuf definitions match the Go types of the plugin contracts.
+// Run task generate/proto after changing the contracts.
+func TestGeneratedUpToDate(t *testing.T) {
+	files, err := generate()
+	require.NoError(t, err)
+
+	for name, content := range files {
+		actual, err := os.ReadFile(filepath.Join("..", "..", "v1", name))
+		require.NoError(t, err, "%s is not generated", name)
+		assert.Equal(t, content, string(actual), "%s is out of date", name)
+	}
+}
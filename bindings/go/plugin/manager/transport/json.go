@@ -0,0 +1,230 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/transport/routes"
+)
+
+// jsonMessage is the message carrying values without protobuf representation as JSON.
+const jsonMessage protoreflect.FullName = routes.Package + ".types.JSON"
+
+// fieldFromJSON sets the field of m from the JSON encoding of the Go value of the field.
+// A JSON null leaves the field unset.
+func fieldFromJSON(m protoreflect.Message, fd protoreflect.FieldDescriptor, data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+
+	switch {
+	case fd.IsMap():
+		var values map[string]string
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+		entries := m.Mutable(fd).Map()
+		for key, value := range values {
+			entries.Set(protoreflect.ValueOfString(key).MapKey(), protoreflect.ValueOfString(value))
+		}
+	case fd.IsList():
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		list := m.Mutable(fd).List()
+		for _, item := range items {
+			value, err := valueFromJSON(fd, list.NewElement(), item)
+			if err != nil {
+				return err
+			}
+			list.Append(value)
+		}
+	case fd.Kind() == protoreflect.MessageKind:
+		return messageFromJSON(m.Mutable(fd).Message(), data)
+	default:
+		value, err := valueFromJSON(fd, protoreflect.Value{}, data)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, value)
+	}
+	return nil
+}
+
+// valueFromJSON returns the value of a singular field or list element from its JSON encoding.
+// Messages are decoded into the message of value.
+func valueFromJSON(fd protoreflect.FieldDescriptor, value protoreflect.Value, data []byte) (protoreflect.Value, error) {
+	var err error
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		return value, messageFromJSON(value.Message(), data)
+	case protoreflect.StringKind:
+		var v string
+		err = json.Unmarshal(data, &v)
+		value = protoreflect.ValueOfString(v)
+	case protoreflect.BoolKind:
+		var v bool
+		err = json.Unmarshal(data, &v)
+		value = protoreflect.ValueOfBool(v)
+	case protoreflect.Int32Kind:
+		var v int32
+		err = json.Unmarshal(data, &v)
+		value = protoreflect.ValueOfInt32(v)
+	case protoreflect.Int64Kind:
+		var v int64
+		err = json.Unmarshal(data, &v)
+		value = protoreflect.ValueOfInt64(v)
+	case protoreflect.Uint32Kind:
+		var v uint32
+		err = json.Unmarshal(data, &v)
+		value = protoreflect.ValueOfUint32(v)
+	case protoreflect.Uint64Kind:
+		var v uint64
+		err = json.Unmarshal(data, &v)
+		value = protoreflect.ValueOfUint64(v)
+	case protoreflect.FloatKind:
+		var v float32
+		err = json.Unmarshal(data, &v)
+		value = protoreflect.ValueOfFloat32(v)
+	case protoreflect.DoubleKind:
+		var v float64
+		err = json.Unmarshal(data, &v)
+		value = protoreflect.ValueOfFloat64(v)
+	case protoreflect.BytesKind:
+		var v []byte
+		err = json.Unmarshal(data, &v)
+		value = protoreflect.ValueOfBytes(v)
+	default:
+		err = fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+	return value, err
+}
+
+// messageFromJSON sets the fields of m from the JSON object of the Go struct of the message.
+// Unknown JSON fields are ignored like by encoding/json.
+func messageFromJSON(m protoreflect.Message, data []byte) error {
+	if m.Descriptor().FullName() == jsonMessage {
+		m.Set(m.Descriptor().Fields().ByName("json"), protoreflect.ValueOfBytes(bytes.Clone(data)))
+		return nil
+	}
+	if isNull(data) {
+		return nil
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", m.Descriptor().Name(), err)
+	}
+	fields := m.Descriptor().Fields()
+	for name, value := range values {
+		fd := fieldByJSONName(fields, name)
+		if fd == nil {
+			continue
+		}
+		if err := fieldFromJSON(m, fd, value); err != nil {
+			return fmt.Errorf("failed to decode field %s of %s: %w", name, m.Descriptor().Name(), err)
+		}
+	}
+	return nil
+}
+
+// fieldByJSONName returns the field with the JSON name. Like encoding/json, it falls back to a case-insensitive match.
+func fieldByJSONName(fields protoreflect.FieldDescriptors, name string) protoreflect.FieldDescriptor {
+	if fd := fields.ByJSONName(name); fd != nil {
+		return fd
+	}
+	for i := range fields.Len() {
+		if strings.EqualFold(fields.Get(i).JSONName(), name) {
+			return fields.Get(i)
+		}
+	}
+	return nil
+}
+
+// fieldToJSON returns the JSON encoding of the Go value of the field of m. Unset fields return false.
+func fieldToJSON(m protoreflect.Message, fd protoreflect.FieldDescriptor) ([]byte, bool, error) {
+	if !m.Has(fd) {
+		return nil, false, nil
+	}
+
+	switch {
+	case fd.IsMap():
+		values := map[string]string{}
+		m.Get(fd).Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			values[key.String()] = value.String()
+			return true
+		})
+		data, err := json.Marshal(values)
+		return data, true, err
+	case fd.IsList():
+		list := m.Get(fd).List()
+		items := make([]json.RawMessage, list.Len())
+		for i := range list.Len() {
+			item, err := valueToJSON(fd, list.Get(i))
+			if err != nil {
+				return nil, false, err
+			}
+			items[i] = item
+		}
+		data, err := json.Marshal(items)
+		return data, true, err
+	default:
+		data, err := valueToJSON(fd, m.Get(fd))
+		return data, true, err
+	}
+}
+
+// valueToJSON returns the JSON encoding of a singular field or list element.
+func valueToJSON(fd protoreflect.FieldDescriptor, value protoreflect.Value) ([]byte, error) {
+	if fd.Kind() == protoreflect.MessageKind {
+		return messageToJSON(value.Message())
+	}
+	return json.Marshal(value.Interface())
+}
+
+// messageToJSON returns the JSON object of the Go struct of the message.
+func messageToJSON(m protoreflect.Message) ([]byte, error) {
+	if m.Descriptor().FullName() == jsonMessage {
+		data := m.Get(m.Descriptor().Fields().ByName("json")).Bytes()
+		if len(data) == 0 {
+			return []byte("null"), nil
+		}
+		return data, nil
+	}
+
+	var b bytes.Buffer
+	b.WriteByte('{')
+	fields := m.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		data, ok, err := fieldToJSON(m, fd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode field %s of %s: %w", fd.JSONName(), m.Descriptor().Name(), err)
+		}
+		if !ok {
+			continue
+		}
+		if b.Len() > 1 {
+			b.WriteByte(',')
+		}
+		name, err := json.Marshal(fd.JSONName())
+		if err != nil {
+			return nil, err
+		}
+		b.Write(name)
+		b.WriteByte(':')
+		b.Write(data)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+func isNull(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) == 0 || string(data) == "null"
+}
@@ -0,0 +1,205 @@
+// Package routes maps the HTTP endpoints of the plugin contracts to the RPCs of the gRPC transport.
+//
+// The routes are the single source of the gRPC transport: the protobuf definitions in transport/v1 are generated from
+// the Go types of the routes, and the transport converts the calls of an endpoint into the messages of its RPC.
+package routes
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+
+	v2 "ocm.software/open-component-model/bindings/go/descriptor/v2"
+	blobtransformerv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/blobtransformer/v1"
+	componentlisterv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/componentlister/v1"
+	credentialsv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/credentials/v1"
+	digestprocessorv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/digestprocessor/v1"
+	inputv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/input/v1"
+	ocmrepositoryv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1"
+	resourcev1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/resource/v1"
+	signingv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/signing/v1"
+	transformationv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// Route is an endpoint of a plugin contract served by an RPC of the gRPC transport.
+type Route struct {
+	// Service is the gRPC service of the contract, e.g. ComponentVersionRepository.
+	Service string
+	// RPC is the name of the method of the service, e.g. AddLocalResource.
+	RPC string
+	// Method is the HTTP method of the endpoint.
+	Method string
+	// Path is the path of the endpoint.
+	Path string
+	// Request is the Go type of the request of the endpoint, nil if the endpoint has no request.
+	Request reflect.Type
+	// Query lists the JSON names of the fields of Request passed as query parameters. If empty, the request is passed
+	// as JSON body. Query parameters of other types than strings are passed as base64 encoded JSON.
+	Query []string
+	// Credentials reports whether the credentials of the call are passed in the Authorization header.
+	Credentials bool
+	// Response is the Go type of the JSON response of the endpoint, nil if the endpoint has no response body.
+	Response reflect.Type
+}
+
+// FullMethod returns the full gRPC method name of the route, e.g. /ocm.software.plugin.transport.v1.Plugin/Healthz.
+func (r Route) FullMethod() string {
+	return "/" + Package + "." + r.Service + "/" + r.RPC
+}
+
+// RequestLocation returns the JSON name of the top-level types.Location field of the request.
+// Its content is streamed to the plugin as blob chunks if the location is a local file.
+func (r Route) RequestLocation() (string, bool) {
+	return locationField(r.Request)
+}
+
+// ResponseLocation returns the JSON name of the top-level types.Location field of the response.
+// Its content is streamed from the plugin as blob chunks if the location is a local file.
+func (r Route) ResponseLocation() (string, bool) {
+	return locationField(r.Response)
+}
+
+// Package is the protobuf package of the gRPC transport.
+const Package = "ocm.software.plugin.transport.v1"
+
+// Routes are all routes of the gRPC transport in the order of their definition in transport/v1.
+// The endpoint shared by all contracts, i.e. the identity endpoint, is served by the Plugin service.
+var Routes = []Route{
+	{Service: "Plugin", RPC: "Healthz", Method: http.MethodGet, Path: "/healthz"},
+	// all contracts use the same request and response for the identity endpoint.
+	{Service: "Plugin", RPC: "GetIdentity", Method: http.MethodPost, Path: "/identity",
+		Request:  reflect.TypeFor[ocmrepositoryv1.GetIdentityRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[ocmrepositoryv1.GetIdentityResponse]()},
+
+	{Service: "BlobTransformer", RPC: "TransformBlob", Method: http.MethodPost, Path: "/blob/transform", Credentials: true,
+		Request:  reflect.TypeFor[blobtransformerv1.TransformBlobRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[blobtransformerv1.TransformBlobResponse]()},
+
+	{Service: "ComponentLister", RPC: "ListComponents", Method: http.MethodPost, Path: "/components/list", Credentials: true,
+		Request:  reflect.TypeFor[componentlisterv1.ListComponentsRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[componentlisterv1.ListComponentsResponse]()},
+
+	{Service: "ComponentVersionRepository", RPC: "AddComponentVersion", Method: http.MethodPost, Path: "/component-version/upload", Credentials: true,
+		Request: reflect.TypeFor[ocmrepositoryv1.PostComponentVersionRequest[runtime.Typed]]()},
+	{Service: "ComponentVersionRepository", RPC: "GetComponentVersion", Method: http.MethodGet, Path: "/component-version/download", Credentials: true,
+		Request:  reflect.TypeFor[ocmrepositoryv1.GetComponentVersionRequest[runtime.Typed]](),
+		Query:    []string{"name", "version"},
+		Response: reflect.TypeFor[v2.Descriptor]()},
+	{Service: "ComponentVersionRepository", RPC: "ListComponentVersions", Method: http.MethodGet, Path: "/component-versions", Credentials: true,
+		Request:  reflect.TypeFor[ocmrepositoryv1.ListComponentVersionsRequest[runtime.Typed]](),
+		Query:    []string{"name"},
+		Response: reflect.TypeFor[[]string]()},
+	{Service: "ComponentVersionRepository", RPC: "AddLocalResource", Method: http.MethodPost, Path: "/local-resource/upload", Credentials: true,
+		Request:  reflect.TypeFor[ocmrepositoryv1.PostLocalResourceRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[v2.Resource]()},
+	{Service: "ComponentVersionRepository", RPC: "GetLocalResource", Method: http.MethodGet, Path: "/local-resource/download", Credentials: true,
+		Request:  reflect.TypeFor[ocmrepositoryv1.GetLocalResourceRequest[runtime.Typed]](),
+		Query:    []string{"name", "version", "identity"},
+		Response: reflect.TypeFor[ocmrepositoryv1.GetLocalResourceResponse]()},
+	{Service: "ComponentVersionRepository", RPC: "AddLocalSource", Method: http.MethodPost, Path: "/local-source/upload", Credentials: true,
+		Request:  reflect.TypeFor[ocmrepositoryv1.PostLocalSourceRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[v2.Source]()},
+	{Service: "ComponentVersionRepository", RPC: "GetLocalSource", Method: http.MethodGet, Path: "/local-source/download", Credentials: true,
+		Request:  reflect.TypeFor[ocmrepositoryv1.GetLocalSourceRequest[runtime.Typed]](),
+		Query:    []string{"name", "version", "identity"},
+		Response: reflect.TypeFor[ocmrepositoryv1.GetLocalSourceResponse]()},
+	{Service: "ComponentVersionRepository", RPC: "CheckHealth", Method: http.MethodPost, Path: "/component-version/check-health", Credentials: true,
+		Request: reflect.TypeFor[ocmrepositoryv1.PostCheckHealthRequest[runtime.Typed]]()},
+
+	{Service: "CredentialRepository", RPC: "ConsumerIdentityForConfig", Method: http.MethodPost, Path: "/consumer-identity",
+		Request:  reflect.TypeFor[credentialsv1.ConsumerIdentityForConfigRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[runtime.Identity]()},
+	{Service: "CredentialRepository", RPC: "Resolve", Method: http.MethodPost, Path: "/resolve", Credentials: true,
+		Request:  reflect.TypeFor[credentialsv1.ResolveRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[runtime.Raw]()},
+
+	{Service: "DigestProcessor", RPC: "ProcessResourceDigest", Method: http.MethodPost, Path: "/resource/digest/process", Credentials: true,
+		Request:  reflect.TypeFor[digestprocessorv1.ProcessResourceDigestRequest](),
+		Response: reflect.TypeFor[digestprocessorv1.ProcessResourceDigestResponse]()},
+
+	{Service: "Input", RPC: "ProcessResource", Method: http.MethodPost, Path: "/resource/process", Credentials: true,
+		Request:  reflect.TypeFor[inputv1.ProcessResourceInputRequest](),
+		Response: reflect.TypeFor[inputv1.ProcessResourceInputResponse]()},
+	{Service: "Input", RPC: "ProcessSource", Method: http.MethodPost, Path: "/source/process", Credentials: true,
+		Request:  reflect.TypeFor[inputv1.ProcessSourceInputRequest](),
+		Response: reflect.TypeFor[inputv1.ProcessSourceInputResponse]()},
+
+	{Service: "Resource", RPC: "GetGlobalResource", Method: http.MethodPost, Path: "/resource/get", Credentials: true,
+		Request:  reflect.TypeFor[resourcev1.GetGlobalResourceRequest](),
+		Response: reflect.TypeFor[resourcev1.GetGlobalResourceResponse]()},
+	{Service: "Resource", RPC: "AddGlobalResource", Method: http.MethodPost, Path: "/resource/add", Credentials: true,
+		Request:  reflect.TypeFor[resourcev1.AddGlobalResourceRequest](),
+		Response: reflect.TypeFor[resourcev1.AddGlobalResourceResponse]()},
+
+	{Service: "SignatureHandler", RPC: "GetSignerIdentity", Method: http.MethodPost, Path: "/sign/identity",
+		Request:  reflect.TypeFor[signingv1.GetSignerIdentityRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[signingv1.IdentityResponse]()},
+	{Service: "SignatureHandler", RPC: "GetVerifierIdentity", Method: http.MethodPost, Path: "/verify/identity",
+		Request:  reflect.TypeFor[signingv1.GetVerifierIdentityRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[signingv1.IdentityResponse]()},
+	{Service: "SignatureHandler", RPC: "Sign", Method: http.MethodPost, Path: "/sign", Credentials: true,
+		Request:  reflect.TypeFor[signingv1.SignRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[signingv1.SignResponse]()},
+	{Service: "SignatureHandler", RPC: "Verify", Method: http.MethodPost, Path: "/verify", Credentials: true,
+		Request:  reflect.TypeFor[signingv1.VerifyRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[signingv1.VerifyResponse]()},
+
+	{Service: "Transformation", RPC: "Transform", Method: http.MethodPost, Path: "/transformation/transform", Credentials: true,
+		Request:  reflect.TypeFor[transformationv1.TransformRequest[runtime.Typed]](),
+		Response: reflect.TypeFor[transformationv1.TransformResponse[runtime.Typed]]()},
+}
+
+// Lookup returns the route of the endpoint with the method and path.
+func Lookup(method, path string) (Route, bool) {
+	for _, route := range Routes {
+		if route.Method == method && route.Path == path {
+			return route, true
+		}
+	}
+	return Route{}, false
+}
+
+// LookupRPC returns the route served by the RPC of the service.
+func LookupRPC(service, rpc string) (Route, bool) {
+	for _, route := range Routes {
+		if route.Service == service && route.RPC == rpc {
+			return route, true
+		}
+	}
+	return Route{}, false
+}
+
+var locationType = reflect.TypeFor[types.Location]()
+
+// locationField returns the JSON name of the top-level field of the struct t with the type types.Location
+// or a pointer to it.
+func locationField(t reflect.Type) (string, bool) {
+	if t == nil || t.Kind() != reflect.Struct {
+		return "", false
+	}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if field.Type != locationType && field.Type != reflect.PointerTo(locationType) {
+			continue
+		}
+		if name, ok := JSONName(field); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// JSONName returns the name of the struct field in its JSON encoding. Fields not encoded return false.
+func JSONName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" || !field.IsExported() {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
@@ -0,0 +1,137 @@
+package routes_test
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	blobtransformerv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/blobtransformer/v1"
+	componentlisterv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/componentlister/v1"
+	digestprocessorv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/digestprocessor/v1"
+	inputv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/input/v1"
+	resourcev1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/resource/v1"
+	transformationv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/blobtransformer"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/componentlister"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/componentversionrepository"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/credentialrepository"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/digestprocessor"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/input"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/resource"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/signinghandler"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/transformation"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/transport/routes"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// TestRoutesMatchEndpoints checks that every endpoint called by the registries is served by a route.
+func TestRoutesMatchEndpoints(t *testing.T) {
+	endpoints := map[string]string{
+		"Plugin.GetIdentity":                               componentversionrepository.Identity,
+		"BlobTransformer.TransformBlob":                    blobtransformer.TransformBlob,
+		"ComponentLister.ListComponents":                   componentlister.ListComponents,
+		"ComponentVersionRepository.AddComponentVersion":   componentversionrepository.UploadComponentVersion,
+		"ComponentVersionRepository.GetComponentVersion":   componentversionrepository.DownloadComponentVersion,
+		"ComponentVersionRepository.ListComponentVersions": componentversionrepository.ListComponentVersions,
+		"ComponentVersionRepository.AddLocalResource":      componentversionrepository.UploadLocalResource,
+		"ComponentVersionRepository.GetLocalResource":      componentversionrepository.DownloadLocalResource,
+		"ComponentVersionRepository.AddLocalSource":        componentversionrepository.UploadLocalSource,
+		"ComponentVersionRepository.GetLocalSource":        componentversionrepository.DownloadLocalSource,
+		"ComponentVersionRepository.CheckHealth":           componentversionrepository.CheckHealth,
+		"CredentialRepository.ConsumerIdentityForConfig":   credentialrepository.ConsumerIdentityForConfig,
+		"CredentialRepository.Resolve":                     credentialrepository.Resolve,
+		"DigestProcessor.ProcessResourceDigest":            digestprocessor.ProcessResourceDigest,
+		"Input.ProcessResource":                            input.ProcessResource,
+		"Input.ProcessSource":                              input.ProcessSource,
+		"Resource.GetGlobalResource":                       resource.GetGlobalResource,
+		"Resource.AddGlobalResource":                       resource.AddGlobalResource,
+		"SignatureHandler.GetSignerIdentity":               signinghandler.GetSignerIdentity,
+		"SignatureHandler.GetVerifierIdentity":             signinghandler.GetVerifierIdentity,
+		"SignatureHandler.Sign":                            signinghandler.Sign,
+		"SignatureHandler.Verify":                          signinghandler.Verify,
+		"Transformation.Transform":                         transformation.Transform,
+	}
+
+	for _, identity := range []string{
+		blobtransformer.Identity, componentlister.Identity, digestprocessor.Identity, input.Identity,
+		resource.GetIdentity, transformation.Identity,
+	} {
+		assert.Equal(t, componentversionrepository.Identity, identity, "all contracts must share the identity endpoint")
+	}
+
+	for _, route := range routes.Routes {
+		if route.RPC == "Healthz" {
+			assert.Equal(t, "/healthz", route.Path)
+			continue
+		}
+		path, ok := endpoints[route.Service+"."+route.RPC]
+		require.True(t, ok, "route %s.%s has no endpoint", route.Service, route.RPC)
+		assert.Equal(t, path, route.Path, "route %s.%s", route.Service, route.RPC)
+		delete(endpoints, route.Service+"."+route.RPC)
+
+		found, ok := routes.Lookup(route.Method, route.Path)
+		require.True(t, ok)
+		assert.Equal(t, route.RPC, found.RPC)
+	}
+	assert.Empty(t, endpoints, "endpoints without route")
+}
+
+// TestIdentityRequestsMatch checks that all contracts send the same request to the shared identity endpoint.
+func TestIdentityRequestsMatch(t *testing.T) {
+	route, ok := routes.LookupRPC("Plugin", "GetIdentity")
+	require.True(t, ok)
+
+	for _, typ := range []reflect.Type{
+		reflect.TypeFor[blobtransformerv1.GetIdentityRequest[runtime.Typed]](),
+		reflect.TypeFor[componentlisterv1.GetIdentityRequest[runtime.Typed]](),
+		reflect.TypeFor[digestprocessorv1.GetIdentityRequest[runtime.Typed]](),
+		reflect.TypeFor[inputv1.GetIdentityRequest[runtime.Typed]](),
+		reflect.TypeFor[resourcev1.GetIdentityRequest[runtime.Typed]](),
+		reflect.TypeFor[transformationv1.GetIdentityRequest[runtime.Typed]](),
+	} {
+		assert.Equal(t, fields(route.Request), fields(typ), typ.String())
+	}
+	for _, typ := range []reflect.Type{
+		reflect.TypeFor[blobtransformerv1.GetIdentityResponse](),
+		reflect.TypeFor[componentlisterv1.GetIdentityResponse](),
+		reflect.TypeFor[digestprocessorv1.GetIdentityResponse](),
+		reflect.TypeFor[inputv1.GetIdentityResponse](),
+		reflect.TypeFor[resourcev1.GetIdentityResponse](),
+		reflect.TypeFor[transformationv1.GetIdentityResponse](),
+	} {
+		assert.Equal(t, fields(route.Response), fields(typ), typ.String())
+	}
+}
+
+func TestLocations(t *testing.T) {
+	route, ok := routes.Lookup(http.MethodPost, componentversionrepository.UploadLocalResource)
+	require.True(t, ok)
+	name, ok := route.RequestLocation()
+	assert.True(t, ok)
+	assert.Equal(t, "resourceLocation", name)
+	_, ok = route.ResponseLocation()
+	assert.False(t, ok)
+
+	route, ok = routes.Lookup(http.MethodGet, componentversionrepository.DownloadLocalResource)
+	require.True(t, ok)
+	_, ok = route.RequestLocation()
+	assert.False(t, ok)
+	name, ok = route.ResponseLocation()
+	assert.True(t, ok)
+	assert.Equal(t, "location", name)
+}
+
+// fields returns the JSON names and types of the fields of the struct.
+func fields(t reflect.Type) map[string]reflect.Type {
+	out := map[string]reflect.Type{}
+	for i := range t.NumField() {
+		name, ok := routes.JSONName(t.Field(i))
+		if ok {
+			out[name] = t.Field(i).Type
+		}
+	}
+	return out
+}
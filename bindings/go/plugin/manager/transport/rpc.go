@@ -0,0 +1,184 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/transport/routes"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+const (
+	// chunkSize is the maximum size of the blob chunks sent in a single message.
+	chunkSize = 256 * 1024
+
+	// locationTypeInline marks a location whose content is streamed as blob chunks following the first message.
+	locationTypeInline types.LocationType = "inline"
+
+	// statusCodeTrailer is the trailer carrying the HTTP status code of an endpoint that failed.
+	statusCodeTrailer = "ocm-status-code"
+)
+
+// rpc is a route with the protobuf messages of its RPC.
+type rpc struct {
+	routes.Route
+	method         protoreflect.MethodDescriptor
+	input, output  protoreflect.MessageType
+	requestField   protoreflect.FieldDescriptor
+	responseField  protoreflect.FieldDescriptor
+	credentials    protoreflect.FieldDescriptor
+	inputBlob      protoreflect.FieldDescriptor
+	outputBlob     protoreflect.FieldDescriptor
+	requestBlobAt  string
+	responseBlobAt string
+}
+
+// rpcs returns the RPCs of all routes.
+var rpcs = sync.OnceValues(func() ([]*rpc, error) {
+	services := v1.File_v1_plugin_proto.Services()
+	out := make([]*rpc, 0, len(routes.Routes))
+	for _, route := range routes.Routes {
+		service := services.ByName(protoreflect.Name(route.Service))
+		if service == nil {
+			return nil, fmt.Errorf("service %s is not defined", route.Service)
+		}
+		method := service.Methods().ByName(protoreflect.Name(route.RPC))
+		if method == nil {
+			return nil, fmt.Errorf("rpc %s.%s is not defined", route.Service, route.RPC)
+		}
+		input, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+		if err != nil {
+			return nil, err
+		}
+		output, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+		if err != nil {
+			return nil, err
+		}
+
+		r := &rpc{
+			Route:         route,
+			method:        method,
+			input:         input,
+			output:        output,
+			requestField:  method.Input().Fields().ByName("request"),
+			responseField: method.Output().Fields().ByName("response"),
+			credentials:   method.Input().Fields().ByName("credentials"),
+			inputBlob:     method.Input().Fields().ByName("blob"),
+			outputBlob:    method.Output().Fields().ByName("blob"),
+		}
+		if name, ok := route.RequestLocation(); ok && method.IsStreamingClient() {
+			r.requestBlobAt = name
+		}
+		if name, ok := route.ResponseLocation(); ok && method.IsStreamingServer() {
+			r.responseBlobAt = name
+		}
+		out = append(out, r)
+	}
+	return out, nil
+})
+
+// lookup returns the RPC serving the endpoint with the method and path.
+func lookup(method, path string) (*rpc, error) {
+	all, err := rpcs()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range all {
+		if r.Method == method && r.Path == path {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrUnsupportedEndpoint, method, path)
+}
+
+// location is the types.Location field of a request or response message.
+type location struct {
+	msg protoreflect.Message
+}
+
+// locationOf returns the location with the JSON name in the message of the field fd of m.
+// It returns false if the location or the message is not set.
+func locationOf(m protoreflect.Message, fd protoreflect.FieldDescriptor, name string) (location, bool) {
+	if fd == nil || name == "" || !m.Has(fd) {
+		return location{}, false
+	}
+	msg := m.Mutable(fd).Message()
+	field := msg.Descriptor().Fields().ByJSONName(name)
+	if field == nil || !msg.Has(field) {
+		return location{}, false
+	}
+	return location{msg: msg.Mutable(field).Message()}, true
+}
+
+func (l location) typ() types.LocationType {
+	return types.LocationType(l.msg.Get(l.msg.Descriptor().Fields().ByName("type")).String())
+}
+
+func (l location) value() string {
+	return l.msg.Get(l.msg.Descriptor().Fields().ByName("value")).String()
+}
+
+func (l location) set(typ types.LocationType, value string) {
+	fields := l.msg.Descriptor().Fields()
+	l.msg.Set(fields.ByName("type"), protoreflect.ValueOfString(string(typ)))
+	l.msg.Set(fields.ByName("value"), protoreflect.ValueOfString(value))
+}
+
+// sendFile sends the content of the file in the blob field of new messages of the type.
+func sendFile(path string, typ protoreflect.MessageType, blob protoreflect.FieldDescriptor, send func(any) error) (err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open blob: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, file.Close())
+	}()
+
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			msg := typ.New()
+			msg.Set(blob, protoreflect.ValueOfBytes(buf[:n]))
+			if err := send(msg.Interface()); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read blob: %w", err)
+		}
+	}
+}
+
+// receiveFile writes the blob chunks of the received messages of the type to w until the stream ends.
+func receiveFile(w io.Writer, typ protoreflect.MessageType, blob protoreflect.FieldDescriptor, recv func(any) error) error {
+	for {
+		msg := typ.New()
+		err := recv(msg.Interface())
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if w == nil {
+			if len(msg.Get(blob).Bytes()) > 0 {
+				return errors.New("received blob chunks without inline location")
+			}
+			continue
+		}
+		if _, err := w.Write(msg.Get(blob).Bytes()); err != nil {
+			return fmt.Errorf("failed to write blob: %w", err)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/transport/routes"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+// RegisterServer registers the services of the gRPC transport with the registrar. The RPCs are served with the
+// handler of a plugin, e.g. the mux of its HTTP server, so the same handlers serve both transports: every RPC is
+// converted into the JSON request of its endpoint and the JSON response of the handler back into the response message.
+// The context of a served request is cancelled once the call is cancelled.
+//
+// A blob streamed to the plugin is written to a temporary file that is removed after the call. A local file
+// returned by the handler is streamed to the client and left in place, as it may not be owned by the plugin.
+func RegisterServer(registrar grpc.ServiceRegistrar, handler http.Handler) error {
+	all, err := rpcs()
+	if err != nil {
+		return err
+	}
+
+	s := &server{handler: handler}
+	var services []*grpc.ServiceDesc
+	for _, r := range all {
+		i := len(services) - 1
+		if i < 0 || services[i].ServiceName != routes.Package+"."+r.Service {
+			services = append(services, &grpc.ServiceDesc{
+				ServiceName: routes.Package + "." + r.Service,
+				// the services are served generically from the routes, not by an implementation of a generated interface.
+				HandlerType: (*any)(nil),
+				Metadata:    v1.File_v1_plugin_proto.Path(),
+			})
+			i++
+		}
+		// unary RPCs use the same protocol on the wire as streams with a single message, so every RPC is served
+		// as stream.
+		services[i].Streams = append(services[i].Streams, grpc.StreamDesc{
+			StreamName:    r.RPC,
+			Handler:       s.serve(r),
+			ClientStreams: r.method.IsStreamingClient(),
+			ServerStreams: r.method.IsStreamingServer(),
+		})
+	}
+	for _, service := range services {
+		registrar.RegisterService(service, s)
+	}
+	return nil
+}
+
+type server struct {
+	handler http.Handler
+}
+
+// serve returns the handler of the RPC.
+func (s *server) serve(r *rpc) grpc.StreamHandler {
+	return func(_ any, stream grpc.ServerStream) error {
+		in := r.input.New()
+		if err := stream.RecvMsg(in.Interface()); err != nil {
+			return err
+		}
+
+		blob, err := receiveBlob(stream, r, in)
+		if blob != "" {
+			defer func() {
+				_ = os.Remove(blob)
+			}()
+		}
+		if err != nil {
+			return err
+		}
+
+		req, err := r.httpRequest(stream, in)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+
+		w := &responseRecorder{header: make(http.Header), code: http.StatusOK}
+		if err := s.serveHTTP(w, req); err != nil {
+			return err
+		}
+		if w.code != http.StatusOK {
+			stream.SetTrailer(metadata.Pairs(statusCodeTrailer, strconv.Itoa(w.code)))
+			return status.Error(statusCode(w.code), w.body.String())
+		}
+
+		out := r.output.New()
+		if r.responseField != nil {
+			if err := fieldFromJSON(out, r.responseField, w.body.Bytes()); err != nil {
+				return status.Errorf(codes.Internal, "invalid response of %s: %v", r.Path, err)
+			}
+		}
+		return sendResponse(stream, r, out)
+	}
+}
+
+// serveHTTP serves the request with the handler. Like the http server, it recovers from panics of the handler,
+// so a single call doesn't crash the plugin.
+func (s *server) serveHTTP(w http.ResponseWriter, req *http.Request) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "panic serving %s: %v", req.URL.Path, r)
+		}
+	}()
+	s.handler.ServeHTTP(w, req)
+	return nil
+}
+
+// receiveBlob receives the blob chunks following the request message into a temporary file and sets the file as
+// the request location. It returns the path of the file.
+func receiveBlob(stream grpc.ServerStream, r *rpc, in protoreflect.Message) (string, error) {
+	if !r.method.IsStreamingClient() {
+		return "", nil
+	}
+
+	loc, ok := locationOf(in, r.requestField, r.requestBlobAt)
+	if !ok || loc.typ() != locationTypeInline {
+		if err := receiveFile(nil, r.input, r.inputBlob, stream.RecvMsg); err != nil {
+			return "", status.Error(codes.InvalidArgument, err.Error())
+		}
+		return "", nil
+	}
+
+	file, err := os.CreateTemp("", "ocm-plugin-blob-*")
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to create blob file: %v", err)
+	}
+	err = receiveFile(file, r.input, r.inputBlob, stream.RecvMsg)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return file.Name(), err
+	}
+	loc.set(types.LocationTypeLocalFile, file.Name())
+	return file.Name(), nil
+}
+
+// sendResponse sends the response message. The content of a local file in the response location is streamed in
+// blob chunks following the response message.
+func sendResponse(stream grpc.ServerStream, r *rpc, out protoreflect.Message) error {
+	loc, ok := locationOf(out, r.responseField, r.responseBlobAt)
+	if !ok || loc.typ() != types.LocationTypeLocalFile {
+		return stream.SendMsg(out.Interface())
+	}
+
+	blob := loc.value()
+	loc.set(locationTypeInline, "")
+	if err := stream.SendMsg(out.Interface()); err != nil {
+		return err
+	}
+	if err := sendFile(blob, r.output, r.outputBlob, stream.SendMsg); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// httpRequest converts the request message of the RPC into the request of its endpoint.
+func (r *rpc) httpRequest(stream grpc.ServerStream, in protoreflect.Message) (*http.Request, error) {
+	target := r.Path
+	if len(r.Query) > 0 {
+		query := url.Values{}
+		fields := in.Descriptor().Fields()
+		for _, name := range r.Query {
+			fd := fields.ByJSONName(name)
+			if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+				if value := in.Get(fd).String(); value != "" {
+					query.Set(name, value)
+				}
+				continue
+			}
+			data, ok, err := fieldToJSON(in, fd)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				query.Set(name, base64.StdEncoding.EncodeToString(data))
+			}
+		}
+		if len(query) > 0 {
+			target += "?" + query.Encode()
+		}
+	}
+
+	var body io.Reader = http.NoBody
+	if r.requestField != nil {
+		data, ok, err := fieldToJSON(in, r.requestField)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			body = bytes.NewReader(data)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(stream.Context(), r.Method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	req.RequestURI = target
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if r.credentials != nil && in.Has(r.credentials) {
+		credentials, err := messageToJSON(in.Get(r.credentials).Message())
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", string(credentials))
+	}
+	return req, nil
+}
+
+// statusCode returns the gRPC code of the HTTP status code of an endpoint.
+func statusCode(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	default:
+		return codes.Unknown
+	}
+}
+
+// responseRecorder records the response of a handler.
+type responseRecorder struct {
+	header      http.Header
+	code        int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+var _ http.Flusher = (*responseRecorder)(nil)
+
+func (w *responseRecorder) Header() http.Header {
+	return w.header
+}
+
+func (w *responseRecorder) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.code = statusCode
+}
+
+func (w *responseRecorder) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(p)
+}
+
+// Flush is a no-op, the response is sent once the handler returns.
+func (w *responseRecorder) Flush() {}
@@ -0,0 +1,284 @@
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	v2 "ocm.software/open-component-model/bindings/go/descriptor/v2"
+	digestprocessorv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/digestprocessor/v1"
+	ocmrepositoryv1 "ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/transport"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// serve serves the handler over the grpc transport on a unix socket and returns a client calling it.
+func serve(t *testing.T, handler http.Handler) *http.Client {
+	t.Helper()
+
+	// socket paths are limited in length, so the test directory can't be used.
+	dir, err := os.MkdirTemp("", "transport")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	socket := filepath.Join(dir, "plugin.socket")
+
+	var lc net.ListenConfig
+	listener, err := lc.Listen(t.Context(), "unix", socket)
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	require.NoError(t, transport.RegisterServer(server, handler))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	client, err := transport.NewClient("unix", socket)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close())
+	})
+
+	return &http.Client{Transport: client}
+}
+
+// call calls the endpoint like the plugin contracts do and decodes the response into result.
+func call(t *testing.T, client *http.Client, method, endpoint string, payload, result any) *http.Response {
+	t.Helper()
+
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		require.NoError(t, err)
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(t.Context(), method, "http://unix"+endpoint, body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", `{"username":"test"}`)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = resp.Body.Close()
+	})
+	if resp.StatusCode == http.StatusOK && result != nil {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(result))
+	}
+	return resp
+}
+
+func testResource() *v2.Resource {
+	return &v2.Resource{
+		ElementMeta: v2.ElementMeta{
+			ObjectMeta: v2.ObjectMeta{
+				Name:    "resource",
+				Version: "1.0.0",
+				Labels:  []v2.Label{{Name: "label", Value: json.RawMessage(`{"key":["value",1]}`), Signing: true}},
+			},
+			ExtraIdentity: runtime.Identity{"architecture": "amd64"},
+		},
+		SourceRefs: []v2.SourceRef{{IdentitySelector: map[string]string{"name": "source"}}},
+		Type:       "blob",
+		Relation:   v2.LocalRelation,
+		Access:     &runtime.Raw{Type: runtime.NewVersionedType("LocalBlob", "v1"), Data: []byte(`{"type":"LocalBlob/v1","localReference":"sha256:abc"}`)},
+		Digest:     &v2.Digest{HashAlgorithm: "SHA-256", NormalisationAlgorithm: "genericBlobDigest/v1", Value: "abc"},
+	}
+}
+
+func TestCall(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /resource/digest/process", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `{"username":"test"}`, r.Header.Get("Authorization"))
+		var request digestprocessorv1.ProcessResourceDigestRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			plugins.NewError(err, http.StatusBadRequest).Write(w)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(digestprocessorv1.ProcessResourceDigestResponse{Resource: request.Resource})
+	})
+	client := serve(t, mux)
+
+	var response digestprocessorv1.ProcessResourceDigestResponse
+	resp := call(t, client, http.MethodPost, "/resource/digest/process",
+		digestprocessorv1.ProcessResourceDigestRequest{Resource: testResource()}, &response)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// the resource passes the messages of the contract in both directions unchanged.
+	expected, err := json.Marshal(testResource())
+	require.NoError(t, err)
+	actual, err := json.Marshal(response.Resource)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+}
+
+func TestCallErrors(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /resource/digest/process", func(w http.ResponseWriter, r *http.Request) {
+		plugins.NewError(assert.AnError, http.StatusUnauthorized).Write(w)
+	})
+	client := serve(t, mux)
+
+	resp := call(t, client, http.MethodPost, "/resource/digest/process", digestprocessorv1.ProcessResourceDigestRequest{}, nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), assert.AnError.Error())
+
+	// endpoints of contracts the plugin doesn't implement aren't found like with the http transport.
+	resp = call(t, client, http.MethodPost, "/resource/process", nil, nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// endpoints that are no part of a contract can't be called.
+	_, err = client.Get("http://unix/unknown")
+	assert.ErrorIs(t, err, transport.ErrUnsupportedEndpoint)
+}
+
+func TestCallStreamsRequestBlob(t *testing.T) {
+	// larger than the maximum message size of grpc, so the blob has to be chunked.
+	blob := make([]byte, 10*1024*1024+1)
+	_, err := rand.Read(blob)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "blob")
+	require.NoError(t, os.WriteFile(path, blob, 0o600))
+
+	var staged string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /local-resource/upload", func(w http.ResponseWriter, r *http.Request) {
+		var request ocmrepositoryv1.PostLocalResourceRequest[*runtime.Raw]
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			plugins.NewError(err, http.StatusBadRequest).Write(w)
+			return
+		}
+		staged = request.ResourceLocation.Value
+		assert.Equal(t, types.LocationTypeLocalFile, request.ResourceLocation.LocationType)
+		assert.Equal(t, "application/octet-stream", request.ResourceLocation.MediaType)
+		content, err := os.ReadFile(staged)
+		if err != nil {
+			plugins.NewError(err, http.StatusInternalServerError).Write(w)
+			return
+		}
+		assert.Equal(t, blob, content)
+		_ = json.NewEncoder(w).Encode(request.Resource)
+	})
+	client := serve(t, mux)
+
+	var resource v2.Resource
+	resp := call(t, client, http.MethodPost, "/local-resource/upload", ocmrepositoryv1.PostLocalResourceRequest[runtime.Typed]{
+		Repository: &runtime.Raw{Type: runtime.NewVersionedType("Dummy", "v1"), Data: []byte(`{"type":"Dummy/v1"}`)},
+		Name:       "component",
+		Version:    "1.0.0",
+		ResourceLocation: types.Location{
+			LocationType: types.LocationTypeLocalFile,
+			Value:        path,
+			MediaType:    "application/octet-stream",
+		},
+		Resource: testResource(),
+	}, &resource)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "resource", resource.Name)
+
+	// the blob was streamed into a file of the plugin, which is removed after the call.
+	assert.NotEqual(t, path, staged)
+	assert.NoFileExists(t, staged)
+}
+
+func TestCallStreamsResponseBlob(t *testing.T) {
+	blob := make([]byte, 1024*1024+1)
+	_, err := rand.Read(blob)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "blob")
+	require.NoError(t, os.WriteFile(path, blob, 0o600))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /local-resource/download", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, "component", query.Get("name"))
+		assert.Equal(t, "1.0.0", query.Get("version"))
+		identity, err := base64.StdEncoding.DecodeString(query.Get("identity"))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"resource"}`, string(identity))
+
+		_ = json.NewEncoder(w).Encode(ocmrepositoryv1.GetLocalResourceResponse{
+			Location: types.Location{LocationType: types.LocationTypeLocalFile, Value: path},
+			Resource: testResource(),
+		})
+	})
+	client := serve(t, mux)
+
+	// the query parameters are passed like by the component version repository contract.
+	identity := base64.StdEncoding.EncodeToString([]byte(`{"name":"resource"}`))
+	var response ocmrepositoryv1.GetLocalResourceResponse
+	resp := call(t, client, http.MethodGet, "/local-resource/download?name=component&version=1.0.0&identity="+identity, nil, &response)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// the blob was streamed into a file of the caller, the file of the plugin is left in place.
+	assert.Equal(t, types.LocationTypeLocalFile, response.Location.LocationType)
+	assert.NotEqual(t, path, response.Location.Value)
+	t.Cleanup(func() {
+		_ = os.Remove(response.Location.Value)
+	})
+	content, err := os.ReadFile(response.Location.Value)
+	require.NoError(t, err)
+	assert.Equal(t, blob, content)
+	assert.FileExists(t, path)
+	assert.Equal(t, "resource", response.Resource.Name)
+}
+
+func TestCallCancellationIsPropagated(t *testing.T) {
+	started := make(chan struct{})
+	cancelled := make(chan error, 1)
+	client := serve(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+		cancelled <- r.Context().Err()
+	}))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://unix/healthz", nil)
+	require.NoError(t, err)
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.Do(req)
+		errs <- err
+	}()
+	<-started
+
+	cancel()
+
+	select {
+	case err := <-cancelled:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the cancellation of the call was not propagated to the plugin")
+	}
+	assert.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestCallUnavailablePlugin(t *testing.T) {
+	client, err := transport.NewClient("unix", filepath.Join(t.TempDir(), "missing.socket"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	_, err = (&http.Client{Transport: client}).Get("http://unix/healthz")
+	assert.ErrorIs(t, err, transport.ErrUnavailable)
+}
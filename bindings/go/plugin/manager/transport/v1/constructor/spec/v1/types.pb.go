@@ -0,0 +1,478 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/constructor/spec/v1/types.proto
+
+package constructorv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Resource is generated from ocm.software/open-component-model/bindings/go/constructor/spec/v1.Resource.
+type Resource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Labels        []*Label               `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	ExtraIdentity map[string]string      `protobuf:"bytes,4,rep,name=extra_identity,json=extraIdentity,proto3" json:"extra_identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SrcRefs       []*SourceRef           `protobuf:"bytes,5,rep,name=src_refs,json=srcRefs,proto3" json:"src_refs,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Relation      string                 `protobuf:"bytes,7,opt,name=relation,proto3" json:"relation,omitempty"`
+	Access        *types.JSON            `protobuf:"bytes,8,opt,name=access,proto3" json:"access,omitempty"`
+	Input         *types.JSON            `protobuf:"bytes,9,opt,name=input,proto3" json:"input,omitempty"`
+	CopyPolicy    string                 `protobuf:"bytes,10,opt,name=copy_policy,json=copyPolicy,proto3" json:"copy_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	mi := &file_v1_constructor_spec_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_constructor_spec_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_v1_constructor_spec_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Resource) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Resource) GetExtraIdentity() map[string]string {
+	if x != nil {
+		return x.ExtraIdentity
+	}
+	return nil
+}
+
+func (x *Resource) GetSrcRefs() []*SourceRef {
+	if x != nil {
+		return x.SrcRefs
+	}
+	return nil
+}
+
+func (x *Resource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Resource) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *Resource) GetAccess() *types.JSON {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+func (x *Resource) GetInput() *types.JSON {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *Resource) GetCopyPolicy() string {
+	if x != nil {
+		return x.CopyPolicy
+	}
+	return ""
+}
+
+// Label is generated from ocm.software/open-component-model/bindings/go/constructor/spec/v1.Label.
+type Label struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         *types.JSON            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Signing       bool                   `protobuf:"varint,3,opt,name=signing,proto3" json:"signing,omitempty"`
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	mi := &file_v1_constructor_spec_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_constructor_spec_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_v1_constructor_spec_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Label) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Label) GetValue() *types.JSON {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Label) GetSigning() bool {
+	if x != nil {
+		return x.Signing
+	}
+	return false
+}
+
+func (x *Label) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// SourceRef is generated from ocm.software/open-component-model/bindings/go/constructor/spec/v1.SourceRef.
+type SourceRef struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IdentitySelector map[string]string      `protobuf:"bytes,1,rep,name=identity_selector,json=identitySelector,proto3" json:"identity_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels           []*Label               `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SourceRef) Reset() {
+	*x = SourceRef{}
+	mi := &file_v1_constructor_spec_v1_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceRef) ProtoMessage() {}
+
+func (x *SourceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_constructor_spec_v1_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceRef.ProtoReflect.Descriptor instead.
+func (*SourceRef) Descriptor() ([]byte, []int) {
+	return file_v1_constructor_spec_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SourceRef) GetIdentitySelector() map[string]string {
+	if x != nil {
+		return x.IdentitySelector
+	}
+	return nil
+}
+
+func (x *SourceRef) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// Source is generated from ocm.software/open-component-model/bindings/go/constructor/spec/v1.Source.
+type Source struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Labels        []*Label               `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	ExtraIdentity map[string]string      `protobuf:"bytes,4,rep,name=extra_identity,json=extraIdentity,proto3" json:"extra_identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Access        *types.JSON            `protobuf:"bytes,6,opt,name=access,proto3" json:"access,omitempty"`
+	Input         *types.JSON            `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	CopyPolicy    string                 `protobuf:"bytes,8,opt,name=copy_policy,json=copyPolicy,proto3" json:"copy_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Source) Reset() {
+	*x = Source{}
+	mi := &file_v1_constructor_spec_v1_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Source) ProtoMessage() {}
+
+func (x *Source) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_constructor_spec_v1_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Source.ProtoReflect.Descriptor instead.
+func (*Source) Descriptor() ([]byte, []int) {
+	return file_v1_constructor_spec_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Source) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Source) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Source) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Source) GetExtraIdentity() map[string]string {
+	if x != nil {
+		return x.ExtraIdentity
+	}
+	return nil
+}
+
+func (x *Source) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Source) GetAccess() *types.JSON {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+func (x *Source) GetInput() *types.JSON {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *Source) GetCopyPolicy() string {
+	if x != nil {
+		return x.CopyPolicy
+	}
+	return ""
+}
+
+var File_v1_constructor_spec_v1_types_proto protoreflect.FileDescriptor
+
+const file_v1_constructor_spec_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"\"v1/constructor/spec/v1/types.proto\x124ocm.software.plugin.transport.v1.constructor.spec.v1\x1a\x14v1/types/types.proto\"\x80\x05\n" +
+	"\bResource\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12S\n" +
+	"\x06labels\x18\x03 \x03(\v2;.ocm.software.plugin.transport.v1.constructor.spec.v1.LabelR\x06labels\x12x\n" +
+	"\x0eextra_identity\x18\x04 \x03(\v2Q.ocm.software.plugin.transport.v1.constructor.spec.v1.Resource.ExtraIdentityEntryR\rextraIdentity\x12Z\n" +
+	"\bsrc_refs\x18\x05 \x03(\v2?.ocm.software.plugin.transport.v1.constructor.spec.v1.SourceRefR\asrcRefs\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1a\n" +
+	"\brelation\x18\a \x01(\tR\brelation\x12D\n" +
+	"\x06access\x18\b \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x06access\x12B\n" +
+	"\x05input\x18\t \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x05input\x12\x1f\n" +
+	"\vcopy_policy\x18\n" +
+	" \x01(\tR\n" +
+	"copyPolicy\x1a@\n" +
+	"\x12ExtraIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x93\x01\n" +
+	"\x05Label\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12B\n" +
+	"\x05value\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x05value\x12\x18\n" +
+	"\asigning\x18\x03 \x01(\bR\asigning\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\"\xaa\x02\n" +
+	"\tSourceRef\x12\x82\x01\n" +
+	"\x11identity_selector\x18\x01 \x03(\v2U.ocm.software.plugin.transport.v1.constructor.spec.v1.SourceRef.IdentitySelectorEntryR\x10identitySelector\x12S\n" +
+	"\x06labels\x18\x02 \x03(\v2;.ocm.software.plugin.transport.v1.constructor.spec.v1.LabelR\x06labels\x1aC\n" +
+	"\x15IdentitySelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x84\x04\n" +
+	"\x06Source\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12S\n" +
+	"\x06labels\x18\x03 \x03(\v2;.ocm.software.plugin.transport.v1.constructor.spec.v1.LabelR\x06labels\x12v\n" +
+	"\x0eextra_identity\x18\x04 \x03(\v2O.ocm.software.plugin.transport.v1.constructor.spec.v1.Source.ExtraIdentityEntryR\rextraIdentity\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12D\n" +
+	"\x06access\x18\x06 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x06access\x12B\n" +
+	"\x05input\x18\a \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x05input\x12\x1f\n" +
+	"\vcopy_policy\x18\b \x01(\tR\n" +
+	"copyPolicy\x1a@\n" +
+	"\x12ExtraIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01BmZkocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/constructor/spec/v1;constructorv1b\x06proto3"
+
+var (
+	file_v1_constructor_spec_v1_types_proto_rawDescOnce sync.Once
+	file_v1_constructor_spec_v1_types_proto_rawDescData []byte
+)
+
+func file_v1_constructor_spec_v1_types_proto_rawDescGZIP() []byte {
+	file_v1_constructor_spec_v1_types_proto_rawDescOnce.Do(func() {
+		file_v1_constructor_spec_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_constructor_spec_v1_types_proto_rawDesc), len(file_v1_constructor_spec_v1_types_proto_rawDesc)))
+	})
+	return file_v1_constructor_spec_v1_types_proto_rawDescData
+}
+
+var file_v1_constructor_spec_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_v1_constructor_spec_v1_types_proto_goTypes = []any{
+	(*Resource)(nil),   // 0: ocm.software.plugin.transport.v1.constructor.spec.v1.Resource
+	(*Label)(nil),      // 1: ocm.software.plugin.transport.v1.constructor.spec.v1.Label
+	(*SourceRef)(nil),  // 2: ocm.software.plugin.transport.v1.constructor.spec.v1.SourceRef
+	(*Source)(nil),     // 3: ocm.software.plugin.transport.v1.constructor.spec.v1.Source
+	nil,                // 4: ocm.software.plugin.transport.v1.constructor.spec.v1.Resource.ExtraIdentityEntry
+	nil,                // 5: ocm.software.plugin.transport.v1.constructor.spec.v1.SourceRef.IdentitySelectorEntry
+	nil,                // 6: ocm.software.plugin.transport.v1.constructor.spec.v1.Source.ExtraIdentityEntry
+	(*types.JSON)(nil), // 7: ocm.software.plugin.transport.v1.types.JSON
+}
+var file_v1_constructor_spec_v1_types_proto_depIdxs = []int32{
+	1,  // 0: ocm.software.plugin.transport.v1.constructor.spec.v1.Resource.labels:type_name -> ocm.software.plugin.transport.v1.constructor.spec.v1.Label
+	4,  // 1: ocm.software.plugin.transport.v1.constructor.spec.v1.Resource.extra_identity:type_name -> ocm.software.plugin.transport.v1.constructor.spec.v1.Resource.ExtraIdentityEntry
+	2,  // 2: ocm.software.plugin.transport.v1.constructor.spec.v1.Resource.src_refs:type_name -> ocm.software.plugin.transport.v1.constructor.spec.v1.SourceRef
+	7,  // 3: ocm.software.plugin.transport.v1.constructor.spec.v1.Resource.access:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	7,  // 4: ocm.software.plugin.transport.v1.constructor.spec.v1.Resource.input:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	7,  // 5: ocm.software.plugin.transport.v1.constructor.spec.v1.Label.value:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	5,  // 6: ocm.software.plugin.transport.v1.constructor.spec.v1.SourceRef.identity_selector:type_name -> ocm.software.plugin.transport.v1.constructor.spec.v1.SourceRef.IdentitySelectorEntry
+	1,  // 7: ocm.software.plugin.transport.v1.constructor.spec.v1.SourceRef.labels:type_name -> ocm.software.plugin.transport.v1.constructor.spec.v1.Label
+	1,  // 8: ocm.software.plugin.transport.v1.constructor.spec.v1.Source.labels:type_name -> ocm.software.plugin.transport.v1.constructor.spec.v1.Label
+	6,  // 9: ocm.software.plugin.transport.v1.constructor.spec.v1.Source.extra_identity:type_name -> ocm.software.plugin.transport.v1.constructor.spec.v1.Source.ExtraIdentityEntry
+	7,  // 10: ocm.software.plugin.transport.v1.constructor.spec.v1.Source.access:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	7,  // 11: ocm.software.plugin.transport.v1.constructor.spec.v1.Source.input:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_v1_constructor_spec_v1_types_proto_init() }
+func file_v1_constructor_spec_v1_types_proto_init() {
+	if File_v1_constructor_spec_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_constructor_spec_v1_types_proto_rawDesc), len(file_v1_constructor_spec_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_constructor_spec_v1_types_proto_goTypes,
+		DependencyIndexes: file_v1_constructor_spec_v1_types_proto_depIdxs,
+		MessageInfos:      file_v1_constructor_spec_v1_types_proto_msgTypes,
+	}.Build()
+	File_v1_constructor_spec_v1_types_proto = out.File
+	file_v1_constructor_spec_v1_types_proto_goTypes = nil
+	file_v1_constructor_spec_v1_types_proto_depIdxs = nil
+}
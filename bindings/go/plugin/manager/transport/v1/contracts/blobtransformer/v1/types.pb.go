@@ -0,0 +1,188 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/contracts/blobtransformer/v1/types.proto
+
+package blobtransformerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TransformBlobRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/blobtransformer/v1.TransformBlobRequest.
+type TransformBlobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *types.Location        `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Specification *types.JSON            `protobuf:"bytes,2,opt,name=specification,proto3" json:"specification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformBlobRequest) Reset() {
+	*x = TransformBlobRequest{}
+	mi := &file_v1_contracts_blobtransformer_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformBlobRequest) ProtoMessage() {}
+
+func (x *TransformBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_blobtransformer_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformBlobRequest.ProtoReflect.Descriptor instead.
+func (*TransformBlobRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_blobtransformer_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransformBlobRequest) GetLocation() *types.Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *TransformBlobRequest) GetSpecification() *types.JSON {
+	if x != nil {
+		return x.Specification
+	}
+	return nil
+}
+
+// TransformBlobResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/blobtransformer/v1.TransformBlobResponse.
+type TransformBlobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *types.Location        `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformBlobResponse) Reset() {
+	*x = TransformBlobResponse{}
+	mi := &file_v1_contracts_blobtransformer_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformBlobResponse) ProtoMessage() {}
+
+func (x *TransformBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_blobtransformer_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformBlobResponse.ProtoReflect.Descriptor instead.
+func (*TransformBlobResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_blobtransformer_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransformBlobResponse) GetLocation() *types.Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+var File_v1_contracts_blobtransformer_v1_types_proto protoreflect.FileDescriptor
+
+const file_v1_contracts_blobtransformer_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"+v1/contracts/blobtransformer/v1/types.proto\x12=ocm.software.plugin.transport.v1.contracts.blobtransformer.v1\x1a\x14v1/types/types.proto\"\xb8\x01\n" +
+	"\x14TransformBlobRequest\x12L\n" +
+	"\blocation\x18\x01 \x01(\v20.ocm.software.plugin.transport.v1.types.LocationR\blocation\x12R\n" +
+	"\rspecification\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\rspecification\"e\n" +
+	"\x15TransformBlobResponse\x12L\n" +
+	"\blocation\x18\x01 \x01(\v20.ocm.software.plugin.transport.v1.types.LocationR\blocationBzZxocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/blobtransformer/v1;blobtransformerv1b\x06proto3"
+
+var (
+	file_v1_contracts_blobtransformer_v1_types_proto_rawDescOnce sync.Once
+	file_v1_contracts_blobtransformer_v1_types_proto_rawDescData []byte
+)
+
+func file_v1_contracts_blobtransformer_v1_types_proto_rawDescGZIP() []byte {
+	file_v1_contracts_blobtransformer_v1_types_proto_rawDescOnce.Do(func() {
+		file_v1_contracts_blobtransformer_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_contracts_blobtransformer_v1_types_proto_rawDesc), len(file_v1_contracts_blobtransformer_v1_types_proto_rawDesc)))
+	})
+	return file_v1_contracts_blobtransformer_v1_types_proto_rawDescData
+}
+
+var file_v1_contracts_blobtransformer_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_contracts_blobtransformer_v1_types_proto_goTypes = []any{
+	(*TransformBlobRequest)(nil),  // 0: ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobRequest
+	(*TransformBlobResponse)(nil), // 1: ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobResponse
+	(*types.Location)(nil),        // 2: ocm.software.plugin.transport.v1.types.Location
+	(*types.JSON)(nil),            // 3: ocm.software.plugin.transport.v1.types.JSON
+}
+var file_v1_contracts_blobtransformer_v1_types_proto_depIdxs = []int32{
+	2, // 0: ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobRequest.location:type_name -> ocm.software.plugin.transport.v1.types.Location
+	3, // 1: ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobRequest.specification:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	2, // 2: ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobResponse.location:type_name -> ocm.software.plugin.transport.v1.types.Location
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_v1_contracts_blobtransformer_v1_types_proto_init() }
+func file_v1_contracts_blobtransformer_v1_types_proto_init() {
+	if File_v1_contracts_blobtransformer_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_contracts_blobtransformer_v1_types_proto_rawDesc), len(file_v1_contracts_blobtransformer_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_contracts_blobtransformer_v1_types_proto_goTypes,
+		DependencyIndexes: file_v1_contracts_blobtransformer_v1_types_proto_depIdxs,
+		MessageInfos:      file_v1_contracts_blobtransformer_v1_types_proto_msgTypes,
+	}.Build()
+	File_v1_contracts_blobtransformer_v1_types_proto = out.File
+	file_v1_contracts_blobtransformer_v1_types_proto_goTypes = nil
+	file_v1_contracts_blobtransformer_v1_types_proto_depIdxs = nil
+}
@@ -0,0 +1,245 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/contracts/componentlister/v1/types.proto
+
+package componentlisterv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListComponentsRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/componentlister/v1.ListComponentsRequest.
+type ListComponentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    *types.JSON            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Last          string                 `protobuf:"bytes,2,opt,name=last,proto3" json:"last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentsRequest) Reset() {
+	*x = ListComponentsRequest{}
+	mi := &file_v1_contracts_componentlister_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentsRequest) ProtoMessage() {}
+
+func (x *ListComponentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_componentlister_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentsRequest.ProtoReflect.Descriptor instead.
+func (*ListComponentsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_componentlister_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListComponentsRequest) GetRepository() *types.JSON {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *ListComponentsRequest) GetLast() string {
+	if x != nil {
+		return x.Last
+	}
+	return ""
+}
+
+// ListComponentsResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/componentlister/v1.ListComponentsResponse.
+type ListComponentsResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	List          []string                      `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Header        *ListComponentsResponseHeader `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentsResponse) Reset() {
+	*x = ListComponentsResponse{}
+	mi := &file_v1_contracts_componentlister_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentsResponse) ProtoMessage() {}
+
+func (x *ListComponentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_componentlister_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentsResponse.ProtoReflect.Descriptor instead.
+func (*ListComponentsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_componentlister_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListComponentsResponse) GetList() []string {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListComponentsResponse) GetHeader() *ListComponentsResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+// ListComponentsResponseHeader is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/componentlister/v1.ListComponentsResponseHeader.
+type ListComponentsResponseHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Last          string                 `protobuf:"bytes,1,opt,name=last,proto3" json:"last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentsResponseHeader) Reset() {
+	*x = ListComponentsResponseHeader{}
+	mi := &file_v1_contracts_componentlister_v1_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentsResponseHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentsResponseHeader) ProtoMessage() {}
+
+func (x *ListComponentsResponseHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_componentlister_v1_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentsResponseHeader.ProtoReflect.Descriptor instead.
+func (*ListComponentsResponseHeader) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_componentlister_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListComponentsResponseHeader) GetLast() string {
+	if x != nil {
+		return x.Last
+	}
+	return ""
+}
+
+var File_v1_contracts_componentlister_v1_types_proto protoreflect.FileDescriptor
+
+const file_v1_contracts_componentlister_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"+v1/contracts/componentlister/v1/types.proto\x12=ocm.software.plugin.transport.v1.contracts.componentlister.v1\x1a\x14v1/types/types.proto\"y\n" +
+	"\x15ListComponentsRequest\x12L\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\n" +
+	"repository\x12\x12\n" +
+	"\x04last\x18\x02 \x01(\tR\x04last\"\xa1\x01\n" +
+	"\x16ListComponentsResponse\x12\x12\n" +
+	"\x04list\x18\x01 \x03(\tR\x04list\x12s\n" +
+	"\x06header\x18\x02 \x01(\v2[.ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsResponseHeaderR\x06header\"2\n" +
+	"\x1cListComponentsResponseHeader\x12\x12\n" +
+	"\x04last\x18\x01 \x01(\tR\x04lastBzZxocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/componentlister/v1;componentlisterv1b\x06proto3"
+
+var (
+	file_v1_contracts_componentlister_v1_types_proto_rawDescOnce sync.Once
+	file_v1_contracts_componentlister_v1_types_proto_rawDescData []byte
+)
+
+func file_v1_contracts_componentlister_v1_types_proto_rawDescGZIP() []byte {
+	file_v1_contracts_componentlister_v1_types_proto_rawDescOnce.Do(func() {
+		file_v1_contracts_componentlister_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_contracts_componentlister_v1_types_proto_rawDesc), len(file_v1_contracts_componentlister_v1_types_proto_rawDesc)))
+	})
+	return file_v1_contracts_componentlister_v1_types_proto_rawDescData
+}
+
+var file_v1_contracts_componentlister_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_v1_contracts_componentlister_v1_types_proto_goTypes = []any{
+	(*ListComponentsRequest)(nil),        // 0: ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsRequest
+	(*ListComponentsResponse)(nil),       // 1: ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsResponse
+	(*ListComponentsResponseHeader)(nil), // 2: ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsResponseHeader
+	(*types.JSON)(nil),                   // 3: ocm.software.plugin.transport.v1.types.JSON
+}
+var file_v1_contracts_componentlister_v1_types_proto_depIdxs = []int32{
+	3, // 0: ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsRequest.repository:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	2, // 1: ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsResponse.header:type_name -> ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsResponseHeader
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_v1_contracts_componentlister_v1_types_proto_init() }
+func file_v1_contracts_componentlister_v1_types_proto_init() {
+	if File_v1_contracts_componentlister_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_contracts_componentlister_v1_types_proto_rawDesc), len(file_v1_contracts_componentlister_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_contracts_componentlister_v1_types_proto_goTypes,
+		DependencyIndexes: file_v1_contracts_componentlister_v1_types_proto_depIdxs,
+		MessageInfos:      file_v1_contracts_componentlister_v1_types_proto_msgTypes,
+	}.Build()
+	File_v1_contracts_componentlister_v1_types_proto = out.File
+	file_v1_contracts_componentlister_v1_types_proto_goTypes = nil
+	file_v1_contracts_componentlister_v1_types_proto_depIdxs = nil
+}
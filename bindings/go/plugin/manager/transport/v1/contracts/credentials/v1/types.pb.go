@@ -0,0 +1,191 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/contracts/credentials/v1/types.proto
+
+package credentialsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConsumerIdentityForConfigRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/credentials/v1.ConsumerIdentityForConfigRequest.
+type ConsumerIdentityForConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *types.JSON            `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumerIdentityForConfigRequest) Reset() {
+	*x = ConsumerIdentityForConfigRequest{}
+	mi := &file_v1_contracts_credentials_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerIdentityForConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerIdentityForConfigRequest) ProtoMessage() {}
+
+func (x *ConsumerIdentityForConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_credentials_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerIdentityForConfigRequest.ProtoReflect.Descriptor instead.
+func (*ConsumerIdentityForConfigRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_credentials_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConsumerIdentityForConfigRequest) GetConfig() *types.JSON {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// ResolveRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/credentials/v1.ResolveRequest.
+type ResolveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *types.JSON            `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Identity      map[string]string      `protobuf:"bytes,2,rep,name=identity,proto3" json:"identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveRequest) Reset() {
+	*x = ResolveRequest{}
+	mi := &file_v1_contracts_credentials_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRequest) ProtoMessage() {}
+
+func (x *ResolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_credentials_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRequest.ProtoReflect.Descriptor instead.
+func (*ResolveRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_credentials_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResolveRequest) GetConfig() *types.JSON {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ResolveRequest) GetIdentity() map[string]string {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+var File_v1_contracts_credentials_v1_types_proto protoreflect.FileDescriptor
+
+const file_v1_contracts_credentials_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"'v1/contracts/credentials/v1/types.proto\x129ocm.software.plugin.transport.v1.contracts.credentials.v1\x1a\x14v1/types/types.proto\"h\n" +
+	" ConsumerIdentityForConfigRequest\x12D\n" +
+	"\x06config\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x06config\"\x88\x02\n" +
+	"\x0eResolveRequest\x12D\n" +
+	"\x06config\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x06config\x12s\n" +
+	"\bidentity\x18\x02 \x03(\v2W.ocm.software.plugin.transport.v1.contracts.credentials.v1.ResolveRequest.IdentityEntryR\bidentity\x1a;\n" +
+	"\rIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01BrZpocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/credentials/v1;credentialsv1b\x06proto3"
+
+var (
+	file_v1_contracts_credentials_v1_types_proto_rawDescOnce sync.Once
+	file_v1_contracts_credentials_v1_types_proto_rawDescData []byte
+)
+
+func file_v1_contracts_credentials_v1_types_proto_rawDescGZIP() []byte {
+	file_v1_contracts_credentials_v1_types_proto_rawDescOnce.Do(func() {
+		file_v1_contracts_credentials_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_contracts_credentials_v1_types_proto_rawDesc), len(file_v1_contracts_credentials_v1_types_proto_rawDesc)))
+	})
+	return file_v1_contracts_credentials_v1_types_proto_rawDescData
+}
+
+var file_v1_contracts_credentials_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_v1_contracts_credentials_v1_types_proto_goTypes = []any{
+	(*ConsumerIdentityForConfigRequest)(nil), // 0: ocm.software.plugin.transport.v1.contracts.credentials.v1.ConsumerIdentityForConfigRequest
+	(*ResolveRequest)(nil),                   // 1: ocm.software.plugin.transport.v1.contracts.credentials.v1.ResolveRequest
+	nil,                                      // 2: ocm.software.plugin.transport.v1.contracts.credentials.v1.ResolveRequest.IdentityEntry
+	(*types.JSON)(nil),                       // 3: ocm.software.plugin.transport.v1.types.JSON
+}
+var file_v1_contracts_credentials_v1_types_proto_depIdxs = []int32{
+	3, // 0: ocm.software.plugin.transport.v1.contracts.credentials.v1.ConsumerIdentityForConfigRequest.config:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	3, // 1: ocm.software.plugin.transport.v1.contracts.credentials.v1.ResolveRequest.config:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	2, // 2: ocm.software.plugin.transport.v1.contracts.credentials.v1.ResolveRequest.identity:type_name -> ocm.software.plugin.transport.v1.contracts.credentials.v1.ResolveRequest.IdentityEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_v1_contracts_credentials_v1_types_proto_init() }
+func file_v1_contracts_credentials_v1_types_proto_init() {
+	if File_v1_contracts_credentials_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_contracts_credentials_v1_types_proto_rawDesc), len(file_v1_contracts_credentials_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_contracts_credentials_v1_types_proto_goTypes,
+		DependencyIndexes: file_v1_contracts_credentials_v1_types_proto_depIdxs,
+		MessageInfos:      file_v1_contracts_credentials_v1_types_proto_msgTypes,
+	}.Build()
+	File_v1_contracts_credentials_v1_types_proto = out.File
+	file_v1_contracts_credentials_v1_types_proto_goTypes = nil
+	file_v1_contracts_credentials_v1_types_proto_depIdxs = nil
+}
@@ -0,0 +1,177 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/contracts/digestprocessor/v1/types.proto
+
+package digestprocessorv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	v2 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/descriptor/v2"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProcessResourceDigestRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/digestprocessor/v1.ProcessResourceDigestRequest.
+type ProcessResourceDigestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *v2.Resource           `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResourceDigestRequest) Reset() {
+	*x = ProcessResourceDigestRequest{}
+	mi := &file_v1_contracts_digestprocessor_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResourceDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResourceDigestRequest) ProtoMessage() {}
+
+func (x *ProcessResourceDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_digestprocessor_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResourceDigestRequest.ProtoReflect.Descriptor instead.
+func (*ProcessResourceDigestRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_digestprocessor_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProcessResourceDigestRequest) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// ProcessResourceDigestResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/digestprocessor/v1.ProcessResourceDigestResponse.
+type ProcessResourceDigestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *v2.Resource           `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResourceDigestResponse) Reset() {
+	*x = ProcessResourceDigestResponse{}
+	mi := &file_v1_contracts_digestprocessor_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResourceDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResourceDigestResponse) ProtoMessage() {}
+
+func (x *ProcessResourceDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_digestprocessor_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResourceDigestResponse.ProtoReflect.Descriptor instead.
+func (*ProcessResourceDigestResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_digestprocessor_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProcessResourceDigestResponse) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+var File_v1_contracts_digestprocessor_v1_types_proto protoreflect.FileDescriptor
+
+const file_v1_contracts_digestprocessor_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"+v1/contracts/digestprocessor/v1/types.proto\x12=ocm.software.plugin.transport.v1.contracts.digestprocessor.v1\x1a\x1cv1/descriptor/v2/types.proto\"t\n" +
+	"\x1cProcessResourceDigestRequest\x12T\n" +
+	"\bresource\x18\x01 \x01(\v28.ocm.software.plugin.transport.v1.descriptor.v2.ResourceR\bresource\"u\n" +
+	"\x1dProcessResourceDigestResponse\x12T\n" +
+	"\bresource\x18\x01 \x01(\v28.ocm.software.plugin.transport.v1.descriptor.v2.ResourceR\bresourceBzZxocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/digestprocessor/v1;digestprocessorv1b\x06proto3"
+
+var (
+	file_v1_contracts_digestprocessor_v1_types_proto_rawDescOnce sync.Once
+	file_v1_contracts_digestprocessor_v1_types_proto_rawDescData []byte
+)
+
+func file_v1_contracts_digestprocessor_v1_types_proto_rawDescGZIP() []byte {
+	file_v1_contracts_digestprocessor_v1_types_proto_rawDescOnce.Do(func() {
+		file_v1_contracts_digestprocessor_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_contracts_digestprocessor_v1_types_proto_rawDesc), len(file_v1_contracts_digestprocessor_v1_types_proto_rawDesc)))
+	})
+	return file_v1_contracts_digestprocessor_v1_types_proto_rawDescData
+}
+
+var file_v1_contracts_digestprocessor_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_contracts_digestprocessor_v1_types_proto_goTypes = []any{
+	(*ProcessResourceDigestRequest)(nil),  // 0: ocm.software.plugin.transport.v1.contracts.digestprocessor.v1.ProcessResourceDigestRequest
+	(*ProcessResourceDigestResponse)(nil), // 1: ocm.software.plugin.transport.v1.contracts.digestprocessor.v1.ProcessResourceDigestResponse
+	(*v2.Resource)(nil),                   // 2: ocm.software.plugin.transport.v1.descriptor.v2.Resource
+}
+var file_v1_contracts_digestprocessor_v1_types_proto_depIdxs = []int32{
+	2, // 0: ocm.software.plugin.transport.v1.contracts.digestprocessor.v1.ProcessResourceDigestRequest.resource:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	2, // 1: ocm.software.plugin.transport.v1.contracts.digestprocessor.v1.ProcessResourceDigestResponse.resource:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_v1_contracts_digestprocessor_v1_types_proto_init() }
+func file_v1_contracts_digestprocessor_v1_types_proto_init() {
+	if File_v1_contracts_digestprocessor_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_contracts_digestprocessor_v1_types_proto_rawDesc), len(file_v1_contracts_digestprocessor_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_contracts_digestprocessor_v1_types_proto_goTypes,
+		DependencyIndexes: file_v1_contracts_digestprocessor_v1_types_proto_depIdxs,
+		MessageInfos:      file_v1_contracts_digestprocessor_v1_types_proto_msgTypes,
+	}.Build()
+	File_v1_contracts_digestprocessor_v1_types_proto = out.File
+	file_v1_contracts_digestprocessor_v1_types_proto_goTypes = nil
+	file_v1_contracts_digestprocessor_v1_types_proto_depIdxs = nil
+}
@@ -0,0 +1,301 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/contracts/input/v1/types.proto
+
+package inputv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/constructor/spec/v1"
+	v2 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/descriptor/v2"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProcessResourceInputRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/input/v1.ProcessResourceInputRequest.
+type ProcessResourceInputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *v1.Resource           `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResourceInputRequest) Reset() {
+	*x = ProcessResourceInputRequest{}
+	mi := &file_v1_contracts_input_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResourceInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResourceInputRequest) ProtoMessage() {}
+
+func (x *ProcessResourceInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_input_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResourceInputRequest.ProtoReflect.Descriptor instead.
+func (*ProcessResourceInputRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_input_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProcessResourceInputRequest) GetResource() *v1.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// ProcessResourceInputResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/input/v1.ProcessResourceInputResponse.
+type ProcessResourceInputResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *v2.Resource           `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Location      *types.Location        `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResourceInputResponse) Reset() {
+	*x = ProcessResourceInputResponse{}
+	mi := &file_v1_contracts_input_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResourceInputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResourceInputResponse) ProtoMessage() {}
+
+func (x *ProcessResourceInputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_input_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResourceInputResponse.ProtoReflect.Descriptor instead.
+func (*ProcessResourceInputResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_input_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProcessResourceInputResponse) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *ProcessResourceInputResponse) GetLocation() *types.Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+// ProcessSourceInputRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/input/v1.ProcessSourceInputRequest.
+type ProcessSourceInputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        *v1.Source             `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessSourceInputRequest) Reset() {
+	*x = ProcessSourceInputRequest{}
+	mi := &file_v1_contracts_input_v1_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessSourceInputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessSourceInputRequest) ProtoMessage() {}
+
+func (x *ProcessSourceInputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_input_v1_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessSourceInputRequest.ProtoReflect.Descriptor instead.
+func (*ProcessSourceInputRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_input_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProcessSourceInputRequest) GetSource() *v1.Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+// ProcessSourceInputResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/input/v1.ProcessSourceInputResponse.
+type ProcessSourceInputResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        *v2.Source             `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Location      *types.Location        `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessSourceInputResponse) Reset() {
+	*x = ProcessSourceInputResponse{}
+	mi := &file_v1_contracts_input_v1_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessSourceInputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessSourceInputResponse) ProtoMessage() {}
+
+func (x *ProcessSourceInputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_input_v1_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessSourceInputResponse.ProtoReflect.Descriptor instead.
+func (*ProcessSourceInputResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_input_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProcessSourceInputResponse) GetSource() *v2.Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ProcessSourceInputResponse) GetLocation() *types.Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+var File_v1_contracts_input_v1_types_proto protoreflect.FileDescriptor
+
+const file_v1_contracts_input_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"!v1/contracts/input/v1/types.proto\x123ocm.software.plugin.transport.v1.contracts.input.v1\x1a\"v1/constructor/spec/v1/types.proto\x1a\x1cv1/descriptor/v2/types.proto\x1a\x14v1/types/types.proto\"y\n" +
+	"\x1bProcessResourceInputRequest\x12Z\n" +
+	"\bresource\x18\x01 \x01(\v2>.ocm.software.plugin.transport.v1.constructor.spec.v1.ResourceR\bresource\"\xc2\x01\n" +
+	"\x1cProcessResourceInputResponse\x12T\n" +
+	"\bresource\x18\x01 \x01(\v28.ocm.software.plugin.transport.v1.descriptor.v2.ResourceR\bresource\x12L\n" +
+	"\blocation\x18\x02 \x01(\v20.ocm.software.plugin.transport.v1.types.LocationR\blocation\"q\n" +
+	"\x19ProcessSourceInputRequest\x12T\n" +
+	"\x06source\x18\x01 \x01(\v2<.ocm.software.plugin.transport.v1.constructor.spec.v1.SourceR\x06source\"\xba\x01\n" +
+	"\x1aProcessSourceInputResponse\x12N\n" +
+	"\x06source\x18\x01 \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.SourceR\x06source\x12L\n" +
+	"\blocation\x18\x02 \x01(\v20.ocm.software.plugin.transport.v1.types.LocationR\blocationBfZdocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/input/v1;inputv1b\x06proto3"
+
+var (
+	file_v1_contracts_input_v1_types_proto_rawDescOnce sync.Once
+	file_v1_contracts_input_v1_types_proto_rawDescData []byte
+)
+
+func file_v1_contracts_input_v1_types_proto_rawDescGZIP() []byte {
+	file_v1_contracts_input_v1_types_proto_rawDescOnce.Do(func() {
+		file_v1_contracts_input_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_contracts_input_v1_types_proto_rawDesc), len(file_v1_contracts_input_v1_types_proto_rawDesc)))
+	})
+	return file_v1_contracts_input_v1_types_proto_rawDescData
+}
+
+var file_v1_contracts_input_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v1_contracts_input_v1_types_proto_goTypes = []any{
+	(*ProcessResourceInputRequest)(nil),  // 0: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputRequest
+	(*ProcessResourceInputResponse)(nil), // 1: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputResponse
+	(*ProcessSourceInputRequest)(nil),    // 2: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputRequest
+	(*ProcessSourceInputResponse)(nil),   // 3: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputResponse
+	(*v1.Resource)(nil),                  // 4: ocm.software.plugin.transport.v1.constructor.spec.v1.Resource
+	(*v2.Resource)(nil),                  // 5: ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	(*types.Location)(nil),               // 6: ocm.software.plugin.transport.v1.types.Location
+	(*v1.Source)(nil),                    // 7: ocm.software.plugin.transport.v1.constructor.spec.v1.Source
+	(*v2.Source)(nil),                    // 8: ocm.software.plugin.transport.v1.descriptor.v2.Source
+}
+var file_v1_contracts_input_v1_types_proto_depIdxs = []int32{
+	4, // 0: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputRequest.resource:type_name -> ocm.software.plugin.transport.v1.constructor.spec.v1.Resource
+	5, // 1: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputResponse.resource:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	6, // 2: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputResponse.location:type_name -> ocm.software.plugin.transport.v1.types.Location
+	7, // 3: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputRequest.source:type_name -> ocm.software.plugin.transport.v1.constructor.spec.v1.Source
+	8, // 4: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputResponse.source:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Source
+	6, // 5: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputResponse.location:type_name -> ocm.software.plugin.transport.v1.types.Location
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_v1_contracts_input_v1_types_proto_init() }
+func file_v1_contracts_input_v1_types_proto_init() {
+	if File_v1_contracts_input_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_contracts_input_v1_types_proto_rawDesc), len(file_v1_contracts_input_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_contracts_input_v1_types_proto_goTypes,
+		DependencyIndexes: file_v1_contracts_input_v1_types_proto_depIdxs,
+		MessageInfos:      file_v1_contracts_input_v1_types_proto_msgTypes,
+	}.Build()
+	File_v1_contracts_input_v1_types_proto = out.File
+	file_v1_contracts_input_v1_types_proto_goTypes = nil
+	file_v1_contracts_input_v1_types_proto_depIdxs = nil
+}
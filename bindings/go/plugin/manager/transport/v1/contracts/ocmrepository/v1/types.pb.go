@@ -0,0 +1,596 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/contracts/ocmrepository/v1/types.proto
+
+package ocmrepositoryv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	v2 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/descriptor/v2"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetIdentityRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1.GetIdentityRequest.
+type GetIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *types.JSON            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityRequest) Reset() {
+	*x = GetIdentityRequest{}
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityRequest) ProtoMessage() {}
+
+func (x *GetIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityRequest.ProtoReflect.Descriptor instead.
+func (*GetIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_ocmrepository_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetIdentityRequest) GetType() *types.JSON {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+// GetIdentityResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1.GetIdentityResponse.
+type GetIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      map[string]string      `protobuf:"bytes,1,rep,name=identity,proto3" json:"identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityResponse) Reset() {
+	*x = GetIdentityResponse{}
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityResponse) ProtoMessage() {}
+
+func (x *GetIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityResponse.ProtoReflect.Descriptor instead.
+func (*GetIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_ocmrepository_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetIdentityResponse) GetIdentity() map[string]string {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+// PostComponentVersionRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1.PostComponentVersionRequest.
+type PostComponentVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    *types.JSON            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Descriptor_   *v2.Descriptor         `protobuf:"bytes,2,opt,name=descriptor,proto3" json:"descriptor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostComponentVersionRequest) Reset() {
+	*x = PostComponentVersionRequest{}
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostComponentVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostComponentVersionRequest) ProtoMessage() {}
+
+func (x *PostComponentVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostComponentVersionRequest.ProtoReflect.Descriptor instead.
+func (*PostComponentVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_ocmrepository_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PostComponentVersionRequest) GetRepository() *types.JSON {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *PostComponentVersionRequest) GetDescriptor_() *v2.Descriptor {
+	if x != nil {
+		return x.Descriptor_
+	}
+	return nil
+}
+
+// PostLocalResourceRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1.PostLocalResourceRequest.
+type PostLocalResourceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Repository       *types.JSON            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version          string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	ResourceLocation *types.Location        `protobuf:"bytes,4,opt,name=resource_location,json=resourceLocation,proto3" json:"resource_location,omitempty"`
+	Resource         *v2.Resource           `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PostLocalResourceRequest) Reset() {
+	*x = PostLocalResourceRequest{}
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostLocalResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLocalResourceRequest) ProtoMessage() {}
+
+func (x *PostLocalResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLocalResourceRequest.ProtoReflect.Descriptor instead.
+func (*PostLocalResourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_ocmrepository_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PostLocalResourceRequest) GetRepository() *types.JSON {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *PostLocalResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostLocalResourceRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PostLocalResourceRequest) GetResourceLocation() *types.Location {
+	if x != nil {
+		return x.ResourceLocation
+	}
+	return nil
+}
+
+func (x *PostLocalResourceRequest) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// GetLocalResourceResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1.GetLocalResourceResponse.
+type GetLocalResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *types.Location        `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Resource      *v2.Resource           `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocalResourceResponse) Reset() {
+	*x = GetLocalResourceResponse{}
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocalResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalResourceResponse) ProtoMessage() {}
+
+func (x *GetLocalResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalResourceResponse.ProtoReflect.Descriptor instead.
+func (*GetLocalResourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_ocmrepository_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetLocalResourceResponse) GetLocation() *types.Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetLocalResourceResponse) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// PostLocalSourceRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1.PostLocalSourceRequest.
+type PostLocalSourceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Repository     *types.JSON            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version        string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	SourceLocation *types.Location        `protobuf:"bytes,4,opt,name=source_location,json=sourceLocation,proto3" json:"source_location,omitempty"`
+	Source         *v2.Source             `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PostLocalSourceRequest) Reset() {
+	*x = PostLocalSourceRequest{}
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostLocalSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostLocalSourceRequest) ProtoMessage() {}
+
+func (x *PostLocalSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostLocalSourceRequest.ProtoReflect.Descriptor instead.
+func (*PostLocalSourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_ocmrepository_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PostLocalSourceRequest) GetRepository() *types.JSON {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *PostLocalSourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PostLocalSourceRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PostLocalSourceRequest) GetSourceLocation() *types.Location {
+	if x != nil {
+		return x.SourceLocation
+	}
+	return nil
+}
+
+func (x *PostLocalSourceRequest) GetSource() *v2.Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+// GetLocalSourceResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1.GetLocalSourceResponse.
+type GetLocalSourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *types.Location        `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Source        *v2.Source             `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocalSourceResponse) Reset() {
+	*x = GetLocalSourceResponse{}
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocalSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalSourceResponse) ProtoMessage() {}
+
+func (x *GetLocalSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalSourceResponse.ProtoReflect.Descriptor instead.
+func (*GetLocalSourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_ocmrepository_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetLocalSourceResponse) GetLocation() *types.Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetLocalSourceResponse) GetSource() *v2.Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+// PostCheckHealthRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/ocmrepository/v1.PostCheckHealthRequest.
+type PostCheckHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    *types.JSON            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostCheckHealthRequest) Reset() {
+	*x = PostCheckHealthRequest{}
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostCheckHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCheckHealthRequest) ProtoMessage() {}
+
+func (x *PostCheckHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_ocmrepository_v1_types_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCheckHealthRequest.ProtoReflect.Descriptor instead.
+func (*PostCheckHealthRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_ocmrepository_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PostCheckHealthRequest) GetRepository() *types.JSON {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+var File_v1_contracts_ocmrepository_v1_types_proto protoreflect.FileDescriptor
+
+const file_v1_contracts_ocmrepository_v1_types_proto_rawDesc = "" +
+	"\n" +
+	")v1/contracts/ocmrepository/v1/types.proto\x12;ocm.software.plugin.transport.v1.contracts.ocmrepository.v1\x1a\x1cv1/descriptor/v2/types.proto\x1a\x14v1/types/types.proto\"V\n" +
+	"\x12GetIdentityRequest\x12@\n" +
+	"\x04type\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x04type\"\xce\x01\n" +
+	"\x13GetIdentityResponse\x12z\n" +
+	"\bidentity\x18\x01 \x03(\v2^.ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityResponse.IdentityEntryR\bidentity\x1a;\n" +
+	"\rIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc7\x01\n" +
+	"\x1bPostComponentVersionRequest\x12L\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\n" +
+	"repository\x12Z\n" +
+	"\n" +
+	"descriptor\x18\x02 \x01(\v2:.ocm.software.plugin.transport.v1.descriptor.v2.DescriptorR\n" +
+	"descriptor\"\xcb\x02\n" +
+	"\x18PostLocalResourceRequest\x12L\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\n" +
+	"repository\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12]\n" +
+	"\x11resource_location\x18\x04 \x01(\v20.ocm.software.plugin.transport.v1.types.LocationR\x10resourceLocation\x12T\n" +
+	"\bresource\x18\x05 \x01(\v28.ocm.software.plugin.transport.v1.descriptor.v2.ResourceR\bresource\"\xbe\x01\n" +
+	"\x18GetLocalResourceResponse\x12L\n" +
+	"\blocation\x18\x01 \x01(\v20.ocm.software.plugin.transport.v1.types.LocationR\blocation\x12T\n" +
+	"\bresource\x18\x02 \x01(\v28.ocm.software.plugin.transport.v1.descriptor.v2.ResourceR\bresource\"\xbf\x02\n" +
+	"\x16PostLocalSourceRequest\x12L\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\n" +
+	"repository\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12Y\n" +
+	"\x0fsource_location\x18\x04 \x01(\v20.ocm.software.plugin.transport.v1.types.LocationR\x0esourceLocation\x12N\n" +
+	"\x06source\x18\x05 \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.SourceR\x06source\"\xb6\x01\n" +
+	"\x16GetLocalSourceResponse\x12L\n" +
+	"\blocation\x18\x01 \x01(\v20.ocm.software.plugin.transport.v1.types.LocationR\blocation\x12N\n" +
+	"\x06source\x18\x02 \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.SourceR\x06source\"f\n" +
+	"\x16PostCheckHealthRequest\x12L\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\n" +
+	"repositoryBvZtocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/ocmrepository/v1;ocmrepositoryv1b\x06proto3"
+
+var (
+	file_v1_contracts_ocmrepository_v1_types_proto_rawDescOnce sync.Once
+	file_v1_contracts_ocmrepository_v1_types_proto_rawDescData []byte
+)
+
+func file_v1_contracts_ocmrepository_v1_types_proto_rawDescGZIP() []byte {
+	file_v1_contracts_ocmrepository_v1_types_proto_rawDescOnce.Do(func() {
+		file_v1_contracts_ocmrepository_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_contracts_ocmrepository_v1_types_proto_rawDesc), len(file_v1_contracts_ocmrepository_v1_types_proto_rawDesc)))
+	})
+	return file_v1_contracts_ocmrepository_v1_types_proto_rawDescData
+}
+
+var file_v1_contracts_ocmrepository_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_v1_contracts_ocmrepository_v1_types_proto_goTypes = []any{
+	(*GetIdentityRequest)(nil),          // 0: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityRequest
+	(*GetIdentityResponse)(nil),         // 1: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityResponse
+	(*PostComponentVersionRequest)(nil), // 2: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostComponentVersionRequest
+	(*PostLocalResourceRequest)(nil),    // 3: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalResourceRequest
+	(*GetLocalResourceResponse)(nil),    // 4: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalResourceResponse
+	(*PostLocalSourceRequest)(nil),      // 5: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalSourceRequest
+	(*GetLocalSourceResponse)(nil),      // 6: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalSourceResponse
+	(*PostCheckHealthRequest)(nil),      // 7: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostCheckHealthRequest
+	nil,                                 // 8: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityResponse.IdentityEntry
+	(*types.JSON)(nil),                  // 9: ocm.software.plugin.transport.v1.types.JSON
+	(*v2.Descriptor)(nil),               // 10: ocm.software.plugin.transport.v1.descriptor.v2.Descriptor
+	(*types.Location)(nil),              // 11: ocm.software.plugin.transport.v1.types.Location
+	(*v2.Resource)(nil),                 // 12: ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	(*v2.Source)(nil),                   // 13: ocm.software.plugin.transport.v1.descriptor.v2.Source
+}
+var file_v1_contracts_ocmrepository_v1_types_proto_depIdxs = []int32{
+	9,  // 0: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityRequest.type:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	8,  // 1: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityResponse.identity:type_name -> ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityResponse.IdentityEntry
+	9,  // 2: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostComponentVersionRequest.repository:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	10, // 3: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostComponentVersionRequest.descriptor:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Descriptor
+	9,  // 4: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalResourceRequest.repository:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	11, // 5: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalResourceRequest.resource_location:type_name -> ocm.software.plugin.transport.v1.types.Location
+	12, // 6: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalResourceRequest.resource:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	11, // 7: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalResourceResponse.location:type_name -> ocm.software.plugin.transport.v1.types.Location
+	12, // 8: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalResourceResponse.resource:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	9,  // 9: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalSourceRequest.repository:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	11, // 10: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalSourceRequest.source_location:type_name -> ocm.software.plugin.transport.v1.types.Location
+	13, // 11: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalSourceRequest.source:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Source
+	11, // 12: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalSourceResponse.location:type_name -> ocm.software.plugin.transport.v1.types.Location
+	13, // 13: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalSourceResponse.source:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Source
+	9,  // 14: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostCheckHealthRequest.repository:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_v1_contracts_ocmrepository_v1_types_proto_init() }
+func file_v1_contracts_ocmrepository_v1_types_proto_init() {
+	if File_v1_contracts_ocmrepository_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_contracts_ocmrepository_v1_types_proto_rawDesc), len(file_v1_contracts_ocmrepository_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_contracts_ocmrepository_v1_types_proto_goTypes,
+		DependencyIndexes: file_v1_contracts_ocmrepository_v1_types_proto_depIdxs,
+		MessageInfos:      file_v1_contracts_ocmrepository_v1_types_proto_msgTypes,
+	}.Build()
+	File_v1_contracts_ocmrepository_v1_types_proto = out.File
+	file_v1_contracts_ocmrepository_v1_types_proto_goTypes = nil
+	file_v1_contracts_ocmrepository_v1_types_proto_depIdxs = nil
+}
@@ -0,0 +1,287 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/contracts/resource/v1/types.proto
+
+package resourcev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	v2 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/descriptor/v2"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetGlobalResourceRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/resource/v1.GetGlobalResourceRequest.
+type GetGlobalResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *v2.Resource           `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGlobalResourceRequest) Reset() {
+	*x = GetGlobalResourceRequest{}
+	mi := &file_v1_contracts_resource_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGlobalResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGlobalResourceRequest) ProtoMessage() {}
+
+func (x *GetGlobalResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_resource_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGlobalResourceRequest.ProtoReflect.Descriptor instead.
+func (*GetGlobalResourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_resource_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetGlobalResourceRequest) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// GetGlobalResourceResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/resource/v1.GetGlobalResourceResponse.
+type GetGlobalResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *types.Location        `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGlobalResourceResponse) Reset() {
+	*x = GetGlobalResourceResponse{}
+	mi := &file_v1_contracts_resource_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGlobalResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGlobalResourceResponse) ProtoMessage() {}
+
+func (x *GetGlobalResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_resource_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGlobalResourceResponse.ProtoReflect.Descriptor instead.
+func (*GetGlobalResourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_resource_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGlobalResourceResponse) GetLocation() *types.Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+// AddGlobalResourceRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/resource/v1.AddGlobalResourceRequest.
+type AddGlobalResourceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ResourceLocation *types.Location        `protobuf:"bytes,1,opt,name=resource_location,json=resourceLocation,proto3" json:"resource_location,omitempty"`
+	Resource         *v2.Resource           `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AddGlobalResourceRequest) Reset() {
+	*x = AddGlobalResourceRequest{}
+	mi := &file_v1_contracts_resource_v1_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGlobalResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGlobalResourceRequest) ProtoMessage() {}
+
+func (x *AddGlobalResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_resource_v1_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGlobalResourceRequest.ProtoReflect.Descriptor instead.
+func (*AddGlobalResourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_resource_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddGlobalResourceRequest) GetResourceLocation() *types.Location {
+	if x != nil {
+		return x.ResourceLocation
+	}
+	return nil
+}
+
+func (x *AddGlobalResourceRequest) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// AddGlobalResourceResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/resource/v1.AddGlobalResourceResponse.
+type AddGlobalResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *v2.Resource           `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGlobalResourceResponse) Reset() {
+	*x = AddGlobalResourceResponse{}
+	mi := &file_v1_contracts_resource_v1_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGlobalResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGlobalResourceResponse) ProtoMessage() {}
+
+func (x *AddGlobalResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_resource_v1_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGlobalResourceResponse.ProtoReflect.Descriptor instead.
+func (*AddGlobalResourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_resource_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddGlobalResourceResponse) GetResource() *v2.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+var File_v1_contracts_resource_v1_types_proto protoreflect.FileDescriptor
+
+const file_v1_contracts_resource_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"$v1/contracts/resource/v1/types.proto\x126ocm.software.plugin.transport.v1.contracts.resource.v1\x1a\x1cv1/descriptor/v2/types.proto\x1a\x14v1/types/types.proto\"p\n" +
+	"\x18GetGlobalResourceRequest\x12T\n" +
+	"\bresource\x18\x01 \x01(\v28.ocm.software.plugin.transport.v1.descriptor.v2.ResourceR\bresource\"i\n" +
+	"\x19GetGlobalResourceResponse\x12L\n" +
+	"\blocation\x18\x01 \x01(\v20.ocm.software.plugin.transport.v1.types.LocationR\blocation\"\xcf\x01\n" +
+	"\x18AddGlobalResourceRequest\x12]\n" +
+	"\x11resource_location\x18\x01 \x01(\v20.ocm.software.plugin.transport.v1.types.LocationR\x10resourceLocation\x12T\n" +
+	"\bresource\x18\x02 \x01(\v28.ocm.software.plugin.transport.v1.descriptor.v2.ResourceR\bresource\"q\n" +
+	"\x19AddGlobalResourceResponse\x12T\n" +
+	"\bresource\x18\x01 \x01(\v28.ocm.software.plugin.transport.v1.descriptor.v2.ResourceR\bresourceBlZjocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/resource/v1;resourcev1b\x06proto3"
+
+var (
+	file_v1_contracts_resource_v1_types_proto_rawDescOnce sync.Once
+	file_v1_contracts_resource_v1_types_proto_rawDescData []byte
+)
+
+func file_v1_contracts_resource_v1_types_proto_rawDescGZIP() []byte {
+	file_v1_contracts_resource_v1_types_proto_rawDescOnce.Do(func() {
+		file_v1_contracts_resource_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_contracts_resource_v1_types_proto_rawDesc), len(file_v1_contracts_resource_v1_types_proto_rawDesc)))
+	})
+	return file_v1_contracts_resource_v1_types_proto_rawDescData
+}
+
+var file_v1_contracts_resource_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v1_contracts_resource_v1_types_proto_goTypes = []any{
+	(*GetGlobalResourceRequest)(nil),  // 0: ocm.software.plugin.transport.v1.contracts.resource.v1.GetGlobalResourceRequest
+	(*GetGlobalResourceResponse)(nil), // 1: ocm.software.plugin.transport.v1.contracts.resource.v1.GetGlobalResourceResponse
+	(*AddGlobalResourceRequest)(nil),  // 2: ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceRequest
+	(*AddGlobalResourceResponse)(nil), // 3: ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceResponse
+	(*v2.Resource)(nil),               // 4: ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	(*types.Location)(nil),            // 5: ocm.software.plugin.transport.v1.types.Location
+}
+var file_v1_contracts_resource_v1_types_proto_depIdxs = []int32{
+	4, // 0: ocm.software.plugin.transport.v1.contracts.resource.v1.GetGlobalResourceRequest.resource:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	5, // 1: ocm.software.plugin.transport.v1.contracts.resource.v1.GetGlobalResourceResponse.location:type_name -> ocm.software.plugin.transport.v1.types.Location
+	5, // 2: ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceRequest.resource_location:type_name -> ocm.software.plugin.transport.v1.types.Location
+	4, // 3: ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceRequest.resource:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	4, // 4: ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceResponse.resource:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_v1_contracts_resource_v1_types_proto_init() }
+func file_v1_contracts_resource_v1_types_proto_init() {
+	if File_v1_contracts_resource_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_contracts_resource_v1_types_proto_rawDesc), len(file_v1_contracts_resource_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_contracts_resource_v1_types_proto_goTypes,
+		DependencyIndexes: file_v1_contracts_resource_v1_types_proto_depIdxs,
+		MessageInfos:      file_v1_contracts_resource_v1_types_proto_msgTypes,
+	}.Build()
+	File_v1_contracts_resource_v1_types_proto = out.File
+	file_v1_contracts_resource_v1_types_proto_goTypes = nil
+	file_v1_contracts_resource_v1_types_proto_depIdxs = nil
+}
@@ -0,0 +1,469 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/contracts/signing/v1/types.proto
+
+package signingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	v2 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/descriptor/v2"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetSignerIdentityRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/signing/v1.GetSignerIdentityRequest.
+type GetSignerIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digest        *v2.Digest             `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	Config        *types.JSON            `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignerIdentityRequest) Reset() {
+	*x = GetSignerIdentityRequest{}
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignerIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignerIdentityRequest) ProtoMessage() {}
+
+func (x *GetSignerIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignerIdentityRequest.ProtoReflect.Descriptor instead.
+func (*GetSignerIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_signing_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSignerIdentityRequest) GetDigest() *v2.Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *GetSignerIdentityRequest) GetConfig() *types.JSON {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetSignerIdentityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// IdentityResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/signing/v1.IdentityResponse.
+type IdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      map[string]string      `protobuf:"bytes,1,rep,name=identity,proto3" json:"identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityResponse) Reset() {
+	*x = IdentityResponse{}
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityResponse) ProtoMessage() {}
+
+func (x *IdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityResponse.ProtoReflect.Descriptor instead.
+func (*IdentityResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_signing_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IdentityResponse) GetIdentity() map[string]string {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+// GetVerifierIdentityRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/signing/v1.GetVerifierIdentityRequest.
+type GetVerifierIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signature     *v2.Signature          `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Config        *types.JSON            `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerifierIdentityRequest) Reset() {
+	*x = GetVerifierIdentityRequest{}
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerifierIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerifierIdentityRequest) ProtoMessage() {}
+
+func (x *GetVerifierIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerifierIdentityRequest.ProtoReflect.Descriptor instead.
+func (*GetVerifierIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_signing_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetVerifierIdentityRequest) GetSignature() *v2.Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GetVerifierIdentityRequest) GetConfig() *types.JSON {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// SignRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/signing/v1.SignRequest.
+type SignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digest        *v2.Digest             `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	Config        *types.JSON            `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignRequest) Reset() {
+	*x = SignRequest{}
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignRequest) ProtoMessage() {}
+
+func (x *SignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignRequest.ProtoReflect.Descriptor instead.
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_signing_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SignRequest) GetDigest() *v2.Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *SignRequest) GetConfig() *types.JSON {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// SignResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/signing/v1.SignResponse.
+type SignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signature     *v2.SignatureInfo      `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignResponse) Reset() {
+	*x = SignResponse{}
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignResponse) ProtoMessage() {}
+
+func (x *SignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignResponse.ProtoReflect.Descriptor instead.
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_signing_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SignResponse) GetSignature() *v2.SignatureInfo {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// VerifyRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/signing/v1.VerifyRequest.
+type VerifyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signature     *v2.Signature          `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Config        *types.JSON            `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyRequest) Reset() {
+	*x = VerifyRequest{}
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRequest) ProtoMessage() {}
+
+func (x *VerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_signing_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VerifyRequest) GetSignature() *v2.Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *VerifyRequest) GetConfig() *types.JSON {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// VerifyResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/signing/v1.VerifyResponse.
+type VerifyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyResponse) Reset() {
+	*x = VerifyResponse{}
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyResponse) ProtoMessage() {}
+
+func (x *VerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_signing_v1_types_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyResponse.ProtoReflect.Descriptor instead.
+func (*VerifyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_signing_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+var File_v1_contracts_signing_v1_types_proto protoreflect.FileDescriptor
+
+const file_v1_contracts_signing_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"#v1/contracts/signing/v1/types.proto\x125ocm.software.plugin.transport.v1.contracts.signing.v1\x1a\x1cv1/descriptor/v2/types.proto\x1a\x14v1/types/types.proto\"\xc4\x01\n" +
+	"\x18GetSignerIdentityRequest\x12N\n" +
+	"\x06digest\x18\x01 \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.DigestR\x06digest\x12D\n" +
+	"\x06config\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x06config\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\xc2\x01\n" +
+	"\x10IdentityResponse\x12q\n" +
+	"\bidentity\x18\x01 \x03(\v2U.ocm.software.plugin.transport.v1.contracts.signing.v1.IdentityResponse.IdentityEntryR\bidentity\x1a;\n" +
+	"\rIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbb\x01\n" +
+	"\x1aGetVerifierIdentityRequest\x12W\n" +
+	"\tsignature\x18\x01 \x01(\v29.ocm.software.plugin.transport.v1.descriptor.v2.SignatureR\tsignature\x12D\n" +
+	"\x06config\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x06config\"\xa3\x01\n" +
+	"\vSignRequest\x12N\n" +
+	"\x06digest\x18\x01 \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.DigestR\x06digest\x12D\n" +
+	"\x06config\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x06config\"k\n" +
+	"\fSignResponse\x12[\n" +
+	"\tsignature\x18\x01 \x01(\v2=.ocm.software.plugin.transport.v1.descriptor.v2.SignatureInfoR\tsignature\"\xae\x01\n" +
+	"\rVerifyRequest\x12W\n" +
+	"\tsignature\x18\x01 \x01(\v29.ocm.software.plugin.transport.v1.descriptor.v2.SignatureR\tsignature\x12D\n" +
+	"\x06config\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x06config\"\x10\n" +
+	"\x0eVerifyResponseBjZhocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/signing/v1;signingv1b\x06proto3"
+
+var (
+	file_v1_contracts_signing_v1_types_proto_rawDescOnce sync.Once
+	file_v1_contracts_signing_v1_types_proto_rawDescData []byte
+)
+
+func file_v1_contracts_signing_v1_types_proto_rawDescGZIP() []byte {
+	file_v1_contracts_signing_v1_types_proto_rawDescOnce.Do(func() {
+		file_v1_contracts_signing_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_contracts_signing_v1_types_proto_rawDesc), len(file_v1_contracts_signing_v1_types_proto_rawDesc)))
+	})
+	return file_v1_contracts_signing_v1_types_proto_rawDescData
+}
+
+var file_v1_contracts_signing_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_v1_contracts_signing_v1_types_proto_goTypes = []any{
+	(*GetSignerIdentityRequest)(nil),   // 0: ocm.software.plugin.transport.v1.contracts.signing.v1.GetSignerIdentityRequest
+	(*IdentityResponse)(nil),           // 1: ocm.software.plugin.transport.v1.contracts.signing.v1.IdentityResponse
+	(*GetVerifierIdentityRequest)(nil), // 2: ocm.software.plugin.transport.v1.contracts.signing.v1.GetVerifierIdentityRequest
+	(*SignRequest)(nil),                // 3: ocm.software.plugin.transport.v1.contracts.signing.v1.SignRequest
+	(*SignResponse)(nil),               // 4: ocm.software.plugin.transport.v1.contracts.signing.v1.SignResponse
+	(*VerifyRequest)(nil),              // 5: ocm.software.plugin.transport.v1.contracts.signing.v1.VerifyRequest
+	(*VerifyResponse)(nil),             // 6: ocm.software.plugin.transport.v1.contracts.signing.v1.VerifyResponse
+	nil,                                // 7: ocm.software.plugin.transport.v1.contracts.signing.v1.IdentityResponse.IdentityEntry
+	(*v2.Digest)(nil),                  // 8: ocm.software.plugin.transport.v1.descriptor.v2.Digest
+	(*types.JSON)(nil),                 // 9: ocm.software.plugin.transport.v1.types.JSON
+	(*v2.Signature)(nil),               // 10: ocm.software.plugin.transport.v1.descriptor.v2.Signature
+	(*v2.SignatureInfo)(nil),           // 11: ocm.software.plugin.transport.v1.descriptor.v2.SignatureInfo
+}
+var file_v1_contracts_signing_v1_types_proto_depIdxs = []int32{
+	8,  // 0: ocm.software.plugin.transport.v1.contracts.signing.v1.GetSignerIdentityRequest.digest:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Digest
+	9,  // 1: ocm.software.plugin.transport.v1.contracts.signing.v1.GetSignerIdentityRequest.config:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	7,  // 2: ocm.software.plugin.transport.v1.contracts.signing.v1.IdentityResponse.identity:type_name -> ocm.software.plugin.transport.v1.contracts.signing.v1.IdentityResponse.IdentityEntry
+	10, // 3: ocm.software.plugin.transport.v1.contracts.signing.v1.GetVerifierIdentityRequest.signature:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Signature
+	9,  // 4: ocm.software.plugin.transport.v1.contracts.signing.v1.GetVerifierIdentityRequest.config:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	8,  // 5: ocm.software.plugin.transport.v1.contracts.signing.v1.SignRequest.digest:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Digest
+	9,  // 6: ocm.software.plugin.transport.v1.contracts.signing.v1.SignRequest.config:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	11, // 7: ocm.software.plugin.transport.v1.contracts.signing.v1.SignResponse.signature:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.SignatureInfo
+	10, // 8: ocm.software.plugin.transport.v1.contracts.signing.v1.VerifyRequest.signature:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Signature
+	9,  // 9: ocm.software.plugin.transport.v1.contracts.signing.v1.VerifyRequest.config:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_v1_contracts_signing_v1_types_proto_init() }
+func file_v1_contracts_signing_v1_types_proto_init() {
+	if File_v1_contracts_signing_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_contracts_signing_v1_types_proto_rawDesc), len(file_v1_contracts_signing_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_contracts_signing_v1_types_proto_goTypes,
+		DependencyIndexes: file_v1_contracts_signing_v1_types_proto_depIdxs,
+		MessageInfos:      file_v1_contracts_signing_v1_types_proto_msgTypes,
+	}.Build()
+	File_v1_contracts_signing_v1_types_proto = out.File
+	file_v1_contracts_signing_v1_types_proto_goTypes = nil
+	file_v1_contracts_signing_v1_types_proto_depIdxs = nil
+}
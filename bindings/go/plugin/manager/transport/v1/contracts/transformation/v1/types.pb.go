@@ -0,0 +1,177 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/contracts/transformation/v1/types.proto
+
+package transformationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TransformRequest is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1.TransformRequest.
+type TransformRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Transformation *types.JSON            `protobuf:"bytes,1,opt,name=transformation,proto3" json:"transformation,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransformRequest) Reset() {
+	*x = TransformRequest{}
+	mi := &file_v1_contracts_transformation_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformRequest) ProtoMessage() {}
+
+func (x *TransformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_transformation_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformRequest.ProtoReflect.Descriptor instead.
+func (*TransformRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_transformation_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransformRequest) GetTransformation() *types.JSON {
+	if x != nil {
+		return x.Transformation
+	}
+	return nil
+}
+
+// TransformResponse is generated from ocm.software/open-component-model/bindings/go/plugin/manager/contracts/transformation/v1.TransformResponse.
+type TransformResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Transformation *types.JSON            `protobuf:"bytes,1,opt,name=transformation,proto3" json:"transformation,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransformResponse) Reset() {
+	*x = TransformResponse{}
+	mi := &file_v1_contracts_transformation_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformResponse) ProtoMessage() {}
+
+func (x *TransformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contracts_transformation_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformResponse.ProtoReflect.Descriptor instead.
+func (*TransformResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contracts_transformation_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransformResponse) GetTransformation() *types.JSON {
+	if x != nil {
+		return x.Transformation
+	}
+	return nil
+}
+
+var File_v1_contracts_transformation_v1_types_proto protoreflect.FileDescriptor
+
+const file_v1_contracts_transformation_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"*v1/contracts/transformation/v1/types.proto\x12<ocm.software.plugin.transport.v1.contracts.transformation.v1\x1a\x14v1/types/types.proto\"h\n" +
+	"\x10TransformRequest\x12T\n" +
+	"\x0etransformation\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x0etransformation\"i\n" +
+	"\x11TransformResponse\x12T\n" +
+	"\x0etransformation\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x0etransformationBxZvocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/transformation/v1;transformationv1b\x06proto3"
+
+var (
+	file_v1_contracts_transformation_v1_types_proto_rawDescOnce sync.Once
+	file_v1_contracts_transformation_v1_types_proto_rawDescData []byte
+)
+
+func file_v1_contracts_transformation_v1_types_proto_rawDescGZIP() []byte {
+	file_v1_contracts_transformation_v1_types_proto_rawDescOnce.Do(func() {
+		file_v1_contracts_transformation_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_contracts_transformation_v1_types_proto_rawDesc), len(file_v1_contracts_transformation_v1_types_proto_rawDesc)))
+	})
+	return file_v1_contracts_transformation_v1_types_proto_rawDescData
+}
+
+var file_v1_contracts_transformation_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_contracts_transformation_v1_types_proto_goTypes = []any{
+	(*TransformRequest)(nil),  // 0: ocm.software.plugin.transport.v1.contracts.transformation.v1.TransformRequest
+	(*TransformResponse)(nil), // 1: ocm.software.plugin.transport.v1.contracts.transformation.v1.TransformResponse
+	(*types.JSON)(nil),        // 2: ocm.software.plugin.transport.v1.types.JSON
+}
+var file_v1_contracts_transformation_v1_types_proto_depIdxs = []int32{
+	2, // 0: ocm.software.plugin.transport.v1.contracts.transformation.v1.TransformRequest.transformation:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	2, // 1: ocm.software.plugin.transport.v1.contracts.transformation.v1.TransformResponse.transformation:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_v1_contracts_transformation_v1_types_proto_init() }
+func file_v1_contracts_transformation_v1_types_proto_init() {
+	if File_v1_contracts_transformation_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_contracts_transformation_v1_types_proto_rawDesc), len(file_v1_contracts_transformation_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_contracts_transformation_v1_types_proto_goTypes,
+		DependencyIndexes: file_v1_contracts_transformation_v1_types_proto_depIdxs,
+		MessageInfos:      file_v1_contracts_transformation_v1_types_proto_msgTypes,
+	}.Build()
+	File_v1_contracts_transformation_v1_types_proto = out.File
+	file_v1_contracts_transformation_v1_types_proto_goTypes = nil
+	file_v1_contracts_transformation_v1_types_proto_depIdxs = nil
+}
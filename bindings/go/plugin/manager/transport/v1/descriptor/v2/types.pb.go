@@ -0,0 +1,1238 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/descriptor/v2/types.proto
+
+package descriptorv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Descriptor is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.Descriptor.
+type Descriptor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *Meta                  `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Component     *Component             `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
+	Signatures    []*Signature           `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	NestedDigests []*NestedDigest        `protobuf:"bytes,4,rep,name=nested_digests,json=nestedDigests,proto3" json:"nested_digests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Descriptor) Reset() {
+	*x = Descriptor{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Descriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Descriptor) ProtoMessage() {}
+
+func (x *Descriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Descriptor.ProtoReflect.Descriptor instead.
+func (*Descriptor) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Descriptor) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *Descriptor) GetComponent() *Component {
+	if x != nil {
+		return x.Component
+	}
+	return nil
+}
+
+func (x *Descriptor) GetSignatures() []*Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *Descriptor) GetNestedDigests() []*NestedDigest {
+	if x != nil {
+		return x.NestedDigests
+	}
+	return nil
+}
+
+// Meta is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.Meta.
+type Meta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchemaVersion string                 `protobuf:"bytes,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Meta) Reset() {
+	*x = Meta{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meta) ProtoMessage() {}
+
+func (x *Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
+func (*Meta) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Meta) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+// Component is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.Component.
+type Component struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version             string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Labels              []*Label               `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	CreationTime        string                 `protobuf:"bytes,4,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	RepositoryContexts  []*types.JSON          `protobuf:"bytes,5,rep,name=repository_contexts,json=repositoryContexts,proto3" json:"repository_contexts,omitempty"`
+	Provider            string                 `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	Resources           []*Resource            `protobuf:"bytes,7,rep,name=resources,proto3" json:"resources,omitempty"`
+	Sources             []*Source              `protobuf:"bytes,8,rep,name=sources,proto3" json:"sources,omitempty"`
+	ComponentReferences []*Reference           `protobuf:"bytes,9,rep,name=component_references,json=componentReferences,proto3" json:"component_references,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Component) Reset() {
+	*x = Component{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Component) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Component) ProtoMessage() {}
+
+func (x *Component) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Component.ProtoReflect.Descriptor instead.
+func (*Component) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Component) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Component) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Component) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Component) GetCreationTime() string {
+	if x != nil {
+		return x.CreationTime
+	}
+	return ""
+}
+
+func (x *Component) GetRepositoryContexts() []*types.JSON {
+	if x != nil {
+		return x.RepositoryContexts
+	}
+	return nil
+}
+
+func (x *Component) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Component) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *Component) GetSources() []*Source {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *Component) GetComponentReferences() []*Reference {
+	if x != nil {
+		return x.ComponentReferences
+	}
+	return nil
+}
+
+// Label is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.Label.
+type Label struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         *types.JSON            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Signing       bool                   `protobuf:"varint,3,opt,name=signing,proto3" json:"signing,omitempty"`
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Label) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Label) GetValue() *types.JSON {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Label) GetSigning() bool {
+	if x != nil {
+		return x.Signing
+	}
+	return false
+}
+
+func (x *Label) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// Resource is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.Resource.
+type Resource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Labels        []*Label               `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	ExtraIdentity map[string]string      `protobuf:"bytes,4,rep,name=extra_identity,json=extraIdentity,proto3" json:"extra_identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SrcRefs       []*SourceRef           `protobuf:"bytes,5,rep,name=src_refs,json=srcRefs,proto3" json:"src_refs,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Relation      string                 `protobuf:"bytes,7,opt,name=relation,proto3" json:"relation,omitempty"`
+	Access        *types.JSON            `protobuf:"bytes,8,opt,name=access,proto3" json:"access,omitempty"`
+	Digest        *Digest                `protobuf:"bytes,9,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Resource) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Resource) GetExtraIdentity() map[string]string {
+	if x != nil {
+		return x.ExtraIdentity
+	}
+	return nil
+}
+
+func (x *Resource) GetSrcRefs() []*SourceRef {
+	if x != nil {
+		return x.SrcRefs
+	}
+	return nil
+}
+
+func (x *Resource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Resource) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *Resource) GetAccess() *types.JSON {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+func (x *Resource) GetDigest() *Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+// SourceRef is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.SourceRef.
+type SourceRef struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IdentitySelector map[string]string      `protobuf:"bytes,1,rep,name=identity_selector,json=identitySelector,proto3" json:"identity_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels           []*Label               `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SourceRef) Reset() {
+	*x = SourceRef{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceRef) ProtoMessage() {}
+
+func (x *SourceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceRef.ProtoReflect.Descriptor instead.
+func (*SourceRef) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SourceRef) GetIdentitySelector() map[string]string {
+	if x != nil {
+		return x.IdentitySelector
+	}
+	return nil
+}
+
+func (x *SourceRef) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// Digest is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.Digest.
+type Digest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	HashAlgorithm          string                 `protobuf:"bytes,1,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	NormalisationAlgorithm string                 `protobuf:"bytes,2,opt,name=normalisation_algorithm,json=normalisationAlgorithm,proto3" json:"normalisation_algorithm,omitempty"`
+	Value                  string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Digest) Reset() {
+	*x = Digest{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Digest) ProtoMessage() {}
+
+func (x *Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Digest.ProtoReflect.Descriptor instead.
+func (*Digest) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Digest) GetHashAlgorithm() string {
+	if x != nil {
+		return x.HashAlgorithm
+	}
+	return ""
+}
+
+func (x *Digest) GetNormalisationAlgorithm() string {
+	if x != nil {
+		return x.NormalisationAlgorithm
+	}
+	return ""
+}
+
+func (x *Digest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Source is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.Source.
+type Source struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Labels        []*Label               `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	ExtraIdentity map[string]string      `protobuf:"bytes,4,rep,name=extra_identity,json=extraIdentity,proto3" json:"extra_identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Access        *types.JSON            `protobuf:"bytes,6,opt,name=access,proto3" json:"access,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Source) Reset() {
+	*x = Source{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Source) ProtoMessage() {}
+
+func (x *Source) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Source.ProtoReflect.Descriptor instead.
+func (*Source) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Source) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Source) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Source) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Source) GetExtraIdentity() map[string]string {
+	if x != nil {
+		return x.ExtraIdentity
+	}
+	return nil
+}
+
+func (x *Source) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Source) GetAccess() *types.JSON {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+// Reference is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.Reference.
+type Reference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Labels        []*Label               `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	ExtraIdentity map[string]string      `protobuf:"bytes,4,rep,name=extra_identity,json=extraIdentity,proto3" json:"extra_identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ComponentName string                 `protobuf:"bytes,5,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
+	Digest        *Digest                `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reference) Reset() {
+	*x = Reference{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reference) ProtoMessage() {}
+
+func (x *Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reference.ProtoReflect.Descriptor instead.
+func (*Reference) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Reference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Reference) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Reference) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Reference) GetExtraIdentity() map[string]string {
+	if x != nil {
+		return x.ExtraIdentity
+	}
+	return nil
+}
+
+func (x *Reference) GetComponentName() string {
+	if x != nil {
+		return x.ComponentName
+	}
+	return ""
+}
+
+func (x *Reference) GetDigest() *Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+// Signature is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.Signature.
+type Signature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Digest        *Digest                `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Signature     *SignatureInfo         `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Timestamp     *TimestampSpec         `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Signature) Reset() {
+	*x = Signature{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Signature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signature) ProtoMessage() {}
+
+func (x *Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signature.ProtoReflect.Descriptor instead.
+func (*Signature) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Signature) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Signature) GetDigest() *Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *Signature) GetSignature() *SignatureInfo {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Signature) GetTimestamp() *TimestampSpec {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// SignatureInfo is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.SignatureInfo.
+type SignatureInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	MediaType     string                 `protobuf:"bytes,3,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	Issuer        string                 `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignatureInfo) Reset() {
+	*x = SignatureInfo{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignatureInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureInfo) ProtoMessage() {}
+
+func (x *SignatureInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignatureInfo.ProtoReflect.Descriptor instead.
+func (*SignatureInfo) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SignatureInfo) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *SignatureInfo) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SignatureInfo) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *SignatureInfo) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+// TimestampSpec is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.TimestampSpec.
+type TimestampSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Time          *types.JSON            `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimestampSpec) Reset() {
+	*x = TimestampSpec{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimestampSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimestampSpec) ProtoMessage() {}
+
+func (x *TimestampSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimestampSpec.ProtoReflect.Descriptor instead.
+func (*TimestampSpec) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TimestampSpec) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TimestampSpec) GetTime() *types.JSON {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// NestedDigest is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.NestedDigest.
+type NestedDigest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version         string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Digest          *Digest                `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	ResourceDigests []*ResourceDigest      `protobuf:"bytes,4,rep,name=resource_digests,json=resourceDigests,proto3" json:"resource_digests,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NestedDigest) Reset() {
+	*x = NestedDigest{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedDigest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedDigest) ProtoMessage() {}
+
+func (x *NestedDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedDigest.ProtoReflect.Descriptor instead.
+func (*NestedDigest) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NestedDigest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NestedDigest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NestedDigest) GetDigest() *Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *NestedDigest) GetResourceDigests() []*ResourceDigest {
+	if x != nil {
+		return x.ResourceDigests
+	}
+	return nil
+}
+
+// ResourceDigest is generated from ocm.software/open-component-model/bindings/go/descriptor/v2.ResourceDigest.
+type ResourceDigest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ExtraIdentity map[string]string      `protobuf:"bytes,3,rep,name=extra_identity,json=extraIdentity,proto3" json:"extra_identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Digest        *Digest                `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceDigest) Reset() {
+	*x = ResourceDigest{}
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceDigest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceDigest) ProtoMessage() {}
+
+func (x *ResourceDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_descriptor_v2_types_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceDigest.ProtoReflect.Descriptor instead.
+func (*ResourceDigest) Descriptor() ([]byte, []int) {
+	return file_v1_descriptor_v2_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResourceDigest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceDigest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ResourceDigest) GetExtraIdentity() map[string]string {
+	if x != nil {
+		return x.ExtraIdentity
+	}
+	return nil
+}
+
+func (x *ResourceDigest) GetDigest() *Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+var File_v1_descriptor_v2_types_proto protoreflect.FileDescriptor
+
+const file_v1_descriptor_v2_types_proto_rawDesc = "" +
+	"\n" +
+	"\x1cv1/descriptor/v2/types.proto\x12.ocm.software.plugin.transport.v1.descriptor.v2\x1a\x14v1/types/types.proto\"\xef\x02\n" +
+	"\n" +
+	"Descriptor\x12H\n" +
+	"\x04meta\x18\x01 \x01(\v24.ocm.software.plugin.transport.v1.descriptor.v2.MetaR\x04meta\x12W\n" +
+	"\tcomponent\x18\x02 \x01(\v29.ocm.software.plugin.transport.v1.descriptor.v2.ComponentR\tcomponent\x12Y\n" +
+	"\n" +
+	"signatures\x18\x03 \x03(\v29.ocm.software.plugin.transport.v1.descriptor.v2.SignatureR\n" +
+	"signatures\x12c\n" +
+	"\x0enested_digests\x18\x04 \x03(\v2<.ocm.software.plugin.transport.v1.descriptor.v2.NestedDigestR\rnestedDigests\"-\n" +
+	"\x04Meta\x12%\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\rschemaVersion\"\xc0\x04\n" +
+	"\tComponent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12M\n" +
+	"\x06labels\x18\x03 \x03(\v25.ocm.software.plugin.transport.v1.descriptor.v2.LabelR\x06labels\x12#\n" +
+	"\rcreation_time\x18\x04 \x01(\tR\fcreationTime\x12]\n" +
+	"\x13repository_contexts\x18\x05 \x03(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x12repositoryContexts\x12\x1a\n" +
+	"\bprovider\x18\x06 \x01(\tR\bprovider\x12V\n" +
+	"\tresources\x18\a \x03(\v28.ocm.software.plugin.transport.v1.descriptor.v2.ResourceR\tresources\x12P\n" +
+	"\asources\x18\b \x03(\v26.ocm.software.plugin.transport.v1.descriptor.v2.SourceR\asources\x12l\n" +
+	"\x14component_references\x18\t \x03(\v29.ocm.software.plugin.transport.v1.descriptor.v2.ReferenceR\x13componentReferences\"\x93\x01\n" +
+	"\x05Label\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12B\n" +
+	"\x05value\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x05value\x12\x18\n" +
+	"\asigning\x18\x03 \x01(\bR\asigning\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\"\xd9\x04\n" +
+	"\bResource\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12M\n" +
+	"\x06labels\x18\x03 \x03(\v25.ocm.software.plugin.transport.v1.descriptor.v2.LabelR\x06labels\x12r\n" +
+	"\x0eextra_identity\x18\x04 \x03(\v2K.ocm.software.plugin.transport.v1.descriptor.v2.Resource.ExtraIdentityEntryR\rextraIdentity\x12T\n" +
+	"\bsrc_refs\x18\x05 \x03(\v29.ocm.software.plugin.transport.v1.descriptor.v2.SourceRefR\asrcRefs\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1a\n" +
+	"\brelation\x18\a \x01(\tR\brelation\x12D\n" +
+	"\x06access\x18\b \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x06access\x12N\n" +
+	"\x06digest\x18\t \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.DigestR\x06digest\x1a@\n" +
+	"\x12ExtraIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9d\x02\n" +
+	"\tSourceRef\x12|\n" +
+	"\x11identity_selector\x18\x01 \x03(\v2O.ocm.software.plugin.transport.v1.descriptor.v2.SourceRef.IdentitySelectorEntryR\x10identitySelector\x12M\n" +
+	"\x06labels\x18\x02 \x03(\v25.ocm.software.plugin.transport.v1.descriptor.v2.LabelR\x06labels\x1aC\n" +
+	"\x15IdentitySelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"~\n" +
+	"\x06Digest\x12%\n" +
+	"\x0ehash_algorithm\x18\x01 \x01(\tR\rhashAlgorithm\x127\n" +
+	"\x17normalisation_algorithm\x18\x02 \x01(\tR\x16normalisationAlgorithm\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\x93\x03\n" +
+	"\x06Source\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12M\n" +
+	"\x06labels\x18\x03 \x03(\v25.ocm.software.plugin.transport.v1.descriptor.v2.LabelR\x06labels\x12p\n" +
+	"\x0eextra_identity\x18\x04 \x03(\v2I.ocm.software.plugin.transport.v1.descriptor.v2.Source.ExtraIdentityEntryR\rextraIdentity\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12D\n" +
+	"\x06access\x18\x06 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x06access\x1a@\n" +
+	"\x12ExtraIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb6\x03\n" +
+	"\tReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12M\n" +
+	"\x06labels\x18\x03 \x03(\v25.ocm.software.plugin.transport.v1.descriptor.v2.LabelR\x06labels\x12s\n" +
+	"\x0eextra_identity\x18\x04 \x03(\v2L.ocm.software.plugin.transport.v1.descriptor.v2.Reference.ExtraIdentityEntryR\rextraIdentity\x12%\n" +
+	"\x0ecomponent_name\x18\x05 \x01(\tR\rcomponentName\x12N\n" +
+	"\x06digest\x18\x06 \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.DigestR\x06digest\x1a@\n" +
+	"\x12ExtraIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\x02\n" +
+	"\tSignature\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12N\n" +
+	"\x06digest\x18\x02 \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.DigestR\x06digest\x12[\n" +
+	"\tsignature\x18\x03 \x01(\v2=.ocm.software.plugin.transport.v1.descriptor.v2.SignatureInfoR\tsignature\x12[\n" +
+	"\ttimestamp\x18\x04 \x01(\v2=.ocm.software.plugin.transport.v1.descriptor.v2.TimestampSpecR\ttimestamp\"z\n" +
+	"\rSignatureInfo\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x03 \x01(\tR\tmediaType\x12\x16\n" +
+	"\x06issuer\x18\x04 \x01(\tR\x06issuer\"g\n" +
+	"\rTimestampSpec\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12@\n" +
+	"\x04time\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\x04time\"\xf7\x01\n" +
+	"\fNestedDigest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12N\n" +
+	"\x06digest\x18\x03 \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.DigestR\x06digest\x12i\n" +
+	"\x10resource_digests\x18\x04 \x03(\v2>.ocm.software.plugin.transport.v1.descriptor.v2.ResourceDigestR\x0fresourceDigests\"\xca\x02\n" +
+	"\x0eResourceDigest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12x\n" +
+	"\x0eextra_identity\x18\x03 \x03(\v2Q.ocm.software.plugin.transport.v1.descriptor.v2.ResourceDigest.ExtraIdentityEntryR\rextraIdentity\x12N\n" +
+	"\x06digest\x18\x04 \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.DigestR\x06digest\x1a@\n" +
+	"\x12ExtraIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01BfZdocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/descriptor/v2;descriptorv2b\x06proto3"
+
+var (
+	file_v1_descriptor_v2_types_proto_rawDescOnce sync.Once
+	file_v1_descriptor_v2_types_proto_rawDescData []byte
+)
+
+func file_v1_descriptor_v2_types_proto_rawDescGZIP() []byte {
+	file_v1_descriptor_v2_types_proto_rawDescOnce.Do(func() {
+		file_v1_descriptor_v2_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_descriptor_v2_types_proto_rawDesc), len(file_v1_descriptor_v2_types_proto_rawDesc)))
+	})
+	return file_v1_descriptor_v2_types_proto_rawDescData
+}
+
+var file_v1_descriptor_v2_types_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_v1_descriptor_v2_types_proto_goTypes = []any{
+	(*Descriptor)(nil),     // 0: ocm.software.plugin.transport.v1.descriptor.v2.Descriptor
+	(*Meta)(nil),           // 1: ocm.software.plugin.transport.v1.descriptor.v2.Meta
+	(*Component)(nil),      // 2: ocm.software.plugin.transport.v1.descriptor.v2.Component
+	(*Label)(nil),          // 3: ocm.software.plugin.transport.v1.descriptor.v2.Label
+	(*Resource)(nil),       // 4: ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	(*SourceRef)(nil),      // 5: ocm.software.plugin.transport.v1.descriptor.v2.SourceRef
+	(*Digest)(nil),         // 6: ocm.software.plugin.transport.v1.descriptor.v2.Digest
+	(*Source)(nil),         // 7: ocm.software.plugin.transport.v1.descriptor.v2.Source
+	(*Reference)(nil),      // 8: ocm.software.plugin.transport.v1.descriptor.v2.Reference
+	(*Signature)(nil),      // 9: ocm.software.plugin.transport.v1.descriptor.v2.Signature
+	(*SignatureInfo)(nil),  // 10: ocm.software.plugin.transport.v1.descriptor.v2.SignatureInfo
+	(*TimestampSpec)(nil),  // 11: ocm.software.plugin.transport.v1.descriptor.v2.TimestampSpec
+	(*NestedDigest)(nil),   // 12: ocm.software.plugin.transport.v1.descriptor.v2.NestedDigest
+	(*ResourceDigest)(nil), // 13: ocm.software.plugin.transport.v1.descriptor.v2.ResourceDigest
+	nil,                    // 14: ocm.software.plugin.transport.v1.descriptor.v2.Resource.ExtraIdentityEntry
+	nil,                    // 15: ocm.software.plugin.transport.v1.descriptor.v2.SourceRef.IdentitySelectorEntry
+	nil,                    // 16: ocm.software.plugin.transport.v1.descriptor.v2.Source.ExtraIdentityEntry
+	nil,                    // 17: ocm.software.plugin.transport.v1.descriptor.v2.Reference.ExtraIdentityEntry
+	nil,                    // 18: ocm.software.plugin.transport.v1.descriptor.v2.ResourceDigest.ExtraIdentityEntry
+	(*types.JSON)(nil),     // 19: ocm.software.plugin.transport.v1.types.JSON
+}
+var file_v1_descriptor_v2_types_proto_depIdxs = []int32{
+	1,  // 0: ocm.software.plugin.transport.v1.descriptor.v2.Descriptor.meta:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Meta
+	2,  // 1: ocm.software.plugin.transport.v1.descriptor.v2.Descriptor.component:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Component
+	9,  // 2: ocm.software.plugin.transport.v1.descriptor.v2.Descriptor.signatures:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Signature
+	12, // 3: ocm.software.plugin.transport.v1.descriptor.v2.Descriptor.nested_digests:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.NestedDigest
+	3,  // 4: ocm.software.plugin.transport.v1.descriptor.v2.Component.labels:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Label
+	19, // 5: ocm.software.plugin.transport.v1.descriptor.v2.Component.repository_contexts:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	4,  // 6: ocm.software.plugin.transport.v1.descriptor.v2.Component.resources:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	7,  // 7: ocm.software.plugin.transport.v1.descriptor.v2.Component.sources:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Source
+	8,  // 8: ocm.software.plugin.transport.v1.descriptor.v2.Component.component_references:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Reference
+	19, // 9: ocm.software.plugin.transport.v1.descriptor.v2.Label.value:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	3,  // 10: ocm.software.plugin.transport.v1.descriptor.v2.Resource.labels:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Label
+	14, // 11: ocm.software.plugin.transport.v1.descriptor.v2.Resource.extra_identity:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource.ExtraIdentityEntry
+	5,  // 12: ocm.software.plugin.transport.v1.descriptor.v2.Resource.src_refs:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.SourceRef
+	19, // 13: ocm.software.plugin.transport.v1.descriptor.v2.Resource.access:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	6,  // 14: ocm.software.plugin.transport.v1.descriptor.v2.Resource.digest:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Digest
+	15, // 15: ocm.software.plugin.transport.v1.descriptor.v2.SourceRef.identity_selector:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.SourceRef.IdentitySelectorEntry
+	3,  // 16: ocm.software.plugin.transport.v1.descriptor.v2.SourceRef.labels:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Label
+	3,  // 17: ocm.software.plugin.transport.v1.descriptor.v2.Source.labels:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Label
+	16, // 18: ocm.software.plugin.transport.v1.descriptor.v2.Source.extra_identity:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Source.ExtraIdentityEntry
+	19, // 19: ocm.software.plugin.transport.v1.descriptor.v2.Source.access:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	3,  // 20: ocm.software.plugin.transport.v1.descriptor.v2.Reference.labels:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Label
+	17, // 21: ocm.software.plugin.transport.v1.descriptor.v2.Reference.extra_identity:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Reference.ExtraIdentityEntry
+	6,  // 22: ocm.software.plugin.transport.v1.descriptor.v2.Reference.digest:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Digest
+	6,  // 23: ocm.software.plugin.transport.v1.descriptor.v2.Signature.digest:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Digest
+	10, // 24: ocm.software.plugin.transport.v1.descriptor.v2.Signature.signature:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.SignatureInfo
+	11, // 25: ocm.software.plugin.transport.v1.descriptor.v2.Signature.timestamp:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.TimestampSpec
+	19, // 26: ocm.software.plugin.transport.v1.descriptor.v2.TimestampSpec.time:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	6,  // 27: ocm.software.plugin.transport.v1.descriptor.v2.NestedDigest.digest:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Digest
+	13, // 28: ocm.software.plugin.transport.v1.descriptor.v2.NestedDigest.resource_digests:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.ResourceDigest
+	18, // 29: ocm.software.plugin.transport.v1.descriptor.v2.ResourceDigest.extra_identity:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.ResourceDigest.ExtraIdentityEntry
+	6,  // 30: ocm.software.plugin.transport.v1.descriptor.v2.ResourceDigest.digest:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Digest
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_v1_descriptor_v2_types_proto_init() }
+func file_v1_descriptor_v2_types_proto_init() {
+	if File_v1_descriptor_v2_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_descriptor_v2_types_proto_rawDesc), len(file_v1_descriptor_v2_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_descriptor_v2_types_proto_goTypes,
+		DependencyIndexes: file_v1_descriptor_v2_types_proto_depIdxs,
+		MessageInfos:      file_v1_descriptor_v2_types_proto_msgTypes,
+	}.Build()
+	File_v1_descriptor_v2_types_proto = out.File
+	file_v1_descriptor_v2_types_proto_goTypes = nil
+	file_v1_descriptor_v2_types_proto_depIdxs = nil
+}
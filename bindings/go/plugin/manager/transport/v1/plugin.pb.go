@@ -0,0 +1,2931 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/plugin.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	v11 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/blobtransformer/v1"
+	v12 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/componentlister/v1"
+	v13 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/credentials/v1"
+	v14 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/digestprocessor/v1"
+	v15 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/input/v1"
+	v1 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/ocmrepository/v1"
+	v16 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/resource/v1"
+	v17 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/signing/v1"
+	v18 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/contracts/transformation/v1"
+	v2 "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/descriptor/v2"
+	types "ocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HealthzRequest is the request of Plugin.Healthz.
+type HealthzRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthzRequest) Reset() {
+	*x = HealthzRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthzRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthzRequest) ProtoMessage() {}
+
+func (x *HealthzRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthzRequest.ProtoReflect.Descriptor instead.
+func (*HealthzRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+// HealthzResponse is the response of Plugin.Healthz.
+type HealthzResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthzResponse) Reset() {
+	*x = HealthzResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthzResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthzResponse) ProtoMessage() {}
+
+func (x *HealthzResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthzResponse.ProtoReflect.Descriptor instead.
+func (*HealthzResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+// GetIdentityRequest is the request of Plugin.GetIdentity.
+type GetIdentityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request       *v1.GetIdentityRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityRequest) Reset() {
+	*x = GetIdentityRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityRequest) ProtoMessage() {}
+
+func (x *GetIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityRequest.ProtoReflect.Descriptor instead.
+func (*GetIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetIdentityRequest) GetRequest() *v1.GetIdentityRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// GetIdentityResponse is the response of Plugin.GetIdentity.
+type GetIdentityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v1.GetIdentityResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityResponse) Reset() {
+	*x = GetIdentityResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityResponse) ProtoMessage() {}
+
+func (x *GetIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityResponse.ProtoReflect.Descriptor instead.
+func (*GetIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetIdentityResponse) GetResponse() *v1.GetIdentityResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// TransformBlobRequest is the request of BlobTransformer.TransformBlob.
+type TransformBlobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v11.TransformBlobRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// blob is a chunk of the content of the request location. It is only set in the messages following the first one.
+	Blob          []byte `protobuf:"bytes,3,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformBlobRequest) Reset() {
+	*x = TransformBlobRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformBlobRequest) ProtoMessage() {}
+
+func (x *TransformBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformBlobRequest.ProtoReflect.Descriptor instead.
+func (*TransformBlobRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TransformBlobRequest) GetRequest() *v11.TransformBlobRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *TransformBlobRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *TransformBlobRequest) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// TransformBlobResponse is the response of BlobTransformer.TransformBlob.
+type TransformBlobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response *v11.TransformBlobResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// blob is a chunk of the content of the response location. It is only set in the messages following the first one.
+	Blob          []byte `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformBlobResponse) Reset() {
+	*x = TransformBlobResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformBlobResponse) ProtoMessage() {}
+
+func (x *TransformBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformBlobResponse.ProtoReflect.Descriptor instead.
+func (*TransformBlobResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TransformBlobResponse) GetResponse() *v11.TransformBlobResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TransformBlobResponse) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// ListComponentsRequest is the request of ComponentLister.ListComponents.
+type ListComponentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v12.ListComponentsRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentsRequest) Reset() {
+	*x = ListComponentsRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentsRequest) ProtoMessage() {}
+
+func (x *ListComponentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentsRequest.ProtoReflect.Descriptor instead.
+func (*ListComponentsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListComponentsRequest) GetRequest() *v12.ListComponentsRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ListComponentsRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// ListComponentsResponse is the response of ComponentLister.ListComponents.
+type ListComponentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v12.ListComponentsResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentsResponse) Reset() {
+	*x = ListComponentsResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentsResponse) ProtoMessage() {}
+
+func (x *ListComponentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentsResponse.ProtoReflect.Descriptor instead.
+func (*ListComponentsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListComponentsResponse) GetResponse() *v12.ListComponentsResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// AddComponentVersionRequest is the request of ComponentVersionRepository.AddComponentVersion.
+type AddComponentVersionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v1.PostComponentVersionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddComponentVersionRequest) Reset() {
+	*x = AddComponentVersionRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddComponentVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddComponentVersionRequest) ProtoMessage() {}
+
+func (x *AddComponentVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddComponentVersionRequest.ProtoReflect.Descriptor instead.
+func (*AddComponentVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddComponentVersionRequest) GetRequest() *v1.PostComponentVersionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AddComponentVersionRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// AddComponentVersionResponse is the response of ComponentVersionRepository.AddComponentVersion.
+type AddComponentVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddComponentVersionResponse) Reset() {
+	*x = AddComponentVersionResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddComponentVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddComponentVersionResponse) ProtoMessage() {}
+
+func (x *AddComponentVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddComponentVersionResponse.ProtoReflect.Descriptor instead.
+func (*AddComponentVersionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{9}
+}
+
+// GetComponentVersionRequest is the request of ComponentVersionRepository.GetComponentVersion.
+type GetComponentVersionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the query parameter name of the endpoint.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the query parameter version of the endpoint.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,3,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComponentVersionRequest) Reset() {
+	*x = GetComponentVersionRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComponentVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComponentVersionRequest) ProtoMessage() {}
+
+func (x *GetComponentVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComponentVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetComponentVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetComponentVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetComponentVersionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetComponentVersionRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// GetComponentVersionResponse is the response of ComponentVersionRepository.GetComponentVersion.
+type GetComponentVersionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v2.Descriptor `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetComponentVersionResponse) Reset() {
+	*x = GetComponentVersionResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComponentVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComponentVersionResponse) ProtoMessage() {}
+
+func (x *GetComponentVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComponentVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetComponentVersionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetComponentVersionResponse) GetResponse() *v2.Descriptor {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// ListComponentVersionsRequest is the request of ComponentVersionRepository.ListComponentVersions.
+type ListComponentVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the query parameter name of the endpoint.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentVersionsRequest) Reset() {
+	*x = ListComponentVersionsRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentVersionsRequest) ProtoMessage() {}
+
+func (x *ListComponentVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListComponentVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListComponentVersionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListComponentVersionsRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// ListComponentVersionsResponse is the response of ComponentVersionRepository.ListComponentVersions.
+type ListComponentVersionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      []string `protobuf:"bytes,1,rep,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentVersionsResponse) Reset() {
+	*x = ListComponentVersionsResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentVersionsResponse) ProtoMessage() {}
+
+func (x *ListComponentVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListComponentVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListComponentVersionsResponse) GetResponse() []string {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// AddLocalResourceRequest is the request of ComponentVersionRepository.AddLocalResource.
+type AddLocalResourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v1.PostLocalResourceRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// blob is a chunk of the content of the request location. It is only set in the messages following the first one.
+	Blob          []byte `protobuf:"bytes,3,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLocalResourceRequest) Reset() {
+	*x = AddLocalResourceRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLocalResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLocalResourceRequest) ProtoMessage() {}
+
+func (x *AddLocalResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLocalResourceRequest.ProtoReflect.Descriptor instead.
+func (*AddLocalResourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddLocalResourceRequest) GetRequest() *v1.PostLocalResourceRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AddLocalResourceRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *AddLocalResourceRequest) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// AddLocalResourceResponse is the response of ComponentVersionRepository.AddLocalResource.
+type AddLocalResourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v2.Resource `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLocalResourceResponse) Reset() {
+	*x = AddLocalResourceResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLocalResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLocalResourceResponse) ProtoMessage() {}
+
+func (x *AddLocalResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLocalResourceResponse.ProtoReflect.Descriptor instead.
+func (*AddLocalResourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddLocalResourceResponse) GetResponse() *v2.Resource {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// GetLocalResourceRequest is the request of ComponentVersionRepository.GetLocalResource.
+type GetLocalResourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the query parameter name of the endpoint.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the query parameter version of the endpoint.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// identity is the query parameter identity of the endpoint.
+	Identity map[string]string `protobuf:"bytes,3,rep,name=identity,proto3" json:"identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocalResourceRequest) Reset() {
+	*x = GetLocalResourceRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocalResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalResourceRequest) ProtoMessage() {}
+
+func (x *GetLocalResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalResourceRequest.ProtoReflect.Descriptor instead.
+func (*GetLocalResourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetLocalResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetLocalResourceRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetLocalResourceRequest) GetIdentity() map[string]string {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *GetLocalResourceRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// GetLocalResourceResponse is the response of ComponentVersionRepository.GetLocalResource.
+type GetLocalResourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response *v1.GetLocalResourceResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// blob is a chunk of the content of the response location. It is only set in the messages following the first one.
+	Blob          []byte `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocalResourceResponse) Reset() {
+	*x = GetLocalResourceResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocalResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalResourceResponse) ProtoMessage() {}
+
+func (x *GetLocalResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalResourceResponse.ProtoReflect.Descriptor instead.
+func (*GetLocalResourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetLocalResourceResponse) GetResponse() *v1.GetLocalResourceResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetLocalResourceResponse) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// AddLocalSourceRequest is the request of ComponentVersionRepository.AddLocalSource.
+type AddLocalSourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v1.PostLocalSourceRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// blob is a chunk of the content of the request location. It is only set in the messages following the first one.
+	Blob          []byte `protobuf:"bytes,3,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLocalSourceRequest) Reset() {
+	*x = AddLocalSourceRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLocalSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLocalSourceRequest) ProtoMessage() {}
+
+func (x *AddLocalSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLocalSourceRequest.ProtoReflect.Descriptor instead.
+func (*AddLocalSourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddLocalSourceRequest) GetRequest() *v1.PostLocalSourceRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AddLocalSourceRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *AddLocalSourceRequest) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// AddLocalSourceResponse is the response of ComponentVersionRepository.AddLocalSource.
+type AddLocalSourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v2.Source `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddLocalSourceResponse) Reset() {
+	*x = AddLocalSourceResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLocalSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLocalSourceResponse) ProtoMessage() {}
+
+func (x *AddLocalSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLocalSourceResponse.ProtoReflect.Descriptor instead.
+func (*AddLocalSourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AddLocalSourceResponse) GetResponse() *v2.Source {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// GetLocalSourceRequest is the request of ComponentVersionRepository.GetLocalSource.
+type GetLocalSourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the query parameter name of the endpoint.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the query parameter version of the endpoint.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// identity is the query parameter identity of the endpoint.
+	Identity map[string]string `protobuf:"bytes,3,rep,name=identity,proto3" json:"identity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocalSourceRequest) Reset() {
+	*x = GetLocalSourceRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocalSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalSourceRequest) ProtoMessage() {}
+
+func (x *GetLocalSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalSourceRequest.ProtoReflect.Descriptor instead.
+func (*GetLocalSourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetLocalSourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetLocalSourceRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetLocalSourceRequest) GetIdentity() map[string]string {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *GetLocalSourceRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// GetLocalSourceResponse is the response of ComponentVersionRepository.GetLocalSource.
+type GetLocalSourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response *v1.GetLocalSourceResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// blob is a chunk of the content of the response location. It is only set in the messages following the first one.
+	Blob          []byte `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocalSourceResponse) Reset() {
+	*x = GetLocalSourceResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocalSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocalSourceResponse) ProtoMessage() {}
+
+func (x *GetLocalSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocalSourceResponse.ProtoReflect.Descriptor instead.
+func (*GetLocalSourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetLocalSourceResponse) GetResponse() *v1.GetLocalSourceResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetLocalSourceResponse) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// CheckHealthRequest is the request of ComponentVersionRepository.CheckHealth.
+type CheckHealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v1.PostCheckHealthRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckHealthRequest) Reset() {
+	*x = CheckHealthRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckHealthRequest) ProtoMessage() {}
+
+func (x *CheckHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckHealthRequest.ProtoReflect.Descriptor instead.
+func (*CheckHealthRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckHealthRequest) GetRequest() *v1.PostCheckHealthRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *CheckHealthRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// CheckHealthResponse is the response of ComponentVersionRepository.CheckHealth.
+type CheckHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckHealthResponse) Reset() {
+	*x = CheckHealthResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckHealthResponse) ProtoMessage() {}
+
+func (x *CheckHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckHealthResponse.ProtoReflect.Descriptor instead.
+func (*CheckHealthResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{23}
+}
+
+// ConsumerIdentityForConfigRequest is the request of CredentialRepository.ConsumerIdentityForConfig.
+type ConsumerIdentityForConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request       *v13.ConsumerIdentityForConfigRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumerIdentityForConfigRequest) Reset() {
+	*x = ConsumerIdentityForConfigRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerIdentityForConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerIdentityForConfigRequest) ProtoMessage() {}
+
+func (x *ConsumerIdentityForConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerIdentityForConfigRequest.ProtoReflect.Descriptor instead.
+func (*ConsumerIdentityForConfigRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ConsumerIdentityForConfigRequest) GetRequest() *v13.ConsumerIdentityForConfigRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// ConsumerIdentityForConfigResponse is the response of CredentialRepository.ConsumerIdentityForConfig.
+type ConsumerIdentityForConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      map[string]string `protobuf:"bytes,1,rep,name=response,proto3" json:"response,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumerIdentityForConfigResponse) Reset() {
+	*x = ConsumerIdentityForConfigResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerIdentityForConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerIdentityForConfigResponse) ProtoMessage() {}
+
+func (x *ConsumerIdentityForConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerIdentityForConfigResponse.ProtoReflect.Descriptor instead.
+func (*ConsumerIdentityForConfigResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ConsumerIdentityForConfigResponse) GetResponse() map[string]string {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// ResolveRequest is the request of CredentialRepository.Resolve.
+type ResolveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v13.ResolveRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveRequest) Reset() {
+	*x = ResolveRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRequest) ProtoMessage() {}
+
+func (x *ResolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRequest.ProtoReflect.Descriptor instead.
+func (*ResolveRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ResolveRequest) GetRequest() *v13.ResolveRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ResolveRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// ResolveResponse is the response of CredentialRepository.Resolve.
+type ResolveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *types.JSON `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveResponse) Reset() {
+	*x = ResolveResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveResponse) ProtoMessage() {}
+
+func (x *ResolveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveResponse.ProtoReflect.Descriptor instead.
+func (*ResolveResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ResolveResponse) GetResponse() *types.JSON {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// ProcessResourceDigestRequest is the request of DigestProcessor.ProcessResourceDigest.
+type ProcessResourceDigestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v14.ProcessResourceDigestRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResourceDigestRequest) Reset() {
+	*x = ProcessResourceDigestRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResourceDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResourceDigestRequest) ProtoMessage() {}
+
+func (x *ProcessResourceDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResourceDigestRequest.ProtoReflect.Descriptor instead.
+func (*ProcessResourceDigestRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProcessResourceDigestRequest) GetRequest() *v14.ProcessResourceDigestRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ProcessResourceDigestRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// ProcessResourceDigestResponse is the response of DigestProcessor.ProcessResourceDigest.
+type ProcessResourceDigestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v14.ProcessResourceDigestResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResourceDigestResponse) Reset() {
+	*x = ProcessResourceDigestResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResourceDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResourceDigestResponse) ProtoMessage() {}
+
+func (x *ProcessResourceDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResourceDigestResponse.ProtoReflect.Descriptor instead.
+func (*ProcessResourceDigestResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ProcessResourceDigestResponse) GetResponse() *v14.ProcessResourceDigestResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// ProcessResourceRequest is the request of Input.ProcessResource.
+type ProcessResourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v15.ProcessResourceInputRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResourceRequest) Reset() {
+	*x = ProcessResourceRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResourceRequest) ProtoMessage() {}
+
+func (x *ProcessResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResourceRequest.ProtoReflect.Descriptor instead.
+func (*ProcessResourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProcessResourceRequest) GetRequest() *v15.ProcessResourceInputRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ProcessResourceRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// ProcessResourceResponse is the response of Input.ProcessResource.
+type ProcessResourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response *v15.ProcessResourceInputResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// blob is a chunk of the content of the response location. It is only set in the messages following the first one.
+	Blob          []byte `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResourceResponse) Reset() {
+	*x = ProcessResourceResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResourceResponse) ProtoMessage() {}
+
+func (x *ProcessResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResourceResponse.ProtoReflect.Descriptor instead.
+func (*ProcessResourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProcessResourceResponse) GetResponse() *v15.ProcessResourceInputResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ProcessResourceResponse) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// ProcessSourceRequest is the request of Input.ProcessSource.
+type ProcessSourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v15.ProcessSourceInputRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessSourceRequest) Reset() {
+	*x = ProcessSourceRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessSourceRequest) ProtoMessage() {}
+
+func (x *ProcessSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessSourceRequest.ProtoReflect.Descriptor instead.
+func (*ProcessSourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProcessSourceRequest) GetRequest() *v15.ProcessSourceInputRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ProcessSourceRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// ProcessSourceResponse is the response of Input.ProcessSource.
+type ProcessSourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response *v15.ProcessSourceInputResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// blob is a chunk of the content of the response location. It is only set in the messages following the first one.
+	Blob          []byte `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessSourceResponse) Reset() {
+	*x = ProcessSourceResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessSourceResponse) ProtoMessage() {}
+
+func (x *ProcessSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessSourceResponse.ProtoReflect.Descriptor instead.
+func (*ProcessSourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ProcessSourceResponse) GetResponse() *v15.ProcessSourceInputResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ProcessSourceResponse) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// GetGlobalResourceRequest is the request of Resource.GetGlobalResource.
+type GetGlobalResourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v16.GetGlobalResourceRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGlobalResourceRequest) Reset() {
+	*x = GetGlobalResourceRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGlobalResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGlobalResourceRequest) ProtoMessage() {}
+
+func (x *GetGlobalResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGlobalResourceRequest.ProtoReflect.Descriptor instead.
+func (*GetGlobalResourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetGlobalResourceRequest) GetRequest() *v16.GetGlobalResourceRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *GetGlobalResourceRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// GetGlobalResourceResponse is the response of Resource.GetGlobalResource.
+type GetGlobalResourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response *v16.GetGlobalResourceResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// blob is a chunk of the content of the response location. It is only set in the messages following the first one.
+	Blob          []byte `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGlobalResourceResponse) Reset() {
+	*x = GetGlobalResourceResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGlobalResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGlobalResourceResponse) ProtoMessage() {}
+
+func (x *GetGlobalResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGlobalResourceResponse.ProtoReflect.Descriptor instead.
+func (*GetGlobalResourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetGlobalResourceResponse) GetResponse() *v16.GetGlobalResourceResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetGlobalResourceResponse) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// AddGlobalResourceRequest is the request of Resource.AddGlobalResource.
+type AddGlobalResourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v16.AddGlobalResourceRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// blob is a chunk of the content of the request location. It is only set in the messages following the first one.
+	Blob          []byte `protobuf:"bytes,3,opt,name=blob,proto3" json:"blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGlobalResourceRequest) Reset() {
+	*x = AddGlobalResourceRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGlobalResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGlobalResourceRequest) ProtoMessage() {}
+
+func (x *AddGlobalResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGlobalResourceRequest.ProtoReflect.Descriptor instead.
+func (*AddGlobalResourceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AddGlobalResourceRequest) GetRequest() *v16.AddGlobalResourceRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AddGlobalResourceRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *AddGlobalResourceRequest) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// AddGlobalResourceResponse is the response of Resource.AddGlobalResource.
+type AddGlobalResourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v16.AddGlobalResourceResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGlobalResourceResponse) Reset() {
+	*x = AddGlobalResourceResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGlobalResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGlobalResourceResponse) ProtoMessage() {}
+
+func (x *AddGlobalResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGlobalResourceResponse.ProtoReflect.Descriptor instead.
+func (*AddGlobalResourceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddGlobalResourceResponse) GetResponse() *v16.AddGlobalResourceResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// GetSignerIdentityRequest is the request of SignatureHandler.GetSignerIdentity.
+type GetSignerIdentityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request       *v17.GetSignerIdentityRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignerIdentityRequest) Reset() {
+	*x = GetSignerIdentityRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignerIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignerIdentityRequest) ProtoMessage() {}
+
+func (x *GetSignerIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignerIdentityRequest.ProtoReflect.Descriptor instead.
+func (*GetSignerIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetSignerIdentityRequest) GetRequest() *v17.GetSignerIdentityRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// GetSignerIdentityResponse is the response of SignatureHandler.GetSignerIdentity.
+type GetSignerIdentityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v17.IdentityResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignerIdentityResponse) Reset() {
+	*x = GetSignerIdentityResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignerIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignerIdentityResponse) ProtoMessage() {}
+
+func (x *GetSignerIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignerIdentityResponse.ProtoReflect.Descriptor instead.
+func (*GetSignerIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetSignerIdentityResponse) GetResponse() *v17.IdentityResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// GetVerifierIdentityRequest is the request of SignatureHandler.GetVerifierIdentity.
+type GetVerifierIdentityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request       *v17.GetVerifierIdentityRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerifierIdentityRequest) Reset() {
+	*x = GetVerifierIdentityRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerifierIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerifierIdentityRequest) ProtoMessage() {}
+
+func (x *GetVerifierIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerifierIdentityRequest.ProtoReflect.Descriptor instead.
+func (*GetVerifierIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetVerifierIdentityRequest) GetRequest() *v17.GetVerifierIdentityRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// GetVerifierIdentityResponse is the response of SignatureHandler.GetVerifierIdentity.
+type GetVerifierIdentityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v17.IdentityResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerifierIdentityResponse) Reset() {
+	*x = GetVerifierIdentityResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerifierIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerifierIdentityResponse) ProtoMessage() {}
+
+func (x *GetVerifierIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerifierIdentityResponse.ProtoReflect.Descriptor instead.
+func (*GetVerifierIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetVerifierIdentityResponse) GetResponse() *v17.IdentityResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// SignRequest is the request of SignatureHandler.Sign.
+type SignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v17.SignRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignRequest) Reset() {
+	*x = SignRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignRequest) ProtoMessage() {}
+
+func (x *SignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignRequest.ProtoReflect.Descriptor instead.
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SignRequest) GetRequest() *v17.SignRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *SignRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// SignResponse is the response of SignatureHandler.Sign.
+type SignResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v17.SignResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignResponse) Reset() {
+	*x = SignResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignResponse) ProtoMessage() {}
+
+func (x *SignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignResponse.ProtoReflect.Descriptor instead.
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SignResponse) GetResponse() *v17.SignResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// VerifyRequest is the request of SignatureHandler.Verify.
+type VerifyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v17.VerifyRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyRequest) Reset() {
+	*x = VerifyRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRequest) ProtoMessage() {}
+
+func (x *VerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *VerifyRequest) GetRequest() *v17.VerifyRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *VerifyRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// VerifyResponse is the response of SignatureHandler.Verify.
+type VerifyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v17.VerifyResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyResponse) Reset() {
+	*x = VerifyResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyResponse) ProtoMessage() {}
+
+func (x *VerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyResponse.ProtoReflect.Descriptor instead.
+func (*VerifyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *VerifyResponse) GetResponse() *v17.VerifyResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// TransformRequest is the request of Transformation.Transform.
+type TransformRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request is the request of the endpoint.
+	Request *v18.TransformRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// credentials are the credentials of the call.
+	Credentials   *types.JSON `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformRequest) Reset() {
+	*x = TransformRequest{}
+	mi := &file_v1_plugin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformRequest) ProtoMessage() {}
+
+func (x *TransformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformRequest.ProtoReflect.Descriptor instead.
+func (*TransformRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TransformRequest) GetRequest() *v18.TransformRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *TransformRequest) GetCredentials() *types.JSON {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// TransformResponse is the response of Transformation.Transform.
+type TransformResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the response of the endpoint.
+	Response      *v18.TransformResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransformResponse) Reset() {
+	*x = TransformResponse{}
+	mi := &file_v1_plugin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformResponse) ProtoMessage() {}
+
+func (x *TransformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plugin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformResponse.ProtoReflect.Descriptor instead.
+func (*TransformResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plugin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TransformResponse) GetResponse() *v18.TransformResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+var File_v1_plugin_proto protoreflect.FileDescriptor
+
+const file_v1_plugin_proto_rawDesc = "" +
+	"\n" +
+	"\x0fv1/plugin.proto\x12 ocm.software.plugin.transport.v1\x1a+v1/contracts/blobtransformer/v1/types.proto\x1a+v1/contracts/componentlister/v1/types.proto\x1a'v1/contracts/credentials/v1/types.proto\x1a+v1/contracts/digestprocessor/v1/types.proto\x1a!v1/contracts/input/v1/types.proto\x1a)v1/contracts/ocmrepository/v1/types.proto\x1a$v1/contracts/resource/v1/types.proto\x1a#v1/contracts/signing/v1/types.proto\x1a*v1/contracts/transformation/v1/types.proto\x1a\x1cv1/descriptor/v2/types.proto\x1a\x14v1/types/types.proto\"\x10\n" +
+	"\x0eHealthzRequest\"\x11\n" +
+	"\x0fHealthzResponse\"\x7f\n" +
+	"\x12GetIdentityRequest\x12i\n" +
+	"\arequest\x18\x01 \x01(\v2O.ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityRequestR\arequest\"\x83\x01\n" +
+	"\x13GetIdentityResponse\x12l\n" +
+	"\bresponse\x18\x01 \x01(\v2P.ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityResponseR\bresponse\"\xe9\x01\n" +
+	"\x14TransformBlobRequest\x12m\n" +
+	"\arequest\x18\x01 \x01(\v2S.ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\x12\x12\n" +
+	"\x04blob\x18\x03 \x01(\fR\x04blob\"\x9d\x01\n" +
+	"\x15TransformBlobResponse\x12p\n" +
+	"\bresponse\x18\x01 \x01(\v2T.ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobResponseR\bresponse\x12\x12\n" +
+	"\x04blob\x18\x02 \x01(\fR\x04blob\"\xd7\x01\n" +
+	"\x15ListComponentsRequest\x12n\n" +
+	"\arequest\x18\x01 \x01(\v2T.ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"\x8b\x01\n" +
+	"\x16ListComponentsResponse\x12q\n" +
+	"\bresponse\x18\x01 \x01(\v2U.ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsResponseR\bresponse\"\xe0\x01\n" +
+	"\x1aAddComponentVersionRequest\x12r\n" +
+	"\arequest\x18\x01 \x01(\v2X.ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostComponentVersionRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"\x1d\n" +
+	"\x1bAddComponentVersionResponse\"\x9a\x01\n" +
+	"\x1aGetComponentVersionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12N\n" +
+	"\vcredentials\x18\x03 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"u\n" +
+	"\x1bGetComponentVersionResponse\x12V\n" +
+	"\bresponse\x18\x01 \x01(\v2:.ocm.software.plugin.transport.v1.descriptor.v2.DescriptorR\bresponse\"\x82\x01\n" +
+	"\x1cListComponentVersionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\";\n" +
+	"\x1dListComponentVersionsResponse\x12\x1a\n" +
+	"\bresponse\x18\x01 \x03(\tR\bresponse\"\xee\x01\n" +
+	"\x17AddLocalResourceRequest\x12o\n" +
+	"\arequest\x18\x01 \x01(\v2U.ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalResourceRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\x12\x12\n" +
+	"\x04blob\x18\x03 \x01(\fR\x04blob\"p\n" +
+	"\x18AddLocalResourceResponse\x12T\n" +
+	"\bresponse\x18\x01 \x01(\v28.ocm.software.plugin.transport.v1.descriptor.v2.ResourceR\bresponse\"\xb9\x02\n" +
+	"\x17GetLocalResourceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12c\n" +
+	"\bidentity\x18\x03 \x03(\v2G.ocm.software.plugin.transport.v1.GetLocalResourceRequest.IdentityEntryR\bidentity\x12N\n" +
+	"\vcredentials\x18\x04 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\x1a;\n" +
+	"\rIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa1\x01\n" +
+	"\x18GetLocalResourceResponse\x12q\n" +
+	"\bresponse\x18\x01 \x01(\v2U.ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalResourceResponseR\bresponse\x12\x12\n" +
+	"\x04blob\x18\x02 \x01(\fR\x04blob\"\xea\x01\n" +
+	"\x15AddLocalSourceRequest\x12m\n" +
+	"\arequest\x18\x01 \x01(\v2S.ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalSourceRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\x12\x12\n" +
+	"\x04blob\x18\x03 \x01(\fR\x04blob\"l\n" +
+	"\x16AddLocalSourceResponse\x12R\n" +
+	"\bresponse\x18\x01 \x01(\v26.ocm.software.plugin.transport.v1.descriptor.v2.SourceR\bresponse\"\xb5\x02\n" +
+	"\x15GetLocalSourceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12a\n" +
+	"\bidentity\x18\x03 \x03(\v2E.ocm.software.plugin.transport.v1.GetLocalSourceRequest.IdentityEntryR\bidentity\x12N\n" +
+	"\vcredentials\x18\x04 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\x1a;\n" +
+	"\rIdentityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9d\x01\n" +
+	"\x16GetLocalSourceResponse\x12o\n" +
+	"\bresponse\x18\x01 \x01(\v2S.ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalSourceResponseR\bresponse\x12\x12\n" +
+	"\x04blob\x18\x02 \x01(\fR\x04blob\"\xd3\x01\n" +
+	"\x12CheckHealthRequest\x12m\n" +
+	"\arequest\x18\x01 \x01(\v2S.ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostCheckHealthRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"\x15\n" +
+	"\x13CheckHealthResponse\"\x99\x01\n" +
+	" ConsumerIdentityForConfigRequest\x12u\n" +
+	"\arequest\x18\x01 \x01(\v2[.ocm.software.plugin.transport.v1.contracts.credentials.v1.ConsumerIdentityForConfigRequestR\arequest\"\xcf\x01\n" +
+	"!ConsumerIdentityForConfigResponse\x12m\n" +
+	"\bresponse\x18\x01 \x03(\v2Q.ocm.software.plugin.transport.v1.ConsumerIdentityForConfigResponse.ResponseEntryR\bresponse\x1a;\n" +
+	"\rResponseEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc5\x01\n" +
+	"\x0eResolveRequest\x12c\n" +
+	"\arequest\x18\x01 \x01(\v2I.ocm.software.plugin.transport.v1.contracts.credentials.v1.ResolveRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"[\n" +
+	"\x0fResolveResponse\x12H\n" +
+	"\bresponse\x18\x01 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\bresponse\"\xe5\x01\n" +
+	"\x1cProcessResourceDigestRequest\x12u\n" +
+	"\arequest\x18\x01 \x01(\v2[.ocm.software.plugin.transport.v1.contracts.digestprocessor.v1.ProcessResourceDigestRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"\x99\x01\n" +
+	"\x1dProcessResourceDigestResponse\x12x\n" +
+	"\bresponse\x18\x01 \x01(\v2\\.ocm.software.plugin.transport.v1.contracts.digestprocessor.v1.ProcessResourceDigestResponseR\bresponse\"\xd4\x01\n" +
+	"\x16ProcessResourceRequest\x12j\n" +
+	"\arequest\x18\x01 \x01(\v2P.ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"\x9c\x01\n" +
+	"\x17ProcessResourceResponse\x12m\n" +
+	"\bresponse\x18\x01 \x01(\v2Q.ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputResponseR\bresponse\x12\x12\n" +
+	"\x04blob\x18\x02 \x01(\fR\x04blob\"\xd0\x01\n" +
+	"\x14ProcessSourceRequest\x12h\n" +
+	"\arequest\x18\x01 \x01(\v2N.ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"\x98\x01\n" +
+	"\x15ProcessSourceResponse\x12k\n" +
+	"\bresponse\x18\x01 \x01(\v2O.ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputResponseR\bresponse\x12\x12\n" +
+	"\x04blob\x18\x02 \x01(\fR\x04blob\"\xd6\x01\n" +
+	"\x18GetGlobalResourceRequest\x12j\n" +
+	"\arequest\x18\x01 \x01(\v2P.ocm.software.plugin.transport.v1.contracts.resource.v1.GetGlobalResourceRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"\x9e\x01\n" +
+	"\x19GetGlobalResourceResponse\x12m\n" +
+	"\bresponse\x18\x01 \x01(\v2Q.ocm.software.plugin.transport.v1.contracts.resource.v1.GetGlobalResourceResponseR\bresponse\x12\x12\n" +
+	"\x04blob\x18\x02 \x01(\fR\x04blob\"\xea\x01\n" +
+	"\x18AddGlobalResourceRequest\x12j\n" +
+	"\arequest\x18\x01 \x01(\v2P.ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\x12\x12\n" +
+	"\x04blob\x18\x03 \x01(\fR\x04blob\"\x8a\x01\n" +
+	"\x19AddGlobalResourceResponse\x12m\n" +
+	"\bresponse\x18\x01 \x01(\v2Q.ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceResponseR\bresponse\"\x85\x01\n" +
+	"\x18GetSignerIdentityRequest\x12i\n" +
+	"\arequest\x18\x01 \x01(\v2O.ocm.software.plugin.transport.v1.contracts.signing.v1.GetSignerIdentityRequestR\arequest\"\x80\x01\n" +
+	"\x19GetSignerIdentityResponse\x12c\n" +
+	"\bresponse\x18\x01 \x01(\v2G.ocm.software.plugin.transport.v1.contracts.signing.v1.IdentityResponseR\bresponse\"\x89\x01\n" +
+	"\x1aGetVerifierIdentityRequest\x12k\n" +
+	"\arequest\x18\x01 \x01(\v2Q.ocm.software.plugin.transport.v1.contracts.signing.v1.GetVerifierIdentityRequestR\arequest\"\x82\x01\n" +
+	"\x1bGetVerifierIdentityResponse\x12c\n" +
+	"\bresponse\x18\x01 \x01(\v2G.ocm.software.plugin.transport.v1.contracts.signing.v1.IdentityResponseR\bresponse\"\xbb\x01\n" +
+	"\vSignRequest\x12\\\n" +
+	"\arequest\x18\x01 \x01(\v2B.ocm.software.plugin.transport.v1.contracts.signing.v1.SignRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"o\n" +
+	"\fSignResponse\x12_\n" +
+	"\bresponse\x18\x01 \x01(\v2C.ocm.software.plugin.transport.v1.contracts.signing.v1.SignResponseR\bresponse\"\xbf\x01\n" +
+	"\rVerifyRequest\x12^\n" +
+	"\arequest\x18\x01 \x01(\v2D.ocm.software.plugin.transport.v1.contracts.signing.v1.VerifyRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"s\n" +
+	"\x0eVerifyResponse\x12a\n" +
+	"\bresponse\x18\x01 \x01(\v2E.ocm.software.plugin.transport.v1.contracts.signing.v1.VerifyResponseR\bresponse\"\xcc\x01\n" +
+	"\x10TransformRequest\x12h\n" +
+	"\arequest\x18\x01 \x01(\v2N.ocm.software.plugin.transport.v1.contracts.transformation.v1.TransformRequestR\arequest\x12N\n" +
+	"\vcredentials\x18\x02 \x01(\v2,.ocm.software.plugin.transport.v1.types.JSONR\vcredentials\"\x80\x01\n" +
+	"\x11TransformResponse\x12k\n" +
+	"\bresponse\x18\x01 \x01(\v2O.ocm.software.plugin.transport.v1.contracts.transformation.v1.TransformResponseR\bresponse2\xf4\x01\n" +
+	"\x06Plugin\x12n\n" +
+	"\aHealthz\x120.ocm.software.plugin.transport.v1.HealthzRequest\x1a1.ocm.software.plugin.transport.v1.HealthzResponse\x12z\n" +
+	"\vGetIdentity\x124.ocm.software.plugin.transport.v1.GetIdentityRequest\x1a5.ocm.software.plugin.transport.v1.GetIdentityResponse2\x98\x01\n" +
+	"\x0fBlobTransformer\x12\x84\x01\n" +
+	"\rTransformBlob\x126.ocm.software.plugin.transport.v1.TransformBlobRequest\x1a7.ocm.software.plugin.transport.v1.TransformBlobResponse(\x010\x012\x97\x01\n" +
+	"\x0fComponentLister\x12\x83\x01\n" +
+	"\x0eListComponents\x127.ocm.software.plugin.transport.v1.ListComponentsRequest\x1a8.ocm.software.plugin.transport.v1.ListComponentsResponse2\x89\t\n" +
+	"\x1aComponentVersionRepository\x12\x92\x01\n" +
+	"\x13AddComponentVersion\x12<.ocm.software.plugin.transport.v1.AddComponentVersionRequest\x1a=.ocm.software.plugin.transport.v1.AddComponentVersionResponse\x12\x92\x01\n" +
+	"\x13GetComponentVersion\x12<.ocm.software.plugin.transport.v1.GetComponentVersionRequest\x1a=.ocm.software.plugin.transport.v1.GetComponentVersionResponse\x12\x98\x01\n" +
+	"\x15ListComponentVersions\x12>.ocm.software.plugin.transport.v1.ListComponentVersionsRequest\x1a?.ocm.software.plugin.transport.v1.ListComponentVersionsResponse\x12\x8b\x01\n" +
+	"\x10AddLocalResource\x129.ocm.software.plugin.transport.v1.AddLocalResourceRequest\x1a:.ocm.software.plugin.transport.v1.AddLocalResourceResponse(\x01\x12\x8b\x01\n" +
+	"\x10GetLocalResource\x129.ocm.software.plugin.transport.v1.GetLocalResourceRequest\x1a:.ocm.software.plugin.transport.v1.GetLocalResourceResponse0\x01\x12\x85\x01\n" +
+	"\x0eAddLocalSource\x127.ocm.software.plugin.transport.v1.AddLocalSourceRequest\x1a8.ocm.software.plugin.transport.v1.AddLocalSourceResponse(\x01\x12\x85\x01\n" +
+	"\x0eGetLocalSource\x127.ocm.software.plugin.transport.v1.GetLocalSourceRequest\x1a8.ocm.software.plugin.transport.v1.GetLocalSourceResponse0\x01\x12z\n" +
+	"\vCheckHealth\x124.ocm.software.plugin.transport.v1.CheckHealthRequest\x1a5.ocm.software.plugin.transport.v1.CheckHealthResponse2\xad\x02\n" +
+	"\x14CredentialRepository\x12\xa4\x01\n" +
+	"\x19ConsumerIdentityForConfig\x12B.ocm.software.plugin.transport.v1.ConsumerIdentityForConfigRequest\x1aC.ocm.software.plugin.transport.v1.ConsumerIdentityForConfigResponse\x12n\n" +
+	"\aResolve\x120.ocm.software.plugin.transport.v1.ResolveRequest\x1a1.ocm.software.plugin.transport.v1.ResolveResponse2\xac\x01\n" +
+	"\x0fDigestProcessor\x12\x98\x01\n" +
+	"\x15ProcessResourceDigest\x12>.ocm.software.plugin.transport.v1.ProcessResourceDigestRequest\x1a?.ocm.software.plugin.transport.v1.ProcessResourceDigestResponse2\x97\x02\n" +
+	"\x05Input\x12\x88\x01\n" +
+	"\x0fProcessResource\x128.ocm.software.plugin.transport.v1.ProcessResourceRequest\x1a9.ocm.software.plugin.transport.v1.ProcessResourceResponse0\x01\x12\x82\x01\n" +
+	"\rProcessSource\x126.ocm.software.plugin.transport.v1.ProcessSourceRequest\x1a7.ocm.software.plugin.transport.v1.ProcessSourceResponse0\x012\xac\x02\n" +
+	"\bResource\x12\x8e\x01\n" +
+	"\x11GetGlobalResource\x12:.ocm.software.plugin.transport.v1.GetGlobalResourceRequest\x1a;.ocm.software.plugin.transport.v1.GetGlobalResourceResponse0\x01\x12\x8e\x01\n" +
+	"\x11AddGlobalResource\x12:.ocm.software.plugin.transport.v1.AddGlobalResourceRequest\x1a;.ocm.software.plugin.transport.v1.AddGlobalResourceResponse(\x012\x8a\x04\n" +
+	"\x10SignatureHandler\x12\x8c\x01\n" +
+	"\x11GetSignerIdentity\x12:.ocm.software.plugin.transport.v1.GetSignerIdentityRequest\x1a;.ocm.software.plugin.transport.v1.GetSignerIdentityResponse\x12\x92\x01\n" +
+	"\x13GetVerifierIdentity\x12<.ocm.software.plugin.transport.v1.GetVerifierIdentityRequest\x1a=.ocm.software.plugin.transport.v1.GetVerifierIdentityResponse\x12e\n" +
+	"\x04Sign\x12-.ocm.software.plugin.transport.v1.SignRequest\x1a..ocm.software.plugin.transport.v1.SignResponse\x12k\n" +
+	"\x06Verify\x12/.ocm.software.plugin.transport.v1.VerifyRequest\x1a0.ocm.software.plugin.transport.v1.VerifyResponse2\x86\x01\n" +
+	"\x0eTransformation\x12t\n" +
+	"\tTransform\x122.ocm.software.plugin.transport.v1.TransformRequest\x1a3.ocm.software.plugin.transport.v1.TransformResponseBNZLocm.software/open-component-model/bindings/go/plugin/manager/transport/v1;v1b\x06proto3"
+
+var (
+	file_v1_plugin_proto_rawDescOnce sync.Once
+	file_v1_plugin_proto_rawDescData []byte
+)
+
+func file_v1_plugin_proto_rawDescGZIP() []byte {
+	file_v1_plugin_proto_rawDescOnce.Do(func() {
+		file_v1_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_plugin_proto_rawDesc), len(file_v1_plugin_proto_rawDesc)))
+	})
+	return file_v1_plugin_proto_rawDescData
+}
+
+var file_v1_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_v1_plugin_proto_goTypes = []any{
+	(*HealthzRequest)(nil),                       // 0: ocm.software.plugin.transport.v1.HealthzRequest
+	(*HealthzResponse)(nil),                      // 1: ocm.software.plugin.transport.v1.HealthzResponse
+	(*GetIdentityRequest)(nil),                   // 2: ocm.software.plugin.transport.v1.GetIdentityRequest
+	(*GetIdentityResponse)(nil),                  // 3: ocm.software.plugin.transport.v1.GetIdentityResponse
+	(*TransformBlobRequest)(nil),                 // 4: ocm.software.plugin.transport.v1.TransformBlobRequest
+	(*TransformBlobResponse)(nil),                // 5: ocm.software.plugin.transport.v1.TransformBlobResponse
+	(*ListComponentsRequest)(nil),                // 6: ocm.software.plugin.transport.v1.ListComponentsRequest
+	(*ListComponentsResponse)(nil),               // 7: ocm.software.plugin.transport.v1.ListComponentsResponse
+	(*AddComponentVersionRequest)(nil),           // 8: ocm.software.plugin.transport.v1.AddComponentVersionRequest
+	(*AddComponentVersionResponse)(nil),          // 9: ocm.software.plugin.transport.v1.AddComponentVersionResponse
+	(*GetComponentVersionRequest)(nil),           // 10: ocm.software.plugin.transport.v1.GetComponentVersionRequest
+	(*GetComponentVersionResponse)(nil),          // 11: ocm.software.plugin.transport.v1.GetComponentVersionResponse
+	(*ListComponentVersionsRequest)(nil),         // 12: ocm.software.plugin.transport.v1.ListComponentVersionsRequest
+	(*ListComponentVersionsResponse)(nil),        // 13: ocm.software.plugin.transport.v1.ListComponentVersionsResponse
+	(*AddLocalResourceRequest)(nil),              // 14: ocm.software.plugin.transport.v1.AddLocalResourceRequest
+	(*AddLocalResourceResponse)(nil),             // 15: ocm.software.plugin.transport.v1.AddLocalResourceResponse
+	(*GetLocalResourceRequest)(nil),              // 16: ocm.software.plugin.transport.v1.GetLocalResourceRequest
+	(*GetLocalResourceResponse)(nil),             // 17: ocm.software.plugin.transport.v1.GetLocalResourceResponse
+	(*AddLocalSourceRequest)(nil),                // 18: ocm.software.plugin.transport.v1.AddLocalSourceRequest
+	(*AddLocalSourceResponse)(nil),               // 19: ocm.software.plugin.transport.v1.AddLocalSourceResponse
+	(*GetLocalSourceRequest)(nil),                // 20: ocm.software.plugin.transport.v1.GetLocalSourceRequest
+	(*GetLocalSourceResponse)(nil),               // 21: ocm.software.plugin.transport.v1.GetLocalSourceResponse
+	(*CheckHealthRequest)(nil),                   // 22: ocm.software.plugin.transport.v1.CheckHealthRequest
+	(*CheckHealthResponse)(nil),                  // 23: ocm.software.plugin.transport.v1.CheckHealthResponse
+	(*ConsumerIdentityForConfigRequest)(nil),     // 24: ocm.software.plugin.transport.v1.ConsumerIdentityForConfigRequest
+	(*ConsumerIdentityForConfigResponse)(nil),    // 25: ocm.software.plugin.transport.v1.ConsumerIdentityForConfigResponse
+	(*ResolveRequest)(nil),                       // 26: ocm.software.plugin.transport.v1.ResolveRequest
+	(*ResolveResponse)(nil),                      // 27: ocm.software.plugin.transport.v1.ResolveResponse
+	(*ProcessResourceDigestRequest)(nil),         // 28: ocm.software.plugin.transport.v1.ProcessResourceDigestRequest
+	(*ProcessResourceDigestResponse)(nil),        // 29: ocm.software.plugin.transport.v1.ProcessResourceDigestResponse
+	(*ProcessResourceRequest)(nil),               // 30: ocm.software.plugin.transport.v1.ProcessResourceRequest
+	(*ProcessResourceResponse)(nil),              // 31: ocm.software.plugin.transport.v1.ProcessResourceResponse
+	(*ProcessSourceRequest)(nil),                 // 32: ocm.software.plugin.transport.v1.ProcessSourceRequest
+	(*ProcessSourceResponse)(nil),                // 33: ocm.software.plugin.transport.v1.ProcessSourceResponse
+	(*GetGlobalResourceRequest)(nil),             // 34: ocm.software.plugin.transport.v1.GetGlobalResourceRequest
+	(*GetGlobalResourceResponse)(nil),            // 35: ocm.software.plugin.transport.v1.GetGlobalResourceResponse
+	(*AddGlobalResourceRequest)(nil),             // 36: ocm.software.plugin.transport.v1.AddGlobalResourceRequest
+	(*AddGlobalResourceResponse)(nil),            // 37: ocm.software.plugin.transport.v1.AddGlobalResourceResponse
+	(*GetSignerIdentityRequest)(nil),             // 38: ocm.software.plugin.transport.v1.GetSignerIdentityRequest
+	(*GetSignerIdentityResponse)(nil),            // 39: ocm.software.plugin.transport.v1.GetSignerIdentityResponse
+	(*GetVerifierIdentityRequest)(nil),           // 40: ocm.software.plugin.transport.v1.GetVerifierIdentityRequest
+	(*GetVerifierIdentityResponse)(nil),          // 41: ocm.software.plugin.transport.v1.GetVerifierIdentityResponse
+	(*SignRequest)(nil),                          // 42: ocm.software.plugin.transport.v1.SignRequest
+	(*SignResponse)(nil),                         // 43: ocm.software.plugin.transport.v1.SignResponse
+	(*VerifyRequest)(nil),                        // 44: ocm.software.plugin.transport.v1.VerifyRequest
+	(*VerifyResponse)(nil),                       // 45: ocm.software.plugin.transport.v1.VerifyResponse
+	(*TransformRequest)(nil),                     // 46: ocm.software.plugin.transport.v1.TransformRequest
+	(*TransformResponse)(nil),                    // 47: ocm.software.plugin.transport.v1.TransformResponse
+	nil,                                          // 48: ocm.software.plugin.transport.v1.GetLocalResourceRequest.IdentityEntry
+	nil,                                          // 49: ocm.software.plugin.transport.v1.GetLocalSourceRequest.IdentityEntry
+	nil,                                          // 50: ocm.software.plugin.transport.v1.ConsumerIdentityForConfigResponse.ResponseEntry
+	(*v1.GetIdentityRequest)(nil),                // 51: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityRequest
+	(*v1.GetIdentityResponse)(nil),               // 52: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityResponse
+	(*v11.TransformBlobRequest)(nil),             // 53: ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobRequest
+	(*types.JSON)(nil),                           // 54: ocm.software.plugin.transport.v1.types.JSON
+	(*v11.TransformBlobResponse)(nil),            // 55: ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobResponse
+	(*v12.ListComponentsRequest)(nil),            // 56: ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsRequest
+	(*v12.ListComponentsResponse)(nil),           // 57: ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsResponse
+	(*v1.PostComponentVersionRequest)(nil),       // 58: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostComponentVersionRequest
+	(*v2.Descriptor)(nil),                        // 59: ocm.software.plugin.transport.v1.descriptor.v2.Descriptor
+	(*v1.PostLocalResourceRequest)(nil),          // 60: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalResourceRequest
+	(*v2.Resource)(nil),                          // 61: ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	(*v1.GetLocalResourceResponse)(nil),          // 62: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalResourceResponse
+	(*v1.PostLocalSourceRequest)(nil),            // 63: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalSourceRequest
+	(*v2.Source)(nil),                            // 64: ocm.software.plugin.transport.v1.descriptor.v2.Source
+	(*v1.GetLocalSourceResponse)(nil),            // 65: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalSourceResponse
+	(*v1.PostCheckHealthRequest)(nil),            // 66: ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostCheckHealthRequest
+	(*v13.ConsumerIdentityForConfigRequest)(nil), // 67: ocm.software.plugin.transport.v1.contracts.credentials.v1.ConsumerIdentityForConfigRequest
+	(*v13.ResolveRequest)(nil),                   // 68: ocm.software.plugin.transport.v1.contracts.credentials.v1.ResolveRequest
+	(*v14.ProcessResourceDigestRequest)(nil),     // 69: ocm.software.plugin.transport.v1.contracts.digestprocessor.v1.ProcessResourceDigestRequest
+	(*v14.ProcessResourceDigestResponse)(nil),    // 70: ocm.software.plugin.transport.v1.contracts.digestprocessor.v1.ProcessResourceDigestResponse
+	(*v15.ProcessResourceInputRequest)(nil),      // 71: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputRequest
+	(*v15.ProcessResourceInputResponse)(nil),     // 72: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputResponse
+	(*v15.ProcessSourceInputRequest)(nil),        // 73: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputRequest
+	(*v15.ProcessSourceInputResponse)(nil),       // 74: ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputResponse
+	(*v16.GetGlobalResourceRequest)(nil),         // 75: ocm.software.plugin.transport.v1.contracts.resource.v1.GetGlobalResourceRequest
+	(*v16.GetGlobalResourceResponse)(nil),        // 76: ocm.software.plugin.transport.v1.contracts.resource.v1.GetGlobalResourceResponse
+	(*v16.AddGlobalResourceRequest)(nil),         // 77: ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceRequest
+	(*v16.AddGlobalResourceResponse)(nil),        // 78: ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceResponse
+	(*v17.GetSignerIdentityRequest)(nil),         // 79: ocm.software.plugin.transport.v1.contracts.signing.v1.GetSignerIdentityRequest
+	(*v17.IdentityResponse)(nil),                 // 80: ocm.software.plugin.transport.v1.contracts.signing.v1.IdentityResponse
+	(*v17.GetVerifierIdentityRequest)(nil),       // 81: ocm.software.plugin.transport.v1.contracts.signing.v1.GetVerifierIdentityRequest
+	(*v17.SignRequest)(nil),                      // 82: ocm.software.plugin.transport.v1.contracts.signing.v1.SignRequest
+	(*v17.SignResponse)(nil),                     // 83: ocm.software.plugin.transport.v1.contracts.signing.v1.SignResponse
+	(*v17.VerifyRequest)(nil),                    // 84: ocm.software.plugin.transport.v1.contracts.signing.v1.VerifyRequest
+	(*v17.VerifyResponse)(nil),                   // 85: ocm.software.plugin.transport.v1.contracts.signing.v1.VerifyResponse
+	(*v18.TransformRequest)(nil),                 // 86: ocm.software.plugin.transport.v1.contracts.transformation.v1.TransformRequest
+	(*v18.TransformResponse)(nil),                // 87: ocm.software.plugin.transport.v1.contracts.transformation.v1.TransformResponse
+}
+var file_v1_plugin_proto_depIdxs = []int32{
+	51, // 0: ocm.software.plugin.transport.v1.GetIdentityRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityRequest
+	52, // 1: ocm.software.plugin.transport.v1.GetIdentityResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetIdentityResponse
+	53, // 2: ocm.software.plugin.transport.v1.TransformBlobRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobRequest
+	54, // 3: ocm.software.plugin.transport.v1.TransformBlobRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	55, // 4: ocm.software.plugin.transport.v1.TransformBlobResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.blobtransformer.v1.TransformBlobResponse
+	56, // 5: ocm.software.plugin.transport.v1.ListComponentsRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsRequest
+	54, // 6: ocm.software.plugin.transport.v1.ListComponentsRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	57, // 7: ocm.software.plugin.transport.v1.ListComponentsResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.componentlister.v1.ListComponentsResponse
+	58, // 8: ocm.software.plugin.transport.v1.AddComponentVersionRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostComponentVersionRequest
+	54, // 9: ocm.software.plugin.transport.v1.AddComponentVersionRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	54, // 10: ocm.software.plugin.transport.v1.GetComponentVersionRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	59, // 11: ocm.software.plugin.transport.v1.GetComponentVersionResponse.response:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Descriptor
+	54, // 12: ocm.software.plugin.transport.v1.ListComponentVersionsRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	60, // 13: ocm.software.plugin.transport.v1.AddLocalResourceRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalResourceRequest
+	54, // 14: ocm.software.plugin.transport.v1.AddLocalResourceRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	61, // 15: ocm.software.plugin.transport.v1.AddLocalResourceResponse.response:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Resource
+	48, // 16: ocm.software.plugin.transport.v1.GetLocalResourceRequest.identity:type_name -> ocm.software.plugin.transport.v1.GetLocalResourceRequest.IdentityEntry
+	54, // 17: ocm.software.plugin.transport.v1.GetLocalResourceRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	62, // 18: ocm.software.plugin.transport.v1.GetLocalResourceResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalResourceResponse
+	63, // 19: ocm.software.plugin.transport.v1.AddLocalSourceRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostLocalSourceRequest
+	54, // 20: ocm.software.plugin.transport.v1.AddLocalSourceRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	64, // 21: ocm.software.plugin.transport.v1.AddLocalSourceResponse.response:type_name -> ocm.software.plugin.transport.v1.descriptor.v2.Source
+	49, // 22: ocm.software.plugin.transport.v1.GetLocalSourceRequest.identity:type_name -> ocm.software.plugin.transport.v1.GetLocalSourceRequest.IdentityEntry
+	54, // 23: ocm.software.plugin.transport.v1.GetLocalSourceRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	65, // 24: ocm.software.plugin.transport.v1.GetLocalSourceResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.GetLocalSourceResponse
+	66, // 25: ocm.software.plugin.transport.v1.CheckHealthRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.ocmrepository.v1.PostCheckHealthRequest
+	54, // 26: ocm.software.plugin.transport.v1.CheckHealthRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	67, // 27: ocm.software.plugin.transport.v1.ConsumerIdentityForConfigRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.credentials.v1.ConsumerIdentityForConfigRequest
+	50, // 28: ocm.software.plugin.transport.v1.ConsumerIdentityForConfigResponse.response:type_name -> ocm.software.plugin.transport.v1.ConsumerIdentityForConfigResponse.ResponseEntry
+	68, // 29: ocm.software.plugin.transport.v1.ResolveRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.credentials.v1.ResolveRequest
+	54, // 30: ocm.software.plugin.transport.v1.ResolveRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	54, // 31: ocm.software.plugin.transport.v1.ResolveResponse.response:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	69, // 32: ocm.software.plugin.transport.v1.ProcessResourceDigestRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.digestprocessor.v1.ProcessResourceDigestRequest
+	54, // 33: ocm.software.plugin.transport.v1.ProcessResourceDigestRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	70, // 34: ocm.software.plugin.transport.v1.ProcessResourceDigestResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.digestprocessor.v1.ProcessResourceDigestResponse
+	71, // 35: ocm.software.plugin.transport.v1.ProcessResourceRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputRequest
+	54, // 36: ocm.software.plugin.transport.v1.ProcessResourceRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	72, // 37: ocm.software.plugin.transport.v1.ProcessResourceResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.input.v1.ProcessResourceInputResponse
+	73, // 38: ocm.software.plugin.transport.v1.ProcessSourceRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputRequest
+	54, // 39: ocm.software.plugin.transport.v1.ProcessSourceRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	74, // 40: ocm.software.plugin.transport.v1.ProcessSourceResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.input.v1.ProcessSourceInputResponse
+	75, // 41: ocm.software.plugin.transport.v1.GetGlobalResourceRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.resource.v1.GetGlobalResourceRequest
+	54, // 42: ocm.software.plugin.transport.v1.GetGlobalResourceRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	76, // 43: ocm.software.plugin.transport.v1.GetGlobalResourceResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.resource.v1.GetGlobalResourceResponse
+	77, // 44: ocm.software.plugin.transport.v1.AddGlobalResourceRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceRequest
+	54, // 45: ocm.software.plugin.transport.v1.AddGlobalResourceRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	78, // 46: ocm.software.plugin.transport.v1.AddGlobalResourceResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.resource.v1.AddGlobalResourceResponse
+	79, // 47: ocm.software.plugin.transport.v1.GetSignerIdentityRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.signing.v1.GetSignerIdentityRequest
+	80, // 48: ocm.software.plugin.transport.v1.GetSignerIdentityResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.signing.v1.IdentityResponse
+	81, // 49: ocm.software.plugin.transport.v1.GetVerifierIdentityRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.signing.v1.GetVerifierIdentityRequest
+	80, // 50: ocm.software.plugin.transport.v1.GetVerifierIdentityResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.signing.v1.IdentityResponse
+	82, // 51: ocm.software.plugin.transport.v1.SignRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.signing.v1.SignRequest
+	54, // 52: ocm.software.plugin.transport.v1.SignRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	83, // 53: ocm.software.plugin.transport.v1.SignResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.signing.v1.SignResponse
+	84, // 54: ocm.software.plugin.transport.v1.VerifyRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.signing.v1.VerifyRequest
+	54, // 55: ocm.software.plugin.transport.v1.VerifyRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	85, // 56: ocm.software.plugin.transport.v1.VerifyResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.signing.v1.VerifyResponse
+	86, // 57: ocm.software.plugin.transport.v1.TransformRequest.request:type_name -> ocm.software.plugin.transport.v1.contracts.transformation.v1.TransformRequest
+	54, // 58: ocm.software.plugin.transport.v1.TransformRequest.credentials:type_name -> ocm.software.plugin.transport.v1.types.JSON
+	87, // 59: ocm.software.plugin.transport.v1.TransformResponse.response:type_name -> ocm.software.plugin.transport.v1.contracts.transformation.v1.TransformResponse
+	0,  // 60: ocm.software.plugin.transport.v1.Plugin.Healthz:input_type -> ocm.software.plugin.transport.v1.HealthzRequest
+	2,  // 61: ocm.software.plugin.transport.v1.Plugin.GetIdentity:input_type -> ocm.software.plugin.transport.v1.GetIdentityRequest
+	4,  // 62: ocm.software.plugin.transport.v1.BlobTransformer.TransformBlob:input_type -> ocm.software.plugin.transport.v1.TransformBlobRequest
+	6,  // 63: ocm.software.plugin.transport.v1.ComponentLister.ListComponents:input_type -> ocm.software.plugin.transport.v1.ListComponentsRequest
+	8,  // 64: ocm.software.plugin.transport.v1.ComponentVersionRepository.AddComponentVersion:input_type -> ocm.software.plugin.transport.v1.AddComponentVersionRequest
+	10, // 65: ocm.software.plugin.transport.v1.ComponentVersionRepository.GetComponentVersion:input_type -> ocm.software.plugin.transport.v1.GetComponentVersionRequest
+	12, // 66: ocm.software.plugin.transport.v1.ComponentVersionRepository.ListComponentVersions:input_type -> ocm.software.plugin.transport.v1.ListComponentVersionsRequest
+	14, // 67: ocm.software.plugin.transport.v1.ComponentVersionRepository.AddLocalResource:input_type -> ocm.software.plugin.transport.v1.AddLocalResourceRequest
+	16, // 68: ocm.software.plugin.transport.v1.ComponentVersionRepository.GetLocalResource:input_type -> ocm.software.plugin.transport.v1.GetLocalResourceRequest
+	18, // 69: ocm.software.plugin.transport.v1.ComponentVersionRepository.AddLocalSource:input_type -> ocm.software.plugin.transport.v1.AddLocalSourceRequest
+	20, // 70: ocm.software.plugin.transport.v1.ComponentVersionRepository.GetLocalSource:input_type -> ocm.software.plugin.transport.v1.GetLocalSourceRequest
+	22, // 71: ocm.software.plugin.transport.v1.ComponentVersionRepository.CheckHealth:input_type -> ocm.software.plugin.transport.v1.CheckHealthRequest
+	24, // 72: ocm.software.plugin.transport.v1.CredentialRepository.ConsumerIdentityForConfig:input_type -> ocm.software.plugin.transport.v1.ConsumerIdentityForConfigRequest
+	26, // 73: ocm.software.plugin.transport.v1.CredentialRepository.Resolve:input_type -> ocm.software.plugin.transport.v1.ResolveRequest
+	28, // 74: ocm.software.plugin.transport.v1.DigestProcessor.ProcessResourceDigest:input_type -> ocm.software.plugin.transport.v1.ProcessResourceDigestRequest
+	30, // 75: ocm.software.plugin.transport.v1.Input.ProcessResource:input_type -> ocm.software.plugin.transport.v1.ProcessResourceRequest
+	32, // 76: ocm.software.plugin.transport.v1.Input.ProcessSource:input_type -> ocm.software.plugin.transport.v1.ProcessSourceRequest
+	34, // 77: ocm.software.plugin.transport.v1.Resource.GetGlobalResource:input_type -> ocm.software.plugin.transport.v1.GetGlobalResourceRequest
+	36, // 78: ocm.software.plugin.transport.v1.Resource.AddGlobalResource:input_type -> ocm.software.plugin.transport.v1.AddGlobalResourceRequest
+	38, // 79: ocm.software.plugin.transport.v1.SignatureHandler.GetSignerIdentity:input_type -> ocm.software.plugin.transport.v1.GetSignerIdentityRequest
+	40, // 80: ocm.software.plugin.transport.v1.SignatureHandler.GetVerifierIdentity:input_type -> ocm.software.plugin.transport.v1.GetVerifierIdentityRequest
+	42, // 81: ocm.software.plugin.transport.v1.SignatureHandler.Sign:input_type -> ocm.software.plugin.transport.v1.SignRequest
+	44, // 82: ocm.software.plugin.transport.v1.SignatureHandler.Verify:input_type -> ocm.software.plugin.transport.v1.VerifyRequest
+	46, // 83: ocm.software.plugin.transport.v1.Transformation.Transform:input_type -> ocm.software.plugin.transport.v1.TransformRequest
+	1,  // 84: ocm.software.plugin.transport.v1.Plugin.Healthz:output_type -> ocm.software.plugin.transport.v1.HealthzResponse
+	3,  // 85: ocm.software.plugin.transport.v1.Plugin.GetIdentity:output_type -> ocm.software.plugin.transport.v1.GetIdentityResponse
+	5,  // 86: ocm.software.plugin.transport.v1.BlobTransformer.TransformBlob:output_type -> ocm.software.plugin.transport.v1.TransformBlobResponse
+	7,  // 87: ocm.software.plugin.transport.v1.ComponentLister.ListComponents:output_type -> ocm.software.plugin.transport.v1.ListComponentsResponse
+	9,  // 88: ocm.software.plugin.transport.v1.ComponentVersionRepository.AddComponentVersion:output_type -> ocm.software.plugin.transport.v1.AddComponentVersionResponse
+	11, // 89: ocm.software.plugin.transport.v1.ComponentVersionRepository.GetComponentVersion:output_type -> ocm.software.plugin.transport.v1.GetComponentVersionResponse
+	13, // 90: ocm.software.plugin.transport.v1.ComponentVersionRepository.ListComponentVersions:output_type -> ocm.software.plugin.transport.v1.ListComponentVersionsResponse
+	15, // 91: ocm.software.plugin.transport.v1.ComponentVersionRepository.AddLocalResource:output_type -> ocm.software.plugin.transport.v1.AddLocalResourceResponse
+	17, // 92: ocm.software.plugin.transport.v1.ComponentVersionRepository.GetLocalResource:output_type -> ocm.software.plugin.transport.v1.GetLocalResourceResponse
+	19, // 93: ocm.software.plugin.transport.v1.ComponentVersionRepository.AddLocalSource:output_type -> ocm.software.plugin.transport.v1.AddLocalSourceResponse
+	21, // 94: ocm.software.plugin.transport.v1.ComponentVersionRepository.GetLocalSource:output_type -> ocm.software.plugin.transport.v1.GetLocalSourceResponse
+	23, // 95: ocm.software.plugin.transport.v1.ComponentVersionRepository.CheckHealth:output_type -> ocm.software.plugin.transport.v1.CheckHealthResponse
+	25, // 96: ocm.software.plugin.transport.v1.CredentialRepository.ConsumerIdentityForConfig:output_type -> ocm.software.plugin.transport.v1.ConsumerIdentityForConfigResponse
+	27, // 97: ocm.software.plugin.transport.v1.CredentialRepository.Resolve:output_type -> ocm.software.plugin.transport.v1.ResolveResponse
+	29, // 98: ocm.software.plugin.transport.v1.DigestProcessor.ProcessResourceDigest:output_type -> ocm.software.plugin.transport.v1.ProcessResourceDigestResponse
+	31, // 99: ocm.software.plugin.transport.v1.Input.ProcessResource:output_type -> ocm.software.plugin.transport.v1.ProcessResourceResponse
+	33, // 100: ocm.software.plugin.transport.v1.Input.ProcessSource:output_type -> ocm.software.plugin.transport.v1.ProcessSourceResponse
+	35, // 101: ocm.software.plugin.transport.v1.Resource.GetGlobalResource:output_type -> ocm.software.plugin.transport.v1.GetGlobalResourceResponse
+	37, // 102: ocm.software.plugin.transport.v1.Resource.AddGlobalResource:output_type -> ocm.software.plugin.transport.v1.AddGlobalResourceResponse
+	39, // 103: ocm.software.plugin.transport.v1.SignatureHandler.GetSignerIdentity:output_type -> ocm.software.plugin.transport.v1.GetSignerIdentityResponse
+	41, // 104: ocm.software.plugin.transport.v1.SignatureHandler.GetVerifierIdentity:output_type -> ocm.software.plugin.transport.v1.GetVerifierIdentityResponse
+	43, // 105: ocm.software.plugin.transport.v1.SignatureHandler.Sign:output_type -> ocm.software.plugin.transport.v1.SignResponse
+	45, // 106: ocm.software.plugin.transport.v1.SignatureHandler.Verify:output_type -> ocm.software.plugin.transport.v1.VerifyResponse
+	47, // 107: ocm.software.plugin.transport.v1.Transformation.Transform:output_type -> ocm.software.plugin.transport.v1.TransformResponse
+	84, // [84:108] is the sub-list for method output_type
+	60, // [60:84] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
+}
+
+func init() { file_v1_plugin_proto_init() }
+func file_v1_plugin_proto_init() {
+	if File_v1_plugin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_plugin_proto_rawDesc), len(file_v1_plugin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   51,
+			NumExtensions: 0,
+			NumServices:   10,
+		},
+		GoTypes:           file_v1_plugin_proto_goTypes,
+		DependencyIndexes: file_v1_plugin_proto_depIdxs,
+		MessageInfos:      file_v1_plugin_proto_msgTypes,
+	}.Build()
+	File_v1_plugin_proto = out.File
+	file_v1_plugin_proto_goTypes = nil
+	file_v1_plugin_proto_depIdxs = nil
+}
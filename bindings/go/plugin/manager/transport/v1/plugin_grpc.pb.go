@@ -0,0 +1,1671 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: v1/plugin.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Plugin_Healthz_FullMethodName     = "/ocm.software.plugin.transport.v1.Plugin/Healthz"
+	Plugin_GetIdentity_FullMethodName = "/ocm.software.plugin.transport.v1.Plugin/GetIdentity"
+)
+
+// PluginClient is the client API for Plugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Plugin serves the endpoints of the Plugin contract.
+type PluginClient interface {
+	// Healthz serves GET /healthz.
+	Healthz(ctx context.Context, in *HealthzRequest, opts ...grpc.CallOption) (*HealthzResponse, error)
+	// GetIdentity serves POST /identity.
+	GetIdentity(ctx context.Context, in *GetIdentityRequest, opts ...grpc.CallOption) (*GetIdentityResponse, error)
+}
+
+type pluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPluginClient(cc grpc.ClientConnInterface) PluginClient {
+	return &pluginClient{cc}
+}
+
+func (c *pluginClient) Healthz(ctx context.Context, in *HealthzRequest, opts ...grpc.CallOption) (*HealthzResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthzResponse)
+	err := c.cc.Invoke(ctx, Plugin_Healthz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) GetIdentity(ctx context.Context, in *GetIdentityRequest, opts ...grpc.CallOption) (*GetIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIdentityResponse)
+	err := c.cc.Invoke(ctx, Plugin_GetIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PluginServer is the server API for Plugin service.
+// All implementations must embed UnimplementedPluginServer
+// for forward compatibility.
+//
+// Plugin serves the endpoints of the Plugin contract.
+type PluginServer interface {
+	// Healthz serves GET /healthz.
+	Healthz(context.Context, *HealthzRequest) (*HealthzResponse, error)
+	// GetIdentity serves POST /identity.
+	GetIdentity(context.Context, *GetIdentityRequest) (*GetIdentityResponse, error)
+	mustEmbedUnimplementedPluginServer()
+}
+
+// UnimplementedPluginServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPluginServer struct{}
+
+func (UnimplementedPluginServer) Healthz(context.Context, *HealthzRequest) (*HealthzResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Healthz not implemented")
+}
+func (UnimplementedPluginServer) GetIdentity(context.Context, *GetIdentityRequest) (*GetIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentity not implemented")
+}
+func (UnimplementedPluginServer) mustEmbedUnimplementedPluginServer() {}
+func (UnimplementedPluginServer) testEmbeddedByValue()                {}
+
+// UnsafePluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PluginServer will
+// result in compilation errors.
+type UnsafePluginServer interface {
+	mustEmbedUnimplementedPluginServer()
+}
+
+func RegisterPluginServer(s grpc.ServiceRegistrar, srv PluginServer) {
+	// If the following call pancis, it indicates UnimplementedPluginServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Plugin_ServiceDesc, srv)
+}
+
+func _Plugin_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthzRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).Healthz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Plugin_Healthz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).Healthz(ctx, req.(*HealthzRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_GetIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).GetIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Plugin_GetIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).GetIdentity(ctx, req.(*GetIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Plugin_ServiceDesc is the grpc.ServiceDesc for Plugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Plugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocm.software.plugin.transport.v1.Plugin",
+	HandlerType: (*PluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Healthz",
+			Handler:    _Plugin_Healthz_Handler,
+		},
+		{
+			MethodName: "GetIdentity",
+			Handler:    _Plugin_GetIdentity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/plugin.proto",
+}
+
+const (
+	BlobTransformer_TransformBlob_FullMethodName = "/ocm.software.plugin.transport.v1.BlobTransformer/TransformBlob"
+)
+
+// BlobTransformerClient is the client API for BlobTransformer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BlobTransformer serves the endpoints of the BlobTransformer contract.
+type BlobTransformerClient interface {
+	// TransformBlob serves POST /blob/transform.
+	// The content of a local file in the request location is streamed to the plugin as blob chunks.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	TransformBlob(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TransformBlobRequest, TransformBlobResponse], error)
+}
+
+type blobTransformerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlobTransformerClient(cc grpc.ClientConnInterface) BlobTransformerClient {
+	return &blobTransformerClient{cc}
+}
+
+func (c *blobTransformerClient) TransformBlob(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TransformBlobRequest, TransformBlobResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BlobTransformer_ServiceDesc.Streams[0], BlobTransformer_TransformBlob_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TransformBlobRequest, TransformBlobResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BlobTransformer_TransformBlobClient = grpc.BidiStreamingClient[TransformBlobRequest, TransformBlobResponse]
+
+// BlobTransformerServer is the server API for BlobTransformer service.
+// All implementations must embed UnimplementedBlobTransformerServer
+// for forward compatibility.
+//
+// BlobTransformer serves the endpoints of the BlobTransformer contract.
+type BlobTransformerServer interface {
+	// TransformBlob serves POST /blob/transform.
+	// The content of a local file in the request location is streamed to the plugin as blob chunks.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	TransformBlob(grpc.BidiStreamingServer[TransformBlobRequest, TransformBlobResponse]) error
+	mustEmbedUnimplementedBlobTransformerServer()
+}
+
+// UnimplementedBlobTransformerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBlobTransformerServer struct{}
+
+func (UnimplementedBlobTransformerServer) TransformBlob(grpc.BidiStreamingServer[TransformBlobRequest, TransformBlobResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method TransformBlob not implemented")
+}
+func (UnimplementedBlobTransformerServer) mustEmbedUnimplementedBlobTransformerServer() {}
+func (UnimplementedBlobTransformerServer) testEmbeddedByValue()                         {}
+
+// UnsafeBlobTransformerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlobTransformerServer will
+// result in compilation errors.
+type UnsafeBlobTransformerServer interface {
+	mustEmbedUnimplementedBlobTransformerServer()
+}
+
+func RegisterBlobTransformerServer(s grpc.ServiceRegistrar, srv BlobTransformerServer) {
+	// If the following call pancis, it indicates UnimplementedBlobTransformerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BlobTransformer_ServiceDesc, srv)
+}
+
+func _BlobTransformer_TransformBlob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BlobTransformerServer).TransformBlob(&grpc.GenericServerStream[TransformBlobRequest, TransformBlobResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BlobTransformer_TransformBlobServer = grpc.BidiStreamingServer[TransformBlobRequest, TransformBlobResponse]
+
+// BlobTransformer_ServiceDesc is the grpc.ServiceDesc for BlobTransformer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlobTransformer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocm.software.plugin.transport.v1.BlobTransformer",
+	HandlerType: (*BlobTransformerServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TransformBlob",
+			Handler:       _BlobTransformer_TransformBlob_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "v1/plugin.proto",
+}
+
+const (
+	ComponentLister_ListComponents_FullMethodName = "/ocm.software.plugin.transport.v1.ComponentLister/ListComponents"
+)
+
+// ComponentListerClient is the client API for ComponentLister service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ComponentLister serves the endpoints of the ComponentLister contract.
+type ComponentListerClient interface {
+	// ListComponents serves POST /components/list.
+	ListComponents(ctx context.Context, in *ListComponentsRequest, opts ...grpc.CallOption) (*ListComponentsResponse, error)
+}
+
+type componentListerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewComponentListerClient(cc grpc.ClientConnInterface) ComponentListerClient {
+	return &componentListerClient{cc}
+}
+
+func (c *componentListerClient) ListComponents(ctx context.Context, in *ListComponentsRequest, opts ...grpc.CallOption) (*ListComponentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListComponentsResponse)
+	err := c.cc.Invoke(ctx, ComponentLister_ListComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ComponentListerServer is the server API for ComponentLister service.
+// All implementations must embed UnimplementedComponentListerServer
+// for forward compatibility.
+//
+// ComponentLister serves the endpoints of the ComponentLister contract.
+type ComponentListerServer interface {
+	// ListComponents serves POST /components/list.
+	ListComponents(context.Context, *ListComponentsRequest) (*ListComponentsResponse, error)
+	mustEmbedUnimplementedComponentListerServer()
+}
+
+// UnimplementedComponentListerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedComponentListerServer struct{}
+
+func (UnimplementedComponentListerServer) ListComponents(context.Context, *ListComponentsRequest) (*ListComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComponents not implemented")
+}
+func (UnimplementedComponentListerServer) mustEmbedUnimplementedComponentListerServer() {}
+func (UnimplementedComponentListerServer) testEmbeddedByValue()                         {}
+
+// UnsafeComponentListerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ComponentListerServer will
+// result in compilation errors.
+type UnsafeComponentListerServer interface {
+	mustEmbedUnimplementedComponentListerServer()
+}
+
+func RegisterComponentListerServer(s grpc.ServiceRegistrar, srv ComponentListerServer) {
+	// If the following call pancis, it indicates UnimplementedComponentListerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ComponentLister_ServiceDesc, srv)
+}
+
+func _ComponentLister_ListComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComponentListerServer).ListComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ComponentLister_ListComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComponentListerServer).ListComponents(ctx, req.(*ListComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ComponentLister_ServiceDesc is the grpc.ServiceDesc for ComponentLister service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ComponentLister_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocm.software.plugin.transport.v1.ComponentLister",
+	HandlerType: (*ComponentListerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListComponents",
+			Handler:    _ComponentLister_ListComponents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/plugin.proto",
+}
+
+const (
+	ComponentVersionRepository_AddComponentVersion_FullMethodName   = "/ocm.software.plugin.transport.v1.ComponentVersionRepository/AddComponentVersion"
+	ComponentVersionRepository_GetComponentVersion_FullMethodName   = "/ocm.software.plugin.transport.v1.ComponentVersionRepository/GetComponentVersion"
+	ComponentVersionRepository_ListComponentVersions_FullMethodName = "/ocm.software.plugin.transport.v1.ComponentVersionRepository/ListComponentVersions"
+	ComponentVersionRepository_AddLocalResource_FullMethodName      = "/ocm.software.plugin.transport.v1.ComponentVersionRepository/AddLocalResource"
+	ComponentVersionRepository_GetLocalResource_FullMethodName      = "/ocm.software.plugin.transport.v1.ComponentVersionRepository/GetLocalResource"
+	ComponentVersionRepository_AddLocalSource_FullMethodName        = "/ocm.software.plugin.transport.v1.ComponentVersionRepository/AddLocalSource"
+	ComponentVersionRepository_GetLocalSource_FullMethodName        = "/ocm.software.plugin.transport.v1.ComponentVersionRepository/GetLocalSource"
+	ComponentVersionRepository_CheckHealth_FullMethodName           = "/ocm.software.plugin.transport.v1.ComponentVersionRepository/CheckHealth"
+)
+
+// ComponentVersionRepositoryClient is the client API for ComponentVersionRepository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ComponentVersionRepository serves the endpoints of the ComponentVersionRepository contract.
+type ComponentVersionRepositoryClient interface {
+	// AddComponentVersion serves POST /component-version/upload.
+	AddComponentVersion(ctx context.Context, in *AddComponentVersionRequest, opts ...grpc.CallOption) (*AddComponentVersionResponse, error)
+	// GetComponentVersion serves GET /component-version/download.
+	GetComponentVersion(ctx context.Context, in *GetComponentVersionRequest, opts ...grpc.CallOption) (*GetComponentVersionResponse, error)
+	// ListComponentVersions serves GET /component-versions.
+	ListComponentVersions(ctx context.Context, in *ListComponentVersionsRequest, opts ...grpc.CallOption) (*ListComponentVersionsResponse, error)
+	// AddLocalResource serves POST /local-resource/upload.
+	// The content of a local file in the request location is streamed to the plugin as blob chunks.
+	AddLocalResource(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddLocalResourceRequest, AddLocalResourceResponse], error)
+	// GetLocalResource serves GET /local-resource/download.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	GetLocalResource(ctx context.Context, in *GetLocalResourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetLocalResourceResponse], error)
+	// AddLocalSource serves POST /local-source/upload.
+	// The content of a local file in the request location is streamed to the plugin as blob chunks.
+	AddLocalSource(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddLocalSourceRequest, AddLocalSourceResponse], error)
+	// GetLocalSource serves GET /local-source/download.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	GetLocalSource(ctx context.Context, in *GetLocalSourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetLocalSourceResponse], error)
+	// CheckHealth serves POST /component-version/check-health.
+	CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error)
+}
+
+type componentVersionRepositoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewComponentVersionRepositoryClient(cc grpc.ClientConnInterface) ComponentVersionRepositoryClient {
+	return &componentVersionRepositoryClient{cc}
+}
+
+func (c *componentVersionRepositoryClient) AddComponentVersion(ctx context.Context, in *AddComponentVersionRequest, opts ...grpc.CallOption) (*AddComponentVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddComponentVersionResponse)
+	err := c.cc.Invoke(ctx, ComponentVersionRepository_AddComponentVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *componentVersionRepositoryClient) GetComponentVersion(ctx context.Context, in *GetComponentVersionRequest, opts ...grpc.CallOption) (*GetComponentVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetComponentVersionResponse)
+	err := c.cc.Invoke(ctx, ComponentVersionRepository_GetComponentVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *componentVersionRepositoryClient) ListComponentVersions(ctx context.Context, in *ListComponentVersionsRequest, opts ...grpc.CallOption) (*ListComponentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListComponentVersionsResponse)
+	err := c.cc.Invoke(ctx, ComponentVersionRepository_ListComponentVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *componentVersionRepositoryClient) AddLocalResource(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddLocalResourceRequest, AddLocalResourceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ComponentVersionRepository_ServiceDesc.Streams[0], ComponentVersionRepository_AddLocalResource_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddLocalResourceRequest, AddLocalResourceResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ComponentVersionRepository_AddLocalResourceClient = grpc.ClientStreamingClient[AddLocalResourceRequest, AddLocalResourceResponse]
+
+func (c *componentVersionRepositoryClient) GetLocalResource(ctx context.Context, in *GetLocalResourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetLocalResourceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ComponentVersionRepository_ServiceDesc.Streams[1], ComponentVersionRepository_GetLocalResource_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetLocalResourceRequest, GetLocalResourceResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ComponentVersionRepository_GetLocalResourceClient = grpc.ServerStreamingClient[GetLocalResourceResponse]
+
+func (c *componentVersionRepositoryClient) AddLocalSource(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddLocalSourceRequest, AddLocalSourceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ComponentVersionRepository_ServiceDesc.Streams[2], ComponentVersionRepository_AddLocalSource_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddLocalSourceRequest, AddLocalSourceResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ComponentVersionRepository_AddLocalSourceClient = grpc.ClientStreamingClient[AddLocalSourceRequest, AddLocalSourceResponse]
+
+func (c *componentVersionRepositoryClient) GetLocalSource(ctx context.Context, in *GetLocalSourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetLocalSourceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ComponentVersionRepository_ServiceDesc.Streams[3], ComponentVersionRepository_GetLocalSource_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetLocalSourceRequest, GetLocalSourceResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ComponentVersionRepository_GetLocalSourceClient = grpc.ServerStreamingClient[GetLocalSourceResponse]
+
+func (c *componentVersionRepositoryClient) CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckHealthResponse)
+	err := c.cc.Invoke(ctx, ComponentVersionRepository_CheckHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ComponentVersionRepositoryServer is the server API for ComponentVersionRepository service.
+// All implementations must embed UnimplementedComponentVersionRepositoryServer
+// for forward compatibility.
+//
+// ComponentVersionRepository serves the endpoints of the ComponentVersionRepository contract.
+type ComponentVersionRepositoryServer interface {
+	// AddComponentVersion serves POST /component-version/upload.
+	AddComponentVersion(context.Context, *AddComponentVersionRequest) (*AddComponentVersionResponse, error)
+	// GetComponentVersion serves GET /component-version/download.
+	GetComponentVersion(context.Context, *GetComponentVersionRequest) (*GetComponentVersionResponse, error)
+	// ListComponentVersions serves GET /component-versions.
+	ListComponentVersions(context.Context, *ListComponentVersionsRequest) (*ListComponentVersionsResponse, error)
+	// AddLocalResource serves POST /local-resource/upload.
+	// The content of a local file in the request location is streamed to the plugin as blob chunks.
+	AddLocalResource(grpc.ClientStreamingServer[AddLocalResourceRequest, AddLocalResourceResponse]) error
+	// GetLocalResource serves GET /local-resource/download.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	GetLocalResource(*GetLocalResourceRequest, grpc.ServerStreamingServer[GetLocalResourceResponse]) error
+	// AddLocalSource serves POST /local-source/upload.
+	// The content of a local file in the request location is streamed to the plugin as blob chunks.
+	AddLocalSource(grpc.ClientStreamingServer[AddLocalSourceRequest, AddLocalSourceResponse]) error
+	// GetLocalSource serves GET /local-source/download.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	GetLocalSource(*GetLocalSourceRequest, grpc.ServerStreamingServer[GetLocalSourceResponse]) error
+	// CheckHealth serves POST /component-version/check-health.
+	CheckHealth(context.Context, *CheckHealthRequest) (*CheckHealthResponse, error)
+	mustEmbedUnimplementedComponentVersionRepositoryServer()
+}
+
+// UnimplementedComponentVersionRepositoryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedComponentVersionRepositoryServer struct{}
+
+func (UnimplementedComponentVersionRepositoryServer) AddComponentVersion(context.Context, *AddComponentVersionRequest) (*AddComponentVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComponentVersion not implemented")
+}
+func (UnimplementedComponentVersionRepositoryServer) GetComponentVersion(context.Context, *GetComponentVersionRequest) (*GetComponentVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentVersion not implemented")
+}
+func (UnimplementedComponentVersionRepositoryServer) ListComponentVersions(context.Context, *ListComponentVersionsRequest) (*ListComponentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComponentVersions not implemented")
+}
+func (UnimplementedComponentVersionRepositoryServer) AddLocalResource(grpc.ClientStreamingServer[AddLocalResourceRequest, AddLocalResourceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AddLocalResource not implemented")
+}
+func (UnimplementedComponentVersionRepositoryServer) GetLocalResource(*GetLocalResourceRequest, grpc.ServerStreamingServer[GetLocalResourceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetLocalResource not implemented")
+}
+func (UnimplementedComponentVersionRepositoryServer) AddLocalSource(grpc.ClientStreamingServer[AddLocalSourceRequest, AddLocalSourceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AddLocalSource not implemented")
+}
+func (UnimplementedComponentVersionRepositoryServer) GetLocalSource(*GetLocalSourceRequest, grpc.ServerStreamingServer[GetLocalSourceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetLocalSource not implemented")
+}
+func (UnimplementedComponentVersionRepositoryServer) CheckHealth(context.Context, *CheckHealthRequest) (*CheckHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+}
+func (UnimplementedComponentVersionRepositoryServer) mustEmbedUnimplementedComponentVersionRepositoryServer() {
+}
+func (UnimplementedComponentVersionRepositoryServer) testEmbeddedByValue() {}
+
+// UnsafeComponentVersionRepositoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ComponentVersionRepositoryServer will
+// result in compilation errors.
+type UnsafeComponentVersionRepositoryServer interface {
+	mustEmbedUnimplementedComponentVersionRepositoryServer()
+}
+
+func RegisterComponentVersionRepositoryServer(s grpc.ServiceRegistrar, srv ComponentVersionRepositoryServer) {
+	// If the following call pancis, it indicates UnimplementedComponentVersionRepositoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ComponentVersionRepository_ServiceDesc, srv)
+}
+
+func _ComponentVersionRepository_AddComponentVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddComponentVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComponentVersionRepositoryServer).AddComponentVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ComponentVersionRepository_AddComponentVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComponentVersionRepositoryServer).AddComponentVersion(ctx, req.(*AddComponentVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComponentVersionRepository_GetComponentVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComponentVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComponentVersionRepositoryServer).GetComponentVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ComponentVersionRepository_GetComponentVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComponentVersionRepositoryServer).GetComponentVersion(ctx, req.(*GetComponentVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComponentVersionRepository_ListComponentVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListComponentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComponentVersionRepositoryServer).ListComponentVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ComponentVersionRepository_ListComponentVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComponentVersionRepositoryServer).ListComponentVersions(ctx, req.(*ListComponentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ComponentVersionRepository_AddLocalResource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ComponentVersionRepositoryServer).AddLocalResource(&grpc.GenericServerStream[AddLocalResourceRequest, AddLocalResourceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ComponentVersionRepository_AddLocalResourceServer = grpc.ClientStreamingServer[AddLocalResourceRequest, AddLocalResourceResponse]
+
+func _ComponentVersionRepository_GetLocalResource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLocalResourceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ComponentVersionRepositoryServer).GetLocalResource(m, &grpc.GenericServerStream[GetLocalResourceRequest, GetLocalResourceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ComponentVersionRepository_GetLocalResourceServer = grpc.ServerStreamingServer[GetLocalResourceResponse]
+
+func _ComponentVersionRepository_AddLocalSource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ComponentVersionRepositoryServer).AddLocalSource(&grpc.GenericServerStream[AddLocalSourceRequest, AddLocalSourceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ComponentVersionRepository_AddLocalSourceServer = grpc.ClientStreamingServer[AddLocalSourceRequest, AddLocalSourceResponse]
+
+func _ComponentVersionRepository_GetLocalSource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLocalSourceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ComponentVersionRepositoryServer).GetLocalSource(m, &grpc.GenericServerStream[GetLocalSourceRequest, GetLocalSourceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ComponentVersionRepository_GetLocalSourceServer = grpc.ServerStreamingServer[GetLocalSourceResponse]
+
+func _ComponentVersionRepository_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComponentVersionRepositoryServer).CheckHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ComponentVersionRepository_CheckHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComponentVersionRepositoryServer).CheckHealth(ctx, req.(*CheckHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ComponentVersionRepository_ServiceDesc is the grpc.ServiceDesc for ComponentVersionRepository service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ComponentVersionRepository_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocm.software.plugin.transport.v1.ComponentVersionRepository",
+	HandlerType: (*ComponentVersionRepositoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddComponentVersion",
+			Handler:    _ComponentVersionRepository_AddComponentVersion_Handler,
+		},
+		{
+			MethodName: "GetComponentVersion",
+			Handler:    _ComponentVersionRepository_GetComponentVersion_Handler,
+		},
+		{
+			MethodName: "ListComponentVersions",
+			Handler:    _ComponentVersionRepository_ListComponentVersions_Handler,
+		},
+		{
+			MethodName: "CheckHealth",
+			Handler:    _ComponentVersionRepository_CheckHealth_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AddLocalResource",
+			Handler:       _ComponentVersionRepository_AddLocalResource_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetLocalResource",
+			Handler:       _ComponentVersionRepository_GetLocalResource_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddLocalSource",
+			Handler:       _ComponentVersionRepository_AddLocalSource_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetLocalSource",
+			Handler:       _ComponentVersionRepository_GetLocalSource_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v1/plugin.proto",
+}
+
+const (
+	CredentialRepository_ConsumerIdentityForConfig_FullMethodName = "/ocm.software.plugin.transport.v1.CredentialRepository/ConsumerIdentityForConfig"
+	CredentialRepository_Resolve_FullMethodName                   = "/ocm.software.plugin.transport.v1.CredentialRepository/Resolve"
+)
+
+// CredentialRepositoryClient is the client API for CredentialRepository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CredentialRepository serves the endpoints of the CredentialRepository contract.
+type CredentialRepositoryClient interface {
+	// ConsumerIdentityForConfig serves POST /consumer-identity.
+	ConsumerIdentityForConfig(ctx context.Context, in *ConsumerIdentityForConfigRequest, opts ...grpc.CallOption) (*ConsumerIdentityForConfigResponse, error)
+	// Resolve serves POST /resolve.
+	Resolve(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error)
+}
+
+type credentialRepositoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCredentialRepositoryClient(cc grpc.ClientConnInterface) CredentialRepositoryClient {
+	return &credentialRepositoryClient{cc}
+}
+
+func (c *credentialRepositoryClient) ConsumerIdentityForConfig(ctx context.Context, in *ConsumerIdentityForConfigRequest, opts ...grpc.CallOption) (*ConsumerIdentityForConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumerIdentityForConfigResponse)
+	err := c.cc.Invoke(ctx, CredentialRepository_ConsumerIdentityForConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialRepositoryClient) Resolve(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveResponse)
+	err := c.cc.Invoke(ctx, CredentialRepository_Resolve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CredentialRepositoryServer is the server API for CredentialRepository service.
+// All implementations must embed UnimplementedCredentialRepositoryServer
+// for forward compatibility.
+//
+// CredentialRepository serves the endpoints of the CredentialRepository contract.
+type CredentialRepositoryServer interface {
+	// ConsumerIdentityForConfig serves POST /consumer-identity.
+	ConsumerIdentityForConfig(context.Context, *ConsumerIdentityForConfigRequest) (*ConsumerIdentityForConfigResponse, error)
+	// Resolve serves POST /resolve.
+	Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error)
+	mustEmbedUnimplementedCredentialRepositoryServer()
+}
+
+// UnimplementedCredentialRepositoryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCredentialRepositoryServer struct{}
+
+func (UnimplementedCredentialRepositoryServer) ConsumerIdentityForConfig(context.Context, *ConsumerIdentityForConfigRequest) (*ConsumerIdentityForConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerIdentityForConfig not implemented")
+}
+func (UnimplementedCredentialRepositoryServer) Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
+}
+func (UnimplementedCredentialRepositoryServer) mustEmbedUnimplementedCredentialRepositoryServer() {}
+func (UnimplementedCredentialRepositoryServer) testEmbeddedByValue()                              {}
+
+// UnsafeCredentialRepositoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CredentialRepositoryServer will
+// result in compilation errors.
+type UnsafeCredentialRepositoryServer interface {
+	mustEmbedUnimplementedCredentialRepositoryServer()
+}
+
+func RegisterCredentialRepositoryServer(s grpc.ServiceRegistrar, srv CredentialRepositoryServer) {
+	// If the following call pancis, it indicates UnimplementedCredentialRepositoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CredentialRepository_ServiceDesc, srv)
+}
+
+func _CredentialRepository_ConsumerIdentityForConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerIdentityForConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialRepositoryServer).ConsumerIdentityForConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialRepository_ConsumerIdentityForConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialRepositoryServer).ConsumerIdentityForConfig(ctx, req.(*ConsumerIdentityForConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialRepository_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialRepositoryServer).Resolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialRepository_Resolve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialRepositoryServer).Resolve(ctx, req.(*ResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CredentialRepository_ServiceDesc is the grpc.ServiceDesc for CredentialRepository service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CredentialRepository_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocm.software.plugin.transport.v1.CredentialRepository",
+	HandlerType: (*CredentialRepositoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConsumerIdentityForConfig",
+			Handler:    _CredentialRepository_ConsumerIdentityForConfig_Handler,
+		},
+		{
+			MethodName: "Resolve",
+			Handler:    _CredentialRepository_Resolve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/plugin.proto",
+}
+
+const (
+	DigestProcessor_ProcessResourceDigest_FullMethodName = "/ocm.software.plugin.transport.v1.DigestProcessor/ProcessResourceDigest"
+)
+
+// DigestProcessorClient is the client API for DigestProcessor service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DigestProcessor serves the endpoints of the DigestProcessor contract.
+type DigestProcessorClient interface {
+	// ProcessResourceDigest serves POST /resource/digest/process.
+	ProcessResourceDigest(ctx context.Context, in *ProcessResourceDigestRequest, opts ...grpc.CallOption) (*ProcessResourceDigestResponse, error)
+}
+
+type digestProcessorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDigestProcessorClient(cc grpc.ClientConnInterface) DigestProcessorClient {
+	return &digestProcessorClient{cc}
+}
+
+func (c *digestProcessorClient) ProcessResourceDigest(ctx context.Context, in *ProcessResourceDigestRequest, opts ...grpc.CallOption) (*ProcessResourceDigestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProcessResourceDigestResponse)
+	err := c.cc.Invoke(ctx, DigestProcessor_ProcessResourceDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DigestProcessorServer is the server API for DigestProcessor service.
+// All implementations must embed UnimplementedDigestProcessorServer
+// for forward compatibility.
+//
+// DigestProcessor serves the endpoints of the DigestProcessor contract.
+type DigestProcessorServer interface {
+	// ProcessResourceDigest serves POST /resource/digest/process.
+	ProcessResourceDigest(context.Context, *ProcessResourceDigestRequest) (*ProcessResourceDigestResponse, error)
+	mustEmbedUnimplementedDigestProcessorServer()
+}
+
+// UnimplementedDigestProcessorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDigestProcessorServer struct{}
+
+func (UnimplementedDigestProcessorServer) ProcessResourceDigest(context.Context, *ProcessResourceDigestRequest) (*ProcessResourceDigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessResourceDigest not implemented")
+}
+func (UnimplementedDigestProcessorServer) mustEmbedUnimplementedDigestProcessorServer() {}
+func (UnimplementedDigestProcessorServer) testEmbeddedByValue()                         {}
+
+// UnsafeDigestProcessorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DigestProcessorServer will
+// result in compilation errors.
+type UnsafeDigestProcessorServer interface {
+	mustEmbedUnimplementedDigestProcessorServer()
+}
+
+func RegisterDigestProcessorServer(s grpc.ServiceRegistrar, srv DigestProcessorServer) {
+	// If the following call pancis, it indicates UnimplementedDigestProcessorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DigestProcessor_ServiceDesc, srv)
+}
+
+func _DigestProcessor_ProcessResourceDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessResourceDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DigestProcessorServer).ProcessResourceDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DigestProcessor_ProcessResourceDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DigestProcessorServer).ProcessResourceDigest(ctx, req.(*ProcessResourceDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DigestProcessor_ServiceDesc is the grpc.ServiceDesc for DigestProcessor service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DigestProcessor_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocm.software.plugin.transport.v1.DigestProcessor",
+	HandlerType: (*DigestProcessorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProcessResourceDigest",
+			Handler:    _DigestProcessor_ProcessResourceDigest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/plugin.proto",
+}
+
+const (
+	Input_ProcessResource_FullMethodName = "/ocm.software.plugin.transport.v1.Input/ProcessResource"
+	Input_ProcessSource_FullMethodName   = "/ocm.software.plugin.transport.v1.Input/ProcessSource"
+)
+
+// InputClient is the client API for Input service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Input serves the endpoints of the Input contract.
+type InputClient interface {
+	// ProcessResource serves POST /resource/process.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	ProcessResource(ctx context.Context, in *ProcessResourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProcessResourceResponse], error)
+	// ProcessSource serves POST /source/process.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	ProcessSource(ctx context.Context, in *ProcessSourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProcessSourceResponse], error)
+}
+
+type inputClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInputClient(cc grpc.ClientConnInterface) InputClient {
+	return &inputClient{cc}
+}
+
+func (c *inputClient) ProcessResource(ctx context.Context, in *ProcessResourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProcessResourceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Input_ServiceDesc.Streams[0], Input_ProcessResource_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ProcessResourceRequest, ProcessResourceResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Input_ProcessResourceClient = grpc.ServerStreamingClient[ProcessResourceResponse]
+
+func (c *inputClient) ProcessSource(ctx context.Context, in *ProcessSourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProcessSourceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Input_ServiceDesc.Streams[1], Input_ProcessSource_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ProcessSourceRequest, ProcessSourceResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Input_ProcessSourceClient = grpc.ServerStreamingClient[ProcessSourceResponse]
+
+// InputServer is the server API for Input service.
+// All implementations must embed UnimplementedInputServer
+// for forward compatibility.
+//
+// Input serves the endpoints of the Input contract.
+type InputServer interface {
+	// ProcessResource serves POST /resource/process.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	ProcessResource(*ProcessResourceRequest, grpc.ServerStreamingServer[ProcessResourceResponse]) error
+	// ProcessSource serves POST /source/process.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	ProcessSource(*ProcessSourceRequest, grpc.ServerStreamingServer[ProcessSourceResponse]) error
+	mustEmbedUnimplementedInputServer()
+}
+
+// UnimplementedInputServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInputServer struct{}
+
+func (UnimplementedInputServer) ProcessResource(*ProcessResourceRequest, grpc.ServerStreamingServer[ProcessResourceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ProcessResource not implemented")
+}
+func (UnimplementedInputServer) ProcessSource(*ProcessSourceRequest, grpc.ServerStreamingServer[ProcessSourceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ProcessSource not implemented")
+}
+func (UnimplementedInputServer) mustEmbedUnimplementedInputServer() {}
+func (UnimplementedInputServer) testEmbeddedByValue()               {}
+
+// UnsafeInputServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InputServer will
+// result in compilation errors.
+type UnsafeInputServer interface {
+	mustEmbedUnimplementedInputServer()
+}
+
+func RegisterInputServer(s grpc.ServiceRegistrar, srv InputServer) {
+	// If the following call pancis, it indicates UnimplementedInputServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Input_ServiceDesc, srv)
+}
+
+func _Input_ProcessResource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProcessResourceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InputServer).ProcessResource(m, &grpc.GenericServerStream[ProcessResourceRequest, ProcessResourceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Input_ProcessResourceServer = grpc.ServerStreamingServer[ProcessResourceResponse]
+
+func _Input_ProcessSource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProcessSourceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InputServer).ProcessSource(m, &grpc.GenericServerStream[ProcessSourceRequest, ProcessSourceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Input_ProcessSourceServer = grpc.ServerStreamingServer[ProcessSourceResponse]
+
+// Input_ServiceDesc is the grpc.ServiceDesc for Input service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Input_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocm.software.plugin.transport.v1.Input",
+	HandlerType: (*InputServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProcessResource",
+			Handler:       _Input_ProcessResource_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ProcessSource",
+			Handler:       _Input_ProcessSource_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v1/plugin.proto",
+}
+
+const (
+	Resource_GetGlobalResource_FullMethodName = "/ocm.software.plugin.transport.v1.Resource/GetGlobalResource"
+	Resource_AddGlobalResource_FullMethodName = "/ocm.software.plugin.transport.v1.Resource/AddGlobalResource"
+)
+
+// ResourceClient is the client API for Resource service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Resource serves the endpoints of the Resource contract.
+type ResourceClient interface {
+	// GetGlobalResource serves POST /resource/get.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	GetGlobalResource(ctx context.Context, in *GetGlobalResourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGlobalResourceResponse], error)
+	// AddGlobalResource serves POST /resource/add.
+	// The content of a local file in the request location is streamed to the plugin as blob chunks.
+	AddGlobalResource(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddGlobalResourceRequest, AddGlobalResourceResponse], error)
+}
+
+type resourceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResourceClient(cc grpc.ClientConnInterface) ResourceClient {
+	return &resourceClient{cc}
+}
+
+func (c *resourceClient) GetGlobalResource(ctx context.Context, in *GetGlobalResourceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetGlobalResourceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Resource_ServiceDesc.Streams[0], Resource_GetGlobalResource_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetGlobalResourceRequest, GetGlobalResourceResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Resource_GetGlobalResourceClient = grpc.ServerStreamingClient[GetGlobalResourceResponse]
+
+func (c *resourceClient) AddGlobalResource(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddGlobalResourceRequest, AddGlobalResourceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Resource_ServiceDesc.Streams[1], Resource_AddGlobalResource_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddGlobalResourceRequest, AddGlobalResourceResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Resource_AddGlobalResourceClient = grpc.ClientStreamingClient[AddGlobalResourceRequest, AddGlobalResourceResponse]
+
+// ResourceServer is the server API for Resource service.
+// All implementations must embed UnimplementedResourceServer
+// for forward compatibility.
+//
+// Resource serves the endpoints of the Resource contract.
+type ResourceServer interface {
+	// GetGlobalResource serves POST /resource/get.
+	// The content of a local file in the response location is streamed from the plugin as blob chunks.
+	GetGlobalResource(*GetGlobalResourceRequest, grpc.ServerStreamingServer[GetGlobalResourceResponse]) error
+	// AddGlobalResource serves POST /resource/add.
+	// The content of a local file in the request location is streamed to the plugin as blob chunks.
+	AddGlobalResource(grpc.ClientStreamingServer[AddGlobalResourceRequest, AddGlobalResourceResponse]) error
+	mustEmbedUnimplementedResourceServer()
+}
+
+// UnimplementedResourceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedResourceServer struct{}
+
+func (UnimplementedResourceServer) GetGlobalResource(*GetGlobalResourceRequest, grpc.ServerStreamingServer[GetGlobalResourceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetGlobalResource not implemented")
+}
+func (UnimplementedResourceServer) AddGlobalResource(grpc.ClientStreamingServer[AddGlobalResourceRequest, AddGlobalResourceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AddGlobalResource not implemented")
+}
+func (UnimplementedResourceServer) mustEmbedUnimplementedResourceServer() {}
+func (UnimplementedResourceServer) testEmbeddedByValue()                  {}
+
+// UnsafeResourceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResourceServer will
+// result in compilation errors.
+type UnsafeResourceServer interface {
+	mustEmbedUnimplementedResourceServer()
+}
+
+func RegisterResourceServer(s grpc.ServiceRegistrar, srv ResourceServer) {
+	// If the following call pancis, it indicates UnimplementedResourceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Resource_ServiceDesc, srv)
+}
+
+func _Resource_GetGlobalResource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetGlobalResourceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceServer).GetGlobalResource(m, &grpc.GenericServerStream[GetGlobalResourceRequest, GetGlobalResourceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Resource_GetGlobalResourceServer = grpc.ServerStreamingServer[GetGlobalResourceResponse]
+
+func _Resource_AddGlobalResource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ResourceServer).AddGlobalResource(&grpc.GenericServerStream[AddGlobalResourceRequest, AddGlobalResourceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Resource_AddGlobalResourceServer = grpc.ClientStreamingServer[AddGlobalResourceRequest, AddGlobalResourceResponse]
+
+// Resource_ServiceDesc is the grpc.ServiceDesc for Resource service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Resource_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocm.software.plugin.transport.v1.Resource",
+	HandlerType: (*ResourceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetGlobalResource",
+			Handler:       _Resource_GetGlobalResource_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddGlobalResource",
+			Handler:       _Resource_AddGlobalResource_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "v1/plugin.proto",
+}
+
+const (
+	SignatureHandler_GetSignerIdentity_FullMethodName   = "/ocm.software.plugin.transport.v1.SignatureHandler/GetSignerIdentity"
+	SignatureHandler_GetVerifierIdentity_FullMethodName = "/ocm.software.plugin.transport.v1.SignatureHandler/GetVerifierIdentity"
+	SignatureHandler_Sign_FullMethodName                = "/ocm.software.plugin.transport.v1.SignatureHandler/Sign"
+	SignatureHandler_Verify_FullMethodName              = "/ocm.software.plugin.transport.v1.SignatureHandler/Verify"
+)
+
+// SignatureHandlerClient is the client API for SignatureHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SignatureHandler serves the endpoints of the SignatureHandler contract.
+type SignatureHandlerClient interface {
+	// GetSignerIdentity serves POST /sign/identity.
+	GetSignerIdentity(ctx context.Context, in *GetSignerIdentityRequest, opts ...grpc.CallOption) (*GetSignerIdentityResponse, error)
+	// GetVerifierIdentity serves POST /verify/identity.
+	GetVerifierIdentity(ctx context.Context, in *GetVerifierIdentityRequest, opts ...grpc.CallOption) (*GetVerifierIdentityResponse, error)
+	// Sign serves POST /sign.
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	// Verify serves POST /verify.
+	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
+}
+
+type signatureHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSignatureHandlerClient(cc grpc.ClientConnInterface) SignatureHandlerClient {
+	return &signatureHandlerClient{cc}
+}
+
+func (c *signatureHandlerClient) GetSignerIdentity(ctx context.Context, in *GetSignerIdentityRequest, opts ...grpc.CallOption) (*GetSignerIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSignerIdentityResponse)
+	err := c.cc.Invoke(ctx, SignatureHandler_GetSignerIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signatureHandlerClient) GetVerifierIdentity(ctx context.Context, in *GetVerifierIdentityRequest, opts ...grpc.CallOption) (*GetVerifierIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVerifierIdentityResponse)
+	err := c.cc.Invoke(ctx, SignatureHandler_GetVerifierIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signatureHandlerClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, SignatureHandler_Sign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signatureHandlerClient) Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyResponse)
+	err := c.cc.Invoke(ctx, SignatureHandler_Verify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignatureHandlerServer is the server API for SignatureHandler service.
+// All implementations must embed UnimplementedSignatureHandlerServer
+// for forward compatibility.
+//
+// SignatureHandler serves the endpoints of the SignatureHandler contract.
+type SignatureHandlerServer interface {
+	// GetSignerIdentity serves POST /sign/identity.
+	GetSignerIdentity(context.Context, *GetSignerIdentityRequest) (*GetSignerIdentityResponse, error)
+	// GetVerifierIdentity serves POST /verify/identity.
+	GetVerifierIdentity(context.Context, *GetVerifierIdentityRequest) (*GetVerifierIdentityResponse, error)
+	// Sign serves POST /sign.
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	// Verify serves POST /verify.
+	Verify(context.Context, *VerifyRequest) (*VerifyResponse, error)
+	mustEmbedUnimplementedSignatureHandlerServer()
+}
+
+// UnimplementedSignatureHandlerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSignatureHandlerServer struct{}
+
+func (UnimplementedSignatureHandlerServer) GetSignerIdentity(context.Context, *GetSignerIdentityRequest) (*GetSignerIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignerIdentity not implemented")
+}
+func (UnimplementedSignatureHandlerServer) GetVerifierIdentity(context.Context, *GetVerifierIdentityRequest) (*GetVerifierIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerifierIdentity not implemented")
+}
+func (UnimplementedSignatureHandlerServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedSignatureHandlerServer) Verify(context.Context, *VerifyRequest) (*VerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
+}
+func (UnimplementedSignatureHandlerServer) mustEmbedUnimplementedSignatureHandlerServer() {}
+func (UnimplementedSignatureHandlerServer) testEmbeddedByValue()                          {}
+
+// UnsafeSignatureHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SignatureHandlerServer will
+// result in compilation errors.
+type UnsafeSignatureHandlerServer interface {
+	mustEmbedUnimplementedSignatureHandlerServer()
+}
+
+func RegisterSignatureHandlerServer(s grpc.ServiceRegistrar, srv SignatureHandlerServer) {
+	// If the following call pancis, it indicates UnimplementedSignatureHandlerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SignatureHandler_ServiceDesc, srv)
+}
+
+func _SignatureHandler_GetSignerIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignerIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignatureHandlerServer).GetSignerIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignatureHandler_GetSignerIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignatureHandlerServer).GetSignerIdentity(ctx, req.(*GetSignerIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignatureHandler_GetVerifierIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVerifierIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignatureHandlerServer).GetVerifierIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignatureHandler_GetVerifierIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignatureHandlerServer).GetVerifierIdentity(ctx, req.(*GetVerifierIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignatureHandler_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignatureHandlerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignatureHandler_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignatureHandlerServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignatureHandler_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignatureHandlerServer).Verify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignatureHandler_Verify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignatureHandlerServer).Verify(ctx, req.(*VerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SignatureHandler_ServiceDesc is the grpc.ServiceDesc for SignatureHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SignatureHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocm.software.plugin.transport.v1.SignatureHandler",
+	HandlerType: (*SignatureHandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSignerIdentity",
+			Handler:    _SignatureHandler_GetSignerIdentity_Handler,
+		},
+		{
+			MethodName: "GetVerifierIdentity",
+			Handler:    _SignatureHandler_GetVerifierIdentity_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _SignatureHandler_Sign_Handler,
+		},
+		{
+			MethodName: "Verify",
+			Handler:    _SignatureHandler_Verify_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/plugin.proto",
+}
+
+const (
+	Transformation_Transform_FullMethodName = "/ocm.software.plugin.transport.v1.Transformation/Transform"
+)
+
+// TransformationClient is the client API for Transformation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Transformation serves the endpoints of the Transformation contract.
+type TransformationClient interface {
+	// Transform serves POST /transformation/transform.
+	Transform(ctx context.Context, in *TransformRequest, opts ...grpc.CallOption) (*TransformResponse, error)
+}
+
+type transformationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransformationClient(cc grpc.ClientConnInterface) TransformationClient {
+	return &transformationClient{cc}
+}
+
+func (c *transformationClient) Transform(ctx context.Context, in *TransformRequest, opts ...grpc.CallOption) (*TransformResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransformResponse)
+	err := c.cc.Invoke(ctx, Transformation_Transform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransformationServer is the server API for Transformation service.
+// All implementations must embed UnimplementedTransformationServer
+// for forward compatibility.
+//
+// Transformation serves the endpoints of the Transformation contract.
+type TransformationServer interface {
+	// Transform serves POST /transformation/transform.
+	Transform(context.Context, *TransformRequest) (*TransformResponse, error)
+	mustEmbedUnimplementedTransformationServer()
+}
+
+// UnimplementedTransformationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTransformationServer struct{}
+
+func (UnimplementedTransformationServer) Transform(context.Context, *TransformRequest) (*TransformResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transform not implemented")
+}
+func (UnimplementedTransformationServer) mustEmbedUnimplementedTransformationServer() {}
+func (UnimplementedTransformationServer) testEmbeddedByValue()                        {}
+
+// UnsafeTransformationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransformationServer will
+// result in compilation errors.
+type UnsafeTransformationServer interface {
+	mustEmbedUnimplementedTransformationServer()
+}
+
+func RegisterTransformationServer(s grpc.ServiceRegistrar, srv TransformationServer) {
+	// If the following call pancis, it indicates UnimplementedTransformationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Transformation_ServiceDesc, srv)
+}
+
+func _Transformation_Transform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransformationServer).Transform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transformation_Transform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransformationServer).Transform(ctx, req.(*TransformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Transformation_ServiceDesc is the grpc.ServiceDesc for Transformation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Transformation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ocm.software.plugin.transport.v1.Transformation",
+	HandlerType: (*TransformationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Transform",
+			Handler:    _Transformation_Transform_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/plugin.proto",
+}
@@ -0,0 +1,193 @@
+// Code generated by internal/protogen from the Go types of the plugin contracts. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/types/types.proto
+
+package types
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// JSON is the JSON encoding of a Go value without protobuf representation,
+// e.g. a runtime.Typed or a type with custom JSON encoding.
+type JSON struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Json          []byte                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JSON) Reset() {
+	*x = JSON{}
+	mi := &file_v1_types_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JSON) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSON) ProtoMessage() {}
+
+func (x *JSON) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_types_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSON.ProtoReflect.Descriptor instead.
+func (*JSON) Descriptor() ([]byte, []int) {
+	return file_v1_types_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JSON) GetJson() []byte {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+// Location is generated from ocm.software/open-component-model/bindings/go/plugin/manager/types.Location.
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	MediaType     string                 `protobuf:"bytes,3,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_v1_types_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_types_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_v1_types_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Location) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Location) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Location) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+var File_v1_types_types_proto protoreflect.FileDescriptor
+
+const file_v1_types_types_proto_rawDesc = "" +
+	"\n" +
+	"\x14v1/types/types.proto\x12&ocm.software.plugin.transport.v1.types\"\x1a\n" +
+	"\x04JSON\x12\x12\n" +
+	"\x04json\x18\x01 \x01(\fR\x04json\"S\n" +
+	"\bLocation\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x03 \x01(\tR\tmediaTypeBWZUocm.software/open-component-model/bindings/go/plugin/manager/transport/v1/types;typesb\x06proto3"
+
+var (
+	file_v1_types_types_proto_rawDescOnce sync.Once
+	file_v1_types_types_proto_rawDescData []byte
+)
+
+func file_v1_types_types_proto_rawDescGZIP() []byte {
+	file_v1_types_types_proto_rawDescOnce.Do(func() {
+		file_v1_types_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_types_types_proto_rawDesc), len(file_v1_types_types_proto_rawDesc)))
+	})
+	return file_v1_types_types_proto_rawDescData
+}
+
+var file_v1_types_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_types_types_proto_goTypes = []any{
+	(*JSON)(nil),     // 0: ocm.software.plugin.transport.v1.types.JSON
+	(*Location)(nil), // 1: ocm.software.plugin.transport.v1.types.Location
+}
+var file_v1_types_types_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_v1_types_types_proto_init() }
+func file_v1_types_types_proto_init() {
+	if File_v1_types_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_types_types_proto_rawDesc), len(file_v1_types_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_types_types_proto_goTypes,
+		DependencyIndexes: file_v1_types_types_proto_depIdxs,
+		MessageInfos:      file_v1_types_types_proto_msgTypes,
+	}.Build()
+	File_v1_types_types_proto = out.File
+	file_v1_types_types_proto_goTypes = nil
+	file_v1_types_types_proto_depIdxs = nil
+}
@@ -13,6 +13,17 @@ const (
 	TCP    ConnectionType = "tcp"
 )
 
+// Transport is the protocol the plugin manager and a plugin communicate with over their connection.
+type Transport string
+
+const (
+	// TransportHTTP exchanges JSON encoded requests and responses over HTTP. It is supported by every plugin.
+	TransportHTTP Transport = "http"
+	// TransportGRPC calls the RPCs generated from the contracts with protobuf messages. Blobs of local files are
+	// streamed inline and cancelling a call cancels it in the plugin. Plugins have to declare support for it.
+	TransportGRPC Transport = "grpc"
+)
+
 // Config defines information about the plugin. It contains what type of plugin we are dealing with,
 // the id of the plugin and the connection type. The connection type is either unix ( preferred) or
 // tcp based. The plugin will perform certain actions based on the connection type such as create the
@@ -27,6 +38,9 @@ type Config struct {
 	// IdleTimeout sets how long the plugin should sit around without work to do. If idle time is exceeded the plugin
 	// is automatically terminated to not hog resources indefinitely.
 	IdleTimeout *time.Duration `json:"idleTimeout,omitempty"`
+	// Transport is the transport negotiated with the plugin during the capabilities handshake.
+	// If empty, the plugin is called over HTTP.
+	Transport Transport `json:"transport,omitempty"`
 	// ConfigTypes defines the configurations that are sent to the plugin.
 	ConfigTypes []*runtime.Raw `json:"configTypes,omitempty"`
 }
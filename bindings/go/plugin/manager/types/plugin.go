@@ -18,4 +18,7 @@ type Plugin struct {
 	// Stdout pipe is a link to the plugin's output. This is the standard output to fetch
 	// location data from the plugin once the plugin is started.
 	Stdout io.ReadCloser
+	// Supervisor starts and supervises the processes of the plugin. If set, Cmd, Stderr and Stdout are unused
+	// as every process is created by the supervisor.
+	Supervisor Supervisor
 }
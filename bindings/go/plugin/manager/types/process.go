@@ -0,0 +1,52 @@
+package types
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Supervisor starts the processes of a plugin and keeps them alive.
+// Registries use it instead of starting the plugin command themselves if it is set.
+type Supervisor interface {
+	// Start starts the plugin if it isn't running yet. It returns a client that
+	// dispatches calls to the running instances of the plugin and the location of the plugin.
+	Start(ctx context.Context) (*http.Client, string, error)
+}
+
+// ProcessOptions configure how the processes of a plugin are supervised.
+type ProcessOptions struct {
+	// Instances is the maximum number of processes started for a plugin. Additional instances are
+	// only started if all running instances are busy. Defaults to 1.
+	Instances int
+	// HealthCheckInterval is the interval in which the /healthz endpoint of running instances is checked.
+	// Instances failing the check are stopped and restarted on the next call. Defaults to 30 seconds,
+	// a negative value disables health checks.
+	HealthCheckInterval time.Duration
+	// MaxRestarts is the maximum number of consecutive restarts of an instance without a successful
+	// call in between. Once exceeded, calls to the plugin fail. Defaults to 5, a negative value
+	// allows unlimited restarts.
+	MaxRestarts int
+	// Limits are the resource limits applied to every instance.
+	Limits ResourceLimits
+}
+
+// ResourceLimits cap the resources of a plugin process. Limits are only supported on Linux.
+type ResourceLimits struct {
+	// MemoryBytes caps the memory of the process. It is applied as memory.max of the cgroup if
+	// CgroupParent is set, otherwise as the address space limit (RLIMIT_AS) of the process.
+	MemoryBytes int64
+	// CPUTime caps the CPU time the process may consume (RLIMIT_CPU) before it is terminated.
+	CPUTime time.Duration
+	// CPUQuota caps the CPU usage of the process in CPUs, e.g. 0.5 for half a CPU.
+	// It is applied as cpu.max of the cgroup and requires CgroupParent.
+	CPUQuota float64
+	// CgroupParent is a delegated cgroup v2 directory, e.g. /sys/fs/cgroup/ocm. If set, every
+	// instance is started in its own child cgroup of it.
+	CgroupParent string
+}
+
+// IsZero reports whether no limit is set.
+func (l ResourceLimits) IsZero() bool {
+	return l == ResourceLimits{}
+}
@@ -13,6 +13,7 @@ func ConvertToSpec(pluginSpec *PluginSpec) (*spec.PluginSpec, error) {
 	plugin := &spec.PluginSpec{
 		CapabilitySpecs:      make([]*runtime.Raw, len(pluginSpec.CapabilitySpecs)),
 		SupportedConfigTypes: pluginSpec.SupportedConfigTypes,
+		SupportedTransports:  pluginSpec.SupportedTransports,
 	}
 
 	for index, capability := range pluginSpec.CapabilitySpecs {
@@ -29,6 +30,7 @@ func ConvertFromSpec(scheme *runtime.Scheme, pluginSpec *spec.PluginSpec) (*Plug
 	plugin := &PluginSpec{
 		CapabilitySpecs:      make([]runtime.Typed, len(pluginSpec.CapabilitySpecs)),
 		SupportedConfigTypes: pluginSpec.SupportedConfigTypes,
+		SupportedTransports:  pluginSpec.SupportedTransports,
 	}
 
 	for index, raw := range pluginSpec.CapabilitySpecs {
@@ -3,6 +3,7 @@ package runtime
 import (
 	"errors"
 
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
 	"ocm.software/open-component-model/bindings/go/runtime"
 )
 
@@ -12,9 +13,11 @@ import (
 // Afterwards, we can use our scheme to convert into the correct runtime.Typed.
 // Each runtime.Typed of a plugin knows at what kind of registries it can
 // register itself.
+// SupportedTransports are the transports the plugin supports in addition to HTTP.
 type PluginSpec struct {
 	CapabilitySpecs      []runtime.Typed
 	SupportedConfigTypes []runtime.Type
+	SupportedTransports  []types.Transport
 }
 
 func (spec *PluginSpec) MarshalJSON() ([]byte, error) {
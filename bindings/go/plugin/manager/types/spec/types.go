@@ -1,6 +1,9 @@
 package spec
 
-import "ocm.software/open-component-model/bindings/go/runtime"
+import (
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
 
 // PluginSpec is the list of plugin capabilities a plugin supports.
 // To determine into what type of plugin we have to unmarshal, we unmarshal
@@ -8,7 +11,9 @@ import "ocm.software/open-component-model/bindings/go/runtime"
 // Afterwards, we can use our scheme to convert into the correct runtime.Typed.
 // Each runtime.Typed of a plugin knows at what kind of registries it can
 // register itself.
+// SupportedTransports are the transports the plugin supports in addition to HTTP.
 type PluginSpec struct {
-	CapabilitySpecs      []*runtime.Raw `json:"capabilities"`
-	SupportedConfigTypes []runtime.Type `json:"supportedConfigTypes,omitempty"`
+	CapabilitySpecs      []*runtime.Raw    `json:"capabilities"`
+	SupportedConfigTypes []runtime.Type    `json:"supportedConfigTypes,omitempty"`
+	SupportedTransports  []types.Transport `json:"supportedTransports,omitempty"`
 }
@@ -0,0 +1,41 @@
+package types
+
+import (
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+)
+
+// WASMExtension is the file extension of plugins compiled to WebAssembly.
+const WASMExtension = ".wasm"
+
+// WASMOptions configure the sandbox in which WebAssembly plugins are run.
+type WASMOptions struct {
+	// Permissions grant plugins access to the host by plugin ID. Without permissions, a plugin can
+	// only access its private temporary directory used to exchange blobs with the plugin manager.
+	Permissions map[string]WASMPermissions
+	// MemoryLimitBytes caps the memory of every plugin instance. Defaults to the 4GiB addressable by wasm32.
+	MemoryLimitBytes int64
+	// CompilationCacheDir is the directory compiled plugins are cached in. Compiling plugins is expensive,
+	// so without the cache every registration of a plugin compiles it again.
+	CompilationCacheDir string
+	// HTTPConfig configures the client performing the HTTP requests of plugins, e.g. timeouts and retries.
+	HTTPConfig *httpv1alpha1.Config
+}
+
+// WASMPermissions are the capabilities granted to a WebAssembly plugin.
+type WASMPermissions struct {
+	// Mounts are the host directories accessible by the plugin.
+	Mounts []Mount
+	// AllowedHosts are the hosts the plugin may send HTTP requests to, e.g. ghcr.io or *.github.com.
+	// Patterns are matched with path.Match against the host name without port.
+	AllowedHosts []string
+}
+
+// Mount makes a host directory accessible to a WebAssembly plugin.
+type Mount struct {
+	// HostPath is the directory on the host.
+	HostPath string
+	// GuestPath is the path of the directory in the plugin. Defaults to HostPath.
+	GuestPath string
+	// ReadOnly prevents the plugin from modifying the directory.
+	ReadOnly bool
+}
@@ -0,0 +1,19 @@
+// Package wasm runs plugins compiled to WebAssembly with the embedded wazero runtime.
+//
+// WebAssembly plugins are WASI (wasip1) command modules, e.g. built with GOOS=wasip1 GOARCH=wasm. They implement the
+// same contracts as native plugins, so a single artifact serves every os/arch the plugin manager runs on. Instead of
+// listening on a socket, every call instantiates the module once:
+//
+//   - **capabilities**: The module is run with the argument `capabilities` and prints its capabilities to stdout,
+//     the same as native plugins.
+//   - **calls**: The module is run with `--config <json>`, reads the HTTP/1.1 request of the call from stdin and
+//     writes the HTTP/1.1 response to stdout. Logs are written to stderr and streamed to the manager's logger.
+//
+// Modules are sandboxed. They can only access a private temporary directory of the plugin, which is used to exchange
+// blobs with the plugin manager, and the directories and hosts granted by types.WASMPermissions. Local files passed
+// to a plugin are staged into its temporary directory. Network access is provided by the host module `ocm_http`,
+// which performs HTTP requests on behalf of the plugin with the client configured by types.WASMOptions.HTTPConfig,
+// if the host is allowed.
+//
+// Plugins use client/sdk/wasm to serve their calls and to send HTTP requests through the host.
+package wasm
@@ -0,0 +1,162 @@
+package wasm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"sync"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// HostModule is the name of the host module providing network access to plugins.
+const HostModule = "ocm_http"
+
+// maxRedirects is the number of redirects followed for a single request of a plugin.
+const maxRedirects = 10
+
+// ErrHostNotAllowed is returned to plugins sending requests to hosts they aren't allowed to access.
+var ErrHostNotAllowed = errors.New("host not allowed")
+
+// callKey is the context key of the state of a call.
+type callKey struct{}
+
+// call is the state of a single instantiation of a module.
+type call struct {
+	allowedHosts []string
+	client       *http.Client
+
+	mu sync.Mutex
+	// responses are the results of requests not read by the plugin yet by handle.
+	responses map[uint32][]byte
+	next      uint32
+}
+
+func withCall(ctx context.Context, c *call) context.Context {
+	return context.WithValue(ctx, callKey{}, c)
+}
+
+func (c *call) allowed(host string) bool {
+	return slices.ContainsFunc(c.allowedHosts, func(pattern string) bool {
+		matched, err := path.Match(pattern, host)
+		return err == nil && matched
+	})
+}
+
+// do performs the serialized request and returns the serialized response,
+// or an error if the request isn't allowed or failed.
+func (c *call) do(ctx context.Context, raw []byte) ([]byte, error) {
+	req, err := http.ReadRequest(bufio.NewReader(bytes.NewReader(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if !req.URL.IsAbs() {
+		return nil, fmt.Errorf("invalid request: URL %q is not absolute", req.URL)
+	}
+	if !c.allowed(req.URL.Hostname()) {
+		return nil, fmt.Errorf("%w: %s", ErrHostNotAllowed, req.URL.Hostname())
+	}
+	req.RequestURI = ""
+	req = req.WithContext(ctx)
+
+	// every redirect is checked against the allowed hosts as well, otherwise an allowed host
+	// could forward the plugin to any other host.
+	client := *c.client
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if !c.allowed(req.URL.Hostname()) {
+			return fmt.Errorf("%w: redirect to %s", ErrHostNotAllowed, req.URL.Hostname())
+		}
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		return nil
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// responses are read into the memory of the plugin as a whole, so they are buffered here.
+	var buf bytes.Buffer
+	if err := resp.Write(&buf); err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// store keeps the result until the plugin reads it and returns its handle.
+func (c *call) store(result []byte) uint32 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.next++
+	c.responses[c.next] = result
+	return c.next
+}
+
+// take removes the result with the given handle.
+func (c *call) take(handle uint32) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := c.responses[handle]
+	delete(c.responses, handle)
+	return result
+}
+
+// instantiateHostModule instantiates the host module providing network access to plugins:
+//
+//   - do(request_ptr, request_len, handle_ptr) -> i64: Performs the serialized HTTP/1.1 request in the memory of the
+//     plugin. It writes the handle of the result to handle_ptr and returns its length. Errors are returned as a
+//     negative length with the error message as result.
+//   - read(handle, ptr, len) -> i32: Copies the result of the handle into the memory of the plugin and releases it.
+//     It returns the number of bytes copied.
+func instantiateHostModule(ctx context.Context, r wazero.Runtime) error {
+	_, err := r.NewHostModuleBuilder(HostModule).
+		NewFunctionBuilder().
+		WithFunc(func(ctx context.Context, m api.Module, reqPtr, reqLen, handlePtr uint32) int64 {
+			c, ok := ctx.Value(callKey{}).(*call)
+			if !ok {
+				return -1
+			}
+			raw, ok := m.Memory().Read(reqPtr, reqLen)
+			if !ok {
+				return -1
+			}
+			result, err := c.do(ctx, bytes.Clone(raw))
+			sign := int64(1)
+			if err != nil {
+				result, sign = []byte(err.Error()), -1
+			}
+			if !m.Memory().WriteUint32Le(handlePtr, c.store(result)) {
+				return -1
+			}
+			return sign * int64(len(result))
+		}).
+		Export("do").
+		NewFunctionBuilder().
+		WithFunc(func(ctx context.Context, m api.Module, handle, ptr, length uint32) uint32 {
+			c, ok := ctx.Value(callKey{}).(*call)
+			if !ok {
+				return 0
+			}
+			result := c.take(handle)
+			if len(result) > int(length) {
+				result = result[:length]
+			}
+			if !m.Memory().Write(ptr, result) {
+				return 0
+			}
+			return uint32(len(result))
+		}).
+		Export("read").
+		Instantiate(ctx)
+	return err
+}
@@ -0,0 +1,53 @@
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowedHosts(t *testing.T) {
+	c := &call{allowedHosts: []string{"ghcr.io", "*.github.com"}}
+
+	assert.True(t, c.allowed("ghcr.io"))
+	assert.True(t, c.allowed("api.github.com"))
+	assert.False(t, c.allowed("github.com"))
+	assert.False(t, c.allowed("ghcr.io.example.com"))
+	assert.False(t, (&call{}).allowed("ghcr.io"), "hosts are denied by default")
+}
+
+func TestRedirectToDisallowedHost(t *testing.T) {
+	var hit bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hit = true
+	}))
+	t.Cleanup(target.Close)
+	targetURL, err := url.Parse(target.URL)
+	require.NoError(t, err)
+
+	allowed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusFound)
+	}))
+	t.Cleanup(allowed.Close)
+	allowedURL, err := url.Parse(allowed.URL)
+	require.NoError(t, err)
+	// both servers listen on 127.0.0.1, so the allowed one is addressed as localhost.
+	allowedURL.Host = "localhost:" + allowedURL.Port()
+
+	req, err := http.NewRequest(http.MethodGet, allowedURL.String(), nil)
+	require.NoError(t, err)
+	var raw bytes.Buffer
+	require.NoError(t, req.WriteProxy(&raw))
+
+	c := &call{allowedHosts: []string{"localhost"}, client: &http.Client{}}
+	_, err = c.do(context.Background(), raw.Bytes())
+	assert.ErrorIs(t, err, ErrHostNotAllowed)
+	assert.ErrorContains(t, err, targetURL.Hostname())
+	assert.False(t, hit, "the disallowed host must not be contacted")
+}
@@ -0,0 +1,353 @@
+package wasm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+
+	ocmhttp "ocm.software/open-component-model/bindings/go/http"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types"
+)
+
+// pageSize is the size of a page of WebAssembly memory.
+const pageSize = 64 * 1024
+
+// Plugin runs a plugin compiled to WebAssembly. The module is compiled once and instantiated for every call.
+// It implements types.Supervisor, so registries call it the same as native plugins.
+type Plugin struct {
+	plugin      types.Plugin
+	permissions types.WASMPermissions
+
+	// baseCtx is used to stream the logs of the plugin.
+	baseCtx context.Context
+
+	runtime wazero.Runtime
+	module  wazero.CompiledModule
+	// cache is the compilation cache of the runtime, if configured.
+	cache wazero.CompilationCache
+
+	// client performs the HTTP requests of the plugin.
+	client *http.Client
+	// blobDir is the private temporary directory of the plugin. It is the only directory mounted besides
+	// the mounts granted by the permissions, and is used to exchange blobs with the plugin manager.
+	blobDir string
+
+	closeOnce sync.Once
+}
+
+var (
+	_ types.Supervisor  = (*Plugin)(nil)
+	_ http.RoundTripper = (*Plugin)(nil)
+)
+
+// NewPlugin compiles the module of the plugin at plugin.Path. The plugin is granted the permissions
+// configured for its ID in opts. Close releases the compiled module and removes the temporary directory
+// of the plugin.
+func NewPlugin(ctx context.Context, plugin types.Plugin, opts types.WASMOptions) (*Plugin, error) {
+	code, err := os.ReadFile(plugin.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugin %s: %w", plugin.ID, err)
+	}
+	blobDir, err := os.MkdirTemp("", "ocm-wasm-plugin-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory for plugin %s: %w", plugin.ID, err)
+	}
+
+	config := wazero.NewRuntimeConfig().WithCloseOnContextDone(true)
+	if opts.MemoryLimitBytes > 0 {
+		config = config.WithMemoryLimitPages(uint32(max(opts.MemoryLimitBytes/pageSize, 1)))
+	}
+	var cache wazero.CompilationCache
+	if opts.CompilationCacheDir != "" {
+		if cache, err = wazero.NewCompilationCacheWithDir(opts.CompilationCacheDir); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to open compilation cache for plugin %s: %w", plugin.ID, err), os.RemoveAll(blobDir))
+		}
+		config = config.WithCompilationCache(cache)
+	}
+
+	p := &Plugin{
+		plugin:      plugin,
+		permissions: opts.Permissions[plugin.ID],
+		baseCtx:     ctx,
+		runtime:     wazero.NewRuntimeWithConfig(ctx, config),
+		cache:       cache,
+		client:      ocmhttp.New(ocmhttp.WithConfig(opts.HTTPConfig)),
+		blobDir:     blobDir,
+	}
+
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, p.runtime); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to instantiate WASI for plugin %s: %w", plugin.ID, err), p.Close(ctx))
+	}
+	if err := instantiateHostModule(ctx, p.runtime); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to instantiate host module for plugin %s: %w", plugin.ID, err), p.Close(ctx))
+	}
+	if p.module, err = p.runtime.CompileModule(ctx, code); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to compile plugin %s: %w", plugin.ID, err), p.Close(ctx))
+	}
+
+	return p, nil
+}
+
+// Configure sets the config passed to the instances of the plugin, e.g. once the
+// configuration types requested with the capabilities of the plugin are known.
+func (p *Plugin) Configure(config types.Config) {
+	p.plugin.Config = config
+}
+
+// Capabilities runs the capabilities command of the plugin and returns its output.
+func (p *Plugin) Capabilities(ctx context.Context) (*bytes.Buffer, error) {
+	output := bytes.NewBuffer(nil)
+	if err := p.run(ctx, http.NoBody, output, "capabilities"); err != nil {
+		return nil, fmt.Errorf("failed to get capabilities of plugin %s: %w", p.plugin.ID, err)
+	}
+	return output, nil
+}
+
+// Start returns a client instantiating the module for every call. As modules are instantiated on demand,
+// nothing is started. The location is the path of the module.
+func (p *Plugin) Start(_ context.Context) (*http.Client, string, error) {
+	return &http.Client{Transport: p}, p.plugin.Path, nil
+}
+
+// RoundTrip serves the request with a new instance of the module. The request is written to the stdin of
+// the instance and the response is read from its stdout while it's written. Cancelling the context of the
+// request or closing the response body terminates the instance.
+//
+// Local files passed with the request are staged into the temporary directory of the plugin first.
+func (p *Plugin) RoundTrip(req *http.Request) (*http.Response, error) {
+	config, err := json.Marshal(p.plugin.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal plugin config: %w", err)
+	}
+	if req, err = p.stageLocalFiles(req); err != nil {
+		return nil, fmt.Errorf("failed to pass local files to plugin %s: %w", p.plugin.ID, err)
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	stdin, stdinWriter := io.Pipe()
+	stdout, stdoutWriter := io.Pipe()
+
+	go func() {
+		stdinWriter.CloseWithError(req.Write(stdinWriter))
+	}()
+
+	go func() {
+		err := p.run(ctx, stdin, stdoutWriter, "--config", string(config))
+		if err == nil {
+			// the module exited before the response was complete.
+			err = io.ErrUnexpectedEOF
+		}
+		// unblock writing the request if the module didn't read it.
+		_ = stdin.CloseWithError(err)
+		stdoutWriter.CloseWithError(err)
+	}()
+
+	resp, err := http.ReadResponse(bufio.NewReader(stdout), req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to call plugin %s: %w", p.plugin.ID, err)
+	}
+	resp.Body = &responseBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// responseBody terminates the instance serving the response once it's closed.
+type responseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *responseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// stageLocalFiles makes the local files of the locations in the JSON body of the request accessible to the
+// plugin. The files are linked, or copied if linking fails, into the temporary directory of the plugin and
+// the locations are rewritten to the staged files. The staged files are removed with the directory on Close,
+// as the plugin may return them as its result.
+func (p *Plugin) stageLocalFiles(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.Header.Get("Content-Type") != "application/json" {
+		return req, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = req.Body.Close()
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	staged := false
+	if decoder.Decode(&value) == nil {
+		if staged, err = p.stageLocations(value); err != nil {
+			return nil, err
+		}
+	}
+	if staged {
+		if body, err = json.Marshal(value); err != nil {
+			return nil, err
+		}
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	return req, nil
+}
+
+// stageLocations walks the decoded JSON value and stages the files of all local file locations outside the
+// temporary directory of the plugin. It reports whether a location was rewritten.
+func (p *Plugin) stageLocations(value any) (bool, error) {
+	staged := false
+	switch v := value.(type) {
+	case map[string]any:
+		if path, ok := v["value"].(string); ok && v["type"] == string(types.LocationTypeLocalFile) && !p.inBlobDir(path) {
+			stagedPath, err := p.stageFile(path)
+			if err != nil {
+				return false, err
+			}
+			v["value"] = stagedPath
+			return true, nil
+		}
+		for _, child := range v {
+			ok, err := p.stageLocations(child)
+			if err != nil {
+				return false, err
+			}
+			staged = staged || ok
+		}
+	case []any:
+		for _, child := range v {
+			ok, err := p.stageLocations(child)
+			if err != nil {
+				return false, err
+			}
+			staged = staged || ok
+		}
+	}
+	return staged, nil
+}
+
+func (p *Plugin) inBlobDir(path string) bool {
+	rel, err := filepath.Rel(p.blobDir, path)
+	return err == nil && filepath.IsLocal(rel)
+}
+
+// stageFile links or copies the file into the temporary directory of the plugin and returns the new path.
+func (p *Plugin) stageFile(path string) (_ string, err error) {
+	dir, err := os.MkdirTemp(p.blobDir, "blob-")
+	if err != nil {
+		return "", err
+	}
+	name := filepath.Join(dir, filepath.Base(path))
+	if os.Link(path, name) == nil {
+		return name, nil
+	}
+
+	src, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = errors.Join(err, src.Close())
+	}()
+	dst, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = errors.Join(err, dst.Close())
+	}()
+	if _, err := io.Copy(dst, src); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// run instantiates the module with the given arguments and waits for it to exit.
+func (p *Plugin) run(ctx context.Context, stdin io.Reader, stdout io.Writer, args ...string) error {
+	// the temporary directory is mounted at the same path, so that paths of blobs are valid on both sides.
+	fsConfig := wazero.NewFSConfig().WithDirMount(p.blobDir, p.blobDir)
+	for _, mount := range p.permissions.Mounts {
+		guestPath := mount.GuestPath
+		if guestPath == "" {
+			guestPath = mount.HostPath
+		}
+		if mount.ReadOnly {
+			fsConfig = fsConfig.WithReadOnlyDirMount(mount.HostPath, guestPath)
+		} else {
+			fsConfig = fsConfig.WithDirMount(mount.HostPath, guestPath)
+		}
+	}
+
+	stderr, stderrWriter := io.Pipe()
+	defer stderrWriter.Close()
+	go plugins.StartLogStreamer(p.baseCtx, &types.Plugin{ID: p.plugin.ID, Stderr: stderr})
+
+	config := wazero.NewModuleConfig().
+		// instances of the same module are run concurrently, so they must not be named.
+		WithName("").
+		WithArgs(append([]string{p.plugin.ID}, args...)...).
+		WithEnv("TMPDIR", p.blobDir).
+		WithStdin(stdin).
+		WithStdout(stdout).
+		WithStderr(stderrWriter).
+		WithFSConfig(fsConfig).
+		WithSysWalltime().
+		WithSysNanotime().
+		WithSysNanosleep().
+		WithRandSource(rand.Reader)
+
+	ctx = withCall(ctx, &call{
+		allowedHosts: p.permissions.AllowedHosts,
+		client:       p.client,
+		responses:    make(map[uint32][]byte),
+	})
+
+	mod, err := p.runtime.InstantiateModule(ctx, p.module, config)
+	if mod != nil {
+		_ = mod.Close(ctx)
+	}
+	if exitErr := (*sys.ExitError)(nil); errors.As(err, &exitErr) && exitErr.ExitCode() == 0 {
+		return nil
+	}
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return errors.Join(ctxErr, err)
+		}
+		return fmt.Errorf("plugin %s failed: %w", p.plugin.ID, err)
+	}
+	return nil
+}
+
+// Close releases the runtime of the plugin, terminates running instances and removes the temporary
+// directory of the plugin, including the blobs returned by the plugin.
+func (p *Plugin) Close(ctx context.Context) error {
+	var err error
+	p.closeOnce.Do(func() {
+		err = p.runtime.Close(ctx)
+		if p.cache != nil {
+			err = errors.Join(err, p.cache.Close(ctx))
+		}
+		err = errors.Join(err, os.RemoveAll(p.blobDir))
+	})
+	return err
+}
@@ -0,0 +1,61 @@
+package wasm
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStageLocalFiles(t *testing.T) {
+	p := &Plugin{blobDir: t.TempDir()}
+	outside := filepath.Join(t.TempDir(), "resource")
+	require.NoError(t, os.WriteFile(outside, []byte("resource"), 0o600))
+	inside := filepath.Join(p.blobDir, "result")
+
+	body := `{"repository":{"type":"dummy/v1","size":12345678901234567890},` +
+		`"resourceLocation":{"type":"localFile","value":"` + outside + `"},` +
+		`"results":[{"type":"localFile","value":"` + inside + `"},{"type":"remoteURL","value":"https://example.com"}]}`
+	req, err := http.NewRequest(http.MethodPost, "http://plugin/resource", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	req, err = p.stageLocalFiles(req)
+	require.NoError(t, err)
+	staged, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(staged)), req.ContentLength)
+
+	var request struct {
+		Repository struct {
+			Size json.Number `json:"size"`
+		} `json:"repository"`
+		ResourceLocation map[string]string   `json:"resourceLocation"`
+		Results          []map[string]string `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal(staged, &request))
+	assert.Equal(t, "12345678901234567890", request.Repository.Size.String(), "numbers must be passed unchanged")
+	assert.True(t, p.inBlobDir(request.ResourceLocation["value"]), "files outside of the plugin directory must be staged")
+	content, err := os.ReadFile(request.ResourceLocation["value"])
+	require.NoError(t, err)
+	assert.Equal(t, "resource", string(content))
+	assert.Equal(t, inside, request.Results[0]["value"], "files in the plugin directory must not be staged")
+	assert.Equal(t, "https://example.com", request.Results[1]["value"])
+}
+
+func TestStageLocalFilesMissingFile(t *testing.T) {
+	p := &Plugin{blobDir: t.TempDir()}
+	body := `{"location":{"type":"localFile","value":"` + filepath.Join(t.TempDir(), "missing") + `"}}`
+	req, err := http.NewRequest(http.MethodPost, "http://plugin/resource", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = p.stageLocalFiles(req)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
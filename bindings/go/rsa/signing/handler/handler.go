@@ -3,9 +3,13 @@
 //  1. Plain: hex signature bytes without certificates.
 //  2. PEM: a SIGNATURE PEM block with an embedded X.509 chain.
 //
+// Private keys are used through crypto.Signer, so keys that never leave a
+// hardware security module can be used through a SignerProvider.
+//
 // For PEM verification, the leaf public key is taken from the chain after
 // the chain validates against system roots and/or an optional trust anchor
-// provided via credentials.
+// provided via credentials. If a VerifyConfig trusts a timestamp authority, the
+// chain is validated at the time asserted by the RFC 3161 timestamp of the signature.
 package handler
 
 import (
@@ -22,12 +26,12 @@ import (
 
 	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
 	rsacredentials "ocm.software/open-component-model/bindings/go/rsa/signing/handler/internal/credentials"
-	rsasignature "ocm.software/open-component-model/bindings/go/rsa/signing/handler/internal/pem"
-	"ocm.software/open-component-model/bindings/go/rsa/signing/handler/internal/rfc2253"
 	"ocm.software/open-component-model/bindings/go/rsa/signing/v1alpha1"
 	rsacredentialsv1 "ocm.software/open-component-model/bindings/go/rsa/spec/credentials/v1"
 	identityv1 "ocm.software/open-component-model/bindings/go/rsa/spec/identity/v1"
 	"ocm.software/open-component-model/bindings/go/runtime"
+	signingpem "ocm.software/open-component-model/bindings/go/signing/pem"
+	"ocm.software/open-component-model/bindings/go/signing/rfc2253"
 )
 
 // Common errors for callers to test.
@@ -39,12 +43,13 @@ var (
 
 // Handler holds trust anchors and time source for X.509 validation.
 type Handler struct {
-	roots *x509.CertPool
-	now   func() time.Time
+	roots           *x509.CertPool
+	now             func() time.Time
+	signerProviders []SignerProvider
 }
 
 // New returns a Handler. If useSystemRoots is true, system trust roots are loaded, otherwise an empty pool is used.
-func New(scheme *runtime.Scheme, useSystemRoots bool) (*Handler, error) {
+func New(scheme *runtime.Scheme, useSystemRoots bool, opts ...HandlerOption) (*Handler, error) {
 	var (
 		roots *x509.CertPool
 		err   error
@@ -55,10 +60,14 @@ func New(scheme *runtime.Scheme, useSystemRoots bool) (*Handler, error) {
 			return nil, fmt.Errorf("load system roots: %w", err)
 		}
 	}
-	return &Handler{
+	h := &Handler{
 		roots: roots,
 		now:   time.Now,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h, nil
 }
 
 func (h *Handler) GetSigningHandlerScheme() *runtime.Scheme {
@@ -82,21 +91,17 @@ func (h *Handler) Sign(
 	}
 	algorithm := supported.GetSignatureAlgorithm()
 
-	var rsaCreds *rsacredentialsv1.RSACredentials
-	if creds != nil {
-		if c, err := rsacredentialsv1.ConvertToRSACredentials(creds); err != nil {
-			return descruntime.SignatureInfo{}, fmt.Errorf("parse rsa credentials: %w", err)
-		} else {
-			rsaCreds = c
-		}
-	}
-
-	priv, err := rsacredentials.PrivateKeyFromCredentials(rsaCreds)
+	pemEncoded := supported.GetSignatureEncodingPolicy() == v1alpha1.SignatureEncodingPolicyPEM
+	signer, chain, release, err := h.signerFromCredentials(ctx, creds, pemEncoded)
 	if err != nil {
-		return descruntime.SignatureInfo{}, fmt.Errorf("cannot load private key from credentials for signing: %w", err)
+		return descruntime.SignatureInfo{}, err
 	}
-	if priv == nil {
-		return descruntime.SignatureInfo{}, ErrMissingPrivateKey
+	if release != nil {
+		defer func() {
+			if err := release(); err != nil {
+				slog.WarnContext(ctx, "failed to release signer", "error", err)
+			}
+		}()
 	}
 
 	hash, dig, err := parseDigest(unsigned)
@@ -104,7 +109,7 @@ func (h *Handler) Sign(
 		return descruntime.SignatureInfo{}, err
 	}
 
-	rawSig, err := signRSA(algorithm, priv, hash, dig)
+	rawSig, err := signRSA(algorithm, signer, hash, dig)
 	if err != nil {
 		return descruntime.SignatureInfo{}, fmt.Errorf("rsa sign: %w", err)
 	}
@@ -112,11 +117,7 @@ func (h *Handler) Sign(
 	switch supported.GetSignatureEncodingPolicy() {
 	case v1alpha1.SignatureEncodingPolicyPEM:
 		slog.WarnContext(ctx, "signing with PEM encoding is experimental")
-		chain, err := rsacredentials.CertificateChainFromCredentials(rsaCreds)
-		if err != nil {
-			return descruntime.SignatureInfo{}, fmt.Errorf("read certificate chain: %w", err)
-		}
-		pem := rsasignature.SignatureBytesToPem(string(algorithm), rawSig, chain...)
+		pem := signingpem.SignatureBytesToPem(string(algorithm), rawSig, chain...)
 		return descruntime.SignatureInfo{
 			Algorithm: string(algorithm),
 			MediaType: v1alpha1.MediaTypePEM,
@@ -139,10 +140,20 @@ func (h *Handler) Sign(
 func (h *Handler) Verify(
 	ctx context.Context,
 	signed descruntime.Signature,
-	// we use hints from the signature to determine the correct settings, so no additional config is needed
-	_ runtime.Typed,
+	// we use hints from the signature to determine the correct settings,
+	// only a VerifyConfig with a trust policy adds constraints
+	rawCfg runtime.Typed,
 	creds runtime.Typed,
 ) error {
+	cfg, err := verifyConfigFromConfig(rawCfg)
+	if err != nil {
+		return err
+	}
+	var policy *v1alpha1.TrustPolicy
+	if cfg != nil {
+		policy = cfg.TrustPolicy
+	}
+
 	var rsaCreds *rsacredentialsv1.RSACredentials
 	if creds != nil {
 		if c, err := rsacredentialsv1.ConvertToRSACredentials(creds); err != nil {
@@ -164,6 +175,9 @@ func (h *Handler) Verify(
 
 	switch signed.Signature.MediaType {
 	case v1alpha1.MediaTypePlainRSASSAPSS, v1alpha1.MediaTypePlainRSASSAPKCS1V15:
+		if policy != nil {
+			return ErrTrustPolicyRequiresCertificate
+		}
 		if pubFromCreds == nil {
 			return ErrMissingPublicKey
 		}
@@ -175,11 +189,15 @@ func (h *Handler) Verify(
 		if err != nil {
 			return err
 		}
-		return verifyRSA(alg, pubFromCreds.PublicKey, hash, dig, sig)
+		return verifyRSA(alg, pubFromCreds, hash, dig, sig)
 
 	case v1alpha1.MediaTypePEM:
 		slog.WarnContext(ctx, "verifying signatures with PEM encoding is experimental")
-		return h.verifyPEMSignature(signed, hash, dig, rsaCreds)
+		signingTime, err := h.signingTime(ctx, signed, cfg)
+		if err != nil {
+			return err
+		}
+		return h.verifyPEMSignature(signed, hash, dig, rsaCreds, policy, signingTime)
 
 	default:
 		return fmt.Errorf("unsupported media type %q", signed.Signature.MediaType)
@@ -190,13 +208,17 @@ func (h *Handler) Verify(
 // embedded chain, classifies the credential chain into intermediates and an
 // optional root anchor, merges the two intermediate pools, validates the X.509
 // path and issuer constraint, and finally verifies the RSA signature bytes.
+// If a trust policy is given, the X.509 path is validated against it instead.
+// The chain must be valid at signingTime.
 func (h *Handler) verifyPEMSignature(
 	signed descruntime.Signature,
 	hash crypto.Hash,
 	dig []byte,
 	creds *rsacredentialsv1.RSACredentials,
+	policy *v1alpha1.TrustPolicy,
+	signingTime time.Time,
 ) error {
-	sig, algFromPEM, chain, err := rsasignature.GetSignatureFromPem([]byte(signed.Signature.Value))
+	sig, algFromPEM, chain, err := signingpem.GetSignatureFromPem([]byte(signed.Signature.Value))
 	if err != nil {
 		return fmt.Errorf("parse pem signature: %w", err)
 	}
@@ -219,7 +241,11 @@ func (h *Handler) verifyPEMSignature(
 	allIntermediates = append(allIntermediates, chain[1:]...)
 	allIntermediates = append(allIntermediates, credIntermediates...)
 
-	if err := verifyChainWithOptionalAnchor(leaf, allIntermediates, credAnchor, h.roots, h.now); err != nil {
+	if policy != nil {
+		if err := verifyTrustPolicy(policy, leaf, allIntermediates, credAnchor, h.roots, signingTime); err != nil {
+			return err
+		}
+	} else if err := verifyChainWithOptionalAnchor(leaf, allIntermediates, credAnchor, h.roots, func() time.Time { return signingTime }); err != nil {
 		return fmt.Errorf("certificate verification failed: %w", err)
 	}
 
@@ -262,7 +288,7 @@ func (*Handler) GetVerifyingCredentialConsumerIdentity(
 	alg := signature.Signature.Algorithm
 
 	if signature.Signature.MediaType == v1alpha1.MediaTypePEM {
-		_, pemAlg, _, err := rsasignature.GetSignatureFromPem([]byte(signature.Signature.Value))
+		_, pemAlg, _, err := signingpem.GetSignatureFromPem([]byte(signature.Signature.Value))
 		if err != nil {
 			return nil, fmt.Errorf("parse pem signature: %w", err)
 		}
@@ -285,6 +311,47 @@ func (*Handler) GetVerifyingCredentialConsumerIdentity(
 
 // ---- internal helpers ----
 
+// signerFromCredentials returns the signer to use for creds and, if withChain is set,
+// the certificate chain to embed. Signer providers are asked first, then the private
+// key is read from RSA credentials.
+// The returned release function is nil if there is nothing to release.
+func (h *Handler) signerFromCredentials(ctx context.Context, creds runtime.Typed, withChain bool) (crypto.Signer, []*x509.Certificate, func() error, error) {
+	for _, provide := range h.signerProviders {
+		signer, chain, release, err := provide(ctx, creds)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("cannot load signer from credentials: %w", err)
+		}
+		if signer != nil {
+			return signer, chain, release, nil
+		}
+	}
+
+	var rsaCreds *rsacredentialsv1.RSACredentials
+	if creds != nil {
+		if c, err := rsacredentialsv1.ConvertToRSACredentials(creds); err != nil {
+			return nil, nil, nil, fmt.Errorf("parse rsa credentials: %w", err)
+		} else {
+			rsaCreds = c
+		}
+	}
+
+	priv, err := rsacredentials.PrivateKeyFromCredentials(rsaCreds)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot load private key from credentials for signing: %w", err)
+	}
+	if priv == nil {
+		return nil, nil, nil, ErrMissingPrivateKey
+	}
+	if !withChain {
+		return priv, nil, nil, nil
+	}
+	chain, err := rsacredentials.CertificateChainFromCredentials(rsaCreds)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("read certificate chain: %w", err)
+	}
+	return priv, chain, nil, nil
+}
+
 // algorithmFromPlainMedia infers the RSA algorithm from a plain media type.
 func algorithmFromPlainMedia(mt string) (v1alpha1.SignatureAlgorithm, error) {
 	switch mt {
@@ -363,14 +430,7 @@ func verifyChainWithOptionalAnchor(
 	roots *x509.CertPool,
 	now func() time.Time,
 ) error {
-	if anchor != nil {
-		// Credential root supplied: use an isolated pool so system roots cannot
-		// satisfy the chain in place of the verifier's chosen anchor.
-		roots = x509.NewCertPool()
-		roots.AddCert(anchor)
-	} else if roots == nil {
-		roots = x509.NewCertPool()
-	}
+	roots = trustedRoots(anchor, roots)
 
 	var (
 		ip  *x509.CertPool
@@ -393,6 +453,20 @@ func verifyChainWithOptionalAnchor(
 	return err
 }
 
+// trustedRoots returns the root pool for chain validation: an isolated pool with
+// only the anchor if one is given, the system roots otherwise.
+func trustedRoots(anchor *x509.Certificate, roots *x509.CertPool) *x509.CertPool {
+	if anchor != nil {
+		// Credential root supplied: use an isolated pool so system roots cannot
+		// satisfy the chain in place of the verifier's chosen anchor.
+		roots = x509.NewCertPool()
+		roots.AddCert(anchor)
+	} else if roots == nil {
+		roots = x509.NewCertPool()
+	}
+	return roots
+}
+
 // verifyIssuerForLeafCert checks that the Issuer field declared in the signature
 // matches the X.509 Issuer of the leaf certificate, i.e. the DN of the CA that
 // directly signed the leaf. The check is skipped when the Issuer field is empty.
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
@@ -9,6 +10,7 @@ import (
 	"crypto/x509/pkix"
 	"encoding/hex"
 	"encoding/pem"
+	"io"
 	"math/big"
 	"os"
 	"path/filepath"
@@ -19,11 +21,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
-	internalpem "ocm.software/open-component-model/bindings/go/rsa/signing/handler/internal/pem"
 	"ocm.software/open-component-model/bindings/go/rsa/signing/v1alpha1"
 	rsacredentialsv1 "ocm.software/open-component-model/bindings/go/rsa/spec/credentials/v1"
 	identityv1 "ocm.software/open-component-model/bindings/go/rsa/spec/identity/v1"
 	"ocm.software/open-component-model/bindings/go/runtime"
+	signingpem "ocm.software/open-component-model/bindings/go/signing/pem"
 )
 
 func Test_RSA_Handler(t *testing.T) {
@@ -809,6 +811,57 @@ func Test_RSA_Verify_ErrorPaths_BothAlgs(t *testing.T) {
 	}
 }
 
+func Test_RSA_SignerProvider(t *testing.T) {
+	key := mustKey(t)
+	cert := mustSelfSigned(t, "hsm-signer", key)
+	hsmType := runtime.NewUnversionedType("HSM")
+
+	var released int
+	h, err := New(v1alpha1.Scheme, false, WithSignerProvider(func(_ context.Context, creds runtime.Typed) (crypto.Signer, []*x509.Certificate, func() error, error) {
+		if creds == nil || creds.GetType() != hsmType {
+			return nil, nil, nil, nil
+		}
+		return opaqueSigner{key}, []*x509.Certificate{cert}, func() error {
+			released++
+			return nil
+		}, nil
+	}))
+	require.NoError(t, err)
+
+	d := digestHex(crypto.SHA256, []byte("hello world"))
+	for _, alg := range []v1alpha1.SignatureAlgorithm{v1alpha1.AlgorithmRSASSAPSS, v1alpha1.AlgorithmRSASSAPKCS1V15} {
+		t.Run(string(alg), func(t *testing.T) {
+			si, err := h.Sign(t.Context(), d, &v1alpha1.Config{
+				SignatureAlgorithm:      alg,
+				SignatureEncodingPolicy: v1alpha1.SignatureEncodingPolicyPEM,
+			}, &runtime.Raw{Type: hsmType})
+			require.NoError(t, err)
+
+			sig := descruntime.Signature{Name: "hsm-signer", Digest: d, Signature: si}
+			require.NoError(t, h.Verify(t.Context(), sig, nil, &rsacredentialsv1.RSACredentials{
+				Type:         rsacredentialsv1.VersionedType,
+				PublicKeyPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})),
+			}))
+		})
+	}
+	require.Equal(t, 2, released)
+
+	// credentials not handled by the provider fall back to RSA credentials
+	_, err = h.Sign(t.Context(), d, &v1alpha1.Config{}, nil)
+	require.ErrorIs(t, err, ErrMissingPrivateKey)
+}
+
+// opaqueSigner hides the concrete key type, like a signer backed by an HSM.
+type opaqueSigner struct {
+	key *rsa.PrivateKey
+}
+
+func (s opaqueSigner) Public() crypto.PublicKey { return &s.key.PublicKey }
+
+func (s opaqueSigner) Sign(r io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.key.Sign(r, digest, opts)
+}
+
 func Test_RSA_Identity(t *testing.T) {
 	h, err := New(v1alpha1.Scheme, false)
 	require.NoError(t, err)
@@ -880,7 +933,7 @@ func Test_RSA_Identity(t *testing.T) {
 			t.Helper()
 			cert := mustSelfSigned(t, "cn=signer", mustKey(t))
 			// dummy bytes, no chain needed for identity parsing
-			return string(internalpem.SignatureBytesToPem(alg, []byte{0x01}, cert))
+			return string(signingpem.SignatureBytesToPem(alg, []byte{0x01}, cert))
 		}
 		tests := []struct {
 			name    string
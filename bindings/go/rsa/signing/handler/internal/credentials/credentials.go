@@ -1,13 +1,14 @@
 package credentials
 
 import (
+	"crypto"
 	"crypto/rsa"
 	"crypto/x509"
 	"fmt"
 	"os"
 
-	rsapem "ocm.software/open-component-model/bindings/go/rsa/signing/handler/internal/pem"
 	rsacredentialsv1 "ocm.software/open-component-model/bindings/go/rsa/spec/credentials/v1"
+	signingpem "ocm.software/open-component-model/bindings/go/signing/pem"
 )
 
 func PrivateKeyFromCredentials(creds *rsacredentialsv1.RSACredentials) (*rsa.PrivateKey, error) {
@@ -21,10 +22,14 @@ func PrivateKeyFromCredentials(creds *rsacredentialsv1.RSACredentials) (*rsa.Pri
 	if len(b) == 0 {
 		return nil, nil
 	}
-	return rsapem.ParseRSAPrivateKeyPEM(b), nil
+	key, _ := signingpem.ParsePrivateKeyPEM(b, func(k crypto.Signer) bool {
+		_, ok := k.(*rsa.PrivateKey)
+		return ok
+	}).(*rsa.PrivateKey)
+	return key, nil
 }
 
-func PublicKeyFromCredentials(creds *rsacredentialsv1.RSACredentials) (*rsapem.RSAPublicKeyPEM, error) {
+func PublicKeyFromCredentials(creds *rsacredentialsv1.RSACredentials) (*rsa.PublicKey, error) {
 	if creds == nil {
 		return nil, nil
 	}
@@ -41,11 +46,16 @@ func PublicKeyFromCredentials(creds *rsacredentialsv1.RSACredentials) (*rsapem.R
 		if pk == nil {
 			return nil, nil
 		}
-		return &rsapem.RSAPublicKeyPEM{
-			PublicKey: &pk.PublicKey,
-		}, nil
+		return &pk.PublicKey, nil
 	}
-	return rsapem.ParseRSAPublicKeyPEM(b), nil
+	pub := signingpem.ParsePublicKeyPEM(b, func(k crypto.PublicKey) bool {
+		_, ok := k.(*rsa.PublicKey)
+		return ok
+	})
+	if pub == nil {
+		return nil, nil
+	}
+	return pub.PublicKey.(*rsa.PublicKey), nil
 }
 
 func CertificateChainFromCredentials(creds *rsacredentialsv1.RSACredentials) ([]*x509.Certificate, error) {
@@ -56,7 +66,7 @@ func CertificateChainFromCredentials(creds *rsacredentialsv1.RSACredentials) ([]
 	if err != nil || len(b) == 0 {
 		return nil, nil
 	}
-	return rsapem.ParseCertificateChain(b)
+	return signingpem.ParseCertificateChain(b)
 }
 
 func loadBytes(inline, file string) ([]byte, error) {
@@ -4,17 +4,22 @@ import (
 	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
+	"fmt"
 
 	"ocm.software/open-component-model/bindings/go/rsa/signing/v1alpha1"
 )
 
 // signRSA signs dig using the requested RSA algorithm and hash.
-func signRSA(algorithm v1alpha1.SignatureAlgorithm, priv *rsa.PrivateKey, h crypto.Hash, dig []byte) ([]byte, error) {
+// PSS uses the maximum salt length, like rsa.SignPSS without options.
+func signRSA(algorithm v1alpha1.SignatureAlgorithm, signer crypto.Signer, h crypto.Hash, dig []byte) ([]byte, error) {
+	if _, ok := signer.Public().(*rsa.PublicKey); !ok {
+		return nil, fmt.Errorf("expected RSA key, got %T", signer.Public())
+	}
 	switch algorithm {
 	case v1alpha1.AlgorithmRSASSAPSS:
-		return rsa.SignPSS(rand.Reader, priv, h, dig, nil)
+		return signer.Sign(rand.Reader, dig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto, Hash: h})
 	case v1alpha1.AlgorithmRSASSAPKCS1V15:
-		return rsa.SignPKCS1v15(rand.Reader, priv, h, dig)
+		return signer.Sign(rand.Reader, dig, h)
 	default:
 		return nil, ErrInvalidAlgorithm
 	}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/rsa/signing/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/signing/timestamp"
+)
+
+// signingTime returns the time at which the certificate chain of a PEM signature must be valid.
+// If the configuration trusts a timestamp authority and the signature carries a timestamp,
+// the time asserted by the verified timestamp token is used. A timestamp that fails
+// verification is an error. In all other cases, the time of verification is used.
+func (h *Handler) signingTime(ctx context.Context, signed descruntime.Signature, cfg *v1alpha1.VerifyConfig) (time.Time, error) {
+	if signed.Timestamp == nil {
+		return h.now(), nil
+	}
+	if cfg == nil || cfg.TimestampAuthority == nil {
+		slog.DebugContext(ctx, "ignoring signature timestamp, no timestamp authority is trusted", "signature", signed.Name)
+		return h.now(), nil
+	}
+	roots, err := loadRoots(cfg.TimestampAuthority.RootCertificatesPEM, cfg.TimestampAuthority.RootCertificatesPEMFile)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("load timestamp authority roots: %w", err)
+	}
+	t, err := timestamp.VerifySignature(signed, roots)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("verify signature timestamp: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"crypto"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/rsa/signing/v1alpha1"
+	rsacredentialsv1 "ocm.software/open-component-model/bindings/go/rsa/spec/credentials/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/signing/timestamp"
+	"ocm.software/open-component-model/bindings/go/signing/timestamp/tsatest"
+)
+
+func Test_RSA_Timestamp(t *testing.T) {
+	c := buildChain(t)
+	leafKey := mustKey(t)
+	leaf := issueLeaf(t, c.interm, c.intermKey, &leafKey.PublicKey, nil)
+	rootPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.root.Raw}))
+	anchor := &rsacredentialsv1.RSACredentials{Type: rsacredentialsv1.VersionedType, PublicKeyPEM: rootPEM}
+
+	h, err := New(v1alpha1.Scheme, false)
+	require.NoError(t, err)
+	d := digestHex(crypto.SHA256, []byte("hello world"))
+	signed := signPEM(t, h, d, leafKey, leaf, c.interm)
+
+	tsa := tsatest.New(t)
+	signed.Timestamp, err = timestamp.ForSignature(t.Context(), nil, tsa.URL, signed.Signature)
+	require.NoError(t, err)
+
+	// verify after the signer certificate expired
+	h.now = func() time.Time { return leaf.NotAfter.Add(24 * time.Hour) }
+
+	verify := func(sig descruntime.Signature, tsaCfg *v1alpha1.TimestampAuthority, policy *v1alpha1.TrustPolicy) error {
+		return h.Verify(t.Context(), sig, &v1alpha1.VerifyConfig{
+			Type:               runtime.NewVersionedType(v1alpha1.VerifyConfigType, v1alpha1.Version),
+			TrustPolicy:        policy,
+			TimestampAuthority: tsaCfg,
+		}, anchor)
+	}
+	trusted := &v1alpha1.TimestampAuthority{RootCertificatesPEM: string(tsa.RootPEM())}
+
+	t.Run("timestamp proves signing during validity", func(t *testing.T) {
+		require.NoError(t, verify(signed, trusted, nil))
+		require.NoError(t, verify(signed, trusted, &v1alpha1.TrustPolicy{RootCertificatesPEM: rootPEM}))
+	})
+
+	t.Run("timestamp authority roots from file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "tsa.pem")
+		require.NoError(t, os.WriteFile(file, tsa.RootPEM(), 0o600))
+		require.NoError(t, verify(signed, &v1alpha1.TimestampAuthority{RootCertificatesPEMFile: file}, nil))
+	})
+
+	t.Run("timestamp ignored without trusted authority", func(t *testing.T) {
+		require.ErrorContains(t, verify(signed, nil, nil), "expired")
+		require.ErrorContains(t, h.Verify(t.Context(), signed, &v1alpha1.Config{}, anchor), "expired")
+	})
+
+	t.Run("no timestamp", func(t *testing.T) {
+		unstamped := *signed.DeepCopy()
+		unstamped.Timestamp = nil
+		require.ErrorContains(t, verify(unstamped, trusted, nil), "expired")
+	})
+
+	t.Run("untrusted authority", func(t *testing.T) {
+		other := tsatest.New(t)
+		err := verify(signed, &v1alpha1.TimestampAuthority{RootCertificatesPEM: string(other.RootPEM())}, nil)
+		require.ErrorContains(t, err, "verify signature timestamp")
+	})
+
+	t.Run("timestamp of another signature", func(t *testing.T) {
+		other := signPEM(t, h, d, leafKey, leaf, c.interm)
+		other.Timestamp = signed.Timestamp
+		// PSS signatures are randomized, so the timestamp does not cover the new signature.
+		require.ErrorContains(t, verify(other, trusted, nil), "timestamp does not match the signature")
+	})
+
+	t.Run("timestamp after expiry", func(t *testing.T) {
+		tsa.SetNow(h.now)
+		t.Cleanup(func() { tsa.SetNow(time.Now) })
+		late := *signed.DeepCopy()
+		late.Timestamp, err = timestamp.ForSignature(t.Context(), nil, tsa.URL, late.Signature)
+		require.NoError(t, err)
+		require.ErrorContains(t, verify(late, trusted, nil), "expired")
+	})
+
+	t.Run("invalid timestamp authority", func(t *testing.T) {
+		require.ErrorContains(t, verify(signed, &v1alpha1.TimestampAuthority{}, nil), "invalid timestamp authority")
+	})
+}
@@ -0,0 +1,306 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"ocm.software/open-component-model/bindings/go/rsa/signing/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	signingpem "ocm.software/open-component-model/bindings/go/signing/pem"
+	"ocm.software/open-component-model/bindings/go/signing/rfc2253"
+)
+
+// ErrTrustPolicyRequiresCertificate is returned when a trust policy is configured
+// but the signature does not embed a certificate chain.
+var ErrTrustPolicyRequiresCertificate = errors.New("trust policy requires a PEM signature with a certificate chain")
+
+// verifyConfigFromConfig returns rawCfg as validated VerifyConfig.
+// It returns nil for other configuration types.
+func verifyConfigFromConfig(rawCfg runtime.Typed) (*v1alpha1.VerifyConfig, error) {
+	if rawCfg == nil || rawCfg.GetType().Name != v1alpha1.VerifyConfigType {
+		return nil, nil
+	}
+	var cfg v1alpha1.VerifyConfig
+	if err := v1alpha1.Scheme.Convert(rawCfg, &cfg); err != nil {
+		return nil, fmt.Errorf("convert config: %w", err)
+	}
+	if cfg.TrustPolicy != nil {
+		if err := cfg.TrustPolicy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid trust policy: %w", err)
+		}
+	}
+	if cfg.TimestampAuthority != nil {
+		if err := cfg.TimestampAuthority.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid timestamp authority: %w", err)
+		}
+	}
+	return &cfg, nil
+}
+
+// verifyTrustPolicy validates leaf against the trust policy at the given signing time.
+// If the policy configures root certificates, they replace system roots and the
+// credential anchor. Subject, subject alternative names and key usages are checked
+// on the leaf, and revocation data is checked for every certificate of the chain.
+func verifyTrustPolicy(
+	policy *v1alpha1.TrustPolicy,
+	leaf *x509.Certificate,
+	intermediates []*x509.Certificate,
+	anchor *x509.Certificate,
+	roots *x509.CertPool,
+	signingTime time.Time,
+) error {
+	policyRoots, err := loadPolicyRoots(policy)
+	if err != nil {
+		return err
+	}
+	if policyRoots != nil {
+		roots = policyRoots
+	} else {
+		roots = trustedRoots(anchor, roots)
+	}
+
+	ip, err := classifyEmbeddedChain(intermediates, nil)
+	if err != nil {
+		return fmt.Errorf("invalid certificate chain: %w", err)
+	}
+	ekus, err := extKeyUsages(policy.GetExtendedKeyUsages())
+	if err != nil {
+		return err
+	}
+	chains, err := leaf.Verify(x509.VerifyOptions{
+		Intermediates: ip,
+		Roots:         roots,
+		KeyUsages:     ekus,
+		CurrentTime:   signingTime,
+	})
+	if err != nil {
+		return fmt.Errorf("certificate verification failed: %w", err)
+	}
+
+	if err := verifySubject(policy, leaf); err != nil {
+		return err
+	}
+	if err := verifyKeyUsages(policy.GetKeyUsages(), leaf); err != nil {
+		return err
+	}
+
+	revocation, err := loadRevocationData(policy)
+	if err != nil {
+		return err
+	}
+	if revocation == nil {
+		return nil
+	}
+	// Any verified chain without revoked certificates is sufficient.
+	var errs []error
+	for _, chain := range chains {
+		err := revocation.check(chain, signingTime, policy.RequireRevocationCheck)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+	return fmt.Errorf("revocation check failed: %w", errors.Join(errs...))
+}
+
+func loadPolicyRoots(policy *v1alpha1.TrustPolicy) (*x509.CertPool, error) {
+	return loadRoots(policy.RootCertificatesPEM, policy.RootCertificatesPEMFile)
+}
+
+// loadRoots returns a pool of the certificates from the inline PEM bundle or the PEM file.
+// It returns nil if neither is set.
+func loadRoots(inline, file string) (*x509.CertPool, error) {
+	data := []byte(inline)
+	if file != "" {
+		var err error
+		if data, err = os.ReadFile(file); err != nil {
+			return nil, fmt.Errorf("read root certificates: %w", err)
+		}
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	certs, err := signingpem.ParseCertificateChain(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse root certificates: %w", err)
+	}
+	pool := x509.NewCertPool()
+	for _, c := range certs {
+		pool.AddCert(c)
+	}
+	return pool, nil
+}
+
+// verifySubject checks the subject DN and subject alternative names of the leaf certificate.
+func verifySubject(policy *v1alpha1.TrustPolicy, leaf *x509.Certificate) error {
+	if policy.Subject != "" {
+		want, err := rfc2253.Parse(policy.Subject)
+		if err != nil {
+			return fmt.Errorf("parsing trust policy subject %q failed: %w", policy.Subject, err)
+		}
+		if err := rfc2253.Match(leaf.Subject, want); err != nil {
+			return fmt.Errorf("subject %q does not match trust policy subject %q: %w", leaf.Subject.String(), policy.Subject, err)
+		}
+	}
+
+	if len(policy.SubjectAlternativeNames) == 0 {
+		return nil
+	}
+	sans := slices.Concat(leaf.DNSNames, leaf.EmailAddresses)
+	for _, uri := range leaf.URIs {
+		sans = append(sans, uri.String())
+	}
+	for _, ip := range leaf.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, want := range policy.SubjectAlternativeNames {
+		if slices.Contains(sans, want) {
+			return nil
+		}
+	}
+	return fmt.Errorf("subject alternative names %v do not include any of %v", sans, policy.SubjectAlternativeNames)
+}
+
+func verifyKeyUsages(usages []v1alpha1.KeyUsage, leaf *x509.Certificate) error {
+	for _, u := range usages {
+		var bit x509.KeyUsage
+		switch u {
+		case v1alpha1.KeyUsageDigitalSignature:
+			bit = x509.KeyUsageDigitalSignature
+		case v1alpha1.KeyUsageContentCommitment:
+			bit = x509.KeyUsageContentCommitment
+		default:
+			return fmt.Errorf("unsupported key usage %q", u)
+		}
+		if leaf.KeyUsage&bit == 0 {
+			return fmt.Errorf("signer certificate does not allow key usage %s", u)
+		}
+	}
+	return nil
+}
+
+func extKeyUsages(usages []v1alpha1.ExtendedKeyUsage) ([]x509.ExtKeyUsage, error) {
+	ekus := make([]x509.ExtKeyUsage, 0, len(usages))
+	for _, u := range usages {
+		switch u {
+		case v1alpha1.ExtendedKeyUsageAny:
+			ekus = append(ekus, x509.ExtKeyUsageAny)
+		case v1alpha1.ExtendedKeyUsageCodeSigning:
+			ekus = append(ekus, x509.ExtKeyUsageCodeSigning)
+		case v1alpha1.ExtendedKeyUsageEmailProtection:
+			ekus = append(ekus, x509.ExtKeyUsageEmailProtection)
+		case v1alpha1.ExtendedKeyUsageTimeStamping:
+			ekus = append(ekus, x509.ExtKeyUsageTimeStamping)
+		default:
+			return nil, fmt.Errorf("unsupported extended key usage %q", u)
+		}
+	}
+	return ekus, nil
+}
+
+// revocationData holds offline CRLs and OCSP responses.
+type revocationData struct {
+	crls []*x509.RevocationList
+	ocsp [][]byte
+}
+
+func loadRevocationData(policy *v1alpha1.TrustPolicy) (*revocationData, error) {
+	if len(policy.CRLFiles) == 0 && len(policy.OCSPResponseFiles) == 0 {
+		if policy.RequireRevocationCheck {
+			return nil, errors.New("trust policy requires a revocation check but configures no CRL or OCSP data")
+		}
+		return nil, nil
+	}
+	data := &revocationData{}
+	for _, file := range policy.CRLFiles {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read CRL: %w", err)
+		}
+		if block, _ := pem.Decode(b); block != nil {
+			b = block.Bytes
+		}
+		crl, err := x509.ParseRevocationList(b)
+		if err != nil {
+			return nil, fmt.Errorf("parse CRL %q: %w", file, err)
+		}
+		data.crls = append(data.crls, crl)
+	}
+	for _, file := range policy.OCSPResponseFiles {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read OCSP response: %w", err)
+		}
+		data.ocsp = append(data.ocsp, b)
+	}
+	return data, nil
+}
+
+// check verifies that no certificate of chain (leaf first, root last) was revoked
+// at signing time. Revocations because of a key or CA compromise invalidate the
+// certificate regardless of the revocation time. CRLs and OCSP responses are only
+// used if they are signed by the issuer and still valid at signing time.
+// If required is set, every certificate but the root must be covered by revocation data.
+func (d *revocationData) check(chain []*x509.Certificate, signingTime time.Time, required bool) error {
+	for i := 0; i < len(chain)-1; i++ {
+		cert, issuer := chain[i], chain[i+1]
+		checked := false
+
+		for _, crl := range d.crls {
+			if !bytes.Equal(crl.RawIssuer, cert.RawIssuer) || crl.CheckSignatureFrom(issuer) != nil {
+				continue
+			}
+			if !crl.NextUpdate.IsZero() && crl.NextUpdate.Before(signingTime) {
+				continue
+			}
+			checked = true
+			for _, entry := range crl.RevokedCertificateEntries {
+				if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+					if err := revoked(cert, entry.RevocationTime, entry.ReasonCode, signingTime); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		for _, raw := range d.ocsp {
+			resp, err := ocsp.ParseResponseForCert(raw, cert, issuer)
+			if err != nil {
+				// the response is for another certificate or not signed by the issuer
+				continue
+			}
+			if !resp.NextUpdate.IsZero() && resp.NextUpdate.Before(signingTime) {
+				continue
+			}
+			switch resp.Status {
+			case ocsp.Good:
+				checked = true
+			case ocsp.Revoked:
+				checked = true
+				if err := revoked(cert, resp.RevokedAt, resp.RevocationReason, signingTime); err != nil {
+					return err
+				}
+			}
+		}
+
+		if required && !checked {
+			return fmt.Errorf("no revocation data for certificate %q", cert.Subject.String())
+		}
+	}
+	return nil
+}
+
+func revoked(cert *x509.Certificate, at time.Time, reason int, signingTime time.Time) error {
+	if reason == ocsp.KeyCompromise || reason == ocsp.CACompromise || !at.After(signingTime) {
+		return fmt.Errorf("certificate %q was revoked at %s", cert.Subject.String(), at.Format(time.RFC3339))
+	}
+	return nil
+}
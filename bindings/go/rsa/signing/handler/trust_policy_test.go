@@ -0,0 +1,303 @@
+package handler
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/rsa/signing/v1alpha1"
+	rsacredentialsv1 "ocm.software/open-component-model/bindings/go/rsa/spec/credentials/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func Test_RSA_TrustPolicy(t *testing.T) {
+	c := buildChain(t)
+	leafKey := mustKey(t)
+	leaf := issueLeaf(t, c.interm, c.intermKey, &leafKey.PublicKey, func(tmpl *x509.Certificate) {
+		tmpl.Subject = pkix.Name{CommonName: "alice", OrganizationalUnit: []string{"Release Engineering"}, Organization: []string{"Example Corp"}}
+		tmpl.EmailAddresses = []string{"alice@example.com"}
+	})
+
+	h, err := New(v1alpha1.Scheme, false)
+	require.NoError(t, err)
+	d := digestHex(crypto.SHA256, []byte("hello world"))
+	signed := signPEM(t, h, d, leafKey, leaf, c.interm)
+
+	rootPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.root.Raw}))
+	otherRoot := mustSelfSigned(t, "other-root", mustKey(t))
+	otherRootPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: otherRoot.Raw}))
+
+	verify := func(policy *v1alpha1.TrustPolicy, sig descruntime.Signature) error {
+		return h.Verify(t.Context(), sig, &v1alpha1.VerifyConfig{
+			Type:        runtime.NewVersionedType(v1alpha1.VerifyConfigType, v1alpha1.Version),
+			TrustPolicy: policy,
+		}, nil)
+	}
+
+	t.Run("accepts any certificate of the unit issued by the root", func(t *testing.T) {
+		require.NoError(t, verify(&v1alpha1.TrustPolicy{
+			RootCertificatesPEM: rootPEM,
+			Subject:             "OU=Release Engineering,O=Example Corp",
+		}, signed))
+	})
+
+	t.Run("root from file", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "roots.pem")
+		require.NoError(t, os.WriteFile(file, []byte(otherRootPEM+rootPEM), 0o600))
+		require.NoError(t, verify(&v1alpha1.TrustPolicy{RootCertificatesPEMFile: file}, signed))
+	})
+
+	t.Run("untrusted root", func(t *testing.T) {
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{RootCertificatesPEM: otherRootPEM}, signed), "certificate verification failed")
+	})
+
+	t.Run("policy roots replace credential anchor", func(t *testing.T) {
+		err := h.Verify(t.Context(), signed, &v1alpha1.VerifyConfig{
+			Type:        runtime.NewUnversionedType(v1alpha1.VerifyConfigType),
+			TrustPolicy: &v1alpha1.TrustPolicy{RootCertificatesPEM: otherRootPEM},
+		}, &rsacredentialsv1.RSACredentials{Type: rsacredentialsv1.VersionedType, PublicKeyPEM: rootPEM})
+		require.ErrorContains(t, err, "certificate verification failed")
+	})
+
+	t.Run("credential anchor without policy roots", func(t *testing.T) {
+		err := h.Verify(t.Context(), signed, &v1alpha1.VerifyConfig{
+			Type:        runtime.NewUnversionedType(v1alpha1.VerifyConfigType),
+			TrustPolicy: &v1alpha1.TrustPolicy{Subject: "O=Example Corp"},
+		}, &rsacredentialsv1.RSACredentials{Type: rsacredentialsv1.VersionedType, PublicKeyPEM: rootPEM})
+		require.NoError(t, err)
+	})
+
+	t.Run("subject mismatch", func(t *testing.T) {
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{
+			RootCertificatesPEM: rootPEM,
+			Subject:             "OU=Marketing,O=Example Corp",
+		}, signed), "does not match trust policy subject")
+	})
+
+	t.Run("subject alternative names", func(t *testing.T) {
+		require.NoError(t, verify(&v1alpha1.TrustPolicy{
+			RootCertificatesPEM:     rootPEM,
+			SubjectAlternativeNames: []string{"bob@example.com", "alice@example.com"},
+		}, signed))
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{
+			RootCertificatesPEM:     rootPEM,
+			SubjectAlternativeNames: []string{"bob@example.com"},
+		}, signed), "subject alternative names")
+	})
+
+	t.Run("key usage", func(t *testing.T) {
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{
+			RootCertificatesPEM: rootPEM,
+			KeyUsages:           []v1alpha1.KeyUsage{v1alpha1.KeyUsageContentCommitment},
+		}, signed), "does not allow key usage contentCommitment")
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{
+			KeyUsages: []v1alpha1.KeyUsage{"keyAgreement"},
+		}, signed), "unsupported key usage")
+	})
+
+	t.Run("extended key usage", func(t *testing.T) {
+		tlsKey := mustKey(t)
+		tlsLeaf := issueLeaf(t, c.interm, c.intermKey, &tlsKey.PublicKey, func(tmpl *x509.Certificate) {
+			tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		})
+		tlsSigned := signPEM(t, h, d, tlsKey, tlsLeaf, c.interm)
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{RootCertificatesPEM: rootPEM}, tlsSigned), "certificate verification failed")
+		require.NoError(t, verify(&v1alpha1.TrustPolicy{
+			RootCertificatesPEM: rootPEM,
+			ExtendedKeyUsages:   []v1alpha1.ExtendedKeyUsage{v1alpha1.ExtendedKeyUsageAny},
+		}, tlsSigned))
+	})
+
+	t.Run("validity at signing time", func(t *testing.T) {
+		expiredKey := mustKey(t)
+		expired := issueLeaf(t, c.interm, c.intermKey, &expiredKey.PublicKey, func(tmpl *x509.Certificate) {
+			tmpl.NotBefore = time.Now().Add(-48 * time.Hour)
+			tmpl.NotAfter = time.Now().Add(-24 * time.Hour)
+		})
+		expiredSigned := signPEM(t, h, d, expiredKey, expired, c.interm)
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{RootCertificatesPEM: rootPEM}, expiredSigned), "expired")
+	})
+
+	t.Run("plain signatures cannot satisfy a policy", func(t *testing.T) {
+		si, err := h.Sign(t.Context(), d, &v1alpha1.Config{}, &rsacredentialsv1.RSACredentials{
+			Type:          rsacredentialsv1.VersionedType,
+			PrivateKeyPEM: string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(leafKey)})),
+		})
+		require.NoError(t, err)
+		require.ErrorIs(t, verify(&v1alpha1.TrustPolicy{RootCertificatesPEM: rootPEM}, descruntime.Signature{Digest: d, Signature: si}), ErrTrustPolicyRequiresCertificate)
+	})
+
+	t.Run("verify config without policy", func(t *testing.T) {
+		require.NoError(t, h.Verify(t.Context(), signed, &runtime.Raw{
+			Type: runtime.NewVersionedType(v1alpha1.VerifyConfigType, v1alpha1.Version),
+			Data: []byte(`{"type":"RSAVerificationConfiguration/v1alpha1"}`),
+		}, &rsacredentialsv1.RSACredentials{Type: rsacredentialsv1.VersionedType, PublicKeyPEM: rootPEM}))
+	})
+}
+
+func Test_RSA_TrustPolicy_Revocation(t *testing.T) {
+	c := buildChain(t)
+	leafKey := mustKey(t)
+	leaf := issueLeaf(t, c.interm, c.intermKey, &leafKey.PublicKey, nil)
+
+	h, err := New(v1alpha1.Scheme, false)
+	require.NoError(t, err)
+	d := digestHex(crypto.SHA256, []byte("hello world"))
+	signed := signPEM(t, h, d, leafKey, leaf, c.interm)
+	rootPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.root.Raw}))
+	dir := t.TempDir()
+
+	verify := func(policy *v1alpha1.TrustPolicy) error {
+		policy.RootCertificatesPEM = rootPEM
+		return h.Verify(t.Context(), signed, &v1alpha1.VerifyConfig{
+			Type:        runtime.NewVersionedType(v1alpha1.VerifyConfigType, v1alpha1.Version),
+			TrustPolicy: policy,
+		}, nil)
+	}
+
+	emptyIntermCRL := writeCRL(t, dir, "interm-empty.crl", c.interm, c.intermKey)
+	emptyRootCRL := writeCRL(t, dir, "root-empty.crl", c.root, c.rootKey)
+	revokedLeafCRL := writeCRL(t, dir, "leaf-revoked.crl", c.interm, c.intermKey, x509.RevocationListEntry{
+		SerialNumber:   leaf.SerialNumber,
+		RevocationTime: time.Now().Add(-time.Minute),
+	})
+	laterRevokedLeafCRL := writeCRL(t, dir, "leaf-revoked-later.crl", c.interm, c.intermKey, x509.RevocationListEntry{
+		SerialNumber:   leaf.SerialNumber,
+		RevocationTime: time.Now().Add(time.Hour),
+	})
+	compromisedLeafCRL := writeCRL(t, dir, "leaf-compromised.crl", c.interm, c.intermKey, x509.RevocationListEntry{
+		SerialNumber:   leaf.SerialNumber,
+		RevocationTime: time.Now().Add(time.Hour),
+		ReasonCode:     ocsp.KeyCompromise,
+	})
+	forgedCRL := writeCRL(t, dir, "forged.crl", c.interm, mustKey(t), x509.RevocationListEntry{
+		SerialNumber:   leaf.SerialNumber,
+		RevocationTime: time.Now().Add(-time.Minute),
+	})
+
+	t.Run("not revoked", func(t *testing.T) {
+		require.NoError(t, verify(&v1alpha1.TrustPolicy{CRLFiles: []string{emptyIntermCRL, emptyRootCRL}, RequireRevocationCheck: true}))
+	})
+
+	t.Run("revoked before signing", func(t *testing.T) {
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{CRLFiles: []string{revokedLeafCRL}}), "was revoked")
+	})
+
+	t.Run("revoked after signing", func(t *testing.T) {
+		require.NoError(t, verify(&v1alpha1.TrustPolicy{CRLFiles: []string{laterRevokedLeafCRL}}))
+	})
+
+	t.Run("key compromise after signing", func(t *testing.T) {
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{CRLFiles: []string{compromisedLeafCRL}}), "was revoked")
+	})
+
+	t.Run("CRL not signed by issuer is ignored", func(t *testing.T) {
+		require.NoError(t, verify(&v1alpha1.TrustPolicy{CRLFiles: []string{forgedCRL}}))
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{CRLFiles: []string{forgedCRL}, RequireRevocationCheck: true}), "no revocation data")
+	})
+
+	t.Run("required revocation data missing", func(t *testing.T) {
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{CRLFiles: []string{emptyIntermCRL}, RequireRevocationCheck: true}), "no revocation data for certificate \"CN=cn=intermediate\"")
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{RequireRevocationCheck: true}), "configures no CRL or OCSP data")
+	})
+
+	t.Run("OCSP", func(t *testing.T) {
+		good := writeOCSP(t, dir, "good.ocsp", leaf, c.interm, c.intermKey, ocsp.Response{Status: ocsp.Good})
+		revoked := writeOCSP(t, dir, "revoked.ocsp", leaf, c.interm, c.intermKey, ocsp.Response{
+			Status:    ocsp.Revoked,
+			RevokedAt: time.Now().Add(-time.Minute),
+		})
+		require.NoError(t, verify(&v1alpha1.TrustPolicy{
+			CRLFiles:               []string{emptyRootCRL},
+			OCSPResponseFiles:      []string{good},
+			RequireRevocationCheck: true,
+		}))
+		require.ErrorContains(t, verify(&v1alpha1.TrustPolicy{OCSPResponseFiles: []string{revoked}}), "was revoked")
+	})
+}
+
+// ---- helpers ----
+
+func issueLeaf(t *testing.T, parent *x509.Certificate, parentKey *rsa.PrivateKey, pub *rsa.PublicKey, mutate func(*x509.Certificate)) *x509.Certificate {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: mustRand128(t),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	if mutate != nil {
+		mutate(tmpl)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, pub, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func signPEM(t *testing.T, h *Handler, d descruntime.Digest, key *rsa.PrivateKey, chain ...*x509.Certificate) descruntime.Signature {
+	t.Helper()
+	var chainPEM []byte
+	for _, c := range chain {
+		chainPEM = append(chainPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})...)
+	}
+	si, err := h.Sign(t.Context(), d, &v1alpha1.Config{SignatureEncodingPolicy: v1alpha1.SignatureEncodingPolicyPEM}, &rsacredentialsv1.RSACredentials{
+		Type:          rsacredentialsv1.VersionedType,
+		PrivateKeyPEM: string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		PublicKeyPEM:  string(chainPEM),
+	})
+	require.NoError(t, err)
+	return descruntime.Signature{Name: "default", Digest: d, Signature: si}
+}
+
+func writeCRL(t *testing.T, dir, name string, issuer *x509.Certificate, issuerKey *rsa.PrivateKey, entries ...x509.RevocationListEntry) string {
+	t.Helper()
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                time.Now().Add(-time.Hour),
+		NextUpdate:                time.Now().Add(24 * time.Hour),
+		RevokedCertificateEntries: entries,
+	}, issuer, issuerKey)
+	if err != nil {
+		// CreateRevocationList checks that the key matches the issuer, forge by hand otherwise.
+		forger := *issuer
+		forger.PublicKey = &issuerKey.PublicKey
+		der, err = x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+			Number:                    big.NewInt(1),
+			ThisUpdate:                time.Now().Add(-time.Hour),
+			NextUpdate:                time.Now().Add(24 * time.Hour),
+			RevokedCertificateEntries: entries,
+		}, &forger, issuerKey)
+	}
+	require.NoError(t, err)
+	file := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}), 0o600))
+	return file
+}
+
+func writeOCSP(t *testing.T, dir, name string, cert, issuer *x509.Certificate, issuerKey *rsa.PrivateKey, tmpl ocsp.Response) string {
+	t.Helper()
+	tmpl.SerialNumber = cert.SerialNumber
+	tmpl.ThisUpdate = time.Now().Add(-time.Hour)
+	tmpl.NextUpdate = time.Now().Add(24 * time.Hour)
+	der, err := ocsp.CreateResponse(issuer, issuer, tmpl, issuerKey)
+	require.NoError(t, err)
+	file := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(file, der, 0o600))
+	return file
+}
@@ -0,0 +1,175 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const VerifyConfigType = "RSAVerificationConfiguration"
+
+func init() {
+	Scheme.MustRegisterWithAlias(&VerifyConfig{},
+		runtime.NewUnversionedType(VerifyConfigType),
+		runtime.NewVersionedType(VerifyConfigType, Version),
+	)
+}
+
+// VerifyConfig defines configuration for verifying RSA signatures.
+//
+// Without a TrustPolicy, verification behaves as with a Config: plain signatures
+// are verified against the public key from credentials, and PEM signatures
+// against system roots and/or the trust anchor from credentials.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type VerifyConfig struct {
+	// Type identifies this configuration object’s runtime type.
+	// +ocm:jsonschema-gen:enum=RSAVerificationConfiguration/v1alpha1
+	// +ocm:jsonschema-gen:enum:deprecated=RSAVerificationConfiguration
+	Type runtime.Type `json:"type"`
+
+	// TrustPolicy restricts which signer certificates are accepted.
+	// If set, only PEM signatures with an embedded certificate chain can satisfy it.
+	TrustPolicy *TrustPolicy `json:"trustPolicy,omitempty"`
+
+	// TimestampAuthority configures which RFC 3161 timestamp authorities are trusted.
+	// If set, the time asserted by a valid timestamp token on a PEM signature is used
+	// as signing time, so signatures remain valid after the signer certificate expired.
+	TimestampAuthority *TimestampAuthority `json:"timestampAuthority,omitempty"`
+}
+
+// TimestampAuthority describes the trusted issuers of timestamp tokens.
+// Exactly one of RootCertificatesPEM and RootCertificatesPEMFile must be set.
+//
+// +k8s:deepcopy-gen=true
+// +ocm:jsonschema-gen=true
+type TimestampAuthority struct {
+	// RootCertificatesPEM is an inline PEM bundle of root certificates
+	// the timestamp authority certificate must chain to.
+	RootCertificatesPEM string `json:"rootCertificatesPEM,omitempty"`
+
+	// RootCertificatesPEMFile is the path to a PEM bundle of root certificates,
+	// see RootCertificatesPEM.
+	RootCertificatesPEMFile string `json:"rootCertificatesPEMFile,omitempty"`
+}
+
+// TrustPolicy describes which X.509 certificates are trusted to sign component versions.
+// It allows trusting every certificate a CA issued for a given subject instead of
+// pinning individual public keys.
+//
+// All certificates of the chain must be valid at signing time. Unless the signing
+// time is proven by a trusted timestamp, the time of verification is used.
+//
+// +k8s:deepcopy-gen=true
+// +ocm:jsonschema-gen=true
+type TrustPolicy struct {
+	// RootCertificatesPEM is an inline PEM bundle of root CA certificates.
+	// If RootCertificatesPEM or RootCertificatesPEMFile is set, the chain must
+	// terminate at one of these roots; system roots and trust anchors from
+	// credentials are not used.
+	RootCertificatesPEM string `json:"rootCertificatesPEM,omitempty"`
+
+	// RootCertificatesPEMFile is the path to a PEM bundle of root CA certificates,
+	// see RootCertificatesPEM.
+	RootCertificatesPEMFile string `json:"rootCertificatesPEMFile,omitempty"`
+
+	// Subject is an RFC 2253 distinguished name the signer certificate subject must match.
+	// Every attribute given must be present in the subject, so
+	// "OU=Release Engineering,O=Example Corp" accepts any common name in that unit.
+	Subject string `json:"subject,omitempty"`
+
+	// SubjectAlternativeNames lists accepted subject alternative names (DNS names,
+	// email addresses, URIs or IP addresses). If set, the signer certificate must
+	// carry at least one of them.
+	SubjectAlternativeNames []string `json:"subjectAlternativeNames,omitempty"`
+
+	// KeyUsages lists the key usages the signer certificate must allow.
+	// Defaults to digitalSignature.
+	KeyUsages []KeyUsage `json:"keyUsages,omitempty"`
+
+	// ExtendedKeyUsages lists the extended key usages the chain must allow.
+	// Defaults to codeSigning.
+	ExtendedKeyUsages []ExtendedKeyUsage `json:"extendedKeyUsages,omitempty"`
+
+	// CRLFiles are paths to certificate revocation lists (PEM or DER) used to
+	// check the chain for revoked certificates without network access.
+	CRLFiles []string `json:"crlFiles,omitempty"`
+
+	// OCSPResponseFiles are paths to DER encoded OCSP responses used to check
+	// the chain for revoked certificates without network access.
+	OCSPResponseFiles []string `json:"ocspResponseFiles,omitempty"`
+
+	// RequireRevocationCheck requires revocation data from CRLFiles or
+	// OCSPResponseFiles for every certificate of the chain except the root.
+	// If not set, only certificates with revocation data are checked.
+	RequireRevocationCheck bool `json:"requireRevocationCheck,omitempty"`
+}
+
+// KeyUsage is an X.509 key usage.
+// +ocm:jsonschema-gen:enum=digitalSignature,contentCommitment
+type KeyUsage string
+
+const (
+	KeyUsageDigitalSignature  KeyUsage = "digitalSignature"
+	KeyUsageContentCommitment KeyUsage = "contentCommitment"
+)
+
+// ExtendedKeyUsage is an X.509 extended key usage.
+// +ocm:jsonschema-gen:enum=any,codeSigning,emailProtection,timeStamping
+type ExtendedKeyUsage string
+
+const (
+	ExtendedKeyUsageAny             ExtendedKeyUsage = "any"
+	ExtendedKeyUsageCodeSigning     ExtendedKeyUsage = "codeSigning"
+	ExtendedKeyUsageEmailProtection ExtendedKeyUsage = "emailProtection"
+	ExtendedKeyUsageTimeStamping    ExtendedKeyUsage = "timeStamping"
+)
+
+// GetKeyUsages returns the required key usages, defaulting to digitalSignature.
+func (p *TrustPolicy) GetKeyUsages() []KeyUsage {
+	if p == nil || len(p.KeyUsages) == 0 {
+		return []KeyUsage{KeyUsageDigitalSignature}
+	}
+	return p.KeyUsages
+}
+
+// GetExtendedKeyUsages returns the required extended key usages, defaulting to codeSigning.
+func (p *TrustPolicy) GetExtendedKeyUsages() []ExtendedKeyUsage {
+	if p == nil || len(p.ExtendedKeyUsages) == 0 {
+		return []ExtendedKeyUsage{ExtendedKeyUsageCodeSigning}
+	}
+	return p.ExtendedKeyUsages
+}
+
+// Validate checks that exactly one source of root certificates is set.
+func (a *TimestampAuthority) Validate() error {
+	if (a.RootCertificatesPEM == "") == (a.RootCertificatesPEMFile == "") {
+		return fmt.Errorf("exactly one of rootCertificatesPEM and rootCertificatesPEMFile must be set")
+	}
+	return nil
+}
+
+// Validate checks that the TrustPolicy fields are well-formed.
+func (p *TrustPolicy) Validate() error {
+	if p.RootCertificatesPEM != "" && p.RootCertificatesPEMFile != "" {
+		return fmt.Errorf("only one of rootCertificatesPEM and rootCertificatesPEMFile can be set")
+	}
+	for _, u := range p.KeyUsages {
+		switch u {
+		case KeyUsageDigitalSignature, KeyUsageContentCommitment:
+		default:
+			return fmt.Errorf("unsupported key usage %q", u)
+		}
+	}
+	for _, u := range p.ExtendedKeyUsages {
+		switch u {
+		case ExtendedKeyUsageAny, ExtendedKeyUsageCodeSigning, ExtendedKeyUsageEmailProtection, ExtendedKeyUsageTimeStamping:
+		default:
+			return fmt.Errorf("unsupported extended key usage %q", u)
+		}
+	}
+	return nil
+}
@@ -33,3 +33,95 @@ func (in *Config) DeepCopyTyped() runtime.Typed {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampAuthority) DeepCopyInto(out *TimestampAuthority) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampAuthority.
+func (in *TimestampAuthority) DeepCopy() *TimestampAuthority {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampAuthority)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustPolicy) DeepCopyInto(out *TrustPolicy) {
+	*out = *in
+	if in.SubjectAlternativeNames != nil {
+		in, out := &in.SubjectAlternativeNames, &out.SubjectAlternativeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyUsages != nil {
+		in, out := &in.KeyUsages, &out.KeyUsages
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtendedKeyUsages != nil {
+		in, out := &in.ExtendedKeyUsages, &out.ExtendedKeyUsages
+		*out = make([]ExtendedKeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.CRLFiles != nil {
+		in, out := &in.CRLFiles, &out.CRLFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OCSPResponseFiles != nil {
+		in, out := &in.OCSPResponseFiles, &out.OCSPResponseFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustPolicy.
+func (in *TrustPolicy) DeepCopy() *TrustPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerifyConfig) DeepCopyInto(out *VerifyConfig) {
+	*out = *in
+	out.Type = in.Type
+	if in.TrustPolicy != nil {
+		in, out := &in.TrustPolicy, &out.TrustPolicy
+		*out = new(TrustPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimestampAuthority != nil {
+		in, out := &in.TimestampAuthority, &out.TimestampAuthority
+		*out = new(TimestampAuthority)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerifyConfig.
+func (in *VerifyConfig) DeepCopy() *VerifyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VerifyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyTyped is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Typed.
+func (in *VerifyConfig) DeepCopyTyped() runtime.Typed {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -12,17 +12,42 @@ import (
 //go:embed schemas/Config.schema.json
 var schemaConfig []byte
 
+//go:embed schemas/ExtendedKeyUsage.schema.json
+var schemaExtendedKeyUsage []byte
+
+//go:embed schemas/KeyUsage.schema.json
+var schemaKeyUsage []byte
+
 //go:embed schemas/SignatureAlgorithm.schema.json
 var schemaSignatureAlgorithm []byte
 
 //go:embed schemas/SignatureEncodingPolicy.schema.json
 var schemaSignatureEncodingPolicy []byte
 
+//go:embed schemas/TimestampAuthority.schema.json
+var schemaTimestampAuthority []byte
+
+//go:embed schemas/TrustPolicy.schema.json
+var schemaTrustPolicy []byte
+
+//go:embed schemas/VerifyConfig.schema.json
+var schemaVerifyConfig []byte
+
 // JSONSchema returns the JSON Schema for Config.
 func (Config) JSONSchema() []byte {
 	return schemaConfig
 }
 
+// JSONSchema returns the JSON Schema for ExtendedKeyUsage.
+func (ExtendedKeyUsage) JSONSchema() []byte {
+	return schemaExtendedKeyUsage
+}
+
+// JSONSchema returns the JSON Schema for KeyUsage.
+func (KeyUsage) JSONSchema() []byte {
+	return schemaKeyUsage
+}
+
 // JSONSchema returns the JSON Schema for SignatureAlgorithm.
 func (SignatureAlgorithm) JSONSchema() []byte {
 	return schemaSignatureAlgorithm
@@ -32,3 +57,18 @@ func (SignatureAlgorithm) JSONSchema() []byte {
 func (SignatureEncodingPolicy) JSONSchema() []byte {
 	return schemaSignatureEncodingPolicy
 }
+
+// JSONSchema returns the JSON Schema for TimestampAuthority.
+func (TimestampAuthority) JSONSchema() []byte {
+	return schemaTimestampAuthority
+}
+
+// JSONSchema returns the JSON Schema for TrustPolicy.
+func (TrustPolicy) JSONSchema() []byte {
+	return schemaTrustPolicy
+}
+
+// JSONSchema returns the JSON Schema for VerifyConfig.
+func (VerifyConfig) JSONSchema() []byte {
+	return schemaVerifyConfig
+}
@@ -16,3 +16,13 @@ func (t *Config) SetType(typ runtime.Type) {
 func (t *Config) GetType() runtime.Type {
 	return t.Type
 }
+
+// SetType is an autogenerated setter function, useful for type inference and defaulting.
+func (t *VerifyConfig) SetType(typ runtime.Type) {
+	t.Type = typ
+}
+
+// GetType is an autogenerated getter function, useful for type inference and defaulting.
+func (t *VerifyConfig) GetType() runtime.Type {
+	return t.Type
+}
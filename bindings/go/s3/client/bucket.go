@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"io"
+)
+
+// Bucket gives access to the objects of a single bucket as flat key-value store.
+// It can be used as ctf.ObjectStore to store a CTF in a bucket.
+type Bucket struct {
+	Client *Client
+	Name   string
+}
+
+// NewBucket returns the bucket with the given name.
+func NewBucket(client *Client, name string) *Bucket {
+	return &Bucket{Client: client, Name: name}
+}
+
+// GetObject returns the content and size of the object with the given key.
+// The returned error matches fs.ErrNotExist if the object does not exist.
+func (b *Bucket) GetObject(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	obj, err := b.Client.GetObject(ctx, b.Name, key, "")
+	if err != nil {
+		return nil, 0, err
+	}
+	return obj.Body, obj.Size, nil
+}
+
+// StatObject returns the size of the object with the given key.
+// The returned error matches fs.ErrNotExist if the object does not exist.
+func (b *Bucket) StatObject(ctx context.Context, key string) (int64, error) {
+	info, err := b.Client.HeadObject(ctx, b.Name, key)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size, nil
+}
+
+// PutObject stores the content under the given key.
+func (b *Bucket) PutObject(ctx context.Context, key string, data io.Reader, size int64) error {
+	_, err := b.Client.PutObject(ctx, b.Name, key, data, size, "")
+	return err
+}
+
+// DeleteObject deletes the object with the given key.
+func (b *Bucket) DeleteObject(ctx context.Context, key string) error {
+	return b.Client.DeleteObject(ctx, b.Name, key)
+}
+
+// ListObjects returns the keys of all objects starting with the given prefix.
+func (b *Bucket) ListObjects(ctx context.Context, prefix string) ([]string, error) {
+	return b.Client.ListObjects(ctx, b.Name, prefix)
+}
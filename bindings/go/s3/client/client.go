@@ -0,0 +1,330 @@
+// Package client implements a minimal client for S3-compatible object storages, such as AWS S3 or MinIO.
+//
+// Only the operations on single objects and the listing of objects are supported. Requests are signed
+// with AWS Signature Version 4. Objects on custom endpoints are addressed path-style
+// (http://endpoint/bucket/key), which is supported by all S3-compatible servers. Without an endpoint,
+// the virtual-hosted AWS S3 endpoint of the region (https://bucket.s3.region.amazonaws.com/key) is used.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultRegion is used if no region is configured.
+const DefaultRegion = "us-east-1"
+
+const (
+	// DefaultPartSize is the size of the parts of multipart uploads if no part size is configured.
+	DefaultPartSize = 16 << 20
+	// MinPartSize is the minimum size S3 accepts for all but the last part of a multipart upload.
+	MinPartSize = 5 << 20
+	// maxParts is the maximum number of parts of a multipart upload.
+	maxParts = 10000
+)
+
+// Credentials are the access key used to sign requests.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// Client sends requests to an S3-compatible object storage.
+type Client struct {
+	// HTTPClient is used for all requests. If nil, nethttp.DefaultClient is used.
+	HTTPClient *nethttp.Client
+	// Endpoint is the URL of an S3-compatible server. If empty, AWS S3 is used.
+	Endpoint string
+	// Region is the region used for signing and for the AWS S3 endpoint. If empty, DefaultRegion is used.
+	Region string
+	// Credentials sign the requests. If nil, requests are sent anonymously.
+	Credentials *Credentials
+	// PartSize is the size of the parts of multipart uploads. Objects larger than a part or of unknown size
+	// are uploaded with a multipart upload. If zero, DefaultPartSize is used, values below MinPartSize
+	// are raised to MinPartSize.
+	PartSize int64
+
+	// now is used as the signing time, overridable in tests.
+	now func() time.Time
+}
+
+// Object is the content of an object.
+type Object struct {
+	// Body is the content of the object and must be closed by the caller.
+	Body io.ReadCloser
+	// Size is the size of the object in bytes or -1 if unknown.
+	Size int64
+	// ContentType is the Content-Type the object was stored with.
+	ContentType string
+	// VersionID is the version of the object, if the bucket is versioned.
+	VersionID string
+}
+
+// ObjectInfo describes an object without its content.
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+	VersionID   string
+}
+
+// Error is returned for error responses of the server.
+// Errors for missing buckets or objects additionally match fs.ErrNotExist.
+type Error struct {
+	StatusCode int
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("s3 request failed with status %d", e.StatusCode)
+	if e.Code != "" {
+		msg += ": " + e.Code
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Is reports not found responses as fs.ErrNotExist.
+func (e *Error) Is(target error) bool {
+	return target == fs.ErrNotExist && e.StatusCode == nethttp.StatusNotFound
+}
+
+// GetObject downloads the object with the given key. If versionID is set, that version of the object is returned.
+func (c *Client) GetObject(ctx context.Context, bucket, key, versionID string) (*Object, error) {
+	query := url.Values{}
+	if versionID != "" {
+		query.Set("versionId", versionID)
+	}
+	resp, err := c.do(ctx, nethttp.MethodGet, bucket, key, query, nil, -1, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting object %q from bucket %q: %w", key, bucket, err)
+	}
+	return &Object{
+		Body:        resp.Body,
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+		VersionID:   resp.Header.Get("X-Amz-Version-Id"),
+	}, nil
+}
+
+// HeadObject returns the metadata of the object with the given key.
+func (c *Client) HeadObject(ctx context.Context, bucket, key string) (*ObjectInfo, error) {
+	resp, err := c.do(ctx, nethttp.MethodHead, bucket, key, nil, nil, -1, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting metadata of object %q in bucket %q: %w", key, bucket, err)
+	}
+	_ = resp.Body.Close()
+	return &ObjectInfo{
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+		VersionID:   resp.Header.Get("X-Amz-Version-Id"),
+	}, nil
+}
+
+// PutObject uploads the object with the given key and returns its version, if the bucket is versioned.
+// Objects up to the part size are uploaded with a single request. Larger objects are uploaded with a
+// multipart upload, so that objects larger than the 5 GiB limit of single uploads are supported.
+// If the size is unknown (negative), at most one part is buffered in memory at a time, because S3
+// requires the content length of every request.
+func (c *Client) PutObject(ctx context.Context, bucket, key string, body io.Reader, size int64, contentType string) (versionID string, err error) {
+	partSize := c.partSize()
+	if size < 0 {
+		// read the first part to find out whether a single request is enough.
+		first, err := readPart(body, partSize)
+		if err != nil {
+			return "", fmt.Errorf("error reading content of object %q: %w", key, err)
+		}
+		if int64(len(first)) < partSize {
+			return c.putObject(ctx, bucket, key, bytes.NewReader(first), int64(len(first)), contentType)
+		}
+		return c.multipartUpload(ctx, bucket, key, contentType, bufferedParts(first, body, partSize))
+	}
+	if size <= partSize {
+		return c.putObject(ctx, bucket, key, body, size, contentType)
+	}
+	// keep within the maximum number of parts for very large objects.
+	if minimum := (size + maxParts - 1) / maxParts; partSize < minimum {
+		partSize = minimum
+	}
+	return c.multipartUpload(ctx, bucket, key, contentType, streamedParts(body, size, partSize))
+}
+
+func (c *Client) putObject(ctx context.Context, bucket, key string, body io.Reader, size int64, contentType string) (string, error) {
+	header := nethttp.Header{}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	resp, err := c.do(ctx, nethttp.MethodPut, bucket, key, nil, header, size, body)
+	if err != nil {
+		return "", fmt.Errorf("error putting object %q into bucket %q: %w", key, bucket, err)
+	}
+	_ = resp.Body.Close()
+	return resp.Header.Get("X-Amz-Version-Id"), nil
+}
+
+// DeleteObject deletes the object with the given key. Deleting a missing object is not an error.
+func (c *Client) DeleteObject(ctx context.Context, bucket, key string) error {
+	resp, err := c.do(ctx, nethttp.MethodDelete, bucket, key, nil, nil, -1, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting object %q from bucket %q: %w", key, bucket, err)
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+}
+
+// ListObjects returns the keys of all objects in the bucket starting with the given prefix.
+func (c *Client) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := c.do(ctx, nethttp.MethodGet, bucket, "", query, nil, -1, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error listing objects in bucket %q: %w", bucket, err)
+		}
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		err = errors.Join(err, resp.Body.Close())
+		if err != nil {
+			return nil, fmt.Errorf("error decoding object listing of bucket %q: %w", bucket, err)
+		}
+		for _, content := range result.Contents {
+			keys = append(keys, content.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// ObjectURL returns the URL of the object with the given key.
+func (c *Client) ObjectURL(bucket, key string) (*url.URL, error) {
+	if bucket == "" {
+		return nil, errors.New("bucket is required")
+	}
+	if c.Endpoint == "" {
+		return &url.URL{
+			Scheme: "https",
+			Host:   bucket + ".s3." + c.region() + ".amazonaws.com",
+			Path:   "/" + key,
+		}, nil
+	}
+	endpoint, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", c.Endpoint, err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("endpoint %q must use the http or https scheme", c.Endpoint)
+	}
+	return &url.URL{
+		Scheme: endpoint.Scheme,
+		Host:   endpoint.Host,
+		Path:   strings.TrimSuffix(endpoint.Path, "/") + "/" + bucket + "/" + key,
+	}, nil
+}
+
+func (c *Client) do(ctx context.Context, method, bucket, key string, query url.Values, header nethttp.Header, size int64, body io.Reader) (*nethttp.Response, error) {
+	u, err := c.ObjectURL(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	u.RawPath = escapePath(u.Path)
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := nethttp.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	payloadHash := emptyPayloadHash
+	if body != nil {
+		req.ContentLength = size
+		if size == 0 {
+			// an empty body would otherwise be sent with chunked encoding, which S3 rejects.
+			req.Body = nethttp.NoBody
+		}
+		// the content is not hashed to allow streaming uploads, which is permitted by SigV4.
+		payloadHash = unsignedPayload
+	}
+	if c.Credentials != nil {
+		now := time.Now
+		if c.now != nil {
+			now = c.now
+		}
+		sign(req, *c.Credentials, c.region(), payloadHash, now())
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = nethttp.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		return nil, responseError(resp)
+	}
+	return resp, nil
+}
+
+func (c *Client) partSize() int64 {
+	switch {
+	case c.PartSize == 0:
+		return DefaultPartSize
+	case c.PartSize < MinPartSize:
+		return MinPartSize
+	default:
+		return c.PartSize
+	}
+}
+
+func (c *Client) region() string {
+	if c.Region == "" {
+		return DefaultRegion
+	}
+	return c.Region
+}
+
+func responseError(resp *nethttp.Response) error {
+	e := &Error{StatusCode: resp.StatusCode}
+	// HEAD responses and some proxies do not carry an error document.
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err == nil && len(data) > 0 {
+		_ = xml.Unmarshal(data, e)
+	}
+	return e
+}
@@ -0,0 +1,229 @@
+package client_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/s3/client"
+	"ocm.software/open-component-model/bindings/go/s3/internal/fakes3"
+)
+
+func newClient(t *testing.T) (*fakes3.Server, *client.Client) {
+	server := fakes3.New(t)
+	server.AccessKeyID = "access"
+	return server, &client.Client{
+		HTTPClient:  server.Client(),
+		Endpoint:    server.URL,
+		Credentials: &client.Credentials{AccessKeyID: "access", SecretAccessKey: "secret"},
+	}
+}
+
+func Test_Client_ObjectLifecycle(t *testing.T) {
+	r := require.New(t)
+	ctx := t.Context()
+	server, c := newClient(t)
+
+	v1, err := c.PutObject(ctx, "bucket", "dir/file name.txt", strings.NewReader("first"), 5, "text/plain")
+	r.NoError(err)
+	// unknown size fitting into a single part
+	v2, err := c.PutObject(ctx, "bucket", "dir/file name.txt", strings.NewReader("second"), -1, "text/plain")
+	r.NoError(err)
+	r.NotEqual(v1, v2)
+
+	data, ok := server.Object("bucket", "dir/file name.txt")
+	r.True(ok)
+	r.Equal("second", string(data))
+
+	obj, err := c.GetObject(ctx, "bucket", "dir/file name.txt", v1)
+	r.NoError(err)
+	content, err := io.ReadAll(obj.Body)
+	r.NoError(err)
+	r.NoError(obj.Body.Close())
+	r.Equal("first", string(content))
+	r.Equal("text/plain", obj.ContentType)
+	r.Equal(v1, obj.VersionID)
+
+	info, err := c.HeadObject(ctx, "bucket", "dir/file name.txt")
+	r.NoError(err)
+	r.Equal(int64(6), info.Size)
+
+	r.NoError(c.DeleteObject(ctx, "bucket", "dir/file name.txt"))
+	_, err = c.GetObject(ctx, "bucket", "dir/file name.txt", "")
+	r.ErrorIs(err, fs.ErrNotExist)
+	r.ErrorContains(err, "NoSuchKey")
+	_, err = c.HeadObject(ctx, "bucket", "dir/file name.txt")
+	r.ErrorIs(err, fs.ErrNotExist)
+}
+
+func Test_Client_MultipartUpload(t *testing.T) {
+	data := make([]byte, 2*client.MinPartSize+1024)
+	_, _ = rand.Read(data)
+
+	for name, size := range map[string]int64{"known size": int64(len(data)), "unknown size": -1} {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			server, c := newClient(t)
+			c.PartSize = client.MinPartSize
+
+			// hide the size of the reader to make sure the content is streamed or read part by part.
+			body := io.MultiReader(bytes.NewReader(data))
+			versionID, err := c.PutObject(t.Context(), "bucket", "large", body, size, "application/octet-stream")
+			r.NoError(err)
+			r.NotEmpty(versionID)
+			r.Zero(server.Uploads())
+
+			stored, ok := server.Object("bucket", "large")
+			r.True(ok)
+			r.Equal(data, stored)
+
+			obj, err := c.GetObject(t.Context(), "bucket", "large", versionID)
+			r.NoError(err)
+			r.NoError(obj.Body.Close())
+			r.Equal("application/octet-stream", obj.ContentType)
+		})
+	}
+}
+
+func Test_Client_MultipartUpload_ExactPartSize(t *testing.T) {
+	r := require.New(t)
+	server, c := newClient(t)
+	c.PartSize = client.MinPartSize
+
+	data := bytes.Repeat([]byte("a"), 2*client.MinPartSize)
+	_, err := c.PutObject(t.Context(), "bucket", "exact", bytes.NewReader(data), -1, "")
+	r.NoError(err)
+
+	stored, ok := server.Object("bucket", "exact")
+	r.True(ok)
+	r.Equal(data, stored)
+}
+
+func Test_Client_MultipartUpload_Abort(t *testing.T) {
+	r := require.New(t)
+	server, c := newClient(t)
+	c.PartSize = client.MinPartSize
+	server.FailPart = 2
+
+	data := make([]byte, 2*client.MinPartSize)
+	_, err := c.PutObject(t.Context(), "bucket", "failed", bytes.NewReader(data), int64(len(data)), "")
+	r.ErrorContains(err, "error uploading part 2")
+	r.ErrorContains(err, "InternalError")
+	r.Zero(server.Uploads())
+	_, ok := server.Object("bucket", "failed")
+	r.False(ok)
+}
+
+func Test_Client_EmptyObject(t *testing.T) {
+	r := require.New(t)
+	_, c := newClient(t)
+
+	_, err := c.PutObject(t.Context(), "bucket", "empty", bytes.NewReader(nil), 0, "")
+	r.NoError(err)
+	info, err := c.HeadObject(t.Context(), "bucket", "empty")
+	r.NoError(err)
+	r.Equal(int64(0), info.Size)
+}
+
+func Test_Client_ListObjects(t *testing.T) {
+	r := require.New(t)
+	server, c := newClient(t)
+	server.MaxKeys = 2
+
+	for _, key := range []string{"ctf/blobs/a", "ctf/blobs/b", "ctf/blobs/c", "ctf/index", "other"} {
+		server.PutObject("bucket", key, []byte(key), "")
+	}
+
+	keys, err := c.ListObjects(t.Context(), "bucket", "ctf/blobs/")
+	r.NoError(err)
+	r.Equal([]string{"ctf/blobs/a", "ctf/blobs/b", "ctf/blobs/c"}, keys)
+
+	_, err = c.ListObjects(t.Context(), "missing", "")
+	r.ErrorIs(err, fs.ErrNotExist)
+}
+
+func Test_Client_AccessDenied(t *testing.T) {
+	r := require.New(t)
+	_, c := newClient(t)
+	c.Credentials = nil
+
+	_, err := c.GetObject(t.Context(), "bucket", "key", "")
+	r.ErrorContains(err, "AccessDenied")
+	r.NotErrorIs(err, fs.ErrNotExist)
+}
+
+func Test_Client_ObjectURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   client.Client
+		expected string
+		err      bool
+	}{
+		{
+			name:     "aws default region",
+			client:   client.Client{},
+			expected: "https://bucket.s3.us-east-1.amazonaws.com/a/b",
+		},
+		{
+			name:     "aws region",
+			client:   client.Client{Region: "eu-central-1"},
+			expected: "https://bucket.s3.eu-central-1.amazonaws.com/a/b",
+		},
+		{
+			name:     "custom endpoint is path-style",
+			client:   client.Client{Endpoint: "http://localhost:9000"},
+			expected: "http://localhost:9000/bucket/a/b",
+		},
+		{
+			name:     "custom endpoint with path",
+			client:   client.Client{Endpoint: "https://storage.example.com/s3/"},
+			expected: "https://storage.example.com/s3/bucket/a/b",
+		},
+		{
+			name:   "invalid endpoint scheme",
+			client: client.Client{Endpoint: "ftp://localhost"},
+			err:    true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := tc.client.ObjectURL("bucket", "a/b")
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, u.String())
+		})
+	}
+}
+
+func Test_Bucket(t *testing.T) {
+	r := require.New(t)
+	ctx := t.Context()
+	_, c := newClient(t)
+	bucket := client.NewBucket(c, "transfer")
+
+	r.NoError(bucket.PutObject(ctx, "ctf/artifact-index.json", strings.NewReader("{}"), 2))
+	size, err := bucket.StatObject(ctx, "ctf/artifact-index.json")
+	r.NoError(err)
+	r.Equal(int64(2), size)
+
+	data, size, err := bucket.GetObject(ctx, "ctf/artifact-index.json")
+	r.NoError(err)
+	r.Equal(int64(2), size)
+	r.NoError(data.Close())
+
+	keys, err := bucket.ListObjects(ctx, "ctf/")
+	r.NoError(err)
+	r.Equal([]string{"ctf/artifact-index.json"}, keys)
+
+	r.NoError(bucket.DeleteObject(ctx, "ctf/artifact-index.json"))
+	_, err = bucket.StatObject(ctx, "ctf/artifact-index.json")
+	r.ErrorIs(err, fs.ErrNotExist)
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"strconv"
+)
+
+// part is the content of a single part of a multipart upload.
+type part struct {
+	body io.Reader
+	size int64
+}
+
+// parts returns the next part of an upload. It returns io.EOF once all parts have been returned.
+type parts func() (part, error)
+
+// streamedParts splits content of a known size into parts that are streamed from body without buffering.
+func streamedParts(body io.Reader, size, partSize int64) parts {
+	remaining := size
+	return func() (part, error) {
+		if remaining == 0 {
+			return part{}, io.EOF
+		}
+		n := min(partSize, remaining)
+		remaining -= n
+		return part{body: io.LimitReader(body, n), size: n}, nil
+	}
+}
+
+// bufferedParts splits content of an unknown size into parts by buffering one part at a time.
+// first is the already read first part.
+func bufferedParts(first []byte, body io.Reader, partSize int64) parts {
+	next := first
+	done := false
+	return func() (part, error) {
+		if next == nil {
+			if done {
+				return part{}, io.EOF
+			}
+			data, err := readPart(body, partSize)
+			if err != nil {
+				return part{}, err
+			}
+			if len(data) == 0 {
+				return part{}, io.EOF
+			}
+			next = data
+		}
+		data := next
+		next = nil
+		// a short part can only be the last one.
+		done = done || int64(len(data)) < partSize
+		return part{body: bytes.NewReader(data), size: int64(len(data))}, nil
+	}
+}
+
+// readPart reads up to partSize bytes. It only returns less if body is exhausted.
+func readPart(body io.Reader, partSize int64) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, body, partSize); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type initiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
+
+// multipartUpload uploads the parts of an object with a multipart upload and returns the version of the object.
+// The upload is aborted if any of the parts fails, so that no incomplete parts are left in the bucket.
+func (c *Client) multipartUpload(ctx context.Context, bucket, key, contentType string, next parts) (_ string, err error) {
+	uploadID, err := c.createMultipartUpload(ctx, bucket, key, contentType)
+	if err != nil {
+		return "", fmt.Errorf("error starting multipart upload of object %q into bucket %q: %w", key, bucket, err)
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, c.abortMultipartUpload(context.WithoutCancel(ctx), bucket, key, uploadID))
+		}
+	}()
+
+	var completed []completedPart
+	for number := 1; ; number++ {
+		p, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("error reading part %d of object %q: %w", number, key, err)
+		}
+		if number > maxParts {
+			return "", fmt.Errorf("object %q exceeds the maximum of %d parts of a multipart upload", key, maxParts)
+		}
+		etag, err := c.uploadPart(ctx, bucket, key, uploadID, number, p)
+		if err != nil {
+			return "", fmt.Errorf("error uploading part %d of object %q into bucket %q: %w", number, key, bucket, err)
+		}
+		completed = append(completed, completedPart{PartNumber: number, ETag: etag})
+	}
+
+	versionID, err := c.completeMultipartUpload(ctx, bucket, key, uploadID, completed)
+	if err != nil {
+		return "", fmt.Errorf("error completing multipart upload of object %q into bucket %q: %w", key, bucket, err)
+	}
+	return versionID, nil
+}
+
+func (c *Client) createMultipartUpload(ctx context.Context, bucket, key, contentType string) (string, error) {
+	header := nethttp.Header{}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	resp, err := c.do(ctx, nethttp.MethodPost, bucket, key, url.Values{"uploads": {""}}, header, -1, nil)
+	if err != nil {
+		return "", err
+	}
+	var result initiateMultipartUploadResult
+	err = xml.NewDecoder(resp.Body).Decode(&result)
+	if err = errors.Join(err, resp.Body.Close()); err != nil {
+		return "", fmt.Errorf("error decoding multipart upload: %w", err)
+	}
+	if result.UploadID == "" {
+		return "", errors.New("server returned no upload id")
+	}
+	return result.UploadID, nil
+}
+
+func (c *Client) uploadPart(ctx context.Context, bucket, key, uploadID string, number int, p part) (string, error) {
+	query := url.Values{"partNumber": {strconv.Itoa(number)}, "uploadId": {uploadID}}
+	resp, err := c.do(ctx, nethttp.MethodPut, bucket, key, query, nil, p.size, p.body)
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+	return resp.Header.Get("ETag"), nil
+}
+
+func (c *Client) completeMultipartUpload(ctx context.Context, bucket, key, uploadID string, completed []completedPart) (string, error) {
+	body, err := xml.Marshal(completeMultipartUpload{Parts: completed})
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.do(ctx, nethttp.MethodPost, bucket, key, url.Values{"uploadId": {uploadID}}, nil, int64(len(body)), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	// S3 reports some failures of the completion with status 200 and an error document.
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	var e Error
+	if xml.Unmarshal(data, &e) == nil && e.Code != "" {
+		e.StatusCode = resp.StatusCode
+		return "", &e
+	}
+	return resp.Header.Get("X-Amz-Version-Id"), nil
+}
+
+func (c *Client) abortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	resp, err := c.do(ctx, nethttp.MethodDelete, bucket, key, url.Values{"uploadId": {uploadID}}, nil, -1, nil)
+	if err != nil {
+		return fmt.Errorf("error aborting multipart upload of object %q: %w", key, err)
+	}
+	_ = resp.Body.Close()
+	return nil
+}
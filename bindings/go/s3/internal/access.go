@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"fmt"
+	nethttp "net/http"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/s3/client"
+	v1 "ocm.software/open-component-model/bindings/go/s3/spec/access/v1"
+	credsv1 "ocm.software/open-component-model/bindings/go/s3/spec/credentials/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/s3/spec/identity/v1"
+)
+
+// Validate checks that the access references an object.
+func Validate(access *v1.S3) error {
+	if access.Bucket == "" {
+		return fmt.Errorf("bucket is required for s3 access")
+	}
+	if access.Key == "" {
+		return fmt.Errorf("key is required for s3 access")
+	}
+	return nil
+}
+
+// CredentialConsumerIdentity resolves the credential consumer identity of the object of the access.
+// The identity contains the bucket, key, region and endpoint. Empty attributes are omitted.
+func CredentialConsumerIdentity(access *v1.S3) (runtime.Identity, error) {
+	if err := Validate(access); err != nil {
+		return nil, err
+	}
+	identity := runtime.Identity{
+		identityv1.IdentityAttributeBucket: access.Bucket,
+		identityv1.IdentityAttributeKey:    access.Key,
+	}
+	if access.Region != "" {
+		identity[identityv1.IdentityAttributeRegion] = access.Region
+	}
+	if access.Endpoint != "" {
+		identity[identityv1.IdentityAttributeEndpoint] = access.Endpoint
+	}
+	identity.SetType(identityv1.Type)
+	return identity, nil
+}
+
+// NewClient creates a client for the server of the access, signing requests with the given credentials.
+func NewClient(httpClient *nethttp.Client, access *v1.S3, credentials runtime.Typed) (*client.Client, error) {
+	creds, err := credsv1.ConvertToS3Credentials(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("error converting credentials: %w", err)
+	}
+	c := &client.Client{
+		HTTPClient: httpClient,
+		Endpoint:   access.Endpoint,
+		Region:     access.Region,
+	}
+	if creds != nil && creds.AccessKeyID != "" {
+		c.Credentials = &client.Credentials{
+			AccessKeyID:     creds.AccessKeyID,
+			SecretAccessKey: creds.SecretAccessKey,
+			SessionToken:    creds.SessionToken,
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,315 @@
+// Package fakes3 provides an in-process fake of an S3-compatible server for tests.
+//
+// The fake supports path-style requests to get, head, put and delete objects and to list objects
+// (ListObjectsV2), and multipart uploads (initiate, upload part, complete and abort).
+// Buckets are created on the first upload and are always versioned.
+// Signatures are not verified, but if an access key is configured, requests must be signed with it.
+package fakes3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// minPartSize is the minimum size of all but the last part of a multipart upload.
+const minPartSize = 5 << 20
+
+type version struct {
+	id          string
+	data        []byte
+	contentType string
+}
+
+// Server is a fake S3 server.
+type Server struct {
+	*httptest.Server
+
+	// AccessKeyID is the access key requests must be signed with. If empty, anonymous requests are accepted.
+	AccessKeyID string
+	// MaxKeys limits the number of keys per listing page.
+	MaxKeys int
+
+	// FailPart makes uploads of the part with this number fail, if set.
+	FailPart int
+
+	mu      sync.Mutex
+	buckets map[string]map[string][]version
+	uploads map[string]*upload
+	counter int
+}
+
+type upload struct {
+	bucket, key string
+	contentType string
+	parts       map[int][]byte
+}
+
+// New starts a fake S3 server. It is closed with the cleanup of the test.
+func New(t interface{ Cleanup(func()) }) *Server {
+	s := &Server{buckets: map[string]map[string][]version{}, uploads: map[string]*upload{}, MaxKeys: 1000}
+	s.Server = httptest.NewServer(nethttp.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Object returns the latest content of the object with the given key.
+func (s *Server) Object(bucket, key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	versions := s.buckets[bucket][key]
+	if len(versions) == 0 {
+		return nil, false
+	}
+	return versions[len(versions)-1].data, true
+}
+
+// Uploads returns the number of multipart uploads that were neither completed nor aborted.
+func (s *Server) Uploads() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.uploads)
+}
+
+// PutObject stores the content under the given key and returns the new version ID.
+func (s *Server) PutObject(bucket, key string, data []byte, contentType string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.buckets[bucket] == nil {
+		s.buckets[bucket] = map[string][]version{}
+	}
+	s.counter++
+	id := strconv.Itoa(s.counter)
+	s.buckets[bucket][key] = append(s.buckets[bucket][key], version{id: id, data: data, contentType: contentType})
+	return id
+}
+
+func (s *Server) serve(w nethttp.ResponseWriter, r *nethttp.Request) {
+	if s.AccessKeyID != "" && !strings.Contains(r.Header.Get("Authorization"), "Credential="+s.AccessKeyID+"/") {
+		writeError(w, nethttp.StatusForbidden, "AccessDenied", "Access Denied")
+		return
+	}
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket == "" {
+		writeError(w, nethttp.StatusBadRequest, "InvalidRequest", "bucket is required")
+		return
+	}
+
+	switch {
+	case key == "" && r.Method == nethttp.MethodGet && r.URL.Query().Get("list-type") == "2":
+		s.list(w, r, bucket)
+	case key == "":
+		writeError(w, nethttp.StatusNotImplemented, "NotImplemented", "bucket operations are not supported")
+	case r.Method == nethttp.MethodPost && r.URL.Query().Has("uploads"):
+		s.initiateUpload(w, r, bucket, key)
+	case r.Method == nethttp.MethodPut && r.URL.Query().Has("uploadId"):
+		s.uploadPart(w, r)
+	case r.Method == nethttp.MethodPost && r.URL.Query().Has("uploadId"):
+		s.completeUpload(w, r)
+	case r.Method == nethttp.MethodDelete && r.URL.Query().Has("uploadId"):
+		s.mu.Lock()
+		delete(s.uploads, r.URL.Query().Get("uploadId"))
+		s.mu.Unlock()
+		w.WriteHeader(nethttp.StatusNoContent)
+	case r.Method == nethttp.MethodGet || r.Method == nethttp.MethodHead:
+		s.get(w, r, bucket, key)
+	case r.Method == nethttp.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, nethttp.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		if r.ContentLength >= 0 && int64(len(data)) != r.ContentLength {
+			writeError(w, nethttp.StatusBadRequest, "IncompleteBody", "content length mismatch")
+			return
+		}
+		w.Header().Set("X-Amz-Version-Id", s.PutObject(bucket, key, data, r.Header.Get("Content-Type")))
+		w.WriteHeader(nethttp.StatusOK)
+	case r.Method == nethttp.MethodDelete:
+		s.mu.Lock()
+		delete(s.buckets[bucket], key)
+		s.mu.Unlock()
+		w.WriteHeader(nethttp.StatusNoContent)
+	default:
+		writeError(w, nethttp.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+	}
+}
+
+func (s *Server) get(w nethttp.ResponseWriter, r *nethttp.Request, bucket, key string) {
+	s.mu.Lock()
+	versions := s.buckets[bucket][key]
+	s.mu.Unlock()
+
+	if len(versions) == 0 {
+		writeError(w, nethttp.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+		return
+	}
+	v := versions[len(versions)-1]
+	if id := r.URL.Query().Get("versionId"); id != "" {
+		found := false
+		for _, candidate := range versions {
+			if candidate.id == id {
+				v, found = candidate, true
+			}
+		}
+		if !found {
+			writeError(w, nethttp.StatusNotFound, "NoSuchVersion", "The specified version does not exist.")
+			return
+		}
+	}
+
+	if v.contentType != "" {
+		w.Header().Set("Content-Type", v.contentType)
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(v.data)))
+	w.Header().Set("X-Amz-Version-Id", v.id)
+	w.WriteHeader(nethttp.StatusOK)
+	if r.Method == nethttp.MethodGet {
+		_, _ = w.Write(v.data)
+	}
+}
+
+func (s *Server) initiateUpload(w nethttp.ResponseWriter, r *nethttp.Request, bucket, key string) {
+	s.mu.Lock()
+	s.counter++
+	id := "upload-" + strconv.Itoa(s.counter)
+	s.uploads[id] = &upload{bucket: bucket, key: key, contentType: r.Header.Get("Content-Type"), parts: map[int][]byte{}}
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = fmt.Fprintf(w, "<InitiateMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>", bucket, key, id)
+}
+
+func (s *Server) uploadPart(w nethttp.ResponseWriter, r *nethttp.Request) {
+	number, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || number < 1 {
+		writeError(w, nethttp.StatusBadRequest, "InvalidArgument", "invalid part number")
+		return
+	}
+	if number == s.FailPart {
+		writeError(w, nethttp.StatusInternalServerError, "InternalError", "part upload failed")
+		return
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil || int64(len(data)) != r.ContentLength {
+		writeError(w, nethttp.StatusBadRequest, "IncompleteBody", "content length mismatch")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.uploads[r.URL.Query().Get("uploadId")]
+	if !ok {
+		writeError(w, nethttp.StatusNotFound, "NoSuchUpload", "The specified upload does not exist.")
+		return
+	}
+	u.parts[number] = data
+	w.Header().Set("ETag", fmt.Sprintf("%q", "etag-"+strconv.Itoa(number)))
+	w.WriteHeader(nethttp.StatusOK)
+}
+
+type completeMultipartUpload struct {
+	Parts []struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	} `xml:"Part"`
+}
+
+func (s *Server) completeUpload(w nethttp.ResponseWriter, r *nethttp.Request) {
+	var complete completeMultipartUpload
+	if err := xml.NewDecoder(r.Body).Decode(&complete); err != nil {
+		writeError(w, nethttp.StatusBadRequest, "MalformedXML", err.Error())
+		return
+	}
+
+	id := r.URL.Query().Get("uploadId")
+	s.mu.Lock()
+	u, ok := s.uploads[id]
+	delete(s.uploads, id)
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, nethttp.StatusNotFound, "NoSuchUpload", "The specified upload does not exist.")
+		return
+	}
+
+	var data []byte
+	for i, part := range complete.Parts {
+		content, ok := u.parts[part.PartNumber]
+		if !ok || part.PartNumber != i+1 || part.ETag != fmt.Sprintf("%q", "etag-"+strconv.Itoa(part.PartNumber)) {
+			writeError(w, nethttp.StatusBadRequest, "InvalidPart", "One or more of the specified parts could not be found.")
+			return
+		}
+		if i < len(complete.Parts)-1 && len(content) < minPartSize {
+			writeError(w, nethttp.StatusBadRequest, "EntityTooSmall", "Your proposed upload is smaller than the minimum allowed object size.")
+			return
+		}
+		data = append(data, content...)
+	}
+
+	w.Header().Set("X-Amz-Version-Id", s.PutObject(u.bucket, u.key, data, u.contentType))
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = fmt.Fprintf(w, "<CompleteMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key></CompleteMultipartUploadResult>", u.bucket, u.key)
+}
+
+type listBucketResult struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	Name                  string   `xml:"Name"`
+	Prefix                string   `xml:"Prefix"`
+	IsTruncated           bool     `xml:"IsTruncated"`
+	NextContinuationToken string   `xml:"NextContinuationToken,omitempty"`
+	Contents              []struct {
+		Key  string `xml:"Key"`
+		Size int    `xml:"Size"`
+	} `xml:"Contents"`
+}
+
+func (s *Server) list(w nethttp.ResponseWriter, r *nethttp.Request, bucket string) {
+	prefix := r.URL.Query().Get("prefix")
+	start := r.URL.Query().Get("continuation-token")
+
+	s.mu.Lock()
+	objects, ok := s.buckets[bucket]
+	var keys []string
+	sizes := map[string]int{}
+	for key, versions := range objects {
+		if strings.HasPrefix(key, prefix) && key > start {
+			keys = append(keys, key)
+			sizes[key] = len(versions[len(versions)-1].data)
+		}
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, nethttp.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist.")
+		return
+	}
+	sort.Strings(keys)
+
+	result := listBucketResult{Name: bucket, Prefix: prefix}
+	if len(keys) > s.MaxKeys {
+		keys = keys[:s.MaxKeys]
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[len(keys)-1]
+	}
+	for _, key := range keys {
+		result.Contents = append(result.Contents, struct {
+			Key  string `xml:"Key"`
+			Size int    `xml:"Size"`
+		}{Key: key, Size: sizes[key]})
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func writeError(w nethttp.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, message)
+}
@@ -0,0 +1,22 @@
+// Package resource implements [repository.ResourceRepository] for objects in S3-compatible object storages,
+// such as AWS S3 or a MinIO serving as transfer medium into air-gapped environments.
+//
+// The [ResourceRepository] handles the S3/v1 access type. Objects are downloaded from and uploaded to
+// the bucket and key of the access. Custom endpoints are addressed path-style, AWS S3 is used if no
+// endpoint is set.
+//
+// # Credentials
+//
+// The consumer identity of type S3 carries the bucket, region and endpoint of the object, and its key
+// as path attribute, so that credentials can be configured per bucket or per key pattern.
+// Credentials of type S3Credentials/v1 or DirectCredentials with accessKeyID, secretAccessKey and
+// sessionToken are supported. Without credentials, requests are sent anonymously.
+//
+// # Usage
+//
+//	repo := resource.NewResourceRepository(resource.WithHTTPConfig(httpConfig))
+//
+//	identity, err := repo.GetResourceCredentialConsumerIdentity(ctx, res)
+//	content, err := repo.DownloadResource(ctx, res, creds)
+//	uploaded, err := repo.UploadResource(ctx, target, content, creds)
+package resource
@@ -0,0 +1,187 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	nethttp "net/http"
+
+	"github.com/opencontainers/go-digest"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	"ocm.software/open-component-model/bindings/go/blob/inmemory"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	ocmhttp "ocm.software/open-component-model/bindings/go/http"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/repository"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/s3/internal"
+	s3access "ocm.software/open-component-model/bindings/go/s3/spec/access"
+	v1 "ocm.software/open-component-model/bindings/go/s3/spec/access/v1"
+)
+
+// DefaultUserAgent is used for requests if no user agent is configured.
+const DefaultUserAgent = "ocm.software/open-component-model/bindings/go/s3"
+
+// DefaultMediaType is used if neither the access nor the stored object specify a media type.
+const DefaultMediaType = "application/octet-stream"
+
+// ResourceRepository implements a resource repository for objects in S3-compatible object storages.
+// Requests use the retrying client of the http bindings, so per-host timeouts and retry
+// policies of the HTTP configuration apply.
+type ResourceRepository struct {
+	httpConfig *httpv1alpha1.Config
+	userAgent  string
+}
+
+// Option configures a ResourceRepository.
+type Option func(*ResourceRepository)
+
+// WithHTTPConfig sets the HTTP client configuration used for requests.
+func WithHTTPConfig(cfg *httpv1alpha1.Config) Option {
+	return func(r *ResourceRepository) {
+		r.httpConfig = cfg
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with all requests.
+func WithUserAgent(userAgent string) Option {
+	return func(r *ResourceRepository) {
+		r.userAgent = userAgent
+	}
+}
+
+var _ repository.ResourceRepository = (*ResourceRepository)(nil)
+
+// NewResourceRepository creates a ResourceRepository.
+func NewResourceRepository(opts ...Option) *ResourceRepository {
+	r := &ResourceRepository{userAgent: DefaultUserAgent}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// GetResourceRepositoryScheme returns the s3 access scheme containing the
+// S3/v1 type and its aliases.
+func (r *ResourceRepository) GetResourceRepositoryScheme() *runtime.Scheme {
+	return s3access.Scheme
+}
+
+// GetResourceCredentialConsumerIdentity resolves the S3 credential consumer identity
+// from the bucket, key, region and endpoint of the access of the resource.
+func (r *ResourceRepository) GetResourceCredentialConsumerIdentity(_ context.Context, resource *descriptor.Resource) (runtime.Identity, error) {
+	access, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	return internal.CredentialConsumerIdentity(access)
+}
+
+// DownloadResource fetches the object referenced by the s3 access of the resource.
+func (r *ResourceRepository) DownloadResource(ctx context.Context, resource *descriptor.Resource, credentials runtime.Typed) (_ blob.ReadOnlyBlob, err error) {
+	access, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	c, err := internal.NewClient(r.httpClient(), access, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := c.GetObject(ctx, access.Bucket, access.Key, access.Version)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, obj.Body.Close())
+	}()
+
+	digester := digest.Canonical.Digester()
+	data, err := io.ReadAll(io.TeeReader(obj.Body, digester.Hash()))
+	if err != nil {
+		return nil, fmt.Errorf("error reading object %s: %w", access, err)
+	}
+
+	return inmemory.New(bytes.NewReader(data),
+		inmemory.WithMediaType(mediaType(access.MediaType, obj.ContentType)),
+		inmemory.WithSize(int64(len(data))),
+		inmemory.WithDigest(digester.Digest().String()),
+	), nil
+}
+
+// UploadResource stores the content as object at the bucket and key of the s3 access of the resource.
+// The returned resource carries the access with the version of the uploaded object, if the bucket is
+// versioned, and the media type of the content.
+func (r *ResourceRepository) UploadResource(ctx context.Context, resource *descriptor.Resource, content blob.ReadOnlyBlob, credentials runtime.Typed) (_ *descriptor.Resource, err error) {
+	access, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	if access.MediaType == "" {
+		if mediaTypeAware, ok := content.(blob.MediaTypeAware); ok {
+			access.MediaType, _ = mediaTypeAware.MediaType()
+		}
+	}
+	c, err := internal.NewClient(r.httpClient(), access, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	size := blob.SizeUnknown
+	if sizeAware, ok := content.(blob.SizeAware); ok {
+		size = sizeAware.Size()
+	}
+	data, err := content.ReadCloser()
+	if err != nil {
+		return nil, fmt.Errorf("error reading content for object %s: %w", access, err)
+	}
+	defer func() {
+		err = errors.Join(err, data.Close())
+	}()
+
+	if access.Version, err = c.PutObject(ctx, access.Bucket, access.Key, data, size, access.MediaType); err != nil {
+		return nil, err
+	}
+
+	resource = resource.DeepCopy()
+	access.Type = runtime.NewVersionedType(v1.Type, v1.Version)
+	resource.Access = access
+	return resource, nil
+}
+
+func (r *ResourceRepository) httpClient() *nethttp.Client {
+	return ocmhttp.New(
+		ocmhttp.WithConfig(r.httpConfig),
+		ocmhttp.WithUserAgent(r.userAgent),
+	)
+}
+
+func convertAccess(resource *descriptor.Resource) (*v1.S3, error) {
+	if resource == nil || resource.Access == nil {
+		return nil, fmt.Errorf("resource access is required")
+	}
+	var access v1.S3
+	if err := s3access.Scheme.Convert(resource.Access, &access); err != nil {
+		return nil, fmt.Errorf("error converting access to s3 spec: %w", err)
+	}
+	if err := internal.Validate(&access); err != nil {
+		return nil, err
+	}
+	return &access, nil
+}
+
+func mediaType(accessMediaType, contentType string) string {
+	if accessMediaType != "" {
+		return accessMediaType
+	}
+	if contentType != "" {
+		if mt, _, err := mime.ParseMediaType(contentType); err == nil {
+			return mt
+		}
+	}
+	return DefaultMediaType
+}
@@ -0,0 +1,172 @@
+package resource_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	"ocm.software/open-component-model/bindings/go/blob/inmemory"
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/s3/internal/fakes3"
+	"ocm.software/open-component-model/bindings/go/s3/repository/resource"
+	v1 "ocm.software/open-component-model/bindings/go/s3/spec/access/v1"
+	credsv1 "ocm.software/open-component-model/bindings/go/s3/spec/credentials/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/s3/spec/identity/v1"
+)
+
+const content = "vendor binary"
+
+func newResource(access *v1.S3) *descriptor.Resource {
+	access.Type = runtime.NewVersionedType(v1.Type, v1.Version)
+	return &descriptor.Resource{
+		ElementMeta: descriptor.ElementMeta{
+			ObjectMeta: descriptor.ObjectMeta{Name: "tool", Version: "1.0.0"},
+		},
+		Type:     "executable",
+		Relation: descriptor.ExternalRelation,
+		Access:   access,
+	}
+}
+
+func credentials() runtime.Typed {
+	return &credsv1.S3Credentials{
+		Type:            runtime.NewVersionedType(credsv1.S3CredentialsType, credsv1.Version),
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+	}
+}
+
+func readAll(t *testing.T, b blob.ReadOnlyBlob) []byte {
+	t.Helper()
+	rc, err := b.ReadCloser()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, rc.Close())
+	}()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return data
+}
+
+func TestResourceRepository_GetResourceCredentialConsumerIdentity(t *testing.T) {
+	repo := resource.NewResourceRepository()
+
+	identity, err := repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource(&v1.S3{
+		Bucket:   "bucket",
+		Key:      "releases/tool",
+		Region:   "eu-central-1",
+		Endpoint: "http://localhost:9000",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, runtime.Identity{
+		runtime.IdentityAttributeType:        identityv1.Type.String(),
+		identityv1.IdentityAttributeBucket:   "bucket",
+		identityv1.IdentityAttributeKey:      "releases/tool",
+		identityv1.IdentityAttributeRegion:   "eu-central-1",
+		identityv1.IdentityAttributeEndpoint: "http://localhost:9000",
+	}, identity)
+
+	// credentials configured for a key pattern of the bucket match
+	assert.True(t, identity.Match(runtime.Identity{
+		runtime.IdentityAttributeType:        identityv1.Type.String(),
+		identityv1.IdentityAttributeBucket:   "bucket",
+		identityv1.IdentityAttributeKey:      "releases/*",
+		identityv1.IdentityAttributeRegion:   "eu-central-1",
+		identityv1.IdentityAttributeEndpoint: "http://localhost:9000",
+	}))
+
+	_, err = repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource(&v1.S3{Bucket: "bucket"}))
+	assert.ErrorContains(t, err, "key is required")
+}
+
+func TestResourceRepository_DownloadResource(t *testing.T) {
+	server := fakes3.New(t)
+	server.AccessKeyID = "access"
+	first := server.PutObject("bucket", "releases/tool", []byte("old"), "")
+	server.PutObject("bucket", "releases/tool", []byte(content), "application/x-executable")
+	repo := resource.NewResourceRepository()
+
+	t.Run("latest version", func(t *testing.T) {
+		b, err := repo.DownloadResource(t.Context(), newResource(&v1.S3{
+			Bucket: "bucket", Key: "releases/tool", Endpoint: server.URL,
+		}), credentials())
+		require.NoError(t, err)
+		assert.Equal(t, content, string(readAll(t, b)))
+
+		mediaType, known := b.(blob.MediaTypeAware).MediaType()
+		assert.True(t, known)
+		assert.Equal(t, "application/x-executable", mediaType)
+		dig, known := b.(blob.DigestAware).Digest()
+		assert.True(t, known)
+		assert.Equal(t, digest.FromString(content).String(), dig)
+	})
+
+	t.Run("pinned version with media type", func(t *testing.T) {
+		b, err := repo.DownloadResource(t.Context(), newResource(&v1.S3{
+			Bucket: "bucket", Key: "releases/tool", Endpoint: server.URL, Version: first, MediaType: "text/plain",
+		}), credentials())
+		require.NoError(t, err)
+		assert.Equal(t, "old", string(readAll(t, b)))
+		mediaType, _ := b.(blob.MediaTypeAware).MediaType()
+		assert.Equal(t, "text/plain", mediaType)
+	})
+
+	t.Run("direct credentials", func(t *testing.T) {
+		_, err := repo.DownloadResource(t.Context(), newResource(&v1.S3{
+			Bucket: "bucket", Key: "releases/tool", Endpoint: server.URL,
+		}), &credv1.DirectCredentials{
+			Type:       runtime.NewVersionedType(credv1.CredentialsType, credv1.Version),
+			Properties: map[string]string{"accessKeyID": "access", "secretAccessKey": "secret"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("missing credentials", func(t *testing.T) {
+		_, err := repo.DownloadResource(t.Context(), newResource(&v1.S3{
+			Bucket: "bucket", Key: "releases/tool", Endpoint: server.URL,
+		}), nil)
+		assert.ErrorContains(t, err, "AccessDenied")
+	})
+
+	t.Run("missing object", func(t *testing.T) {
+		_, err := repo.DownloadResource(t.Context(), newResource(&v1.S3{
+			Bucket: "bucket", Key: "releases/missing", Endpoint: server.URL,
+		}), credentials())
+		assert.ErrorContains(t, err, "NoSuchKey")
+	})
+}
+
+func TestResourceRepository_UploadResource(t *testing.T) {
+	server := fakes3.New(t)
+	repo := resource.NewResourceRepository()
+
+	target := newResource(&v1.S3{Bucket: "transfer", Key: "tool", Endpoint: server.URL})
+	uploaded, err := repo.UploadResource(t.Context(), target, inmemory.New(bytes.NewReader([]byte(content)),
+		inmemory.WithMediaType("application/x-executable"),
+	), nil)
+	require.NoError(t, err)
+
+	access, ok := uploaded.Access.(*v1.S3)
+	require.True(t, ok)
+	assert.Equal(t, "transfer", access.Bucket)
+	assert.Equal(t, "tool", access.Key)
+	assert.NotEmpty(t, access.Version)
+	assert.Equal(t, "application/x-executable", access.MediaType)
+	assert.Equal(t, runtime.NewVersionedType(v1.Type, v1.Version), access.Type)
+	assert.Empty(t, target.Access.(*v1.S3).Version, "the given resource must not be modified")
+
+	data, ok := server.Object("transfer", "tool")
+	require.True(t, ok)
+	assert.Equal(t, content, string(data))
+
+	b, err := repo.DownloadResource(t.Context(), uploaded, nil)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(readAll(t, b)))
+}
@@ -0,0 +1,21 @@
+package access
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+	v1 "ocm.software/open-component-model/bindings/go/s3/spec/access/v1"
+)
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	MustAddToScheme(Scheme)
+}
+
+func MustAddToScheme(scheme *runtime.Scheme) {
+	scheme.MustRegisterWithAlias(&v1.S3{},
+		runtime.NewVersionedType(v1.Type, v1.Version),
+		runtime.NewUnversionedType(v1.Type),
+		runtime.NewVersionedType(v1.LegacyType, v1.Version),
+		runtime.NewUnversionedType(v1.LegacyType),
+	)
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	Version    = "v1"
+	Type       = "S3"
+	LegacyType = "s3"
+)
+
+// S3 describes the access for an object stored in an S3-compatible object storage,
+// such as AWS S3 or MinIO.
+// This spec is aligned with ocm v1 https://github.com/open-component-model/ocm/blob/main/api/ocm/extensions/accessmethods/s3/method.go
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type S3 struct {
+	// +ocm:jsonschema-gen:enum=S3/v1,s3/v1
+	// +ocm:jsonschema-gen:enum:deprecated=S3,s3
+	Type runtime.Type `json:"type"`
+
+	// Region is the region of the bucket. If not set, us-east-1 is used.
+	Region string `json:"region,omitempty"`
+
+	// Bucket is the name of the bucket containing the object.
+	Bucket string `json:"bucket"`
+
+	// Key is the key of the object in the bucket.
+	Key string `json:"key"`
+
+	// Version is the version ID of the object. If not set, the latest version is accessed.
+	Version string `json:"version,omitempty"`
+
+	// Endpoint is the URL of an S3-compatible server, e.g. http://localhost:9000 for a local MinIO.
+	// Objects are addressed path-style on custom endpoints.
+	// If not set, the AWS S3 endpoint of the region is used.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// MediaType is the media type of the object.
+	// If not set, the Content-Type of the stored object is used.
+	MediaType string `json:"mediaType,omitempty"`
+}
+
+func (s *S3) String() string {
+	return "s3://" + s.Bucket + "/" + s.Key
+}
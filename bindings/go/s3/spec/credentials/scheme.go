@@ -0,0 +1,12 @@
+package credentials
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+	v1 "ocm.software/open-component-model/bindings/go/s3/spec/credentials/v1"
+)
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	v1.MustRegisterCredentialType(Scheme)
+}
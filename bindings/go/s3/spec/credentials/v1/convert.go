@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"fmt"
+
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	credentialKeyAccessKeyID     = "accessKeyID"
+	credentialKeySecretAccessKey = "secretAccessKey"
+	credentialKeySessionToken    = "sessionToken"
+
+	// keys used by the s3 access method of ocm v1, still accepted for compatibility.
+	legacyCredentialKeyAccessKeyID     = "awsAccessKeyID"
+	legacyCredentialKeySecretAccessKey = "awsSecretAccessKey"
+	legacyCredentialKeySessionToken    = "token"
+)
+
+var convertScheme = runtime.NewScheme()
+
+func init() {
+	MustRegisterCredentialType(convertScheme)
+	credv1.MustRegister(convertScheme)
+}
+
+func directToS3Credentials(properties map[string]string) *S3Credentials {
+	property := func(key, legacyKey string) string {
+		if value := properties[key]; value != "" {
+			return value
+		}
+		return properties[legacyKey]
+	}
+	return &S3Credentials{
+		Type:            runtime.NewVersionedType(S3CredentialsType, Version),
+		AccessKeyID:     property(credentialKeyAccessKeyID, legacyCredentialKeyAccessKeyID),
+		SecretAccessKey: property(credentialKeySecretAccessKey, legacyCredentialKeySecretAccessKey),
+		SessionToken:    property(credentialKeySessionToken, legacyCredentialKeySessionToken),
+	}
+}
+
+// ConvertToS3Credentials converts runtime.Typed credentials into *S3Credentials.
+// DirectCredentials are mapped using the S3-relevant fields (accessKeyID, secretAccessKey, sessionToken),
+// falling back to the keys of ocm v1 (awsAccessKeyID, awsSecretAccessKey, token).
+// Returns nil, nil for nil input or input with an empty type.
+func ConvertToS3Credentials(creds runtime.Typed) (*S3Credentials, error) {
+	if creds == nil || creds.GetType().String() == "" {
+		return nil, nil
+	}
+	typed, err := convertScheme.NewObject(creds.GetType())
+	if err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+	if err = convertScheme.Convert(creds, typed); err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+	switch t := typed.(type) {
+	case *credv1.DirectCredentials:
+		return directToS3Credentials(t.Properties), nil
+	case *S3Credentials:
+		return t, nil
+	}
+	return nil, fmt.Errorf("unsupported credential type for S3: %v", typed.GetType())
+}
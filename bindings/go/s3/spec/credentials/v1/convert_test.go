@@ -0,0 +1,82 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	v1 "ocm.software/open-component-model/bindings/go/s3/spec/credentials/v1"
+)
+
+func TestConvertToS3Credentials(t *testing.T) {
+	tests := []struct {
+		name        string
+		creds       runtime.Typed
+		expected    *v1.S3Credentials
+		expectedErr string
+	}{
+		{
+			name:  "nil credentials",
+			creds: nil,
+		},
+		{
+			name: "direct credentials",
+			creds: &credv1.DirectCredentials{
+				Type:       runtime.NewVersionedType(credv1.CredentialsType, credv1.Version),
+				Properties: map[string]string{"accessKeyID": "id", "secretAccessKey": "secret", "sessionToken": "session", "other": "ignored"},
+			},
+			expected: &v1.S3Credentials{
+				Type:            runtime.NewVersionedType(v1.S3CredentialsType, v1.Version),
+				AccessKeyID:     "id",
+				SecretAccessKey: "secret",
+				SessionToken:    "session",
+			},
+		},
+		{
+			name: "direct credentials with ocm v1 keys",
+			creds: &credv1.DirectCredentials{
+				Type:       runtime.NewVersionedType(credv1.CredentialsType, credv1.Version),
+				Properties: map[string]string{"awsAccessKeyID": "id", "awsSecretAccessKey": "secret", "token": "session"},
+			},
+			expected: &v1.S3Credentials{
+				Type:            runtime.NewVersionedType(v1.S3CredentialsType, v1.Version),
+				AccessKeyID:     "id",
+				SecretAccessKey: "secret",
+				SessionToken:    "session",
+			},
+		},
+		{
+			name: "typed credentials",
+			creds: &v1.S3Credentials{
+				Type:            runtime.NewUnversionedType(v1.S3CredentialsType),
+				AccessKeyID:     "id",
+				SecretAccessKey: "secret",
+			},
+			expected: &v1.S3Credentials{
+				Type:            runtime.NewUnversionedType(v1.S3CredentialsType),
+				AccessKeyID:     "id",
+				SecretAccessKey: "secret",
+			},
+		},
+		{
+			name:        "unsupported credentials",
+			creds:       &runtime.Raw{Type: runtime.NewVersionedType("OCICredentials", "v1"), Data: []byte(`{"type":"OCICredentials/v1"}`)},
+			expectedErr: "error converting credential type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := v1.ConvertToS3Credentials(tt.creds)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, creds)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	//nolint:gosec // G101: This is a type name, not a credential.
+	S3CredentialsType = "S3Credentials"
+	Version           = "v1"
+)
+
+// S3Credentials represents typed credentials for S3-compatible object storages.
+// Requests are signed with AWS Signature Version 4 using the access key.
+// The session token is only required for temporary credentials.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type S3Credentials struct {
+	// +ocm:jsonschema-gen:enum=S3Credentials/v1
+	// +ocm:jsonschema-gen:enum:deprecated=S3Credentials
+	Type            runtime.Type `json:"type"`
+	AccessKeyID     string       `json:"accessKeyID,omitempty"`
+	SecretAccessKey string       `json:"secretAccessKey,omitempty"`
+	SessionToken    string       `json:"sessionToken,omitempty"`
+}
+
+// MustRegisterCredentialType registers S3Credentials/v1 in the given scheme.
+func MustRegisterCredentialType(scheme *runtime.Scheme) {
+	scheme.MustRegisterWithAlias(&S3Credentials{},
+		runtime.NewVersionedType(S3CredentialsType, Version),
+		runtime.NewUnversionedType(S3CredentialsType),
+	)
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	S3IdentityType = "S3"
+	Version        = "v1"
+)
+
+const (
+	// IdentityAttributeBucket is the name of the bucket.
+	IdentityAttributeBucket = "bucket"
+	// IdentityAttributeKey is the key of the object in the bucket.
+	// It is stored as path attribute, so that credentials can be configured for
+	// key patterns such as "releases/*" (see runtime.IdentityMatchesPath).
+	IdentityAttributeKey = runtime.IdentityAttributePath
+	// IdentityAttributeRegion is the region of the bucket.
+	IdentityAttributeRegion = "region"
+	// IdentityAttributeEndpoint is the URL of the S3-compatible server. It is not set for AWS S3.
+	IdentityAttributeEndpoint = "endpoint"
+)
+
+// Type is the unversioned consumer identity type for objects in S3-compatible object storages (backward compat).
+var Type = runtime.NewUnversionedType(S3IdentityType)
+
+// VersionedType is the versioned consumer identity type.
+// Identities of this type carry the bucket, key, region and endpoint of an object.
+var VersionedType = runtime.NewVersionedType(S3IdentityType, Version)
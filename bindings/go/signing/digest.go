@@ -10,15 +10,26 @@ import (
 	"strings"
 
 	"ocm.software/open-component-model/bindings/go/descriptor/normalisation"
+	jsonv1 "ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v1"
+	jsonv2 "ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v2"
+	jsonv3 "ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v3"
 	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v4alpha1"
 	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
 )
 
 const (
 	// LegacyNormalisationAlgo identifies the deprecated v3 JSON normalisation algorithm.
-	// It is replaced by v4alpha1.Algorithm. Calls using this value are transparently
+	// It is replaced by v4alpha1.Algorithm. Digests generated with this value are transparently
 	// mapped to v4alpha1 with a warning.
+	//
+	// Digests of OCM v1 signatures with this value are verified with the jsonNormalisation/v3
+	// algorithm of OCM v1 (see LegacyV3NormalisationAlgo) and, if that does not match, with
+	// v4alpha1 like in earlier versions.
 	LegacyNormalisationAlgo = "jsonNormalisation/v3"
+	// LegacyV3NormalisationAlgo is the jsonNormalisation/v3 algorithm of OCM v1, which is
+	// registered under the name of LegacyNormalisationAlgo. It can be used directly with
+	// normalisation.Normalise, but is only used for verification by this package.
+	LegacyV3NormalisationAlgo = jsonv3.Algorithm
 	// AccessTypeNone is the access type for resources without access.
 	// It is used to prevent meaningless digest claims.
 	AccessTypeNone = "None"
@@ -29,26 +40,44 @@ const (
 // signature’s claimed digest.
 //
 // Steps:
-//  1. Resolve the normalisation algorithm (legacy → v4alpha1 if required).
-//  2. Normalise the descriptor with that algorithm.
-//  3. Select the hash algorithm from supported list (SHA256, SHA512).
-//  4. Hash the normalised descriptor.
-//  5. Decode the digest value from the signature.
-//  6. Compare the freshly computed digest against the signature digest.
+//  1. Normalise the descriptor with the normalisation algorithm of the signature.
+//     The legacy algorithms of OCM v1 (jsonNormalisation/v1, v2 and v3) follow the
+//     normalisation rules of OCM v1, but are not yet checked against its output.
+//  2. Select the hash algorithm from supported list (SHA256, SHA512).
+//  3. Hash the normalised descriptor.
+//  4. Decode the digest value from the signature.
+//  5. Compare the freshly computed digest against the signature digest.
+//  6. For LegacyNormalisationAlgo, retry with v4alpha1 if the digests do not match.
 func VerifyDigestMatchesDescriptor(
 	ctx context.Context,
 	desc *descruntime.Descriptor,
 	signature descruntime.Signature,
 	logger *slog.Logger,
 ) error {
-	signature.Digest.NormalisationAlgorithm = ensureNormalisationAlgo(ctx, signature.Digest.NormalisationAlgorithm, logger)
+	logLegacyNormalisationAlgo(ctx, signature.Digest.NormalisationAlgorithm, logger)
 
-	normalised, err := normalisation.Normalise(desc, signature.Digest.NormalisationAlgorithm)
+	err := verifyDigest(desc, signature.Digest, signature.Digest.NormalisationAlgorithm)
+	if err == nil || signature.Digest.NormalisationAlgorithm != LegacyNormalisationAlgo {
+		return err
+	}
+	// earlier versions verified legacy digests with v4alpha1, keep accepting them.
+	if verifyDigest(desc, signature.Digest, v4alpha1.Algorithm) == nil {
+		logger.DebugContext(ctx, "legacy normalisation algorithm matched with v4alpha1",
+			"legacy", LegacyNormalisationAlgo,
+			"new", v4alpha1.Algorithm,
+		)
+		return nil
+	}
+	return err
+}
+
+func verifyDigest(desc *descruntime.Descriptor, digest descruntime.Digest, normalisationAlgorithm string) error {
+	normalised, err := normalisation.Normalise(desc, normalisationAlgorithm)
 	if err != nil {
 		return fmt.Errorf("normalising component version failed: %w", err)
 	}
 
-	hash, err := getSupportedHash(signature.Digest.HashAlgorithm)
+	hash, err := getSupportedHash(digest.HashAlgorithm)
 	if err != nil {
 		return err
 	}
@@ -59,7 +88,7 @@ func VerifyDigestMatchesDescriptor(
 	}
 	freshDigest := h.Sum(nil)
 
-	digestFromSignature, err := hex.DecodeString(signature.Digest.Value)
+	digestFromSignature, err := hex.DecodeString(digest.Value)
 	if err != nil {
 		return fmt.Errorf("decoding digest from signature failed: %w", err)
 	}
@@ -161,19 +190,34 @@ func hasUsableAccess(res descruntime.Resource) bool {
 // ensureNormalisationAlgo resolves the effective normalisation algorithm.
 // If the provided value is the legacy v3 algorithm, it logs a warning and
 // returns v4alpha1.Algorithm instead. Otherwise, it returns the original value.
-// this is to ensure compatibility with old ocm v1 style signatures.
+// The other legacy algorithms of OCM v1 are passed through with a warning.
 func ensureNormalisationAlgo(ctx context.Context, algo string, logger *slog.Logger) string {
-	if algo == LegacyNormalisationAlgo {
+	switch algo {
+	case LegacyNormalisationAlgo:
 		logger.WarnContext(ctx,
 			"legacy normalisation algorithm detected, using v4alpha1",
 			"legacy", LegacyNormalisationAlgo,
 			"new", v4alpha1.Algorithm,
 		)
 		return v4alpha1.Algorithm
+	case jsonv1.Algorithm, jsonv2.Algorithm:
+		logger.WarnContext(ctx,
+			"generating digest with legacy normalisation algorithm of OCM v1, use v4alpha1 instead",
+			"legacy", algo,
+			"new", v4alpha1.Algorithm,
+		)
 	}
 	return algo
 }
 
+// logLegacyNormalisationAlgo logs the verification of a digest with a legacy normalisation algorithm of OCM v1.
+func logLegacyNormalisationAlgo(ctx context.Context, algo string, logger *slog.Logger) {
+	switch algo {
+	case jsonv1.Algorithm, jsonv2.Algorithm, LegacyV3NormalisationAlgo:
+		logger.DebugContext(ctx, "using legacy normalisation algorithm of OCM v1", "algorithm", algo)
+	}
+}
+
 // supportedHashes lists supported hashing algorithms keyed by their identifier
 var supportedHashes = map[string]crypto.Hash{
 	crypto.SHA256.String(): crypto.SHA256,
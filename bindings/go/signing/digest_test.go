@@ -1,8 +1,10 @@
 package signing
 
 import (
+	"bytes"
 	"context"
 	"crypto"
+	"crypto/sha256"
 	"encoding/hex"
 	"io"
 	"log/slog"
@@ -11,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation"
 	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v4alpha1"
 	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
 )
@@ -36,6 +39,37 @@ func TestEnsureNormalisationAlgo(t *testing.T) {
 	in := "someAlgo"
 	got = ensureNormalisationAlgo(ctx, in, logger)
 	assert.Equal(t, in, got)
+
+	// other legacy algorithms of OCM v1 are not translated, but warned about
+	var buf bytes.Buffer
+	got = ensureNormalisationAlgo(ctx, "jsonNormalisation/v1", slog.New(slog.NewTextHandler(&buf, nil)))
+	assert.Equal(t, "jsonNormalisation/v1", got)
+	assert.Contains(t, buf.String(), "level=WARN msg=\"generating digest with legacy normalisation algorithm of OCM v1")
+}
+
+func TestLogLegacyNormalisationAlgo(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		algo string
+		want string
+	}{
+		{name: "v1", algo: "jsonNormalisation/v1", want: "level=DEBUG msg=\"using legacy normalisation algorithm of OCM v1\" algorithm=jsonNormalisation/v1"},
+		{name: "v3", algo: LegacyNormalisationAlgo, want: "algorithm=jsonNormalisation/v3"},
+		{name: "v4alpha1", algo: v4alpha1.Algorithm},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			logLegacyNormalisationAlgo(ctx, tt.algo, logger)
+			if tt.want == "" {
+				assert.Empty(t, buf.String())
+				return
+			}
+			assert.Contains(t, buf.String(), tt.want)
+		})
+	}
 }
 
 func TestIsSafelyDigestible(t *testing.T) {
@@ -201,12 +235,41 @@ func TestVerifyDigestMatchesDescriptor_LegacyNormalisation(t *testing.T) {
 
 	d := &descruntime.Descriptor{Component: descruntime.Component{ComponentMeta: descruntime.ComponentMeta{ObjectMeta: descruntime.ObjectMeta{Name: "legacy"}}, Provider: descruntime.Provider{Name: "p"}}}
 
-	dg, err := GenerateDigest(ctx, d, logger, v4alpha1.Algorithm, crypto.SHA256.String())
-	require.NoError(t, err)
-
-	// present the signature with the legacy normalisation identifier - it should be mapped and succeed
-	dg.NormalisationAlgorithm = LegacyNormalisationAlgo
-	sig := descruntime.Signature{Name: "s4", Digest: *dg}
+	for _, algo := range []string{"jsonNormalisation/v1", "jsonNormalisation/v2"} {
+		t.Run(algo, func(t *testing.T) {
+			dg, err := GenerateDigest(ctx, d, logger, algo, crypto.SHA256.String())
+			require.NoError(t, err)
+			require.Equal(t, algo, dg.NormalisationAlgorithm)
+			sig := descruntime.Signature{Name: "s4", Digest: *dg}
+			require.NoError(t, VerifyDigestMatchesDescriptor(ctx, d, sig, logger))
+
+			// the entry list formats of v1 and v2 must not verify with v4alpha1
+			dg.NormalisationAlgorithm = v4alpha1.Algorithm
+			sig = descruntime.Signature{Name: "s4", Digest: *dg}
+			require.ErrorContains(t, VerifyDigestMatchesDescriptor(ctx, d, sig, logger), "digest mismatch")
+		})
+	}
 
-	require.NoError(t, VerifyDigestMatchesDescriptor(ctx, d, sig, logger))
+	t.Run(LegacyNormalisationAlgo, func(t *testing.T) {
+		// a digest of the jsonNormalisation/v3 algorithm of OCM v1
+		normalised, err := normalisation.Normalise(d, LegacyV3NormalisationAlgo)
+		require.NoError(t, err)
+		sum := sha256.Sum256(normalised)
+		sig := descruntime.Signature{Name: "s4", Digest: descruntime.Digest{
+			HashAlgorithm:          crypto.SHA256.String(),
+			NormalisationAlgorithm: LegacyNormalisationAlgo,
+			Value:                  hex.EncodeToString(sum[:]),
+		}}
+		require.NoError(t, VerifyDigestMatchesDescriptor(ctx, d, sig, logger))
+
+		// present a v4alpha1 digest with the legacy normalisation identifier - it should still succeed
+		dg, err := GenerateDigest(ctx, d, logger, v4alpha1.Algorithm, crypto.SHA256.String())
+		require.NoError(t, err)
+		dg.NormalisationAlgorithm = LegacyNormalisationAlgo
+		require.NoError(t, VerifyDigestMatchesDescriptor(ctx, d, descruntime.Signature{Name: "s4", Digest: *dg}, logger))
+
+		// a wrong digest reports the mismatch of the legacy algorithm
+		sig.Digest.Value = hex.EncodeToString(make([]byte, sha256.Size))
+		require.ErrorContains(t, VerifyDigestMatchesDescriptor(ctx, d, sig, logger), "digest mismatch")
+	})
 }
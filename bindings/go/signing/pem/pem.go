@@ -1,9 +1,12 @@
-// Package pem contains low-level PEM and X.509 helpers used by the RSA-PSS
-// handler. Functions here are intentionally small and dependency-free.
+// Package pem contains low-level PEM and X.509 helpers shared by the signing
+// handlers. Functions here are intentionally small and dependency-free.
+//
+// Key parsing is not bound to an algorithm: callers pass a function that
+// accepts the key types their handler supports.
 package pem
 
 import (
-	"crypto/rsa"
+	"crypto"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
@@ -13,57 +16,53 @@ import (
 const (
 	CertificatePEMBlockType = "CERTIFICATE"
 	pemPKCS1PrivateKey      = "RSA PRIVATE KEY"
+	pemSEC1PrivateKey       = "EC PRIVATE KEY"
 	pemPKCS8PrivateKey      = "PRIVATE KEY"
 	pemPKIXPublicKey        = "PUBLIC KEY"
 	pemPKCS1PublicKey       = "RSA PUBLIC KEY"
 )
 
-// ParseRSAPrivateKeyPEM scans concatenated PEM data and returns the first RSA
-// private key found. It supports PKCS#1 ("RSA PRIVATE KEY") and PKCS#8
-// ("PRIVATE KEY") containers. It returns nil if no RSA key can be parsed.
-func ParseRSAPrivateKeyPEM(pemBytes []byte) *rsa.PrivateKey {
+// ParsePrivateKeyPEM scans concatenated PEM data and returns the first private
+// key for which accept returns true. It supports PKCS#1 ("RSA PRIVATE KEY"),
+// SEC 1 ("EC PRIVATE KEY") and PKCS#8 ("PRIVATE KEY") containers.
+// It returns nil if no accepted key can be parsed.
+func ParsePrivateKeyPEM(pemBytes []byte, accept func(crypto.Signer) bool) crypto.Signer {
 	for len(pemBytes) > 0 {
 		block, rest := pem.Decode(pemBytes)
 		if block == nil {
 			break
 		}
+		var key any
+		var err error
 		switch block.Type {
 		case pemPKCS1PrivateKey:
-			if k, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
-				return k
-			}
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case pemSEC1PrivateKey:
+			key, err = x509.ParseECPrivateKey(block.Bytes)
 		case pemPKCS8PrivateKey:
-			if anyKey, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
-				if k, ok := anyKey.(*rsa.PrivateKey); ok {
-					return k
-				}
-			}
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		}
+		if signer, ok := key.(crypto.Signer); ok && err == nil && accept(signer) {
+			return signer
 		}
 		pemBytes = rest
 	}
 	return nil
 }
 
-// RSAPublicKeyPEM holds a parsed RSA public key and optionally the original
-// X.509 certificate it came from or the private key that it was derived from.
-type RSAPublicKeyPEM struct {
-	PublicKey      *rsa.PublicKey
+// PublicKeyPEM holds a parsed public key and optionally the original
+// X.509 certificate it came from.
+type PublicKeyPEM struct {
+	PublicKey      crypto.PublicKey
 	UnderlyingCert *x509.Certificate
 }
 
-func (pem *RSAPublicKeyPEM) GetOptionalUnderlyingCert() *x509.Certificate {
-	if pem == nil {
-		return nil
-	}
-	return pem.UnderlyingCert
-}
-
-// ParseRSAPublicKeyPEM scans concatenated PEM data and returns RSAPublicKeyPEM
-// if one can be parsed. It supports PKCS#1 ("RSA PUBLIC KEY") and PKIX ("PUBLIC KEY")
-// containers as well as X.509 certificates.
+// ParsePublicKeyPEM scans concatenated PEM data and returns the first public
+// key for which accept returns true. It supports PKIX ("PUBLIC KEY") and
+// PKCS#1 ("RSA PUBLIC KEY") containers as well as X.509 certificates.
 //
 // If none can be parsed it returns (nil).
-func ParseRSAPublicKeyPEM(pemBytes []byte) *RSAPublicKeyPEM {
+func ParsePublicKeyPEM(pemBytes []byte, accept func(crypto.PublicKey) bool) *PublicKeyPEM {
 	for len(pemBytes) > 0 {
 		block, rest := pem.Decode(pemBytes)
 		if block == nil {
@@ -72,26 +71,18 @@ func ParseRSAPublicKeyPEM(pemBytes []byte) *RSAPublicKeyPEM {
 		}
 		switch block.Type {
 		case pemPKIXPublicKey:
-			if k, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
-				if pk, ok := k.(*rsa.PublicKey); ok {
-					return &RSAPublicKeyPEM{
-						PublicKey: pk,
-					}
-				}
+			if k, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil && accept(k) {
+				return &PublicKeyPEM{PublicKey: k}
 			}
 		case pemPKCS1PublicKey:
-			if pk, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
-				return &RSAPublicKeyPEM{
-					PublicKey: pk,
-				}
+			if k, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil && accept(k) {
+				return &PublicKeyPEM{PublicKey: k}
 			}
 		case CertificatePEMBlockType:
-			if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
-				if pk, ok := cert.PublicKey.(*rsa.PublicKey); ok {
-					return &RSAPublicKeyPEM{
-						PublicKey:      pk,
-						UnderlyingCert: cert,
-					}
+			if cert, err := x509.ParseCertificate(block.Bytes); err == nil && accept(cert.PublicKey) {
+				return &PublicKeyPEM{
+					PublicKey:      cert.PublicKey,
+					UnderlyingCert: cert,
 				}
 			}
 		}
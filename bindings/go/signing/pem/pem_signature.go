@@ -11,7 +11,7 @@ import (
 const SignaturePEMBlockType = "SIGNATURE"
 
 // SignaturePEMBlockAlgorithmHeader is an optional PEM header that records the
-// signature algorithm used for the SIGNATURE block, for example "RSASSA-PSS".
+// signature algorithm used for the SIGNATURE block, for example "RSASSA-PSS" or "ECDSA-P256".
 const SignaturePEMBlockAlgorithmHeader = "Signature Algorithm"
 
 // SignatureBytesToPem encodes a signature and an optional certificate chain to PEM.
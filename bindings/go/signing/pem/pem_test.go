@@ -0,0 +1,145 @@
+package pem_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	signingpem "ocm.software/open-component-model/bindings/go/signing/pem"
+)
+
+func isRSA(k crypto.PublicKey) bool {
+	_, ok := k.(*rsa.PublicKey)
+	return ok
+}
+
+func isECDSA(k crypto.PublicKey) bool {
+	_, ok := k.(*ecdsa.PublicKey)
+	return ok
+}
+
+func acceptSigner(accept func(crypto.PublicKey) bool) func(crypto.Signer) bool {
+	return func(s crypto.Signer) bool { return accept(s.Public()) }
+}
+
+func encode(t *testing.T, typ string, der []byte) []byte {
+	t.Helper()
+	return pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der})
+}
+
+func pkcs8(t *testing.T, key any) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return encode(t, "PRIVATE KEY", der)
+}
+
+func TestParsePrivateKeyPEM(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sec1, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+
+	isEd25519 := func(k crypto.PublicKey) bool {
+		_, ok := k.(ed25519.PublicKey)
+		return ok
+	}
+
+	tests := []struct {
+		name   string
+		data   []byte
+		accept func(crypto.PublicKey) bool
+		want   crypto.Signer
+	}{
+		{name: "PKCS#1 RSA", data: encode(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)), accept: isRSA, want: rsaKey},
+		{name: "PKCS#8 RSA", data: pkcs8(t, rsaKey), accept: isRSA, want: rsaKey},
+		{name: "SEC 1 ECDSA", data: encode(t, "EC PRIVATE KEY", sec1), accept: isECDSA, want: ecKey},
+		{name: "PKCS#8 ECDSA", data: pkcs8(t, ecKey), accept: isECDSA, want: ecKey},
+		{name: "PKCS#8 Ed25519", data: pkcs8(t, edKey), accept: isEd25519, want: edKey},
+		{name: "first accepted key of a bundle", data: append(pkcs8(t, rsaKey), pkcs8(t, ecKey)...), accept: isECDSA, want: ecKey},
+		{name: "no accepted key", data: pkcs8(t, rsaKey), accept: isECDSA},
+		{name: "no PEM", data: []byte("not a key"), accept: isRSA},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := signingpem.ParsePrivateKeyPEM(tt.data, acceptSigner(tt.accept))
+			if tt.want == nil {
+				require.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			require.True(t, got.Public().(interface{ Equal(crypto.PublicKey) bool }).Equal(tt.want.Public()))
+		})
+	}
+}
+
+func TestParsePublicKeyPEM(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pkix256, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+	cert := selfSigned(t, ecKey)
+
+	t.Run("PKIX", func(t *testing.T) {
+		got := signingpem.ParsePublicKeyPEM(encode(t, "PUBLIC KEY", pkix256), isECDSA)
+		require.NotNil(t, got)
+		require.True(t, ecKey.PublicKey.Equal(got.PublicKey))
+		require.Nil(t, got.UnderlyingCert)
+	})
+	t.Run("PKCS#1", func(t *testing.T) {
+		got := signingpem.ParsePublicKeyPEM(encode(t, "RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)), isRSA)
+		require.NotNil(t, got)
+		require.True(t, rsaKey.PublicKey.Equal(got.PublicKey))
+	})
+	t.Run("certificate", func(t *testing.T) {
+		got := signingpem.ParsePublicKeyPEM(signingpem.CertificateChainToPem([]*x509.Certificate{cert}), isECDSA)
+		require.NotNil(t, got)
+		require.True(t, ecKey.PublicKey.Equal(got.PublicKey))
+		require.Equal(t, cert, got.UnderlyingCert)
+	})
+	t.Run("no accepted key", func(t *testing.T) {
+		require.Nil(t, signingpem.ParsePublicKeyPEM(encode(t, "PUBLIC KEY", pkix256), isRSA))
+	})
+}
+
+func TestSignaturePEMRoundTrip(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	cert := selfSigned(t, key)
+
+	data := signingpem.SignatureBytesToPem("ECDSA-P256", []byte{0x01, 0x02}, cert)
+	sig, algo, chain, err := signingpem.GetSignatureFromPem(data)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x02}, sig)
+	require.Equal(t, "ECDSA-P256", algo)
+	require.Equal(t, []*x509.Certificate{cert}, chain)
+
+	_, _, _, err = signingpem.GetSignatureFromPem([]byte("no pem"))
+	require.ErrorIs(t, err, signingpem.ErrNoPEM)
+}
+
+func selfSigned(t *testing.T, key *ecdsa.PrivateKey) *x509.Certificate {
+	t.Helper()
+	tmpl := &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "signer"}}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
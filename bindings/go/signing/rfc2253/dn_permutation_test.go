@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
-	dn "ocm.software/open-component-model/bindings/go/rsa/signing/handler/internal/rfc2253"
+	dn "ocm.software/open-component-model/bindings/go/signing/rfc2253"
 )
 
 func perms(ss []string) [][]string {
@@ -6,7 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
-	"ocm.software/open-component-model/bindings/go/rsa/signing/handler/internal/rfc2253"
+	"ocm.software/open-component-model/bindings/go/signing/rfc2253"
 )
 
 func TestRFC2253_Conformance(t *testing.T) {
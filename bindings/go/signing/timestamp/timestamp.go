@@ -0,0 +1,189 @@
+// Package timestamp requests and verifies RFC 3161 timestamp tokens for OCM signatures.
+//
+// A timestamp token proves that a signature existed at the time asserted by a
+// timestamp authority (TSA). Verifiers use this time instead of the time of
+// verification to check the validity of the signing certificate, so signatures
+// remain verifiable after the signing certificate has expired.
+//
+// The message imprint of a token is the SHA-256 hash of the signature value
+// (descruntime.SignatureInfo.Value) exactly as stored in the descriptor.
+//
+// See https://datatracker.ietf.org/doc/html/rfc3161.
+package timestamp
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/digitorus/pkcs7"
+	"github.com/digitorus/timestamp"
+
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+)
+
+const (
+	// MediaTypeQuery is the media type of a timestamp request.
+	MediaTypeQuery = "application/timestamp-query"
+	// MediaTypeReply is the media type of a timestamp response.
+	MediaTypeReply = "application/timestamp-reply"
+
+	// maxResponseSize limits the size of a TSA response.
+	maxResponseSize = 1 << 20
+)
+
+// ErrNoTimestamp is returned by VerifySignature if the signature carries no timestamp.
+var ErrNoTimestamp = errors.New("signature has no timestamp")
+
+// Token is an RFC 3161 timestamp token.
+type Token struct {
+	// Raw is the DER encoded token.
+	Raw []byte
+	// Time is the generation time asserted by the TSA.
+	Time time.Time
+	// Certificates are the certificates embedded in the token, including the TSA certificate.
+	Certificates []*x509.Certificate
+}
+
+// Request obtains a timestamp token for data from the TSA at url.
+// If client is nil, http.DefaultClient is used.
+// The returned token is checked to match the request, its signer is not verified.
+func Request(ctx context.Context, client *http.Client, url string, data []byte) (*Token, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, fmt.Errorf("generating nonce failed: %w", err)
+	}
+	digest := crypto.SHA256.New()
+	digest.Write(data)
+	query, err := (&timestamp.Request{
+		HashAlgorithm: crypto.SHA256,
+		HashedMessage: digest.Sum(nil),
+		Certificates:  true,
+		Nonce:         nonce,
+	}).Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("creating timestamp request failed: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(query))
+	if err != nil {
+		return nil, fmt.Errorf("creating timestamp request failed: %w", err)
+	}
+	req.Header.Set("Content-Type", MediaTypeQuery)
+	req.Header.Set("Accept", MediaTypeReply)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("requesting timestamp from %q failed: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("requesting timestamp from %q failed: %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading timestamp response failed: %w", err)
+	}
+
+	ts, err := timestamp.ParseResponse(body)
+	if err != nil {
+		return nil, fmt.Errorf("parsing timestamp response failed: %w", err)
+	}
+	if ts.Nonce == nil || ts.Nonce.Cmp(nonce) != 0 {
+		return nil, errors.New("timestamp response does not match the request nonce")
+	}
+	if err := checkImprint(ts, data); err != nil {
+		return nil, err
+	}
+	return &Token{Raw: ts.RawToken, Time: ts.Time, Certificates: ts.Certificates}, nil
+}
+
+// Verify checks that raw is a timestamp token over data, signed by a TSA
+// certificate that chains to roots and was valid for time stamping at the
+// asserted generation time. Roots must not be nil.
+func Verify(raw, data []byte, roots *x509.CertPool) (*Token, error) {
+	if roots == nil {
+		return nil, errors.New("no trusted timestamp authority roots")
+	}
+	ts, err := timestamp.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parsing timestamp token failed: %w", err)
+	}
+	if err := checkImprint(ts, data); err != nil {
+		return nil, err
+	}
+
+	p7, err := pkcs7.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parsing timestamp token failed: %w", err)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range p7.Certificates {
+		intermediates.AddCert(cert)
+	}
+	if err := p7.VerifyWithOpts(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+		CurrentTime:   ts.Time,
+	}); err != nil {
+		return nil, fmt.Errorf("timestamp authority verification failed: %w", err)
+	}
+	return &Token{Raw: raw, Time: ts.Time, Certificates: ts.Certificates}, nil
+}
+
+// ForSignature requests a timestamp for the signature value from the TSA at url
+// and returns it in the form stored in a descriptor.
+func ForSignature(ctx context.Context, client *http.Client, url string, signature descruntime.SignatureInfo) (*descruntime.TimestampSpec, error) {
+	token, err := Request(ctx, client, url, []byte(signature.Value))
+	if err != nil {
+		return nil, err
+	}
+	t := token.Time.UTC()
+	return &descruntime.TimestampSpec{
+		Value: base64.StdEncoding.EncodeToString(token.Raw),
+		Time:  &t,
+	}, nil
+}
+
+// VerifySignature verifies the timestamp of signature against roots and returns
+// the time asserted by the TSA. It returns ErrNoTimestamp if the signature has no timestamp.
+func VerifySignature(signature descruntime.Signature, roots *x509.CertPool) (time.Time, error) {
+	if signature.Timestamp == nil || signature.Timestamp.Value == "" {
+		return time.Time{}, ErrNoTimestamp
+	}
+	raw, err := base64.StdEncoding.DecodeString(signature.Timestamp.Value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decoding timestamp token failed: %w", err)
+	}
+	token, err := Verify(raw, []byte(signature.Signature.Value), roots)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return token.Time, nil
+}
+
+func checkImprint(ts *timestamp.Timestamp, data []byte) error {
+	switch ts.HashAlgorithm {
+	case crypto.SHA256, crypto.SHA384, crypto.SHA512:
+	default:
+		return fmt.Errorf("unsupported timestamp hash algorithm %s", ts.HashAlgorithm)
+	}
+	h := ts.HashAlgorithm.New()
+	h.Write(data)
+	if !bytes.Equal(h.Sum(nil), ts.HashedMessage) {
+		return errors.New("timestamp does not match the signature")
+	}
+	return nil
+}
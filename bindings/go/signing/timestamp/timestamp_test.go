@@ -0,0 +1,93 @@
+package timestamp_test
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/signing/timestamp"
+	"ocm.software/open-component-model/bindings/go/signing/timestamp/tsatest"
+)
+
+func Test_Timestamp_Signature(t *testing.T) {
+	tsa := tsatest.New(t)
+	genTime := time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
+	tsa.SetNow(func() time.Time { return genTime })
+
+	info := descruntime.SignatureInfo{Algorithm: "RSASSA-PSS", MediaType: "application/vnd.ocm.signature.rsa.pss", Value: "abcdef"}
+	spec, err := timestamp.ForSignature(t.Context(), nil, tsa.URL, info)
+	require.NoError(t, err)
+	require.Equal(t, 1, tsa.Requests())
+	require.NotEmpty(t, spec.Value)
+	require.Equal(t, genTime, *spec.Time)
+
+	sig := descruntime.Signature{Name: "default", Signature: info, Timestamp: spec}
+
+	t.Run("valid", func(t *testing.T) {
+		got, err := timestamp.VerifySignature(sig, tsa.RootPool())
+		require.NoError(t, err)
+		require.True(t, genTime.Equal(got))
+	})
+
+	t.Run("no timestamp", func(t *testing.T) {
+		_, err := timestamp.VerifySignature(descruntime.Signature{Signature: info}, tsa.RootPool())
+		require.ErrorIs(t, err, timestamp.ErrNoTimestamp)
+	})
+
+	t.Run("modified signature", func(t *testing.T) {
+		modified := *sig.DeepCopy()
+		modified.Signature.Value = "abcdee"
+		_, err := timestamp.VerifySignature(modified, tsa.RootPool())
+		require.ErrorContains(t, err, "timestamp does not match the signature")
+	})
+
+	t.Run("untrusted authority", func(t *testing.T) {
+		other := tsatest.New(t)
+		_, err := timestamp.VerifySignature(sig, other.RootPool())
+		require.ErrorContains(t, err, "timestamp authority verification failed")
+	})
+
+	t.Run("no roots", func(t *testing.T) {
+		_, err := timestamp.VerifySignature(sig, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("asserted time outside TSA validity", func(t *testing.T) {
+		tsa.SetNow(func() time.Time { return tsa.Certificate.NotAfter.Add(time.Hour) })
+		t.Cleanup(func() { tsa.SetNow(time.Now) })
+		spec, err := timestamp.ForSignature(t.Context(), nil, tsa.URL, info)
+		require.NoError(t, err)
+		_, err = timestamp.VerifySignature(descruntime.Signature{Signature: info, Timestamp: spec}, tsa.RootPool())
+		require.ErrorContains(t, err, "timestamp authority verification failed")
+	})
+}
+
+func Test_Request(t *testing.T) {
+	tsa := tsatest.New(t)
+
+	token, err := timestamp.Request(t.Context(), nil, tsa.URL, []byte("data"))
+	require.NoError(t, err)
+	require.NotEmpty(t, token.Certificates)
+
+	verified, err := timestamp.Verify(token.Raw, []byte("data"), tsa.RootPool())
+	require.NoError(t, err)
+	require.True(t, token.Time.Equal(verified.Time))
+
+	_, err = timestamp.Verify(token.Raw, []byte("other"), tsa.RootPool())
+	require.ErrorContains(t, err, "timestamp does not match the signature")
+
+	_, err = timestamp.Verify([]byte("not a token"), []byte("data"), x509.NewCertPool())
+	require.ErrorContains(t, err, "parsing timestamp token failed")
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(failing.Close)
+	_, err = timestamp.Request(t.Context(), nil, failing.URL, []byte("data"))
+	require.ErrorContains(t, err, "503 Service Unavailable")
+}
@@ -0,0 +1,178 @@
+// Package tsatest provides an in-process RFC 3161 timestamp authority for tests.
+//
+// The stub issues its own root CA and a TSA certificate with the critical
+// timeStamping extended key usage. Tokens are signed with SHA-256 and always
+// embed the TSA certificate.
+package tsatest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/digitorus/timestamp"
+)
+
+// policy is the TSA policy OID asserted in issued tokens.
+var policy = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 99, 1}
+
+// Server is a stub timestamp authority.
+type Server struct {
+	*httptest.Server
+
+	// Root is the self-signed root certificate the TSA certificate chains to.
+	Root *x509.Certificate
+	// Certificate is the TSA signing certificate.
+	Certificate *x509.Certificate
+
+	key crypto.Signer
+
+	mu       sync.Mutex
+	now      func() time.Time
+	requests int
+}
+
+// New starts a stub TSA. It is closed with the cleanup of the test.
+func New(t interface {
+	Cleanup(func())
+	Fatalf(format string, args ...any)
+},
+) *Server {
+	s, err := newServer()
+	if err != nil {
+		t.Fatalf("starting stub timestamp authority failed: %v", err)
+	}
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newServer() (*Server, error) {
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	notBefore := time.Now().Add(-24 * time.Hour)
+	notAfter := time.Now().Add(10 * 365 * 24 * time.Hour)
+	rootTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test TSA Root"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTmpl, rootTmpl, &rootKey.PublicKey, rootKey)
+	if err != nil {
+		return nil, err
+	}
+	root, err := x509.ParseCertificate(rootDER)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	ekuValue, err := asn1.Marshal([]asn1.ObjectIdentifier{{1, 3, 6, 1, 5, 5, 7, 3, 8}})
+	if err != nil {
+		return nil, err
+	}
+	tsaTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "Test TSA"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		// RFC 3161 requires the timeStamping extended key usage to be critical.
+		ExtraExtensions: []pkix.Extension{{Id: asn1.ObjectIdentifier{2, 5, 29, 37}, Critical: true, Value: ekuValue}},
+	}
+	tsaDER, err := x509.CreateCertificate(rand.Reader, tsaTmpl, root, &key.PublicKey, rootKey)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(tsaDER)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{Root: root, Certificate: cert, key: key, now: time.Now}
+	s.Server = httptest.NewServer(nethttp.HandlerFunc(s.serve))
+	return s, nil
+}
+
+// SetNow sets the clock used for the generation time of issued tokens.
+func (s *Server) SetNow(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// Requests returns the number of timestamp requests served.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// RootPool returns a certificate pool containing Root.
+func (s *Server) RootPool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(s.Root)
+	return pool
+}
+
+// RootPEM returns Root PEM encoded.
+func (s *Server) RootPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Root.Raw})
+}
+
+func (s *Server) serve(w nethttp.ResponseWriter, r *nethttp.Request) {
+	if r.Method != nethttp.MethodPost {
+		nethttp.Error(w, "method not allowed", nethttp.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		nethttp.Error(w, err.Error(), nethttp.StatusBadRequest)
+		return
+	}
+	req, err := timestamp.ParseRequest(body)
+	if err != nil {
+		nethttp.Error(w, fmt.Sprintf("invalid timestamp request: %v", err), nethttp.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	now := s.now()
+	s.requests++
+	s.mu.Unlock()
+
+	resp, err := (&timestamp.Timestamp{
+		HashAlgorithm:     req.HashAlgorithm,
+		HashedMessage:     req.HashedMessage,
+		Time:              now.UTC(),
+		Nonce:             req.Nonce,
+		Policy:            policy,
+		AddTSACertificate: req.Certificates,
+	}).CreateResponseWithOpts(s.Certificate, s.key, crypto.SHA256)
+	if err != nil {
+		nethttp.Error(w, err.Error(), nethttp.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/timestamp-reply")
+	_, _ = w.Write(resp)
+}
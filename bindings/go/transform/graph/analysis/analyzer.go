@@ -16,20 +16,39 @@ import (
 	"ocm.software/open-component-model/bindings/go/runtime"
 	"ocm.software/open-component-model/bindings/go/transform/graph"
 	"ocm.software/open-component-model/bindings/go/transform/graph/env"
+	graphRuntime "ocm.software/open-component-model/bindings/go/transform/graph/runtime"
 )
 
 type StaticPluginAnalysisProcessor struct {
-	Scheme                  *runtime.Scheme
+	Scheme *runtime.Scheme
+	// Resolver provides the schemas of transformation types that are not registered in the Scheme.
+	Resolver                graphRuntime.TransformerResolver
 	Builder                 *env.Builder
 	AnalyzedTransformations map[string]graph.Transformation
 }
 
 func (b *StaticPluginAnalysisProcessor) ProcessValue(_ context.Context, transformation graph.Transformation) error {
+	if transformation.IsForEach() {
+		return b.processForEach(transformation)
+	}
+
 	celEnv, _, err := b.Builder.CurrentEnv()
 	if err != nil {
 		return err
 	}
 
+	if transformation.WhenExpression != nil {
+		ast, issues := celEnv.Compile(transformation.WhenExpression.Value)
+		if issues.Err() != nil {
+			return fmt.Errorf("cannot compile when expression %q: %w", transformation.WhenExpression.Value, issues.Err())
+		}
+		if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+			return fmt.Errorf("when expression %q of transformation %q must evaluate to bool, but evaluates to %s",
+				transformation.WhenExpression.Value, transformation.ID, outputType.TypeName())
+		}
+		transformation.WhenExpression = &variable.Expression{Value: transformation.WhenExpression.Value, AST: ast}
+	}
+
 	for i, fieldDescriptor := range transformation.FieldDescriptors {
 		for j, expression := range fieldDescriptor.Expressions {
 			ast, issues := celEnv.Compile(expression.Value)
@@ -41,27 +60,9 @@ func (b *StaticPluginAnalysisProcessor) ProcessValue(_ context.Context, transfor
 		transformation.FieldDescriptors[i] = fieldDescriptor
 	}
 
-	typ := transformation.GetType()
-	if typ.IsEmpty() {
-		return fmt.Errorf("transformation type after render is empty")
-	}
-
-	obj, err := b.Scheme.NewObject(typ)
+	schema, err := b.compileSchema(transformation.GetType())
 	if err != nil {
-		return fmt.Errorf("creating transformation type %q: %w", typ.String(), err)
-	}
-	jsonSchemaIntrospectable := obj.(runtime.JSONSchemaIntrospectable)
-	typeSchema, err := jsonschema.UnmarshalJSON(bytes.NewReader(jsonSchemaIntrospectable.JSONSchema()))
-	if err != nil {
-		return fmt.Errorf("unmarshaling JSON schema for transformation type %q: %w", typ.String(), err)
-	}
-	compiler := jsonschema.NewCompiler()
-	if err := compiler.AddResource(typ.String(), typeSchema); err != nil {
-		return fmt.Errorf("adding JSON schema resource for transformation type %q: %w", typ.String(), err)
-	}
-	schema, err := compiler.Compile(typ.String())
-	if err != nil {
-		return fmt.Errorf("compiling JSON schema for transformation type %q: %w", typ.String(), err)
+		return err
 	}
 	transformation.Schema = schema
 
@@ -108,3 +109,64 @@ func (b *StaticPluginAnalysisProcessor) ProcessValue(_ context.Context, transfor
 
 	return nil
 }
+
+// processForEach declares a transformation with forEach as the list of its instances.
+func (b *StaticPluginAnalysisProcessor) processForEach(transformation graph.Transformation) error {
+	schema, err := b.compileSchema(transformation.GetType())
+	if err != nil {
+		return err
+	}
+	transformation.Schema = schema
+
+	declType := stv6jsonschema.NewSchemaDeclType(schema)
+	b.Builder.RegisterDeclTypes(declType)
+	b.Builder.RegisterEnvOption(cel.Variable(transformation.ID, cel.ListType(declType.CelType())))
+
+	b.AnalyzedTransformations[transformation.ID] = transformation
+	return nil
+}
+
+func (b *StaticPluginAnalysisProcessor) compileSchema(typ runtime.Type) (*jsonschema.Schema, error) {
+	if typ.IsEmpty() {
+		return nil, fmt.Errorf("transformation type after render is empty")
+	}
+
+	rawSchema, err := b.jsonSchema(typ)
+	if err != nil {
+		return nil, err
+	}
+	typeSchema, err := jsonschema.UnmarshalJSON(bytes.NewReader(rawSchema))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling JSON schema for transformation type %q: %w", typ.String(), err)
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(typ.String(), typeSchema); err != nil {
+		return nil, fmt.Errorf("adding JSON schema resource for transformation type %q: %w", typ.String(), err)
+	}
+	schema, err := compiler.Compile(typ.String())
+	if err != nil {
+		return nil, fmt.Errorf("compiling JSON schema for transformation type %q: %w", typ.String(), err)
+	}
+	return schema, nil
+}
+
+// jsonSchema returns the JSON schema of a transformation type registered in the Scheme,
+// or, if the type is not registered there, the one of the resolver.
+func (b *StaticPluginAnalysisProcessor) jsonSchema(typ runtime.Type) ([]byte, error) {
+	if b.Resolver != nil && !b.Scheme.IsRegistered(typ) {
+		schema, err := b.Resolver.GetTransformationJSONSchema(typ)
+		if err != nil {
+			return nil, fmt.Errorf("resolving transformation type %q: %w", typ.String(), err)
+		}
+		return schema, nil
+	}
+	obj, err := b.Scheme.NewObject(typ)
+	if err != nil {
+		return nil, fmt.Errorf("creating transformation type %q: %w", typ.String(), err)
+	}
+	jsonSchemaIntrospectable, ok := obj.(runtime.JSONSchemaIntrospectable)
+	if !ok {
+		return nil, fmt.Errorf("transformation type %q does not provide a JSON schema", typ.String())
+	}
+	return jsonSchemaIntrospectable.JSONSchema(), nil
+}
@@ -2,7 +2,10 @@ package builder
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/google/cel-go/cel"
 
@@ -19,6 +22,7 @@ import (
 type Builder struct {
 	scheme       *runtime.Scheme
 	transformers map[runtime.Type]graphRuntime.Transformer
+	resolver     graphRuntime.TransformerResolver
 	events       chan graphRuntime.ProgressEvent
 }
 
@@ -29,26 +33,32 @@ func NewBuilder(scheme *runtime.Scheme) *Builder {
 func (b *Builder) BuildAndCheck(original *v1alpha1.TransformationGraphDefinition) (*Graph, error) {
 	tgd := original.DeepCopy()
 
-	nodes, err := getTransformationNodes(tgd)
+	environmentData := tgd.GetEnvironmentData()
+	builder, err := graphEnv.NewEnvBuilder(environmentData)
 	if err != nil {
 		return nil, err
 	}
-
-	g := dag.NewDirectedAcyclicGraph[string]()
-	for _, node := range nodes {
-		if err := g.AddVertex(node.ID, map[string]any{syncdag.AttributeValue: node}); err != nil {
-			return nil, err
-		}
+	env, _, err := builder.CurrentEnv()
+	if err != nil {
+		return nil, err
 	}
-	environmentData := tgd.GetEnvironmentData()
-	builder, err := graphEnv.NewEnvBuilder(environmentData)
+
+	expanded, instances, err := expandForEach(env, tgd.Transformations)
 	if err != nil {
 		return nil, err
 	}
-	env, _, err := builder.CurrentEnv()
+	tgd.Transformations = expanded
+	nodes, err := getTransformationNodes(expanded, instances)
 	if err != nil {
 		return nil, err
 	}
+
+	g := dag.NewDirectedAcyclicGraph[string]()
+	for _, node := range nodes {
+		if err := g.AddVertex(node.ID, map[string]any{syncdag.AttributeValue: node}); err != nil {
+			return nil, err
+		}
+	}
 	if err := discoverDependencies(g, env); err != nil {
 		return nil, fmt.Errorf("error discovering dependencies: %w", err)
 	}
@@ -57,6 +67,7 @@ func (b *Builder) BuildAndCheck(original *v1alpha1.TransformationGraphDefinition
 
 	pluginProcessor := &analysis.StaticPluginAnalysisProcessor{
 		Scheme:                  b.scheme,
+		Resolver:                b.resolver,
 		Builder:                 builder,
 		AnalyzedTransformations: make(map[string]graph.Transformation),
 	}
@@ -79,9 +90,11 @@ func (b *Builder) BuildAndCheck(original *v1alpha1.TransformationGraphDefinition
 	}
 
 	return &Graph{
+		definition:   tgd,
 		env:          env,
 		checked:      g,
 		transformers: b.transformers,
+		resolver:     b.resolver,
 		events:       b.events,
 	}, nil
 }
@@ -105,23 +118,35 @@ func (b *Builder) WithTransformer(typed interface {
 	return b
 }
 
+// WithTransformerResolver sets the resolver for transformation types that are neither registered in the scheme
+// nor with WithTransformer, for example types provided by plugins.
+// Their schemas are resolved while building the graph, their transformers only when a transformation is executed.
+func (b *Builder) WithTransformerResolver(resolver graphRuntime.TransformerResolver) *Builder {
+	b.resolver = resolver
+	return b
+}
+
 type Graph struct {
+	definition   *v1alpha1.TransformationGraphDefinition
 	env          *cel.Env
 	checked      *dag.DirectedAcyclicGraph[string]
 	transformers map[runtime.Type]graphRuntime.Transformer
+	resolver     graphRuntime.TransformerResolver
 	events       chan graphRuntime.ProgressEvent
 }
 
 func (g *Graph) Process(ctx context.Context) error {
 	synced := syncdag.ToSyncedGraph(g.checked)
+	rt := &graphRuntime.Runtime{
+		Environment:              g.env,
+		Transformers:             g.transformers,
+		Resolver:                 g.resolver,
+		EvaluatedExpressionCache: make(map[string]any),
+		EvaluatedTransformations: make(map[string]any),
+		Events:                   g.events,
+	}
 	runtimeEvaluationProcessor := syncdag.NewGraphProcessor(synced, &syncdag.GraphProcessorOptions[string, graph.Transformation]{
-		Processor: &graphRuntime.Runtime{
-			Environment:              g.env,
-			Transformers:             g.transformers,
-			EvaluatedExpressionCache: make(map[string]any),
-			EvaluatedTransformations: make(map[string]any),
-			Events:                   g.events,
-		},
+		Processor:   rt,
 		Concurrency: 1,
 	})
 
@@ -130,7 +155,40 @@ func (g *Graph) Process(ctx context.Context) error {
 		close(g.events)
 	}
 
-	return err
+	// transformations that continue on error fail the graph once everything else was processed.
+	return errors.Join(append([]error{err}, rt.Failures()...)...)
+}
+
+// Render returns the transformation graph definition with every expression evaluated that does not
+// depend on the output of another transformation, for example expressions referring to the environment.
+// Transformations with forEach are replaced by their instances.
+// It does not execute any transformation and can be used to review a graph before calling Process.
+func (g *Graph) Render() (*v1alpha1.TransformationGraphDefinition, error) {
+	ids := slices.Collect(maps.Keys(g.checked.Vertices))
+	rendered := &v1alpha1.TransformationGraphDefinition{
+		Environment:     g.definition.Environment.DeepCopy(),
+		Transformations: make([]v1alpha1.GenericTransformation, 0, len(g.definition.Transformations)),
+	}
+	for _, original := range g.definition.Transformations {
+		if original.ForEach != "" {
+			// forEach transformations are rendered as their instances.
+			continue
+		}
+		vertex, ok := g.checked.Vertices[original.ID]
+		if !ok {
+			return nil, fmt.Errorf("transformation %q not found in graph", original.ID)
+		}
+		transformation, ok := vertex.Attributes[syncdag.AttributeValue].(graph.Transformation)
+		if !ok {
+			return nil, fmt.Errorf("transformation %q was not analyzed", original.ID)
+		}
+		renderedTransformation, err := graphRuntime.Render(g.env, transformation, ids)
+		if err != nil {
+			return nil, err
+		}
+		rendered.Transformations = append(rendered.Transformations, *renderedTransformation)
+	}
+	return rendered, nil
 }
 
 // WithEvents sets the channel where progress events will be sent during Process().
@@ -145,7 +203,22 @@ func (g *Graph) Events() <-chan graphRuntime.ProgressEvent {
 	return g.events
 }
 
-// NodeCount returns the total number of nodes in the graph.
+// DAG returns the checked graph. The value of every vertex is its analyzed graph.Transformation and
+// edges point from a transformation to the transformations that refer to it in their expressions.
+// The graph must not be modified.
+func (g *Graph) DAG() *dag.DirectedAcyclicGraph[string] {
+	return g.checked
+}
+
+// NodeCount returns the total number of executed nodes in the graph.
+// Transformations with forEach are not counted, their instances are.
 func (g *Graph) NodeCount() int {
-	return len(g.checked.Vertices)
+	count := 0
+	for _, vertex := range g.checked.Vertices {
+		if transformation, ok := vertex.Attributes[syncdag.AttributeValue].(graph.Transformation); ok && transformation.IsForEach() {
+			continue
+		}
+		count++
+	}
+	return count
 }
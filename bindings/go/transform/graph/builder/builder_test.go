@@ -1,6 +1,8 @@
 package builder
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -8,6 +10,7 @@ import (
 	"ocm.software/open-component-model/bindings/go/transform/graph/internal/testutils"
 	graphRuntime "ocm.software/open-component-model/bindings/go/transform/graph/runtime"
 	"ocm.software/open-component-model/bindings/go/transform/spec/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/transform/spec/v1alpha1/meta"
 	"sigs.k8s.io/yaml"
 )
 
@@ -445,3 +448,361 @@ transformations:
 		require.NoError(t, graph.Process(t.Context()))
 	})
 }
+
+func TestGraph_Render(t *testing.T) {
+	r := require.New(t)
+	tgd := &v1alpha1.TransformationGraphDefinition{}
+	r.NoError(yaml.Unmarshal([]byte(`
+environment:
+  name: "my-object"
+  version: "1.0.0"
+transformations:
+- id: get1
+  type: MockGetObjectTransformer/v1alpha1
+  spec:
+    name: "prefix-${environment.name}"
+    version: "${environment.version}"
+- id: add1
+  type: MockAddObjectTransformer/v1alpha1
+  spec:
+    object: ${get1.output.object}
+`), tgd))
+
+	graph, err := newTestBuilder(t).BuildAndCheck(tgd)
+	r.NoError(err)
+
+	rendered, err := graph.Render()
+	r.NoError(err)
+	r.Equal(tgd.Environment, rendered.Environment)
+	r.Len(rendered.Transformations, 2)
+
+	r.Equal("get1", rendered.Transformations[0].ID)
+	r.Equal(map[string]any{
+		"name":    "prefix-my-object",
+		"version": "1.0.0",
+	}, rendered.Transformations[0].Spec.Data)
+
+	r.Equal("add1", rendered.Transformations[1].ID)
+	r.Equal(map[string]any{
+		"object": "${get1.output.object}",
+	}, rendered.Transformations[1].Spec.Data, "expressions referring to other transformations must be kept")
+
+	r.Equal("${environment.version}", tgd.Transformations[0].Spec.Data["version"], "the definition must not be modified")
+	r.NoError(graph.Process(t.Context()), "a rendered graph can still be processed")
+}
+
+// processWithEvents processes the graph and returns the last event of every transformation.
+func processWithEvents(t *testing.T, tgd *v1alpha1.TransformationGraphDefinition) map[string]graphRuntime.ProgressEvent {
+	t.Helper()
+	events := make(chan graphRuntime.ProgressEvent, 2*len(tgd.Transformations)+16)
+	graph, err := newTestBuilder(t).WithEvents(events).BuildAndCheck(tgd)
+	require.NoError(t, err)
+	require.NoError(t, graph.Process(t.Context()))
+
+	last := map[string]graphRuntime.ProgressEvent{}
+	for event := range events {
+		last[event.Transformation.ID] = event
+	}
+	return last
+}
+
+func TestGraph_When(t *testing.T) {
+	r := require.New(t)
+	tgd := &v1alpha1.TransformationGraphDefinition{}
+	r.NoError(yaml.Unmarshal([]byte(`
+environment:
+  enabled: false
+transformations:
+- id: get1
+  type: MockGetObjectTransformer/v1alpha1
+  when: ${environment.enabled}
+  spec:
+    name: "my-object"
+    version: "1.0.0"
+- id: add1
+  type: MockAddObjectTransformer/v1alpha1
+  spec:
+    object: ${get1.output.object}
+- id: add2
+  type: MockAddObjectTransformer/v1alpha1
+  when: ${!has(get1.output)}
+  spec:
+    object:
+      name: fallback
+`), tgd))
+
+	events := processWithEvents(t, tgd)
+	r.Equal(graphRuntime.Skipped, events["get1"].State)
+	r.Equal(graphRuntime.Skipped, events["add1"].State, "dependants without when are skipped with their dependency")
+	r.Equal(graphRuntime.Completed, events["add2"].State, "dependants with when decide themselves")
+
+	t.Run("invalid when", func(t *testing.T) {
+		for name, when := range map[string]string{
+			"not an expression": "true",
+			"not a bool":        "${environment.enabled ? 'yes' : 'no'}",
+		} {
+			t.Run(name, func(t *testing.T) {
+				invalid := tgd.DeepCopy()
+				invalid.Transformations[0].When = when
+				_, err := newTestBuilder(t).BuildAndCheck(invalid)
+				require.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestGraph_ForEach(t *testing.T) {
+	r := require.New(t)
+	tgd := &v1alpha1.TransformationGraphDefinition{}
+	r.NoError(yaml.Unmarshal([]byte(`
+environment:
+  suffix: "object"
+  objects:
+  - name: a
+    version: "1.0.0"
+  - name: b
+    version: "2.0.0"
+  - name: c
+    version: "3.0.0"
+transformations:
+- id: objects
+  type: MockGetObjectTransformer/v1alpha1
+  forEach: ${environment.objects}
+  when: ${item.name != "b"}
+  spec:
+    name: "${item.name}-${index}-${environment.suffix}"
+    version: ${item.version}
+- id: copies
+  type: MockAddObjectTransformer/v1alpha1
+  forEach: ${environment.objects}
+  spec:
+    object:
+      name: "${has(objects[index].output) ? objects[index].output.object.name : item.name}"
+- id: first
+  type: MockAddObjectTransformer/v1alpha1
+  spec:
+    object: ${objects.filter(o, has(o.output)).map(o, o.output.object)[1]}
+`), tgd))
+
+	graph, err := newTestBuilder(t).BuildAndCheck(tgd)
+	r.NoError(err)
+	r.Equal(7, graph.NodeCount(), "forEach transformations are only counted by their instances")
+
+	rendered, err := graph.Render()
+	r.NoError(err)
+	var ids []string
+	for _, transformation := range rendered.Transformations {
+		ids = append(ids, transformation.ID)
+	}
+	r.Equal([]string{"objects0", "objects1", "objects2", "copies0", "copies1", "copies2", "first"}, ids)
+	r.Equal("${true}", rendered.Transformations[0].When)
+	r.Equal("${false}", rendered.Transformations[1].When)
+	r.Equal(map[string]any{"name": "a-0-object", "version": "1.0.0"}, rendered.Transformations[0].Spec.Data)
+	r.Equal(map[string]any{"name": "c-2-object", "version": "3.0.0"}, rendered.Transformations[2].Spec.Data)
+	r.Contains(rendered.Transformations[4].Spec.Data["object"].(map[string]any)["name"], "cel.bind(",
+		"items needed in expressions depending on other transformations are bound")
+
+	events := processWithEvents(t, tgd)
+	r.Equal(graphRuntime.Completed, events["objects0"].State)
+	r.Equal(graphRuntime.Skipped, events["objects1"].State)
+	r.Equal(graphRuntime.Completed, events["objects2"].State)
+	r.NotContains(events, "objects", "forEach transformations do not emit events")
+
+	r.Equal("a-0-object", events["copies0"].Transformation.Spec.Data["object"].(map[string]any)["name"])
+	r.Equal("b", events["copies1"].Transformation.Spec.Data["object"].(map[string]any)["name"])
+	r.Equal("c-2-object", events["first"].Transformation.Spec.Data["object"].(map[string]any)["name"])
+
+	t.Run("forEach must only refer to the environment", func(t *testing.T) {
+		invalid := tgd.DeepCopy()
+		invalid.Transformations[1].ForEach = "${objects}"
+		_, err := newTestBuilder(t).BuildAndCheck(invalid)
+		require.ErrorContains(t, err, "may only refer to the environment")
+	})
+
+	t.Run("forEach must evaluate to a list", func(t *testing.T) {
+		invalid := tgd.DeepCopy()
+		invalid.Transformations[0].ForEach = "${environment.suffix}"
+		_, err := newTestBuilder(t).BuildAndCheck(invalid)
+		require.ErrorContains(t, err, "must evaluate to a list")
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		empty := tgd.DeepCopy()
+		empty.Environment.Data["objects"] = []any{}
+		empty.Transformations = empty.Transformations[:1]
+		graph, err := newTestBuilder(t).BuildAndCheck(empty)
+		require.NoError(t, err)
+		require.Zero(t, graph.NodeCount())
+		require.NoError(t, graph.Process(t.Context()))
+	})
+}
+
+// mockFailingGetObject fails for objects named "fail".
+type mockFailingGetObject struct {
+	*testutils.MockGetObject
+}
+
+func (m *mockFailingGetObject) Transform(ctx context.Context, step runtime.Typed) (runtime.Typed, error) {
+	transformation := &testutils.MockGetObjectTransformer{}
+	if err := m.Scheme.Convert(step, transformation); err != nil {
+		return nil, err
+	}
+	if transformation.Spec.Name == "fail" {
+		return nil, fmt.Errorf("cannot get object %q", transformation.ID)
+	}
+	return m.MockGetObject.Transform(ctx, step)
+}
+
+func TestGraph_ContinueOnError(t *testing.T) {
+	r := require.New(t)
+	scheme := runtime.NewScheme()
+	scheme.MustRegisterScheme(testutils.Scheme)
+	builder := NewBuilder(scheme).
+		WithTransformer(&testutils.MockGetObjectTransformer{}, &mockFailingGetObject{&testutils.MockGetObject{Scheme: scheme}}).
+		WithTransformer(&testutils.MockAddObjectTransformer{}, &testutils.MockAddObject{Scheme: scheme})
+
+	tgd := &v1alpha1.TransformationGraphDefinition{}
+	r.NoError(yaml.Unmarshal([]byte(`
+transformations:
+- id: broken1
+  type: MockGetObjectTransformer/v1alpha1
+  policy:
+    continueOnError: true
+  spec:
+    name: fail
+    version: "1.0.0"
+- id: broken2
+  type: MockGetObjectTransformer/v1alpha1
+  policy:
+    continueOnError: true
+  spec:
+    name: fail
+    version: "1.0.0"
+- id: add1
+  type: MockAddObjectTransformer/v1alpha1
+  spec:
+    object: ${broken1.output.object}
+- id: get1
+  type: MockGetObjectTransformer/v1alpha1
+  spec:
+    name: works
+    version: "1.0.0"
+- id: add2
+  type: MockAddObjectTransformer/v1alpha1
+  spec:
+    object: ${get1.output.object}
+- id: add3
+  type: MockAddObjectTransformer/v1alpha1
+  when: ${broken1.output.object.name == "fail"}
+  spec:
+    object: ${get1.output.object}
+- id: fallback
+  type: MockGetObjectTransformer/v1alpha1
+  when: ${!has(broken2.output)}
+  spec:
+    name: fallback
+    version: "1.0.0"
+`), tgd))
+
+	events := make(chan graphRuntime.ProgressEvent, 32)
+	graph, err := builder.WithEvents(events).BuildAndCheck(tgd)
+	r.NoError(err)
+
+	err = graph.Process(t.Context())
+	r.ErrorContains(err, `transformation "broken1" failed`)
+	r.ErrorContains(err, `transformation "broken2" failed`, "every failed transformation is reported")
+
+	last := map[string]graphRuntime.State{}
+	for event := range events {
+		last[event.Transformation.ID] = event.State
+	}
+	r.Equal(graphRuntime.Failed, last["broken1"])
+	r.Equal(graphRuntime.Failed, last["broken2"])
+	r.Equal(graphRuntime.Skipped, last["add1"], "dependants of failed transformations are skipped")
+	r.Equal(graphRuntime.Completed, last["get1"], "independent transformations are executed")
+	r.Equal(graphRuntime.Completed, last["add2"])
+	r.Equal(graphRuntime.Skipped, last["add3"], "a when expression reading the output of a failed transformation skips")
+	r.Equal(graphRuntime.Completed, last["fallback"], "a when expression can react to a failed transformation")
+
+	t.Run("invalid policy", func(t *testing.T) {
+		invalid := tgd.DeepCopy()
+		invalid.Transformations[0].Policy.Timeout = "soon"
+		_, err := newTestBuilder(t).BuildAndCheck(invalid)
+		require.ErrorContains(t, err, `invalid policy of transformation "broken1"`)
+	})
+}
+
+// mockResolver provides the mock add transformation as if it was executed by a plugin.
+type mockResolver struct {
+	transformer graphRuntime.Transformer
+	resolved    int
+}
+
+func (m *mockResolver) GetTransformationJSONSchema(typ runtime.Type) ([]byte, error) {
+	if typ != testutils.MockAddObjectV1alpha1 {
+		return nil, fmt.Errorf("unknown type %s", typ)
+	}
+	return (&testutils.MockAddObjectTransformer{}).JSONSchema(), nil
+}
+
+func (m *mockResolver) GetTransformer(_ context.Context, typ runtime.Type) (graphRuntime.Transformer, error) {
+	if _, err := m.GetTransformationJSONSchema(typ); err != nil {
+		return nil, err
+	}
+	m.resolved++
+	return m.transformer, nil
+}
+
+func TestGraph_TransformerResolver(t *testing.T) {
+	r := require.New(t)
+	// the builder only knows the get transformation, the add transformation is resolved.
+	scheme := runtime.NewScheme()
+	scheme.MustRegisterWithAlias(&testutils.MockGetObjectTransformer{}, testutils.MockGetObjectV1alpha1)
+	fullScheme := runtime.NewScheme()
+	fullScheme.MustRegisterScheme(testutils.Scheme)
+	resolver := &mockResolver{transformer: &testutils.MockAddObject{Scheme: fullScheme}}
+	builder := NewBuilder(scheme).
+		WithTransformer(&testutils.MockGetObjectTransformer{}, &testutils.MockGetObject{Scheme: scheme}).
+		WithTransformerResolver(resolver)
+
+	tgd := &v1alpha1.TransformationGraphDefinition{}
+	r.NoError(yaml.Unmarshal([]byte(`
+transformations:
+- id: get1
+  type: MockGetObjectTransformer/v1alpha1
+  spec:
+    name: my-object
+    version: "1.0.0"
+- id: add1
+  type: MockAddObjectTransformer/v1alpha1
+  spec:
+    object: ${get1.output.object}
+`), tgd))
+
+	graph, err := builder.BuildAndCheck(tgd)
+	r.NoError(err)
+	r.Zero(resolver.resolved, "transformers are only resolved when executed")
+
+	r.NoError(graph.Process(t.Context()))
+	r.Equal(1, resolver.resolved)
+
+	t.Run("type check against resolved schema", func(t *testing.T) {
+		invalid := tgd.DeepCopy()
+		invalid.Transformations = append(invalid.Transformations, v1alpha1.GenericTransformation{
+			TransformationMeta: meta.TransformationMeta{ID: "get2", Type: testutils.MockGetObjectV1alpha1},
+			Spec: &runtime.Unstructured{Data: map[string]any{
+				"name":    "${add1.output.nonExisting}",
+				"version": "1.0.0",
+			}},
+		})
+		_, err := builder.BuildAndCheck(invalid)
+		require.ErrorContains(t, err, "nonExisting")
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		unknown := tgd.DeepCopy()
+		unknown.Transformations[1].Type = runtime.NewVersionedType("Unknown", "v1")
+		_, err := builder.BuildAndCheck(unknown)
+		require.ErrorContains(t, err, `resolving transformation type "Unknown/v1": unknown type Unknown/v1`)
+	})
+}
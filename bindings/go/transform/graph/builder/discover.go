@@ -16,23 +16,43 @@ import (
 	"ocm.software/open-component-model/bindings/go/transform/spec/v1alpha1"
 )
 
-func getTransformationNodes(tgd *v1alpha1.TransformationGraphDefinition) (map[string]graph.Transformation, error) {
-	transformations := make(map[string]graph.Transformation, len(tgd.Transformations))
-	for _, transformation := range tgd.Transformations {
+func getTransformationNodes(generic []v1alpha1.GenericTransformation, instances map[string][]string) (map[string]graph.Transformation, error) {
+	transformations := make(map[string]graph.Transformation, len(generic))
+	for _, transformation := range generic {
 		typ := transformation.GetType()
 		if typ.IsEmpty() {
 			return nil, fmt.Errorf("transformations type is empty")
 		}
+		if _, exists := transformations[transformation.ID]; exists {
+			return nil, fmt.Errorf("duplicate transformation ID %s", transformation.ID)
+		}
+		if err := transformation.Policy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid policy of transformation %q: %w", transformation.ID, err)
+		}
+		if transformation.ForEach != "" {
+			// the spec of a forEach transformation is only evaluated for its instances.
+			transformations[transformation.ID] = graph.Transformation{
+				GenericTransformation: transformation,
+				Instances:             instances[transformation.ID],
+			}
+			continue
+		}
+		var when *variable.Expression
+		if transformation.When != "" {
+			expression, err := standaloneExpression(transformation.When)
+			if err != nil {
+				return nil, fmt.Errorf("invalid when of transformation %q: %w", transformation.ID, err)
+			}
+			when = &variable.Expression{Value: expression}
+		}
 		fieldDescriptors, err := parser.ParseSchemaless(transformation.Spec.Data)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse resource of type %s: %w", typ, err)
 		}
-		if _, exists := transformations[transformation.ID]; exists {
-			return nil, fmt.Errorf("duplicate transformation ID %s", transformation.ID)
-		}
 		transformations[transformation.ID] = graph.Transformation{
 			GenericTransformation: transformation,
 			FieldDescriptors:      fieldDescriptors,
+			WhenExpression:        when,
 		}
 	}
 
@@ -60,6 +80,21 @@ func discoverDependencies(g *dag.DirectedAcyclicGraph[string], env *cel.Env) err
 			}
 			ttransformation.Expressions = append(ttransformation.Expressions, expressions...)
 		}
+		if ttransformation.WhenExpression != nil {
+			expressions, err := discoverExpressions(inspector, g, id, variable.FieldDescriptor{
+				Expressions: []variable.Expression{*ttransformation.WhenExpression},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to discover when expression of transformation %q: %w", id, err)
+			}
+			ttransformation.WhenExpressions = expressions
+		}
+		for _, instance := range ttransformation.Instances {
+			if err := g.AddEdge(instance, id); err != nil {
+				return err
+			}
+		}
+		vertex.Attributes[syncdag.AttributeValue] = ttransformation
 	}
 
 	return nil
@@ -0,0 +1,335 @@
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+
+	ast "ocm.software/open-component-model/bindings/go/cel/expression/inspector"
+	"ocm.software/open-component-model/bindings/go/cel/expression/parser"
+	graphRuntime "ocm.software/open-component-model/bindings/go/transform/graph/runtime"
+	"ocm.software/open-component-model/bindings/go/transform/spec/v1alpha1"
+)
+
+const (
+	// itemVariable refers to the current item in the expressions of a forEach transformation.
+	itemVariable = "item"
+	// indexVariable refers to the position of the current item in the expressions of a forEach transformation.
+	indexVariable = "index"
+)
+
+// expandForEach replaces every transformation with a forEach expression by one instance per item
+// of the evaluated list, followed by the forEach transformation itself that later aggregates the instances.
+// It returns the expanded transformations and the IDs of the instances of each forEach transformation.
+//
+// Expressions of an instance that refer to item or index are partially evaluated,
+// so that every instance is a regular transformation that can be analyzed and executed on its own.
+// Everything that depends on other transformations stays an expression; where the item is still
+// needed, for example in a branch that depends on another transformation, it is bound with cel.bind.
+func expandForEach(env *cel.Env, transformations []v1alpha1.GenericTransformation) ([]v1alpha1.GenericTransformation, map[string][]string, error) {
+	ids := make([]string, 0, len(transformations))
+	for _, transformation := range transformations {
+		ids = append(ids, transformation.ID)
+	}
+
+	expanded := make([]v1alpha1.GenericTransformation, 0, len(transformations))
+	instances := make(map[string][]string)
+	for _, transformation := range transformations {
+		if transformation.ForEach == "" {
+			expanded = append(expanded, transformation)
+			continue
+		}
+		items, err := evaluateForEach(env, ids, transformation)
+		if err != nil {
+			return nil, nil, err
+		}
+		instances[transformation.ID] = make([]string, 0, len(items))
+		for index, item := range items {
+			instance, err := newInstance(transformation, item, index)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to expand item %d of transformation %q: %w", index, transformation.ID, err)
+			}
+			expanded = append(expanded, *instance)
+			instances[transformation.ID] = append(instances[transformation.ID], instance.ID)
+		}
+		aggregate := transformation.DeepCopy()
+		aggregate.When = ""
+		expanded = append(expanded, *aggregate)
+	}
+	return expanded, instances, nil
+}
+
+// evaluateForEach evaluates the forEach expression of a transformation against the environment.
+func evaluateForEach(env *cel.Env, ids []string, transformation v1alpha1.GenericTransformation) ([]any, error) {
+	expression, err := standaloneExpression(transformation.ForEach)
+	if err != nil {
+		return nil, fmt.Errorf("invalid forEach of transformation %q: %w", transformation.ID, err)
+	}
+	inspection, err := ast.NewInspectorWithEnv(env, ids).Inspect(expression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect forEach of transformation %q: %w", transformation.ID, err)
+	}
+	if len(inspection.ResourceDependencies) > 0 {
+		return nil, fmt.Errorf("forEach of transformation %q refers to transformation %q, but may only refer to the environment",
+			transformation.ID, inspection.ResourceDependencies[0].ID)
+	}
+	compiled, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("cannot compile forEach of transformation %q: %w", transformation.ID, issues.Err())
+	}
+	program, err := env.Program(compiled)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create program for forEach of transformation %q: %w", transformation.ID, err)
+	}
+	result, _, err := program.Eval(map[string]any{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate forEach of transformation %q: %w", transformation.ID, err)
+	}
+	value, err := graphRuntime.GoNativeValue(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert forEach of transformation %q to go native type: %w", transformation.ID, err)
+	}
+	items, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("forEach of transformation %q must evaluate to a list, got %T", transformation.ID, value)
+	}
+	return items, nil
+}
+
+// newInstance creates the instance of a forEach transformation for the item at the given index.
+func newInstance(transformation v1alpha1.GenericTransformation, item any, index int) (*v1alpha1.GenericTransformation, error) {
+	binder, err := newItemBinder(item, index)
+	if err != nil {
+		return nil, err
+	}
+	instance := transformation.DeepCopy()
+	instance.ID = fmt.Sprintf("%s%d", transformation.ID, index)
+	instance.ForEach = ""
+	if instance.When != "" {
+		when, err := binder.bindString(instance.When)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind when: %w", err)
+		}
+		whenString, ok := when.(string)
+		if !ok {
+			// the item made the guard static, keep it an expression so it stays a bool.
+			whenString = fmt.Sprintf("${%v}", when)
+		}
+		instance.When = whenString
+	}
+	if instance.Spec != nil {
+		data, err := binder.bind(instance.Spec.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind spec: %w", err)
+		}
+		instance.Spec.Data = data.(map[string]any)
+	}
+	return instance, nil
+}
+
+// itemBinder substitutes item and index in the expressions of a forEach instance.
+type itemBinder struct {
+	env       *cel.Env
+	inspector *ast.Inspector
+	values    map[string]any
+}
+
+func newItemBinder(item any, index int) (*itemBinder, error) {
+	// Expressions are only parsed, so the environment does not need to know any declaration.
+	env, err := cel.NewEnv(cel.OptionalTypes(), ext.Bindings())
+	if err != nil {
+		return nil, err
+	}
+	return &itemBinder{
+		env:       env,
+		inspector: ast.NewInspectorWithEnv(env, []string{itemVariable, indexVariable}),
+		values:    map[string]any{itemVariable: item, indexVariable: index},
+	}, nil
+}
+
+func (b *itemBinder) bind(value any) (any, error) {
+	switch typed := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(typed))
+		for key, v := range typed {
+			bound, err := b.bind(v)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = bound
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(typed))
+		for i, v := range typed {
+			bound, err := b.bind(v)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = bound
+		}
+		return out, nil
+	case string:
+		return b.bindString(typed)
+	default:
+		return value, nil
+	}
+}
+
+// bindString binds all expressions in a string. A standalone expression that only depends on the
+// item is replaced by its value, in a string template such values are inlined.
+func (b *itemBinder) bindString(str string) (any, error) {
+	expressions, err := parser.ExtractExpressions(str)
+	if err != nil {
+		return nil, err
+	}
+	if len(expressions) == 0 {
+		return str, nil
+	}
+	standalone, err := parser.IsStandaloneExpression(str)
+	if err != nil {
+		return nil, err
+	}
+
+	var out strings.Builder
+	cursor := 0
+	for _, expression := range expressions {
+		token := "${" + expression + "}"
+		start := cursor + strings.Index(str[cursor:], token)
+		out.WriteString(str[cursor:start])
+		cursor = start + len(token)
+
+		value, known, residual, err := b.bindExpression(expression)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind expression %q: %w", expression, err)
+		}
+		switch {
+		case known && standalone:
+			return value, nil
+		case known:
+			// the same formatting as used by the resolver for string templates.
+			fmt.Fprintf(&out, "%v", value)
+		default:
+			out.WriteString("${" + residual + "}")
+		}
+	}
+	out.WriteString(str[cursor:])
+	return out.String(), nil
+}
+
+// bindExpression partially evaluates an expression with the item and index known.
+// If the expression does not depend on anything else, its value is returned as known.
+// Otherwise, the residual expression is returned.
+func (b *itemBinder) bindExpression(expression string) (any, bool, string, error) {
+	inspection, err := b.inspector.Inspect(expression)
+	if err != nil {
+		return nil, false, "", err
+	}
+	if len(inspection.ResourceDependencies) == 0 {
+		return nil, false, expression, nil
+	}
+
+	parsed, issues := b.env.Parse(expression)
+	if issues.Err() != nil {
+		return nil, false, "", issues.Err()
+	}
+	result, details, err := b.evaluate(parsed, inspection)
+	if err != nil {
+		return nil, false, "", err
+	}
+	if !types.IsUnknown(result) {
+		value, err := graphRuntime.GoNativeValue(result)
+		if err != nil {
+			return nil, false, "", err
+		}
+		return value, true, "", nil
+	}
+
+	residual := expression
+	// Not every expression can be pruned, for example comprehensions with unknown results.
+	// These keep the original expression with the item bound below.
+	if residualAST, err := b.env.ResidualAst(parsed, details); err == nil {
+		if residual, err = cel.AstToString(residualAST); err != nil {
+			return nil, false, "", err
+		}
+	}
+
+	remaining, err := b.inspector.Inspect(residual)
+	if err != nil {
+		return nil, false, "", err
+	}
+	for _, name := range []string{indexVariable, itemVariable} {
+		if !dependsOn(remaining, name) {
+			continue
+		}
+		literal, err := b.literal(name)
+		if err != nil {
+			return nil, false, "", err
+		}
+		residual = fmt.Sprintf("cel.bind(%s, %s, %s)", name, literal, residual)
+	}
+	return nil, false, residual, nil
+}
+
+// evaluate evaluates an expression with item and index known and every other identifier unknown.
+func (b *itemBinder) evaluate(parsed *cel.Ast, inspection ast.ExpressionInspection) (ref.Val, *cel.EvalDetails, error) {
+	unknown := make([]*cel.AttributePatternType, 0, len(inspection.UnknownResources))
+	for _, resource := range inspection.UnknownResources {
+		unknown = append(unknown, cel.AttributePattern(resource.ID))
+	}
+	activation, err := cel.PartialVars(b.values, unknown...)
+	if err != nil {
+		return nil, nil, err
+	}
+	program, err := b.env.Program(parsed, cel.EvalOptions(cel.OptPartialEval, cel.OptTrackState))
+	if err != nil {
+		return nil, nil, err
+	}
+	result, details, err := program.Eval(activation)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, details, nil
+}
+
+// literal returns the CEL literal of the value of a variable.
+func (b *itemBinder) literal(name string) (string, error) {
+	parsed, issues := b.env.Parse(name)
+	if issues.Err() != nil {
+		return "", issues.Err()
+	}
+	_, details, err := b.evaluate(parsed, ast.ExpressionInspection{})
+	if err != nil {
+		return "", err
+	}
+	residual, err := b.env.ResidualAst(parsed, details)
+	if err != nil {
+		return "", err
+	}
+	return cel.AstToString(residual)
+}
+
+func dependsOn(inspection ast.ExpressionInspection, id string) bool {
+	for _, dependency := range inspection.ResourceDependencies {
+		if dependency.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// standaloneExpression returns the expression of a string that consists of exactly one "${...}" expression.
+func standaloneExpression(str string) (string, error) {
+	standalone, err := parser.IsStandaloneExpression(str)
+	if err != nil {
+		return "", err
+	}
+	if !standalone {
+		return "", fmt.Errorf("%q must be a single expression of the form ${...}", str)
+	}
+	return str[len("${") : len(str)-len("}")], nil
+}
@@ -34,6 +34,7 @@ var (
 		"externalRefs",
 		"externalReferences",
 		"graph",
+		"index",
 		"instance",
 		"item",
 		"items",
@@ -3,6 +3,7 @@ package env
 import (
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
 
 	"ocm.software/open-component-model/bindings/go/cel/jsonschema/decl"
 	"ocm.software/open-component-model/bindings/go/cel/jsonschema/provider"
@@ -43,6 +44,8 @@ func (envBuilder *Builder) RegisterEnvOption(envOptions ...cel.EnvOption) *Build
 func (envBuilder *Builder) CurrentEnv() (*cel.Env, *provider.DeclTypeProvider, error) {
 	baseEnv, err := cel.NewEnv(
 		cel.OptionalTypes(),
+		// cel.bind keeps the items of expanded forEach transformations, see builder.expandForEach.
+		ext.Bindings(),
 	)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,75 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+
+	"ocm.software/open-component-model/bindings/go/cel/expression/variable"
+	"ocm.software/open-component-model/bindings/go/transform/graph"
+	"ocm.software/open-component-model/bindings/go/transform/graph/runtime/resolver"
+	"ocm.software/open-component-model/bindings/go/transform/spec/v1alpha1"
+)
+
+// Render evaluates all expressions of an analyzed transformation that can be evaluated without
+// executing a transformation, for example expressions that only refer to the environment.
+// Expressions that refer to one of the given transformation IDs are unknown until the referenced
+// transformation was executed and are kept as they are.
+//
+// The transformation itself is not modified; the rendered transformation is returned.
+func Render(env *cel.Env, transformation graph.Transformation, transformationIDs []string) (*v1alpha1.GenericTransformation, error) {
+	unknown := make([]*cel.AttributePatternType, 0, len(transformationIDs))
+	for _, id := range transformationIDs {
+		unknown = append(unknown, cel.AttributePattern(id))
+	}
+	activation, err := cel.PartialVars(map[string]any{}, unknown...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create activation: %w", err)
+	}
+
+	evaluated := make(map[string]any)
+	var resolvable []variable.FieldDescriptor
+	for _, fieldDescriptor := range transformation.FieldDescriptors {
+		known := true
+		for _, expression := range fieldDescriptor.Expressions {
+			if _, found := evaluated[expression.String()]; found {
+				continue
+			}
+			if expression.AST == nil {
+				return nil, fmt.Errorf("expression %q of transformation %q was not analyzed", expression.String(), transformation.ID)
+			}
+			program, err := env.Program(expression.AST, cel.EvalOptions(cel.OptPartialEval))
+			if err != nil {
+				return nil, fmt.Errorf("failed to create program for expression %q: %w", expression.String(), err)
+			}
+			result, _, err := program.Eval(activation)
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate expression %q: %w", expression.String(), err)
+			}
+			if types.IsUnknown(result) {
+				known = false
+				continue
+			}
+			val, err := GoNativeValue(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert result of expression %q to go native type: %w", expression.String(), err)
+			}
+			evaluated[expression.String()] = val
+		}
+		if known {
+			resolvable = append(resolvable, fieldDescriptor)
+		}
+	}
+
+	rendered := transformation.GenericTransformation.DeepCopy()
+	if rendered.Spec == nil {
+		return rendered, nil
+	}
+	res := resolver.NewResolver(rendered.Spec.Data, evaluated, specSubSchema(transformation.Schema))
+	if summary := res.Resolve(resolvable); len(summary.Errors) > 0 {
+		return nil, fmt.Errorf("failed to render transformation %q: %w", transformation.ID, errors.Join(summary.Errors...))
+	}
+	return rendered, nil
+}
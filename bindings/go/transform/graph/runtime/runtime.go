@@ -4,6 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/santhosh-tekuri/jsonschema/v6"
@@ -22,6 +26,16 @@ type Transformer interface {
 	) (runtime.Typed, error)
 }
 
+// TransformerResolver provides transformers for transformation types that are not registered
+// with the builder, for example transformations executed by plugins.
+type TransformerResolver interface {
+	// GetTransformationJSONSchema returns the JSON schema of the complete transformation including its spec and output.
+	// It is used for static analysis and must not execute anything.
+	GetTransformationJSONSchema(typ runtime.Type) ([]byte, error)
+	// GetTransformer returns the transformer for the type. It is only called once a transformation of the type is executed.
+	GetTransformer(ctx context.Context, typ runtime.Type) (Transformer, error)
+}
+
 // State represents the state of a transformation node.
 type State int
 
@@ -33,6 +47,8 @@ func (s State) String() string {
 		return "completed"
 	case Failed:
 		return "failed"
+	case Skipped:
+		return "skipped"
 	default:
 		return fmt.Sprintf("unknown(%d)", s)
 	}
@@ -45,6 +61,9 @@ const (
 	Completed
 	// Failed means the transformation failed.
 	Failed
+	// Skipped means the transformation was not executed because its when expression
+	// evaluated to false or because it depends on a skipped transformation.
+	Skipped
 )
 
 // ProgressEvent represents a state change during graph execution.
@@ -59,19 +78,47 @@ type Runtime struct {
 	EvaluatedExpressionCache map[string]any
 	EvaluatedTransformations map[string]any
 
+	// SkippedTransformations contains the IDs of the skipped transformations.
+	SkippedTransformations map[string]struct{}
+	// FailedTransformations contains the errors of the transformations that failed,
+	// but let the graph continue with their policy.
+	FailedTransformations map[string]error
+
 	Transformers map[runtime.Type]Transformer
-	Events       chan<- ProgressEvent
+	// Resolver provides transformers for types that are not in Transformers.
+	Resolver TransformerResolver
+	Events   chan<- ProgressEvent
 }
 
 func (b *Runtime) ProcessValue(ctx context.Context, transformation graph.Transformation) error {
+	if transformation.IsForEach() {
+		b.aggregate(transformation)
+		return nil
+	}
+
 	t := &transformation
 	if b.Events != nil {
 		b.Events <- ProgressEvent{Transformation: t, State: Running}
 	}
-	if err := b.processTransformation(ctx, transformation); err != nil {
+	skip, err := b.shouldSkip(transformation)
+	if err == nil && skip {
+		b.skip(transformation)
+		if b.Events != nil {
+			b.Events <- ProgressEvent{Transformation: t, State: Skipped}
+		}
+		return nil
+	}
+	if err == nil {
+		err = b.processWithPolicy(ctx, transformation)
+	}
+	if err != nil {
 		if b.Events != nil {
 			b.Events <- ProgressEvent{Transformation: t, State: Failed, Err: err}
 		}
+		if transformation.Policy.ShouldContinueOnError() {
+			b.fail(transformation, err)
+			return nil
+		}
 		return err
 	}
 
@@ -81,6 +128,141 @@ func (b *Runtime) ProcessValue(ctx context.Context, transformation graph.Transfo
 	return nil
 }
 
+// Failures returns the errors of all transformations that failed but let the graph continue, ordered by ID.
+func (b *Runtime) Failures() []error {
+	ids := slices.Sorted(maps.Keys(b.FailedTransformations))
+	errs := make([]error, 0, len(ids))
+	for _, id := range ids {
+		errs = append(errs, b.FailedTransformations[id])
+	}
+	return errs
+}
+
+// processWithPolicy processes a transformation with the retries and timeout of its policy.
+func (b *Runtime) processWithPolicy(ctx context.Context, transformation graph.Transformation) error {
+	policy := transformation.Policy
+	backoff, err := policy.GetBackoff()
+	if err != nil {
+		return err
+	}
+	timeout, err := policy.GetTimeout()
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		err = b.processAttempt(ctx, transformation, timeout)
+		if err == nil || attempt >= policy.GetRetries() || ctx.Err() != nil {
+			return err
+		}
+		slog.WarnContext(ctx, "transformation failed, retrying",
+			"id", transformation.ID, "attempt", attempt+1, "backoff", backoff, "error", err)
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (b *Runtime) processAttempt(ctx context.Context, transformation graph.Transformation, timeout time.Duration) error {
+	if timeout == 0 {
+		return b.processTransformation(ctx, transformation)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := b.processTransformation(attemptCtx, transformation)
+	if err != nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return fmt.Errorf("transformation %q did not finish within %s: %w", transformation.ID, timeout, err)
+	}
+	return err
+}
+
+// shouldSkip evaluates the when expression of a transformation. A transformation whose spec refers to
+// a failed transformation is always skipped. Without a when expression, a transformation is skipped if it
+// depends on a skipped transformation. A when expression that cannot be evaluated because it refers to the
+// output of a failed transformation skips the transformation as well.
+func (b *Runtime) shouldSkip(transformation graph.Transformation) (bool, error) {
+	for _, dependency := range transformation.SpecDependencies() {
+		if _, failed := b.FailedTransformations[dependency]; failed {
+			return true, nil
+		}
+	}
+	if transformation.WhenExpression == nil {
+		for _, dependency := range transformation.Dependencies() {
+			if _, skipped := b.SkippedTransformations[dependency]; skipped {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	program, err := b.Environment.Program(transformation.WhenExpression.AST)
+	if err != nil {
+		return false, fmt.Errorf("failed to create program for when expression %q: %w", transformation.WhenExpression.String(), err)
+	}
+	result, _, err := program.Eval(b.EvaluatedTransformations)
+	if err != nil {
+		if failed := b.failedDependencies(transformation); len(failed) > 0 {
+			slog.Debug("skipping transformation, its when expression refers to failed transformations",
+				"id", transformation.ID, "failed", failed, "error", err)
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to evaluate when expression %q: %w", transformation.WhenExpression.String(), err)
+	}
+	run, ok := result.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("when expression %q of transformation %q evaluated to %v instead of bool",
+			transformation.WhenExpression.String(), transformation.ID, result.Value())
+	}
+	return !run, nil
+}
+
+// skip records a skipped transformation. Its value only contains the id and type, but no spec or output.
+func (b *Runtime) skip(transformation graph.Transformation) {
+	if b.SkippedTransformations == nil {
+		b.SkippedTransformations = make(map[string]struct{})
+	}
+	b.SkippedTransformations[transformation.ID] = struct{}{}
+	b.EvaluatedTransformations[transformation.ID] = map[string]any{
+		"id":   transformation.ID,
+		"type": transformation.GetType().String(),
+	}
+}
+
+// failedDependencies returns the IDs of the failed transformations the transformation depends on.
+func (b *Runtime) failedDependencies(transformation graph.Transformation) []string {
+	var failed []string
+	for _, dependency := range transformation.Dependencies() {
+		if _, ok := b.FailedTransformations[dependency]; ok {
+			failed = append(failed, dependency)
+		}
+	}
+	return failed
+}
+
+// fail records a transformation that failed but lets the graph continue.
+// Like a skipped transformation, its value only contains the id and type.
+func (b *Runtime) fail(transformation graph.Transformation, err error) {
+	if b.FailedTransformations == nil {
+		b.FailedTransformations = make(map[string]error)
+	}
+	b.FailedTransformations[transformation.ID] = fmt.Errorf("transformation %q failed: %w", transformation.ID, err)
+	b.EvaluatedTransformations[transformation.ID] = map[string]any{
+		"id":   transformation.ID,
+		"type": transformation.GetType().String(),
+	}
+}
+
+// aggregate sets the value of a transformation with forEach to the list of the values of its instances.
+func (b *Runtime) aggregate(transformation graph.Transformation) {
+	values := make([]any, 0, len(transformation.Instances))
+	for _, instance := range transformation.Instances {
+		values = append(values, b.EvaluatedTransformations[instance])
+	}
+	b.EvaluatedTransformations[transformation.ID] = values
+}
+
 func (b *Runtime) processTransformation(ctx context.Context, transformation graph.Transformation) error {
 	for _, fieldDescriptor := range transformation.FieldDescriptors {
 		for _, expression := range fieldDescriptor.Expressions {
@@ -126,11 +308,13 @@ func (b *Runtime) processTransformation(ctx context.Context, transformation grap
 		return fmt.Errorf("transformation type after render is empty")
 	}
 
-	transformer, ok := b.Transformers[runtimeType]
-	if !ok {
-		return fmt.Errorf("no transformer runtime registered for type %s", runtimeType)
+	transformer, err := b.transformer(ctx, runtimeType)
+	if err != nil {
+		return err
 	}
 
+	// when, forEach and the policy are applied by the graph, transformers only get the transformation itself.
+	transformation.When, transformation.ForEach, transformation.Policy = "", "", nil
 	transformed, err := transformer.Transform(ctx, transformation.AsRaw())
 	if err != nil {
 		return fmt.Errorf("failed to transform transformation %q: %w", transformation.ID, err)
@@ -156,6 +340,21 @@ func (b *Runtime) processTransformation(ctx context.Context, transformation grap
 	return nil
 }
 
+// transformer returns the registered transformer for the type or, if there is none, the one of the resolver.
+func (b *Runtime) transformer(ctx context.Context, typ runtime.Type) (Transformer, error) {
+	if transformer, ok := b.Transformers[typ]; ok {
+		return transformer, nil
+	}
+	if b.Resolver == nil {
+		return nil, fmt.Errorf("no transformer runtime registered for type %s", typ)
+	}
+	transformer, err := b.Resolver.GetTransformer(ctx, typ)
+	if err != nil {
+		return nil, fmt.Errorf("no transformer runtime available for type %s: %w", typ, err)
+	}
+	return transformer, nil
+}
+
 // specSubSchema extracts the "spec" sub-schema from a full transformation
 // schema. The resolver works with Spec.Data (the contents of the spec field),
 // so the schema passed to it must match that level. Returns nil if the spec
@@ -95,3 +95,74 @@ func TestProcessValueEvents(t *testing.T) {
 		require.NoError(t, rt.ProcessValue(t.Context(), transformation), "ProcessValue should succeed without events")
 	})
 }
+
+// mockFlakyTransformer fails until it was called failures+1 times.
+type mockFlakyTransformer struct {
+	Transformer
+	failures int
+	calls    int
+}
+
+func (m *mockFlakyTransformer) Transform(ctx context.Context, step runtime.Typed) (runtime.Typed, error) {
+	m.calls++
+	if m.calls <= m.failures {
+		return nil, fmt.Errorf("attempt %d failed", m.calls)
+	}
+	return m.Transformer.Transform(ctx, step)
+}
+
+type mockBlockingTransformer struct{}
+
+func (m *mockBlockingTransformer) Transform(ctx context.Context, _ runtime.Typed) (runtime.Typed, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestProcessValuePolicy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	scheme.MustRegisterScheme(testutils.Scheme)
+
+	t.Run("retries until the transformation succeeds", func(t *testing.T) {
+		flaky := &mockFlakyTransformer{Transformer: &testutils.MockGetObject{Scheme: scheme}, failures: 2}
+		rt := newTestRuntime(t, flaky, nil)
+		transformation := newTestTransformation(t)
+		transformation.Policy = &v1alpha1.Policy{Retries: 2, Backoff: "1ms"}
+
+		require.NoError(t, rt.ProcessValue(t.Context(), transformation))
+		require.Equal(t, 3, flaky.calls)
+	})
+
+	t.Run("fails after all retries", func(t *testing.T) {
+		flaky := &mockFlakyTransformer{Transformer: &testutils.MockGetObject{Scheme: scheme}, failures: 5}
+		rt := newTestRuntime(t, flaky, nil)
+		transformation := newTestTransformation(t)
+		transformation.Policy = &v1alpha1.Policy{Retries: 1, Backoff: "1ms"}
+
+		require.ErrorContains(t, rt.ProcessValue(t.Context(), transformation), "attempt 2 failed")
+		require.Equal(t, 2, flaky.calls)
+	})
+
+	t.Run("timeout limits every attempt", func(t *testing.T) {
+		rt := newTestRuntime(t, &mockBlockingTransformer{}, nil)
+		transformation := newTestTransformation(t)
+		transformation.Policy = &v1alpha1.Policy{Timeout: "10ms"}
+
+		err := rt.ProcessValue(t.Context(), transformation)
+		require.ErrorContains(t, err, "did not finish within 10ms")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("continue on error records the failure", func(t *testing.T) {
+		events := make(chan ProgressEvent, 2)
+		rt := newTestRuntime(t, &mockFailingTransformer{}, events)
+		transformation := newTestTransformation(t)
+		transformation.Policy = &v1alpha1.Policy{ContinueOnError: true}
+
+		require.NoError(t, rt.ProcessValue(t.Context(), transformation))
+		require.Equal(t, Running, (<-events).State)
+		require.Equal(t, Failed, (<-events).State)
+		require.Len(t, rt.Failures(), 1)
+		require.ErrorContains(t, rt.Failures()[0], `transformation "test1" failed`)
+		require.NotContains(t, rt.EvaluatedTransformations["test1"], "output")
+	})
+}
@@ -11,6 +11,40 @@ import (
 type Transformation struct {
 	v1alpha1.GenericTransformation
 	FieldDescriptors []variable.FieldDescriptor
-	Expressions      []inspector.ExpressionInspection
-	Schema           *jsonschema.Schema
+	// Expressions are the inspected expressions of the spec of the transformation.
+	Expressions []inspector.ExpressionInspection
+	Schema      *jsonschema.Schema
+	// WhenExpression is the when expression without "${" and "}", nil if the transformation is not guarded.
+	WhenExpression *variable.Expression
+	// WhenExpressions are the inspected expressions of the when expression.
+	WhenExpressions []inspector.ExpressionInspection
+	// Instances are the IDs of the transformations a forEach transformation was expanded into.
+	Instances []string
+}
+
+// IsForEach reports whether the transformation was expanded into instances.
+// It is not executed itself, its value is the list of the values of its instances.
+func (t *Transformation) IsForEach() bool {
+	return t.ForEach != ""
+}
+
+// Dependencies returns the IDs of all transformations the expressions of the transformation refer to.
+func (t *Transformation) Dependencies() []string {
+	return append(t.SpecDependencies(), dependencies(t.WhenExpressions)...)
+}
+
+// SpecDependencies returns the IDs of the transformations the spec of the transformation refers to.
+// Unlike the when expression, the spec cannot be rendered without their values.
+func (t *Transformation) SpecDependencies() []string {
+	return dependencies(t.Expressions)
+}
+
+func dependencies(expressions []inspector.ExpressionInspection) []string {
+	var ids []string
+	for _, expression := range expressions {
+		for _, dependency := range expression.ResourceDependencies {
+			ids = append(ids, dependency.ID)
+		}
+	}
+	return ids
 }
@@ -29,8 +29,27 @@ func (tgd *TransformationGraphDefinition) GetEnvironmentData() map[string]interf
 // +ocm:typegen=true
 type GenericTransformation struct {
 	meta.TransformationMeta `json:",inline"`
-	Spec                    *runtime.Unstructured `json:"spec"`
-	Output                  *runtime.Unstructured `json:"output,omitempty"`
+	// When is an optional CEL expression such as "${environment.sign}" that
+	// must evaluate to a bool. If it evaluates to false, the transformation is
+	// skipped. Transformations depending on a skipped transformation without a
+	// When expression of their own are skipped as well. A skipped
+	// transformation has no output, which can be checked with
+	// "${has(transformation.output)}".
+	When string `json:"when,omitempty"`
+	// ForEach is an optional CEL expression that evaluates to a list and may
+	// only refer to the environment. The transformation is expanded into one
+	// transformation per item with the ID suffixed by the item index, such as
+	// "upload0", "upload1". The expressions of each expanded transformation can
+	// refer to the current item with "item" and to its position with "index".
+	// The ID of the transformation itself refers to the list of all expanded
+	// transformations, for example "${upload.map(u, u.output.descriptor)}".
+	ForEach string `json:"forEach,omitempty"`
+	// Policy configures retries, timeouts and whether the graph continues if
+	// the transformation fails. Without a policy, a failing transformation
+	// fails the graph immediately.
+	Policy *Policy               `json:"policy,omitempty"`
+	Spec   *runtime.Unstructured `json:"spec"`
+	Output *runtime.Unstructured `json:"output,omitempty"`
 }
 
 func (t *GenericTransformation) AsRaw() *runtime.Raw {
@@ -0,0 +1,86 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultBackoff is the delay before the first retry of a failed transformation.
+const DefaultBackoff = time.Second
+
+// Policy configures how the graph runtime handles a failing transformation.
+// +k8s:deepcopy-gen=true
+type Policy struct {
+	// Retries is how often a failed transformation is retried.
+	Retries int `json:"retries,omitempty"`
+	// Backoff is the delay before the first retry, for example "5s".
+	// It doubles after every retry. Defaults to 1s.
+	Backoff string `json:"backoff,omitempty"`
+	// Timeout limits every attempt of the transformation, for example "10m".
+	// If not set, an attempt is not limited.
+	Timeout string `json:"timeout,omitempty"`
+	// ContinueOnError lets the graph continue if the transformation failed after all retries.
+	// Transformations whose spec refers to it are skipped, independent transformations are still executed.
+	// A transformation that only refers to it in its when expression can react to the failure,
+	// for example with "when: ${!has(id.output)}". If that when expression cannot be evaluated
+	// without the output, the transformation is skipped as well.
+	// The graph fails after all transformations were processed.
+	ContinueOnError bool `json:"continueOnError,omitempty"`
+}
+
+// GetBackoff returns the delay before the first retry.
+func (p *Policy) GetBackoff() (time.Duration, error) {
+	if p == nil || p.Backoff == "" {
+		return DefaultBackoff, nil
+	}
+	return parseDuration("backoff", p.Backoff)
+}
+
+// GetTimeout returns the limit for every attempt, 0 if attempts are not limited.
+func (p *Policy) GetTimeout() (time.Duration, error) {
+	if p == nil || p.Timeout == "" {
+		return 0, nil
+	}
+	return parseDuration("timeout", p.Timeout)
+}
+
+// GetRetries returns how often a failed transformation is retried.
+func (p *Policy) GetRetries() int {
+	if p == nil {
+		return 0
+	}
+	return p.Retries
+}
+
+// ShouldContinueOnError reports whether the graph continues if the transformation failed.
+func (p *Policy) ShouldContinueOnError() bool {
+	return p != nil && p.ContinueOnError
+}
+
+// Validate checks that the policy can be applied.
+func (p *Policy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.Retries < 0 {
+		return fmt.Errorf("retries must not be negative, got %d", p.Retries)
+	}
+	if _, err := p.GetBackoff(); err != nil {
+		return err
+	}
+	if _, err := p.GetTimeout(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func parseDuration(name, value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", name, value, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("%s must not be negative, got %s", name, value)
+	}
+	return d, nil
+}
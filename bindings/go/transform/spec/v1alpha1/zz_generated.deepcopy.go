@@ -13,6 +13,11 @@ import (
 func (in *GenericTransformation) DeepCopyInto(out *GenericTransformation) {
 	*out = *in
 	out.TransformationMeta = in.TransformationMeta
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(Policy)
+		**out = **in
+	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = (*in).DeepCopy()
@@ -42,6 +47,22 @@ func (in *GenericTransformation) DeepCopyTyped() runtime.Typed {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransformationGraphDefinition) DeepCopyInto(out *TransformationGraphDefinition) {
 	*out = *in
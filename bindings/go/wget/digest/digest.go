@@ -0,0 +1,109 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/digestprocessor"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/wget/internal"
+	wgetaccess "ocm.software/open-component-model/bindings/go/wget/spec/access"
+	v1 "ocm.software/open-component-model/bindings/go/wget/spec/access/v1"
+)
+
+var _ digestprocessor.BuiltinDigestProcessorPlugin = (*DigestProcessor)(nil)
+
+// DigestProcessor resolves digests for wget accesses.
+// As the content of a URL can change at any time, the content is downloaded and its digest
+// is pinned both in the digest of the resource and in the access, so that later downloads
+// fail instead of silently returning other content.
+type DigestProcessor struct {
+	client internal.Client
+}
+
+// Option configures a DigestProcessor.
+type Option func(*DigestProcessor)
+
+// WithHTTPConfig sets the HTTP client configuration used for downloads.
+func WithHTTPConfig(cfg *httpv1alpha1.Config) Option {
+	return func(p *DigestProcessor) {
+		p.client.HTTPConfig = cfg
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with all downloads.
+func WithUserAgent(userAgent string) Option {
+	return func(p *DigestProcessor) {
+		p.client.UserAgent = userAgent
+	}
+}
+
+// NewDigestProcessor creates a new wget digest processor.
+func NewDigestProcessor(opts ...Option) *DigestProcessor {
+	p := &DigestProcessor{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (p *DigestProcessor) GetResourceRepositoryScheme() *runtime.Scheme {
+	return wgetaccess.Scheme
+}
+
+// GetResourceDigestProcessorCredentialConsumerIdentity resolves the Wget credential consumer identity
+// from the URL of the resource access.
+func (p *DigestProcessor) GetResourceDigestProcessorCredentialConsumerIdentity(_ context.Context, resource *descriptor.Resource) (runtime.Identity, error) {
+	wget, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	return internal.CredentialConsumerIdentity(wget.URL)
+}
+
+// ProcessResourceDigest downloads the content of the resource and pins its digest.
+// An existing resource digest is verified instead of overwritten.
+// The digest is also written into the access so that the access is immutable afterward.
+func (p *DigestProcessor) ProcessResourceDigest(ctx context.Context, resource *descriptor.Resource, credentials runtime.Typed) (*descriptor.Resource, error) {
+	wget, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	// download with the expected digest of the resource, so that the same algorithm is used.
+	dig, err := p.client.Digest(ctx, wget, credentials, internal.ExpectedDigest(resource.Digest))
+	if err != nil {
+		return nil, err
+	}
+
+	resource = resource.DeepCopy()
+	if resource.Digest == nil {
+		resource.Digest = &descriptor.Digest{}
+		if err := internal.ApplyDigest(resource.Digest, dig); err != nil {
+			return nil, fmt.Errorf("failed to apply digest to resource: %w", err)
+		}
+	} else if err := internal.VerifyDigest(resource.Digest, dig); err != nil {
+		return nil, fmt.Errorf("failed to verify digest of resource: %w", err)
+	}
+
+	wget.Digest = dig.String()
+	resource.Access = wget
+
+	return resource, nil
+}
+
+func convertAccess(resource *descriptor.Resource) (*v1.Wget, error) {
+	if resource == nil || resource.Access == nil {
+		return nil, fmt.Errorf("resource access is required")
+	}
+	var wget v1.Wget
+	if err := wgetaccess.Scheme.Convert(resource.Access, &wget); err != nil {
+		return nil, fmt.Errorf("error converting resource access spec: %w", err)
+	}
+	if wget.URL == "" {
+		return nil, fmt.Errorf("url is required for wget access")
+	}
+	return &wget, nil
+}
@@ -0,0 +1,76 @@
+package digest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	godigest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/wget/digest"
+	v1 "ocm.software/open-component-model/bindings/go/wget/spec/access/v1"
+)
+
+func newResource(url string, dig *descriptor.Digest) *descriptor.Resource {
+	return &descriptor.Resource{
+		ElementMeta: descriptor.ElementMeta{
+			ObjectMeta: descriptor.ObjectMeta{Name: "tool", Version: "1.0.0"},
+		},
+		Type:     "executable",
+		Relation: descriptor.ExternalRelation,
+		Access: &v1.Wget{
+			Type: runtime.NewUnversionedType(v1.LegacyType),
+			URL:  url,
+		},
+		Digest: dig,
+	}
+}
+
+func TestDigestProcessor_ProcessResourceDigest(t *testing.T) {
+	const content = "vendor binary"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(content))
+	}))
+	t.Cleanup(server.Close)
+	contentDigest := godigest.FromString(content)
+
+	processor := digest.NewDigestProcessor()
+
+	t.Run("pins digest", func(t *testing.T) {
+		original := newResource(server.URL+"/tool", nil)
+		processed, err := processor.ProcessResourceDigest(t.Context(), original, nil)
+		require.NoError(t, err)
+
+		assert.Nil(t, original.Digest, "input resource must not be modified")
+		assert.Equal(t, &descriptor.Digest{
+			HashAlgorithm:          "SHA-256",
+			NormalisationAlgorithm: "genericBlobDigest/v1",
+			Value:                  contentDigest.Encoded(),
+		}, processed.Digest)
+
+		access, ok := processed.Access.(*v1.Wget)
+		require.True(t, ok)
+		assert.Equal(t, contentDigest.String(), access.Digest)
+		assert.Equal(t, server.URL+"/tool", access.URL)
+	})
+
+	t.Run("verifies existing digest", func(t *testing.T) {
+		_, err := processor.ProcessResourceDigest(t.Context(), newResource(server.URL+"/tool", &descriptor.Digest{
+			HashAlgorithm:          "SHA-256",
+			NormalisationAlgorithm: "genericBlobDigest/v1",
+			Value:                  godigest.FromString("other").Encoded(),
+		}), nil)
+		require.ErrorContains(t, err, "digest mismatch")
+	})
+
+	t.Run("consumer identity", func(t *testing.T) {
+		identity, err := processor.GetResourceDigestProcessorCredentialConsumerIdentity(t.Context(), newResource("https://example.com/tool", nil))
+		require.NoError(t, err)
+		assert.Equal(t, "example.com", identity[runtime.IdentityAttributeHostname])
+		assert.Equal(t, "tool", identity[runtime.IdentityAttributePath])
+	})
+}
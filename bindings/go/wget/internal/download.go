@@ -0,0 +1,218 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	nethttp "net/http"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+
+	"ocm.software/open-component-model/bindings/go/blob/filesystem"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	ocmhttp "ocm.software/open-component-model/bindings/go/http"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	v1 "ocm.software/open-component-model/bindings/go/wget/spec/access/v1"
+	credsv1 "ocm.software/open-component-model/bindings/go/wget/spec/credentials/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/wget/spec/identity/v1"
+)
+
+// DefaultUserAgent is used for requests if no user agent is configured.
+const DefaultUserAgent = "ocm.software/open-component-model/bindings/go/wget"
+
+// DefaultMediaType is used if neither the access nor the response specify a media type.
+const DefaultMediaType = "application/octet-stream"
+
+// ErrDigestMismatch is returned if downloaded content does not match the expected digest.
+var ErrDigestMismatch = errors.New("digest mismatch")
+
+// CredentialConsumerIdentity resolves the credential consumer identity for the given URL.
+// The identity contains the scheme, hostname, port and path of the URL.
+func CredentialConsumerIdentity(rawURL string) (runtime.Identity, error) {
+	if !strings.HasPrefix(rawURL, "https://") && !strings.HasPrefix(rawURL, "http://") {
+		return nil, fmt.Errorf("url %q must use the http or https scheme", rawURL)
+	}
+	identity, err := runtime.ParseURLToIdentity(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing url to identity: %w", err)
+	}
+	identity.SetType(identityv1.Type)
+	return identity, nil
+}
+
+// Client downloads content referenced by wget accesses.
+type Client struct {
+	HTTPConfig *httpv1alpha1.Config
+	UserAgent  string
+	// TempDir is the directory downloaded content is buffered in.
+	// If empty, the default directory for temporary files is used.
+	TempDir string
+}
+
+// Download fetches the content of the access into a temporary file and verifies it against the
+// digest of the access and the given resource digest, if they are set.
+// The resource digest is only verified if it is a generic blob digest.
+// Besides the blob, the digest of the content is returned. It is calculated with the algorithm of
+// the access digest, of the resource digest, or with the canonical algorithm, in that order.
+func (c *Client) Download(ctx context.Context, access *v1.Wget, credentials runtime.Typed, resourceDigest *descriptor.Digest) (*filesystem.Blob, digest.Digest, error) {
+	content, err := c.open(ctx, access, credentials, ExpectedDigest(resourceDigest))
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = content.Close()
+	}()
+	verify := func() error {
+		if err := content.verify(); err != nil {
+			return err
+		}
+		if ExpectedDigest(resourceDigest) == "" {
+			return nil
+		}
+		return VerifyDigest(resourceDigest, content.digester.Digest())
+	}
+	b, err := copyToTempBlob(c.TempDir, "wget-download-*", content, verify)
+	if err != nil {
+		return nil, "", fmt.Errorf("error downloading %q: %w", access.URL, err)
+	}
+	b.SetMediaType(content.mediaType)
+	return b, content.digester.Digest(), nil
+}
+
+// Digest fetches the content of the access without storing it and verifies it against the
+// digest of the access, if set. The digest of the content is returned. It is calculated with the
+// algorithm of the access digest, of the expected digest, or with the canonical algorithm, in that order.
+func (c *Client) Digest(ctx context.Context, access *v1.Wget, credentials runtime.Typed, expected digest.Digest) (digest.Digest, error) {
+	content, err := c.open(ctx, access, credentials, expected)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = content.Close()
+	}()
+	if _, err := io.Copy(io.Discard, content); err != nil {
+		return "", fmt.Errorf("error downloading %q: %w", access.URL, err)
+	}
+	if err := content.verify(); err != nil {
+		return "", fmt.Errorf("error downloading %q: %w", access.URL, err)
+	}
+	return content.digester.Digest(), nil
+}
+
+func (c *Client) open(ctx context.Context, access *v1.Wget, credentials runtime.Typed, fallback digest.Digest) (*contentReader, error) {
+	if _, err := CredentialConsumerIdentity(access.URL); err != nil {
+		return nil, err
+	}
+	var expected digest.Digest
+	if access.Digest != "" {
+		var err error
+		if expected, err = digest.Parse(access.Digest); err != nil {
+			return nil, fmt.Errorf("invalid digest %q: %w", access.Digest, err)
+		}
+	}
+
+	creds, err := credsv1.ConvertToHTTPCredentials(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("error converting credentials: %w", err)
+	}
+
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, access.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request for %q: %w", access.URL, err)
+	}
+	for key, values := range access.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if creds != nil {
+		switch {
+		case creds.Token != "":
+			req.Header.Set("Authorization", "Bearer "+creds.Token)
+		case creds.Username != "" || creds.Password != "":
+			req.SetBasicAuth(creds.Username, creds.Password)
+		}
+	}
+
+	resp, err := c.client(access.NoRedirect).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading %q: %w", access.URL, err)
+	}
+	if resp.StatusCode != nethttp.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("error downloading %q: unexpected status %s", access.URL, resp.Status)
+	}
+
+	algorithm := digest.Canonical
+	switch {
+	case expected != "":
+		algorithm = expected.Algorithm()
+	case fallback != "":
+		algorithm = fallback.Algorithm()
+	}
+	r := &contentReader{
+		ReadCloser: resp.Body,
+		digester:   algorithm.Digester(),
+		expected:   expected,
+		url:        access.URL,
+		mediaType:  mediaType(access, resp),
+	}
+	r.tee = io.TeeReader(resp.Body, r.digester.Hash())
+	return r, nil
+}
+
+// contentReader hashes the response body while it is read,
+// so that it can be verified without holding it in memory.
+type contentReader struct {
+	io.ReadCloser
+	tee       io.Reader
+	digester  digest.Digester
+	expected  digest.Digest
+	url       string
+	mediaType string
+}
+
+func (r *contentReader) Read(p []byte) (int, error) {
+	return r.tee.Read(p)
+}
+
+// verify checks the content read so far against the digest of the access.
+func (r *contentReader) verify() error {
+	if actual := r.digester.Digest(); r.expected != "" && actual != r.expected {
+		return fmt.Errorf("%w: content of %q has digest %s, expected %s", ErrDigestMismatch, r.url, actual, r.expected)
+	}
+	return nil
+}
+
+func (c *Client) client(noRedirect bool) *nethttp.Client {
+	userAgent := c.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	client := ocmhttp.New(
+		ocmhttp.WithConfig(c.HTTPConfig),
+		ocmhttp.WithUserAgent(userAgent),
+	)
+	if noRedirect {
+		client.CheckRedirect = func(*nethttp.Request, []*nethttp.Request) error {
+			return nethttp.ErrUseLastResponse
+		}
+	}
+	return client
+}
+
+func mediaType(access *v1.Wget, resp *nethttp.Response) string {
+	if access.MediaType != "" {
+		return access.MediaType
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		if mt, _, err := mime.ParseMediaType(contentType); err == nil {
+			return mt
+		}
+	}
+	return DefaultMediaType
+}
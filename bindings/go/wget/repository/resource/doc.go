@@ -0,0 +1,22 @@
+// Package resource implements [repository.ResourceRepository] for content served by generic HTTP/S URLs,
+// such as release assets of vendor binaries.
+//
+// The [ResourceRepository] handles the Wget/v1 access type. Downloads are done with the client of the
+// http bindings, so per-host timeouts and retries of the HTTP configuration apply. The content is
+// verified against the digest pinned in the access and the digest of the resource. It is hashed while
+// it is streamed into a temporary file (see [WithTempDir]), so that large release assets are never held
+// in memory.
+//
+// # Credentials
+//
+// The consumer identity of type Wget carries the scheme, hostname, port and path of the URL.
+// Credentials of type HTTPCredentials/v1 or DirectCredentials with username/password (basic
+// authentication) or token (bearer authentication) are supported.
+//
+// # Usage
+//
+//	repo := resource.NewResourceRepository(resource.WithHTTPConfig(httpConfig))
+//
+//	identity, err := repo.GetResourceCredentialConsumerIdentity(ctx, res)
+//	content, err := repo.DownloadResource(ctx, res, creds)
+package resource
@@ -0,0 +1,110 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/repository"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/wget/internal"
+	wgetaccess "ocm.software/open-component-model/bindings/go/wget/spec/access"
+	v1 "ocm.software/open-component-model/bindings/go/wget/spec/access/v1"
+)
+
+// ResourceRepository implements a resource repository for content served by generic HTTP/S URLs.
+// Downloads use the retrying client of the http bindings, so per-host timeouts and retry
+// policies of the HTTP configuration apply.
+type ResourceRepository struct {
+	client internal.Client
+}
+
+// Option configures a ResourceRepository.
+type Option func(*ResourceRepository)
+
+// WithHTTPConfig sets the HTTP client configuration used for downloads.
+func WithHTTPConfig(cfg *httpv1alpha1.Config) Option {
+	return func(r *ResourceRepository) {
+		r.client.HTTPConfig = cfg
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with all downloads.
+func WithUserAgent(userAgent string) Option {
+	return func(r *ResourceRepository) {
+		r.client.UserAgent = userAgent
+	}
+}
+
+// WithTempDir sets the directory downloaded content is buffered in.
+func WithTempDir(dir string) Option {
+	return func(r *ResourceRepository) {
+		r.client.TempDir = dir
+	}
+}
+
+var _ repository.ResourceRepository = (*ResourceRepository)(nil)
+
+// NewResourceRepository creates a ResourceRepository.
+func NewResourceRepository(opts ...Option) *ResourceRepository {
+	r := &ResourceRepository{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// GetResourceRepositoryScheme returns the wget access scheme containing the
+// Wget/v1 type and its aliases.
+func (r *ResourceRepository) GetResourceRepositoryScheme() *runtime.Scheme {
+	return wgetaccess.Scheme
+}
+
+// GetResourceCredentialConsumerIdentity resolves the Wget credential consumer identity
+// from the scheme, hostname, port and path of the URL of the resource.
+func (r *ResourceRepository) GetResourceCredentialConsumerIdentity(_ context.Context, resource *descriptor.Resource) (runtime.Identity, error) {
+	wget, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+	return internal.CredentialConsumerIdentity(wget.URL)
+}
+
+// DownloadResource fetches the content of the URL in the wget access of the resource.
+// The content is verified against the digest of the access and the generic blob digest
+// of the resource, if present.
+// The content is buffered in a temporary file, which is not removed by the repository.
+func (r *ResourceRepository) DownloadResource(ctx context.Context, resource *descriptor.Resource, credentials runtime.Typed) (blob.ReadOnlyBlob, error) {
+	wget, err := convertAccess(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	data, _, err := r.client.Download(ctx, wget, credentials, resource.Digest)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading resource %s: %w", resource.ToIdentity(), err)
+	}
+	return data, nil
+}
+
+// UploadResource is not supported for wget accesses and always returns an error.
+// There is no standardized way to upload content to a generic URL.
+func (r *ResourceRepository) UploadResource(_ context.Context, _ *descriptor.Resource, _ blob.ReadOnlyBlob, _ runtime.Typed) (*descriptor.Resource, error) {
+	return nil, fmt.Errorf("wget accesses do not support upload operations")
+}
+
+func convertAccess(resource *descriptor.Resource) (*v1.Wget, error) {
+	if resource == nil || resource.Access == nil {
+		return nil, fmt.Errorf("resource access is required")
+	}
+	var wget v1.Wget
+	if err := wgetaccess.Scheme.Convert(resource.Access, &wget); err != nil {
+		return nil, fmt.Errorf("error converting access to wget spec: %w", err)
+	}
+	if wget.URL == "" {
+		return nil, fmt.Errorf("url is required for wget access")
+	}
+	return &wget, nil
+}
@@ -0,0 +1,192 @@
+package resource_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/blob"
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/wget/repository/resource"
+	v1 "ocm.software/open-component-model/bindings/go/wget/spec/access/v1"
+	identityv1 "ocm.software/open-component-model/bindings/go/wget/spec/identity/v1"
+)
+
+const content = "vendor binary"
+
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/releases/tool", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-executable")
+		_, _ = w.Write([]byte(content))
+	})
+	mux.HandleFunc("/private/tool", func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("X-Custom") != "value" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(content))
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/releases/tool", http.StatusFound)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newResource(access *v1.Wget) *descriptor.Resource {
+	access.Type = runtime.NewVersionedType(v1.Type, v1.Version)
+	return &descriptor.Resource{
+		ElementMeta: descriptor.ElementMeta{
+			ObjectMeta: descriptor.ObjectMeta{Name: "tool", Version: "1.0.0"},
+		},
+		Type:     "executable",
+		Relation: descriptor.ExternalRelation,
+		Access:   access,
+	}
+}
+
+func TestResourceRepository_DownloadResource(t *testing.T) {
+	server := newServer(t)
+	contentDigest := digest.FromString(content)
+
+	tests := []struct {
+		name              string
+		access            *v1.Wget
+		digest            *descriptor.Digest
+		credentials       runtime.Typed
+		expectedMediaType string
+		expectedErr       string
+	}{
+		{
+			name:              "media type from response",
+			access:            &v1.Wget{URL: server.URL + "/releases/tool"},
+			expectedMediaType: "application/x-executable",
+		},
+		{
+			name:              "media type from access and pinned digest",
+			access:            &v1.Wget{URL: server.URL + "/releases/tool", MediaType: "application/octet-stream", Digest: contentDigest.String()},
+			expectedMediaType: "application/octet-stream",
+		},
+		{
+			name:   "resource digest",
+			access: &v1.Wget{URL: server.URL + "/releases/tool"},
+			digest: &descriptor.Digest{
+				HashAlgorithm:          "SHA-256",
+				NormalisationAlgorithm: "genericBlobDigest/v1",
+				Value:                  contentDigest.Encoded(),
+			},
+			expectedMediaType: "application/x-executable",
+		},
+		{
+			name:   "credentials and header",
+			access: &v1.Wget{URL: server.URL + "/private/tool", Header: map[string][]string{"X-Custom": {"value"}}},
+			credentials: &credv1.DirectCredentials{
+				Type:       runtime.NewVersionedType(credv1.CredentialsType, credv1.Version),
+				Properties: map[string]string{"username": "user", "password": "pass"},
+			},
+			expectedMediaType: "text/plain",
+		},
+		{
+			name:              "follows redirects",
+			access:            &v1.Wget{URL: server.URL + "/redirect"},
+			expectedMediaType: "application/x-executable",
+		},
+		{
+			name:        "no redirect",
+			access:      &v1.Wget{URL: server.URL + "/redirect", NoRedirect: true},
+			expectedErr: "unexpected status 302 Found",
+		},
+		{
+			name:        "missing credentials",
+			access:      &v1.Wget{URL: server.URL + "/private/tool"},
+			expectedErr: "unexpected status 401 Unauthorized",
+		},
+		{
+			name:        "pinned digest mismatch",
+			access:      &v1.Wget{URL: server.URL + "/releases/tool", Digest: digest.FromString("other").String()},
+			expectedErr: "digest mismatch",
+		},
+		{
+			name:   "resource digest mismatch",
+			access: &v1.Wget{URL: server.URL + "/releases/tool"},
+			digest: &descriptor.Digest{
+				HashAlgorithm:          "SHA-256",
+				NormalisationAlgorithm: "genericBlobDigest/v1",
+				Value:                  digest.FromString("other").Encoded(),
+			},
+			expectedErr: "digest mismatch",
+		},
+		{
+			name:        "unsupported scheme",
+			access:      &v1.Wget{URL: "ftp://example.com/tool"},
+			expectedErr: "must use the http or https scheme",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			repo := resource.NewResourceRepository(resource.WithTempDir(tempDir))
+			res := newResource(tt.access)
+			res.Digest = tt.digest
+			b, err := repo.DownloadResource(t.Context(), res, tt.credentials)
+			entries, dirErr := os.ReadDir(tempDir)
+			require.NoError(t, dirErr)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				assert.Empty(t, entries, "content of failed downloads must not be kept")
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, entries, 1, "content must be buffered in the temporary directory")
+
+			rc, err := b.ReadCloser()
+			require.NoError(t, err)
+			defer func() { _ = rc.Close() }()
+			data, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			assert.Equal(t, content, string(data))
+
+			mediaType, _ := b.(blob.MediaTypeAware).MediaType()
+			assert.Equal(t, tt.expectedMediaType, mediaType)
+		})
+	}
+}
+
+func TestResourceRepository_GetResourceCredentialConsumerIdentity(t *testing.T) {
+	repo := resource.NewResourceRepository()
+
+	identity, err := repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource(&v1.Wget{URL: "https://example.com:8443/releases/download/v1.0.0/tool"}))
+	require.NoError(t, err)
+	assert.Equal(t, runtime.Identity{
+		runtime.IdentityAttributeType:     identityv1.Type.String(),
+		runtime.IdentityAttributeScheme:   "https",
+		runtime.IdentityAttributeHostname: "example.com",
+		runtime.IdentityAttributePort:     "8443",
+		runtime.IdentityAttributePath:     "releases/download/v1.0.0/tool",
+	}, identity)
+
+	_, err = repo.GetResourceCredentialConsumerIdentity(t.Context(), newResource(&v1.Wget{}))
+	require.ErrorContains(t, err, "url is required")
+}
+
+func TestResourceRepository_UploadResource(t *testing.T) {
+	repo := resource.NewResourceRepository()
+	_, err := repo.UploadResource(t.Context(), newResource(&v1.Wget{URL: "https://example.com/tool"}), nil, nil)
+	require.ErrorContains(t, err, "do not support upload")
+}
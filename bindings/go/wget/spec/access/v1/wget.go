@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	Version    = "v1"
+	Type       = "Wget"
+	LegacyType = "wget"
+)
+
+// Wget describes the access for content served by a generic HTTP/S URL, such as release assets.
+// This spec is aligned with ocm v1 https://github.com/open-component-model/ocm/blob/main/api/ocm/extensions/accessmethods/wget/method.go
+//
+// Content behind a URL is mutable. To make the access immutable, the Digest of the content
+// can be pinned. It is verified on every download and filled in by the digest processor if missing.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type Wget struct {
+	// +ocm:jsonschema-gen:enum=Wget/v1,wget/v1
+	// +ocm:jsonschema-gen:enum:deprecated=Wget,wget
+	Type runtime.Type `json:"type"`
+
+	// URL is the HTTP/S location of the content.
+	URL string `json:"url"`
+
+	// MediaType is the media type of the content. If not set, the Content-Type of the response is used.
+	MediaType string `json:"mediaType,omitempty"`
+
+	// Header contains additional HTTP headers sent with the request.
+	// Credentials should not be put here, but provided through the credential resolver.
+	Header map[string][]string `json:"header,omitempty"`
+
+	// NoRedirect disables following redirects.
+	NoRedirect bool `json:"noRedirect,omitempty"`
+
+	// Digest is the expected digest of the content in OCI digest format (e.g. sha256:7173b809...).
+	Digest string `json:"digest,omitempty"`
+}
+
+func (w *Wget) String() string {
+	return w.URL
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"fmt"
+
+	credv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	credentialKeyUsername = "username"
+	credentialKeyPassword = "password"
+	credentialKeyToken    = "token"
+)
+
+var convertScheme = runtime.NewScheme()
+
+func init() {
+	MustRegisterCredentialType(convertScheme)
+	credv1.MustRegister(convertScheme)
+}
+
+func directToHTTPCredentials(properties map[string]string) *HTTPCredentials {
+	return &HTTPCredentials{
+		Type:     runtime.NewVersionedType(HTTPCredentialsType, Version),
+		Username: properties[credentialKeyUsername],
+		Password: properties[credentialKeyPassword],
+		Token:    properties[credentialKeyToken],
+	}
+}
+
+// ConvertToHTTPCredentials converts runtime.Typed credentials into *HTTPCredentials.
+// DirectCredentials are mapped using the HTTP-relevant fields (username, password, token).
+// Returns nil, nil for nil input or input with an empty type.
+func ConvertToHTTPCredentials(creds runtime.Typed) (*HTTPCredentials, error) {
+	if creds == nil || creds.GetType().String() == "" {
+		return nil, nil
+	}
+	typed, err := convertScheme.NewObject(creds.GetType())
+	if err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+	if err = convertScheme.Convert(creds, typed); err != nil {
+		return nil, fmt.Errorf("error converting credential type: %w", err)
+	}
+	switch t := typed.(type) {
+	case *credv1.DirectCredentials:
+		return directToHTTPCredentials(t.Properties), nil
+	case *HTTPCredentials:
+		return t, nil
+	}
+	return nil, fmt.Errorf("unsupported credential type for HTTP: %v", typed.GetType())
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	//nolint:gosec // G101: This is a type name, not a credential.
+	HTTPCredentialsType = "HTTPCredentials"
+	Version             = "v1"
+)
+
+// HTTPCredentials represents typed credentials for HTTP/S servers accessed by the wget access type.
+// Either Username and Password, or Token can be set. A token is sent as bearer token,
+// username and password are sent with basic authentication.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type HTTPCredentials struct {
+	// +ocm:jsonschema-gen:enum=HTTPCredentials/v1
+	// +ocm:jsonschema-gen:enum:deprecated=HTTPCredentials
+	Type     runtime.Type `json:"type"`
+	Username string       `json:"username,omitempty"`
+	Password string       `json:"password,omitempty"`
+	Token    string       `json:"token,omitempty"`
+}
+
+// MustRegisterCredentialType registers HTTPCredentials/v1 in the given scheme.
+func MustRegisterCredentialType(scheme *runtime.Scheme) {
+	scheme.MustRegisterWithAlias(&HTTPCredentials{},
+		runtime.NewVersionedType(HTTPCredentialsType, Version),
+		runtime.NewUnversionedType(HTTPCredentialsType),
+	)
+}
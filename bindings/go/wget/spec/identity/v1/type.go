@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	WgetIdentityType = "Wget"
+	Version          = "v1"
+)
+
+// Type is the unversioned consumer identity type for content accessed via the wget access type (backward compat).
+var Type = runtime.NewUnversionedType(WgetIdentityType)
+
+// VersionedType is the versioned consumer identity type.
+// Identities of this type carry the hostname, scheme, port and path of the URL,
+// so that credentials can be configured per server or per path prefix.
+var VersionedType = runtime.NewVersionedType(WgetIdentityType, Version)
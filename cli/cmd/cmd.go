@@ -17,6 +17,7 @@ import (
 	"ocm.software/open-component-model/cli/cmd/setup/hooks"
 	"ocm.software/open-component-model/cli/cmd/sign"
 	"ocm.software/open-component-model/cli/cmd/transfer"
+	"ocm.software/open-component-model/cli/cmd/transform"
 	"ocm.software/open-component-model/cli/cmd/verify"
 	"ocm.software/open-component-model/cli/cmd/version"
 	"ocm.software/open-component-model/cli/internal/flags/log"
@@ -65,6 +66,7 @@ func New() *cobra.Command {
 	cmd.AddCommand(sign.New())
 	cmd.AddCommand(pluginregistry.New())
 	cmd.AddCommand(transfer.New())
+	cmd.AddCommand(transform.New())
 	cmd.AddCommand(describe.New())
 	return cmd
 }
@@ -12,6 +12,7 @@ import (
 	"math/big"
 	"os"
 	"path/filepath"
+	goruntime "runtime"
 	"slices"
 	"strings"
 	"testing"
@@ -29,9 +30,12 @@ import (
 	ocictf "ocm.software/open-component-model/bindings/go/oci/ctf"
 	ctfv1 "ocm.software/open-component-model/bindings/go/oci/spec/repository/v1/ctf"
 	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/signing/timestamp/tsatest"
 	componentversion "ocm.software/open-component-model/cli/cmd/add/component-version"
 	"ocm.software/open-component-model/cli/cmd/internal/test"
+	"ocm.software/open-component-model/cli/cmd/plugins/installer"
 	ocmctx "ocm.software/open-component-model/cli/internal/context"
+	"ocm.software/open-component-model/cli/internal/verification"
 )
 
 // setupTestRepositoryWithDescriptorLibrary creates a test repository with the given component versions
@@ -1533,6 +1537,444 @@ configurations:
 	r.NoError(err, "failed to verify component version")
 }
 
+func Test_Sign_And_Verify_Component_Version_With_Timestamp(t *testing.T) {
+	r := require.New(t)
+	tmp := t.TempDir()
+	tsa := tsatest.New(t)
+
+	name, version := "ocm.software/timestamped", "1.0.0"
+	constructorYAML := fmt.Sprintf(`
+name: %[1]s
+version: %[2]s
+provider:
+  name: ocm.software
+resources:
+  - name: my-resource
+    type: blob
+    input:
+      type: utf8/v1
+      text: "I want to be timestamped"
+`, name, version)
+
+	constructorYAMLFilePath := filepath.Join(tmp, "component-constructor.yaml")
+	r.NoError(os.WriteFile(constructorYAMLFilePath, []byte(constructorYAML), 0o600))
+
+	archiveFilePath := filepath.Join(tmp, "transport-archive")
+	_, err := test.OCM(t, test.WithArgs("add", "cv",
+		"--constructor", constructorYAMLFilePath,
+		"--repository", archiveFilePath,
+	))
+	r.NoError(err, "could not construct component version")
+
+	rootKey, leafKey := mustKey(t), mustKey(t)
+	root := mustSelfSigned(t, "root", rootKey)
+	leafTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTmpl, root, &leafKey.PublicKey, rootKey)
+	r.NoError(err)
+	leaf, err := x509.ParseCertificate(leafDER)
+	r.NoError(err)
+
+	privateKeyPath, leafPath := writeKeyAndChain(t, t.TempDir(), leafKey, leaf)
+	rootPath := writeCertsPEM(t, tmp, "root.pem", root)
+	tsaRootPath := filepath.Join(tmp, "tsa-root.pem")
+	r.NoError(os.WriteFile(tsaRootPath, tsa.RootPEM(), 0o600))
+	otherTSARootPath := filepath.Join(tmp, "other-tsa-root.pem")
+	r.NoError(os.WriteFile(otherTSARootPath, tsatest.New(t).RootPEM(), 0o600))
+
+	writeConfig := func(file, properties string) string {
+		path := filepath.Join(tmp, file)
+		r.NoError(os.WriteFile(path, []byte(fmt.Sprintf(`
+type: generic.config.ocm.software/v1
+configurations:
+- type: credentials.config.ocm.software
+  consumers:
+  - identity:
+      type: RSA/v1alpha1
+      algorithm: RSASSA-PSS
+      signature: default
+    credentials:
+    - type: Credentials/v1
+      properties:
+%s
+`, properties)), 0o600))
+		return path
+	}
+	signConfig := writeConfig("sign-config.yaml", fmt.Sprintf("        private_key_pem_file: %s\n        public_key_pem_file: %s", privateKeyPath, leafPath))
+	verifyConfig := writeConfig("verify-config.yaml", fmt.Sprintf("        public_key_pem_file: %s", rootPath))
+
+	signerSpecPath := filepath.Join(tmp, "signer-spec.yaml")
+	r.NoError(os.WriteFile(signerSpecPath, []byte("type: RSASigningConfiguration/v1alpha1\nsignatureEncodingPolicy: PEM\n"), 0o600))
+	writeVerifierSpec := func(file, tsaRoot string) string {
+		path := filepath.Join(tmp, file)
+		r.NoError(os.WriteFile(path, []byte(fmt.Sprintf("type: RSAVerificationConfiguration/v1alpha1\ntimestampAuthority:\n  rootCertificatesPEMFile: %s\n", tsaRoot)), 0o600))
+		return path
+	}
+
+	reference := archiveFilePath + "//" + name + ":" + version
+
+	out := new(bytes.Buffer)
+	_, err = test.OCM(t, test.WithArgs("sign", "component-version",
+		reference,
+		"--signer-spec", signerSpecPath,
+		"--tsa-url", tsa.URL,
+		"--config", signConfig),
+		test.WithOutput(out),
+	)
+	r.NoError(err, "failed to sign component version")
+	r.Equal(1, tsa.Requests())
+	r.Contains(out.String(), "timestamp:")
+
+	fs, err := filesystem.NewFS(archiveFilePath, os.O_RDONLY)
+	r.NoError(err)
+	repo, err := oci.NewRepository(ocictf.WithCTF(ocictf.NewFromCTF(ctf.NewFileSystemCTF(fs))))
+	r.NoError(err)
+	desc, err := repo.GetComponentVersion(t.Context(), name, version)
+	r.NoError(err)
+	r.Len(desc.Signatures, 1)
+	r.NotNil(desc.Signatures[0].Timestamp)
+
+	_, err = test.OCM(t, test.WithArgs("verify", "component-version",
+		reference,
+		"--verifier-spec", writeVerifierSpec("verifier-spec.yaml", tsaRootPath),
+		"--config", verifyConfig),
+	)
+	r.NoError(err, "failed to verify timestamped component version")
+
+	_, err = test.OCM(t, test.WithArgs("verify", "component-version",
+		reference,
+		"--verifier-spec", writeVerifierSpec("other-verifier-spec.yaml", otherTSARootPath),
+		"--config", verifyConfig),
+	)
+	r.ErrorContains(err, "verify signature timestamp")
+
+	_, err = test.OCM(t, test.WithArgs("sign", "component-version",
+		reference,
+		"--signer-spec", signerSpecPath,
+		"--force",
+		"--tsa-url", "http://127.0.0.1:0",
+		"--config", signConfig),
+	)
+	r.ErrorContains(err, "timestamping signature failed")
+
+	ecSignerSpecPath := filepath.Join(tmp, "ec-signer-spec.yaml")
+	r.NoError(os.WriteFile(ecSignerSpecPath, []byte("type: ECDSASigningConfiguration/v1alpha1\n"), 0o600))
+	_, err = test.OCM(t, test.WithArgs("sign", "component-version",
+		reference,
+		"--signer-spec", ecSignerSpecPath,
+		"--signature", "ecdsa",
+		"--tsa-url", tsa.URL,
+		"--config", signConfig),
+	)
+	r.ErrorContains(err, "only RSA signatures can be timestamped")
+	r.Equal(1, tsa.Requests())
+}
+
+func Test_Verify_Component_Version_With_Policy(t *testing.T) {
+	r := require.New(t)
+	tmp := t.TempDir()
+
+	constructorYAML := `
+components:
+- name: ocm.software/app
+  version: 1.0.0
+  provider:
+    name: ocm.software
+  componentReferences:
+    - name: lib
+      version: 1.0.0
+      componentName: ocm.software/lib
+- name: ocm.software/lib
+  version: 1.0.0
+  provider:
+    name: ocm.software
+  resources:
+    - name: my-resource
+      type: blob
+      input:
+        type: utf8/v1
+        text: "I am referenced"
+`
+	constructorYAMLFilePath := filepath.Join(tmp, "component-constructor.yaml")
+	r.NoError(os.WriteFile(constructorYAMLFilePath, []byte(constructorYAML), 0o600))
+
+	archiveFilePath := filepath.Join(tmp, "transport-archive")
+	_, err := test.OCM(t, test.WithArgs("add", "cv",
+		"--constructor", constructorYAMLFilePath,
+		"--repository", archiveFilePath,
+	))
+	r.NoError(err, "could not construct component versions")
+
+	var consumers strings.Builder
+	for _, signature := range []string{"build", "qa"} {
+		key := mustKey(t)
+		privateKeyPath, publicKeyPath := writeKeyAndChain(t, t.TempDir(), key, mustSelfSigned(t, signature, key))
+		fmt.Fprintf(&consumers, `
+  - identity:
+      type: RSA/v1alpha1
+      algorithm: RSASSA-PSS
+      signature: %[1]s
+    credentials:
+    - type: Credentials/v1
+      properties:
+        public_key_pem_file: %[2]s
+        private_key_pem_file: %[3]s`, signature, publicKeyPath, privateKeyPath)
+	}
+	configPath := filepath.Join(tmp, "ocm-config.yaml")
+	r.NoError(os.WriteFile(configPath, []byte(`
+type: generic.config.ocm.software/v1
+configurations:
+- type: credentials.config.ocm.software
+  consumers:`+consumers.String()+"\n"), 0o600))
+
+	app := archiveFilePath + "//ocm.software/app:1.0.0"
+	lib := archiveFilePath + "//ocm.software/lib:1.0.0"
+	sign := func(reference, signature string) {
+		_, err := test.OCM(t, test.WithArgs("sign", "component-version", reference, "--signature", signature, "--config", configPath))
+		r.NoError(err, "failed to sign %s with %s", reference, signature)
+	}
+	sign(app, "build")
+	sign(app, "qa")
+	sign(lib, "build")
+
+	writePolicy := func(file, policy string) string {
+		path := filepath.Join(tmp, file)
+		r.NoError(os.WriteFile(path, []byte(policy), 0o600))
+		return path
+	}
+	releaseGate := writePolicy("release-gate.yaml", `
+type: VerificationPolicy/v1alpha1
+recursive: true
+signatures:
+- name: build
+  required: true
+- name: qa
+  required: true
+`)
+	verify := func(policy string, args ...string) (string, error) {
+		out := new(bytes.Buffer)
+		_, err := test.OCM(t, test.WithArgs(append([]string{"verify", "component-version", app, "--policy", policy, "--config", configPath}, args...)...), test.WithOutput(out))
+		return out.String(), err
+	}
+
+	t.Run("missing signature on referenced component version", func(t *testing.T) {
+		out, err := verify(releaseGate, "--output", "json")
+		require.ErrorContains(t, err, "1 of 2 component versions do not satisfy the policy")
+
+		var report verification.Report
+		require.NoError(t, json.Unmarshal([]byte(out), &report))
+		require.False(t, report.Satisfied)
+		require.Len(t, report.ComponentVersions, 2)
+		require.Equal(t, "ocm.software/app", report.ComponentVersions[0].Component)
+		require.True(t, report.ComponentVersions[0].Satisfied)
+		require.Equal(t, "ocm.software/lib", report.ComponentVersions[1].Component)
+		require.False(t, report.ComponentVersions[1].Satisfied)
+		require.Equal(t, verification.ResultVerified, report.ComponentVersions[1].Signatures[0].Result)
+		require.Equal(t, verification.ResultMissing, report.ComponentVersions[1].Signatures[1].Result)
+		require.Contains(t, report.ComponentVersions[1].Error, `required signatures ["qa"] did not verify`)
+	})
+
+	t.Run("threshold", func(t *testing.T) {
+		_, err := verify(writePolicy("one-of-two.yaml", `
+type: VerificationPolicy/v1alpha1
+recursive: true
+threshold: 1
+signatures:
+- name: build
+- name: qa
+`))
+		require.NoError(t, err)
+	})
+
+	t.Run("not recursive", func(t *testing.T) {
+		out, err := verify(writePolicy("root-only.yaml", `
+type: VerificationPolicy/v1alpha1
+signatures:
+- name: build
+- name: qa
+`))
+		require.NoError(t, err)
+		require.Contains(t, out, "ocm.software/app")
+		require.NotContains(t, out, "ocm.software/lib")
+	})
+
+	t.Run("failing verifier", func(t *testing.T) {
+		out, err := verify(writePolicy("trust-policy.yaml", `
+type: VerificationPolicy/v1alpha1
+signatures:
+- name: build
+  verifier:
+    type: RSAVerificationConfiguration/v1alpha1
+    trustPolicy:
+      rootCertificatesPEM: "-----BEGIN CERTIFICATE-----"
+`), "--output", "json")
+		require.Error(t, err)
+		require.Contains(t, out, `"result": "failed"`)
+	})
+
+	t.Run("all signatures present", func(t *testing.T) {
+		sign(lib, "qa")
+		out, err := verify(releaseGate)
+		require.NoError(t, err)
+		require.Contains(t, out, "ocm.software/lib")
+		require.Contains(t, out, "satisfied")
+	})
+
+	t.Run("policy and signature are exclusive", func(t *testing.T) {
+		_, err := verify(releaseGate, "--signature", "build")
+		require.ErrorContains(t, err, "none of the others can be")
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		_, err := verify(writePolicy("invalid.yaml", "type: VerificationPolicy/v1alpha1\nsignatures: []\n"))
+		require.ErrorContains(t, err, "policy lists no signatures")
+	})
+}
+
+func Test_Sign_Component_Version_Recursive(t *testing.T) {
+	r := require.New(t)
+	tmp := t.TempDir()
+
+	reference := func(name string, target *descriptor.Descriptor) descriptor.Reference {
+		return descriptor.Reference{
+			ElementMeta: descriptor.ElementMeta{ObjectMeta: descriptor.ObjectMeta{Name: name, Version: target.Component.Version}},
+			Component:   target.Component.Name,
+		}
+	}
+	// product -> app -> lib, product -> lib
+	setup := func(t *testing.T, modify func(product, app, lib *descriptor.Descriptor)) (string, string) {
+		lib := createTestDescriptor("ocm.software/lib", "1.0.0")
+		app := createTestDescriptor("ocm.software/app", "1.0.0")
+		app.Component.References = []descriptor.Reference{reference("lib", lib)}
+		product := createTestDescriptor("ocm.software/product", "1.0.0")
+		product.Component.References = []descriptor.Reference{reference("app", app), reference("lib", lib)}
+		if modify != nil {
+			modify(product, app, lib)
+		}
+		archivePath, err := setupTestRepositoryWithDescriptorLibrary(t, product, app, lib)
+		require.NoError(t, err)
+		return archivePath, archivePath + "//ocm.software/product:1.0.0"
+	}
+	getDescriptor := func(t *testing.T, archivePath, component string) *descriptor.Descriptor {
+		fs, err := filesystem.NewFS(archivePath, os.O_RDONLY)
+		require.NoError(t, err)
+		repo, err := oci.NewRepository(ocictf.WithCTF(ocictf.NewFromCTF(ctf.NewFileSystemCTF(fs))))
+		require.NoError(t, err)
+		desc, err := repo.GetComponentVersion(t.Context(), component, "1.0.0")
+		require.NoError(t, err)
+		return desc
+	}
+
+	key := mustKey(t)
+	privateKeyPath, publicKeyPath := writeKeyAndChain(t, t.TempDir(), key, mustSelfSigned(t, "signer", key))
+	configPath := filepath.Join(tmp, "ocm-config.yaml")
+	r.NoError(os.WriteFile(configPath, []byte(fmt.Sprintf(`
+type: generic.config.ocm.software/v1
+configurations:
+- type: credentials.config.ocm.software
+  consumers:
+  - identity:
+      type: RSA/v1alpha1
+      algorithm: RSASSA-PSS
+      signature: default
+    credentials:
+    - type: Credentials/v1
+      properties:
+        public_key_pem_file: %[1]s
+        private_key_pem_file: %[2]s
+  - identity:
+      type: RSA/v1alpha1
+      algorithm: RSASSA-PKCS1-V1_5
+      signature: default
+    credentials:
+    - type: Credentials/v1
+      properties:
+        public_key_pem_file: %[1]s
+        private_key_pem_file: %[2]s
+`, publicKeyPath, privateKeyPath)), 0o600))
+	policyPath := filepath.Join(tmp, "policy.yaml")
+	r.NoError(os.WriteFile(policyPath, []byte("type: VerificationPolicy/v1alpha1\nrecursive: true\nsignatures:\n- name: default\n"), 0o600))
+	pkcs1SpecPath := filepath.Join(tmp, "pkcs1.yaml")
+	r.NoError(os.WriteFile(pkcs1SpecPath, []byte("type: RSASigningConfiguration/v1alpha1\nsignatureAlgorithm: RSASSA-PKCS1-V1_5\n"), 0o600))
+
+	sign := func(ref string, args ...string) (string, error) {
+		out := new(bytes.Buffer)
+		_, err := test.OCM(t, test.WithArgs(append([]string{"sign", "component-version", ref, "--config", configPath}, args...)...), test.WithOutput(out))
+		return out.String(), err
+	}
+
+	t.Run("sign references bottom-up", func(t *testing.T) {
+		archivePath, ref := setup(t, nil)
+		out, err := sign(ref, "--recursive", "--sign-references", "--component-signer-spec", "ocm.software/lib="+pkcs1SpecPath)
+		require.NoError(t, err)
+		require.Contains(t, out, "component: ocm.software/product")
+		require.Contains(t, out, "component: ocm.software/lib")
+
+		product, app, lib := getDescriptor(t, archivePath, "ocm.software/product"), getDescriptor(t, archivePath, "ocm.software/app"), getDescriptor(t, archivePath, "ocm.software/lib")
+		require.Equal(t, app.Signatures[0].Digest, product.Component.References[0].Digest)
+		require.Equal(t, lib.Signatures[0].Digest, product.Component.References[1].Digest)
+		require.Equal(t, lib.Signatures[0].Digest, app.Component.References[0].Digest)
+		require.Equal(t, "RSASSA-PKCS1-V1_5", lib.Signatures[0].Signature.Algorithm)
+		require.Equal(t, "RSASSA-PSS", app.Signatures[0].Signature.Algorithm)
+
+		_, err = test.OCM(t, test.WithArgs("verify", "component-version", ref, "--policy", policyPath, "--config", configPath))
+		require.NoError(t, err, "signed tree should satisfy the policy")
+
+		_, err = sign(ref, "--recursive", "--sign-references")
+		require.ErrorContains(t, err, `signature "default" already exists`)
+		_, err = sign(ref, "--recursive", "--sign-references", "--force")
+		require.NoError(t, err)
+	})
+
+	t.Run("sign root only", func(t *testing.T) {
+		archivePath, ref := setup(t, nil)
+		_, err := sign(ref, "--recursive")
+		require.NoError(t, err)
+		require.Len(t, getDescriptor(t, archivePath, "ocm.software/product").Signatures, 1)
+		app := getDescriptor(t, archivePath, "ocm.software/app")
+		require.Empty(t, app.Signatures)
+		require.NotEmpty(t, app.Component.References[0].Digest.Value)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		archivePath, ref := setup(t, nil)
+		_, err := sign(ref, "--recursive", "--sign-references", "--dry-run")
+		require.NoError(t, err)
+		product := getDescriptor(t, archivePath, "ocm.software/product")
+		require.Empty(t, product.Signatures)
+		require.Empty(t, product.Component.References[0].Digest.Value)
+	})
+
+	t.Run("mismatching reference digest", func(t *testing.T) {
+		archivePath, ref := setup(t, func(product, _, _ *descriptor.Descriptor) {
+			product.Component.References[1].Digest = descriptor.Digest{HashAlgorithm: "SHA-256", NormalisationAlgorithm: "jsonNormalisation/v4alpha1", Value: strings.Repeat("0", 64)}
+		})
+		_, err := sign(ref, "--recursive", "--sign-references")
+		require.ErrorContains(t, err, `digest of reference "lib" in`)
+		require.Empty(t, getDescriptor(t, archivePath, "ocm.software/lib").Signatures, "nothing must be persisted")
+	})
+
+	t.Run("reference digests would invalidate signatures", func(t *testing.T) {
+		_, ref := setup(t, func(_, app, _ *descriptor.Descriptor) {
+			app.Signatures = []descriptor.Signature{{Name: "other"}}
+		})
+		_, err := sign(ref, "--recursive", "--sign-references")
+		require.ErrorContains(t, err, `would invalidate its signatures ["other"]`)
+	})
+
+	t.Run("references require recursive", func(t *testing.T) {
+		_, ref := setup(t, nil)
+		_, err := sign(ref, "--sign-references")
+		require.ErrorContains(t, err, "require --recursive")
+	})
+}
+
 func Test_Sign_With_Sigstore_Spec_Selects_Cosign_Handler(t *testing.T) {
 	t.Setenv("SIGSTORE_ID_TOKEN", "")
 	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "")
@@ -2030,3 +2472,191 @@ resources:
 	// Verify referenced component is still accessible
 	_ = referencedDesc
 }
+
+func Test_Plugin_Install_Lifecycle(t *testing.T) {
+	r := require.New(t)
+	tmp := t.TempDir()
+
+	// the fake plugins report no capabilities, so they are loaded by later commands but fail validation.
+	writePlugin := func(version string) string {
+		path := filepath.Join(tmp, "myplugin-"+version)
+		r.NoError(os.WriteFile(path, []byte("#!/bin/sh\n# "+version+"\necho '{\"capabilities\":[]}'\n"), 0o700))
+		return path
+	}
+	pluginComponent := func(version string) string {
+		return fmt.Sprintf(`
+- name: ocm.software/plugins/myplugin
+  version: %[1]s
+  provider:
+    name: ocm.software
+  resources:
+    - name: myplugin
+      version: %[1]s
+      type: ocmPlugin
+      extraIdentity:
+        os: %[2]s
+        architecture: %[3]s
+      input:
+        type: file/v1
+        path: %[4]s
+    - name: myplugin
+      version: %[1]s
+      type: ocmPlugin
+      extraIdentity:
+        os: plan9
+        architecture: %[3]s
+      input:
+        type: utf8/v1
+        text: "wrong platform"`, version, goruntime.GOOS, goruntime.GOARCH, writePlugin(version))
+	}
+	constructorYAML := `
+components:
+- name: ocm.software/plugin-registry
+  version: 1.0.0
+  provider:
+    name: ocm.software
+  componentReferences:
+    - name: myplugin
+      version: 1.0.0
+      componentName: ocm.software/plugins/myplugin
+      labels:
+        - name: ocm.software/pluginInfo
+          value:
+            description: my plugin
+            platforms: [` + goruntime.GOOS + `/` + goruntime.GOARCH + `]
+    - name: myplugin
+      version: 1.1.0
+      componentName: ocm.software/plugins/myplugin
+` + pluginComponent("1.0.0") + pluginComponent("1.1.0") + "\n"
+	constructorYAMLFilePath := filepath.Join(tmp, "component-constructor.yaml")
+	r.NoError(os.WriteFile(constructorYAMLFilePath, []byte(constructorYAML), 0o600))
+
+	archiveFilePath := filepath.Join(tmp, "transport-archive")
+	_, err := test.OCM(t, test.WithArgs("add", "cv",
+		"--constructor", constructorYAMLFilePath,
+		"--repository", archiveFilePath,
+	))
+	r.NoError(err, "could not construct component versions")
+
+	key := mustKey(t)
+	privateKeyPath, publicKeyPath := writeKeyAndChain(t, t.TempDir(), key, mustSelfSigned(t, "release", key))
+	policyPath := filepath.Join(tmp, "plugin-policy.yaml")
+	r.NoError(os.WriteFile(policyPath, []byte(`
+type: VerificationPolicy/v1alpha1
+signatures:
+- name: release
+  required: true
+`), 0o600))
+	configPath := filepath.Join(tmp, "ocm-config.yaml")
+	r.NoError(os.WriteFile(configPath, []byte(fmt.Sprintf(`
+type: generic.config.ocm.software/v1
+configurations:
+- type: credentials.config.ocm.software
+  consumers:
+  - identity:
+      type: RSA/v1alpha1
+      algorithm: RSASSA-PSS
+      signature: release
+    credentials:
+    - type: Credentials/v1
+      properties:
+        public_key_pem_file: %[1]s
+        private_key_pem_file: %[2]s
+- type: plugin.registry.config.ocm.software/v1alpha1
+  registries:
+  - %[3]s//ocm.software/plugin-registry
+  policy: %[4]s
+`, publicKeyPath, privateKeyPath, archiveFilePath, policyPath)), 0o600))
+
+	sign := func(version string) {
+		_, err := test.OCM(t, test.WithArgs("sign", "component-version", archiveFilePath+"//ocm.software/plugins/myplugin:"+version,
+			"--signature", "release", "--config", configPath))
+		r.NoError(err, "failed to sign plugin version %s", version)
+	}
+	sign("1.0.0")
+
+	pluginDir := filepath.Join(tmp, "plugins")
+	pluginPath := filepath.Join(pluginDir, "myplugin")
+	run := func(args ...string) (string, error) {
+		out := new(bytes.Buffer)
+		_, err := test.OCM(t, test.WithArgs(append(args, "--config", configPath, "--plugin-directory", pluginDir)...), test.WithOutput(out))
+		return out.String(), err
+	}
+	requireInstalled := func(version string) {
+		t.Helper()
+		data, err := os.ReadFile(pluginPath)
+		require.NoError(t, err)
+		require.Contains(t, string(data), "# "+version)
+		lock, err := installer.ReadLock(pluginDir)
+		require.NoError(t, err)
+		require.Len(t, lock.Plugins, 1)
+		require.Equal(t, version, lock.Plugins[0].Version)
+		require.Equal(t, "ocm.software/plugins/myplugin", lock.Plugins[0].Component)
+		require.Equal(t, goruntime.GOOS, lock.Plugins[0].Resource["os"])
+		require.True(t, strings.HasPrefix(lock.Plugins[0].Digest, "sha256:"))
+	}
+
+	t.Run("install", func(t *testing.T) {
+		out, err := run("plugin", "install", "myplugin@1.0.0", "--skip-validation")
+		require.NoError(t, err)
+		require.Contains(t, out, "installed plugin myplugin 1.0.0")
+		requireInstalled("1.0.0")
+
+		out, err = run("plugin", "install", "myplugin@1.0.0", "--skip-validation")
+		require.NoError(t, err)
+		require.Contains(t, out, "already installed")
+	})
+
+	t.Run("outdated", func(t *testing.T) {
+		out, err := run("plugins", "outdated", "-o", "json")
+		require.NoError(t, err)
+		var outdated []map[string]string
+		require.NoError(t, json.Unmarshal([]byte(out), &outdated))
+		require.Len(t, outdated, 1)
+		require.Equal(t, "1.0.0", outdated[0]["installed"])
+		require.Equal(t, "1.1.0", outdated[0]["latest"])
+	})
+
+	t.Run("update to unsigned version fails verification", func(t *testing.T) {
+		_, err := run("plugin", "update", "--skip-validation")
+		require.ErrorContains(t, err, "does not satisfy the verification policy")
+		requireInstalled("1.0.0")
+	})
+
+	t.Run("update", func(t *testing.T) {
+		sign("1.1.0")
+		out, err := run("plugin", "update", "--skip-validation")
+		require.NoError(t, err)
+		require.Contains(t, out, "updated plugin myplugin from 1.0.0 to 1.1.0")
+		requireInstalled("1.1.0")
+
+		out, err = run("plugin", "outdated")
+		require.NoError(t, err)
+		require.Contains(t, out, "all installed plugins are up to date")
+	})
+
+	t.Run("invalid plugin binary is not installed", func(t *testing.T) {
+		_, err := run("plugin", "install", "myplugin@1.0.0")
+		require.ErrorContains(t, err, "is not a valid plugin")
+		requireInstalled("1.1.0")
+		entries, err := os.ReadDir(pluginDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 2, "expected only the plugin and the lock file")
+	})
+
+	t.Run("uninstall", func(t *testing.T) {
+		out, err := run("plugin", "uninstall", "myplugin")
+		require.NoError(t, err)
+		require.Contains(t, out, "uninstalled plugin myplugin 1.1.0")
+		require.NoFileExists(t, pluginPath)
+		lock, err := installer.ReadLock(pluginDir)
+		require.NoError(t, err)
+		require.Empty(t, lock.Plugins)
+	})
+
+	t.Run("policy is required", func(t *testing.T) {
+		_, err := test.OCM(t, test.WithArgs("plugin", "install", "myplugin", "--plugin-directory", pluginDir,
+			"--registry", archiveFilePath+"//ocm.software/plugin-registry:1.0.0"))
+		require.ErrorContains(t, err, "no verification policy configured")
+	})
+}
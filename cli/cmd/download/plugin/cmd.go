@@ -2,18 +2,12 @@ package plugin
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
 	"os"
-	"os/exec"
-	"path"
 	"path/filepath"
-	"runtime"
-	"strings"
-	"time"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
@@ -21,28 +15,25 @@ import (
 
 	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
 	"ocm.software/open-component-model/bindings/go/oci/compref"
-	"ocm.software/open-component-model/bindings/go/plugin/manager/types/spec"
 	ocmruntime "ocm.software/open-component-model/bindings/go/runtime"
 	"ocm.software/open-component-model/cli/cmd/download/shared"
+	"ocm.software/open-component-model/cli/cmd/plugins/installer"
 	ocmctx "ocm.software/open-component-model/cli/internal/context"
 	"ocm.software/open-component-model/cli/internal/flags/enum"
 	"ocm.software/open-component-model/cli/internal/repository/ocm"
 )
 
 const (
-	FlagResourceVersion     = "resource-version"
-	FlagOutput              = "output"
-	FlagOutputFormat        = "output-format"
-	FlagPluginType          = "plugin-type"
-	FlagExtraIdentity       = "extra-identity"
-	SkipValidation          = "skip-validation"
-	pluginValidationTimeout = 30 * time.Second
+	FlagResourceVersion = "resource-version"
+	FlagOutput          = "output"
+	FlagOutputFormat    = "output-format"
+	FlagPluginType      = "plugin-type"
+	FlagExtraIdentity   = "extra-identity"
+	SkipValidation      = "skip-validation"
 )
 
 // PluginType is the type of the resource containing the plugin in the component version.
-// This type has been established by OCM v1 here:
-// https://github.com/open-component-model/ocm/blob/bccf3310af0665eaab3f0ea9803e6b903d858d52/api/ocm/extensions/artifacttypes/const.go#L40
-const PluginType = "ocmPlugin"
+const PluginType = installer.PluginType
 
 // pluginDirectoryDefault contains all plugins for ocm.
 var pluginDirectoryDefault = filepath.Join(os.Getenv("HOME"), ".config", "ocm", "plugins")
@@ -57,6 +48,8 @@ func New() *cobra.Command {
 
 This command fetches a specific plugin resource from the given OCM component version and stores it at the specified output location.
 The plugin binary can be identified by resource name and version, with optional extra identity parameters for platform-specific binaries.
+If the component version has no plugin binary for the platform, the plugin compiled to WebAssembly (os=wasip1, architecture=wasm)
+is downloaded instead. It runs sandboxed on every platform.
 
 Resources can be accessed either locally or via a plugin that supports remote fetching, with optional credential resolution.`,
 		Example: ` # Download a plugin binary with resource name 'helminput' and version 'v0.0.0-main'
@@ -116,7 +109,7 @@ func DownloadPlugin(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("getting extra-identity flag failed: %w", err)
 	}
-	extraIdentity, err := parseExtraIdentity(extraIdentitySlice)
+	extraIdentity, err := installer.ParseExtraIdentity(extraIdentitySlice)
 	if err != nil {
 		return err
 	}
@@ -167,40 +160,12 @@ func DownloadPlugin(cmd *cobra.Command, args []string) error {
 		resourceIdentity[key] = value
 	}
 
-	// Default OS and ARCH if not provided via --extra-identity
-	if _, hasOS := extraIdentity["os"]; !hasOS {
-		resourceIdentity["os"] = runtime.GOOS
-		logger.Debug("defaulting os to runtime OS", slog.String("os", runtime.GOOS))
-	}
-	if _, hasArch := extraIdentity["architecture"]; !hasArch {
-		resourceIdentity["architecture"] = runtime.GOARCH
-		logger.Debug("defaulting arch to runtime ARCH", slog.String("architecture", runtime.GOARCH))
-	}
-
-	var toDownload []descriptor.Resource
-	for _, resource := range desc.Component.Resources {
-		// Type is not part of the identity so the below identity matcher will not
-		// catch that, hence, we do this here.
-		if resource.Type != resourceType {
-			continue
-		}
-
-		// if the type matches we have our resource; we set the name for the identity match.
-		resourceIdentity["name"] = resource.Name
-
-		resourceIdent := resource.ToIdentity()
-		if resourceIdentity.Match(resourceIdent, ocmruntime.IdentityMatchingChainFn(ocmruntime.IdentitySubset)) {
-			toDownload = append(toDownload, resource)
-		}
-	}
-
-	if len(toDownload) == 0 {
-		return fmt.Errorf("no resource found matching identity %v", resourceIdentity)
-	}
-	if len(toDownload) > 1 {
-		logger.Warn("multiple resources match identity, using first match", slog.Int("count", len(toDownload)))
+	// Default OS and ARCH if not provided via --extra-identity, falling back to the plugin compiled to WebAssembly.
+	res, err := installer.SelectPluginResource(desc, resourceType, resourceIdentity, logger)
+	if err != nil {
+		return err
 	}
-	res := &toDownload[0]
+	resourceIdentity["name"] = res.Name
 
 	logger.Info("downloading plugin resource",
 		slog.String("name", res.Name),
@@ -213,9 +178,7 @@ func DownloadPlugin(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("downloading plugin resource for identity %q failed: %w", resourceIdentity, err)
 	}
 
-	// ocm.software/plugins/[helminput]
-	pluginFileName := path.Base(ref.Component)
-	output = filepath.Join(output, pluginFileName)
+	output = filepath.Join(output, installer.PluginFileName(ref.Component, res))
 
 	if err := shared.SaveBlobToFile(data, output); err != nil {
 		return err
@@ -224,7 +187,7 @@ func DownloadPlugin(cmd *cobra.Command, args []string) error {
 	tryToMakePluginExecutableOrWarn(output, logger)
 
 	if !skipValidation {
-		if err := validatePlugin(output, logger); err != nil {
+		if err := installer.ValidatePlugin(output, logger); err != nil {
 			if removeErr := os.Remove(output); removeErr != nil {
 				logger.Warn("failed to remove invalid plugin binary", slog.String("path", output), slog.String("error", removeErr.Error()))
 			}
@@ -305,18 +268,6 @@ func encodePluginInfo(res *descriptor.Resource, source, outputPath, format strin
 	return bytes.NewReader(data), int64(len(data)), nil
 }
 
-func parseExtraIdentity(extraIdentitySlice []string) (map[string]string, error) {
-	extraIdentity := make(map[string]string)
-	for _, param := range extraIdentitySlice {
-		parts := strings.SplitN(param, "=", 2)
-		if len(parts) != 2 {
-			return nil, fmt.Errorf("invalid extra-identity parameter format %q, expected key=value", param)
-		}
-		extraIdentity[parts[0]] = parts[1]
-	}
-	return extraIdentity, nil
-}
-
 func tryToMakePluginExecutableOrWarn(outputPath string, logger *slog.Logger) {
 	if info, err := os.Stat(outputPath); err == nil && info.Mode().IsRegular() {
 		if err := os.Chmod(outputPath, 0o755); err != nil {
@@ -326,29 +277,3 @@ func tryToMakePluginExecutableOrWarn(outputPath string, logger *slog.Logger) {
 		}
 	}
 }
-
-func validatePlugin(pluginPath string, logger *slog.Logger) error {
-	logger.Info("validating plugin binary", slog.String("path", pluginPath))
-
-	ctx, cancel := context.WithTimeout(context.Background(), pluginValidationTimeout)
-	defer cancel()
-
-	cmd := exec.CommandContext(ctx, pluginPath, "capabilities")
-	output, err := cmd.Output()
-	if err != nil {
-		return fmt.Errorf("plugin capabilities command failed: %w", err)
-	}
-
-	var capabilities spec.PluginSpec
-	if err := json.Unmarshal(output, &capabilities); err != nil {
-		return fmt.Errorf("plugin capabilities returned invalid JSON: %w", err)
-	}
-
-	if len(capabilities.CapabilitySpecs) == 0 {
-		return fmt.Errorf("plugin capabilities missing required 'types' field or is empty")
-	}
-
-	logger.Info("plugin validation successful")
-
-	return nil
-}
@@ -26,6 +26,7 @@ import (
 	ocmctx "ocm.software/open-component-model/cli/internal/context"
 	"ocm.software/open-component-model/cli/internal/flags/enum"
 	"ocm.software/open-component-model/cli/internal/render"
+	"ocm.software/open-component-model/cli/internal/render/graph/diagram"
 	"ocm.software/open-component-model/cli/internal/render/graph/list"
 	"ocm.software/open-component-model/cli/internal/render/graph/tree"
 	"ocm.software/open-component-model/cli/internal/repository/ocm"
@@ -80,12 +81,17 @@ Specifying types and schemes:
 
 get cv ctf::github.com/locally-checked-out-repo//ocm.software/ocmcli:0.23.0
 get cvs oci::http://localhost:8080//ocm.software/ocmcli
+
+Rendering the component reference graph for review documents:
+
+get cv ghcr.io/open-component-model/ocm//ocm.software/ocmcli:0.23.0 --recursive=-1 -o mermaid
+get cv ghcr.io/open-component-model/ocm//ocm.software/ocmcli:0.23.0 --recursive=-1 -o dot | dot -Tsvg > graph.svg
 `),
 		RunE:              GetComponentVersion,
 		DisableAutoGenTag: true,
 	}
 
-	enum.VarP(cmd.Flags(), FlagOutput, "o", []string{render.OutputFormatTable.String(), render.OutputFormatYAML.String(), render.OutputFormatJSON.String(), render.OutputFormatNDJSON.String(), render.OutputFormatTree.String()}, "output format of the component descriptors")
+	enum.VarP(cmd.Flags(), FlagOutput, "o", []string{render.OutputFormatTable.String(), render.OutputFormatYAML.String(), render.OutputFormatJSON.String(), render.OutputFormatNDJSON.String(), render.OutputFormatTree.String(), render.OutputFormatDOT.String(), render.OutputFormatMermaid.String()}, "output format of the component descriptors, dot and mermaid render the component reference graph")
 	enum.VarP(cmd.Flags(), FlagDisplayMode, "", []string{render.StaticRenderMode, render.LiveRenderMode}, `display mode can be used in combination with --recursive
   static: print the output once the complete component graph is discovered
   live (experimental): continuously updates the output to represent the current discovery state of the component graph`)
@@ -295,6 +301,9 @@ func buildRenderer(ctx context.Context, dag *syncdag.SyncedDirectedAcyclicGraph[
 	case render.OutputFormatTable.String():
 		serializer := list.ListSerializerFunc[string](serializeVerticesToTable)
 		return list.New(ctx, dag, list.WithListSerializer(serializer), list.WithRoots(roots...)), nil
+	case render.OutputFormatDOT.String(), render.OutputFormatMermaid.String():
+		diagramFormat, _ := diagram.ParseFormat(format)
+		return diagram.New(dag, diagram.WithRoots(roots...), diagram.WithOutputFormat[string](diagramFormat)), nil
 	default:
 		return nil, fmt.Errorf("invalid output format %q", format)
 	}
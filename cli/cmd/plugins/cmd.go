@@ -4,14 +4,19 @@ import (
 	"github.com/spf13/cobra"
 
 	"ocm.software/open-component-model/cli/cmd/plugins/get"
+	"ocm.software/open-component-model/cli/cmd/plugins/install"
 	"ocm.software/open-component-model/cli/cmd/plugins/list"
+	"ocm.software/open-component-model/cli/cmd/plugins/outdated"
+	"ocm.software/open-component-model/cli/cmd/plugins/uninstall"
+	"ocm.software/open-component-model/cli/cmd/plugins/update"
 )
 
 // New represents any command that is related to adding ( "add"ing ) objects
 func New() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "plugin",
-		Short: "Manage OCM plugins",
+		Use:     "plugin",
+		Aliases: []string{"plugins"},
+		Short:   "Manage OCM plugins",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
 		},
@@ -29,6 +34,10 @@ func New() *cobra.Command {
 	registry.AddCommand(list.New())
 
 	cmd.AddCommand(registry)
+	cmd.AddCommand(install.New())
+	cmd.AddCommand(update.New())
+	cmd.AddCommand(uninstall.New())
+	cmd.AddCommand(outdated.New())
 
 	return cmd
 }
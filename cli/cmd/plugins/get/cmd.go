@@ -17,6 +17,7 @@ import (
 	"ocm.software/open-component-model/bindings/go/oci/compref"
 	"ocm.software/open-component-model/bindings/go/repository/component/resolvers"
 	"ocm.software/open-component-model/cli/cmd/download/shared"
+	"ocm.software/open-component-model/cli/cmd/plugins/installer"
 	"ocm.software/open-component-model/cli/cmd/plugins/list"
 	ocmctx "ocm.software/open-component-model/cli/internal/context"
 	"ocm.software/open-component-model/cli/internal/flags/enum"
@@ -47,9 +48,7 @@ func New() *cobra.Command {
 	enum.VarP(cmd.Flags(), FlagOutput, "o", []string{render.OutputFormatTable.String(), render.OutputFormatYAML.String(), render.OutputFormatJSON.String(), render.OutputFormatNDJSON.String()}, "output format of the plugin list")
 	cmd.Flags().String(FlagVersion, "", "specific version of the plugin to display (default: latest version)")
 	cmd.Flags().Bool(FlagComponentDescriptor, false, "return component descriptors of the plugins")
-	cmd.Flags().String(FlagRegistry, "", "registry URL to list plugins from")
-	// TODO: Remove when https://github.com/open-component-model/ocm-project/issues/599 is implemented.
-	_ = cmd.MarkFlagRequired(FlagRegistry)
+	cmd.Flags().String(FlagRegistry, "", "comma-separated plugin registries to list plugins from (default: registries of the plugin.registry.config.ocm.software configuration)")
 
 	return cmd
 }
@@ -67,11 +66,6 @@ func GetPlugin(cmd *cobra.Command, args []string) error {
 
 	config := ocmctx.FromContext(ctx).Configuration()
 
-	pluginRegistryFlag, err := cmd.Flags().GetString(FlagRegistry)
-	if err != nil {
-		return fmt.Errorf("failed to get registry flag: %w", err)
-	}
-
 	pluginArgVersion, err := cmd.Flags().GetString(FlagVersion)
 	if err != nil {
 		return fmt.Errorf("failed to get version flag: %w", err)
@@ -94,16 +88,9 @@ func GetPlugin(cmd *cobra.Command, args []string) error {
 		pluginArgVersion = parts[1]
 	}
 
-	var pluginRegistries []string
-	if pluginRegistryFlag != "" {
-		regs := strings.Split(pluginRegistryFlag, ",")
-		pluginRegistries = append(pluginRegistries, regs...)
-	} else { //nolint:staticcheck // see TODOs below
-		// TODO: Load registries from config
-		// see https://github.com/open-component-model/ocm-project/issues/599
-
-		// TODO: Set default registry if no registry is provided
-		// see https://github.com/open-component-model/ocm-project/issues/598
+	pluginRegistries, err := installer.Registries(cmd)
+	if err != nil {
+		return err
 	}
 
 	// Get plugin registry descriptor to look for a component references of the passed plugin
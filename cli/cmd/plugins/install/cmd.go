@@ -0,0 +1,79 @@
+package install
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"ocm.software/open-component-model/cli/cmd/plugins/installer"
+)
+
+const FlagForce = "force"
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "install <name>[@version]...",
+		Short: "Install plugins from plugin registries into the plugin directory.",
+		Args:  cobra.MinimumNArgs(1),
+		Long: `Install plugins from plugin registries into the plugin directory.
+
+The plugin is resolved by name from the plugin registries of the --registry flag or the
+plugin.registry.config.ocm.software configuration. Without a version, the latest version is installed.
+The plugin resource matching the os and architecture of the running binary (or --extra-identity)
+is downloaded, after the plugin component version satisfied the verification policy of the --policy flag
+or the configuration.
+
+Installed plugins are recorded in the lock file plugins.lock.yaml in the plugin directory.
+An installed plugin is only replaced if its version differs or --force is set.`,
+		Example: `  # Install the latest version of a plugin from a registry
+  ocm plugin install helminput --registry ghcr.io/open-component-model//ocm.software/plugin-registry --policy ./plugin-policy.yaml
+
+  # Install a specific version of a plugin from the configured registries
+  ocm plugin install helminput@0.1.0
+
+  # Install the linux/arm64 binary of a plugin
+  ocm plugin install helminput --extra-identity os=linux,architecture=arm64`,
+		RunE:              InstallPlugins,
+		DisableAutoGenTag: true,
+	}
+
+	installer.AddRegistryFlags(cmd)
+	installer.AddInstallFlags(cmd)
+	cmd.Flags().Bool(FlagForce, false, "reinstall plugins that are installed in the requested version already")
+
+	return cmd
+}
+
+func InstallPlugins(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	inst, err := installer.New(cmd)
+	if err != nil {
+		return err
+	}
+	force, err := cmd.Flags().GetBool(FlagForce)
+	if err != nil {
+		return fmt.Errorf("getting force flag failed: %w", err)
+	}
+	lock, err := installer.ReadLock(inst.Directory)
+	if err != nil {
+		return err
+	}
+
+	for _, arg := range args {
+		name, version := installer.ParsePluginArg(arg)
+		candidate, err := inst.Resolve(ctx, name, version)
+		if err != nil {
+			return err
+		}
+		if locked := lock.Get(name); locked != nil && locked.Version == candidate.Version && !force {
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "plugin %s %s is already installed\n", name, candidate.Version)
+			continue
+		}
+		locked, err := inst.Install(ctx, candidate)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "installed plugin %s %s from %s\n", locked.Name, locked.Version, locked.Registry)
+	}
+	return nil
+}
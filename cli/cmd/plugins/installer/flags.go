@@ -0,0 +1,162 @@
+package installer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/cli/cmd/download/shared"
+	ocmcmd "ocm.software/open-component-model/cli/cmd/internal/cmd"
+	ocmctx "ocm.software/open-component-model/cli/internal/context"
+	registryv1alpha1 "ocm.software/open-component-model/cli/internal/plugin/spec/registry/v1alpha1"
+	"ocm.software/open-component-model/cli/internal/verification"
+)
+
+const (
+	FlagRegistry         = "registry"
+	FlagPolicy           = "policy"
+	FlagSkipVerification = "skip-verification"
+	FlagSkipValidation   = "skip-validation"
+	FlagExtraIdentity    = "extra-identity"
+	FlagPluginType       = "plugin-type"
+)
+
+// AddRegistryFlags adds the flags selecting the plugin registries.
+func AddRegistryFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagRegistry, "", "comma-separated plugin registries to resolve plugins from (default: registries of the plugin.registry.config.ocm.software configuration)")
+}
+
+// AddInstallFlags adds the flags controlling how plugins are verified and installed.
+func AddInstallFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagPolicy, "", "path to a verification policy file (VerificationPolicy/v1alpha1) plugins must satisfy (default: policy of the plugin.registry.config.ocm.software configuration)")
+	cmd.Flags().Bool(FlagSkipVerification, false, "install plugins without verifying their signatures")
+	cmd.Flags().Bool(FlagSkipValidation, false, "skip validation of the downloaded plugin binaries")
+	cmd.Flags().StringSlice(FlagExtraIdentity, []string{}, "extra identity parameters for resource matching (e.g., os=linux,architecture=amd64)")
+	cmd.Flags().String(FlagPluginType, PluginType, "type of the plugin resource in the component version containing the plugin binary")
+}
+
+// Registries returns the plugin registries of the --registry flag or, if it is not set,
+// the registries of the plugin registry configuration.
+func Registries(cmd *cobra.Command) ([]string, error) {
+	if flag, err := cmd.Flags().GetString(FlagRegistry); err == nil && flag != "" {
+		return strings.Split(flag, ","), nil
+	}
+	config, err := registryv1alpha1.LookupConfig(ocmctx.FromContext(cmd.Context()).Configuration())
+	if err != nil {
+		return nil, fmt.Errorf("could not get plugin registry configuration: %w", err)
+	}
+	// TODO: Set default registry if no registry is provided
+	// see https://github.com/open-component-model/ocm-project/issues/598
+	if len(config.Registries) == 0 {
+		return nil, fmt.Errorf("no plugin registries configured, use --%s or a %s configuration", FlagRegistry, registryv1alpha1.ConfigType)
+	}
+	return config.Registries, nil
+}
+
+// Directory returns the plugin directory of the --plugin-directory flag.
+func Directory(cmd *cobra.Command) (string, error) {
+	dir, err := cmd.Flags().GetString(ocmcmd.PluginDirectoryFlag)
+	if err != nil {
+		return "", fmt.Errorf("getting plugin directory flag failed: %w", err)
+	}
+	return os.ExpandEnv(dir), nil
+}
+
+// New creates an Installer from the flags of the command and the plugin registry configuration.
+// Install flags are only evaluated if they were added with AddInstallFlags.
+func New(cmd *cobra.Command) (*Installer, error) {
+	pluginManager, credentialGraph, logger, err := shared.GetContextItems(cmd)
+	if err != nil {
+		return nil, err
+	}
+	ocmContext := ocmctx.FromContext(cmd.Context())
+	if ocmContext == nil {
+		return nil, fmt.Errorf("no OCM context found")
+	}
+	dir, err := Directory(cmd)
+	if err != nil {
+		return nil, err
+	}
+	registries, err := Registries(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	installer := &Installer{
+		Directory:     dir,
+		Registries:    registries,
+		PluginManager: pluginManager,
+		Credentials:   credentialGraph,
+		Config:        ocmContext.Configuration(),
+		Logger:        logger,
+	}
+	if cmd.Flags().Lookup(FlagPolicy) == nil {
+		return installer, nil
+	}
+
+	if installer.SkipValidation, err = cmd.Flags().GetBool(FlagSkipValidation); err != nil {
+		return nil, fmt.Errorf("getting skip-validation flag failed: %w", err)
+	}
+	if installer.ResourceType, err = cmd.Flags().GetString(FlagPluginType); err != nil {
+		return nil, fmt.Errorf("getting plugin-type flag failed: %w", err)
+	}
+	extraIdentity, err := cmd.Flags().GetStringSlice(FlagExtraIdentity)
+	if err != nil {
+		return nil, fmt.Errorf("getting extra-identity flag failed: %w", err)
+	}
+	if installer.ExtraIdentity, err = ParseExtraIdentity(extraIdentity); err != nil {
+		return nil, err
+	}
+
+	skipVerification, err := cmd.Flags().GetBool(FlagSkipVerification)
+	if err != nil {
+		return nil, fmt.Errorf("getting skip-verification flag failed: %w", err)
+	}
+	policyPath, err := cmd.Flags().GetString(FlagPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("getting policy flag failed: %w", err)
+	}
+	if skipVerification {
+		if policyPath != "" {
+			return nil, fmt.Errorf("--%s and --%s are mutually exclusive", FlagPolicy, FlagSkipVerification)
+		}
+		return installer, nil
+	}
+	if policyPath == "" {
+		config, err := registryv1alpha1.LookupConfig(ocmContext.Configuration())
+		if err != nil {
+			return nil, fmt.Errorf("could not get plugin registry configuration: %w", err)
+		}
+		policyPath = config.Policy
+	}
+	if policyPath == "" {
+		return nil, fmt.Errorf("no verification policy configured for plugins, use --%s, a policy in the %s configuration or --%s",
+			FlagPolicy, registryv1alpha1.ConfigType, FlagSkipVerification)
+	}
+	if installer.Policy, err = verification.LoadPolicy(os.ExpandEnv(policyPath)); err != nil {
+		return nil, err
+	}
+	return installer, nil
+}
+
+// ParseExtraIdentity parses key=value parameters into an identity.
+func ParseExtraIdentity(params []string) (runtime.Identity, error) {
+	identity := make(runtime.Identity, len(params))
+	for _, param := range params {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid extra-identity parameter format %q, expected key=value", param)
+		}
+		identity[key] = value
+	}
+	return identity, nil
+}
+
+// ParsePluginArg splits a <name>[@version] argument.
+func ParsePluginArg(arg string) (name, version string) {
+	name, version, _ = strings.Cut(arg, "@")
+	return name, version
+}
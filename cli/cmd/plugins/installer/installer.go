@@ -0,0 +1,460 @@
+// Package installer installs plugins from plugin registries into a plugin directory
+// and records the installed versions in a lock file.
+//
+// A plugin registry is a component version that references the components of its plugins.
+// The name of a reference is the name of the plugin, its version the version of the plugin.
+// A plugin component contains one plugin resource per platform, distinguished by the
+// os and architecture extra identity of the resource. Plugins compiled to WebAssembly have
+// the platform wasip1/wasm and are installed on platforms without a native plugin resource.
+package installer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	goruntime "runtime"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	genericv1 "ocm.software/open-component-model/bindings/go/configuration/generic/v1/spec"
+	"ocm.software/open-component-model/bindings/go/credentials"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/oci/compref"
+	"ocm.software/open-component-model/bindings/go/plugin/manager"
+	mtypes "ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/repository/component/resolvers"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/cli/cmd/download/shared"
+	"ocm.software/open-component-model/cli/internal/repository/ocm"
+	"ocm.software/open-component-model/cli/internal/verification"
+	policyv1alpha1 "ocm.software/open-component-model/cli/internal/verification/policy/v1alpha1"
+)
+
+// PluginType is the type of the resource containing the plugin in the component version.
+// This type has been established by OCM v1 here:
+// https://github.com/open-component-model/ocm/blob/bccf3310af0665eaab3f0ea9803e6b903d858d52/api/ocm/extensions/artifacttypes/const.go#L40
+const PluginType = "ocmPlugin"
+
+const (
+	genericBlobDigest = "genericBlobDigest/v1"
+	sha256Algorithm   = "SHA-256"
+)
+
+// WASMPlatform is the os and architecture of plugins compiled to WebAssembly (GOOS=wasip1 GOARCH=wasm).
+// They are run in the sandbox of the plugin manager on every platform.
+var WASMPlatform = runtime.Identity{"os": "wasip1", "architecture": "wasm"}
+
+// Installer installs plugins from plugin registries into a plugin directory.
+type Installer struct {
+	// Directory is the plugin directory plugins are installed into.
+	Directory string
+	// Registries are the component references of the plugin registries, searched in order.
+	Registries []string
+	// Policy must be satisfied by a plugin component version before it is installed.
+	// If nil, signatures are not verified.
+	Policy *policyv1alpha1.Policy
+	// ExtraIdentity is matched against the plugin resources in addition to the version.
+	// The os and architecture default to the ones of the running binary.
+	ExtraIdentity runtime.Identity
+	// ResourceType is the type of the plugin resource, defaults to PluginType.
+	ResourceType string
+	// SkipValidation skips running the capabilities command of the plugin binary.
+	SkipValidation bool
+
+	PluginManager *manager.PluginManager
+	Credentials   credentials.Resolver
+	Config        *genericv1.Config
+	Logger        *slog.Logger
+}
+
+// Candidate is a version of a plugin available in a plugin registry.
+type Candidate struct {
+	Name      string
+	Version   string
+	Component string
+	// Registry is the plugin registry the candidate was found in, including its version.
+	Registry string
+
+	resolver resolvers.ComponentVersionRepositoryResolver
+}
+
+// Available returns all versions of the plugin in the plugin registries, ordered by ascending version.
+// If the same version is available in several registries, the first registry wins.
+func (i *Installer) Available(ctx context.Context, name string) ([]*Candidate, error) {
+	if len(i.Registries) == 0 {
+		return nil, errors.New("no plugin registries configured")
+	}
+	var candidates []*Candidate
+	for _, reg := range i.Registries {
+		desc, resolver, registry, err := i.registry(ctx, reg)
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range desc.Component.References {
+			if ref.Name != name {
+				continue
+			}
+			if slices.ContainsFunc(candidates, func(c *Candidate) bool { return c.Version == ref.Version }) {
+				continue
+			}
+			candidates = append(candidates, &Candidate{
+				Name:      ref.Name,
+				Version:   ref.Version,
+				Component: ref.Component,
+				Registry:  registry,
+				resolver:  resolver,
+			})
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b *Candidate) int {
+		return CompareVersions(a.Version, b.Version)
+	})
+	return candidates, nil
+}
+
+// Resolve returns the candidate of the plugin with the version, or the latest version if version is empty.
+func (i *Installer) Resolve(ctx context.Context, name, version string) (*Candidate, error) {
+	candidates, err := i.Available(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("plugin %q not found in plugin registries %q", name, strings.Join(i.Registries, ", "))
+	}
+	if version == "" {
+		return candidates[len(candidates)-1], nil
+	}
+	for _, c := range candidates {
+		if c.Version == version {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("version %q of plugin %q not found in plugin registries %q", version, name, strings.Join(i.Registries, ", "))
+}
+
+// registry returns the descriptor and repository resolver of a plugin registry.
+// A registry reference without a version resolves to the latest version of the registry.
+func (i *Installer) registry(ctx context.Context, reg string) (*descriptor.Descriptor, resolvers.ComponentVersionRepositoryResolver, string, error) {
+	ref, err := compref.Parse(reg)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("creating component reference for plugin registry %q failed: %w", reg, err)
+	}
+	resolver, err := ocm.NewComponentVersionRepositoryForComponentProvider(ctx, i.PluginManager.ComponentVersionRepositoryRegistry, i.Credentials, i.Config, ref)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("could not initialize ocm repository provider for plugin registry %q: %w", reg, err)
+	}
+	repo, err := resolver.GetComponentVersionRepositoryForComponent(ctx, ref.Component, ref.Version)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed getting repository for plugin registry %q: %w", reg, err)
+	}
+
+	if ref.Version != "" {
+		desc, err := repo.GetComponentVersion(ctx, ref.Component, ref.Version)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("failed getting plugin registry %q: %w", reg, err)
+		}
+		return desc, resolver, reg, nil
+	}
+
+	descs, err := ocm.GetComponentVersions(ctx, ocm.GetComponentVersionsOptions{
+		VersionOptions: ocm.VersionOptions{LatestOnly: true},
+	}, ref.Component, ref.Version, repo)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed getting component versions for plugin registry %q: %w", reg, err)
+	}
+	if len(descs) == 0 {
+		return nil, nil, "", fmt.Errorf("no versions found for component %q in plugin registry", ref.Component)
+	}
+	return descs[0], resolver, fmt.Sprintf("%s:%s", reg, descs[0].Component.Version), nil
+}
+
+// Install verifies the plugin component version of the candidate, downloads the plugin resource
+// matching the platform into the plugin directory and records it in the lock file.
+// An installed version of the plugin is replaced only once the new one is verified and validated.
+func (i *Installer) Install(ctx context.Context, c *Candidate) (*LockedPlugin, error) {
+	logger := i.logger()
+
+	repo, err := c.resolver.GetComponentVersionRepositoryForComponent(ctx, c.Component, c.Version)
+	if err != nil {
+		return nil, fmt.Errorf("could not access ocm repository of plugin %q: %w", c.Name, err)
+	}
+	desc, err := repo.GetComponentVersion(ctx, c.Component, c.Version)
+	if err != nil {
+		return nil, fmt.Errorf("getting component version of plugin %q failed: %w", c.Name, err)
+	}
+
+	// the descriptor the plugin resource and its digest are taken from is the one that is verified.
+	if err := i.verify(ctx, c, desc); err != nil {
+		return nil, err
+	}
+
+	identity := runtime.Identity{descriptor.IdentityAttributeVersion: desc.Component.Version}
+	for key, value := range i.ExtraIdentity {
+		identity[key] = value
+	}
+	resourceType := i.ResourceType
+	if resourceType == "" {
+		resourceType = PluginType
+	}
+	res, err := SelectPluginResource(desc, resourceType, identity, logger)
+	if err != nil {
+		return nil, fmt.Errorf("selecting resource of plugin %q failed: %w", c.Name, err)
+	}
+
+	logger.InfoContext(ctx, "downloading plugin resource", "plugin", c.Name, "version", c.Version, "identity", res.ToIdentity().String())
+	data, err := shared.DownloadResourceData(ctx, i.PluginManager, i.Credentials, c.Component, c.Version, repo, res, res.ToIdentity())
+	if err != nil {
+		return nil, fmt.Errorf("downloading plugin %q failed: %w", c.Name, err)
+	}
+	reader, err := data.ReadCloser()
+	if err != nil {
+		return nil, fmt.Errorf("reading plugin %q failed: %w", c.Name, err)
+	}
+	defer reader.Close()
+
+	if err := os.MkdirAll(i.Directory, 0o755); err != nil {
+		return nil, fmt.Errorf("creating plugin directory %q failed: %w", i.Directory, err)
+	}
+	// The extension keeps the plugin manager from discovering the unfinished binary.
+	tmp, err := os.CreateTemp(i.Directory, c.Name+"-*.download")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary plugin file failed: %w", err)
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, hash), reader); err != nil {
+		return nil, fmt.Errorf("writing plugin %q failed: %w", c.Name, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, fmt.Errorf("writing plugin %q failed: %w", c.Name, err)
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if err := verifyResourceDigest(res, sum, i.Policy != nil); err != nil {
+		return nil, fmt.Errorf("plugin %q: %w", c.Name, err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o755); err != nil {
+		return nil, fmt.Errorf("making plugin %q executable failed: %w", c.Name, err)
+	}
+	if !i.SkipValidation {
+		if err := ValidatePlugin(tmp.Name(), logger); err != nil {
+			return nil, fmt.Errorf("downloaded binary of plugin %q is not a valid plugin: %w", c.Name, err)
+		}
+	}
+
+	lock, err := ReadLock(i.Directory)
+	if err != nil {
+		return nil, err
+	}
+	file := PluginFileName(c.Component, res)
+	if previous := lock.Get(c.Name); previous != nil && previous.File != file {
+		if err := removePluginFile(i.Directory, previous.File); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(i.Directory, file)); err != nil {
+		return nil, fmt.Errorf("installing plugin %q failed: %w", c.Name, err)
+	}
+
+	locked := LockedPlugin{
+		Name:      c.Name,
+		Version:   c.Version,
+		Component: c.Component,
+		Registry:  c.Registry,
+		Resource:  res.ToIdentity(),
+		Digest:    "sha256:" + sum,
+		File:      file,
+	}
+	lock.Set(locked)
+	if err := WriteLock(i.Directory, lock); err != nil {
+		return nil, err
+	}
+	logger.InfoContext(ctx, "plugin installed", "plugin", c.Name, "version", c.Version, "path", filepath.Join(i.Directory, file))
+	return &locked, nil
+}
+
+// Uninstall removes the binary of an installed plugin and its entry in the lock file.
+func (i *Installer) Uninstall(name string) (*LockedPlugin, error) {
+	lock, err := ReadLock(i.Directory)
+	if err != nil {
+		return nil, err
+	}
+	locked := lock.Get(name)
+	if locked == nil {
+		return nil, fmt.Errorf("plugin %q is not installed in %q", name, i.Directory)
+	}
+	removed := *locked
+	if err := removePluginFile(i.Directory, removed.File); err != nil {
+		return nil, err
+	}
+	lock.Remove(name)
+	if err := WriteLock(i.Directory, lock); err != nil {
+		return nil, err
+	}
+	return &removed, nil
+}
+
+// verify evaluates the policy for the plugin component version.
+func (i *Installer) verify(ctx context.Context, c *Candidate, desc *descriptor.Descriptor) error {
+	if i.Policy == nil {
+		i.logger().WarnContext(ctx, "skipping signature verification of plugin", "plugin", c.Name, "version", c.Version)
+		return nil
+	}
+	report, err := verification.Evaluate(ctx, i.Policy, c.Component, c.Version, verification.Options{
+		Resolver:    c.resolver,
+		Root:        desc,
+		Handlers:    i.PluginManager.SigningRegistry,
+		Credentials: i.Credentials,
+		Logger:      i.Logger,
+	})
+	if err != nil {
+		return fmt.Errorf("verifying plugin %q failed: %w", c.Name, err)
+	}
+	if !report.Satisfied {
+		failed := report.Failed()
+		errs := make([]error, 0, len(failed))
+		for _, cv := range failed {
+			errs = append(errs, fmt.Errorf("%s:%s: %s", cv.Component, cv.Version, cv.Error))
+		}
+		return fmt.Errorf("plugin %q does not satisfy the verification policy: %w", c.Name, errors.Join(errs...))
+	}
+	return nil
+}
+
+func (i *Installer) logger() *slog.Logger {
+	if i.Logger == nil {
+		return slog.Default()
+	}
+	return i.Logger
+}
+
+// DefaultPlatform sets the os and architecture of the identity to the ones of the running binary,
+// unless they are set already.
+func DefaultPlatform(identity runtime.Identity) {
+	if _, ok := identity["os"]; !ok {
+		identity["os"] = goruntime.GOOS
+	}
+	if _, ok := identity["architecture"]; !ok {
+		identity["architecture"] = goruntime.GOARCH
+	}
+}
+
+// SelectPluginResource returns the plugin resource for the platform of the running binary. Unless the os or
+// architecture is set in the identity, the plugin compiled to WebAssembly is selected if there is no
+// plugin resource for the platform.
+func SelectPluginResource(desc *descriptor.Descriptor, resourceType string, identity runtime.Identity, logger *slog.Logger) (*descriptor.Resource, error) {
+	_, hasOS := identity["os"]
+	_, hasArchitecture := identity["architecture"]
+
+	native := identity.Clone()
+	DefaultPlatform(native)
+	res, err := SelectResource(desc, resourceType, native, logger)
+	if err == nil || hasOS || hasArchitecture {
+		return res, err
+	}
+
+	portable := identity.Clone()
+	maps.Copy(portable, WASMPlatform)
+	if res, wasmErr := SelectResource(desc, resourceType, portable, logger); wasmErr == nil {
+		return res, nil
+	}
+	return nil, err
+}
+
+// IsWASMPlugin reports whether the plugin resource is compiled to WebAssembly.
+func IsWASMPlugin(res *descriptor.Resource) bool {
+	return res.ExtraIdentity["os"] == WASMPlatform["os"] && res.ExtraIdentity["architecture"] == WASMPlatform["architecture"]
+}
+
+// PluginFileName returns the name of the file the plugin resource of the component is stored in.
+// Plugins compiled to WebAssembly keep the extension the plugin manager recognizes them by.
+func PluginFileName(component string, res *descriptor.Resource) string {
+	// ocm.software/plugins/[helminput]
+	file := path.Base(component)
+	if IsWASMPlugin(res) {
+		file += mtypes.WASMExtension
+	}
+	return file
+}
+
+// SelectResource returns the resource of the type whose identity matches the given identity.
+// The name of the resource is not part of the match. If several resources match, the first one is returned.
+func SelectResource(desc *descriptor.Descriptor, resourceType string, identity runtime.Identity, logger *slog.Logger) (*descriptor.Resource, error) {
+	var matches []*descriptor.Resource
+	for i, resource := range desc.Component.Resources {
+		// Type is not part of the identity so the below identity matcher will not
+		// catch that, hence, we do this here.
+		if resource.Type != resourceType {
+			continue
+		}
+
+		// if the type matches we have our resource; we set the name for the identity match.
+		candidate := identity.Clone()
+		candidate[descriptor.IdentityAttributeName] = resource.Name
+		if candidate.Match(resource.ToIdentity(), runtime.IdentityMatchingChainFn(runtime.IdentitySubset)) {
+			matches = append(matches, &desc.Component.Resources[i])
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no resource found matching identity %v of type %q", identity, resourceType)
+	}
+	if len(matches) > 1 {
+		logger.Warn("multiple resources match identity, using first match", slog.Int("count", len(matches)))
+	}
+	return matches[0], nil
+}
+
+// CompareVersions compares two versions semantically.
+// Versions that are not semantic versions are compared lexically and sort before semantic versions.
+func CompareVersions(a, b string) int {
+	va, errA := semver.NewVersion(a)
+	vb, errB := semver.NewVersion(b)
+	switch {
+	case errA == nil && errB == nil:
+		return va.Compare(vb)
+	case errA == nil:
+		return 1
+	case errB == nil:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// verifyResourceDigest checks the digest of the downloaded plugin binary against the digest of the resource.
+// Only generic blob digests can be checked against the binary. If the descriptor was verified, the resource
+// digest is what the signature covers, so the binary is refused unless it carries a digest that can be checked.
+// Otherwise other digests are skipped.
+func verifyResourceDigest(res *descriptor.Resource, sum string, required bool) error {
+	if res.Digest == nil || res.Digest.NormalisationAlgorithm != genericBlobDigest || res.Digest.HashAlgorithm != sha256Algorithm {
+		if required {
+			return fmt.Errorf("resource has no %s %s digest, the downloaded binary cannot be verified", sha256Algorithm, genericBlobDigest)
+		}
+		return nil
+	}
+	if res.Digest.Value != sum {
+		return fmt.Errorf("digest %s of the downloaded binary does not match the resource digest %s", sum, res.Digest.Value)
+	}
+	return nil
+}
+
+func removePluginFile(dir, file string) error {
+	if err := os.Remove(filepath.Join(dir, file)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing plugin binary %q failed: %w", file, err)
+	}
+	return nil
+}
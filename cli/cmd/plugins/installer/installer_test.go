@@ -0,0 +1,115 @@
+package installer
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func TestLock(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+
+	lock, err := ReadLock(dir)
+	r.NoError(err, "a missing lock file is an empty lock")
+	r.Empty(lock.Plugins)
+
+	lock.Set(LockedPlugin{Name: "b", Version: "1.0.0"})
+	lock.Set(LockedPlugin{Name: "a", Version: "1.0.0"})
+	lock.Set(LockedPlugin{Name: "b", Version: "2.0.0"})
+	r.NoError(WriteLock(dir, lock))
+
+	read, err := ReadLock(dir)
+	r.NoError(err)
+	r.Equal([]LockedPlugin{{Name: "a", Version: "1.0.0"}, {Name: "b", Version: "2.0.0"}}, read.Plugins)
+
+	r.True(read.Remove("a"))
+	r.False(read.Remove("a"))
+	r.Nil(read.Get("a"))
+	r.Equal("2.0.0", read.Get("b").Version)
+}
+
+func TestSelectResource(t *testing.T) {
+	resource := func(name, os string) descriptor.Resource {
+		return descriptor.Resource{
+			ElementMeta: descriptor.ElementMeta{
+				ObjectMeta:    descriptor.ObjectMeta{Name: name, Version: "1.0.0"},
+				ExtraIdentity: runtime.Identity{"os": os, "architecture": "amd64"},
+			},
+			Type: PluginType,
+		}
+	}
+	desc := &descriptor.Descriptor{}
+	desc.Component.Resources = []descriptor.Resource{
+		{ElementMeta: descriptor.ElementMeta{ObjectMeta: descriptor.ObjectMeta{Name: "docs", Version: "1.0.0"}}, Type: "blob"},
+		resource("plugin", "darwin"),
+		resource("plugin", "linux"),
+	}
+
+	res, err := SelectResource(desc, PluginType, runtime.Identity{"version": "1.0.0", "os": "linux", "architecture": "amd64"}, slog.Default())
+	require.NoError(t, err)
+	require.Equal(t, "linux", res.ExtraIdentity["os"])
+
+	_, err = SelectResource(desc, PluginType, runtime.Identity{"version": "1.0.0", "os": "windows", "architecture": "amd64"}, slog.Default())
+	require.ErrorContains(t, err, "no resource found matching identity")
+}
+
+func TestSelectPluginResource(t *testing.T) {
+	resource := func(os, architecture string) descriptor.Resource {
+		return descriptor.Resource{
+			ElementMeta: descriptor.ElementMeta{
+				ObjectMeta:    descriptor.ObjectMeta{Name: "plugin", Version: "1.0.0"},
+				ExtraIdentity: runtime.Identity{"os": os, "architecture": architecture},
+			},
+			Type: PluginType,
+		}
+	}
+	desc := &descriptor.Descriptor{}
+	desc.Component.Resources = []descriptor.Resource{
+		resource("plan9", "amd64"),
+		resource("wasip1", "wasm"),
+	}
+
+	res, err := SelectPluginResource(desc, PluginType, runtime.Identity{"version": "1.0.0", "os": "plan9", "architecture": "amd64"}, slog.Default())
+	require.NoError(t, err)
+	require.False(t, IsWASMPlugin(res))
+	require.Equal(t, "helminput", PluginFileName("ocm.software/plugins/helminput", res))
+
+	res, err = SelectPluginResource(desc, PluginType, runtime.Identity{"version": "1.0.0"}, slog.Default())
+	require.NoError(t, err)
+	require.True(t, IsWASMPlugin(res), "the plugin compiled to WebAssembly is selected without a resource for the platform")
+	require.Equal(t, "helminput.wasm", PluginFileName("ocm.software/plugins/helminput", res))
+
+	_, err = SelectPluginResource(desc, PluginType, runtime.Identity{"version": "1.0.0", "os": "windows"}, slog.Default())
+	require.ErrorContains(t, err, "no resource found matching identity", "an explicit platform is not replaced")
+}
+
+func TestCompareVersions(t *testing.T) {
+	require.Negative(t, CompareVersions("1.2.0", "1.10.0"))
+	require.Positive(t, CompareVersions("v2.0.0", "1.0.0"))
+	require.Zero(t, CompareVersions("1.0.0", "v1.0.0"))
+	require.Negative(t, CompareVersions("latest", "0.0.1"), "non-semantic versions sort first")
+}
+
+func TestVerifyResourceDigest(t *testing.T) {
+	const sum = "c3ab8ff13720e8ad9047dd39466b3c8974e592c2fa383d4a3960714caef0c4f2"
+	res := &descriptor.Resource{Digest: &descriptor.Digest{
+		HashAlgorithm:          sha256Algorithm,
+		NormalisationAlgorithm: genericBlobDigest,
+		Value:                  sum,
+	}}
+	require.NoError(t, verifyResourceDigest(res, sum, true))
+	require.ErrorContains(t, verifyResourceDigest(res, "00", false), "does not match")
+	require.ErrorContains(t, verifyResourceDigest(res, "00", true), "does not match")
+
+	res.Digest.NormalisationAlgorithm = "ociArtifactDigest/v1"
+	require.NoError(t, verifyResourceDigest(res, "00", false), "only generic blob digests are checked")
+	require.NoError(t, verifyResourceDigest(&descriptor.Resource{}, "00", false))
+
+	require.ErrorContains(t, verifyResourceDigest(res, sum, true), "cannot be verified", "verified plugins need a generic blob digest")
+	require.ErrorContains(t, verifyResourceDigest(&descriptor.Resource{}, sum, true), "cannot be verified")
+}
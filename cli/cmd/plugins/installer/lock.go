@@ -0,0 +1,114 @@
+package installer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// LockFileName is the name of the lock file in the plugin directory.
+// The plugin manager ignores files with an extension, so it is not mistaken for a plugin.
+const LockFileName = "plugins.lock.yaml"
+
+// Lock records the plugins installed into a plugin directory.
+type Lock struct {
+	Plugins []LockedPlugin `json:"plugins"`
+}
+
+// LockedPlugin is a plugin installed from a plugin registry.
+type LockedPlugin struct {
+	// Name is the name of the plugin in the plugin registry.
+	Name string `json:"name"`
+	// Version is the installed version of the plugin.
+	Version string `json:"version"`
+	// Component is the component containing the plugin binary.
+	Component string `json:"component"`
+	// Registry is the plugin registry the plugin was installed from.
+	Registry string `json:"registry"`
+	// Resource is the identity of the installed plugin resource.
+	Resource runtime.Identity `json:"resource"`
+	// Digest is the digest of the installed plugin binary.
+	Digest string `json:"digest"`
+	// File is the file name of the plugin binary in the plugin directory.
+	File string `json:"file"`
+}
+
+// LockFilePath returns the path of the lock file in the plugin directory.
+func LockFilePath(dir string) string {
+	return filepath.Join(dir, LockFileName)
+}
+
+// ReadLock reads the lock file of the plugin directory.
+// A missing lock file results in an empty lock.
+func ReadLock(dir string) (*Lock, error) {
+	data, err := os.ReadFile(LockFilePath(dir))
+	if errors.Is(err, os.ErrNotExist) {
+		return &Lock{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading lock file failed: %w", err)
+	}
+	var lock Lock
+	if err := yaml.UnmarshalStrict(data, &lock); err != nil {
+		return nil, fmt.Errorf("decoding lock file %q failed: %w", LockFilePath(dir), err)
+	}
+	return &lock, nil
+}
+
+// WriteLock writes the lock file of the plugin directory.
+// Plugins are sorted by name to keep the file stable.
+func WriteLock(dir string, lock *Lock) error {
+	slices.SortFunc(lock.Plugins, func(a, b LockedPlugin) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	data, err := yaml.Marshal(lock)
+	if err != nil {
+		return fmt.Errorf("encoding lock file failed: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating plugin directory %q failed: %w", dir, err)
+	}
+	tmp := LockFilePath(dir) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("writing lock file failed: %w", err)
+	}
+	if err := os.Rename(tmp, LockFilePath(dir)); err != nil {
+		return fmt.Errorf("writing lock file failed: %w", err)
+	}
+	return nil
+}
+
+// Get returns the locked plugin with the name, or nil if it is not installed.
+func (l *Lock) Get(name string) *LockedPlugin {
+	for i := range l.Plugins {
+		if l.Plugins[i].Name == name {
+			return &l.Plugins[i]
+		}
+	}
+	return nil
+}
+
+// Set adds or replaces the locked plugin with the same name.
+func (l *Lock) Set(plugin LockedPlugin) {
+	if existing := l.Get(plugin.Name); existing != nil {
+		*existing = plugin
+		return
+	}
+	l.Plugins = append(l.Plugins, plugin)
+}
+
+// Remove removes the locked plugin with the name and reports whether it was installed.
+func (l *Lock) Remove(name string) bool {
+	n := len(l.Plugins)
+	l.Plugins = slices.DeleteFunc(l.Plugins, func(p LockedPlugin) bool {
+		return p.Name == name
+	})
+	return len(l.Plugins) != n
+}
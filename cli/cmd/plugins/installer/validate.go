@@ -0,0 +1,93 @@
+package installer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	mtypes "ocm.software/open-component-model/bindings/go/plugin/manager/types"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/types/spec"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/wasm"
+	"ocm.software/open-component-model/cli/internal/plugin/spec/config/v2alpha1"
+)
+
+const pluginValidationTimeout = 30 * time.Second
+
+// wasmMagic is the magic number WebAssembly modules start with.
+var wasmMagic = []byte("\x00asm")
+
+// ValidatePlugin runs the capabilities command of the plugin binary and checks that it declares capabilities.
+// Plugins compiled to WebAssembly are run in the sandbox of the plugin manager.
+func ValidatePlugin(pluginPath string, logger *slog.Logger) error {
+	logger.Info("validating plugin binary", slog.String("path", pluginPath))
+
+	ctx, cancel := context.WithTimeout(context.Background(), pluginValidationTimeout)
+	defer cancel()
+
+	output, err := runCapabilities(ctx, pluginPath)
+	if err != nil {
+		return fmt.Errorf("plugin capabilities command failed: %w", err)
+	}
+
+	var capabilities spec.PluginSpec
+	if err := json.Unmarshal(output, &capabilities); err != nil {
+		return fmt.Errorf("plugin capabilities returned invalid JSON: %w", err)
+	}
+
+	if len(capabilities.CapabilitySpecs) == 0 {
+		return fmt.Errorf("plugin capabilities missing required 'types' field or is empty")
+	}
+
+	logger.Info("plugin validation successful")
+
+	return nil
+}
+
+func runCapabilities(ctx context.Context, pluginPath string) ([]byte, error) {
+	isWASM, err := isWASMModule(pluginPath)
+	if err != nil {
+		return nil, err
+	}
+	if !isWASM {
+		return exec.CommandContext(ctx, pluginPath, "capabilities").Output()
+	}
+
+	// the compilation cache of the plugin manager is used, so the plugin isn't compiled again once it's registered.
+	plugin, err := wasm.NewPlugin(ctx, mtypes.Plugin{ID: filepath.Base(pluginPath), Path: pluginPath}, mtypes.WASMOptions{
+		CompilationCacheDir: v2alpha1.DefaultWASMCompilationCacheDir(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	output, err := plugin.Capabilities(ctx)
+	if err != nil {
+		return nil, errors.Join(err, plugin.Close(ctx))
+	}
+	return output.Bytes(), plugin.Close(ctx)
+}
+
+// isWASMModule reports whether the file is a WebAssembly module.
+func isWASMModule(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	magic := make([]byte, len(wasmMagic))
+	if _, err := io.ReadFull(file, magic); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return false, nil
+		}
+		return false, err
+	}
+	return bytes.Equal(magic, wasmMagic), nil
+}
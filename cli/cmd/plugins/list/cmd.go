@@ -17,6 +17,7 @@ import (
 	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
 	"ocm.software/open-component-model/bindings/go/oci/compref"
 	"ocm.software/open-component-model/cli/cmd/download/shared"
+	"ocm.software/open-component-model/cli/cmd/plugins/installer"
 	ocmctx "ocm.software/open-component-model/cli/internal/context"
 	"ocm.software/open-component-model/cli/internal/flags/enum"
 	"ocm.software/open-component-model/cli/internal/render"
@@ -45,9 +46,7 @@ func New() *cobra.Command {
 	}
 
 	enum.VarP(cmd.Flags(), FlagOutput, "o", []string{render.OutputFormatTable.String(), render.OutputFormatYAML.String(), render.OutputFormatJSON.String(), render.OutputFormatNDJSON.String(), "wide"}, "output format of the plugin list")
-	cmd.Flags().String(FlagRegistry, "", "registry URL to list plugins from")
-	// TODO: Remove when https://github.com/open-component-model/ocm-project/issues/599 is implemented.
-	_ = cmd.MarkFlagRequired(FlagRegistry)
+	cmd.Flags().String(FlagRegistry, "", "comma-separated plugin registries to list plugins from (default: registries of the plugin.registry.config.ocm.software configuration)")
 
 	return cmd
 }
@@ -71,21 +70,9 @@ func ListPlugins(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("getting output flag failed: %w", err)
 	}
 
-	pluginRegistryFlag, err := cmd.Flags().GetString(FlagRegistry)
+	pluginRegistries, err := installer.Registries(cmd)
 	if err != nil {
-		return fmt.Errorf("failed to get plugin registry flag: %w", err)
-	}
-
-	var pluginRegistries []string
-	if pluginRegistryFlag != "" {
-		regs := strings.Split(pluginRegistryFlag, ",")
-		pluginRegistries = append(pluginRegistries, regs...)
-	} else { //nolint:staticcheck // see TODOs below
-		// TODO: Load registries from config
-		// see https://github.com/open-component-model/ocm-project/issues/599
-
-		// TODO: Set default registry if no registry is provided
-		// see https://github.com/open-component-model/ocm-project/issues/598
+		return err
 	}
 
 	// The config can contain several registries from which we want to list plugins
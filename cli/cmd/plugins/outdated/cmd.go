@@ -0,0 +1,113 @@
+package outdated
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"ocm.software/open-component-model/cli/cmd/plugins/installer"
+	"ocm.software/open-component-model/cli/internal/flags/enum"
+	"ocm.software/open-component-model/cli/internal/render"
+)
+
+const FlagOutput = "output"
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outdated",
+		Short: "List installed plugins with newer versions in the plugin registries.",
+		Args:  cobra.NoArgs,
+		Long: `List plugins of the lock file in the plugin directory for which the plugin registries
+contain a newer version. Use "ocm plugin update" to update them.`,
+		Example: `  # List outdated plugins
+  ocm plugin outdated
+
+  # List outdated plugins as JSON
+  ocm plugin outdated --registry ghcr.io/open-component-model//ocm.software/plugin-registry -o json`,
+		RunE:              OutdatedPlugins,
+		DisableAutoGenTag: true,
+	}
+
+	installer.AddRegistryFlags(cmd)
+	enum.VarP(cmd.Flags(), FlagOutput, "o", []string{render.OutputFormatTable.String(), render.OutputFormatYAML.String(), render.OutputFormatJSON.String()}, "output format of the outdated plugins")
+
+	return cmd
+}
+
+// Outdated is an installed plugin with a newer version in the plugin registries.
+type Outdated struct {
+	Name      string `json:"name"`
+	Installed string `json:"installed"`
+	Latest    string `json:"latest"`
+	Registry  string `json:"registry"`
+}
+
+func OutdatedPlugins(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+	output, err := enum.Get(cmd.Flags(), FlagOutput)
+	if err != nil {
+		return fmt.Errorf("getting output flag failed: %w", err)
+	}
+	inst, err := installer.New(cmd)
+	if err != nil {
+		return err
+	}
+	lock, err := installer.ReadLock(inst.Directory)
+	if err != nil {
+		return err
+	}
+
+	outdated := make([]Outdated, 0, len(lock.Plugins))
+	for _, locked := range lock.Plugins {
+		latest, err := inst.Resolve(ctx, locked.Name, "")
+		if err != nil {
+			return err
+		}
+		if installer.CompareVersions(latest.Version, locked.Version) > 0 {
+			outdated = append(outdated, Outdated{
+				Name:      locked.Name,
+				Installed: locked.Version,
+				Latest:    latest.Version,
+				Registry:  latest.Registry,
+			})
+		}
+	}
+
+	return encode(cmd.OutOrStdout(), outdated, output)
+}
+
+func encode(w io.Writer, outdated []Outdated, format string) error {
+	switch format {
+	case render.OutputFormatJSON.String():
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(outdated)
+	case render.OutputFormatYAML.String():
+		data, err := yaml.Marshal(outdated)
+		if err != nil {
+			return fmt.Errorf("marshaling outdated plugins as YAML failed: %w", err)
+		}
+		_, err = w.Write(data)
+		return err
+	default:
+		if len(outdated) == 0 {
+			_, err := fmt.Fprintln(w, "all installed plugins are up to date")
+			return err
+		}
+		t := table.NewWriter()
+		t.SetOutputMirror(w)
+		t.AppendHeader(table.Row{"Name", "Installed", "Latest", "Registry"})
+		for _, o := range outdated {
+			t.AppendRow(table.Row{o.Name, o.Installed, o.Latest, o.Registry})
+		}
+		style := table.StyleLight
+		style.Options.DrawBorder = false
+		t.SetStyle(style)
+		t.Render()
+		return nil
+	}
+}
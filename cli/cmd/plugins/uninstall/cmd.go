@@ -0,0 +1,42 @@
+package uninstall
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"ocm.software/open-component-model/cli/cmd/plugins/installer"
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uninstall <name>...",
+		Short: "Uninstall plugins installed from plugin registries.",
+		Args:  cobra.MinimumNArgs(1),
+		Long: `Uninstall plugins installed with "ocm plugin install".
+
+The plugin binary is removed from the plugin directory and the plugin is removed from the lock file.`,
+		Example: `  # Uninstall a plugin
+  ocm plugin uninstall helminput`,
+		RunE:              UninstallPlugins,
+		DisableAutoGenTag: true,
+	}
+
+	return cmd
+}
+
+func UninstallPlugins(cmd *cobra.Command, args []string) error {
+	dir, err := installer.Directory(cmd)
+	if err != nil {
+		return err
+	}
+	inst := &installer.Installer{Directory: dir}
+	for _, name := range args {
+		removed, err := inst.Uninstall(name)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "uninstalled plugin %s %s\n", removed.Name, removed.Version)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package update
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"ocm.software/open-component-model/cli/cmd/plugins/installer"
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [<name>[@version]...]",
+		Short: "Update installed plugins from plugin registries.",
+		Args:  cobra.ArbitraryArgs,
+		Long: `Update plugins installed with "ocm plugin install".
+
+Without arguments, all plugins of the lock file in the plugin directory are updated to the latest version
+available in the plugin registries. A plugin with a version is updated (or downgraded) to exactly that version.
+Updated plugins are verified and validated like installed ones.`,
+		Example: `  # Update all installed plugins to their latest versions
+  ocm plugin update
+
+  # Update a single plugin to a specific version
+  ocm plugin update helminput@0.2.0`,
+		RunE:              UpdatePlugins,
+		DisableAutoGenTag: true,
+	}
+
+	installer.AddRegistryFlags(cmd)
+	installer.AddInstallFlags(cmd)
+
+	return cmd
+}
+
+func UpdatePlugins(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	inst, err := installer.New(cmd)
+	if err != nil {
+		return err
+	}
+	lock, err := installer.ReadLock(inst.Directory)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		for _, locked := range lock.Plugins {
+			args = append(args, locked.Name)
+		}
+	}
+
+	for _, arg := range args {
+		name, version := installer.ParsePluginArg(arg)
+		locked := lock.Get(name)
+		if locked == nil {
+			return fmt.Errorf("plugin %q is not installed, use \"ocm plugin install\" to install it", name)
+		}
+		candidate, err := inst.Resolve(ctx, name, version)
+		if err != nil {
+			return err
+		}
+		if version == "" && installer.CompareVersions(candidate.Version, locked.Version) <= 0 ||
+			candidate.Version == locked.Version {
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "plugin %s %s is up to date\n", name, locked.Version)
+			continue
+		}
+		updated, err := inst.Install(ctx, candidate)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "updated plugin %s from %s to %s\n", name, locked.Version, updated.Version)
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	credentialsRuntime "ocm.software/open-component-model/bindings/go/credentials/spec/config/runtime"
 	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
 	"ocm.software/open-component-model/bindings/go/plugin/manager"
+	mtypes "ocm.software/open-component-model/bindings/go/plugin/manager/types"
 	"ocm.software/open-component-model/cli/cmd/configuration"
 	ocmcmd "ocm.software/open-component-model/cli/cmd/internal/cmd"
 	ocmctx "ocm.software/open-component-model/cli/internal/context"
@@ -41,7 +42,18 @@ func OCMConfig(cmd *cobra.Command) error {
 func PluginManager(cmd *cobra.Command) error {
 	pluginManager := manager.NewPluginManager(cmd.Context())
 
-	if cfg := ocmctx.FromContext(cmd.Context()).Configuration(); cfg == nil {
+	ocmContext := ocmctx.FromContext(cmd.Context())
+	httpConfig, err := httpv1alpha1.ResolveHTTPConfig(ocmContext.Configuration())
+	if err != nil {
+		return fmt.Errorf("could not get http configuration: %w", err)
+	}
+	slog.DebugContext(cmd.Context(), "http config resolved",
+		slog.String("timeout", timeoutString(httpConfig.Timeout)),
+		slog.String("tlsHandshakeTimeout", timeoutString(httpConfig.TLSHandshakeTimeout)),
+		slog.Any("hosts", httpConfig.Hosts),
+	)
+
+	if cfg := ocmContext.Configuration(); cfg == nil {
 		slog.WarnContext(cmd.Context(), "could not get configuration to initialize plugin manager")
 	} else {
 		pluginCfg, err := v2alpha1.LookupConfig(cfg)
@@ -62,6 +74,8 @@ func PluginManager(cmd *cobra.Command) error {
 		for _, pluginLocation := range pluginCfg.Locations {
 			err := pluginManager.RegisterPlugins(cmd.Context(), pluginLocation,
 				manager.WithIdleTimeout(time.Duration(pluginCfg.IdleTimeout)),
+				manager.WithProcessOptions(processOptions(pluginCfg)),
+				manager.WithWASMOptions(wasmOptions(pluginCfg, httpConfig)),
 			)
 			if errors.Is(err, manager.ErrNoPluginsFound) {
 				slog.DebugContext(cmd.Context(), "no plugins found at location", slog.String("location", pluginLocation))
@@ -79,17 +93,7 @@ func PluginManager(cmd *cobra.Command) error {
 		}
 	}
 
-	ocmContext := ocmctx.FromContext(cmd.Context())
 	filesystemConfig := ocmContext.FilesystemConfig()
-	httpConfig, err := httpv1alpha1.ResolveHTTPConfig(ocmContext.Configuration())
-	if err != nil {
-		return fmt.Errorf("could not get http configuration: %w", err)
-	}
-	slog.DebugContext(cmd.Context(), "http config resolved",
-		slog.String("timeout", timeoutString(httpConfig.Timeout)),
-		slog.String("tlsHandshakeTimeout", timeoutString(httpConfig.TLSHandshakeTimeout)),
-		slog.Any("hosts", httpConfig.Hosts),
-	)
 	if err := builtin.Register(pluginManager, filesystemConfig, httpConfig, slog.Default()); err != nil {
 		return fmt.Errorf("could not register builtin plugins: %w", err)
 	}
@@ -103,6 +107,7 @@ func PluginManager(cmd *cobra.Command) error {
 	cobra.OnFinalize(func() {
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
+		logPluginMetrics(shutdownCtx, pluginManager)
 		if err := pluginManager.Shutdown(shutdownCtx); err != nil {
 			slog.ErrorContext(shutdownCtx, "failed to shutdown plugin manager", slog.String("error", err.Error()))
 		}
@@ -111,6 +116,72 @@ func PluginManager(cmd *cobra.Command) error {
 	return nil
 }
 
+// logPluginMetrics logs the call metrics of every plugin that was called during the command at debug level.
+func logPluginMetrics(ctx context.Context, pluginManager *manager.PluginManager) {
+	for id, metrics := range pluginManager.Metrics() {
+		if metrics.Calls == 0 {
+			continue
+		}
+		slog.DebugContext(ctx, "plugin call metrics",
+			slog.String("plugin", id),
+			slog.Uint64("calls", metrics.Calls),
+			slog.Uint64("errors", metrics.Errors),
+			slog.Uint64("restarts", metrics.Restarts),
+			slog.Int("instances", metrics.Instances),
+			slog.Duration("duration", metrics.Duration),
+		)
+	}
+}
+
+// processOptions converts the process configuration of the plugin configuration into
+// the options the plugin manager supervises plugin processes with.
+func processOptions(cfg *v2alpha1.Config) mtypes.ProcessOptions {
+	opts := mtypes.ProcessOptions{
+		Instances:           cfg.Instances,
+		HealthCheckInterval: time.Duration(cfg.HealthCheckInterval),
+	}
+	if cfg.Limits != nil {
+		opts.Limits = mtypes.ResourceLimits{
+			MemoryBytes:  cfg.Limits.MemoryBytes,
+			CPUTime:      time.Duration(cfg.Limits.CPUTime),
+			CPUQuota:     cfg.Limits.CPUQuota,
+			CgroupParent: cfg.Limits.CgroupParent,
+		}
+	}
+	return opts
+}
+
+// wasmOptions converts the WebAssembly configuration of the plugin configuration into
+// the options the plugin manager runs plugins compiled to WebAssembly with.
+// HTTP requests of the plugins are sent with the HTTP configuration of the CLI.
+func wasmOptions(cfg *v2alpha1.Config, httpConfig *httpv1alpha1.Config) mtypes.WASMOptions {
+	opts := mtypes.WASMOptions{
+		CompilationCacheDir: v2alpha1.DefaultWASMCompilationCacheDir(),
+		HTTPConfig:          httpConfig,
+	}
+	if cfg.WASM == nil {
+		return opts
+	}
+
+	opts.MemoryLimitBytes = cfg.WASM.MemoryBytes
+	opts.Permissions = make(map[string]mtypes.WASMPermissions, len(cfg.WASM.Permissions))
+	for id, permissions := range cfg.WASM.Permissions {
+		mounts := make([]mtypes.Mount, 0, len(permissions.Mounts))
+		for _, mount := range permissions.Mounts {
+			mounts = append(mounts, mtypes.Mount{
+				HostPath:  os.ExpandEnv(mount.HostPath),
+				GuestPath: mount.GuestPath,
+				ReadOnly:  mount.ReadOnly,
+			})
+		}
+		opts.Permissions[id] = mtypes.WASMPermissions{
+			Mounts:       mounts,
+			AllowedHosts: permissions.AllowedHosts,
+		}
+	}
+	return opts
+}
+
 func CredentialGraph(cmd *cobra.Command) error {
 	pluginManager := ocmctx.FromContext(cmd.Context()).PluginManager()
 	if pluginManager == nil {
@@ -1,11 +1,13 @@
 package componentversion
 
 import (
+	"context"
 	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 	"slices"
 	"strings"
@@ -16,12 +18,17 @@ import (
 	"ocm.software/open-component-model/bindings/go/credentials"
 	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v4alpha1"
 	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	ecdsav1alpha1 "ocm.software/open-component-model/bindings/go/ec/signing/ecdsa/v1alpha1"
+	ed25519v1alpha1 "ocm.software/open-component-model/bindings/go/ec/signing/ed25519/v1alpha1"
+	ocmhttp "ocm.software/open-component-model/bindings/go/http"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
 	"ocm.software/open-component-model/bindings/go/oci/compref"
 	ctfv1 "ocm.software/open-component-model/bindings/go/oci/spec/repository/v1/ctf"
 	ociv1 "ocm.software/open-component-model/bindings/go/oci/spec/repository/v1/oci"
 	"ocm.software/open-component-model/bindings/go/rsa/signing/v1alpha1"
 	"ocm.software/open-component-model/bindings/go/runtime"
 	"ocm.software/open-component-model/bindings/go/signing"
+	"ocm.software/open-component-model/bindings/go/signing/timestamp"
 	ocmctx "ocm.software/open-component-model/cli/internal/context"
 	"ocm.software/open-component-model/cli/internal/flags/enum"
 	"ocm.software/open-component-model/cli/internal/flags/log"
@@ -38,6 +45,10 @@ const (
 	FlagHashAlgorithm          = "hash"
 	FlagDryRun                 = "dry-run"
 	FlagForce                  = "force"
+	FlagTSAURL                 = "tsa-url"
+	FlagRecursive              = "recursive"
+	FlagSignReferences         = "sign-references"
+	FlagComponentSignerSpec    = "component-signer-spec"
 )
 
 const (
@@ -69,6 +80,20 @@ func New() *cobra.Command {
 - Normalise descriptor (--normalisation)
 - Hash normalised descriptor (--hash)
 - Sign hash (--signer-spec)
+- Timestamp signature (--tsa-url, optional)
+
+## Recursive Signing
+
+With --recursive, the component versions referenced by the signed component version are resolved
+transitively and processed bottom-up, referenced component versions before their parents:
+
+- Missing reference digests are set to the digest of the referenced component version
+- Existing reference digests must match the referenced component version, otherwise signing fails
+- --sign-references also signs every referenced component version with the same signature name
+- --component-signer-spec {component}={path} selects a signer spec per component name (default: --signer-spec)
+- Descriptors are only written once all component versions were processed, referenced component versions first;
+  if a write fails, the descriptors written before are restored
+- Setting reference digests fails if the descriptor carries other signatures, since they would become invalid
 
 ## Behavior
 
@@ -77,6 +102,18 @@ func New() *cobra.Command {
 - Default signature name: default
 - Default signer: RSASSA-PSS plugin (needs private key)
 - For Sigstore keyless signing (no keys needed), pass --signer-spec with a SigstoreSigningConfiguration/v1alpha1 config
+- --tsa-url: attach an RFC 3161 timestamp of the signature from the given timestamp authority, so verifiers
+  can accept the signature after the signing certificate expired. The timestamp authority is contacted with the
+  HTTP configuration of the OCM configuration. Only RSA signatures can be timestamped, ECDSA and Ed25519 signer
+  specs are rejected
+
+## Hardware Security Modules (PKCS#11)
+
+**The released ocm binaries do not support PKCS#11.** Keys held by a hardware security module are used through
+PKCS11Credentials/v1 credentials of the RSA, ECDSA and Ed25519 signers. The PKCS#11 module is loaded with dlopen,
+which needs an ocm binary built with cgo on Linux or macOS. The released binaries are cross-compiled without cgo
+and fail with "pkcs11 is not supported by this build". Build ocm with "task build CGO_ENABLED=1" in the cli
+directory to sign with keys in an HSM.
 
 Use this command to establish provenance of component versions.`,
 			compref.DefaultPrefix,
@@ -203,6 +240,15 @@ sign component-version ./repo/ocm//ocm.software/ocmcli:0.23.0 --signer-spec ./rs
 # Dry-run signing
 sign component-version ghcr.io/open-component-model/ocm//ocm.software/ocmcli:0.23.0 --signature test --dry-run
 
+# Attach an RFC 3161 timestamp from a timestamp authority
+sign component-version ./repo/ocm//ocm.software/ocmcli:0.23.0 --signer-spec ./rsassa-pss-pem.yaml --tsa-url https://timestamp.example.com
+
+# Sign a component version after setting the digests of all (transitively) referenced component versions
+sign component-version ./repo/ocm//ocm.software/product:1.0.0 --recursive
+
+# Sign a component version and all referenced component versions, using a dedicated signer spec for one component
+sign component-version ./repo/ocm//ocm.software/product:1.0.0 --recursive --sign-references --component-signer-spec ocm.software/legacy=./rsassa-pkcs1.yaml
+
 # Force overwrite an existing signature
 sign component-version ghcr.io/open-component-model/ocm//ocm.software/ocmcli:0.23.0 --signature my-signature --force`),
 		RunE:              SignComponentVersion,
@@ -213,11 +259,15 @@ sign component-version ghcr.io/open-component-model/ocm//ocm.software/ocmcli:0.2
 
 	cmd.Flags().Int(FlagConcurrencyLimit, 4, "maximum amount of parallel requests to the repository for resolving component versions")
 	cmd.Flags().String(FlagSignature, DefaultSignatureName, "name of the signature to create or update. defaults to \"default\"")
-	cmd.Flags().String(FlagSignerSpec, "", "path to a signer specification file (configures algorithm and encoding, not credentials). If empty, defaults to RSASSA-PSS with Plain encoding.")
+	cmd.Flags().String(FlagSignerSpec, "", "path to a signer specification file (configures algorithm and encoding, not credentials). If empty, defaults to RSASSA-PSS with Plain encoding. Signing with PKCS11Credentials/v1 (HSM keys) requires a binary built with cgo, released binaries are built without.")
 	cmd.Flags().Bool(FlagDryRun, false, "compute signature but do not persist it to the repository")
 	cmd.Flags().String(FlagNormalisationAlgorithm, v4alpha1.Algorithm, "normalisation algorithm to use (default jsonNormalisation/v4alpha1)")
 	cmd.Flags().String(FlagHashAlgorithm, crypto.SHA256.String(), "hash algorithm to use (SHA256, SHA512)")
 	cmd.Flags().Bool(FlagForce, false, "overwrite existing signatures under the same name")
+	cmd.Flags().String(FlagTSAURL, "", "URL of an RFC 3161 timestamp authority to timestamp the signature with. If empty, no timestamp is attached. Only supported for RSA signatures.")
+	cmd.Flags().Bool(FlagRecursive, false, "set the digests of all (transitively) referenced component versions bottom-up before signing")
+	cmd.Flags().Bool(FlagSignReferences, false, "with --recursive, also sign all referenced component versions")
+	cmd.Flags().StringToString(FlagComponentSignerSpec, nil, "with --recursive, path to a signer specification file per component name ({component}={path}). Defaults to --signer-spec.")
 
 	return cmd
 }
@@ -263,6 +313,14 @@ func SignComponentVersion(cmd *cobra.Command, args []string) error {
 	signerSpecPath, _ := cmd.Flags().GetString(FlagSignerSpec)
 	force, _ := cmd.Flags().GetBool(FlagForce)
 	dryRun, _ := cmd.Flags().GetBool(FlagDryRun)
+	tsaURL, _ := cmd.Flags().GetString(FlagTSAURL)
+	recursive, _ := cmd.Flags().GetBool(FlagRecursive)
+	signReferences, _ := cmd.Flags().GetBool(FlagSignReferences)
+	componentSignerSpecPaths, _ := cmd.Flags().GetStringToString(FlagComponentSignerSpec)
+	concurrencyLimit, _ := cmd.Flags().GetInt(FlagConcurrencyLimit)
+	if !recursive && (signReferences || len(componentSignerSpecPaths) > 0) {
+		return fmt.Errorf("--%s and --%s require --%s", FlagSignReferences, FlagComponentSignerSpec, FlagRecursive)
+	}
 
 	reference := args[0]
 	ref, err := compref.Parse(reference, compref.WithCTFAccessMode(ctfv1.AccessModeReadWrite))
@@ -270,11 +328,50 @@ func SignComponentVersion(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("parsing component reference %q failed: %w", reference, err)
 	}
 	config := ocmContext.Configuration()
+	var tsa *timestampAuthority
+	if tsaURL != "" {
+		httpConfig, err := httpv1alpha1.ResolveHTTPConfig(config)
+		if err != nil {
+			return fmt.Errorf("resolving http configuration failed: %w", err)
+		}
+		tsa = &timestampAuthority{url: tsaURL, client: ocmhttp.New(ocmhttp.WithConfig(httpConfig))}
+	}
 	repoProvider, err := ocm.NewComponentVersionRepositoryForComponentProvider(cmd.Context(), pluginManager.ComponentVersionRepositoryRegistry, credentialGraph, config, ref)
 	if err != nil {
 		return fmt.Errorf("could not initialize ocm repository: %w", err)
 	}
 
+	if recursive {
+		signerSpec, err := loadSignerSpec(signerSpecPath, logger)
+		if err != nil {
+			return err
+		}
+		if err := tsa.supports(signerSpec); err != nil {
+			return err
+		}
+		componentSignerSpecs := make(map[string]runtime.Typed, len(componentSignerSpecPaths))
+		for component, path := range componentSignerSpecPaths {
+			if componentSignerSpecs[component], err = loadSignerSpec(path, logger); err != nil {
+				return err
+			}
+			if err := tsa.supports(componentSignerSpecs[component]); err != nil {
+				return err
+			}
+		}
+		return signComponentVersionRecursively(cmd, ref.Component, ref.Version, repoProvider, pluginManager.SigningRegistry, credentialGraph, logger, recursiveSigningOptions{
+			signatureName:          signatureName,
+			signerSpec:             signerSpec,
+			componentSignerSpecs:   componentSignerSpecs,
+			signReferences:         signReferences,
+			force:                  force,
+			dryRun:                 dryRun,
+			tsa:                    tsa,
+			normalisationAlgorithm: cmd.Flag(FlagNormalisationAlgorithm).Value.String(),
+			hashAlgorithm:          cmd.Flag(FlagHashAlgorithm).Value.String(),
+			concurrencyLimit:       concurrencyLimit,
+		})
+	}
+
 	repo, err := repoProvider.GetComponentVersionRepositoryForComponent(cmd.Context(), ref.Component, ref.Version)
 	if err != nil {
 		return fmt.Errorf("could not access ocm repository: %w", err)
@@ -294,6 +391,9 @@ func SignComponentVersion(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if err := tsa.supports(signerSpec); err != nil {
+		return err
+	}
 
 	handler, err := pluginManager.SigningRegistry.GetPlugin(ctx, signerSpec)
 	if err != nil {
@@ -319,31 +419,9 @@ func SignComponentVersion(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("generating digest failed: %w", err)
 	}
 
-	// credentials
-	var foundCreds runtime.Typed
-	if consumerID, err := handler.GetSigningCredentialConsumerIdentity(ctx, signatureName, *unsignedDigest, signerSpec); err == nil {
-		if creds, err := credentialGraph.Resolve(ctx, consumerID); err == nil {
-			foundCreds = creds
-			logger.DebugContext(ctx, "using discovered credentials", "type", foundCreds.GetType())
-		} else {
-			if errors.Is(err, credentials.ErrNotFound) {
-				logger.DebugContext(ctx, "could not resolve credentials", "error", err.Error())
-			} else {
-				return fmt.Errorf("resolving signing credentials failed: %w", err)
-			}
-		}
-	}
-
-	// sign
-	sigBytes, err := handler.Sign(ctx, *unsignedDigest, signerSpec, foundCreds)
+	out, err := signDigest(ctx, logger, handler, signerSpec, credentialGraph, signatureName, *unsignedDigest, tsa)
 	if err != nil {
-		return fmt.Errorf("signing failed: %w", err)
-	}
-
-	out := descruntime.Signature{
-		Name:      signatureName,
-		Digest:    *unsignedDigest,
-		Signature: sigBytes,
+		return err
 	}
 
 	if err := printSignature(cmd, out); err != nil {
@@ -375,6 +453,76 @@ func SignComponentVersion(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// signDigest signs the digest with the handler, using the credentials resolved for the signature name,
+// and timestamps the signature if tsa is set.
+func signDigest(ctx context.Context,
+	logger *slog.Logger,
+	handler signing.Handler,
+	signerSpec runtime.Typed,
+	credentialGraph credentials.Resolver,
+	signatureName string,
+	unsignedDigest descruntime.Digest,
+	tsa *timestampAuthority,
+) (descruntime.Signature, error) {
+	// credentials
+	var foundCreds runtime.Typed
+	if consumerID, err := handler.GetSigningCredentialConsumerIdentity(ctx, signatureName, unsignedDigest, signerSpec); err == nil {
+		if creds, err := credentialGraph.Resolve(ctx, consumerID); err == nil {
+			foundCreds = creds
+			logger.DebugContext(ctx, "using discovered credentials", "type", foundCreds.GetType())
+		} else {
+			if errors.Is(err, credentials.ErrNotFound) {
+				logger.DebugContext(ctx, "could not resolve credentials", "error", err.Error())
+			} else {
+				return descruntime.Signature{}, fmt.Errorf("resolving signing credentials failed: %w", err)
+			}
+		}
+	}
+
+	// sign
+	sigBytes, err := handler.Sign(ctx, unsignedDigest, signerSpec, foundCreds)
+	if err != nil {
+		return descruntime.Signature{}, fmt.Errorf("signing failed: %w", err)
+	}
+
+	out := descruntime.Signature{
+		Name:      signatureName,
+		Digest:    unsignedDigest,
+		Signature: sigBytes,
+	}
+
+	// timestamp
+	if tsa != nil {
+		if out.Timestamp, err = timestamp.ForSignature(ctx, tsa.client, tsa.url, sigBytes); err != nil {
+			return descruntime.Signature{}, fmt.Errorf("timestamping signature failed: %w", err)
+		}
+		logger.DebugContext(ctx, "timestamped signature", "tsa", tsa.url, "time", out.Timestamp.Time)
+	}
+	return out, nil
+}
+
+// timestampAuthority is the RFC 3161 timestamp authority signatures are timestamped with.
+// The client is built from the HTTP configuration of the OCM context, so proxies,
+// timeouts and retries apply as for all other requests of the CLI.
+type timestampAuthority struct {
+	url    string
+	client *http.Client
+}
+
+// supports returns an error if signatures created with signerSpec cannot be timestamped.
+// Only RSA signatures are verified at the time asserted by their timestamp, ECDSA and
+// Ed25519 signatures would carry a timestamp that verification ignores.
+// A nil timestampAuthority supports all signer specs.
+func (tsa *timestampAuthority) supports(signerSpec runtime.Typed) error {
+	if tsa == nil {
+		return nil
+	}
+	if typ := signerSpec.GetType(); ecdsav1alpha1.Scheme.IsRegistered(typ) || ed25519v1alpha1.Scheme.IsRegistered(typ) {
+		return fmt.Errorf("--%s is not supported for %s signatures, only RSA signatures can be timestamped", FlagTSAURL, typ)
+	}
+	return nil
+}
+
 func loadSignerSpec(path string, logger *slog.Logger) (_ runtime.Typed, err error) {
 	if path == "" {
 		spec := &v1alpha1.Config{
@@ -0,0 +1,351 @@
+package componentversion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"ocm.software/open-component-model/bindings/go/credentials"
+	"ocm.software/open-component-model/bindings/go/dag"
+	syncdag "ocm.software/open-component-model/bindings/go/dag/sync"
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	descriptorv2 "ocm.software/open-component-model/bindings/go/descriptor/v2"
+	"ocm.software/open-component-model/bindings/go/repository"
+	"ocm.software/open-component-model/bindings/go/repository/component/resolvers"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/signing"
+	"ocm.software/open-component-model/cli/internal/flags/enum"
+	"ocm.software/open-component-model/cli/internal/render"
+)
+
+// signingHandlerProvider returns the signing handler for a signer specification.
+type signingHandlerProvider interface {
+	GetPlugin(ctx context.Context, spec runtime.Typed) (signing.Handler, error)
+}
+
+// recursiveSigningOptions configure the signing of a component version and its references.
+type recursiveSigningOptions struct {
+	signatureName string
+	// signerSpec is used for all component versions without an entry in componentSignerSpecs.
+	signerSpec runtime.Typed
+	// componentSignerSpecs holds signer specifications by component name.
+	componentSignerSpecs map[string]runtime.Typed
+	// signReferences also signs all referenced component versions, not only the root.
+	signReferences         bool
+	force                  bool
+	dryRun                 bool
+	tsa                    *timestampAuthority
+	normalisationAlgorithm string
+	hashAlgorithm          string
+	concurrencyLimit       int
+}
+
+// signingNode is a component version in the reference graph of the signed component version.
+type signingNode struct {
+	repo repository.ComponentVersionRepository
+	// original is the descriptor as read from the repository, used to roll back failed updates.
+	original *descruntime.Descriptor
+	desc     *descruntime.Descriptor
+	root     bool
+	// digest is the digest of desc after reference digests were set.
+	digest *descruntime.Digest
+	// signature is set if the component version was signed.
+	signature *descruntime.Signature
+	// changed is true if desc differs from original and must be persisted.
+	changed bool
+}
+
+// recursiveSigner signs a component version after computing the digests of all
+// component versions it references, bottom-up.
+type recursiveSigner struct {
+	repoResolver    resolvers.ComponentVersionRepositoryResolver
+	handlers        signingHandlerProvider
+	credentialGraph credentials.Resolver
+	logger          *slog.Logger
+	opts            recursiveSigningOptions
+
+	discoverer *syncdag.GraphDiscoverer[string, *signingNode]
+	root       string
+}
+
+var (
+	_ syncdag.Resolver[string, *signingNode]   = (*recursiveSigner)(nil)
+	_ syncdag.Discoverer[string, *signingNode] = (*recursiveSigner)(nil)
+	_ syncdag.Processor[*signingNode]          = (*recursiveSigner)(nil)
+)
+
+// signComponentVersionRecursively signs the component version and, depending on the options,
+// its references. Missing reference digests are computed and set bottom-up, existing reference
+// digests must match the referenced component versions. Descriptors are only persisted once all
+// component versions were processed successfully.
+func signComponentVersionRecursively(cmd *cobra.Command,
+	component, version string,
+	repoResolver resolvers.ComponentVersionRepositoryResolver,
+	handlers signingHandlerProvider,
+	credentialGraph credentials.Resolver,
+	logger *slog.Logger,
+	opts recursiveSigningOptions,
+) error {
+	ctx := cmd.Context()
+	s := &recursiveSigner{
+		repoResolver:    repoResolver,
+		handlers:        handlers,
+		credentialGraph: credentialGraph,
+		logger:          logger,
+		opts:            opts,
+		root:            componentIdentity(component, version),
+	}
+	s.discoverer = syncdag.NewGraphDiscoverer(&syncdag.GraphDiscovererOptions[string, *signingNode]{
+		Roots:      []string{s.root},
+		Resolver:   s,
+		Discoverer: s,
+	})
+	if err := s.discoverer.Discover(ctx); err != nil {
+		return fmt.Errorf("traversing component version graph failed: %w", err)
+	}
+
+	// process children before their parents
+	var order []string
+	var reversed *dag.DirectedAcyclicGraph[string]
+	if err := s.discoverer.Graph().WithReadLock(func(d *dag.DirectedAcyclicGraph[string]) (err error) {
+		if order, err = d.TopologicalSort(); err != nil {
+			return fmt.Errorf("sorting component version graph failed: %w", err)
+		}
+		if reversed, err = d.Reverse(); err != nil {
+			return fmt.Errorf("failed to reverse graph: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	processor := syncdag.NewGraphProcessor(syncdag.ToSyncedGraph(reversed), &syncdag.GraphProcessorOptions[string, *signingNode]{
+		Processor:   s,
+		Concurrency: opts.concurrencyLimit,
+	})
+	if err := processor.Process(ctx); err != nil {
+		return fmt.Errorf("signing component version graph failed: %w", err)
+	}
+
+	nodes := make([]*signingNode, len(order))
+	for i, id := range order {
+		nodes[i] = s.discoverer.CurrentValue(id)
+	}
+	if err := printSignedComponentVersions(cmd, nodes); err != nil {
+		return err
+	}
+
+	if opts.dryRun {
+		logger.InfoContext(ctx, "dry run: signatures and reference digests not persisted")
+		return nil
+	}
+	if err := persist(ctx, nodes, logger); err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if node.signature != nil {
+			logger.InfoContext(ctx, "signed successfully",
+				"component", node.desc.Component.Name,
+				"version", node.desc.Component.Version,
+				"name", node.signature.Name,
+				"digest", node.signature.Digest.Value,
+			)
+		}
+	}
+	return nil
+}
+
+func (s *recursiveSigner) Resolve(ctx context.Context, key string) (*signingNode, error) {
+	id, err := runtime.ParseIdentity(key)
+	if err != nil {
+		return nil, fmt.Errorf("parsing identity %q failed: %w", key, err)
+	}
+	component, version := id[descruntime.IdentityAttributeName], id[descruntime.IdentityAttributeVersion]
+	repo, err := s.repoResolver.GetComponentVersionRepositoryForComponent(ctx, component, version)
+	if err != nil {
+		return nil, fmt.Errorf("getting component version repository for identity %q failed: %w", id, err)
+	}
+	desc, err := repo.GetComponentVersion(ctx, component, version)
+	if err != nil {
+		return nil, fmt.Errorf("getting component version for identity %q failed: %w", id, err)
+	}
+	return &signingNode{
+		repo:     repo,
+		original: desc,
+		desc:     cloneForSigning(desc),
+		root:     key == s.root,
+	}, nil
+}
+
+func (s *recursiveSigner) Discover(_ context.Context, parent *signingNode) ([]string, error) {
+	children := make([]string, len(parent.desc.Component.References))
+	for i, ref := range parent.desc.Component.References {
+		children[i] = ref.ToComponentIdentity().String()
+	}
+	return children, nil
+}
+
+// ProcessValue sets the reference digests of the component version, computes its digest
+// and signs it if requested. All referenced component versions are processed before.
+func (s *recursiveSigner) ProcessValue(ctx context.Context, node *signingNode) error {
+	desc := node.desc
+	identity := desc.Component.ToIdentity().String()
+
+	for i := range desc.Component.References {
+		ref := &desc.Component.References[i]
+		child := s.discoverer.CurrentValue(ref.ToComponentIdentity().String())
+		if child == nil || child.digest == nil {
+			return fmt.Errorf("referenced component version %s of %s was not processed", ref.ToComponentIdentity(), identity)
+		}
+		if ref.Digest.Value == "" {
+			s.logger.DebugContext(ctx, "setting reference digest", "component", identity, "reference", ref.Name, "digest", child.digest.Value)
+			ref.Digest = *child.digest
+			node.changed = true
+			continue
+		}
+		if err := signing.VerifyDigestMatchesDescriptor(ctx, child.desc, descruntime.Signature{Digest: ref.Digest}, s.logger); err != nil {
+			return fmt.Errorf("digest of reference %q in %s does not match %s: %w", ref.Name, identity, ref.ToComponentIdentity(), err)
+		}
+	}
+
+	sign := node.root || s.opts.signReferences
+	if node.changed {
+		var invalidated []string
+		for _, sig := range desc.Signatures {
+			if !sign || sig.Name != s.opts.signatureName {
+				invalidated = append(invalidated, sig.Name)
+			}
+		}
+		if len(invalidated) > 0 {
+			return fmt.Errorf("setting reference digests of %s would invalidate its signatures %q", identity, invalidated)
+		}
+	}
+
+	digest, err := signing.GenerateDigest(ctx, desc, s.logger, s.opts.normalisationAlgorithm, s.opts.hashAlgorithm)
+	if err != nil {
+		return fmt.Errorf("generating digest of %s failed: %w", identity, err)
+	}
+	node.digest = digest
+	if !sign {
+		return nil
+	}
+
+	sigExists := func(sig descruntime.Signature) bool { return sig.Name == s.opts.signatureName }
+	idx := slices.IndexFunc(desc.Signatures, sigExists)
+	if idx >= 0 {
+		if !s.opts.force {
+			return fmt.Errorf("signature %q already exists on %s", s.opts.signatureName, identity)
+		}
+		s.logger.InfoContext(ctx, "overwriting existing signature", "component", identity, "name", s.opts.signatureName)
+	}
+
+	signerSpec := s.opts.signerSpec
+	if spec, ok := s.opts.componentSignerSpecs[desc.Component.Name]; ok {
+		signerSpec = spec
+	}
+	handler, err := s.handlers.GetPlugin(ctx, signerSpec)
+	if err != nil {
+		return fmt.Errorf("getting signature handler for %s failed: %w", identity, err)
+	}
+	signature, err := signDigest(ctx, s.logger.With("component", identity), handler, signerSpec, s.credentialGraph, s.opts.signatureName, *digest, s.opts.tsa)
+	if err != nil {
+		return fmt.Errorf("signing %s failed: %w", identity, err)
+	}
+
+	if idx >= 0 {
+		desc.Signatures[idx] = signature
+	} else {
+		desc.Signatures = append(desc.Signatures, signature)
+	}
+	node.signature = &signature
+	node.changed = true
+	return nil
+}
+
+// persist writes all changed descriptors, children first. If a write fails,
+// the descriptors written before are restored.
+func persist(ctx context.Context, nodes []*signingNode, logger *slog.Logger) error {
+	var written []*signingNode
+	for _, node := range nodes {
+		if !node.changed {
+			continue
+		}
+		if err := node.repo.AddComponentVersion(ctx, node.desc); err != nil {
+			err = fmt.Errorf("updating component version %s failed: %w", node.desc.Component.ToIdentity(), err)
+			for _, restore := range slices.Backward(written) {
+				logger.WarnContext(ctx, "restoring component version", "component", restore.original.Component.ToIdentity().String())
+				if rerr := restore.repo.AddComponentVersion(ctx, restore.original); rerr != nil {
+					err = errors.Join(err, fmt.Errorf("restoring component version %s failed: %w", restore.original.Component.ToIdentity(), rerr))
+				}
+			}
+			return err
+		}
+		written = append(written, node)
+	}
+	return nil
+}
+
+// signedComponentVersion is the output of recursive signing for a single component version.
+type signedComponentVersion struct {
+	Component string                  `json:"component"`
+	Version   string                  `json:"version"`
+	Signature *descriptorv2.Signature `json:"signature"`
+}
+
+// printSignedComponentVersions prints the created signatures, starting with the root.
+func printSignedComponentVersions(cmd *cobra.Command, nodes []*signingNode) error {
+	output, err := enum.Get(cmd.Flags(), FlagOutput)
+	if err != nil {
+		return fmt.Errorf("getting output flag failed: %w", err)
+	}
+
+	var signed []signedComponentVersion
+	for _, node := range slices.Backward(nodes) {
+		if node.signature != nil {
+			signed = append(signed, signedComponentVersion{
+				Component: node.desc.Component.Name,
+				Version:   node.desc.Component.Version,
+				Signature: descruntime.ConvertToV2Signature(node.signature),
+			})
+		}
+	}
+
+	var b []byte
+	switch strings.ToLower(output) {
+	case render.OutputFormatJSON.String():
+		if b, err = json.MarshalIndent(signed, "", "  "); err != nil {
+			return fmt.Errorf("marshalling signatures to json failed: %w", err)
+		}
+	case render.OutputFormatYAML.String():
+		if b, err = yaml.Marshal(signed); err != nil {
+			return fmt.Errorf("marshalling signatures to yaml failed: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported output format %q (supported: json|yaml)", output)
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(b))
+	return err
+}
+
+// cloneForSigning copies the parts of the descriptor modified during signing,
+// the signatures and the reference digests, and shares everything else.
+func cloneForSigning(desc *descruntime.Descriptor) *descruntime.Descriptor {
+	clone := *desc
+	clone.Signatures = slices.Clone(desc.Signatures)
+	clone.Component.References = slices.Clone(desc.Component.References)
+	return &clone
+}
+
+func componentIdentity(component, version string) string {
+	return runtime.Identity{
+		descruntime.IdentityAttributeName:    component,
+		descruntime.IdentityAttributeVersion: version,
+	}.String()
+}
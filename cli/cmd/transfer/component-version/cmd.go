@@ -24,9 +24,12 @@ import (
 	ocmctx "ocm.software/open-component-model/cli/internal/context"
 	"ocm.software/open-component-model/cli/internal/flags/enum"
 	"ocm.software/open-component-model/cli/internal/render"
+	"ocm.software/open-component-model/cli/internal/render/graph/diagram"
 	"ocm.software/open-component-model/cli/internal/render/progress"
 	"ocm.software/open-component-model/cli/internal/render/progress/bar"
+	"ocm.software/open-component-model/cli/internal/render/progress/transformation"
 	"ocm.software/open-component-model/cli/internal/repository/ocm"
+	"ocm.software/open-component-model/cli/internal/transformers"
 )
 
 const (
@@ -37,6 +40,11 @@ const (
 	FlagUploadAs      = "upload-as"
 	FlagTransferSpec  = "transfer-spec"
 
+	FlagRetries               = "retries"
+	FlagRetryBackoff          = "retry-backoff"
+	FlagTransformationTimeout = "transformation-timeout"
+	FlagContinueOnError       = "continue-on-error"
+
 	// Each node emits 2 events (Running + Completed/Failed) and since the tracker consumes
 	// them faster than the transfer produces, 16 is enough to avoid blocking with room to grow.
 	eventBufferSize = 16
@@ -72,7 +80,12 @@ from a file (or stdin with "-"), enabling a two-step workflow:
 
 Flags like --recursive, --copy-resources, and --upload-as are baked into the generated spec during
 step 1. When --transfer-spec is used in step 2, these flags are ignored because the spec already
-contains the full graph definition. Only --dry-run and --output remain meaningful in step 2.`,
+contains the full graph definition. Only --dry-run and --output remain meaningful in step 2.
+
+--retries, --retry-backoff, --transformation-timeout and --continue-on-error set the policy of every
+transformation that has no policy of its own, both in generated specs and in specs loaded with --transfer-spec.
+With --continue-on-error, component versions and resources that fail to transfer don't stop the transfer of
+the others. Transformations depending on a failed one are skipped and the command fails once all others finished.`,
 		Example: strings.TrimSpace(`
 # Transfer a component version from a CTF archive to an OCI registry
 transfer component-version ctf::./my-archive//ocm.software/mycomponent:1.0.0 ghcr.io/my-org/ocm
@@ -99,18 +112,28 @@ transfer component-version ghcr.io/source-org/ocm//ocm.software/mycomponent:1.0.
 transfer component-version --dry-run -o yaml --copy-resources -r ghcr.io/source-org/ocm//ocm.software/mycomponent:1.0.0 ghcr.io/target-org/ocm > spec.yaml
 # (review/edit spec.yaml as needed, e.g. change the target registry)
 transfer component-version --transfer-spec spec.yaml
+
+# Retry failing transformations and transfer as much as possible despite failures
+transfer component-version ghcr.io/source-org/ocm//ocm.software/mycomponent:1.0.0 ghcr.io/target-org/ocm -r --copy-resources --retries 3 --continue-on-error
+
+# Render the transformation graph as Mermaid diagram, for example for a pull request comment
+transfer component-version --dry-run -o mermaid --copy-resources -r ghcr.io/source-org/ocm//ocm.software/mycomponent:1.0.0 ghcr.io/target-org/ocm
 `),
 		Args:              transferArgs,
 		RunE:              TransferComponentVersion,
 		DisableAutoGenTag: true,
 	}
 
-	enum.VarP(cmd.Flags(), FlagOutput, "o", []string{render.OutputFormatYAML.String(), render.OutputFormatJSON.String(), render.OutputFormatNDJSON.String()}, "output format of the component descriptors")
+	enum.VarP(cmd.Flags(), FlagOutput, "o", []string{render.OutputFormatYAML.String(), render.OutputFormatJSON.String(), render.OutputFormatNDJSON.String(), render.OutputFormatDOT.String(), render.OutputFormatMermaid.String()}, "output format of the transformation graph in dry-run mode, dot and mermaid render the graph as diagram")
 	cmd.Flags().Bool(FlagDryRun, false, "build and validate the graph but do not execute")
 	cmd.Flags().BoolP(FlagRecursive, "r", false, "recursively discover and transfer component versions")
 	cmd.Flags().Bool(FlagCopyResources, false, "copy all resources in the component version")
 	enum.VarP(cmd.Flags(), FlagUploadAs, "u", []string{UploadAsDefault.String(), UploadAsLocalBlob.String(), UploadAsOciArtifact.String()}, "Define whether copied resources should be uploaded as OCI artifacts (instead of local blob resources). This option is only relevant if --copy-resources is set.")
 	cmd.Flags().String(FlagTransferSpec, "", "path to a transfer specification file (use \"-\" for stdin)")
+	cmd.Flags().Int(FlagRetries, 0, "number of retries of a failed transformation")
+	cmd.Flags().Duration(FlagRetryBackoff, transformv1alpha1.DefaultBackoff, "delay before the first retry of a failed transformation, doubled after every retry")
+	cmd.Flags().Duration(FlagTransformationTimeout, 0, "time limit of every attempt of a transformation, 0 means no limit")
+	cmd.Flags().Bool(FlagContinueOnError, false, "continue with independent transformations if a transformation failed, the command fails once all of them finished")
 
 	return cmd
 }
@@ -193,12 +216,24 @@ func TransferComponentVersion(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	policy, err := policyFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	applyPolicy(tgd, policy)
+
 	// Build transformation graph
-	b := transfer.NewDefaultBuilder(pm.ComponentVersionRepositoryRegistry, pm.ResourcePluginRegistry, credGraph)
+	// transformations of plugins can be part of transfer specifications provided with --transfer-spec.
+	b := transfer.NewDefaultBuilder(pm.ComponentVersionRepositoryRegistry, pm.ResourcePluginRegistry, credGraph).
+		WithTransformerResolver(transformers.NewPluginResolver(pm.TransformationRegistry, credGraph))
 	graph, err := b.
 		WithEvents(make(chan graphRuntime.ProgressEvent, eventBufferSize)).
 		BuildAndCheck(tgd)
 	if err != nil {
+		// without a checked graph there is no diagram, show the definition instead.
+		if _, isDiagram := diagram.ParseFormat(output); isDiagram {
+			output = render.OutputFormatYAML.String()
+		}
 		reader, rerr := renderTGD(tgd, output)
 		if rerr != nil {
 			return errors.Join(err, rerr)
@@ -218,6 +253,13 @@ func TransferComponentVersion(cmd *cobra.Command, args []string) error {
 		return errors.Join(err, fmt.Errorf("%s", raw))
 	}
 
+	if diagramFormat, isDiagram := diagram.ParseFormat(output); dryRun && isDiagram {
+		if err := diagram.NewTransformationRenderer(graph, diagramFormat).Render(ctx, cmd.OutOrStdout()); err != nil {
+			return fmt.Errorf("rendering transformation graph failed: %w", err)
+		}
+		return nil
+	}
+
 	if dryRun {
 		reader, err := renderTGD(tgd, output)
 		if err != nil {
@@ -236,8 +278,8 @@ func TransferComponentVersion(cmd *cobra.Command, args []string) error {
 
 	// Execute graph with progress tracking
 	op := tracker.StartOperation("Transferring component versions",
-		progress.WithEvents(graph.Events(), mapEvent, graph.NodeCount()),
-		progress.WithErrorFormatter(formatError))
+		progress.WithEvents(graph.Events(), transformation.MapEvent, graph.NodeCount()),
+		progress.WithErrorFormatter(transformation.FormatError))
 
 	if err := graph.Process(ctx); err != nil {
 		op.Finish(err)
@@ -250,6 +292,59 @@ func TransferComponentVersion(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// policyFromFlags returns the transformation policy configured with flags, nil if no policy flag is set.
+func policyFromFlags(cmd *cobra.Command) (*transformv1alpha1.Policy, error) {
+	flags := cmd.Flags()
+	if !flags.Changed(FlagRetries) && !flags.Changed(FlagRetryBackoff) &&
+		!flags.Changed(FlagTransformationTimeout) && !flags.Changed(FlagContinueOnError) {
+		return nil, nil
+	}
+
+	retries, err := flags.GetInt(FlagRetries)
+	if err != nil {
+		return nil, fmt.Errorf("getting retries flag failed: %w", err)
+	}
+	backoff, err := flags.GetDuration(FlagRetryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("getting retry-backoff flag failed: %w", err)
+	}
+	timeout, err := flags.GetDuration(FlagTransformationTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("getting transformation-timeout flag failed: %w", err)
+	}
+	continueOnError, err := flags.GetBool(FlagContinueOnError)
+	if err != nil {
+		return nil, fmt.Errorf("getting continue-on-error flag failed: %w", err)
+	}
+
+	policy := &transformv1alpha1.Policy{
+		Retries:         retries,
+		ContinueOnError: continueOnError,
+	}
+	if flags.Changed(FlagRetryBackoff) {
+		policy.Backoff = backoff.String()
+	}
+	if timeout > 0 {
+		policy.Timeout = timeout.String()
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid transformation policy: %w", err)
+	}
+	return policy, nil
+}
+
+// applyPolicy sets the policy of all transformations that have no policy of their own.
+func applyPolicy(tgd *transformv1alpha1.TransformationGraphDefinition, policy *transformv1alpha1.Policy) {
+	if policy == nil {
+		return
+	}
+	for i := range tgd.Transformations {
+		if tgd.Transformations[i].Policy == nil {
+			tgd.Transformations[i].Policy = policy.DeepCopy()
+		}
+	}
+}
+
 // loadTransferSpec reads a TransformationGraphDefinition from a file path or stdin (when path is "-").
 func loadTransferSpec(path string, stdin io.Reader) (*transformv1alpha1.TransformationGraphDefinition, error) {
 	var data []byte
@@ -477,3 +477,53 @@ func TestTransferComponentVersionPreservesSignatures(t *testing.T) {
 	r.Len(targetDesc.Component.Resources, 1, "transferred descriptor should have 1 resource")
 	r.Equal("test-blob", targetDesc.Component.Resources[0].Name)
 }
+
+func TestTransferComponentVersionPolicyFlags(t *testing.T) {
+	sourceRef := setupSourceRef(t, "ocm.software/policy-test", "0.0.1")
+	target := fmt.Sprintf("ctf::%s", t.TempDir())
+
+	dryRun := func(t *testing.T, args ...string) string {
+		t.Helper()
+		out := new(bytes.Buffer)
+		_, err := test.OCM(t,
+			test.WithArgs(append([]string{"transfer", "component-version", "--dry-run", "-o", "yaml"}, args...)...),
+			test.WithOutput(out),
+			test.WithErrorOutput(test.NewJSONLogReader()),
+		)
+		require.NoError(t, err)
+		return out.String()
+	}
+
+	t.Run("no policy without flags", func(t *testing.T) {
+		require.NotContains(t, dryRun(t, sourceRef, target), "policy:")
+	})
+
+	t.Run("flags set the policy of every transformation", func(t *testing.T) {
+		spec := dryRun(t, sourceRef, target, "--retries", "2", "--retry-backoff", "5s", "--transformation-timeout", "10m", "--continue-on-error")
+		transformations := strings.Count(spec, "- id: ")
+		require.Positive(t, transformations)
+		require.Equal(t, transformations, strings.Count(spec, "policy:"))
+		require.Equal(t, transformations, strings.Count(spec, "retries: 2"))
+		require.Equal(t, transformations, strings.Count(spec, "backoff: 5s"))
+		require.Equal(t, transformations, strings.Count(spec, "timeout: 10m0s"))
+		require.Equal(t, transformations, strings.Count(spec, "continueOnError: true"))
+	})
+
+	t.Run("policies of a transfer spec are kept", func(t *testing.T) {
+		spec := dryRun(t, sourceRef, target, "--retries", "5")
+		specFile := writeSpecFile(t, spec)
+		rendered := dryRun(t, "--transfer-spec", specFile, "--retries", "1", "--continue-on-error")
+		require.NotContains(t, rendered, "retries: 1")
+		require.NotContains(t, rendered, "continueOnError")
+		require.Equal(t, strings.Count(spec, "retries: 5"), strings.Count(rendered, "retries: 5"))
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		_, err := test.OCM(t,
+			test.WithArgs("transfer", "component-version", "--dry-run", sourceRef, target, "--retries", "-1"),
+			test.WithOutput(new(bytes.Buffer)),
+			test.WithErrorOutput(test.NewJSONLogReader()),
+		)
+		require.ErrorContains(t, err, "retries must not be negative")
+	})
+}
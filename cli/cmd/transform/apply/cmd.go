@@ -0,0 +1,158 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	graphPkg "ocm.software/open-component-model/bindings/go/transform/graph"
+	graphRuntime "ocm.software/open-component-model/bindings/go/transform/graph/runtime"
+	transformv1alpha1 "ocm.software/open-component-model/bindings/go/transform/spec/v1alpha1"
+	"ocm.software/open-component-model/cli/cmd/transform/internal"
+	ocmctx "ocm.software/open-component-model/cli/internal/context"
+	"ocm.software/open-component-model/cli/internal/flags/enum"
+	"ocm.software/open-component-model/cli/internal/render"
+	"ocm.software/open-component-model/cli/internal/render/graph/diagram"
+	"ocm.software/open-component-model/cli/internal/render/progress"
+	"ocm.software/open-component-model/cli/internal/render/progress/bar"
+	"ocm.software/open-component-model/cli/internal/render/progress/transformation"
+)
+
+const (
+	FlagDryRun = "dry-run"
+	FlagOutput = "output"
+
+	// Each node emits 2 events (Running + Completed/Failed), see transfer component-version.
+	eventBufferSize = 16
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply -f {definition}",
+		Short: "Execute a transformation graph definition",
+		Long: `Execute a TransformationGraphDefinition with the transformers that are also used by
+"ocm transfer component-version", for example to get, convert, localize and upload artifacts.
+
+The definition is statically checked before any transformation is executed.
+Values of the environment can be provided with --env files. They are merged into the
+environment of the definition, later files take precedence.
+
+With --dry-run, the definition is checked and printed with every CEL expression evaluated
+that does not depend on the output of another transformation. With -o dot or -o mermaid,
+the checked graph is rendered as diagram instead.`,
+		Example: strings.TrimSpace(`
+# Execute a transformation graph definition
+transform apply -f pipeline.yaml
+
+# Provide the environment from separate files
+transform apply -f pipeline.yaml --env defaults.yaml --env production.yaml
+
+# Review the definition with the environment applied without executing it
+transform apply -f pipeline.yaml --env production.yaml --dry-run -o yaml
+
+# Render the graph as Graphviz diagram
+transform apply -f pipeline.yaml --dry-run -o dot | dot -Tsvg > pipeline.svg
+`),
+		Args:              cobra.NoArgs,
+		RunE:              Apply,
+		DisableAutoGenTag: true,
+	}
+
+	internal.AddDefinitionFlags(cmd)
+	enum.VarP(cmd.Flags(), FlagOutput, "o", []string{render.OutputFormatYAML.String(), render.OutputFormatJSON.String(), render.OutputFormatNDJSON.String(), render.OutputFormatDOT.String(), render.OutputFormatMermaid.String()}, "output format of the rendered definition in dry-run mode, dot and mermaid render the graph as diagram")
+	cmd.Flags().Bool(FlagDryRun, false, "check and render the definition but do not execute it")
+
+	return cmd
+}
+
+func Apply(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	dryRun, err := cmd.Flags().GetBool(FlagDryRun)
+	if err != nil {
+		return fmt.Errorf("getting dry-run flag failed: %w", err)
+	}
+	output, err := enum.Get(cmd.Flags(), FlagOutput)
+	if err != nil {
+		return fmt.Errorf("getting output flag failed: %w", err)
+	}
+
+	tgd, err := internal.LoadDefinitionFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	b, err := internal.NewBuilder(ocmctx.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	graph, err := b.WithEvents(make(chan graphRuntime.ProgressEvent, eventBufferSize)).BuildAndCheck(tgd)
+	if err != nil {
+		return fmt.Errorf("transformation graph definition is invalid: %w", err)
+	}
+
+	if diagramFormat, isDiagram := diagram.ParseFormat(output); dryRun && isDiagram {
+		if err := diagram.NewTransformationRenderer(graph, diagramFormat).Render(ctx, cmd.OutOrStdout()); err != nil {
+			return fmt.Errorf("rendering transformation graph failed: %w", err)
+		}
+		return nil
+	}
+
+	if dryRun {
+		rendered, err := graph.Render()
+		if err != nil {
+			return fmt.Errorf("rendering transformation graph definition failed: %w", err)
+		}
+		data, err := encode(rendered, output)
+		if err != nil {
+			return fmt.Errorf("encoding transformation graph definition failed: %w", err)
+		}
+		if _, err := cmd.OutOrStdout().Write(data); err != nil {
+			return fmt.Errorf("writing transformation graph definition failed: %w", err)
+		}
+		return nil
+	}
+
+	// Progress goes to stderr so that the output of transformations remains clean for piping.
+	tracker := progress.NewTracker(ctx, cmd.ErrOrStderr(), bar.NewVisualizer[*graphPkg.Transformation])
+	defer tracker.Stop()
+
+	op := tracker.StartOperation("Applying transformations",
+		progress.WithEvents(graph.Events(), transformation.MapEvent, graph.NodeCount()),
+		progress.WithErrorFormatter(transformation.FormatError))
+	if err := graph.Process(ctx); err != nil {
+		op.Finish(err)
+		return fmt.Errorf("graph execution failed: %w", err)
+	}
+	op.Finish(nil)
+
+	tracker.Stop() // Restore slog before the log below; defer is the safety net for error paths.
+	slog.DebugContext(ctx, "transformation completed successfully")
+	return nil
+}
+
+func encode(tgd *transformv1alpha1.TransformationGraphDefinition, format string) ([]byte, error) {
+	switch format {
+	case render.OutputFormatJSON.String():
+		data, err := json.MarshalIndent(tgd, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	case render.OutputFormatNDJSON.String():
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(tgd); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case render.OutputFormatYAML.String():
+		return yaml.Marshal(tgd)
+	default:
+		return nil, fmt.Errorf("invalid output format %q", format)
+	}
+}
@@ -0,0 +1,22 @@
+package transform
+
+import (
+	"github.com/spf13/cobra"
+
+	"ocm.software/open-component-model/cli/cmd/transform/apply"
+	"ocm.software/open-component-model/cli/cmd/transform/validate"
+)
+
+// New represents any command that is related to transformation graph definitions
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transform {apply|validate}",
+		Short: "Check and execute transformation graph definitions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+	cmd.AddCommand(apply.New())
+	cmd.AddCommand(validate.New())
+	return cmd
+}
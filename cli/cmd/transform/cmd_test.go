@@ -0,0 +1,197 @@
+package transform_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"ocm.software/open-component-model/bindings/go/blob/filesystem"
+	"ocm.software/open-component-model/bindings/go/ctf"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/oci"
+	ocictf "ocm.software/open-component-model/bindings/go/oci/ctf"
+	transformv1alpha1 "ocm.software/open-component-model/bindings/go/transform/spec/v1alpha1"
+	"ocm.software/open-component-model/cli/cmd/internal/test"
+)
+
+const (
+	componentName    = "ocm.software/transform-test"
+	componentVersion = "0.0.1"
+)
+
+// definition copies a component version between two CTF archives given by the environment.
+const definition = `
+environment:
+  component: ocm.software/transform-test
+  version: 0.0.1
+transformations:
+- id: get
+  type: CTFGetComponentVersion/v1alpha1
+  spec:
+    repository:
+      type: ctf
+      filePath: ${environment.archives.source}
+    component: ${environment.component}
+    version: ${environment.version}
+- id: upload
+  type: CTFAddComponentVersion/v1alpha1
+  spec:
+    repository:
+      type: ctf
+      filePath: ${environment.archives.target}
+      accessMode: readwrite|create
+    descriptor: ${get.output.descriptor}
+`
+
+func openCTFRepo(t *testing.T, path string) *oci.Repository {
+	t.Helper()
+	fs, err := filesystem.NewFS(path, os.O_RDWR)
+	require.NoError(t, err)
+	repo, err := oci.NewRepository(ocictf.WithCTF(ocictf.NewFromCTF(ctf.NewFileSystemCTF(fs))))
+	require.NoError(t, err)
+	return repo
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// setup creates a source archive with a component version and returns the paths of the
+// definition, an environment file pointing to the source and a new target archive, and the target archive.
+func setup(t *testing.T) (string, string, string) {
+	t.Helper()
+	source := t.TempDir()
+	require.NoError(t, openCTFRepo(t, source).AddComponentVersion(t.Context(), &descriptor.Descriptor{
+		Meta: descriptor.Meta{Version: "v2"},
+		Component: descriptor.Component{
+			ComponentMeta: descriptor.ComponentMeta{
+				ObjectMeta: descriptor.ObjectMeta{Name: componentName, Version: componentVersion},
+			},
+			Provider: descriptor.Provider{Name: "ocm.software"},
+		},
+	}))
+	target := t.TempDir()
+
+	dir := t.TempDir()
+	definitionFile := writeFile(t, dir, "definition.yaml", definition)
+	envFile := writeFile(t, dir, "env.yaml", fmt.Sprintf("archives:\n  source: %s\n  target: %s\n", source, target))
+	return definitionFile, envFile, target
+}
+
+func TestTransformValidate(t *testing.T) {
+	definitionFile, envFile, _ := setup(t)
+
+	t.Run("valid definition", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		_, err := test.OCM(t,
+			test.WithArgs("transform", "validate", "-f", definitionFile, "--env", envFile),
+			test.WithOutput(out),
+			test.WithErrorOutput(test.NewJSONLogReader()),
+		)
+		require.NoError(t, err)
+		require.Equal(t, "transformation graph definition is valid (2 transformations)\n", out.String())
+	})
+
+	t.Run("missing environment", func(t *testing.T) {
+		_, err := test.OCM(t,
+			test.WithArgs("transform", "validate", "-f", definitionFile),
+			test.WithOutput(new(bytes.Buffer)),
+			test.WithErrorOutput(test.NewJSONLogReader()),
+		)
+		require.ErrorContains(t, err, "transformation graph definition is invalid")
+	})
+
+	t.Run("unknown transformation type", func(t *testing.T) {
+		_, err := test.OCM(t,
+			test.WithArgs("transform", "validate", "-f", "-"),
+			test.WithInput(bytes.NewBufferString(`
+transformations:
+- id: unknown
+  type: DoesNotExist/v1alpha1
+  spec: {}
+`)),
+			test.WithOutput(new(bytes.Buffer)),
+			test.WithErrorOutput(test.NewJSONLogReader()),
+		)
+		require.ErrorContains(t, err, "DoesNotExist/v1alpha1")
+	})
+}
+
+func TestTransformApplyDryRun(t *testing.T) {
+	r := require.New(t)
+	definitionFile, envFile, target := setup(t)
+
+	out := new(bytes.Buffer)
+	_, err := test.OCM(t,
+		test.WithArgs("transform", "apply", "-f", definitionFile, "--env", envFile, "--dry-run", "-o", "yaml"),
+		test.WithOutput(out),
+		test.WithErrorOutput(test.NewJSONLogReader()),
+	)
+	r.NoError(err)
+
+	var rendered transformv1alpha1.TransformationGraphDefinition
+	r.NoError(yaml.Unmarshal(out.Bytes(), &rendered))
+	r.Len(rendered.Transformations, 2)
+	r.Equal(componentName, rendered.Transformations[0].Spec.Data["component"])
+	r.Equal(target, rendered.Transformations[1].Spec.Data["repository"].(map[string]any)["filePath"])
+	r.Equal("${get.output.descriptor}", rendered.Transformations[1].Spec.Data["descriptor"],
+		"expressions referring to other transformations are only known when applied")
+
+	_, err = openCTFRepo(t, target).GetComponentVersion(t.Context(), componentName, componentVersion)
+	r.Error(err, "dry run must not transfer the component version")
+}
+
+func TestTransformApplyDryRunDiagram(t *testing.T) {
+	definitionFile, envFile, _ := setup(t)
+
+	t.Run("mermaid", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		_, err := test.OCM(t,
+			test.WithArgs("transform", "apply", "-f", definitionFile, "--env", envFile, "--dry-run", "-o", "mermaid"),
+			test.WithOutput(out),
+			test.WithErrorOutput(test.NewJSONLogReader()),
+		)
+		require.NoError(t, err)
+		require.Equal(t, "flowchart TD\n"+
+			"  n0[\"get<br/>CTFGetComponentVersion/v1alpha1\"]\n"+
+			"  n1[\"upload<br/>CTFAddComponentVersion/v1alpha1\"]\n"+
+			"  n0 --> n1\n", out.String())
+	})
+
+	t.Run("dot", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		_, err := test.OCM(t,
+			test.WithArgs("transform", "apply", "-f", definitionFile, "--env", envFile, "--dry-run", "-o", "dot"),
+			test.WithOutput(out),
+			test.WithErrorOutput(test.NewJSONLogReader()),
+		)
+		require.NoError(t, err)
+		require.Contains(t, out.String(), "digraph {")
+		require.Contains(t, out.String(), "n0 -> n1;")
+	})
+}
+
+func TestTransformApply(t *testing.T) {
+	r := require.New(t)
+	definitionFile, envFile, target := setup(t)
+
+	_, err := test.OCM(t,
+		test.WithArgs("transform", "apply", "-f", definitionFile, "--env", envFile),
+		test.WithOutput(new(bytes.Buffer)),
+		test.WithErrorOutput(test.NewJSONLogReader()),
+	)
+	r.NoError(err)
+
+	desc, err := openCTFRepo(t, target).GetComponentVersion(t.Context(), componentName, componentVersion)
+	r.NoError(err)
+	r.Equal(componentName, desc.Component.Name)
+	r.Equal(componentVersion, desc.Component.Version)
+}
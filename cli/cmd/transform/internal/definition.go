@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/transfer"
+	"ocm.software/open-component-model/bindings/go/transform/graph/builder"
+	transformv1alpha1 "ocm.software/open-component-model/bindings/go/transform/spec/v1alpha1"
+	ocmctx "ocm.software/open-component-model/cli/internal/context"
+	"ocm.software/open-component-model/cli/internal/transformers"
+)
+
+const (
+	FlagFile = "file"
+	FlagEnv  = "env"
+)
+
+// AddDefinitionFlags adds the flags to read a transformation graph definition and its environment.
+func AddDefinitionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(FlagFile, "f", "", "path to the transformation graph definition (use \"-\" for stdin)")
+	cmd.Flags().StringArray(FlagEnv, nil, "path to a YAML or JSON file that is merged into the environment of the definition, can be repeated (later files take precedence)")
+	_ = cmd.MarkFlagRequired(FlagFile)
+}
+
+// LoadDefinitionFromFlags reads the transformation graph definition and environment files given by the flags.
+func LoadDefinitionFromFlags(cmd *cobra.Command) (*transformv1alpha1.TransformationGraphDefinition, error) {
+	path, err := cmd.Flags().GetString(FlagFile)
+	if err != nil {
+		return nil, fmt.Errorf("getting file flag failed: %w", err)
+	}
+	envFiles, err := cmd.Flags().GetStringArray(FlagEnv)
+	if err != nil {
+		return nil, fmt.Errorf("getting env flag failed: %w", err)
+	}
+	return LoadDefinition(path, cmd.InOrStdin(), envFiles)
+}
+
+// LoadDefinition reads a TransformationGraphDefinition from a file path or stdin (when path is "-")
+// and merges the given environment files into its environment.
+// Nested objects are merged, all other values of later files replace earlier ones.
+func LoadDefinition(path string, stdin io.Reader, envFiles []string) (*transformv1alpha1.TransformationGraphDefinition, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		if data, err = io.ReadAll(stdin); err != nil {
+			return nil, fmt.Errorf("reading transformation graph definition from stdin: %w", err)
+		}
+	} else if data, err = os.ReadFile(path); err != nil {
+		return nil, fmt.Errorf("reading transformation graph definition file %q: %w", path, err)
+	}
+
+	tgd := &transformv1alpha1.TransformationGraphDefinition{}
+	if err := yaml.Unmarshal(data, tgd); err != nil {
+		return nil, fmt.Errorf("parsing transformation graph definition: %w", err)
+	}
+
+	for _, envFile := range envFiles {
+		data, err := os.ReadFile(envFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading environment file %q: %w", envFile, err)
+		}
+		var env map[string]any
+		if err := yaml.Unmarshal(data, &env); err != nil {
+			return nil, fmt.Errorf("parsing environment file %q: %w", envFile, err)
+		}
+		if tgd.Environment == nil {
+			tgd.Environment = &runtime.Unstructured{Data: map[string]any{}}
+		}
+		tgd.Environment.Data = mergeEnvironment(tgd.Environment.Data, env)
+	}
+
+	return tgd, nil
+}
+
+// NewBuilder returns a transformation graph builder with the transformers the CLI uses for transfers
+// and the transformations provided by plugins.
+func NewBuilder(octx *ocmctx.Context) (*builder.Builder, error) {
+	pm := octx.PluginManager()
+	if pm == nil {
+		return nil, fmt.Errorf("plugin manager missing in context")
+	}
+	credGraph := octx.CredentialGraph()
+	if credGraph == nil {
+		return nil, fmt.Errorf("credentials graph not found in context")
+	}
+	return transfer.NewDefaultBuilder(pm.ComponentVersionRepositoryRegistry, pm.ResourcePluginRegistry, credGraph).
+		WithTransformerResolver(transformers.NewPluginResolver(pm.TransformationRegistry, credGraph)), nil
+}
+
+func mergeEnvironment(dst, src map[string]any) map[string]any {
+	if dst == nil {
+		dst = make(map[string]any, len(src))
+	}
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]any)
+		dstMap, dstIsMap := dst[key].(map[string]any)
+		if srcIsMap && dstIsMap {
+			dst[key] = mergeEnvironment(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
+	}
+	return dst
+}
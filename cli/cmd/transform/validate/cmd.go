@@ -0,0 +1,55 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"ocm.software/open-component-model/cli/cmd/transform/internal"
+	ocmctx "ocm.software/open-component-model/cli/internal/context"
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate -f {definition}",
+		Short: "Statically check a transformation graph definition",
+		Long: `Statically check a TransformationGraphDefinition without executing it.
+
+The check covers the transformation types known to the CLI, the dependencies between
+transformations, the CEL expressions and the types of their results, and the specifications
+of all transformations against their JSON schemas.
+Values of the environment can be provided with --env files, as for "ocm transform apply".`,
+		Example: strings.TrimSpace(`
+# Check a transformation graph definition
+transform validate -f pipeline.yaml
+
+# Check a transformation graph definition with its environment
+transform validate -f pipeline.yaml --env production.yaml
+`),
+		Args:              cobra.NoArgs,
+		RunE:              Validate,
+		DisableAutoGenTag: true,
+	}
+
+	internal.AddDefinitionFlags(cmd)
+
+	return cmd
+}
+
+func Validate(cmd *cobra.Command, _ []string) error {
+	tgd, err := internal.LoadDefinitionFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	b, err := internal.NewBuilder(ocmctx.FromContext(cmd.Context()))
+	if err != nil {
+		return err
+	}
+	graph, err := b.BuildAndCheck(tgd)
+	if err != nil {
+		return fmt.Errorf("transformation graph definition is invalid: %w", err)
+	}
+	_, err = fmt.Fprintf(cmd.OutOrStdout(), "transformation graph definition is valid (%d transformations)\n", graph.NodeCount())
+	return err
+}
@@ -20,7 +20,9 @@ import (
 	"ocm.software/open-component-model/bindings/go/runtime"
 	"ocm.software/open-component-model/bindings/go/signing"
 	ocmctx "ocm.software/open-component-model/cli/internal/context"
+	"ocm.software/open-component-model/cli/internal/flags/enum"
 	"ocm.software/open-component-model/cli/internal/flags/log"
+	"ocm.software/open-component-model/cli/internal/render"
 	"ocm.software/open-component-model/cli/internal/repository/ocm"
 )
 
@@ -28,6 +30,8 @@ const (
 	FlagConcurrencyLimit = "concurrency-limit"
 	FlagSignature        = "signature"
 	FlagVerifierSpec     = "verifier-spec"
+	FlagPolicy           = "policy"
+	FlagOutput           = "output"
 )
 
 func New() *cobra.Command {
@@ -54,11 +58,23 @@ func New() *cobra.Command {
 - Recompute hash and compare with signature digest  
 - Verify signature (--verifier-spec, default RSASSA-PSS verifier)  
 
+## Verification Policies
+
+--policy replaces --signature and --verifier-spec with a VerificationPolicy/v1alpha1 file. It lists the
+signatures to verify by name with a verifier configuration per signature, marks signatures as required,
+sets an m-of-n threshold and whether referenced component versions must satisfy the policy as well (recursive).
+The policy is evaluated for every component version in the graph and a result per component version is
+printed (--output). Referenced component versions must also match the digest of their reference.
+The command exits non-zero if any component version does not satisfy the policy.
+
 ## Behavior
 
 - --signature selects a single signature by name; without it, every signature on the descriptor is verified
 - Signatures are verified concurrently (--concurrency-limit); the command exits non-zero on the first failure
 - Default verifier: RSASSA-PSS, resolves the public key from credentials in .ocmconfig
+- For X.509 trust policies on RSA PEM signatures, pass --verifier-spec with an RSAVerificationConfiguration/v1alpha1 config
+- RSA PEM signatures with an RFC 3161 timestamp (sign --tsa-url) are checked at the timestamped time if the
+  RSAVerificationConfiguration/v1alpha1 trusts the timestamp authority, so they stay valid after the certificate expired
 - For Sigstore keyless verification, pass --verifier-spec with a SigstoreVerificationConfiguration/v1alpha1 config
 
 Use to validate component versions before promotion, deployment, or further usage to ensure integrity and provenance.`,
@@ -108,6 +124,27 @@ verify component-version ghcr.io/open-component-model/ocm//ocm.software/ocmcli:0
           properties:
             public_key_pem_file: /path/to/root-ca.pem
 
+## Example Verifier Spec — RSA trust policy (RSAVerificationConfiguration/v1alpha1)
+#
+# Accepts PEM signatures from any certificate the given root CA issued for the
+# subject, instead of pinning individual public keys. Plain signatures are rejected.
+
+    type: RSAVerificationConfiguration/v1alpha1
+    trustPolicy:
+      rootCertificatesPEMFile: /path/to/corporate-root-ca.pem
+      subject: OU=Release Engineering,O=Example Corp
+      crlFiles:
+      - /path/to/issuing-ca.crl
+
+## Example Verifier Spec — RSA with trusted timestamp authority (RSAVerificationConfiguration/v1alpha1)
+#
+# Verifies the certificate chain at the time asserted by the signature timestamp
+# instead of the current time. A timestamp that does not verify fails the signature.
+
+    type: RSAVerificationConfiguration/v1alpha1
+    timestampAuthority:
+      rootCertificatesPEMFile: /path/to/tsa-root.pem
+
 ## Example Verifier Spec — Sigstore keyless (SigstoreVerificationConfiguration/v1alpha1)
 #
 # Identity constraints are REQUIRED: (certificateOIDCIssuer or certificateOIDCIssuerRegexp)
@@ -166,6 +203,30 @@ verify component-version ghcr.io/open-component-model/ocm//ocm.software/ocmcli:0
 
 # Use a verifier specification file
 verify component-version ghcr.io/open-component-model/ocm//ocm.software/ocmcli:0.23.0 --verifier-spec ./rsassa-pss.yaml
+
+## Example Verification Policy (VerificationPolicy/v1alpha1)
+#
+# Requires the build and qa signatures on every component version in the tree.
+# The verifier of a signature defaults to RSASSA-PSS with credentials from .ocmconfig.
+
+    type: VerificationPolicy/v1alpha1
+    recursive: true
+    signatures:
+    - name: build
+      required: true
+      verifier:
+        type: RSAVerificationConfiguration/v1alpha1
+        trustPolicy:
+          rootCertificatesPEMFile: /path/to/build-root-ca.pem
+    - name: qa
+      required: true
+    - name: security-scan
+    - name: legal
+    # build, qa and at least one of security-scan and legal
+    threshold: 3
+
+# Verify the component version and all referenced component versions against a policy
+verify component-version ghcr.io/open-component-model/ocm//ocm.software/ocmcli:0.23.0 --policy ./release-policy.yaml --output yaml
 `),
 		RunE:              VerifyComponentVersion,
 		DisableAutoGenTag: true,
@@ -174,6 +235,10 @@ verify component-version ghcr.io/open-component-model/ocm//ocm.software/ocmcli:0
 	cmd.Flags().Int(FlagConcurrencyLimit, 4, "maximum amount of parallel requests to the repository for resolving component versions")
 	cmd.Flags().String(FlagSignature, "", "name of the signature to verify. If not set, all signatures are verified.")
 	cmd.Flags().String(FlagVerifierSpec, "", "path to a verifier specification file. If empty, defaults to RSASSA-PSS.")
+	cmd.Flags().String(FlagPolicy, "", "path to a verification policy file (VerificationPolicy/v1alpha1). Replaces --signature and --verifier-spec.")
+	enum.VarP(cmd.Flags(), FlagOutput, "o", []string{render.OutputFormatTable.String(), render.OutputFormatYAML.String(), render.OutputFormatJSON.String()}, "output format of the policy results, only used with --policy")
+	cmd.MarkFlagsMutuallyExclusive(FlagPolicy, FlagSignature)
+	cmd.MarkFlagsMutuallyExclusive(FlagPolicy, FlagVerifierSpec)
 
 	return cmd
 }
@@ -225,6 +290,11 @@ func VerifyComponentVersion(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("getting verifier-spec flag failed: %w", err)
 	}
 
+	policyPath, err := cmd.Flags().GetString(FlagPolicy)
+	if err != nil {
+		return fmt.Errorf("getting policy flag failed: %w", err)
+	}
+
 	reference := args[0]
 
 	config := ocmContext.Configuration()
@@ -237,6 +307,10 @@ func VerifyComponentVersion(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("could not initialize ocm repository: %w", err)
 	}
 
+	if policyPath != "" {
+		return verifyWithPolicy(cmd, policyPath, ref, repoProvider, pluginManager.SigningRegistry, credentialGraph, concurrencyLimit)
+	}
+
 	repo, err := repoProvider.GetComponentVersionRepositoryForComponent(cmd.Context(), ref.Component, ref.Version)
 	if err != nil {
 		return fmt.Errorf("could not access ocm repository: %w", err)
@@ -0,0 +1,119 @@
+package componentversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"ocm.software/open-component-model/bindings/go/credentials"
+	"ocm.software/open-component-model/bindings/go/oci/compref"
+	"ocm.software/open-component-model/bindings/go/repository/component/resolvers"
+	"ocm.software/open-component-model/cli/internal/flags/enum"
+	"ocm.software/open-component-model/cli/internal/flags/log"
+	"ocm.software/open-component-model/cli/internal/render"
+	"ocm.software/open-component-model/cli/internal/verification"
+)
+
+// verifyWithPolicy evaluates the policy file for the referenced component version
+// and prints the result per component version.
+func verifyWithPolicy(cmd *cobra.Command,
+	policyPath string,
+	ref *compref.Ref,
+	repoResolver resolvers.ComponentVersionRepositoryResolver,
+	handlers verification.HandlerProvider,
+	credentialGraph credentials.Resolver,
+	concurrencyLimit int,
+) error {
+	ctx := cmd.Context()
+	logger, err := log.GetBaseLogger(cmd)
+	if err != nil {
+		return fmt.Errorf("getting base logger failed: %w", err)
+	}
+	output, err := enum.Get(cmd.Flags(), FlagOutput)
+	if err != nil {
+		return fmt.Errorf("getting output flag failed: %w", err)
+	}
+
+	policy, err := verification.LoadPolicy(policyPath)
+	if err != nil {
+		return err
+	}
+
+	report, err := verification.Evaluate(ctx, policy, ref.Component, ref.Version, verification.Options{
+		Resolver:         repoResolver,
+		Handlers:         handlers,
+		Credentials:      credentialGraph,
+		ConcurrencyLimit: concurrencyLimit,
+		Logger:           logger,
+	})
+	if err != nil {
+		return fmt.Errorf("SIGNATURE VERIFICATION FAILED: %w", err)
+	}
+
+	if err := renderReport(cmd.OutOrStdout(), report, output); err != nil {
+		return err
+	}
+
+	if failed := report.Failed(); len(failed) > 0 {
+		return fmt.Errorf("SIGNATURE VERIFICATION FAILED: %d of %d component versions do not satisfy the policy", len(failed), len(report.ComponentVersions))
+	}
+	logger.InfoContext(ctx, "SIGNATURE VERIFICATION SUCCESSFUL")
+	return nil
+}
+
+func renderReport(w io.Writer, report *verification.Report, format string) error {
+	switch format {
+	case render.OutputFormatJSON.String():
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return fmt.Errorf("encoding verification report failed: %w", err)
+		}
+	case render.OutputFormatYAML.String():
+		data, err := yaml.Marshal(report)
+		if err != nil {
+			return fmt.Errorf("encoding verification report failed: %w", err)
+		}
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("writing verification report failed: %w", err)
+		}
+	case render.OutputFormatTable.String():
+		renderReportTable(w, report)
+	default:
+		return fmt.Errorf("invalid output format %q", format)
+	}
+	return nil
+}
+
+func renderReportTable(w io.Writer, report *verification.Report) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.AppendHeader(table.Row{"Component", "Version", "Policy", "Signature", "Result", "Details"})
+	for _, cv := range report.ComponentVersions {
+		status := "satisfied"
+		if !cv.Satisfied {
+			status = fmt.Sprintf("violated (%d/%d)", cv.Verified, cv.Threshold)
+		}
+		// only the first row of a component version shows its identity and status
+		row := table.Row{cv.Component, cv.Version, status}
+		for _, sig := range cv.Signatures {
+			name := sig.Name
+			if sig.Required {
+				name += " (required)"
+			}
+			t.AppendRow(append(row, name, sig.Result, sig.Error))
+			row = table.Row{"", "", ""}
+		}
+		if cv.Error != "" {
+			t.AppendRow(append(row, "", "", cv.Error))
+		}
+	}
+	style := table.StyleLight
+	style.Options.DrawBorder = false
+	t.SetStyle(style)
+	t.Render()
+}
@@ -27,21 +27,29 @@ require (
 	ocm.software/open-component-model/bindings/go/descriptor/normalisation v0.0.0-20260610112036-de724a6601de
 	ocm.software/open-component-model/bindings/go/descriptor/runtime v0.0.0-20260610112036-de724a6601de
 	ocm.software/open-component-model/bindings/go/descriptor/v2 v2.0.3-alpha3
+	ocm.software/open-component-model/bindings/go/ec v0.0.0-00010101000000-000000000000
+	ocm.software/open-component-model/bindings/go/git v0.0.0-00010101000000-000000000000
 	ocm.software/open-component-model/bindings/go/gpg v0.0.0-20260610112036-de724a6601de
 	ocm.software/open-component-model/bindings/go/helm v0.0.0-20260610112036-de724a6601de
 	ocm.software/open-component-model/bindings/go/http v0.0.0-20260610112036-de724a6601de
 	ocm.software/open-component-model/bindings/go/input/dir v0.0.4
 	ocm.software/open-component-model/bindings/go/input/file v0.0.5
+	ocm.software/open-component-model/bindings/go/input/ociimage v0.0.0-00010101000000-000000000000
 	ocm.software/open-component-model/bindings/go/input/utf8 v0.0.0-20260610112036-de724a6601de
+	ocm.software/open-component-model/bindings/go/maven v0.0.0-00010101000000-000000000000
+	ocm.software/open-component-model/bindings/go/npm v0.0.0-00010101000000-000000000000
+	ocm.software/open-component-model/bindings/go/pkcs11 v0.0.0-00010101000000-000000000000
 	ocm.software/open-component-model/bindings/go/oci v0.0.46
 	ocm.software/open-component-model/bindings/go/plugin v0.0.17
 	ocm.software/open-component-model/bindings/go/repository v0.0.9
 	ocm.software/open-component-model/bindings/go/rsa v0.0.0-20260610112036-de724a6601de
 	ocm.software/open-component-model/bindings/go/runtime v0.0.8
+	ocm.software/open-component-model/bindings/go/s3 v0.0.0-00010101000000-000000000000
 	ocm.software/open-component-model/bindings/go/signing v0.0.0-20260610112036-de724a6601de
 	ocm.software/open-component-model/bindings/go/sigstore v0.0.0-20260610112036-de724a6601de
 	ocm.software/open-component-model/bindings/go/transfer v0.0.0-20260610112036-de724a6601de
 	ocm.software/open-component-model/bindings/go/transform v0.0.0-20260610112036-de724a6601de
+	ocm.software/open-component-model/bindings/go/wget v0.0.0-00010101000000-000000000000
 	sigs.k8s.io/yaml v1.6.0
 )
 
@@ -59,6 +67,8 @@ require (
 	github.com/cyberphone/json-canonicalization v0.0.0-20241213102144-19d51d7fe467 // indirect
 	github.com/cyphar/filepath-securejoin v0.6.1 // indirect
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
+	github.com/digitorus/pkcs7 v0.0.0-20250730155240-ffadbf3f398c // indirect
+	github.com/digitorus/timestamp v0.0.0-20250524132541-c45532741eea // indirect
 	github.com/dylibso/observe-sdk/go v0.0.0-20240828172851-9145d8ad07e1 // indirect
 	github.com/emicklei/go-restful/v3 v3.13.0 // indirect
 	github.com/extism/go-sdk v1.7.1 // indirect
@@ -119,6 +129,7 @@ require (
 	golang.org/x/time v0.15.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20260414002931-afd174a4e478 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20260504160031-60b97b32f348 // indirect
+	google.golang.org/grpc v1.82.1 // indirect
 	google.golang.org/protobuf v1.36.12-0.20260120151049-f2248ac996af // indirect
 	gopkg.in/evanphx/json-patch.v4 v4.13.0 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
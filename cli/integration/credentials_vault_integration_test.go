@@ -0,0 +1,96 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/oci"
+	urlresolver "ocm.software/open-component-model/bindings/go/oci/resolver/url"
+	"ocm.software/open-component-model/cli/cmd"
+	"ocm.software/open-component-model/cli/integration/internal"
+)
+
+// Test_Integration_Credentials_Vault pushes a component version to a registry whose credentials
+// are only stored in Vault. The OCM configuration contains neither the registry credentials nor the Vault token.
+func Test_Integration_Credentials_Vault(t *testing.T) {
+	r := require.New(t)
+	t.Parallel()
+
+	registry, err := internal.CreateOCIRegistry(t)
+	r.NoError(err)
+	vault := internal.StartVaultDevServer(t)
+
+	vault.PutSecret(t, "ocm/registry", map[string]string{
+		"username": registry.User,
+		"password": registry.Password,
+	}, map[string]string{
+		"consumerIdentity": fmt.Sprintf("type=OCIRegistry,hostname=%s,port=%s,scheme=http", registry.Host, registry.Port),
+	})
+
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "vault-token")
+	r.NoError(os.WriteFile(tokenFile, []byte(vault.RootToken), 0o600))
+
+	cfg := fmt.Sprintf(`
+type: generic.config.ocm.software/v1
+configurations:
+- type: credentials.config.ocm.software
+  repositories:
+  - repository:
+      type: HashiCorpVault/v1alpha1
+      serverURL: %[1]q
+      path: ocm
+      auth:
+        method: token
+        tokenFile: %[2]q
+`, vault.Address, tokenFile)
+	cfgPath := filepath.Join(dir, "ocmconfig.yaml")
+	r.NoError(os.WriteFile(cfgPath, []byte(cfg), os.ModePerm))
+
+	constructorPath := filepath.Join(dir, "constructor.yaml")
+	r.NoError(os.WriteFile(constructorPath, []byte(`
+components:
+- name: ocm.software/vault-component
+  version: v1.0.0
+  provider:
+    name: ocm.software
+  resources:
+  - name: test-resource
+    version: v1.0.0
+    type: plainText
+    input:
+      type: utf8
+      text: "Hello, World from Vault!"
+`), os.ModePerm))
+
+	addCMD := cmd.New()
+	addCMD.SetArgs([]string{
+		"add",
+		"component-version",
+		"--repository", fmt.Sprintf("http://%s", registry.RegistryAddress),
+		"--constructor", constructorPath,
+		"--config", cfgPath,
+	})
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	defer cancel()
+	r.NoError(addCMD.ExecuteContext(ctx), "add component-version should succeed with credentials from vault")
+
+	resolver, err := urlresolver.New(
+		urlresolver.WithBaseURL(registry.RegistryAddress),
+		urlresolver.WithPlainHTTP(true),
+		urlresolver.WithBaseClient(internal.CreateAuthClient(registry.RegistryAddress, registry.User, registry.Password)),
+	)
+	r.NoError(err)
+	repo, err := oci.NewRepository(oci.WithResolver(resolver), oci.WithTempDir(t.TempDir()))
+	r.NoError(err)
+
+	desc, err := repo.GetComponentVersion(ctx, "ocm.software/vault-component", "v1.0.0")
+	r.NoError(err)
+	r.Equal("ocm.software/vault-component", desc.Component.Name)
+}
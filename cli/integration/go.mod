@@ -53,6 +53,8 @@ require (
 	github.com/cyberphone/json-canonicalization v0.0.0-20241213102144-19d51d7fe467 // indirect
 	github.com/cyphar/filepath-securejoin v0.6.1 // indirect
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
+	github.com/digitorus/pkcs7 v0.0.0-20250730155240-ffadbf3f398c // indirect
+	github.com/digitorus/timestamp v0.0.0-20250524132541-c45532741eea // indirect
 	github.com/distribution/reference v0.6.0 // indirect
 	github.com/docker/go-connections v0.7.0 // indirect
 	github.com/docker/go-units v0.5.0 // indirect
@@ -149,6 +151,7 @@ require (
 	golang.org/x/time v0.15.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20260414002931-afd174a4e478 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20260504160031-60b97b32f348 // indirect
+	google.golang.org/grpc v1.82.1 // indirect
 	google.golang.org/protobuf v1.36.12-0.20260120151049-f2248ac996af // indirect
 	gopkg.in/evanphx/json-patch.v4 v4.13.0 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
@@ -164,16 +167,24 @@ require (
 	ocm.software/open-component-model/bindings/go/cel v0.0.0-20260610112036-de724a6601de // indirect
 	ocm.software/open-component-model/bindings/go/constructor v0.0.10 // indirect
 	ocm.software/open-component-model/bindings/go/dag v0.0.6 // indirect
+	ocm.software/open-component-model/bindings/go/ec v0.0.0-00010101000000-000000000000 // indirect
+	ocm.software/open-component-model/bindings/go/git v0.0.0-00010101000000-000000000000 // indirect
 	ocm.software/open-component-model/bindings/go/gpg v0.0.0-20260610112036-de724a6601de // indirect
 	ocm.software/open-component-model/bindings/go/http v0.0.0-20260610112036-de724a6601de // indirect
 	ocm.software/open-component-model/bindings/go/input/dir v0.0.4 // indirect
 	ocm.software/open-component-model/bindings/go/input/file v0.0.5 // indirect
+	ocm.software/open-component-model/bindings/go/input/ociimage v0.0.0-00010101000000-000000000000 // indirect
 	ocm.software/open-component-model/bindings/go/input/utf8 v0.0.0-20260610112036-de724a6601de // indirect
+	ocm.software/open-component-model/bindings/go/maven v0.0.0-00010101000000-000000000000 // indirect
+	ocm.software/open-component-model/bindings/go/npm v0.0.0-00010101000000-000000000000 // indirect
+	ocm.software/open-component-model/bindings/go/pkcs11 v0.0.0-00010101000000-000000000000 // indirect
 	ocm.software/open-component-model/bindings/go/plugin v0.0.17 // indirect
 	ocm.software/open-component-model/bindings/go/rsa v0.0.0-20260610112036-de724a6601de // indirect
+	ocm.software/open-component-model/bindings/go/s3 v0.0.0-00010101000000-000000000000 // indirect
 	ocm.software/open-component-model/bindings/go/sigstore v0.0.0-20260610112036-de724a6601de // indirect
 	ocm.software/open-component-model/bindings/go/transfer v0.0.0-20260610112036-de724a6601de // indirect
 	ocm.software/open-component-model/bindings/go/transform v0.0.0-20260610112036-de724a6601de // indirect
+	ocm.software/open-component-model/bindings/go/wget v0.0.0-00010101000000-000000000000 // indirect
 	sigs.k8s.io/json v0.0.0-20250730193827-2d320260d730 // indirect
 	sigs.k8s.io/kustomize/api v0.21.1 // indirect
 	sigs.k8s.io/kustomize/kyaml v0.21.1 // indirect
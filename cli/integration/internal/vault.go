@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/log"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const vaultImage = "hashicorp/vault:1.18"
+
+// Vault is a Vault dev server with a KV v2 secrets engine mounted at "secret".
+type Vault struct {
+	Address   string
+	RootToken string
+}
+
+// StartVaultDevServer starts a Vault dev server.
+func StartVaultDevServer(t *testing.T) *Vault {
+	t.Helper()
+	r := require.New(t)
+
+	rootToken := GenerateRandomPassword(t, 20)
+	t.Logf("Launching test vault (%s)...", vaultImage)
+	container, err := testcontainers.Run(t.Context(), vaultImage,
+		testcontainers.WithExposedPorts("8200/tcp"),
+		testcontainers.WithEnv(map[string]string{
+			"VAULT_DEV_ROOT_TOKEN_ID":  rootToken,
+			"VAULT_DEV_LISTEN_ADDRESS": "0.0.0.0:8200",
+		}),
+		testcontainers.WithWaitStrategy(wait.ForHTTP("/v1/sys/health").WithPort("8200/tcp")),
+		testcontainers.WithLogger(log.TestLogger(t)),
+		testcontainers.WithName(fmt.Sprintf("%s-vault-%d", sanitizeContainerName(t.Name()), time.Now().UnixNano())),
+	)
+	r.NoError(err)
+	t.Cleanup(func() {
+		r.NoError(testcontainers.TerminateContainer(container))
+	})
+
+	address, err := container.PortEndpoint(t.Context(), "8200/tcp", "http")
+	r.NoError(err)
+	t.Logf("Test vault started at %s", address)
+
+	return &Vault{Address: address, RootToken: rootToken}
+}
+
+// PutSecret writes data to the KV v2 secret at path and sets its custom metadata.
+func (v *Vault) PutSecret(t *testing.T, path string, data map[string]string, customMetadata map[string]string) {
+	t.Helper()
+	v.request(t, http.MethodPost, "/v1/secret/data/"+path, map[string]any{"data": data})
+	v.request(t, http.MethodPost, "/v1/secret/metadata/"+path, map[string]any{"custom_metadata": customMetadata})
+}
+
+func (v *Vault) request(t *testing.T, method, path string, body any) {
+	t.Helper()
+	r := require.New(t)
+
+	data, err := json.Marshal(body)
+	r.NoError(err)
+	req, err := http.NewRequestWithContext(t.Context(), method, v.Address+path, bytes.NewReader(data))
+	r.NoError(err)
+	req.Header.Set("X-Vault-Token", v.RootToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	r.NoError(err)
+	defer resp.Body.Close()
+	r.Less(resp.StatusCode, http.StatusBadRequest, "vault request %s %s failed with status %d", method, path, resp.StatusCode)
+}
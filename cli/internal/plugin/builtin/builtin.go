@@ -5,15 +5,33 @@ import (
 	"log/slog"
 
 	filesystemv1alpha1 "ocm.software/open-component-model/bindings/go/configuration/filesystem/v1alpha1/spec"
+	gitresource "ocm.software/open-component-model/bindings/go/git/repository/resource"
 	helmdigest "ocm.software/open-component-model/bindings/go/helm/digest"
 	helmresource "ocm.software/open-component-model/bindings/go/helm/repository/resource"
 	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	mavendigest "ocm.software/open-component-model/bindings/go/maven/digest"
+	mavenresource "ocm.software/open-component-model/bindings/go/maven/repository/resource"
+	mavencredentials "ocm.software/open-component-model/bindings/go/maven/spec/credentials"
+	npmdigest "ocm.software/open-component-model/bindings/go/npm/digest"
+	npmresource "ocm.software/open-component-model/bindings/go/npm/repository/resource"
+	npmcredentials "ocm.software/open-component-model/bindings/go/npm/spec/credentials"
+	pkcs11credentials "ocm.software/open-component-model/bindings/go/pkcs11/spec/credentials"
 	"ocm.software/open-component-model/bindings/go/plugin/manager"
+	s3resource "ocm.software/open-component-model/bindings/go/s3/repository/resource"
+	s3credentials "ocm.software/open-component-model/bindings/go/s3/spec/credentials"
+	wgetdigest "ocm.software/open-component-model/bindings/go/wget/digest"
+	wgetresource "ocm.software/open-component-model/bindings/go/wget/repository/resource"
+	wgetcredentials "ocm.software/open-component-model/bindings/go/wget/spec/credentials"
+	helpercredentialplugin "ocm.software/open-component-model/cli/internal/plugin/builtin/credentials/helper"
 	ocicredentialplugin "ocm.software/open-component-model/cli/internal/plugin/builtin/credentials/oci"
+	vaultcredentialplugin "ocm.software/open-component-model/cli/internal/plugin/builtin/credentials/vault"
+	"ocm.software/open-component-model/cli/internal/plugin/builtin/ec"
 	"ocm.software/open-component-model/cli/internal/plugin/builtin/gpg"
 	"ocm.software/open-component-model/cli/internal/plugin/builtin/input/dir"
 	"ocm.software/open-component-model/cli/internal/plugin/builtin/input/file"
+	"ocm.software/open-component-model/cli/internal/plugin/builtin/input/git"
 	"ocm.software/open-component-model/cli/internal/plugin/builtin/input/helm"
+	"ocm.software/open-component-model/cli/internal/plugin/builtin/input/ociimage"
 	"ocm.software/open-component-model/cli/internal/plugin/builtin/input/utf8"
 	ociplugin "ocm.software/open-component-model/cli/internal/plugin/builtin/oci"
 	"ocm.software/open-component-model/cli/internal/plugin/builtin/oidc"
@@ -24,6 +42,12 @@ func Register(manager *manager.PluginManager, filesystemConfig *filesystemv1alph
 	if err := ocicredentialplugin.Register(manager.CredentialRepositoryRegistry); err != nil {
 		return fmt.Errorf("could not register OCI inbuilt credential plugin: %w", err)
 	}
+	if err := vaultcredentialplugin.Register(manager.CredentialRepositoryRegistry, httpConfig); err != nil {
+		return fmt.Errorf("could not register HashiCorp Vault inbuilt credential plugin: %w", err)
+	}
+	if err := helpercredentialplugin.Register(manager.CredentialRepositoryRegistry); err != nil {
+		return fmt.Errorf("could not register credential helper inbuilt credential plugin: %w", err)
+	}
 
 	if err := ociplugin.Register(
 		manager.ComponentVersionRepositoryRegistry,
@@ -50,6 +74,12 @@ func Register(manager *manager.PluginManager, filesystemConfig *filesystemv1alph
 	if err := helm.Register(manager.InputRegistry, manager.CredentialRepositoryRegistry, filesystemConfig, httpConfig); err != nil {
 		return fmt.Errorf("could not register helm input plugin: %w", err)
 	}
+	if err := ociimage.Register(manager.InputRegistry, filesystemConfig, httpConfig); err != nil {
+		return fmt.Errorf("could not register oci image input plugin: %w", err)
+	}
+	if err := git.Register(manager.InputRegistry, manager.CredentialRepositoryRegistry, filesystemConfig); err != nil {
+		return fmt.Errorf("could not register git input plugin: %w", err)
+	}
 
 	if err := manager.DigestProcessorRegistry.RegisterInternalDigestProcessorPlugin(
 		helmdigest.NewDigestProcessor(filesystemConfig.TempFolder),
@@ -61,9 +91,67 @@ func Register(manager *manager.PluginManager, filesystemConfig *filesystemv1alph
 	); err != nil {
 		return fmt.Errorf("could not register helm resource repository plugin: %w", err)
 	}
+	if err := manager.ResourcePluginRegistry.RegisterInternalResourcePlugin(
+		gitresource.NewResourceRepository(filesystemConfig),
+	); err != nil {
+		return fmt.Errorf("could not register git resource repository plugin: %w", err)
+	}
+	manager.CredentialRepositoryRegistry.Register(wgetcredentials.Scheme)
+	if err := manager.DigestProcessorRegistry.RegisterInternalDigestProcessorPlugin(
+		wgetdigest.NewDigestProcessor(wgetdigest.WithHTTPConfig(httpConfig)),
+	); err != nil {
+		return fmt.Errorf("could not register wget digest processor plugin: %w", err)
+	}
+	if err := manager.ResourcePluginRegistry.RegisterInternalResourcePlugin(
+		wgetresource.NewResourceRepository(
+			wgetresource.WithHTTPConfig(httpConfig),
+			wgetresource.WithTempDir(filesystemConfig.TempFolder),
+		),
+	); err != nil {
+		return fmt.Errorf("could not register wget resource repository plugin: %w", err)
+	}
+	manager.CredentialRepositoryRegistry.Register(s3credentials.Scheme)
+	if err := manager.ResourcePluginRegistry.RegisterInternalResourcePlugin(
+		s3resource.NewResourceRepository(s3resource.WithHTTPConfig(httpConfig)),
+	); err != nil {
+		return fmt.Errorf("could not register s3 resource repository plugin: %w", err)
+	}
+	manager.CredentialRepositoryRegistry.Register(mavencredentials.Scheme)
+	if err := manager.DigestProcessorRegistry.RegisterInternalDigestProcessorPlugin(
+		mavendigest.NewDigestProcessor(mavendigest.WithHTTPConfig(httpConfig)),
+	); err != nil {
+		return fmt.Errorf("could not register maven digest processor plugin: %w", err)
+	}
+	if err := manager.ResourcePluginRegistry.RegisterInternalResourcePlugin(
+		mavenresource.NewResourceRepository(
+			mavenresource.WithHTTPConfig(httpConfig),
+			mavenresource.WithTempDir(filesystemConfig.TempFolder),
+		),
+	); err != nil {
+		return fmt.Errorf("could not register maven resource repository plugin: %w", err)
+	}
+	manager.CredentialRepositoryRegistry.Register(npmcredentials.Scheme)
+	if err := manager.DigestProcessorRegistry.RegisterInternalDigestProcessorPlugin(
+		npmdigest.NewDigestProcessor(npmdigest.WithHTTPConfig(httpConfig)),
+	); err != nil {
+		return fmt.Errorf("could not register npm digest processor plugin: %w", err)
+	}
+	if err := manager.ResourcePluginRegistry.RegisterInternalResourcePlugin(
+		npmresource.NewResourceRepository(
+			npmresource.WithHTTPConfig(httpConfig),
+			npmresource.WithTempDir(filesystemConfig.TempFolder),
+		),
+	); err != nil {
+		return fmt.Errorf("could not register npm resource repository plugin: %w", err)
+	}
+	// PKCS#11 credentials are shared by the RSA and EC signing handlers.
+	manager.CredentialRepositoryRegistry.Register(pkcs11credentials.Scheme)
 	if err := rsa.Register(manager.SigningRegistry, manager.CredentialRepositoryRegistry, filesystemConfig); err != nil {
 		return fmt.Errorf("could not register RSA signing plugin: %w", err)
 	}
+	if err := ec.Register(manager.SigningRegistry, manager.CredentialRepositoryRegistry, filesystemConfig); err != nil {
+		return fmt.Errorf("could not register EC signing plugin: %w", err)
+	}
 	if err := oidc.Register(manager.SigningRegistry, manager.CredentialRepositoryRegistry, filesystemConfig); err != nil {
 		return fmt.Errorf("could not register Sigstore signing plugin: %w", err)
 	}
@@ -0,0 +1,19 @@
+package helper
+
+import (
+	"ocm.software/open-component-model/bindings/go/credentials"
+	ociidentity "ocm.software/open-component-model/bindings/go/oci/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/credentialrepository"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// Register registers the credential helper repository.
+// Helpers serve OCI registries next to docker config files as well as any other consumer identity type
+// that has no dedicated credential repository, such as Helm chart repositories, RSA or GPG keys.
+// Their output is decoded with the credential types known to the registry.
+func Register(registry *credentialrepository.RepositoryRegistry) error {
+	return registry.RegisterInternalCredentialRepositoryPlugin(
+		NewCredentialRepository(WithCredentialTypeScheme(registry.GetCredentialTypeScheme())),
+		[]runtime.Type{ociidentity.Type, credentials.AnyConsumerIdentityType},
+	)
+}
@@ -0,0 +1,242 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"ocm.software/open-component-model/bindings/go/credentials"
+	credentialsv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/cli/internal/plugin/builtin/credentials/helper/spec/v1alpha1"
+)
+
+// directCredentialsScheme decodes helper output of type Credentials/v1.
+var directCredentialsScheme = runtime.NewScheme()
+
+func init() {
+	credentialsv1.MustRegister(directCredentialsScheme)
+}
+
+// CredentialRepository implements the RepositoryPlugin Credential Graph interface for
+// external helper executables (see v1alpha1.CredentialHelper).
+//
+// The answer of a helper, including the answer that it has no credentials, is cached per
+// helper and consumer identity for the configured cache TTL. Concurrent resolutions of the
+// same identity share a single run of the helper.
+type CredentialRepository struct {
+	credentialTypeScheme *runtime.Scheme
+	now                  func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]*cachedCredentials
+	// runs deduplicates concurrent helper runs per cache key.
+	runs singleflight.Group
+}
+
+var _ credentials.RepositoryPlugin = (*CredentialRepository)(nil)
+
+type cachedCredentials struct {
+	credentials runtime.Typed
+	expires     time.Time
+}
+
+// Option configures a CredentialRepository.
+type Option func(*CredentialRepository)
+
+// WithCredentialTypeScheme sets the scheme used to decode the typed credentials returned by helpers,
+// for example HelmHTTPCredentials/v1 or RSACredentials/v1. Credentials/v1 is always supported.
+func WithCredentialTypeScheme(scheme *runtime.Scheme) Option {
+	return func(r *CredentialRepository) {
+		r.credentialTypeScheme = scheme
+	}
+}
+
+// NewCredentialRepository creates a new credential helper repository.
+func NewCredentialRepository(opts ...Option) *CredentialRepository {
+	r := &CredentialRepository{
+		now:   time.Now,
+		cache: make(map[string]*cachedCredentials),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func (r *CredentialRepository) GetCredentialRepositoryScheme() *runtime.Scheme {
+	return v1alpha1.Scheme
+}
+
+// ConsumerIdentityForConfig is not supported: helpers authenticate on their own
+// and do not need credentials from the credential graph.
+func (r *CredentialRepository) ConsumerIdentityForConfig(_ context.Context, _ runtime.Typed) (runtime.Identity, error) {
+	return nil, fmt.Errorf("credential helpers authenticate on their own and have no consumer identity")
+}
+
+// Resolve asks the helper for credentials of the given identity.
+// It returns no credentials and no error if the helper does not serve the identity type
+// or has no credentials for the identity.
+func (r *CredentialRepository) Resolve(ctx context.Context, cfg runtime.Typed, identity runtime.Identity, _ runtime.Typed) (runtime.Typed, error) {
+	helper, err := r.convert(cfg)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := identity.ParseType()
+	if err != nil {
+		return nil, fmt.Errorf("invalid consumer identity type: %w", err)
+	}
+	if !helper.Serves(typ) {
+		return nil, nil
+	}
+
+	key, err := cacheKey(helper, identity)
+	if err != nil {
+		return nil, err
+	}
+	if creds, ok := r.cached(helper, key); ok {
+		return creds, nil
+	}
+
+	// concurrent resolutions of the same identity share a single helper run.
+	ch := r.runs.DoChan(key, func() (any, error) {
+		// a resolution that finished in the meantime may have filled the cache already.
+		if creds, ok := r.cached(helper, key); ok {
+			return creds, nil
+		}
+		// the run is shared, so it must not be cancelled with the context of the first caller.
+		// It is still bounded by the timeout of the helper.
+		creds, err := r.run(context.WithoutCancel(ctx), helper, identity)
+		if err != nil {
+			return nil, err
+		}
+		if ttl, _ := helper.GetCacheTTL(); ttl > 0 {
+			r.mu.Lock()
+			r.cache[key] = &cachedCredentials{credentials: creds, expires: r.now().Add(ttl)}
+			r.mu.Unlock()
+		}
+		return creds, nil
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		creds, _ := res.Val.(runtime.Typed)
+		return creds, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// cached returns the cached answer of the helper, if the helper has a cache TTL.
+func (r *CredentialRepository) cached(helper *v1alpha1.CredentialHelper, key string) (runtime.Typed, bool) {
+	if ttl, _ := helper.GetCacheTTL(); ttl == 0 {
+		return nil, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cached, ok := r.cache[key]; ok && r.now().Before(cached.expires) {
+		return cached.credentials, true
+	}
+	return nil, false
+}
+
+func (r *CredentialRepository) convert(cfg runtime.Typed) (*v1alpha1.CredentialHelper, error) {
+	helper := &v1alpha1.CredentialHelper{}
+	if err := v1alpha1.Scheme.Convert(cfg, helper); err != nil {
+		return nil, fmt.Errorf("config could not be interpreted as %s: %w", v1alpha1.CredentialHelperVersionedType, err)
+	}
+	if err := helper.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s config: %w", v1alpha1.CredentialHelperVersionedType, err)
+	}
+	return helper, nil
+}
+
+// run starts the helper with the identity on stdin and decodes the credentials from its stdout.
+func (r *CredentialRepository) run(ctx context.Context, helper *v1alpha1.CredentialHelper, identity runtime.Identity) (runtime.Typed, error) {
+	timeout, _ := helper.GetTimeout()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	input, err := json.Marshal(identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal consumer identity: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, helper.Command, helper.Args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	slog.DebugContext(ctx, "running credential helper", "command", helper.Command, "identity", identity.String())
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("credential helper %q did not finish within %s", helper.Command, timeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("credential helper %q failed: %w: %s", helper.Command, err, msg)
+		}
+		return nil, fmt.Errorf("credential helper %q failed: %w", helper.Command, err)
+	}
+
+	output := bytes.TrimSpace(stdout.Bytes())
+	if len(output) == 0 {
+		slog.DebugContext(ctx, "credential helper has no credentials for consumer identity", "command", helper.Command, "identity", identity.String())
+		return nil, nil
+	}
+	creds, err := r.decode(output)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output of credential helper %q: %w", helper.Command, err)
+	}
+	return creds, nil
+}
+
+// decode converts the output of a helper into the typed credentials registered for its type.
+func (r *CredentialRepository) decode(output []byte) (runtime.Typed, error) {
+	var raw runtime.Raw
+	if err := json.Unmarshal(output, &raw); err != nil {
+		return nil, err
+	}
+	if raw.GetType().IsEmpty() {
+		return nil, fmt.Errorf("credentials have no type")
+	}
+
+	for _, scheme := range []*runtime.Scheme{directCredentialsScheme, r.credentialTypeScheme} {
+		if scheme == nil || !scheme.IsRegistered(raw.GetType()) {
+			continue
+		}
+		typed, err := scheme.NewObject(raw.GetType())
+		if err != nil {
+			return nil, err
+		}
+		if err := scheme.Convert(&raw, typed); err != nil {
+			return nil, fmt.Errorf("could not convert credentials of type %q: %w", raw.GetType(), err)
+		}
+		return typed, nil
+	}
+	return nil, fmt.Errorf("unknown credential type %q", raw.GetType())
+}
+
+// cacheKey identifies the answer of a helper for a consumer identity.
+func cacheKey(helper *v1alpha1.CredentialHelper, identity runtime.Identity) (string, error) {
+	data, err := json.Marshal(struct {
+		Command  string           `json:"command"`
+		Args     []string         `json:"args"`
+		Identity runtime.Identity `json:"identity"`
+	}{helper.Command, helper.Args, identity})
+	if err != nil {
+		return "", fmt.Errorf("failed to compute cache key: %w", err)
+	}
+	return string(data), nil
+}
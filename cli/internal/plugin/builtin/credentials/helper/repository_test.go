@@ -0,0 +1,286 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	credentialsv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/cli/internal/plugin/builtin/credentials/helper/spec/v1alpha1"
+)
+
+// fakeHelperEnv makes the test binary act as credential helper instead of running the tests.
+const fakeHelperEnv = "OCM_TEST_FAKE_CREDENTIAL_HELPER"
+
+func TestMain(m *testing.M) {
+	if mode, ok := os.LookupEnv(fakeHelperEnv); ok {
+		os.Exit(fakeHelper(mode))
+	}
+	os.Exit(m.Run())
+}
+
+// fakeHelper answers with credentials for the hostname of the identity read from stdin.
+// Every invocation is appended to the file given as first argument.
+func fakeHelper(mode string) int {
+	if len(os.Args) > 1 {
+		f, err := os.OpenFile(os.Args[1], os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return 2
+		}
+		_, _ = fmt.Fprintln(f, mode)
+		_ = f.Close()
+	}
+
+	var identity runtime.Identity
+	if err := json.NewDecoder(os.Stdin).Decode(&identity); err != nil {
+		fmt.Fprintln(os.Stderr, "invalid identity:", err)
+		return 1
+	}
+
+	switch mode {
+	case "typed":
+		fmt.Printf(`{"type":"TestCredentials/v1","username":"user-%s","password":"secret"}`+"\n", identity["hostname"])
+	case "direct":
+		fmt.Printf(`{"type":"Credentials/v1","properties":{"token":"token-%s"}}`, identity["hostname"])
+	case "empty":
+	case "fail":
+		fmt.Fprintln(os.Stderr, "access denied by broker")
+		return 3
+	case "slow":
+		time.Sleep(500 * time.Millisecond)
+		fmt.Printf(`{"type":"TestCredentials/v1","username":"user-%s","password":"secret"}`+"\n", identity["hostname"])
+	case "hang":
+		time.Sleep(time.Minute)
+	case "garbage":
+		fmt.Print("not json")
+	}
+	return 0
+}
+
+type testCredentials struct {
+	Type     runtime.Type `json:"type"`
+	Username string       `json:"username,omitempty"`
+	Password string       `json:"password,omitempty"`
+}
+
+func (c *testCredentials) GetType() runtime.Type    { return c.Type }
+func (c *testCredentials) SetType(typ runtime.Type) { c.Type = typ }
+func (c *testCredentials) DeepCopyTyped() runtime.Typed {
+	cp := *c
+	return &cp
+}
+
+func newTestRepository(t *testing.T) *CredentialRepository {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	scheme.MustRegisterWithAlias(&testCredentials{}, runtime.NewVersionedType("TestCredentials", "v1"))
+	return NewCredentialRepository(WithCredentialTypeScheme(scheme))
+}
+
+// helperConfig returns a config that runs the test binary as fake helper in the given mode.
+// It returns the file recording the invocations.
+func helperConfig(t *testing.T, mode string, modify ...func(*v1alpha1.CredentialHelper)) (*v1alpha1.CredentialHelper, string) {
+	t.Helper()
+	executable, err := os.Executable()
+	require.NoError(t, err)
+	t.Setenv(fakeHelperEnv, mode)
+	calls := filepath.Join(t.TempDir(), "calls")
+	cfg := &v1alpha1.CredentialHelper{
+		Type:    v1alpha1.CredentialHelperVersionedType,
+		Command: executable,
+		Args:    []string{calls},
+	}
+	for _, m := range modify {
+		m(cfg)
+	}
+	return cfg, calls
+}
+
+func countCalls(t *testing.T, calls string) int {
+	t.Helper()
+	data, err := os.ReadFile(calls)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	require.NoError(t, err)
+	return strings.Count(string(data), "\n")
+}
+
+var ghcr = runtime.Identity{runtime.IdentityAttributeType: "OCIRegistry", runtime.IdentityAttributeHostname: "ghcr.io"}
+
+func Test_Resolve(t *testing.T) {
+	t.Run("typed credentials", func(t *testing.T) {
+		r := require.New(t)
+		cfg, _ := helperConfig(t, "typed")
+		creds, err := newTestRepository(t).Resolve(t.Context(), cfg, ghcr, nil)
+		r.NoError(err)
+		r.Equal(&testCredentials{
+			Type:     runtime.NewVersionedType("TestCredentials", "v1"),
+			Username: "user-ghcr.io",
+			Password: "secret",
+		}, creds)
+	})
+
+	t.Run("direct credentials", func(t *testing.T) {
+		r := require.New(t)
+		cfg, _ := helperConfig(t, "direct")
+		creds, err := NewCredentialRepository().Resolve(t.Context(), cfg, ghcr, nil)
+		r.NoError(err)
+		direct, ok := creds.(*credentialsv1.DirectCredentials)
+		r.True(ok, "expected direct credentials, got %T", creds)
+		r.Equal(map[string]string{"token": "token-ghcr.io"}, direct.Properties)
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		r := require.New(t)
+		cfg, _ := helperConfig(t, "empty")
+		creds, err := newTestRepository(t).Resolve(t.Context(), cfg, ghcr, nil)
+		r.NoError(err)
+		r.Nil(creds)
+	})
+
+	t.Run("identity type not served", func(t *testing.T) {
+		r := require.New(t)
+		cfg, calls := helperConfig(t, "typed", func(cfg *v1alpha1.CredentialHelper) {
+			cfg.IdentityTypes = []string{"HelmChartRepository", "RSA/v1alpha1"}
+		})
+		repo := newTestRepository(t)
+
+		creds, err := repo.Resolve(t.Context(), cfg, ghcr, nil)
+		r.NoError(err)
+		r.Nil(creds)
+		r.Zero(countCalls(t, calls))
+
+		creds, err = repo.Resolve(t.Context(), cfg, runtime.Identity{runtime.IdentityAttributeType: "HelmChartRepository", runtime.IdentityAttributeHostname: "charts.example.com"}, nil)
+		r.NoError(err)
+		r.Equal("user-charts.example.com", creds.(*testCredentials).Username)
+	})
+
+	t.Run("helper fails", func(t *testing.T) {
+		cfg, _ := helperConfig(t, "fail")
+		_, err := newTestRepository(t).Resolve(t.Context(), cfg, ghcr, nil)
+		require.ErrorContains(t, err, "access denied by broker")
+	})
+
+	t.Run("helper times out", func(t *testing.T) {
+		cfg, _ := helperConfig(t, "hang", func(cfg *v1alpha1.CredentialHelper) {
+			cfg.Timeout = "200ms"
+		})
+		_, err := newTestRepository(t).Resolve(t.Context(), cfg, ghcr, nil)
+		require.ErrorContains(t, err, "did not finish within 200ms")
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		cfg, _ := helperConfig(t, "garbage")
+		_, err := newTestRepository(t).Resolve(t.Context(), cfg, ghcr, nil)
+		require.ErrorContains(t, err, "invalid output of credential helper")
+	})
+
+	t.Run("unknown credential type", func(t *testing.T) {
+		cfg, _ := helperConfig(t, "typed")
+		_, err := NewCredentialRepository().Resolve(t.Context(), cfg, ghcr, nil)
+		require.ErrorContains(t, err, `unknown credential type "TestCredentials/v1"`)
+	})
+
+	t.Run("helper not found", func(t *testing.T) {
+		cfg := &v1alpha1.CredentialHelper{Type: v1alpha1.CredentialHelperVersionedType, Command: "ocm-credential-helper-does-not-exist"}
+		_, err := newTestRepository(t).Resolve(t.Context(), cfg, ghcr, nil)
+		require.ErrorContains(t, err, "ocm-credential-helper-does-not-exist")
+	})
+}
+
+func Test_Resolve_Cache(t *testing.T) {
+	r := require.New(t)
+	cfg, calls := helperConfig(t, "typed", func(cfg *v1alpha1.CredentialHelper) {
+		cfg.CacheTTL = "1m"
+	})
+	repo := newTestRepository(t)
+	now := time.Now()
+	repo.now = func() time.Time { return now }
+
+	for range 3 {
+		_, err := repo.Resolve(t.Context(), cfg, ghcr, nil)
+		r.NoError(err)
+	}
+	r.Equal(1, countCalls(t, calls), "helper must be called once while the answer is cached")
+
+	_, err := repo.Resolve(t.Context(), cfg, runtime.Identity{runtime.IdentityAttributeType: "OCIRegistry", runtime.IdentityAttributeHostname: "quay.io"}, nil)
+	r.NoError(err)
+	r.Equal(2, countCalls(t, calls), "other identities are cached separately")
+
+	now = now.Add(2 * time.Minute)
+	_, err = repo.Resolve(t.Context(), cfg, ghcr, nil)
+	r.NoError(err)
+	r.Equal(3, countCalls(t, calls), "helper must be called again after the cache TTL")
+
+	cfg.CacheTTL = "0s"
+	for range 2 {
+		_, err = repo.Resolve(t.Context(), cfg, ghcr, nil)
+		r.NoError(err)
+	}
+	r.Equal(5, countCalls(t, calls), "helper must be called on every resolution without cache TTL")
+}
+
+func Test_Resolve_Concurrent(t *testing.T) {
+	r := require.New(t)
+	cfg, calls := helperConfig(t, "slow", func(cfg *v1alpha1.CredentialHelper) {
+		cfg.CacheTTL = "0s"
+	})
+	repo := newTestRepository(t)
+
+	// a cancelled resolution stops waiting without failing the shared run.
+	ctx, cancel := context.WithCancel(t.Context())
+	cancelled := make(chan error, 1)
+	go func() {
+		_, err := repo.Resolve(ctx, cfg, ghcr, nil)
+		cancelled <- err
+	}()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			creds, err := repo.Resolve(t.Context(), cfg, ghcr, nil)
+			if err == nil && creds.(*testCredentials).Username != "user-ghcr.io" {
+				err = fmt.Errorf("unexpected credentials %v", creds)
+			}
+			errs <- err
+		}()
+	}
+	cancel()
+	r.ErrorIs(<-cancelled, context.Canceled)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		r.NoError(err)
+	}
+	r.Equal(1, countCalls(t, calls), "concurrent resolutions of the same identity must share a helper run")
+}
+
+func Test_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		cfg  v1alpha1.CredentialHelper
+		err  string
+	}{
+		{name: "missing command", cfg: v1alpha1.CredentialHelper{}, err: "command is required"},
+		{name: "invalid timeout", cfg: v1alpha1.CredentialHelper{Command: "x", Timeout: "soon"}, err: "invalid timeout"},
+		{name: "negative cache TTL", cfg: v1alpha1.CredentialHelper{Command: "x", CacheTTL: "-1s"}, err: "must not be negative"},
+		{name: "invalid identity type", cfg: v1alpha1.CredentialHelper{Command: "x", IdentityTypes: []string{"a/b/c"}}, err: "invalid identity type"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorContains(t, tc.cfg.Validate(), tc.err)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	// CredentialHelperType is the type of the credential helper repository.
+	CredentialHelperType = "CredentialHelper"
+
+	// DefaultTimeout is how long a helper may run before it is killed.
+	DefaultTimeout = 10 * time.Second
+	// DefaultCacheTTL is how long the answer of a helper is cached.
+	DefaultCacheTTL = 5 * time.Minute
+)
+
+// CredentialHelperVersionedType is the versioned type of the credential helper repository.
+var CredentialHelperVersionedType = runtime.NewVersionedType(CredentialHelperType, Version)
+
+// Scheme contains the credential helper repository type.
+var Scheme = runtime.NewScheme()
+
+func init() {
+	Scheme.MustRegisterWithAlias(&CredentialHelper{}, CredentialHelperVersionedType, runtime.NewUnversionedType(CredentialHelperType))
+}
+
+// CredentialHelper is a credential repository backed by an external helper executable.
+//
+// For every consumer identity that needs credentials, the helper is started with Args and receives
+// the consumer identity as JSON object on stdin, for example
+//
+//	{"type":"HelmChartRepository","hostname":"charts.example.com"}
+//
+// It answers with a typed credential as JSON object on stdout, for example
+//
+//	{"type":"HelmHTTPCredentials/v1","username":"ci-bot","password":"..."}
+//
+// or with {"type":"Credentials/v1","properties":{...}} for plain key-value credentials.
+// Empty output means that the helper has no credentials for the identity.
+// A non-zero exit code fails the resolution, the standard error of the helper is part of the error.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type CredentialHelper struct {
+	// +ocm:jsonschema-gen:enum=CredentialHelper/v1alpha1
+	// +ocm:jsonschema-gen:enum:deprecated=CredentialHelper
+	Type runtime.Type `json:"type"`
+	// Command is the helper executable, either a path or a name that is looked up in PATH.
+	Command string `json:"command"`
+	// Args are passed to the helper on every invocation.
+	Args []string `json:"args,omitempty"`
+	// IdentityTypes restricts the helper to consumer identities of the given types, for example
+	// HelmChartRepository or RSA/v1alpha1. A type without version matches all versions.
+	// If not set, the helper is asked for every consumer identity.
+	IdentityTypes []string `json:"identityTypes,omitempty"`
+	// Timeout is how long the helper may run, for example "30s". Defaults to 10s.
+	Timeout string `json:"timeout,omitempty"`
+	// CacheTTL is how long the answer of the helper is cached per consumer identity, for example "10m".
+	// Set it to "0s" to ask the helper on every resolution. Defaults to 5m.
+	CacheTTL string `json:"cacheTTL,omitempty"`
+}
+
+// GetTimeout returns how long the helper may run.
+func (h *CredentialHelper) GetTimeout() (time.Duration, error) {
+	return parseDuration("timeout", h.Timeout, DefaultTimeout)
+}
+
+// GetCacheTTL returns how long the answer of the helper is cached.
+func (h *CredentialHelper) GetCacheTTL() (time.Duration, error) {
+	return parseDuration("cache TTL", h.CacheTTL, DefaultCacheTTL)
+}
+
+// Serves reports whether the helper is responsible for consumer identities of the given type.
+func (h *CredentialHelper) Serves(typ runtime.Type) bool {
+	if len(h.IdentityTypes) == 0 {
+		return true
+	}
+	for _, t := range h.IdentityTypes {
+		served, err := runtime.TypeFromString(t)
+		if err != nil {
+			continue
+		}
+		if served.Name == typ.Name && (served.Version == "" || served.Version == typ.Version) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the repository can be used.
+func (h *CredentialHelper) Validate() error {
+	if h.Command == "" {
+		return fmt.Errorf("command is required")
+	}
+	for _, t := range h.IdentityTypes {
+		if _, err := runtime.TypeFromString(t); err != nil {
+			return fmt.Errorf("invalid identity type %q: %w", t, err)
+		}
+	}
+	if _, err := h.GetTimeout(); err != nil {
+		return err
+	}
+	if _, err := h.GetCacheTTL(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func parseDuration(name, value string, def time.Duration) (time.Duration, error) {
+	if value == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", name, value, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid %s %q: must not be negative", name, value)
+	}
+	return d, nil
+}
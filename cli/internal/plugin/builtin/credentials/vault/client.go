@@ -0,0 +1,181 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// errNotFound is returned by the client if Vault responds with 404 Not Found.
+var errNotFound = errors.New("not found")
+
+// responseError is an error response of the Vault HTTP API.
+type responseError struct {
+	StatusCode int
+	Errors     []string
+}
+
+func (e *responseError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("vault responded with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("vault responded with status %d: %s", e.StatusCode, strings.Join(e.Errors, "; "))
+}
+
+// isPermissionDenied reports whether Vault rejected the token, for example because it expired.
+func isPermissionDenied(err error) bool {
+	var respErr *responseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusForbidden
+}
+
+// response is the envelope of all Vault HTTP API responses.
+type response struct {
+	LeaseDuration int             `json:"lease_duration"`
+	Data          json.RawMessage `json:"data"`
+	Auth          *authResponse   `json:"auth"`
+	Errors        []string        `json:"errors"`
+}
+
+type authResponse struct {
+	ClientToken   string `json:"client_token"`
+	LeaseDuration int    `json:"lease_duration"`
+	Renewable     bool   `json:"renewable"`
+}
+
+// kvSecret is a secret version read from a KV v2 secrets engine.
+type kvSecret struct {
+	Data     map[string]any `json:"data"`
+	Metadata struct {
+		CustomMetadata map[string]string `json:"custom_metadata"`
+	} `json:"metadata"`
+	// LeaseDuration is the lease of the secret in seconds, 0 if the secret has no lease.
+	LeaseDuration int `json:"-"`
+}
+
+// client is a minimal client of the Vault HTTP API covering
+// the auth methods and KV v2 operations used by the credential repository.
+type client struct {
+	httpClient *http.Client
+	address    string
+	namespace  string
+}
+
+func newClient(httpClient *http.Client, serverURL, namespace string) (*client, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vault server URL %q: %w", serverURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid vault server URL %q: scheme must be http or https", serverURL)
+	}
+	return &client{
+		httpClient: httpClient,
+		address:    strings.TrimSuffix(u.String(), "/"),
+		namespace:  namespace,
+	}, nil
+}
+
+// login authenticates against the auth method mounted at mountPath and returns the issued token.
+func (c *client) login(ctx context.Context, mountPath string, body map[string]string) (*authResponse, error) {
+	resp, err := c.do(ctx, http.MethodPost, "auth/"+strings.Trim(mountPath, "/")+"/login", "", body)
+	if err != nil {
+		return nil, fmt.Errorf("vault login with auth method at %q failed: %w", mountPath, err)
+	}
+	if resp.Auth == nil || resp.Auth.ClientToken == "" {
+		return nil, fmt.Errorf("vault login with auth method at %q did not return a token", mountPath)
+	}
+	return resp.Auth, nil
+}
+
+// list returns the keys directly below path in the KV v2 engine mounted at mountPath.
+// Keys of sub folders end with a slash. A missing path yields no keys.
+func (c *client) list(ctx context.Context, token, mountPath, path string) ([]string, error) {
+	resp, err := c.do(ctx, "LIST", joinPath(mountPath, "metadata", path), token, nil)
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing secrets at %q failed: %w", joinPath(mountPath, path), err)
+	}
+	var data struct {
+		Keys []string `json:"keys"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("decoding secret list at %q failed: %w", joinPath(mountPath, path), err)
+	}
+	return data.Keys, nil
+}
+
+// read returns the latest version of the secret at path in the KV v2 engine mounted at mountPath.
+func (c *client) read(ctx context.Context, token, mountPath, path string) (*kvSecret, error) {
+	resp, err := c.do(ctx, http.MethodGet, joinPath(mountPath, "data", path), token, nil)
+	if err != nil {
+		return nil, fmt.Errorf("reading secret %q failed: %w", joinPath(mountPath, path), err)
+	}
+	var secret kvSecret
+	if err := json.Unmarshal(resp.Data, &secret); err != nil {
+		return nil, fmt.Errorf("decoding secret %q failed: %w", joinPath(mountPath, path), err)
+	}
+	secret.LeaseDuration = resp.LeaseDuration
+	return &secret, nil
+}
+
+func (c *client) do(ctx context.Context, method, path, token string, body any) (*response, error) {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("encoding request failed: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.address+"/v1/"+path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("creating request failed: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var resp response
+	if res.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(res.Body).Decode(&resp); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("decoding response with status %d failed: %w", res.StatusCode, err)
+		}
+	}
+	switch {
+	case res.StatusCode == http.StatusNotFound && len(resp.Errors) == 0:
+		return nil, errNotFound
+	case res.StatusCode >= http.StatusBadRequest:
+		return nil, &responseError{StatusCode: res.StatusCode, Errors: resp.Errors}
+	}
+	return &resp, nil
+}
+
+func joinPath(elems ...string) string {
+	parts := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		if elem = strings.Trim(elem, "/"); elem != "" {
+			parts = append(parts, elem)
+		}
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,52 @@
+package vault
+
+import (
+	"fmt"
+
+	credentialsv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	gpgcredentialsv1alpha1 "ocm.software/open-component-model/bindings/go/gpg/spec/credentials/v1alpha1"
+	gpgidentityv1alpha1 "ocm.software/open-component-model/bindings/go/gpg/spec/identity/v1alpha1"
+	helmcredentialsv1 "ocm.software/open-component-model/bindings/go/helm/spec/credentials/v1"
+	helmidentityv1 "ocm.software/open-component-model/bindings/go/helm/spec/identity/v1"
+	ocicredentialsv1 "ocm.software/open-component-model/bindings/go/oci/spec/credentials/v1"
+	ociidentityv1 "ocm.software/open-component-model/bindings/go/oci/spec/identity/v1"
+	rsacredentialsv1 "ocm.software/open-component-model/bindings/go/rsa/spec/credentials/v1"
+	rsaidentityv1 "ocm.software/open-component-model/bindings/go/rsa/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// typedCredentials maps the fields of a secret to the typed credentials of the consumer identity type:
+//   - OCIRegistry: OCICredentials/v1 (username, password, accessToken, refreshToken)
+//   - HelmChartRepository: HelmHTTPCredentials/v1 (username, password, certFile, keyFile, keyring)
+//   - RSA: RSACredentials/v1 (publicKeyPEM, privateKeyPEM and their file variants)
+//   - GPG: GPGCredentials/v1alpha1 (publicKeyPGP, privateKeyPGP, their file variants and passphrase)
+//
+// Secrets for other consumer identity types are returned as Credentials/v1 with all fields as properties.
+func typedCredentials(identity runtime.Identity, properties map[string]string) (runtime.Typed, error) {
+	direct := &credentialsv1.DirectCredentials{
+		Type:       runtime.NewVersionedType(credentialsv1.CredentialsType, credentialsv1.Version),
+		Properties: properties,
+	}
+	typ, err := identity.ParseType()
+	if err != nil {
+		return direct, nil
+	}
+
+	var typed runtime.Typed
+	switch typ.Name {
+	case ociidentityv1.OCIRegistryIdentityType:
+		typed, err = ocicredentialsv1.ConvertToOCICredentials(direct)
+	case helmidentityv1.HelmChartRepositoryIdentityType:
+		typed, err = helmcredentialsv1.ConvertToHelmHTTPCredentials(direct)
+	case rsaidentityv1.RSAIdentityType:
+		typed, err = rsacredentialsv1.ConvertToRSACredentials(direct)
+	case gpgidentityv1alpha1.GPGIdentityType:
+		typed, err = gpgcredentialsv1alpha1.ConvertToGPGCredentials(direct)
+	default:
+		return direct, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert secret to credentials for consumer identity type %s: %w", typ, err)
+	}
+	return typed, nil
+}
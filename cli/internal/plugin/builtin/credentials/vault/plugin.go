@@ -0,0 +1,20 @@
+package vault
+
+import (
+	"ocm.software/open-component-model/bindings/go/credentials"
+	ocmhttp "ocm.software/open-component-model/bindings/go/http"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	ociidentity "ocm.software/open-component-model/bindings/go/oci/spec/identity/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/credentialrepository"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// Register registers the HashiCorp Vault credential repository.
+// Vault serves OCI registries next to docker config files as well as any other consumer identity type
+// that has no dedicated credential repository, such as Helm chart repositories, RSA or GPG keys.
+func Register(registry *credentialrepository.RepositoryRegistry, httpConfig *httpv1alpha1.Config) error {
+	return registry.RegisterInternalCredentialRepositoryPlugin(
+		NewCredentialRepository(WithHTTPClient(ocmhttp.New(ocmhttp.WithConfig(httpConfig)))),
+		[]runtime.Type{ociidentity.Type, credentials.AnyConsumerIdentityType},
+	)
+}
@@ -0,0 +1,457 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"ocm.software/open-component-model/bindings/go/credentials"
+	credentialsv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/cli/internal/plugin/builtin/credentials/vault/spec/v1alpha1"
+)
+
+const (
+	// CustomMetadataConsumerIdentity is the custom metadata key of a secret that holds
+	// the consumer identity served by the secret, for example "type=OCIRegistry,hostname=ghcr.io".
+	CustomMetadataConsumerIdentity = "consumerIdentity"
+
+	// Credential properties used to log in to Vault, resolved for the HashiCorpVault consumer identity.
+	CredentialKeyToken    = "token"
+	CredentialKeyRoleID   = "role_id"
+	CredentialKeySecretID = "secret_id"
+
+	// Environment variables used to log in to Vault if neither files nor the credential graph provide a value.
+	EnvToken    = "VAULT_TOKEN"
+	EnvRoleID   = "VAULT_ROLE_ID"
+	EnvSecretID = "VAULT_SECRET_ID"
+
+	// sharedRequestTimeout bounds logins and reads that are shared between concurrent resolutions.
+	// They do not use the context of the resolution that started them, so that its cancellation
+	// does not fail the other resolutions waiting for the result.
+	sharedRequestTimeout = 30 * time.Second
+)
+
+// CredentialRepository implements the RepositoryPlugin Credential Graph interface for
+// KV v2 secrets engines of HashiCorp Vault (see v1alpha1.HashiCorpVault).
+//
+// Tokens issued by a login and secrets read from Vault are cached for the lifetime of the repository.
+// Tokens are renewed by logging in again once 90% of their lease has passed, secrets are read again
+// once the configured cache TTL or their lease expires, whichever comes first.
+type CredentialRepository struct {
+	httpClient *http.Client
+	now        func() time.Time
+
+	// mu guards the caches only, it is never held while talking to Vault.
+	mu      sync.Mutex
+	tokens  map[string]*cachedToken
+	secrets map[string]*cachedSecrets
+	// logins and reads deduplicate concurrent logins and reads per cache key.
+	logins singleflight.Group
+	reads  singleflight.Group
+}
+
+var _ credentials.RepositoryPlugin = (*CredentialRepository)(nil)
+
+type cachedToken struct {
+	value string
+	// expires is zero for tokens that are not issued by a login.
+	expires time.Time
+}
+
+type cachedSecrets struct {
+	secrets []secret
+	expires time.Time
+}
+
+type secret struct {
+	identity   runtime.Identity
+	properties map[string]string
+}
+
+// Option configures a CredentialRepository.
+type Option func(*CredentialRepository)
+
+// WithHTTPClient sets the HTTP client used to talk to Vault.
+func WithHTTPClient(client *http.Client) Option {
+	return func(r *CredentialRepository) {
+		r.httpClient = client
+	}
+}
+
+// NewCredentialRepository creates a new HashiCorp Vault credential repository.
+func NewCredentialRepository(opts ...Option) *CredentialRepository {
+	r := &CredentialRepository{
+		httpClient: http.DefaultClient,
+		now:        time.Now,
+		tokens:     make(map[string]*cachedToken),
+		secrets:    make(map[string]*cachedSecrets),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func (r *CredentialRepository) GetCredentialRepositoryScheme() *runtime.Scheme {
+	return v1alpha1.Scheme
+}
+
+// ConsumerIdentityForConfig returns the identity under which the credentials to log in to Vault
+// are looked up in the credential graph: the type HashiCorpVault/v1alpha1 with the address of the server.
+func (r *CredentialRepository) ConsumerIdentityForConfig(_ context.Context, cfg runtime.Typed) (runtime.Identity, error) {
+	vault, err := r.convert(cfg)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := runtime.ParseURLToIdentity(vault.ServerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vault server URL %q: %w", vault.ServerURL, err)
+	}
+	identity.SetType(v1alpha1.HashiCorpVaultVersionedType)
+	return identity, nil
+}
+
+// Resolve returns the credentials of the secret whose consumer identity matches the given identity,
+// typed for the consumer identity type (see typedCredentials).
+// It returns no credentials and no error if no secret matches.
+func (r *CredentialRepository) Resolve(ctx context.Context, cfg runtime.Typed, identity runtime.Identity, loginCredentials runtime.Typed) (runtime.Typed, error) {
+	vault, err := r.convert(cfg)
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := r.getSecrets(ctx, vault, loginCredentials)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range secrets {
+		if identity.Match(s.identity) {
+			return typedCredentials(identity, s.properties)
+		}
+	}
+	slog.DebugContext(ctx, "no vault secret matches consumer identity", "identity", identity.String(), "server", vault.ServerURL)
+	return nil, nil
+}
+
+func (r *CredentialRepository) convert(cfg runtime.Typed) (*v1alpha1.HashiCorpVault, error) {
+	vault := &v1alpha1.HashiCorpVault{}
+	if err := v1alpha1.Scheme.Convert(cfg, vault); err != nil {
+		return nil, fmt.Errorf("config could not be interpreted as %s: %w", v1alpha1.HashiCorpVaultVersionedType, err)
+	}
+	if err := vault.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s config: %w", v1alpha1.HashiCorpVaultVersionedType, err)
+	}
+	return vault, nil
+}
+
+// getSecrets returns all secrets of the repository that serve a consumer identity, from cache if possible.
+// Concurrent resolutions of the same repository share a single login and read.
+func (r *CredentialRepository) getSecrets(ctx context.Context, vault *v1alpha1.HashiCorpVault, loginCredentials runtime.Typed) ([]secret, error) {
+	key, err := cacheKey(vault)
+	if err != nil {
+		return nil, err
+	}
+	if secrets, ok := r.cachedSecrets(key); ok {
+		return secrets, nil
+	}
+	v, err := shared(ctx, &r.reads, key, func(ctx context.Context) (any, error) {
+		// a resolution that finished in the meantime may have filled the cache already.
+		if secrets, ok := r.cachedSecrets(key); ok {
+			return secrets, nil
+		}
+		return r.fetchSecrets(ctx, vault, key, loginCredentials)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]secret), nil
+}
+
+func (r *CredentialRepository) cachedSecrets(key string) ([]secret, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cached, ok := r.secrets[key]; ok && r.now().Before(cached.expires) {
+		return cached.secrets, true
+	}
+	return nil, false
+}
+
+// fetchSecrets logs in if necessary, reads the secrets from Vault and caches them under key.
+func (r *CredentialRepository) fetchSecrets(ctx context.Context, vault *v1alpha1.HashiCorpVault, key string, loginCredentials runtime.Typed) ([]secret, error) {
+	c, err := newClient(r.httpClient, vault.ServerURL, vault.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	tokenKey, err := cacheKey(&v1alpha1.HashiCorpVault{ServerURL: vault.ServerURL, Namespace: vault.Namespace, Auth: vault.Auth})
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := r.getToken(ctx, c, tokenKey, vault, loginCredentials)
+	if err != nil {
+		return nil, err
+	}
+	secrets, lease, err := r.readSecrets(ctx, c, token.value, vault)
+	if isPermissionDenied(err) && !token.expires.IsZero() {
+		// the token was revoked before its lease expired, log in again once.
+		r.mu.Lock()
+		if r.tokens[tokenKey] == token {
+			delete(r.tokens, tokenKey)
+		}
+		r.mu.Unlock()
+		if token, err = r.getToken(ctx, c, tokenKey, vault, loginCredentials); err != nil {
+			return nil, err
+		}
+		secrets, lease, err = r.readSecrets(ctx, c, token.value, vault)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ttl, _ := vault.GetCacheTTL()
+	if lease > 0 && lease < ttl {
+		ttl = lease
+	}
+	r.mu.Lock()
+	r.secrets[key] = &cachedSecrets{secrets: secrets, expires: r.now().Add(ttl)}
+	r.mu.Unlock()
+	return secrets, nil
+}
+
+// readSecrets reads all secrets of the repository and returns them together with the shortest lease among them.
+func (r *CredentialRepository) readSecrets(ctx context.Context, c *client, token string, vault *v1alpha1.HashiCorpVault) ([]secret, time.Duration, error) {
+	mountPath := vault.GetMountPath()
+	names := vault.Secrets
+	if len(names) == 0 {
+		keys, err := c.list(ctx, token, mountPath, vault.Path)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, key := range keys {
+			if !strings.HasSuffix(key, "/") {
+				names = append(names, key)
+			}
+		}
+	}
+
+	var (
+		secrets []secret
+		lease   time.Duration
+	)
+	for _, name := range names {
+		path := joinPath(vault.Path, name)
+		kv, err := c.read(ctx, token, mountPath, path)
+		if err != nil {
+			return nil, 0, err
+		}
+		if d := time.Duration(kv.LeaseDuration) * time.Second; d > 0 && (lease == 0 || d < lease) {
+			lease = d
+		}
+		value, ok := kv.Metadata.CustomMetadata[CustomMetadataConsumerIdentity]
+		if !ok {
+			slog.DebugContext(ctx, "ignoring vault secret without consumer identity", "secret", joinPath(mountPath, path))
+			continue
+		}
+		identity, err := runtime.ParseIdentity(value)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid consumer identity of secret %q: %w", joinPath(mountPath, path), err)
+		}
+		properties, err := toProperties(kv.Data)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid data of secret %q: %w", joinPath(mountPath, path), err)
+		}
+		secrets = append(secrets, secret{identity: identity, properties: properties})
+	}
+	return secrets, lease, nil
+}
+
+// getToken returns a valid token for the auth configuration of the repository, logging in if necessary.
+// Concurrent resolutions with the same auth configuration share a single login.
+func (r *CredentialRepository) getToken(ctx context.Context, c *client, key string, vault *v1alpha1.HashiCorpVault, loginCredentials runtime.Typed) (*cachedToken, error) {
+	if token, ok := r.cachedToken(key); ok {
+		return token, nil
+	}
+	v, err := shared(ctx, &r.logins, key, func(ctx context.Context) (any, error) {
+		if token, ok := r.cachedToken(key); ok {
+			return token, nil
+		}
+		token, err := r.login(ctx, c, vault, loginCredentials)
+		if err != nil {
+			return nil, err
+		}
+		r.mu.Lock()
+		r.tokens[key] = token
+		r.mu.Unlock()
+		return token, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*cachedToken), nil
+}
+
+// shared runs fn once for all concurrent callers with the same key. fn gets a context that is detached
+// from the cancellation of the calling context and bounded by sharedRequestTimeout. A caller whose
+// context is cancelled stops waiting, while fn keeps running for the other callers.
+func shared(ctx context.Context, group *singleflight.Group, key string, fn func(ctx context.Context) (any, error)) (any, error) {
+	detached := context.WithoutCancel(ctx)
+	ch := group.DoChan(key, func() (any, error) {
+		ctx, cancel := context.WithTimeout(detached, sharedRequestTimeout)
+		defer cancel()
+		return fn(ctx)
+	})
+	select {
+	case res := <-ch:
+		return res.Val, res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *CredentialRepository) cachedToken(key string) (*cachedToken, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cached, ok := r.tokens[key]; ok && (cached.expires.IsZero() || r.now().Before(cached.expires)) {
+		return cached, true
+	}
+	return nil, false
+}
+
+// login returns the token for the auth configuration of the repository, logging in to Vault unless
+// the auth method uses a static token.
+func (r *CredentialRepository) login(ctx context.Context, c *client, vault *v1alpha1.HashiCorpVault, loginCredentials runtime.Typed) (*cachedToken, error) {
+	properties, err := loginProperties(loginCredentials)
+	if err != nil {
+		return nil, err
+	}
+	auth := vault.Auth
+	if auth == nil {
+		auth = &v1alpha1.Auth{}
+	}
+
+	var body map[string]string
+	switch auth.GetMethod() {
+	case v1alpha1.AuthMethodToken:
+		value, err := lookup(auth.TokenFile, properties[CredentialKeyToken], EnvToken)
+		if err != nil {
+			return nil, err
+		}
+		if value == "" {
+			return nil, fmt.Errorf("no vault token configured for %s, provide a token file, credentials for the consumer identity of type %s or $%s",
+				vault.ServerURL, v1alpha1.HashiCorpVaultVersionedType, EnvToken)
+		}
+		return &cachedToken{value: value}, nil
+	case v1alpha1.AuthMethodAppRole:
+		roleID, err := lookup("", firstNonEmpty(auth.RoleID, properties[CredentialKeyRoleID]), EnvRoleID)
+		if err != nil {
+			return nil, err
+		}
+		secretID, err := lookup(auth.SecretIDFile, properties[CredentialKeySecretID], EnvSecretID)
+		if err != nil {
+			return nil, err
+		}
+		if roleID == "" || secretID == "" {
+			return nil, fmt.Errorf("the approle login to %s requires a role ID and a secret ID", vault.ServerURL)
+		}
+		body = map[string]string{"role_id": roleID, "secret_id": secretID}
+	case v1alpha1.AuthMethodKubernetes:
+		tokenFile := auth.ServiceAccountTokenFile
+		if tokenFile == "" {
+			tokenFile = v1alpha1.DefaultServiceAccountTokenFile
+		}
+		jwt, err := lookup(tokenFile, "", "")
+		if err != nil {
+			return nil, err
+		}
+		body = map[string]string{"role": auth.Role, "jwt": jwt}
+	}
+
+	resp, err := c.login(ctx, auth.GetMountPath(), body)
+	if err != nil {
+		return nil, err
+	}
+	token := &cachedToken{value: resp.ClientToken}
+	if lease := time.Duration(resp.LeaseDuration) * time.Second; lease > 0 {
+		// renew the token before it actually expires.
+		token.expires = r.now().Add(lease * 9 / 10)
+	}
+	return token, nil
+}
+
+var loginCredentialsScheme = runtime.NewScheme()
+
+func init() {
+	credentialsv1.MustRegister(loginCredentialsScheme)
+}
+
+// loginProperties returns the properties of the credentials resolved for the Vault server.
+func loginProperties(loginCredentials runtime.Typed) (map[string]string, error) {
+	if loginCredentials == nil {
+		return map[string]string{}, nil
+	}
+	direct := &credentialsv1.DirectCredentials{}
+	if err := loginCredentialsScheme.Convert(loginCredentials, direct); err != nil {
+		return nil, fmt.Errorf("unsupported credentials of type %s for vault login: %w", loginCredentials.GetType(), err)
+	}
+	return direct.Properties, nil
+}
+
+// lookup returns the trimmed content of file if set, otherwise value if set, otherwise the environment variable env.
+func lookup(file, value, env string) (string, error) {
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read vault login secret: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	if value != "" || env == "" {
+		return value, nil
+	}
+	return os.Getenv(env), nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// toProperties converts the data of a secret into credential properties.
+// Values that are not strings are encoded as JSON.
+func toProperties(data map[string]any) (map[string]string, error) {
+	properties := make(map[string]string, len(data))
+	for k, v := range data {
+		if s, ok := v.(string); ok {
+			properties[k] = s
+			continue
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode value of %q: %w", k, err)
+		}
+		properties[k] = string(encoded)
+	}
+	return properties, nil
+}
+
+func cacheKey(vault *v1alpha1.HashiCorpVault) (string, error) {
+	vault = vault.DeepCopy()
+	vault.Type = runtime.Type{}
+	data, err := json.Marshal(vault)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute cache key: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,508 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	credentialsv1 "ocm.software/open-component-model/bindings/go/credentials/spec/config/v1"
+	gpgcredentialsv1alpha1 "ocm.software/open-component-model/bindings/go/gpg/spec/credentials/v1alpha1"
+	helmcredentialsv1 "ocm.software/open-component-model/bindings/go/helm/spec/credentials/v1"
+	ocicredentialsv1 "ocm.software/open-component-model/bindings/go/oci/spec/credentials/v1"
+	rsacredentialsv1 "ocm.software/open-component-model/bindings/go/rsa/spec/credentials/v1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/cli/internal/plugin/builtin/credentials/vault/spec/v1alpha1"
+)
+
+// stubVault emulates the parts of the Vault HTTP API used by the repository:
+// AppRole and Kubernetes logins and a KV v2 secrets engine mounted at "secret".
+type stubVault struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	token    string
+	roleID   string
+	secretID string
+	role     string
+	jwt      string
+	// tokenLease is the lease of tokens issued by a login in seconds.
+	tokenLease int
+	// secretLease is the lease reported for secret reads in seconds.
+	secretLease int
+	secrets     map[string]stubSecret
+	logins      int
+	reads       int
+}
+
+type stubSecret struct {
+	data     map[string]any
+	metadata map[string]string
+}
+
+func newStubVault(t *testing.T) *stubVault {
+	t.Helper()
+	v := &stubVault{
+		token:   "root",
+		secrets: map[string]stubSecret{},
+	}
+	v.Server = httptest.NewServer(http.HandlerFunc(v.serveHTTP))
+	t.Cleanup(v.Close)
+	return v
+}
+
+func (v *stubVault) put(path string, consumerIdentity string, data map[string]any) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	metadata := map[string]string{}
+	if consumerIdentity != "" {
+		metadata[CustomMetadataConsumerIdentity] = consumerIdentity
+	}
+	v.secrets[path] = stubSecret{data: data, metadata: metadata}
+}
+
+func (v *stubVault) counts() (logins, reads int) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.logins, v.reads
+}
+
+func (v *stubVault) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	path := strings.TrimPrefix(req.URL.Path, "/v1/")
+	respond := func(status int, body any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+	denied := func() {
+		respond(http.StatusForbidden, map[string]any{"errors": []string{"permission denied"}})
+	}
+
+	if req.Method == http.MethodPost && strings.HasSuffix(path, "/login") {
+		var body map[string]string
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		switch path {
+		case "auth/approle/login":
+			if body["role_id"] != v.roleID || body["secret_id"] != v.secretID {
+				denied()
+				return
+			}
+		case "auth/kubernetes/login":
+			if body["role"] != v.role || body["jwt"] != v.jwt {
+				denied()
+				return
+			}
+		default:
+			respond(http.StatusNotFound, map[string]any{"errors": []string{"no handler for route"}})
+			return
+		}
+		v.logins++
+		v.token = "issued-" + time.Now().Format(time.RFC3339Nano)
+		respond(http.StatusOK, map[string]any{"auth": map[string]any{
+			"client_token": v.token, "lease_duration": v.tokenLease, "renewable": true,
+		}})
+		return
+	}
+
+	if req.Header.Get("X-Vault-Token") != v.token {
+		denied()
+		return
+	}
+
+	switch {
+	case req.Method == "LIST" && strings.HasPrefix(path, "secret/metadata/"):
+		prefix := strings.TrimSuffix(strings.TrimPrefix(path, "secret/metadata/"), "/") + "/"
+		seen := map[string]bool{}
+		var keys []string
+		for p := range v.secrets {
+			if !strings.HasPrefix(p, prefix) {
+				continue
+			}
+			key, _, nested := strings.Cut(strings.TrimPrefix(p, prefix), "/")
+			if nested {
+				key += "/"
+			}
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			respond(http.StatusNotFound, map[string]any{"errors": []string{}})
+			return
+		}
+		respond(http.StatusOK, map[string]any{"data": map[string]any{"keys": keys}})
+	case req.Method == http.MethodGet && strings.HasPrefix(path, "secret/data/"):
+		s, ok := v.secrets[strings.TrimPrefix(path, "secret/data/")]
+		if !ok {
+			respond(http.StatusNotFound, map[string]any{"errors": []string{}})
+			return
+		}
+		v.reads++
+		respond(http.StatusOK, map[string]any{
+			"lease_duration": v.secretLease,
+			"data": map[string]any{
+				"data":     s.data,
+				"metadata": map[string]any{"custom_metadata": s.metadata},
+			},
+		})
+	default:
+		respond(http.StatusNotFound, map[string]any{"errors": []string{"unsupported route"}})
+	}
+}
+
+func vaultConfig(t *testing.T, serverURL string, extra string) runtime.Typed {
+	t.Helper()
+	raw := &runtime.Raw{}
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"HashiCorpVault/v1alpha1","serverURL":"`+serverURL+`","path":"ocm"`+extra+`}`), raw))
+	return raw
+}
+
+func loginCredentials(properties map[string]string) runtime.Typed {
+	return &credentialsv1.DirectCredentials{
+		Type:       runtime.NewVersionedType(credentialsv1.CredentialsType, credentialsv1.Version),
+		Properties: properties,
+	}
+}
+
+func Test_Resolve(t *testing.T) {
+	vault := newStubVault(t)
+	vault.put("ocm/ghcr", "type=OCIRegistry,hostname=ghcr.io", map[string]any{"username": "ghcr-user", "password": "ghcr-pass"})
+	vault.put("ocm/signing", "type=RSA/v1alpha1,signature=default", map[string]any{"private_key_pem": "KEY"})
+	vault.put("ocm/helm", "type=HelmChartRepository,hostname=charts.example.com", map[string]any{"username": "helm", "password": "helm-pass"})
+	vault.put("ocm/gpg", "type=GPG/v1alpha1,signature=release", map[string]any{"privateKeyPGP": "PGP", "passphrase": "secret"})
+	vault.put("ocm/maven", "type=MavenRepository,hostname=repo.example.com", map[string]any{"username": "maven", "retries": 3})
+	vault.put("ocm/unrelated", "", map[string]any{"foo": "bar"})
+	vault.put("ocm/nested/ignored", "type=OCIRegistry,hostname=ghcr.io", map[string]any{"username": "nested"})
+
+	repo := NewCredentialRepository()
+	cfg := vaultConfig(t, vault.URL, "")
+	token := loginCredentials(map[string]string{CredentialKeyToken: "root"})
+
+	t.Run("oci registry", func(t *testing.T) {
+		r := require.New(t)
+		creds, err := repo.Resolve(t.Context(), cfg, runtime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"}, token)
+		r.NoError(err)
+		oci, ok := creds.(*ocicredentialsv1.OCICredentials)
+		r.True(ok, "expected OCI credentials, got %T", creds)
+		r.Equal("ghcr-user", oci.Username)
+		r.Equal("ghcr-pass", oci.Password)
+	})
+
+	t.Run("typed credentials", func(t *testing.T) {
+		r := require.New(t)
+		creds, err := repo.Resolve(t.Context(), cfg, runtime.Identity{"type": "RSA/v1alpha1", "signature": "default"}, token)
+		r.NoError(err)
+		rsa, ok := creds.(*rsacredentialsv1.RSACredentials)
+		r.True(ok, "expected RSA credentials, got %T", creds)
+		r.Equal("KEY", rsa.PrivateKeyPEM)
+
+		creds, err = repo.Resolve(t.Context(), cfg, runtime.Identity{"type": "HelmChartRepository", "hostname": "charts.example.com"}, token)
+		r.NoError(err)
+		helm, ok := creds.(*helmcredentialsv1.HelmHTTPCredentials)
+		r.True(ok, "expected helm credentials, got %T", creds)
+		r.Equal("helm", helm.Username)
+		r.Equal("helm-pass", helm.Password)
+
+		creds, err = repo.Resolve(t.Context(), cfg, runtime.Identity{"type": "GPG/v1alpha1", "signature": "release"}, token)
+		r.NoError(err)
+		gpg, ok := creds.(*gpgcredentialsv1alpha1.GPGCredentials)
+		r.True(ok, "expected GPG credentials, got %T", creds)
+		r.Equal("PGP", gpg.PrivateKeyPGP)
+		r.Equal("secret", gpg.Passphrase)
+	})
+
+	t.Run("other consumers and non-string values", func(t *testing.T) {
+		r := require.New(t)
+		creds, err := repo.Resolve(t.Context(), cfg, runtime.Identity{"type": "MavenRepository", "hostname": "repo.example.com"}, token)
+		r.NoError(err)
+		direct, ok := creds.(*credentialsv1.DirectCredentials)
+		r.True(ok, "expected direct credentials, got %T", creds)
+		r.Equal(map[string]string{"username": "maven", "retries": "3"}, direct.Properties)
+	})
+
+	t.Run("unknown consumer", func(t *testing.T) {
+		r := require.New(t)
+		creds, err := repo.Resolve(t.Context(), cfg, runtime.Identity{"type": "OCIRegistry", "hostname": "quay.io"}, token)
+		r.NoError(err)
+		r.Nil(creds)
+	})
+
+	t.Run("secrets are read once", func(t *testing.T) {
+		_, reads := vault.counts()
+		require.Equal(t, 6, reads)
+	})
+
+	t.Run("explicit secrets", func(t *testing.T) {
+		r := require.New(t)
+		creds, err := NewCredentialRepository().Resolve(t.Context(), vaultConfig(t, vault.URL, `,"secrets":["nested/ignored"]`),
+			runtime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"}, token)
+		r.NoError(err)
+		r.Equal("nested", creds.(*ocicredentialsv1.OCICredentials).Username)
+	})
+
+	t.Run("token from file and environment", func(t *testing.T) {
+		r := require.New(t)
+		identity := runtime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"}
+
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		r.NoError(os.WriteFile(tokenFile, []byte("root\n"), 0o600))
+		creds, err := NewCredentialRepository().Resolve(t.Context(), vaultConfig(t, vault.URL, `,"auth":{"tokenFile":"`+tokenFile+`"}`), identity, nil)
+		r.NoError(err)
+		r.NotNil(creds)
+
+		t.Setenv(EnvToken, "root")
+		creds, err = NewCredentialRepository().Resolve(t.Context(), cfg, identity, nil)
+		r.NoError(err)
+		r.NotNil(creds)
+
+		t.Setenv(EnvToken, "wrong")
+		_, err = NewCredentialRepository().Resolve(t.Context(), cfg, identity, nil)
+		r.ErrorContains(err, "permission denied")
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		t.Setenv(EnvToken, "")
+		_, err := NewCredentialRepository().Resolve(t.Context(), cfg, runtime.Identity{"type": "OCIRegistry"}, nil)
+		require.ErrorContains(t, err, "no vault token configured")
+	})
+}
+
+func Test_Resolve_LeaseAwareCache(t *testing.T) {
+	r := require.New(t)
+	vault := newStubVault(t)
+	vault.roleID, vault.secretID = "role", "secret"
+	vault.tokenLease = 100
+	vault.put("ocm/ghcr", "type=OCIRegistry,hostname=ghcr.io", map[string]any{"username": "user"})
+
+	now := time.Now()
+	repo := NewCredentialRepository()
+	repo.now = func() time.Time { return now }
+
+	cfg := vaultConfig(t, vault.URL, `,"cacheTTL":"1m","auth":{"method":"approle"}`)
+	login := loginCredentials(map[string]string{CredentialKeyRoleID: "role", CredentialKeySecretID: "secret"})
+	identity := runtime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"}
+	resolve := func() {
+		t.Helper()
+		creds, err := repo.Resolve(t.Context(), cfg, identity, login)
+		r.NoError(err)
+		r.NotNil(creds)
+	}
+	expect := func(logins, reads int) {
+		t.Helper()
+		l, rd := vault.counts()
+		r.Equal(logins, l, "logins")
+		r.Equal(reads, rd, "reads")
+	}
+
+	resolve()
+	expect(1, 1)
+
+	// within the cache TTL secrets are served from cache.
+	now = now.Add(30 * time.Second)
+	resolve()
+	expect(1, 1)
+
+	// after the cache TTL secrets are read again with the cached token.
+	now = now.Add(31 * time.Second)
+	resolve()
+	expect(1, 2)
+
+	// the token is renewed before its lease of 100s expires.
+	now = now.Add(61 * time.Second)
+	resolve()
+	expect(2, 3)
+
+	// a secret lease shorter than the cache TTL bounds the cache.
+	vault.mu.Lock()
+	vault.secretLease = 10
+	vault.mu.Unlock()
+	now = now.Add(61 * time.Second)
+	resolve()
+	expect(2, 4)
+	now = now.Add(11 * time.Second)
+	resolve()
+	expect(2, 5)
+
+	// a revoked token results in a new login.
+	vault.mu.Lock()
+	vault.token = "revoked"
+	vault.mu.Unlock()
+	now = now.Add(11 * time.Second)
+	resolve()
+	expect(3, 6)
+
+	t.Run("wrong secret id", func(t *testing.T) {
+		_, err := NewCredentialRepository().Resolve(t.Context(), cfg, identity,
+			loginCredentials(map[string]string{CredentialKeyRoleID: "role", CredentialKeySecretID: "wrong"}))
+		require.ErrorContains(t, err, "vault login with auth method at \"approle\" failed")
+	})
+}
+
+func Test_Resolve_Concurrent(t *testing.T) {
+	r := require.New(t)
+	vault := newStubVault(t)
+	vault.roleID, vault.secretID = "role", "secret"
+	vault.tokenLease = 100
+	vault.put("ocm/ghcr", "type=OCIRegistry,hostname=ghcr.io", map[string]any{"username": "user"})
+
+	// a server that does not answer logins until released.
+	release, arrived := make(chan struct{}), make(chan struct{}, 1)
+	blocked := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		http.Error(w, `{"errors":["released"]}`, http.StatusForbidden)
+	}))
+	t.Cleanup(blocked.Close)
+	t.Cleanup(func() {
+		select {
+		case <-release:
+		default:
+			close(release)
+		}
+	})
+
+	repo := NewCredentialRepository()
+	login := loginCredentials(map[string]string{CredentialKeyRoleID: "role", CredentialKeySecretID: "secret"})
+	identity := runtime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"}
+
+	blockedDone := make(chan error, 1)
+	go func() {
+		_, err := repo.Resolve(t.Context(), vaultConfig(t, blocked.URL, `,"auth":{"method":"approle"}`), identity, login)
+		blockedDone <- err
+	}()
+	<-arrived
+
+	// resolutions of another server are not held up by the pending login and share a single login and read.
+	cfg := vaultConfig(t, vault.URL, `,"auth":{"method":"approle"}`)
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			creds, err := repo.Resolve(t.Context(), cfg, identity, login)
+			if err == nil && creds == nil {
+				err = errors.New("no credentials resolved")
+			}
+			errs <- err
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("resolution blocked by a pending login to another server")
+	}
+	close(errs)
+	for err := range errs {
+		r.NoError(err)
+	}
+	logins, reads := vault.counts()
+	r.Equal(1, logins, "logins")
+	r.Equal(1, reads, "reads")
+
+	close(release)
+	r.Error(<-blockedDone)
+}
+
+func Test_Resolve_CancelledCaller(t *testing.T) {
+	r := require.New(t)
+	vault := newStubVault(t)
+	vault.roleID, vault.secretID = "role", "secret"
+	vault.tokenLease = 100
+	vault.put("ocm/ghcr", "type=OCIRegistry,hostname=ghcr.io", map[string]any{"username": "user"})
+
+	// hold the first login until released.
+	release, arrived := make(chan struct{}), make(chan struct{}, 1)
+	var once sync.Once
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if strings.HasSuffix(req.URL.Path, "/login") {
+			once.Do(func() {
+				arrived <- struct{}{}
+				<-release
+			})
+		}
+		vault.serveHTTP(w, req)
+	}))
+	t.Cleanup(proxy.Close)
+
+	repo := NewCredentialRepository()
+	cfg := vaultConfig(t, proxy.URL, `,"auth":{"method":"approle"}`)
+	login := loginCredentials(map[string]string{CredentialKeyRoleID: "role", CredentialKeySecretID: "secret"})
+	identity := runtime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	first := make(chan error, 1)
+	go func() {
+		_, err := repo.Resolve(ctx, cfg, identity, login)
+		first <- err
+	}()
+	<-arrived
+
+	second := make(chan error, 1)
+	go func() {
+		creds, err := repo.Resolve(t.Context(), cfg, identity, login)
+		if err == nil && creds == nil {
+			err = errors.New("no credentials resolved")
+		}
+		second <- err
+	}()
+
+	// the cancelled resolution stops waiting, but the shared login and read go on for the other one.
+	cancel()
+	r.ErrorIs(<-first, context.Canceled)
+	close(release)
+	r.NoError(<-second)
+
+	logins, reads := vault.counts()
+	r.Equal(1, logins, "logins")
+	r.Equal(1, reads, "reads")
+}
+
+func Test_Resolve_Kubernetes(t *testing.T) {
+	r := require.New(t)
+	vault := newStubVault(t)
+	vault.role, vault.jwt = "ocm", "service-account-jwt"
+	vault.put("ocm/ghcr", "type=OCIRegistry,hostname=ghcr.io", map[string]any{"username": "user"})
+
+	saToken := filepath.Join(t.TempDir(), "token")
+	r.NoError(os.WriteFile(saToken, []byte("service-account-jwt"), 0o600))
+
+	cfg := vaultConfig(t, vault.URL, `,"auth":{"method":"kubernetes","role":"ocm","serviceAccountTokenFile":"`+saToken+`"}`)
+	creds, err := NewCredentialRepository().Resolve(t.Context(), cfg, runtime.Identity{"type": "OCIRegistry", "hostname": "ghcr.io"}, nil)
+	r.NoError(err)
+	r.Equal("user", creds.(*ocicredentialsv1.OCICredentials).Username)
+}
+
+func Test_ConsumerIdentityForConfig(t *testing.T) {
+	r := require.New(t)
+	repo := NewCredentialRepository()
+
+	identity, err := repo.ConsumerIdentityForConfig(t.Context(), vaultConfig(t, "https://vault.example.com:8200", ""))
+	r.NoError(err)
+	r.Equal(v1alpha1.HashiCorpVaultVersionedType.String(), identity[runtime.IdentityAttributeType])
+	r.Equal("vault.example.com", identity[runtime.IdentityAttributeHostname])
+	r.Equal("8200", identity[runtime.IdentityAttributePort])
+
+	_, err = repo.ConsumerIdentityForConfig(t.Context(), vaultConfig(t, "https://vault.example.com", `,"auth":{"method":"kubernetes"}`))
+	r.ErrorContains(err, "role is required")
+	_, err = repo.ConsumerIdentityForConfig(t.Context(), vaultConfig(t, "https://vault.example.com", `,"auth":{"method":"ldap"}`))
+	r.ErrorContains(err, "unsupported auth method")
+}
@@ -0,0 +1,156 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	// HashiCorpVaultType is the type of the HashiCorp Vault credential repository.
+	// It is also the type of the consumer identity used to look up credentials for logging in to Vault.
+	HashiCorpVaultType = "HashiCorpVault"
+
+	// AuthMethodToken authenticates with a Vault token.
+	AuthMethodToken = "token"
+	// AuthMethodAppRole authenticates with a role ID and secret ID.
+	AuthMethodAppRole = "approle"
+	// AuthMethodKubernetes authenticates with a Kubernetes service account token.
+	AuthMethodKubernetes = "kubernetes"
+
+	// DefaultMountPath is the mount path of the KV v2 secrets engine in a Vault dev server.
+	DefaultMountPath = "secret"
+	// DefaultServiceAccountTokenFile is the location of the service account token in a Kubernetes pod.
+	DefaultServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// DefaultCacheTTL is used for secrets that are read without a lease.
+	DefaultCacheTTL = 5 * time.Minute
+)
+
+// HashiCorpVaultVersionedType is the versioned type of the HashiCorp Vault credential repository.
+var HashiCorpVaultVersionedType = runtime.NewVersionedType(HashiCorpVaultType, Version)
+
+// Scheme contains the HashiCorp Vault credential repository type.
+var Scheme = runtime.NewScheme()
+
+func init() {
+	Scheme.MustRegisterWithAlias(&HashiCorpVault{}, HashiCorpVaultVersionedType, runtime.NewUnversionedType(HashiCorpVaultType))
+}
+
+// HashiCorpVault is a credential repository backed by a KV v2 secrets engine of HashiCorp Vault.
+//
+// Every secret below Path that carries the custom metadata key "consumerIdentity" serves the consumer
+// identity given as its value (for example "type=OCIRegistry,hostname=ghcr.io").
+// The key-value pairs of the secret are returned as credential properties.
+//
+// The configuration never contains secrets itself. The credentials used to log in to Vault
+// are resolved from the credential graph for the consumer identity of type HashiCorpVault/v1alpha1
+// with the hostname of the server, from the referenced files or from the environment.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type HashiCorpVault struct {
+	// +ocm:jsonschema-gen:enum=HashiCorpVault/v1alpha1
+	// +ocm:jsonschema-gen:enum:deprecated=HashiCorpVault
+	Type runtime.Type `json:"type"`
+	// ServerURL is the address of the Vault server, for example https://vault.example.com:8200.
+	ServerURL string `json:"serverURL"`
+	// Namespace is the Vault Enterprise namespace of the secrets engine and auth method.
+	Namespace string `json:"namespace,omitempty"`
+	// MountPath is the mount path of the KV v2 secrets engine. Defaults to "secret".
+	MountPath string `json:"mountPath,omitempty"`
+	// Path is the path below the mount that contains the secrets.
+	Path string `json:"path,omitempty"`
+	// Secrets restricts the lookup to the named secrets below Path.
+	// If not set, all secrets directly below Path are considered.
+	Secrets []string `json:"secrets,omitempty"`
+	// Auth configures how to log in to Vault. Defaults to the token method.
+	Auth *Auth `json:"auth,omitempty"`
+	// CacheTTL is how long secrets are cached, for example "10m".
+	// Secrets with a shorter lease are cached until their lease expires. Defaults to 5m.
+	CacheTTL string `json:"cacheTTL,omitempty"`
+}
+
+// Auth configures the login to Vault.
+//
+// +k8s:deepcopy-gen=true
+// +ocm:jsonschema-gen=true
+type Auth struct {
+	// Method is the auth method, one of token, approle or kubernetes.
+	// +ocm:jsonschema-gen:enum=token,approle,kubernetes
+	Method string `json:"method,omitempty"`
+	// MountPath is the mount path of the auth method. Defaults to the method name.
+	MountPath string `json:"mountPath,omitempty"`
+	// TokenFile is a file containing the Vault token for the token method.
+	// If not set, the token is taken from the credential graph or $VAULT_TOKEN.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// RoleID is the role ID for the approle method.
+	// If not set, it is taken from the credential graph or $VAULT_ROLE_ID.
+	RoleID string `json:"roleID,omitempty"`
+	// SecretIDFile is a file containing the secret ID for the approle method.
+	// If not set, it is taken from the credential graph or $VAULT_SECRET_ID.
+	SecretIDFile string `json:"secretIDFile,omitempty"`
+	// Role is the Vault role for the kubernetes method.
+	Role string `json:"role,omitempty"`
+	// ServiceAccountTokenFile is the service account token for the kubernetes method.
+	// Defaults to the token mounted into the pod.
+	ServiceAccountTokenFile string `json:"serviceAccountTokenFile,omitempty"`
+}
+
+// GetMountPath returns the mount path of the secrets engine.
+func (v *HashiCorpVault) GetMountPath() string {
+	if v.MountPath == "" {
+		return DefaultMountPath
+	}
+	return v.MountPath
+}
+
+// GetCacheTTL returns how long secrets without a lease are cached.
+func (v *HashiCorpVault) GetCacheTTL() (time.Duration, error) {
+	if v.CacheTTL == "" {
+		return DefaultCacheTTL, nil
+	}
+	ttl, err := time.ParseDuration(v.CacheTTL)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cache TTL %q: %w", v.CacheTTL, err)
+	}
+	return ttl, nil
+}
+
+// GetMethod returns the auth method.
+func (a *Auth) GetMethod() string {
+	if a == nil || a.Method == "" {
+		return AuthMethodToken
+	}
+	return a.Method
+}
+
+// GetMountPath returns the mount path of the auth method.
+func (a *Auth) GetMountPath() string {
+	if a == nil || a.MountPath == "" {
+		return a.GetMethod()
+	}
+	return a.MountPath
+}
+
+// Validate checks that the repository can be used.
+func (v *HashiCorpVault) Validate() error {
+	if v.ServerURL == "" {
+		return fmt.Errorf("serverURL is required")
+	}
+	if _, err := v.GetCacheTTL(); err != nil {
+		return err
+	}
+	switch method := v.Auth.GetMethod(); method {
+	case AuthMethodToken, AuthMethodAppRole:
+	case AuthMethodKubernetes:
+		if v.Auth.Role == "" {
+			return fmt.Errorf("role is required for the %s auth method", method)
+		}
+	default:
+		return fmt.Errorf("unsupported auth method %q, expected one of %s, %s or %s", method, AuthMethodToken, AuthMethodAppRole, AuthMethodKubernetes)
+	}
+	return nil
+}
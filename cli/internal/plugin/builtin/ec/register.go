@@ -0,0 +1,36 @@
+package ec
+
+import (
+	"errors"
+
+	filesystemv1alpha1 "ocm.software/open-component-model/bindings/go/configuration/filesystem/v1alpha1/spec"
+	ecdsahandler "ocm.software/open-component-model/bindings/go/ec/signing/ecdsa/handler"
+	ed25519handler "ocm.software/open-component-model/bindings/go/ec/signing/ed25519/handler"
+	eccredentials "ocm.software/open-component-model/bindings/go/ec/spec/credentials"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/credentialrepository"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/signinghandler"
+	"ocm.software/open-component-model/cli/internal/plugin/builtin/pkcs11"
+)
+
+// Register registers the ECDSA and Ed25519 signing handlers and their credential type.
+func Register(
+	signingHandlerRegistry *signinghandler.SigningRegistry,
+	repositoryRegistry *credentialrepository.RepositoryRegistry,
+	_ *filesystemv1alpha1.Config,
+) error {
+	ecdsaHandler, err := ecdsahandler.New(true, ecdsahandler.WithSignerProvider(pkcs11.SignerProvider))
+	if err != nil {
+		return err
+	}
+	ed25519Handler, err := ed25519handler.New(true, ed25519handler.WithSignerProvider(pkcs11.SignerProvider))
+	if err != nil {
+		return err
+	}
+
+	repositoryRegistry.Register(eccredentials.Scheme)
+
+	return errors.Join(
+		signingHandlerRegistry.RegisterInternalComponentSignatureHandler(ecdsaHandler),
+		signingHandlerRegistry.RegisterInternalComponentSignatureHandler(ed25519Handler),
+	)
+}
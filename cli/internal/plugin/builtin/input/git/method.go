@@ -0,0 +1,31 @@
+package git
+
+import (
+	"fmt"
+
+	filesystemv1alpha1 "ocm.software/open-component-model/bindings/go/configuration/filesystem/v1alpha1/spec"
+	gitinput "ocm.software/open-component-model/bindings/go/git/input"
+	gitcredentials "ocm.software/open-component-model/bindings/go/git/spec/credentials"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/credentialrepository"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/input"
+)
+
+func Register(inputRegistry *input.RepositoryRegistry,
+	repositoryRegistry *credentialrepository.RepositoryRegistry,
+	filesystemConfig *filesystemv1alpha1.Config,
+) error {
+	method := &gitinput.InputMethod{
+		WorkingDirectory: filesystemConfig.WorkingDirectory,
+		TempFolder:       filesystemConfig.TempFolder,
+	}
+
+	repositoryRegistry.Register(gitcredentials.Scheme)
+
+	if err := inputRegistry.RegisterInternalResourceInputPlugin(method); err != nil {
+		return fmt.Errorf("could not register git resource input method: %w", err)
+	}
+	if err := inputRegistry.RegisterInternalSourceInputPlugin(method); err != nil {
+		return fmt.Errorf("could not register git source input method: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	filesystemv1alpha1 "ocm.software/open-component-model/bindings/go/configuration/filesystem/v1alpha1/spec"
+	gitv1 "ocm.software/open-component-model/bindings/go/git/spec/input/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/credentialrepository"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/input"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func TestRegister(t *testing.T) {
+	ctx := t.Context()
+	registry := input.NewInputRepositoryRegistry(ctx)
+	credentialsRegistry := credentialrepository.NewCredentialRepositoryRegistry(ctx)
+	cfg := &filesystemv1alpha1.Config{
+		TempFolder: t.TempDir(),
+	}
+
+	require.NoError(t, Register(registry, credentialsRegistry, cfg))
+
+	gitSpec := &gitv1.Git{
+		Type:       runtime.NewVersionedType(gitv1.Type, gitv1.Version),
+		Repository: "https://github.com/open-component-model/open-component-model.git",
+	}
+	resourcePlugin, err := registry.GetResourceInputPlugin(ctx, gitSpec)
+	require.NoError(t, err)
+	require.NotNil(t, resourcePlugin)
+
+	sourcePlugin, err := registry.GetSourceInputPlugin(ctx, gitSpec)
+	require.NoError(t, err)
+	require.NotNil(t, sourcePlugin)
+}
@@ -0,0 +1,25 @@
+package ociimage
+
+import (
+	"fmt"
+
+	filesystemv1alpha1 "ocm.software/open-component-model/bindings/go/configuration/filesystem/v1alpha1/spec"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/input/ociimage"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/input"
+)
+
+func Register(inputRegistry *input.RepositoryRegistry, filesystemConfig *filesystemv1alpha1.Config, httpConfig *httpv1alpha1.Config) error {
+	method := &ociimage.InputMethod{
+		WorkingDirectory: filesystemConfig.WorkingDirectory,
+		TempFolder:       filesystemConfig.TempFolder,
+		HTTPConfig:       httpConfig,
+	}
+	if err := inputRegistry.RegisterInternalResourceInputPlugin(method); err != nil {
+		return fmt.Errorf("could not register oci image resource input method: %w", err)
+	}
+	if err := inputRegistry.RegisterInternalSourceInputPlugin(method); err != nil {
+		return fmt.Errorf("could not register oci image source input method: %w", err)
+	}
+	return nil
+}
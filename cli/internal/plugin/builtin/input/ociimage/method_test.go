@@ -0,0 +1,43 @@
+package ociimage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	filesystemv1alpha1 "ocm.software/open-component-model/bindings/go/configuration/filesystem/v1alpha1/spec"
+	httpv1alpha1 "ocm.software/open-component-model/bindings/go/http/spec/config/v1alpha1"
+	ociimagev1 "ocm.software/open-component-model/bindings/go/input/ociimage/spec/v1"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/input"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+func TestRegister(t *testing.T) {
+	ctx := t.Context()
+	registry := input.NewInputRepositoryRegistry(ctx)
+	cfg := &filesystemv1alpha1.Config{
+		TempFolder: t.TempDir(),
+	}
+
+	require.NoError(t, Register(registry, cfg, &httpv1alpha1.Config{}))
+
+	for _, typ := range []runtime.Type{
+		runtime.NewVersionedType(ociimagev1.Type, ociimagev1.Version),
+		runtime.NewVersionedType(ociimagev1.LegacyType, ociimagev1.Version),
+	} {
+		t.Run(typ.String(), func(t *testing.T) {
+			spec := &ociimagev1.OCIImage{
+				Type: typ,
+				Path: "ghcr.io/open-component-model/ocm:1.0.0",
+			}
+
+			resourcePlugin, err := registry.GetResourceInputPlugin(ctx, spec)
+			require.NoError(t, err)
+			require.NotNil(t, resourcePlugin)
+
+			sourcePlugin, err := registry.GetSourceInputPlugin(ctx, spec)
+			require.NoError(t, err)
+			require.NotNil(t, sourcePlugin)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Package pkcs11 provides the PKCS#11 signer provider of the builtin RSA and EC signing handlers.
+package pkcs11
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	pkcs11signer "ocm.software/open-component-model/bindings/go/pkcs11/signer"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+// SignerProvider opens a signer for PKCS#11 credentials with pkcs11signer.FromCredentials.
+// Released ocm binaries are built without cgo and cannot use PKCS#11, so the error of such
+// builds tells users how to get a binary that can.
+func SignerProvider(ctx context.Context, creds runtime.Typed) (crypto.Signer, []*x509.Certificate, func() error, error) {
+	signer, chain, closeFn, err := pkcs11signer.FromCredentials(ctx, creds)
+	if errors.Is(err, pkcs11signer.ErrUnsupported) {
+		return nil, nil, nil, fmt.Errorf("cannot sign with PKCS11Credentials/v1: %w. "+
+			"The released ocm binaries are built without cgo and do not support PKCS#11, "+
+			"build ocm with cgo on Linux or macOS (task build CGO_ENABLED=1 in the cli directory) to sign with keys in an HSM", err)
+	}
+	return signer, chain, closeFn, err
+}
@@ -0,0 +1,26 @@
+//go:build !cgo || !unix
+
+package pkcs11_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pkcs11signer "ocm.software/open-component-model/bindings/go/pkcs11/signer"
+	pkcs11credentialsv1 "ocm.software/open-component-model/bindings/go/pkcs11/spec/credentials/v1"
+	"ocm.software/open-component-model/cli/internal/plugin/builtin/pkcs11"
+)
+
+func Test_SignerProvider_Unsupported(t *testing.T) {
+	creds := &pkcs11credentialsv1.PKCS11Credentials{
+		Type:       pkcs11credentialsv1.VersionedType,
+		Module:     "/usr/lib/softhsm/libsofthsm2.so",
+		TokenLabel: "token",
+		KeyLabel:   "key",
+	}
+	_, _, _, err := pkcs11.SignerProvider(t.Context(), creds)
+	require.ErrorIs(t, err, pkcs11signer.ErrUnsupported)
+	require.ErrorContains(t, err, "released ocm binaries are built without cgo")
+	require.ErrorContains(t, err, "task build CGO_ENABLED=1")
+}
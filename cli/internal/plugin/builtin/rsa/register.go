@@ -10,6 +10,7 @@ import (
 	"ocm.software/open-component-model/bindings/go/rsa/signing/v1alpha1"
 	rsacredentials "ocm.software/open-component-model/bindings/go/rsa/spec/credentials"
 	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/cli/internal/plugin/builtin/pkcs11"
 )
 
 func Register(
@@ -23,7 +24,7 @@ func Register(
 		return err
 	}
 
-	hdlr, err := handler.New(scheme, true)
+	hdlr, err := handler.New(scheme, true, handler.WithSignerProvider(pkcs11.SignerProvider))
 	if err != nil {
 		return err
 	}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	generic "ocm.software/open-component-model/bindings/go/configuration/generic/v1/spec"
@@ -35,6 +37,75 @@ type Config struct {
 	// Locations is a list of locations where the plugin manager will look for plugins.
 	// This can be a list of directories.
 	Locations []string `json:"locations"`
+	// Instances is the maximum number of processes started per plugin. Additional processes
+	// are only started if all running processes of a plugin are busy. Defaults to 1.
+	Instances int `json:"instances,omitempty"`
+	// HealthCheckInterval is the interval in which running plugins are checked for liveness.
+	// Plugins failing the check are restarted on the next call. Defaults to 30s.
+	HealthCheckInterval Duration `json:"healthCheckInterval,omitempty"`
+	// Limits are the resource limits applied to every plugin process. Limits are only supported on Linux.
+	Limits *ResourceLimits `json:"limits,omitempty"`
+	// WASM configures the sandbox of plugins compiled to WebAssembly.
+	WASM *WASMConfig `json:"wasm,omitempty"`
+}
+
+// ResourceLimits cap the resources of plugin processes.
+//
+// +k8s:deepcopy-gen=true
+type ResourceLimits struct {
+	// MemoryBytes caps the memory of a plugin process. It is applied to the cgroup
+	// if cgroupParent is set, otherwise as the address space limit of the process.
+	MemoryBytes int64 `json:"memoryBytes,omitempty"`
+	// CPUTime caps the CPU time a plugin process may consume before it is terminated.
+	CPUTime Duration `json:"cpuTime,omitempty"`
+	// CPUQuota caps the CPU usage of a plugin process in CPUs, e.g. 0.5 for half a CPU. Requires cgroupParent.
+	CPUQuota float64 `json:"cpuQuota,omitempty"`
+	// CgroupParent is a delegated cgroup v2 directory every plugin process gets its own child cgroup in.
+	CgroupParent string `json:"cgroupParent,omitempty"`
+}
+
+// WASMConfig configures the sandbox of plugins compiled to WebAssembly.
+//
+// +k8s:deepcopy-gen=true
+type WASMConfig struct {
+	// MemoryBytes caps the memory of every instance of a plugin. Defaults to 4GiB.
+	MemoryBytes int64 `json:"memoryBytes,omitempty"`
+	// Permissions grant plugins access to the host by plugin ID, the file name of the plugin without
+	// the .wasm extension. Without permissions, a plugin can only access its private temporary directory
+	// used to exchange blobs with the CLI.
+	Permissions map[string]WASMPermissions `json:"permissions,omitempty"`
+}
+
+// WASMPermissions are the capabilities granted to a plugin compiled to WebAssembly.
+//
+// +k8s:deepcopy-gen=true
+type WASMPermissions struct {
+	// Mounts are the host directories accessible by the plugin.
+	Mounts []Mount `json:"mounts,omitempty"`
+	// AllowedHosts are the hosts the plugin may send HTTP requests to, e.g. ghcr.io or *.github.com.
+	AllowedHosts []string `json:"allowedHosts,omitempty"`
+}
+
+// Mount makes a host directory accessible to a plugin compiled to WebAssembly.
+//
+// +k8s:deepcopy-gen=true
+type Mount struct {
+	// HostPath is the directory on the host.
+	HostPath string `json:"hostPath"`
+	// GuestPath is the path of the directory in the plugin. Defaults to hostPath.
+	GuestPath string `json:"guestPath,omitempty"`
+	// ReadOnly prevents the plugin from modifying the directory.
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// DefaultWASMCompilationCacheDir returns the directory plugins compiled to WebAssembly are cached in across
+// invocations, as compiling them is expensive. It is empty if the user has no cache directory.
+func DefaultWASMCompilationCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "ocm", "plugins", "wasm")
 }
 
 type Duration time.Duration
@@ -110,6 +181,18 @@ func Merge(configs ...*Config) *Config {
 			merged.IdleTimeout = config.IdleTimeout
 		}
 		merged.Locations = append(merged.Locations, config.Locations...)
+		if config.Instances > merged.Instances {
+			merged.Instances = config.Instances
+		}
+		if config.HealthCheckInterval != 0 {
+			merged.HealthCheckInterval = config.HealthCheckInterval
+		}
+		if config.Limits != nil {
+			merged.Limits = config.Limits
+		}
+		if config.WASM != nil {
+			merged.WASM = config.WASM
+		}
 	}
 
 	return merged
@@ -0,0 +1,81 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	generic "ocm.software/open-component-model/bindings/go/configuration/generic/v1/spec"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	// ConfigType defines the type identifier for plugin registry configurations.
+	ConfigType = "plugin.registry.config.ocm.software"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	scheme.MustRegisterWithAlias(&Config{},
+		runtime.NewVersionedType(ConfigType, Version),
+		runtime.NewUnversionedType(ConfigType),
+	)
+}
+
+// Config configures the plugin registries plugins are installed from.
+//
+// Example config:
+//
+//	type: generic.config.ocm.software/v1
+//	configurations:
+//	- type: plugin.registry.config.ocm.software/v1alpha1
+//	  registries:
+//	  - ghcr.io/open-component-model//ocm.software/plugin-registry
+//	  policy: /etc/ocm/plugin-policy.yaml
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type Config struct {
+	Type runtime.Type `json:"type"`
+	// Registries are component references of plugin registries, for example
+	// ghcr.io/open-component-model//ocm.software/plugin-registry:1.0.0.
+	// A registry without a version resolves to its latest version.
+	Registries []string `json:"registries"`
+	// Policy is the path to a VerificationPolicy/v1alpha1 file.
+	// Plugin component versions must satisfy the policy before they are installed.
+	Policy string `json:"policy,omitempty"`
+}
+
+// LookupConfig creates a new plugin registry configuration from a central V1 config.
+// Registries of all matching configurations are merged in order, the last policy wins.
+func LookupConfig(cfg *generic.Config) (*Config, error) {
+	merged := &Config{}
+	_, _ = scheme.DefaultType(merged)
+	if cfg == nil {
+		return merged, nil
+	}
+
+	filtered, err := generic.Filter(cfg, &generic.FilterOptions{
+		ConfigTypes: []runtime.Type{
+			runtime.NewVersionedType(ConfigType, Version),
+			runtime.NewUnversionedType(ConfigType),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter plugin registry config: %w", err)
+	}
+
+	for _, entry := range filtered.Configurations {
+		var config Config
+		if err := scheme.Convert(entry, &config); err != nil {
+			return nil, fmt.Errorf("failed to decode plugin registry config: %w", err)
+		}
+		merged.Registries = append(merged.Registries, config.Registries...)
+		if config.Policy != "" {
+			merged.Policy = config.Policy
+		}
+	}
+
+	return merged, nil
+}
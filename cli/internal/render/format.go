@@ -10,6 +10,8 @@ const (
 	OutputFormatNDJSON
 	OutputFormatTree
 	OutputFormatTable
+	OutputFormatDOT
+	OutputFormatMermaid
 )
 
 func (o OutputFormat) String() string {
@@ -24,6 +26,10 @@ func (o OutputFormat) String() string {
 		return "tree"
 	case OutputFormatTable:
 		return "table"
+	case OutputFormatDOT:
+		return "dot"
+	case OutputFormatMermaid:
+		return "mermaid"
 	default:
 		return fmt.Sprintf("unknown(%d)", o)
 	}
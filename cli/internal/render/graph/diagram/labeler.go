@@ -0,0 +1,47 @@
+package diagram
+
+import (
+	"cmp"
+	"fmt"
+
+	"ocm.software/open-component-model/bindings/go/dag"
+	syncdag "ocm.software/open-component-model/bindings/go/dag/sync"
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/transform/graph"
+)
+
+// VertexLabeler returns the label of a vertex. Line breaks in the label are kept in the diagram.
+// It MUST perform READ-ONLY access to the vertex and its attributes.
+type VertexLabeler[T cmp.Ordered] interface {
+	Label(*dag.Vertex[T]) (string, error)
+}
+
+type VertexLabelerFunc[T cmp.Ordered] func(*dag.Vertex[T]) (string, error)
+
+func (f VertexLabelerFunc[T]) Label(v *dag.Vertex[T]) (string, error) {
+	return f(v)
+}
+
+// defaultVertexLabeler labels component versions with their name and version and
+// falls back to the vertex ID for all other vertices.
+func defaultVertexLabeler[T cmp.Ordered](vertex *dag.Vertex[T]) (string, error) {
+	if component, ok := vertex.Attributes[syncdag.AttributeValue].(*descruntime.Descriptor); ok {
+		return fmt.Sprintf("%s\n%s", component.Component.Name, component.Component.Version), nil
+	}
+	return fmt.Sprint(vertex.ID), nil
+}
+
+// TransformationVertexLabeler labels the vertices of a checked transformation graph with
+// the ID and type of their transformation.
+func TransformationVertexLabeler(vertex *dag.Vertex[string]) (string, error) {
+	transformation, ok := vertex.Attributes[syncdag.AttributeValue].(graph.Transformation)
+	if !ok {
+		return "", fmt.Errorf("vertex %v has a value attribute of unexpected type %T, expected type %T",
+			vertex.ID, vertex.Attributes[syncdag.AttributeValue], graph.Transformation{})
+	}
+	label := fmt.Sprintf("%s\n%s", transformation.ID, transformation.GetType())
+	if transformation.IsForEach() {
+		label += fmt.Sprintf("\nforEach (%d)", len(transformation.Instances))
+	}
+	return label, nil
+}
@@ -0,0 +1,185 @@
+package diagram
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"ocm.software/open-component-model/bindings/go/dag"
+	syncdag "ocm.software/open-component-model/bindings/go/dag/sync"
+	"ocm.software/open-component-model/cli/internal/render"
+	"ocm.software/open-component-model/cli/internal/render/graph"
+)
+
+// Renderer prints a DirectedAcyclicGraph as a Graphviz DOT or Mermaid flowchart,
+// for example to embed it in review documents or pull request comments.
+// Every edge of the graph is drawn as an arrow from the vertex to its neighbor.
+//
+// Example DOT output:
+//
+//	digraph {
+//	  n0 [label="app-frontend\nv1.2.0"];
+//	  n1 [label="ui-library\nv2.1.0"];
+//	  n0 -> n1;
+//	}
+//
+// Example Mermaid output:
+//
+//	flowchart TD
+//	  n0["app-frontend<br/>v1.2.0"]
+//	  n1["ui-library<br/>v2.1.0"]
+//	  n0 --> n1
+type Renderer[T cmp.Ordered] struct {
+	vertexLabeler VertexLabeler[T]
+	format        render.OutputFormat
+	// The roots are optional. If not provided, all vertices are rendered.
+	roots []T
+	graph *syncdag.SyncedDirectedAcyclicGraph[T]
+}
+
+// New creates a new Renderer for the given DirectedAcyclicGraph.
+func New[T cmp.Ordered](graph *syncdag.SyncedDirectedAcyclicGraph[T], opts ...RendererOption[T]) *Renderer[T] {
+	options := &RendererOptions[T]{OutputFormat: render.OutputFormatDOT}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.VertexLabeler == nil {
+		options.VertexLabeler = VertexLabelerFunc[T](defaultVertexLabeler[T])
+	}
+	return &Renderer[T]{
+		vertexLabeler: options.VertexLabeler,
+		format:        options.OutputFormat,
+		roots:         options.Roots,
+		graph:         graph,
+	}
+}
+
+type node struct {
+	label string
+	edges []int
+}
+
+// Render renders the diagram to the writer.
+func (r *Renderer[T]) Render(ctx context.Context, writer io.Writer) error {
+	var nodes []node
+	if err := r.graph.WithReadLock(func(d *dag.DirectedAcyclicGraph[T]) error {
+		var err error
+		nodes, err = r.collect(ctx, d)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	switch r.format {
+	case render.OutputFormatDOT:
+		writeDOT(&out, nodes)
+	case render.OutputFormatMermaid:
+		writeMermaid(&out, nodes)
+	default:
+		return fmt.Errorf("unsupported diagram format %q", r.format)
+	}
+	_, err := io.WriteString(writer, out.String())
+	return err
+}
+
+// collect returns the vertices to render sorted by ID, with edges as indices into the result.
+func (r *Renderer[T]) collect(ctx context.Context, d *dag.DirectedAcyclicGraph[T]) ([]node, error) {
+	ids := r.reachable(d)
+	slices.Sort(ids)
+	index := make(map[T]int, len(ids))
+	for i, id := range ids {
+		index[id] = i
+	}
+
+	nodes := make([]node, len(ids))
+	for i, id := range ids {
+		vertex := d.Vertices[id]
+		label, err := r.vertexLabeler.Label(vertex)
+		if err != nil {
+			return nil, fmt.Errorf("failed to label vertex %v: %w", id, err)
+		}
+		neighbors, err := graph.GetNeighborsSorted(ctx, vertex)
+		if err != nil {
+			return nil, err
+		}
+		nodes[i].label = label
+		for _, neighbor := range neighbors {
+			if j, ok := index[neighbor]; ok {
+				nodes[i].edges = append(nodes[i].edges, j)
+			}
+		}
+	}
+	return nodes, nil
+}
+
+// reachable returns the IDs of all vertices reachable from the roots, or all vertices without roots.
+// Roots that are not (yet) part of the graph are ignored.
+func (r *Renderer[T]) reachable(d *dag.DirectedAcyclicGraph[T]) []T {
+	if len(r.roots) == 0 {
+		ids := make([]T, 0, len(d.Vertices))
+		for id := range d.Vertices {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	visited := make(map[T]struct{})
+	var ids []T
+	var visit func(id T)
+	visit = func(id T) {
+		vertex, ok := d.Vertices[id]
+		if !ok {
+			return
+		}
+		if _, seen := visited[id]; seen {
+			return
+		}
+		visited[id] = struct{}{}
+		ids = append(ids, id)
+		for child := range vertex.Edges {
+			visit(child)
+		}
+	}
+	for _, root := range r.roots {
+		visit(root)
+	}
+	return ids
+}
+
+func writeDOT(out *strings.Builder, nodes []node) {
+	out.WriteString("digraph {\n")
+	for i, n := range nodes {
+		fmt.Fprintf(out, "  n%d [label=%s];\n", i, dotString(n.label))
+	}
+	for i, n := range nodes {
+		for _, j := range n.edges {
+			fmt.Fprintf(out, "  n%d -> n%d;\n", i, j)
+		}
+	}
+	out.WriteString("}\n")
+}
+
+func dotString(label string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + replacer.Replace(label) + `"`
+}
+
+func writeMermaid(out *strings.Builder, nodes []node) {
+	out.WriteString("flowchart TD\n")
+	for i, n := range nodes {
+		fmt.Fprintf(out, "  n%d[%s]\n", i, mermaidString(n.label))
+	}
+	for i, n := range nodes {
+		for _, j := range n.edges {
+			fmt.Fprintf(out, "  n%d --> n%d\n", i, j)
+		}
+	}
+}
+
+func mermaidString(label string) string {
+	replacer := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", "<br/>")
+	return `"` + replacer.Replace(label) + `"`
+}
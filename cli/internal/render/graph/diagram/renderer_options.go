@@ -0,0 +1,42 @@
+package diagram
+
+import (
+	"cmp"
+
+	"ocm.software/open-component-model/cli/internal/render"
+)
+
+// RendererOptions defines the options for the diagram Renderer.
+type RendererOptions[T cmp.Ordered] struct {
+	// VertexLabeler returns the label of a vertex.
+	VertexLabeler VertexLabeler[T]
+	// Roots are the root vertices of the diagram to render.
+	// Only vertices reachable from the roots are rendered.
+	Roots []T
+	// OutputFormat is either render.OutputFormatDOT or render.OutputFormatMermaid.
+	OutputFormat render.OutputFormat
+}
+
+// RendererOption is a function that modifies the RendererOptions.
+type RendererOption[T cmp.Ordered] func(*RendererOptions[T])
+
+// WithVertexLabeler sets the VertexLabeler for the Renderer.
+func WithVertexLabeler[T cmp.Ordered](labeler VertexLabeler[T]) RendererOption[T] {
+	return func(opts *RendererOptions[T]) {
+		opts.VertexLabeler = labeler
+	}
+}
+
+// WithRoots sets the roots for the Renderer.
+func WithRoots[T cmp.Ordered](roots ...T) RendererOption[T] {
+	return func(opts *RendererOptions[T]) {
+		opts.Roots = roots
+	}
+}
+
+// WithOutputFormat sets the diagram language of the Renderer.
+func WithOutputFormat[T cmp.Ordered](format render.OutputFormat) RendererOption[T] {
+	return func(opts *RendererOptions[T]) {
+		opts.OutputFormat = format
+	}
+}
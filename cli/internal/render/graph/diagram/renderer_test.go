@@ -0,0 +1,94 @@
+package diagram
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/dag"
+	syncdag "ocm.software/open-component-model/bindings/go/dag/sync"
+	descriptor "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/cli/internal/render"
+)
+
+func newTestGraph(t *testing.T) *syncdag.SyncedDirectedAcyclicGraph[string] {
+	t.Helper()
+	r := require.New(t)
+	graph := dag.NewDirectedAcyclicGraph[string]()
+	for _, c := range []struct{ id, name, version string }{
+		{"a", "app", "v1.0.0"},
+		{"b", "ui \"library\"", "v2.0.0"},
+		{"c", "icons", "v3.0.0"},
+		{"d", "unrelated", "v4.0.0"},
+	} {
+		r.NoError(graph.AddVertex(c.id, map[string]any{syncdag.AttributeValue: &descriptor.Descriptor{
+			Component: descriptor.Component{ComponentMeta: descriptor.ComponentMeta{
+				ObjectMeta: descriptor.ObjectMeta{Name: c.name, Version: c.version},
+			}},
+		}}))
+	}
+	r.NoError(graph.AddEdge("a", "b"))
+	r.NoError(graph.AddEdge("a", "c"))
+	r.NoError(graph.AddEdge("b", "c"))
+	return syncdag.ToSyncedGraph(graph)
+}
+
+func TestRenderer(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []RendererOption[string]
+		expected string
+	}{
+		{
+			name: "dot",
+			opts: []RendererOption[string]{WithRoots("a")},
+			expected: `digraph {
+  n0 [label="app\nv1.0.0"];
+  n1 [label="ui \"library\"\nv2.0.0"];
+  n2 [label="icons\nv3.0.0"];
+  n0 -> n1;
+  n0 -> n2;
+  n1 -> n2;
+}
+`,
+		},
+		{
+			name: "mermaid",
+			opts: []RendererOption[string]{WithRoots("a"), WithOutputFormat[string](render.OutputFormatMermaid)},
+			expected: `flowchart TD
+  n0["app<br/>v1.0.0"]
+  n1["ui #quot;library#quot;<br/>v2.0.0"]
+  n2["icons<br/>v3.0.0"]
+  n0 --> n1
+  n0 --> n2
+  n1 --> n2
+`,
+		},
+		{
+			name: "all vertices without roots",
+			opts: []RendererOption[string]{
+				WithOutputFormat[string](render.OutputFormatMermaid),
+				WithVertexLabeler(VertexLabelerFunc[string](func(v *dag.Vertex[string]) (string, error) {
+					return v.ID, nil
+				})),
+			},
+			expected: `flowchart TD
+  n0["a"]
+  n1["b"]
+  n2["c"]
+  n3["d"]
+  n0 --> n1
+  n0 --> n2
+  n1 --> n2
+`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, New(newTestGraph(t), tc.opts...).Render(t.Context(), buf))
+			require.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package diagram
+
+import (
+	syncdag "ocm.software/open-component-model/bindings/go/dag/sync"
+	"ocm.software/open-component-model/bindings/go/transform/graph/builder"
+	"ocm.software/open-component-model/cli/internal/render"
+)
+
+// Formats are the output formats supported by the Renderer.
+var Formats = []string{render.OutputFormatDOT.String(), render.OutputFormatMermaid.String()}
+
+// ParseFormat returns the diagram output format for its name and whether it is a diagram format.
+func ParseFormat(format string) (render.OutputFormat, bool) {
+	switch format {
+	case render.OutputFormatDOT.String():
+		return render.OutputFormatDOT, true
+	case render.OutputFormatMermaid.String():
+		return render.OutputFormatMermaid, true
+	default:
+		return 0, false
+	}
+}
+
+// NewTransformationRenderer creates a Renderer for a checked transformation graph.
+// Vertices are labelled with the ID and type of the transformation, edges are the
+// dependencies found in the CEL expressions of the transformations.
+func NewTransformationRenderer(graph *builder.Graph, format render.OutputFormat) *Renderer[string] {
+	return New(syncdag.ToSyncedGraph(graph.DAG()),
+		WithVertexLabeler(VertexLabelerFunc[string](TransformationVertexLabeler)),
+		WithOutputFormat[string](format),
+	)
+}
@@ -78,7 +78,7 @@ func (v *barVisualizer[T]) HandleEvent(event progress.Event[T]) {
 
 	for i, e := range v.events {
 		if e.ID == event.ID {
-			if e.State == progress.Completed || e.State == progress.Failed {
+			if e.State == progress.Completed || e.State == progress.Failed || e.State == progress.Skipped {
 				return
 			}
 			v.events[i] = event
@@ -211,7 +211,7 @@ func (v *barVisualizer[T]) writeEvents() {
 }
 
 func (v *barVisualizer[T]) writeBar() {
-	completed, failed, cancelled := 0, 0, 0
+	completed, failed, cancelled, skipped := 0, 0, 0, 0
 	for _, event := range v.events {
 		switch event.State {
 		case progress.Completed:
@@ -220,6 +220,10 @@ func (v *barVisualizer[T]) writeBar() {
 			failed++
 		case progress.Cancelled:
 			cancelled++
+		case progress.Skipped:
+			// skipped items are done, they count towards the progress.
+			completed++
+			skipped++
 		}
 	}
 
@@ -237,6 +241,9 @@ func (v *barVisualizer[T]) writeBar() {
 	if cancelled > 0 {
 		status += fmt.Sprintf(" %s(%d cancelled)%s", DarkGray, cancelled, Reset)
 	}
+	if skipped > 0 {
+		status += fmt.Sprintf(" %s(%d skipped)%s", DarkGray, skipped, Reset)
+	}
 
 	fmt.Fprintf(&v.buf, "  %s[%s%s%s%s%s]%s %s%3d%%%s %s\n",
 		Bold+DarkGray, white, strings.Repeat("█", filled),
@@ -255,6 +262,8 @@ func (v *barVisualizer[T]) formatItem(item progress.Event[T]) string {
 		symbol, color = "✗", Red
 	case progress.Cancelled:
 		symbol, color = "⊘", DarkGray
+	case progress.Skipped:
+		symbol, color = "↷", DarkGray
 	default:
 		symbol, color = "?", DarkGray
 	}
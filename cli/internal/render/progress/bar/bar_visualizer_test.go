@@ -111,6 +111,7 @@ func TestFormatItem(t *testing.T) {
 		{"completed shows checkmark", progress.Completed, "✓"},
 		{"failed shows X", progress.Failed, "✗"},
 		{"cancelled shows circle", progress.Cancelled, "⊘"},
+		{"skipped shows arrow", progress.Skipped, "↷"},
 	}
 
 	for _, tt := range tests {
@@ -283,6 +284,20 @@ func TestRenderBar_ShowsCancelledCount(t *testing.T) {
 	assert.Contains(t, output, "1 cancelled")
 }
 
+func TestRenderBar_CountsSkippedAsDone(t *testing.T) {
+	v, _ := newTestVisualizer(2)
+	v.events = []progress.Event[string]{
+		{ID: "a", State: progress.Completed},
+		{ID: "b", State: progress.Skipped},
+	}
+
+	v.writeBar()
+	output := stripANSI(v.buf.String())
+
+	assert.Contains(t, output, "2/2")
+	assert.Contains(t, output, "1 skipped")
+}
+
 // --- writeFailureSummary tests ---
 
 func TestRenderFailureSummary_ShowsErrorIDs(t *testing.T) {
@@ -62,6 +62,7 @@ func TestSlogVisualizer_HandleEvent(t *testing.T) {
 		{"completed", Completed, nil, []string{"item completed", "level=INFO"}},
 		{"failed", Failed, fmt.Errorf("timeout"), []string{"item failed", "level=ERROR", "timeout"}},
 		{"cancelled", Cancelled, nil, []string{"item cancelled", "level=WARN"}},
+		{"skipped", Skipped, nil, []string{"item skipped", "level=INFO"}},
 	}
 
 	for _, tt := range tests {
@@ -22,6 +22,8 @@ func (v *SlogVisualizer[T]) HandleEvent(event Event[T]) {
 		slog.Error(v.name+": item failed", "item", event.Name, "error", event.Err)
 	case Cancelled:
 		slog.Warn(v.name+": item cancelled", "item", event.Name)
+	case Skipped:
+		slog.Info(v.name+": item skipped", "item", event.Name)
 	}
 }
 
@@ -18,6 +18,7 @@ const (
 	Completed State = "completed"
 	Failed    State = "failed"
 	Cancelled State = "cancelled"
+	Skipped   State = "skipped"
 	Unknown   State = "unknown"
 )
 
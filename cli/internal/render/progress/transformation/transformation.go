@@ -1,4 +1,5 @@
-package component_version
+// Package transformation renders the progress of transformation graphs with the progress tracker.
+package transformation
 
 import (
 	"encoding/json"
@@ -10,8 +11,8 @@ import (
 	"ocm.software/open-component-model/cli/internal/render/progress/bar"
 )
 
-// mapEvent converts a graph runtime progress event to a typed progress.Event.
-func mapEvent(e graphRuntime.ProgressEvent) progress.Event[*graphPkg.Transformation] {
+// MapEvent converts a graph runtime progress event to a typed progress.Event.
+func MapEvent(e graphRuntime.ProgressEvent) progress.Event[*graphPkg.Transformation] {
 	return progress.Event[*graphPkg.Transformation]{
 		ID:    e.Transformation.ID,
 		Name:  formatTransformationName(e.Transformation),
@@ -30,14 +31,16 @@ func mapState(s graphRuntime.State) progress.State {
 		return progress.Completed
 	case graphRuntime.Failed:
 		return progress.Failed
+	case graphRuntime.Skipped:
+		return progress.Skipped
 	default:
 		return progress.Unknown
 	}
 }
 
-// formatError renders a transformation error with a red sidebar error tree
+// FormatError renders a transformation error with a red sidebar error tree
 // and a framed spec dump for debugging.
-func formatError(t *graphPkg.Transformation, err error) string {
+func FormatError(t *graphPkg.Transformation, err error) string {
 	result := bar.SidebarText("", bar.TreeErrorFormatter(err), bar.Red)
 
 	if t != nil && t.Spec != nil {
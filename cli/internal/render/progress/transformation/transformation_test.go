@@ -1,4 +1,4 @@
-package component_version
+package transformation
 
 import (
 	"bytes"
@@ -92,11 +92,27 @@ func TestMapEvent(t *testing.T) {
 			expectedState: progress.Failed,
 			expectedErr:   testErr,
 		},
+		{
+			name: "skipped state",
+			input: graphRuntime.ProgressEvent{
+				Transformation: &graphPkg.Transformation{
+					GenericTransformation: v1alpha1.GenericTransformation{
+						TransformationMeta: meta.TransformationMeta{
+							Type: runtime.Type{Name: "AddOCIArtifact"},
+							ID:   "transform4",
+						},
+					},
+				},
+				State: graphRuntime.Skipped,
+			},
+			expectedID:    "transform4",
+			expectedState: progress.Skipped,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := mapEvent(tt.input)
+			result := MapEvent(tt.input)
 
 			assert.Equal(t, tt.expectedID, result.ID)
 			assert.Equal(t, tt.expectedState, result.State)
@@ -119,6 +135,6 @@ func TestMapEvent_NameFormattedAsIDAndType(t *testing.T) {
 		State: graphRuntime.Running,
 	}
 
-	result := mapEvent(input)
+	result := MapEvent(input)
 	assert.Equal(t, "myTransform [AddComponentVersion]", result.Name)
 }
@@ -3,6 +3,7 @@ package subsystem
 import (
 	"errors"
 
+	reference "ocm.software/open-component-model/bindings/go/credentials/spec/reference/v1alpha1"
 	"ocm.software/open-component-model/bindings/go/plugin/manager"
 )
 
@@ -50,6 +51,7 @@ func NewRegistryFromPluginManager(pm *manager.PluginManager) (*Registry, error)
 		credentialRepository.Scheme.RegisterScheme(pm.CredentialRepositoryRegistry.RepositoryScheme()),
 		signingHandler.Scheme.RegisterScheme(pm.SigningRegistry.ResourceScheme()),
 		credentials.Scheme.RegisterScheme(pm.CredentialRepositoryRegistry.GetCredentialTypeScheme()),
+		credentials.Scheme.RegisterScheme(reference.Scheme),
 	); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,65 @@
+package transformers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ocm.software/open-component-model/bindings/go/credentials"
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/transformation"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	graphRuntime "ocm.software/open-component-model/bindings/go/transform/graph/runtime"
+)
+
+// PluginResolver provides the transformations of transformation plugins to the transformation graph builder.
+type PluginResolver struct {
+	Registry           *transformation.Registry
+	CredentialProvider credentials.Resolver
+}
+
+var _ graphRuntime.TransformerResolver = (*PluginResolver)(nil)
+
+// NewPluginResolver creates a resolver for the transformation types of the plugins in the registry.
+// Credentials for the plugins are resolved with the given credential provider.
+func NewPluginResolver(registry *transformation.Registry, credentialProvider credentials.Resolver) *PluginResolver {
+	return &PluginResolver{
+		Registry:           registry,
+		CredentialProvider: credentialProvider,
+	}
+}
+
+func (r *PluginResolver) GetTransformationJSONSchema(typ runtime.Type) ([]byte, error) {
+	return r.Registry.GetJSONSchema(typ)
+}
+
+func (r *PluginResolver) GetTransformer(ctx context.Context, typ runtime.Type) (graphRuntime.Transformer, error) {
+	plugin, err := r.Registry.GetPlugin(ctx, typ)
+	if err != nil {
+		return nil, err
+	}
+	return &pluginTransformer{plugin: plugin, credentialProvider: r.CredentialProvider}, nil
+}
+
+// pluginTransformer resolves the credentials of a transformation before it is executed by its plugin.
+type pluginTransformer struct {
+	plugin             transformation.Transformer
+	credentialProvider credentials.Resolver
+}
+
+func (t *pluginTransformer) Transform(ctx context.Context, step runtime.Typed) (runtime.Typed, error) {
+	var creds runtime.Typed
+	if t.credentialProvider != nil {
+		consumerID, err := t.plugin.GetTransformationCredentialConsumerIdentity(ctx, step)
+		if err != nil {
+			return nil, fmt.Errorf("failed getting credential consumer identity of transformation %s: %w", step.GetType(), err)
+		}
+		if len(consumerID) > 0 {
+			if creds, err = t.credentialProvider.Resolve(ctx, consumerID); err != nil {
+				if !errors.Is(err, credentials.ErrNotFound) {
+					return nil, fmt.Errorf("failed resolving credentials: %w", err)
+				}
+			}
+		}
+	}
+	return t.plugin.Transform(ctx, step, creds)
+}
@@ -0,0 +1,92 @@
+package transformers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/credentials"
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+type mockPluginTransformer struct {
+	identity    runtime.Identity
+	identityErr error
+	credentials runtime.Typed
+	transformed bool
+}
+
+func (m *mockPluginTransformer) Transform(_ context.Context, transformation runtime.Typed, credentials runtime.Typed) (runtime.Typed, error) {
+	m.credentials = credentials
+	m.transformed = true
+	return transformation, nil
+}
+
+func (m *mockPluginTransformer) GetTransformationCredentialConsumerIdentity(_ context.Context, _ runtime.Typed) (runtime.Identity, error) {
+	return m.identity, m.identityErr
+}
+
+type mockCredentialResolver map[string]runtime.Typed
+
+func (m mockCredentialResolver) Resolve(_ context.Context, identity runtime.Identity) (runtime.Typed, error) {
+	creds, ok := m[identity["hostname"]]
+	if !ok {
+		return nil, credentials.ErrNotFound
+	}
+	return creds, nil
+}
+
+func TestPluginTransformer(t *testing.T) {
+	scannerCredentials := &runtime.Raw{Type: runtime.NewVersionedType("Credentials", "v1"), Data: []byte(`{"type":"Credentials/v1"}`)}
+	resolver := mockCredentialResolver{"scanner.example.com": scannerCredentials}
+	step := &runtime.Raw{Type: runtime.NewVersionedType("Scan", "v1alpha1"), Data: []byte(`{"type":"Scan/v1alpha1"}`)}
+
+	tests := []struct {
+		name     string
+		identity runtime.Identity
+		resolver credentials.Resolver
+		expected runtime.Typed
+	}{
+		{
+			name:     "credentials of the identity are passed to the plugin",
+			identity: runtime.Identity{"hostname": "scanner.example.com"},
+			resolver: resolver,
+			expected: scannerCredentials,
+		},
+		{
+			name:     "missing credentials are no error",
+			identity: runtime.Identity{"hostname": "other.example.com"},
+			resolver: resolver,
+		},
+		{
+			name:     "no identity",
+			resolver: resolver,
+		},
+		{
+			name:     "no credential provider",
+			identity: runtime.Identity{"hostname": "scanner.example.com"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &mockPluginTransformer{identity: tc.identity}
+			transformer := &pluginTransformer{plugin: plugin, credentialProvider: tc.resolver}
+			transformed, err := transformer.Transform(t.Context(), step)
+			require.NoError(t, err)
+			require.Equal(t, step, transformed)
+			require.Equal(t, tc.expected, plugin.credentials)
+		})
+	}
+}
+
+func TestPluginTransformerIdentityError(t *testing.T) {
+	step := &runtime.Raw{Type: runtime.NewVersionedType("Scan", "v1alpha1"), Data: []byte(`{"type":"Scan/v1alpha1"}`)}
+	plugin := &mockPluginTransformer{identityErr: errors.New("invalid scanner url")}
+	transformer := &pluginTransformer{plugin: plugin, credentialProvider: mockCredentialResolver{}}
+
+	_, err := transformer.Transform(t.Context(), step)
+	require.ErrorContains(t, err, "invalid scanner url")
+	require.False(t, plugin.transformed, "the plugin must not run without its credentials")
+}
@@ -0,0 +1,375 @@
+// Package verification evaluates verification policies over component version graphs.
+package verification
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"ocm.software/open-component-model/bindings/go/credentials"
+	syncdag "ocm.software/open-component-model/bindings/go/dag/sync"
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/repository/component/resolvers"
+	rsav1alpha1 "ocm.software/open-component-model/bindings/go/rsa/signing/v1alpha1"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/signing"
+	"ocm.software/open-component-model/cli/internal/verification/policy/v1alpha1"
+)
+
+// Signature verification results.
+const (
+	ResultVerified = "verified"
+	ResultFailed   = "failed"
+	ResultMissing  = "missing"
+)
+
+// HandlerProvider returns the signing handler for a verifier configuration.
+type HandlerProvider interface {
+	GetPlugin(ctx context.Context, spec runtime.Typed) (signing.Handler, error)
+}
+
+// Options configure the evaluation of a policy.
+type Options struct {
+	// Resolver resolves the repositories of the component versions in the graph.
+	Resolver resolvers.ComponentVersionRepositoryResolver
+	// Root is the descriptor of the root component version. Optional.
+	// If set, it is evaluated instead of resolving the root component version again,
+	// so the caller can rely on the report for the descriptor it already uses.
+	Root *descruntime.Descriptor
+	// Handlers provides the signing handlers for the verifier configurations of the policy.
+	Handlers HandlerProvider
+	// Credentials resolves the credentials for verification. Optional.
+	Credentials credentials.Resolver
+	// ConcurrencyLimit limits the number of parallel signature verifications.
+	ConcurrencyLimit int
+	// Logger defaults to slog.Default().
+	Logger *slog.Logger
+}
+
+// Report is the result of evaluating a policy over a component version graph.
+type Report struct {
+	// Satisfied is true if every component version in the report satisfies the policy.
+	Satisfied bool `json:"satisfied"`
+	// ComponentVersions holds the results per component version, starting with the root.
+	ComponentVersions []*ComponentVersionResult `json:"componentVersions"`
+}
+
+// Failed returns the component versions that do not satisfy the policy.
+func (r *Report) Failed() []*ComponentVersionResult {
+	var failed []*ComponentVersionResult
+	for _, cv := range r.ComponentVersions {
+		if !cv.Satisfied {
+			failed = append(failed, cv)
+		}
+	}
+	return failed
+}
+
+// ComponentVersionResult is the result of evaluating a policy for a single component version.
+type ComponentVersionResult struct {
+	Component string `json:"component"`
+	Version   string `json:"version"`
+	// Satisfied is true if the component version satisfies the policy.
+	Satisfied bool `json:"satisfied"`
+	// Verified is the number of signatures of the policy that verified.
+	Verified int `json:"verified"`
+	// Threshold is the number of signatures of the policy that must verify.
+	Threshold int `json:"threshold"`
+	// Error describes why the component version fails the policy, if it does.
+	Error string `json:"error,omitempty"`
+	// Signatures holds the results per signature of the policy.
+	Signatures []SignatureResult `json:"signatures"`
+}
+
+// SignatureResult is the result of verifying a single signature of a component version.
+type SignatureResult struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required,omitempty"`
+	// Result is one of ResultVerified, ResultFailed or ResultMissing.
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+// LoadPolicy reads and validates a policy file.
+func LoadPolicy(path string) (*v1alpha1.Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading policy file %q failed: %w", path, err)
+	}
+	var raw runtime.Raw
+	if err := runtime.NewScheme(runtime.WithAllowUnknown()).Decode(bytes.NewReader(data), &raw); err != nil {
+		return nil, fmt.Errorf("decoding policy file %q failed: %w", path, err)
+	}
+	var policy v1alpha1.Policy
+	if err := v1alpha1.Scheme.Convert(&raw, &policy); err != nil {
+		return nil, fmt.Errorf("decoding policy file %q failed: %w", path, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy %q: %w", path, err)
+	}
+	return &policy, nil
+}
+
+// Evaluate evaluates the policy for the given root component version and,
+// if the policy is recursive, for all component versions it references.
+// Component versions referenced with a digest must match that digest.
+// Failing signatures do not return an error, they are recorded in the report.
+func Evaluate(ctx context.Context, policy *v1alpha1.Policy, component, version string, opts Options) (*Report, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy: %w", err)
+	}
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	limit := opts.ConcurrencyLimit
+	if limit <= 0 {
+		limit = 1
+	}
+
+	verifiers, err := newVerifiers(ctx, policy, opts.Handlers)
+	if err != nil {
+		return nil, err
+	}
+
+	root := identity(component, version)
+	if opts.Root != nil && identity(opts.Root.Component.Name, opts.Root.Component.Version) != root {
+		return nil, fmt.Errorf("root descriptor %s:%s does not match %s:%s", opts.Root.Component.Name, opts.Root.Component.Version, component, version)
+	}
+	g := &graph{
+		resolver:  opts.Resolver,
+		recursive: policy.Recursive,
+		root:      root,
+		rootDesc:  opts.Root,
+		expected:  make(map[string][]descruntime.Digest),
+	}
+	discoverer := syncdag.NewGraphDiscoverer(&syncdag.GraphDiscovererOptions[string, *descruntime.Descriptor]{
+		Roots:      []string{root},
+		Resolver:   g,
+		Discoverer: g,
+	})
+	if err := discoverer.Discover(ctx); err != nil {
+		return nil, fmt.Errorf("traversing component version graph failed: %w", err)
+	}
+
+	descs := g.order(root, discoverer.CurrentValue)
+	report := &Report{Satisfied: true, ComponentVersions: make([]*ComponentVersionResult, len(descs))}
+	for i, desc := range descs {
+		report.ComponentVersions[i] = &ComponentVersionResult{
+			Component:  desc.Component.Name,
+			Version:    desc.Component.Version,
+			Threshold:  policy.EffectiveThreshold(),
+			Signatures: make([]SignatureResult, len(policy.Signatures)),
+		}
+	}
+
+	eg, egctx := errgroup.WithContext(ctx)
+	eg.SetLimit(limit)
+	for i, desc := range descs {
+		result := report.ComponentVersions[i]
+		var mismatches []error
+		for _, expected := range g.expected[identity(desc.Component.Name, desc.Component.Version)] {
+			if err := signing.VerifyDigestMatchesDescriptor(egctx, desc, descruntime.Signature{Digest: expected}, logger); err != nil {
+				mismatches = append(mismatches, err)
+			}
+		}
+		if len(mismatches) > 0 {
+			result.Error = fmt.Sprintf("descriptor does not match the digest of its reference: %v", errors.Join(mismatches...))
+		}
+		for j, rule := range policy.Signatures {
+			eg.Go(func() error {
+				result.Signatures[j] = verifiers[j].verify(egctx, desc, rule, opts.Credentials, logger)
+				return nil
+			})
+		}
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	for _, result := range report.ComponentVersions {
+		result.evaluate()
+		report.Satisfied = report.Satisfied && result.Satisfied
+	}
+	return report, nil
+}
+
+// evaluate applies the policy to the signature results.
+func (r *ComponentVersionResult) evaluate() {
+	var missingRequired []string
+	for _, sig := range r.Signatures {
+		switch {
+		case sig.Result == ResultVerified:
+			r.Verified++
+		case sig.Required:
+			missingRequired = append(missingRequired, sig.Name)
+		}
+	}
+	switch {
+	case r.Error != "":
+	case len(missingRequired) > 0:
+		r.Error = fmt.Sprintf("required signatures %q did not verify", missingRequired)
+	case r.Verified < r.Threshold:
+		r.Error = fmt.Sprintf("%d of %d signatures verified, %d required", r.Verified, len(r.Signatures), r.Threshold)
+	}
+	r.Satisfied = r.Error == ""
+}
+
+// verifier verifies a signature of the policy with its verifier configuration.
+type verifier struct {
+	config  runtime.Typed
+	handler signing.Handler
+}
+
+func newVerifiers(ctx context.Context, policy *v1alpha1.Policy, handlers HandlerProvider) ([]verifier, error) {
+	verifiers := make([]verifier, len(policy.Signatures))
+	for i, rule := range policy.Signatures {
+		var config runtime.Typed
+		if rule.Verifier != nil {
+			config = rule.Verifier
+		} else {
+			defaultConfig := &rsav1alpha1.Config{}
+			_, _ = rsav1alpha1.Scheme.DefaultType(defaultConfig)
+			config = defaultConfig
+		}
+		handler, err := handlers.GetPlugin(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("getting signature handler plugin for signature %q failed: %w", rule.Name, err)
+		}
+		verifiers[i] = verifier{config: config, handler: handler}
+	}
+	return verifiers, nil
+}
+
+func (v verifier) verify(ctx context.Context, desc *descruntime.Descriptor, rule v1alpha1.Signature, resolver credentials.Resolver, logger *slog.Logger) SignatureResult {
+	result := SignatureResult{Name: rule.Name, Required: rule.Required}
+	idx := slices.IndexFunc(desc.Signatures, func(sig descruntime.Signature) bool { return sig.Name == rule.Name })
+	if idx < 0 {
+		result.Result = ResultMissing
+		return result
+	}
+	signature := desc.Signatures[idx]
+	logger.InfoContext(ctx, "verifying signature", "component", desc.Component.Name, "version", desc.Component.Version, "name", signature.Name)
+
+	if err := v.verifySignature(ctx, desc, signature, resolver, logger); err != nil {
+		logger.InfoContext(ctx, "signature verification failed", "component", desc.Component.Name, "version", desc.Component.Version, "name", signature.Name, "error", err.Error())
+		result.Result = ResultFailed
+		result.Error = err.Error()
+		return result
+	}
+	result.Result = ResultVerified
+	return result
+}
+
+func (v verifier) verifySignature(ctx context.Context, desc *descruntime.Descriptor, signature descruntime.Signature, resolver credentials.Resolver, logger *slog.Logger) error {
+	if err := signing.VerifyDigestMatchesDescriptor(ctx, desc, signature, logger); err != nil {
+		return err
+	}
+
+	var creds runtime.Typed
+	if resolver != nil {
+		if consumerID, err := v.handler.GetVerifyingCredentialConsumerIdentity(ctx, signature, v.config); err == nil {
+			if creds, err = resolver.Resolve(ctx, consumerID); err != nil {
+				if !errors.Is(err, credentials.ErrNotFound) {
+					return fmt.Errorf("resolving credentials for verification failed: %w", err)
+				}
+				logger.DebugContext(ctx, "could not resolve credentials for verification", "error", err.Error())
+			}
+		}
+	}
+	return v.handler.Verify(ctx, signature, v.config, creds)
+}
+
+// graph resolves component versions and discovers their references.
+type graph struct {
+	resolver  resolvers.ComponentVersionRepositoryResolver
+	recursive bool
+	root      string
+	// rootDesc is returned for the root instead of resolving it, if set.
+	rootDesc *descruntime.Descriptor
+
+	mu sync.Mutex
+	// expected holds the digests of references by component identity.
+	// A component version referenced by several parents must match the digests of all references.
+	expected map[string][]descruntime.Digest
+}
+
+var (
+	_ syncdag.Resolver[string, *descruntime.Descriptor]   = (*graph)(nil)
+	_ syncdag.Discoverer[string, *descruntime.Descriptor] = (*graph)(nil)
+)
+
+func (g *graph) Resolve(ctx context.Context, key string) (*descruntime.Descriptor, error) {
+	if key == g.root && g.rootDesc != nil {
+		return g.rootDesc, nil
+	}
+	id, err := runtime.ParseIdentity(key)
+	if err != nil {
+		return nil, fmt.Errorf("parsing identity %q failed: %w", key, err)
+	}
+	component, version := id[descruntime.IdentityAttributeName], id[descruntime.IdentityAttributeVersion]
+	repo, err := g.resolver.GetComponentVersionRepositoryForComponent(ctx, component, version)
+	if err != nil {
+		return nil, fmt.Errorf("getting component version repository for identity %q failed: %w", id, err)
+	}
+	desc, err := repo.GetComponentVersion(ctx, component, version)
+	if err != nil {
+		return nil, fmt.Errorf("getting component version for identity %q failed: %w", id, err)
+	}
+	return desc, nil
+}
+
+func (g *graph) Discover(_ context.Context, parent *descruntime.Descriptor) ([]string, error) {
+	if !g.recursive {
+		return nil, nil
+	}
+	children := make([]string, len(parent.Component.References))
+	for i, ref := range parent.Component.References {
+		children[i] = ref.ToComponentIdentity().String()
+		if ref.Digest.Value != "" {
+			g.mu.Lock()
+			if !slices.Contains(g.expected[children[i]], ref.Digest) {
+				g.expected[children[i]] = append(g.expected[children[i]], ref.Digest)
+			}
+			g.mu.Unlock()
+		}
+	}
+	return children, nil
+}
+
+// order returns the discovered descriptors breadth-first, starting with the root.
+func (g *graph) order(root string, value func(string) *descruntime.Descriptor) []*descruntime.Descriptor {
+	var descs []*descruntime.Descriptor
+	seen := map[string]bool{root: true}
+	for queue := []string{root}; len(queue) > 0; queue = queue[1:] {
+		desc := value(queue[0])
+		if desc == nil {
+			continue
+		}
+		descs = append(descs, desc)
+		if !g.recursive {
+			continue
+		}
+		for _, ref := range desc.Component.References {
+			if id := ref.ToComponentIdentity().String(); !seen[id] {
+				seen[id] = true
+				queue = append(queue, id)
+			}
+		}
+	}
+	return descs
+}
+
+func identity(component, version string) string {
+	return runtime.Identity{
+		descruntime.IdentityAttributeName:    component,
+		descruntime.IdentityAttributeVersion: version,
+	}.String()
+}
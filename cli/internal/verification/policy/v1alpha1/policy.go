@@ -0,0 +1,86 @@
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	"ocm.software/open-component-model/bindings/go/runtime"
+)
+
+const (
+	// PolicyType defines the type identifier for verification policies.
+	PolicyType = "VerificationPolicy"
+)
+
+// Scheme is the scheme of verification policies.
+var Scheme = runtime.NewScheme()
+
+func init() {
+	Scheme.MustRegisterWithAlias(&Policy{}, runtime.NewVersionedType(PolicyType, Version), runtime.NewUnversionedType(PolicyType))
+}
+
+// Policy describes which signatures a component version must carry to pass verification.
+//
+// A component version satisfies the policy if every required signature verifies
+// and at least Threshold of the listed signatures verify.
+//
+// +k8s:deepcopy-gen:interfaces=ocm.software/open-component-model/bindings/go/runtime.Typed
+// +k8s:deepcopy-gen=true
+// +ocm:typegen=true
+// +ocm:jsonschema-gen=true
+type Policy struct {
+	Type runtime.Type `json:"type"`
+	// Signatures lists the signatures evaluated by the policy by name,
+	// each with the verifier configuration used to verify it.
+	Signatures []Signature `json:"signatures"`
+	// Threshold is the minimum number of Signatures that must verify (m-of-n).
+	// If not set, all Signatures must verify.
+	Threshold int `json:"threshold,omitempty"`
+	// Recursive evaluates the policy for all component versions referenced
+	// by the verified component version, transitively.
+	Recursive bool `json:"recursive,omitempty"`
+}
+
+// Signature is a signature evaluated by a Policy.
+//
+// +k8s:deepcopy-gen=true
+// +ocm:jsonschema-gen=true
+type Signature struct {
+	// Name is the name of the signature in the component descriptor.
+	Name string `json:"name"`
+	// Required signatures must verify regardless of the threshold.
+	Required bool `json:"required,omitempty"`
+	// Verifier is the verifier configuration passed to the signing handler,
+	// for example an RSAVerificationConfiguration/v1alpha1.
+	// If not set, the default RSASSA-PSS verifier is used.
+	Verifier *runtime.Raw `json:"verifier,omitempty"`
+}
+
+// EffectiveThreshold returns the number of signatures that must verify.
+func (p *Policy) EffectiveThreshold() int {
+	if p.Threshold == 0 {
+		return len(p.Signatures)
+	}
+	return p.Threshold
+}
+
+// Validate checks that the policy is well-formed.
+func (p *Policy) Validate() error {
+	if len(p.Signatures) == 0 {
+		return errors.New("policy lists no signatures")
+	}
+	names := make(map[string]struct{}, len(p.Signatures))
+	for i, sig := range p.Signatures {
+		if sig.Name == "" {
+			return fmt.Errorf("signature %d has no name", i)
+		}
+		if _, ok := names[sig.Name]; ok {
+			return fmt.Errorf("signature %q is listed more than once", sig.Name)
+		}
+		names[sig.Name] = struct{}{}
+	}
+	if p.Threshold < 0 || p.Threshold > len(p.Signatures) {
+		return fmt.Errorf("threshold %d must not be negative or exceed the number of signatures (%d)", p.Threshold, len(p.Signatures))
+	}
+	return nil
+}
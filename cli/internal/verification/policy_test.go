@@ -0,0 +1,134 @@
+package verification
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/descriptor/normalisation/json/v4alpha1"
+	descruntime "ocm.software/open-component-model/bindings/go/descriptor/runtime"
+	"ocm.software/open-component-model/bindings/go/repository"
+	"ocm.software/open-component-model/bindings/go/repository/component/resolvers"
+	"ocm.software/open-component-model/bindings/go/runtime"
+	"ocm.software/open-component-model/bindings/go/signing"
+	"ocm.software/open-component-model/cli/internal/verification/policy/v1alpha1"
+)
+
+// descriptorRepository serves component versions from memory.
+type descriptorRepository struct {
+	repository.ComponentVersionRepository
+	resolvers.ComponentVersionRepositoryResolver
+	descs map[string]*descruntime.Descriptor
+}
+
+func (r *descriptorRepository) GetComponentVersionRepositoryForComponent(context.Context, string, string) (repository.ComponentVersionRepository, error) {
+	return r, nil
+}
+
+func (r *descriptorRepository) GetComponentVersion(_ context.Context, component, version string) (*descruntime.Descriptor, error) {
+	desc, ok := r.descs[component+":"+version]
+	if !ok {
+		return nil, fmt.Errorf("component version %s:%s not found", component, version)
+	}
+	return desc, nil
+}
+
+type noHandlers struct{}
+
+func (noHandlers) GetPlugin(context.Context, runtime.Typed) (signing.Handler, error) {
+	return nil, nil
+}
+
+func newDescriptor(name string, refs ...descruntime.Reference) *descruntime.Descriptor {
+	return &descruntime.Descriptor{
+		Meta: descruntime.Meta{Version: "v2"},
+		Component: descruntime.Component{
+			ComponentMeta: descruntime.ComponentMeta{
+				ObjectMeta: descruntime.ObjectMeta{Name: name, Version: "1.0.0"},
+			},
+			Provider:   descruntime.Provider{Name: "ocm.software"},
+			References: refs,
+		},
+	}
+}
+
+func newReference(t *testing.T, name string, desc *descruntime.Descriptor) descruntime.Reference {
+	t.Helper()
+	dig, err := signing.GenerateDigest(t.Context(), desc, slog.Default(), v4alpha1.Algorithm, crypto.SHA256.String())
+	require.NoError(t, err)
+	return descruntime.Reference{
+		ElementMeta: descruntime.ElementMeta{
+			ObjectMeta: descruntime.ObjectMeta{Name: name, Version: desc.Component.Version},
+		},
+		Component: desc.Component.Name,
+		Digest:    *dig,
+	}
+}
+
+func TestEvaluateChecksDigestsOfAllReferences(t *testing.T) {
+	shared := newDescriptor("ocm.software/shared")
+	other := newDescriptor("ocm.software/shared")
+	other.Component.Provider.Name = "tampered"
+
+	// both parents reference the shared component version, but only one of them with its digest.
+	libA := newDescriptor("ocm.software/lib-a", newReference(t, "shared", shared))
+	wrong := newReference(t, "shared", other)
+	libB := newDescriptor("ocm.software/lib-b", wrong)
+	app := newDescriptor("ocm.software/app", newReference(t, "lib-a", libA), newReference(t, "lib-b", libB))
+
+	repo := &descriptorRepository{descs: map[string]*descruntime.Descriptor{}}
+	for _, desc := range []*descruntime.Descriptor{shared, libA, libB, app} {
+		repo.descs[desc.Component.Name+":"+desc.Component.Version] = desc
+	}
+
+	policy := &v1alpha1.Policy{
+		Type:       runtime.NewVersionedType(v1alpha1.PolicyType, v1alpha1.Version),
+		Recursive:  true,
+		Signatures: []v1alpha1.Signature{{Name: "default"}},
+	}
+	report, err := Evaluate(t.Context(), policy, app.Component.Name, app.Component.Version, Options{
+		Resolver: repo,
+		Handlers: noHandlers{},
+	})
+	require.NoError(t, err)
+
+	var sharedResult *ComponentVersionResult
+	for _, result := range report.ComponentVersions {
+		if result.Component == shared.Component.Name {
+			sharedResult = result
+		}
+	}
+	require.NotNil(t, sharedResult)
+	require.Contains(t, sharedResult.Error, "descriptor does not match the digest of its reference")
+	require.Contains(t, sharedResult.Error, wrong.Digest.Value)
+}
+
+func TestEvaluateUsesRootDescriptor(t *testing.T) {
+	app := newDescriptor("ocm.software/app")
+	policy := &v1alpha1.Policy{
+		Type:       runtime.NewVersionedType(v1alpha1.PolicyType, v1alpha1.Version),
+		Signatures: []v1alpha1.Signature{{Name: "default"}},
+	}
+
+	// the repository does not know the root, so it must not be resolved again.
+	repo := &descriptorRepository{descs: map[string]*descruntime.Descriptor{}}
+	report, err := Evaluate(t.Context(), policy, app.Component.Name, app.Component.Version, Options{
+		Resolver: repo,
+		Root:     app,
+		Handlers: noHandlers{},
+	})
+	require.NoError(t, err)
+	require.Len(t, report.ComponentVersions, 1)
+	require.Equal(t, app.Component.Name, report.ComponentVersions[0].Component)
+
+	_, err = Evaluate(t.Context(), policy, "ocm.software/other", app.Component.Version, Options{
+		Resolver: repo,
+		Root:     app,
+		Handlers: noHandlers{},
+	})
+	require.ErrorContains(t, err, "does not match")
+}
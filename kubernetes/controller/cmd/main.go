@@ -48,6 +48,7 @@ import (
 	"ocm.software/open-component-model/kubernetes/controller/internal/controller/deployer/dynamic"
 	"ocm.software/open-component-model/kubernetes/controller/internal/controller/repository"
 	"ocm.software/open-component-model/kubernetes/controller/internal/controller/resource"
+	ocmmetrics "ocm.software/open-component-model/kubernetes/controller/internal/metrics"
 	"ocm.software/open-component-model/kubernetes/controller/internal/ocm"
 	"ocm.software/open-component-model/kubernetes/controller/internal/resolution"
 	"ocm.software/open-component-model/kubernetes/controller/internal/resolution/workerpool"
@@ -201,6 +202,7 @@ func main() {
 	}
 
 	pm := manager.NewPluginManager(ctx)
+	metrics.Registry.MustRegister(ocmmetrics.NewPluginCollector(workerpool.MetricsNamespace, pm.Metrics))
 
 	ocirepository.MustAddLegacyToScheme(ocirepository.Scheme)
 	repositoryProvider := provider.NewComponentVersionRepositoryProvider(provider.WithScheme(ocirepository.Scheme))
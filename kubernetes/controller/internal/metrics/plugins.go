@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+)
+
+const pluginLabel = "plugin"
+
+// PluginCollector exposes the call metrics of the plugins supervised by the plugin manager.
+// The metrics are read from the plugin manager on every scrape.
+type PluginCollector struct {
+	metrics func() map[string]plugins.Metrics
+
+	calls     *prometheus.Desc
+	errors    *prometheus.Desc
+	inFlight  *prometheus.Desc
+	restarts  *prometheus.Desc
+	instances *prometheus.Desc
+	duration  *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*PluginCollector)(nil)
+
+// NewPluginCollector creates a collector for the plugin metrics returned by metrics,
+// usually the Metrics method of the plugin manager.
+func NewPluginCollector(namespace string, metrics func() map[string]plugins.Metrics) *PluginCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "plugin", name), help, []string{pluginLabel}, nil)
+	}
+	return &PluginCollector{
+		metrics:   metrics,
+		calls:     desc("calls_total", "Number of calls made to the plugin."),
+		errors:    desc("errors_total", "Number of calls to the plugin that failed or returned a server error."),
+		inFlight:  desc("calls_in_flight", "Number of calls to the plugin currently in progress."),
+		restarts:  desc("restarts_total", "Number of times an instance of the plugin was restarted."),
+		instances: desc("instances", "Number of running instances of the plugin."),
+		duration:  desc("call_duration_seconds_total", "Accumulated duration of all finished calls to the plugin."),
+	}
+}
+
+func (c *PluginCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.calls
+	ch <- c.errors
+	ch <- c.inFlight
+	ch <- c.restarts
+	ch <- c.instances
+	ch <- c.duration
+}
+
+func (c *PluginCollector) Collect(ch chan<- prometheus.Metric) {
+	for id, m := range c.metrics() {
+		ch <- prometheus.MustNewConstMetric(c.calls, prometheus.CounterValue, float64(m.Calls), id)
+		ch <- prometheus.MustNewConstMetric(c.errors, prometheus.CounterValue, float64(m.Errors), id)
+		ch <- prometheus.MustNewConstMetric(c.inFlight, prometheus.GaugeValue, float64(m.InFlight), id)
+		ch <- prometheus.MustNewConstMetric(c.restarts, prometheus.CounterValue, float64(m.Restarts), id)
+		ch <- prometheus.MustNewConstMetric(c.instances, prometheus.GaugeValue, float64(m.Instances), id)
+		ch <- prometheus.MustNewConstMetric(c.duration, prometheus.CounterValue, m.Duration.Seconds(), id)
+	}
+}
@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"ocm.software/open-component-model/bindings/go/plugin/manager/registries/plugins"
+)
+
+func TestPluginCollector(t *testing.T) {
+	collector := NewPluginCollector("ocm_system", func() map[string]plugins.Metrics {
+		return map[string]plugins.Metrics{
+			"helm": {Calls: 3, Errors: 1, InFlight: 1, Restarts: 2, Instances: 1, Duration: 1500 * time.Millisecond},
+		}
+	})
+
+	expected := `
+# HELP ocm_system_plugin_calls_total Number of calls made to the plugin.
+# TYPE ocm_system_plugin_calls_total counter
+ocm_system_plugin_calls_total{plugin="helm"} 3
+# HELP ocm_system_plugin_restarts_total Number of times an instance of the plugin was restarted.
+# TYPE ocm_system_plugin_restarts_total counter
+ocm_system_plugin_restarts_total{plugin="helm"} 2
+# HELP ocm_system_plugin_call_duration_seconds_total Accumulated duration of all finished calls to the plugin.
+# TYPE ocm_system_plugin_call_duration_seconds_total counter
+ocm_system_plugin_call_duration_seconds_total{plugin="helm"} 1.5
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"ocm_system_plugin_calls_total", "ocm_system_plugin_restarts_total", "ocm_system_plugin_call_duration_seconds_total"))
+	require.Equal(t, 6, testutil.CollectAndCount(collector))
+}